@@ -10,6 +10,7 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 )
 
 const (
@@ -69,9 +70,12 @@ func (i *IsolateOptions) Run() error {
 
 	errList := []error{}
 	for _, project := range projects {
-		// TBD: Create or Update network namespace
-		// TODO: Fix this once we move VNID allocation to REST layer
-		errList = append(errList, fmt.Errorf("Project '%s' can not be isolated. Isolate project network feature yet to be implemented!", project.ObjectMeta.Name))
+		netns, err := i.Options.UpdatePodNetwork(project.ObjectMeta.Name, sdnapi.IsolatePodNetwork, "")
+		if err != nil {
+			errList = append(errList, fmt.Errorf("Network isolation for project '%s' failed, error: %v", project.ObjectMeta.Name, err))
+		} else if netns.NetID == globalVNID {
+			errList = append(errList, fmt.Errorf("Network isolation for project '%s' failed, check the master logs for details", project.ObjectMeta.Name))
+		}
 	}
 	return kerrors.NewAggregate(errList)
 }
@@ -11,6 +11,7 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 )
 
 const (
@@ -90,9 +91,11 @@ func (j *JoinOptions) Run() error {
 
 	errList := []error{}
 	for _, project := range projects {
-		err = j.Options.CreateOrUpdateNetNamespace(project.ObjectMeta.Name, netID)
+		netns, err := j.Options.UpdatePodNetwork(project.ObjectMeta.Name, sdnapi.JoinPodNetwork, j.joinProjectName)
 		if err != nil {
 			errList = append(errList, fmt.Errorf("Project '%s' failed to join '%s', error: %v", project.ObjectMeta.Name, j.joinProjectName, err))
+		} else if netns.NetID != netID {
+			errList = append(errList, fmt.Errorf("Project '%s' failed to join '%s', check the master logs for details", project.ObjectMeta.Name, j.joinProjectName))
 		}
 	}
 	return kerrors.NewAggregate(errList)
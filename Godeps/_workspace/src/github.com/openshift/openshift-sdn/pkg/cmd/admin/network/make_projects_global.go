@@ -10,6 +10,7 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 )
 
 const (
@@ -71,9 +72,11 @@ func (m *MakeGlobalOptions) Run() error {
 
 	errList := []error{}
 	for _, project := range projects {
-		err = m.Options.CreateOrUpdateNetNamespace(project.ObjectMeta.Name, globalVNID)
+		netns, err := m.Options.UpdatePodNetwork(project.ObjectMeta.Name, sdnapi.GlobalPodNetwork, "")
 		if err != nil {
 			errList = append(errList, fmt.Errorf("Removing network isolation for project '%s' failed, error: %v", project.ObjectMeta.Name, err))
+		} else if netns.NetID != globalVNID {
+			errList = append(errList, fmt.Errorf("Removing network isolation for project '%s' failed, check the master logs for details", project.ObjectMeta.Name))
 		}
 	}
 	return kerrors.NewAggregate(errList)
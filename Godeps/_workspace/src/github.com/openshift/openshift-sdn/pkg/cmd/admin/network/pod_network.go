@@ -29,9 +29,8 @@ func NewCmdPodNetwork(name, fullName string, f *clientcmd.Factory, out io.Writer
 
 	cmds.AddCommand(NewCmdJoinProjectsNetwork(JoinProjectsNetworkCommandName, fullName+" "+JoinProjectsNetworkCommandName, f, out))
 	cmds.AddCommand(NewCmdMakeGlobalProjectsNetwork(MakeGlobalProjectsNetworkCommandName, fullName+" "+MakeGlobalProjectsNetworkCommandName, f, out))
-
-	// TODO: Enable isolate-projects subcommand once we move VNID allocation to REST layer
-	//cmds.AddCommand(NewCmdIsolateProjectsNetwork(IsolateProjectsNetworkCommandName, fullName+" "+IsolateProjectsNetworkCommandName, f, out))
+	cmds.AddCommand(NewCmdIsolateProjectsNetwork(IsolateProjectsNetworkCommandName, fullName+" "+IsolateProjectsNetworkCommandName, f, out))
+	cmds.AddCommand(NewCmdVerifyPodNetwork(VerifyPodNetworkCommandName, fullName+" "+VerifyPodNetworkCommandName, f, out))
 
 	return cmds
 }
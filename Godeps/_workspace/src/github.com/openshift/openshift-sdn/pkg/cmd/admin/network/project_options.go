@@ -6,18 +6,19 @@ import (
 	"io"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
-	"k8s.io/kubernetes/pkg/api/unversioned"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/wait"
 
 	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -27,6 +28,9 @@ import (
 
 const (
 	ovsPluginName = "redhat/openshift-ovs-multitenant"
+
+	changePodNetworkInterval = time.Second
+	changePodNetworkTimeout  = 30 * time.Second
 )
 
 type ProjectOptions struct {
@@ -167,25 +171,31 @@ func (p *ProjectOptions) GetNetID(name string) (uint, error) {
 	return netID, fmt.Errorf("Net ID not found for project: %s", name)
 }
 
-func (p *ProjectOptions) CreateOrUpdateNetNamespace(name string, id uint) error {
+// UpdatePodNetwork asks the SDN master to apply action to the network of the given project and waits
+// until the master has handled the request.  joinName is the project to join for the join action.
+func (p *ProjectOptions) UpdatePodNetwork(name string, action sdnapi.PodNetworkAction, joinName string) (*sdnapi.NetNamespace, error) {
 	netns, err := p.Oclient.NetNamespaces().Get(name)
 	if err != nil {
-		// Create netns
-		netns := newNetNamespace(name, id)
-		_, err = p.Oclient.NetNamespaces().Create(netns)
-	} else if netns.NetID != id {
-		// Update netns
-		netns.NetID = id
-		_, err = p.Oclient.NetNamespaces().Update(netns)
+		return nil, err
+	}
+	sdnapi.SetChangePodNetworkAnnotation(netns, action, joinName)
+	if _, err := p.Oclient.NetNamespaces().Update(netns); err != nil {
+		return nil, err
 	}
-	return err
-}
 
-func newNetNamespace(name string, id uint) *sdnapi.NetNamespace {
-	return &sdnapi.NetNamespace{
-		TypeMeta:   unversioned.TypeMeta{Kind: "NetNamespace"},
-		ObjectMeta: kapi.ObjectMeta{Name: name},
-		NetName:    name,
-		NetID:      id,
+	// the master removes the annotation once it has handled the request
+	var updated *sdnapi.NetNamespace
+	err = wait.Poll(changePodNetworkInterval, changePodNetworkTimeout, func() (bool, error) {
+		var err error
+		updated, err = p.Oclient.NetNamespaces().Get(name)
+		if err != nil {
+			return false, err
+		}
+		_, _, err = sdnapi.GetChangePodNetworkAnnotation(updated)
+		return err == sdnapi.ErrorPodNetworkAnnotationNotFound, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("the change was not applied within %v, check that the master is running with the %s network plugin", changePodNetworkTimeout, ovsPluginName)
 	}
+	return updated, err
 }
@@ -0,0 +1,279 @@
+package network
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+	sdnvalidation "github.com/openshift/origin/pkg/sdn/api/validation"
+)
+
+const (
+	VerifyPodNetworkCommandName = "verify"
+
+	verifyPodNetworkLong = `
+Verify the pod network
+
+Checks that the host subnets and network namespaces stored by the master are consistent with the
+cluster network, the nodes, and the projects of the cluster:
+
+* every host subnet belongs to a node, and was allocated from the cluster network without
+  overlapping the subnet of another node
+* every node has a host subnet
+* every network namespace belongs to a project and has a valid network id
+* when the %[1]s network plugin is used, every project has a network namespace
+
+With --repair, the host subnets of nodes and the network namespaces of projects that no longer
+exist are listed for deletion, so that their subnets and network ids can be allocated again. The
+--confirm flag is needed for them to be deleted. Other problems are only reported. The Open
+vSwitch flows of the nodes are not inspected.`
+
+	verifyPodNetworkExample = `	# Check the pod network for problems
+	$ %[1]s
+
+	# List the host subnets and network namespaces left behind by deleted nodes and projects
+	$ %[1]s --repair
+
+	# Delete the host subnets and network namespaces left behind by deleted nodes and projects
+	$ %[1]s --repair --confirm`
+)
+
+type VerifyOptions struct {
+	Oclient *osclient.Client
+	Kclient *kclient.Client
+	Out     io.Writer
+
+	Repair  bool
+	Confirm bool
+}
+
+func NewCmdVerifyPodNetwork(commandName, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &VerifyOptions{}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Verify pod network",
+		Long:    fmt.Sprintf(verifyPodNetworkLong, ovsPluginName),
+		Example: fmt.Sprintf(verifyPodNetworkExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := opts.Complete(f, c, args, out); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+
+			err := opts.Run()
+			kcmdutil.CheckErr(err)
+		},
+	}
+	flags := cmd.Flags()
+
+	flags.BoolVar(&opts.Repair, "repair", false, "List the host subnets of nodes and the network namespaces of projects that no longer exist for deletion")
+	flags.BoolVar(&opts.Confirm, "confirm", false, "Specify that the repair should proceed. Defaults to false, displaying what would be deleted but not actually deleting anything.")
+
+	return cmd
+}
+
+func (v *VerifyOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string, out io.Writer) error {
+	if len(args) != 0 {
+		return kcmdutil.UsageError(c, "no arguments are allowed")
+	}
+	if v.Confirm && !v.Repair {
+		return kcmdutil.UsageError(c, "--confirm is only valid with --repair")
+	}
+	oc, kc, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	v.Oclient = oc
+	v.Kclient = kc
+	v.Out = out
+	return nil
+}
+
+// podNetworkState holds the objects the pod network is verified against
+type podNetworkState struct {
+	clusterNetwork *sdnapi.ClusterNetwork
+	subnets        []sdnapi.HostSubnet
+	netNamespaces  []sdnapi.NetNamespace
+	nodes          sets.String
+	namespaces     sets.String
+}
+
+// podNetworkProblems holds the problems found in the pod network
+type podNetworkProblems struct {
+	messages []string
+	// staleSubnets are the host subnets of nodes that no longer exist
+	staleSubnets []string
+	// staleNetNamespaces are the network namespaces of projects that no longer exist
+	staleNetNamespaces []string
+}
+
+func (p *podNetworkProblems) add(format string, args ...interface{}) {
+	p.messages = append(p.messages, fmt.Sprintf(format, args...))
+}
+
+func (v *VerifyOptions) Run() error {
+	state := &podNetworkState{nodes: sets.NewString(), namespaces: sets.NewString()}
+
+	clusterNetwork, err := v.Oclient.ClusterNetwork().Get(sdnapi.ClusterNetworkDefault)
+	if err != nil {
+		return fmt.Errorf("unable to get the cluster network: %v", err)
+	}
+	state.clusterNetwork = clusterNetwork
+	subnets, err := v.Oclient.HostSubnets().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	state.subnets = subnets.Items
+	netNamespaces, err := v.Oclient.NetNamespaces().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	state.netNamespaces = netNamespaces.Items
+	nodes, err := v.Kclient.Nodes().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes.Items {
+		state.nodes.Insert(node.Name)
+	}
+	namespaces, err := v.Kclient.Namespaces().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces.Items {
+		state.namespaces.Insert(namespace.Name)
+	}
+
+	problems := verifyPodNetwork(state)
+	for _, message := range problems.messages {
+		fmt.Fprintf(v.Out, "%s\n", message)
+	}
+	remaining := len(problems.messages)
+
+	if v.Repair && !v.Confirm {
+		for _, name := range problems.staleSubnets {
+			fmt.Fprintf(v.Out, "Would delete host subnet %q\n", name)
+		}
+		for _, name := range problems.staleNetNamespaces {
+			fmt.Fprintf(v.Out, "Would delete network namespace %q\n", name)
+		}
+		if len(problems.staleSubnets)+len(problems.staleNetNamespaces) > 0 {
+			fmt.Fprintf(v.Out, "Dry run enabled - no modifications will be made. Add --confirm to delete them\n")
+		}
+	}
+	if v.Repair && v.Confirm {
+		for _, name := range problems.staleSubnets {
+			if err := v.Oclient.HostSubnets().Delete(name); err != nil {
+				fmt.Fprintf(v.Out, "Unable to delete host subnet %q: %v\n", name, err)
+				continue
+			}
+			fmt.Fprintf(v.Out, "Deleted host subnet %q\n", name)
+			remaining--
+		}
+		for _, name := range problems.staleNetNamespaces {
+			if err := v.Oclient.NetNamespaces().Delete(name); err != nil {
+				fmt.Fprintf(v.Out, "Unable to delete network namespace %q: %v\n", name, err)
+				continue
+			}
+			fmt.Fprintf(v.Out, "Deleted network namespace %q\n", name)
+			remaining--
+		}
+	}
+
+	if remaining > 0 {
+		return fmt.Errorf("%d problem(s) found in the pod network", remaining)
+	}
+	if len(problems.messages) == 0 {
+		fmt.Fprintf(v.Out, "The pod network is consistent\n")
+	}
+	return nil
+}
+
+// verifyPodNetwork returns the problems found in the host subnets and network namespaces
+func verifyPodNetwork(state *podNetworkState) *podNetworkProblems {
+	problems := &podNetworkProblems{}
+
+	subnets := append([]sdnapi.HostSubnet{}, state.subnets...)
+	sort.Sort(hostSubnetsByName(subnets))
+	hosts := sets.NewString()
+	subnetNets := map[string]*net.IPNet{}
+	for i := range subnets {
+		hs := &subnets[i]
+		hosts.Insert(hs.Name)
+		if !state.nodes.Has(hs.Name) {
+			problems.add("Host subnet %q belongs to a node that does not exist", hs.Name)
+			problems.staleSubnets = append(problems.staleSubnets, hs.Name)
+		}
+
+		errs := sdnvalidation.ValidateHostSubnet(hs)
+		errs = append(errs, sdnvalidation.ValidateHostSubnetClusterNetwork(hs, state.clusterNetwork)...)
+		for _, err := range errs {
+			problems.add("Host subnet %q is invalid: %v", hs.Name, err)
+		}
+
+		_, subnetNet, err := net.ParseCIDR(hs.Subnet)
+		if err != nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			other, ok := subnetNets[subnets[j].Name]
+			if ok && (other.Contains(subnetNet.IP) || subnetNet.Contains(other.IP)) {
+				problems.add("Host subnet %q overlaps with host subnet %q", hs.Name, subnets[j].Name)
+			}
+		}
+		subnetNets[hs.Name] = subnetNet
+	}
+	for _, node := range state.nodes.List() {
+		if !hosts.Has(node) {
+			problems.add("Node %q has no host subnet", node)
+		}
+	}
+
+	netNamespaces := append([]sdnapi.NetNamespace{}, state.netNamespaces...)
+	sort.Sort(netNamespacesByName(netNamespaces))
+	netNames := sets.NewString()
+	for i := range netNamespaces {
+		netns := &netNamespaces[i]
+		netNames.Insert(netns.Name)
+		if !state.namespaces.Has(netns.Name) {
+			problems.add("Network namespace %q belongs to a project that does not exist", netns.Name)
+			problems.staleNetNamespaces = append(problems.staleNetNamespaces, netns.Name)
+		}
+		for _, err := range sdnvalidation.ValidateNetNamespace(netns) {
+			problems.add("Network namespace %q is invalid: %v", netns.Name, err)
+		}
+	}
+	// network namespaces are only created by the multitenant plugin
+	if len(netNamespaces) > 0 {
+		for _, namespace := range state.namespaces.List() {
+			if !netNames.Has(namespace) {
+				problems.add("Project %q has no network namespace", namespace)
+			}
+		}
+	}
+
+	return problems
+}
+
+type hostSubnetsByName []sdnapi.HostSubnet
+
+func (s hostSubnetsByName) Len() int           { return len(s) }
+func (s hostSubnetsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s hostSubnetsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+type netNamespacesByName []sdnapi.NetNamespace
+
+func (s netNamespacesByName) Len() int           { return len(s) }
+func (s netNamespacesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s netNamespacesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
@@ -13,6 +13,12 @@ const (
 	Modified EventType = "MODIFIED"
 )
 
+// ClusterNetwork is a CIDR that node subnets are allocated from
+type ClusterNetwork struct {
+	CIDR             string
+	HostSubnetLength uint
+}
+
 type Subnet struct {
 	NodeIP     string
 	SubnetCIDR string
@@ -43,6 +49,10 @@ type NetNamespaceEvent struct {
 	Type  EventType
 	Name  string
 	NetID uint
+	// Action is the requested change of the pod network of the namespace, if any
+	Action string
+	// ActionNamespace is the namespace whose network should be joined for the join action
+	ActionNamespace string
 }
 
 type NamespaceEvent struct {
@@ -84,7 +94,7 @@ type Pod struct {
 type OsdnPlugin interface {
 	knetwork.NetworkPlugin
 
-	StartMaster(clusterNetworkCIDR string, clusterBitsPerSubnet uint, serviceNetworkCIDR string) error
+	StartMaster(clusterNetworks []ClusterNetwork, serviceNetworkCIDR string) error
 	StartNode(mtu uint) error
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/golang/glog"
@@ -19,7 +20,7 @@ import (
 )
 
 type PluginHooks interface {
-	PluginStartMaster(clusterNetworkCIDR string, clusterBitsPerSubnet uint, serviceNetworkCIDR string) error
+	PluginStartMaster(clusterNetworks []api.ClusterNetwork, serviceNetworkCIDR string) error
 	PluginStartNode(mtu uint) error
 	UpdatePod(namespace string, name string, id kubetypes.DockerID) error
 }
@@ -30,18 +31,19 @@ type OvsController struct {
 	localIP         string
 	localSubnet     *api.Subnet
 	hostName        string
-	subnetAllocator *netutils.SubnetAllocator
+	subnetAllocators []*netutils.SubnetAllocator
 	sig             chan struct{}
 	podNetworkReady chan struct{}
 	flowController  FlowController
 	VNIDMap         map[string]uint
 	netIDManager    *netutils.NetIDAllocator
+	vnidLock        sync.Mutex
 	adminNamespaces []string
 	services        map[string]api.Service
 }
 
 type FlowController interface {
-	Setup(localSubnetCIDR, clusterNetworkCIDR, serviceNetworkCIDR string, mtu uint) error
+	Setup(localSubnetCIDR string, clusterNetworkCIDRs []string, serviceNetworkCIDR string, mtu uint) error
 
 	AddOFRules(nodeIP, nodeSubnetCIDR, localIP string) error
 	DelOFRules(nodeIP, localIP string) error
@@ -83,31 +85,41 @@ func (oc *OvsController) BaseInit(registry *Registry, flowController FlowControl
 	return nil
 }
 
-func (oc *OvsController) validateClusterNetwork(networkCIDR string, subnetsInUse []string, hostIPNets []*net.IPNet) error {
-	clusterIP, clusterIPNet, err := net.ParseCIDR(networkCIDR)
-	if err != nil {
-		return fmt.Errorf("Failed to parse network address: %s", networkCIDR)
-	}
-
+func (oc *OvsController) validateClusterNetworks(clusterNetworks []api.ClusterNetwork, subnetsInUse []string, hostIPNets []*net.IPNet) error {
 	errList := []error{}
-	for _, ipNet := range hostIPNets {
-		if ipNet.Contains(clusterIP) {
-			errList = append(errList, fmt.Errorf("Error: Cluster IP: %s conflicts with host network: %s", clusterIP.String(), ipNet.String()))
+	clusterIPNets := []*net.IPNet{}
+	for _, clusterNetwork := range clusterNetworks {
+		networkCIDR := clusterNetwork.CIDR
+		clusterIP, clusterIPNet, err := net.ParseCIDR(networkCIDR)
+		if err != nil {
+			errList = append(errList, fmt.Errorf("Failed to parse network address: %s", networkCIDR))
+			continue
 		}
-		if clusterIPNet.Contains(ipNet.IP) {
-			errList = append(errList, fmt.Errorf("Error: Host network with IP: %s conflicts with cluster network: %s", ipNet.IP.String(), networkCIDR))
+		clusterIPNets = append(clusterIPNets, clusterIPNet)
+
+		for _, ipNet := range hostIPNets {
+			if ipNet.Contains(clusterIP) {
+				errList = append(errList, fmt.Errorf("Error: Cluster IP: %s conflicts with host network: %s", clusterIP.String(), ipNet.String()))
+			}
+			if clusterIPNet.Contains(ipNet.IP) {
+				errList = append(errList, fmt.Errorf("Error: Host network with IP: %s conflicts with cluster network: %s", ipNet.IP.String(), networkCIDR))
+			}
 		}
 	}
 
+SubnetLoop:
 	for _, netStr := range subnetsInUse {
 		subnetIP, _, err := net.ParseCIDR(netStr)
 		if err != nil {
 			errList = append(errList, fmt.Errorf("Failed to parse network address: %s", netStr))
 			continue
 		}
-		if !clusterIPNet.Contains(subnetIP) {
-			errList = append(errList, fmt.Errorf("Error: Existing node subnet: %s is not part of cluster network: %s", netStr, networkCIDR))
+		for _, clusterIPNet := range clusterIPNets {
+			if clusterIPNet.Contains(subnetIP) {
+				continue SubnetLoop
+			}
 		}
+		errList = append(errList, fmt.Errorf("Error: Existing node subnet: %s is not part of any cluster network", netStr))
 	}
 	return kerrors.NewAggregate(errList)
 }
@@ -140,7 +152,7 @@ func (oc *OvsController) validateServiceNetwork(networkCIDR string, hostIPNets [
 	return kerrors.NewAggregate(errList)
 }
 
-func (oc *OvsController) validateNetworkConfig(clusterNetworkCIDR, serviceNetworkCIDR string, subnetsInUse []string) error {
+func (oc *OvsController) validateNetworkConfig(clusterNetworks []api.ClusterNetwork, serviceNetworkCIDR string, subnetsInUse []string) error {
 	// TODO: Instead of hardcoding 'tun0' and 'lbr0', get it from common place.
 	// This will ensure both the kube/multitenant scripts and master validations use the same name.
 	hostIPNets, err := netutils.GetHostIPNetworks([]string{"tun0", "lbr0"})
@@ -149,7 +161,7 @@ func (oc *OvsController) validateNetworkConfig(clusterNetworkCIDR, serviceNetwor
 	}
 
 	errList := []error{}
-	if err := oc.validateClusterNetwork(clusterNetworkCIDR, subnetsInUse, hostIPNets); err != nil {
+	if err := oc.validateClusterNetworks(clusterNetworks, subnetsInUse, hostIPNets); err != nil {
 		errList = append(errList, err)
 	}
 	if err := oc.validateServiceNetwork(serviceNetworkCIDR, hostIPNets); err != nil {
@@ -158,11 +170,11 @@ func (oc *OvsController) validateNetworkConfig(clusterNetworkCIDR, serviceNetwor
 	return kerrors.NewAggregate(errList)
 }
 
-func (oc *OvsController) StartMaster(clusterNetworkCIDR string, clusterBitsPerSubnet uint, serviceNetworkCIDR string) error {
+func (oc *OvsController) StartMaster(clusterNetworks []api.ClusterNetwork, serviceNetworkCIDR string) error {
 	// Any mismatch in cluster/service network is handled by WriteNetworkConfig
 	// For any new cluster/service network, ensure existing node subnets belong
-	// to the given cluster network and service IPs belong to the given service network
-	if _, err := oc.Registry.GetClusterNetworkCIDR(); err != nil {
+	// to the given cluster networks and service IPs belong to the given service network
+	if _, err := oc.Registry.GetClusterNetworkCIDRs(); err != nil {
 		subrange := make([]string, 0)
 		subnets, _, err := oc.Registry.GetSubnets()
 		if err != nil {
@@ -173,17 +185,17 @@ func (oc *OvsController) StartMaster(clusterNetworkCIDR string, clusterBitsPerSu
 			subrange = append(subrange, sub.SubnetCIDR)
 		}
 
-		err = oc.validateNetworkConfig(clusterNetworkCIDR, serviceNetworkCIDR, subrange)
+		err = oc.validateNetworkConfig(clusterNetworks, serviceNetworkCIDR, subrange)
 		if err != nil {
 			return err
 		}
 	}
 
-	if err := oc.Registry.WriteNetworkConfig(clusterNetworkCIDR, clusterBitsPerSubnet, serviceNetworkCIDR); err != nil {
+	if err := oc.Registry.WriteNetworkConfig(clusterNetworks, serviceNetworkCIDR); err != nil {
 		return err
 	}
 
-	if err := oc.pluginHooks.PluginStartMaster(clusterNetworkCIDR, clusterBitsPerSubnet, serviceNetworkCIDR); err != nil {
+	if err := oc.pluginHooks.PluginStartMaster(clusterNetworks, serviceNetworkCIDR); err != nil {
 		return fmt.Errorf("Failed to start plugin: %v", err)
 	}
 
@@ -192,19 +204,19 @@ func (oc *OvsController) StartMaster(clusterNetworkCIDR string, clusterBitsPerSu
 
 func (oc *OvsController) StartNode(mtu uint) error {
 	// Assume we are working with IPv4
-	clusterNetworkCIDR, err := oc.Registry.GetClusterNetworkCIDR()
+	clusterNetworkCIDRs, err := oc.Registry.GetClusterNetworkCIDRs()
 	if err != nil {
 		log.Errorf("Failed to obtain ClusterNetwork: %v", err)
 		return err
 	}
 
 	ipt := iptables.New(kexec.New(), utildbus.New(), iptables.ProtocolIpv4)
-	if err := SetupIptables(ipt, clusterNetworkCIDR); err != nil {
+	if err := SetupIptables(ipt, clusterNetworkCIDRs); err != nil {
 		return fmt.Errorf("Failed to set up iptables: %v", err)
 	}
 
 	ipt.AddReloadFunc(func() {
-		err := SetupIptables(ipt, clusterNetworkCIDR)
+		err := SetupIptables(ipt, clusterNetworkCIDRs)
 		if err != nil {
 			log.Errorf("Error reloading iptables: %v\n", err)
 		}
@@ -296,13 +308,42 @@ type FirewallRule struct {
 	args  []string
 }
 
-func SetupIptables(ipt iptables.Interface, clusterNetworkCIDR string) error {
+// masqueradeChain holds the rules that masquerade traffic leaving the cluster networks. Traffic between two
+// cluster networks returns from the chain before reaching the MASQUERADE rule.
+const masqueradeChain = "OPENSHIFT-MASQUERADE"
+
+func SetupIptables(ipt iptables.Interface, clusterNetworkCIDRs []string) error {
+	if _, err := ipt.EnsureChain(iptables.TableNAT, masqueradeChain); err != nil {
+		return err
+	}
+	// The chain is rebuilt so that cluster networks added since the last start are returned from
+	// before the MASQUERADE rule.
+	if err := ipt.FlushChain(iptables.TableNAT, masqueradeChain); err != nil {
+		return err
+	}
+	for _, cidr := range clusterNetworkCIDRs {
+		if _, err := ipt.EnsureRule(iptables.Append, iptables.TableNAT, masqueradeChain, "-d", cidr, "-j", "RETURN"); err != nil {
+			return err
+		}
+	}
+	if _, err := ipt.EnsureRule(iptables.Append, iptables.TableNAT, masqueradeChain, "-j", "MASQUERADE"); err != nil {
+		return err
+	}
+
 	rules := []FirewallRule{
-		{"nat", "POSTROUTING", []string{"-s", clusterNetworkCIDR, "!", "-d", clusterNetworkCIDR, "-j", "MASQUERADE"}},
 		{"filter", "INPUT", []string{"-p", "udp", "-m", "multiport", "--dports", "4789", "-m", "comment", "--comment", "001 vxlan incoming", "-j", "ACCEPT"}},
 		{"filter", "INPUT", []string{"-i", "tun0", "-m", "comment", "--comment", "traffic from docker for internet", "-j", "ACCEPT"}},
-		{"filter", "FORWARD", []string{"-d", clusterNetworkCIDR, "-j", "ACCEPT"}},
-		{"filter", "FORWARD", []string{"-s", clusterNetworkCIDR, "-j", "ACCEPT"}},
+	}
+	for _, cidr := range clusterNetworkCIDRs {
+		// Nodes set up before multiple cluster networks were supported masquerade directly from POSTROUTING
+		if err := ipt.DeleteRule(iptables.TableNAT, iptables.ChainPostrouting, "-s", cidr, "!", "-d", cidr, "-j", "MASQUERADE"); err != nil {
+			return err
+		}
+		rules = append(rules,
+			FirewallRule{"nat", "POSTROUTING", []string{"-s", cidr, "-j", masqueradeChain}},
+			FirewallRule{"filter", "FORWARD", []string{"-d", cidr, "-j", "ACCEPT"}},
+			FirewallRule{"filter", "FORWARD", []string{"-s", cidr, "-j", "ACCEPT"}},
+		)
 	}
 
 	for _, rule := range rules {
@@ -35,7 +35,7 @@ func NewFlowController(multitenant bool) *FlowController {
 	return &FlowController{multitenant}
 }
 
-func alreadySetUp(multitenant bool, localSubnetGatewayCIDR string) bool {
+func alreadySetUp(multitenant bool, localSubnetGatewayCIDR string, clusterNetworkCIDRs []string) bool {
 	var found bool
 
 	itx := ipcmd.NewTransaction(LBR)
@@ -76,6 +76,20 @@ func alreadySetUp(multitenant bool, localSubnetGatewayCIDR string) bool {
 		return false
 	}
 
+	// Cluster networks added since the bridge was set up need their own routing flows
+	for _, cidr := range clusterNetworkCIDRs {
+		found = false
+		for _, flow := range flows {
+			if strings.Contains(flow, "table=6") && strings.Contains(flow, "nw_dst="+cidr) && strings.Contains(flow, "goto_table:8") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -109,7 +123,7 @@ func deleteLocalSubnetRoute(device, localSubnetCIDR string) {
 	glog.Errorf("Timed out looking for %s route for dev %s; if it appears later it will not be deleted.", localSubnetCIDR, device)
 }
 
-func (c *FlowController) Setup(localSubnetCIDR, clusterNetworkCIDR, servicesNetworkCIDR string, mtu uint) error {
+func (c *FlowController) Setup(localSubnetCIDR string, clusterNetworkCIDRs []string, servicesNetworkCIDR string, mtu uint) error {
 	_, ipnet, err := net.ParseCIDR(localSubnetCIDR)
 	localSubnetMaskLength, _ := ipnet.Mask.Size()
 	localSubnetGateway := netutils.GenerateDefaultGateway(ipnet).String()
@@ -117,7 +131,7 @@ func (c *FlowController) Setup(localSubnetCIDR, clusterNetworkCIDR, servicesNetw
 	glog.V(5).Infof("[SDN setup] node pod subnet %s gateway %s", ipnet.String(), localSubnetGateway)
 
 	gwCIDR := fmt.Sprintf("%s/%d", localSubnetGateway, localSubnetMaskLength)
-	if alreadySetUp(c.multitenant, gwCIDR) {
+	if alreadySetUp(c.multitenant, gwCIDR, clusterNetworkCIDRs) {
 		glog.V(5).Infof("[SDN setup] no SDN setup required")
 		return nil
 	}
@@ -147,7 +161,7 @@ func (c *FlowController) Setup(localSubnetCIDR, clusterNetworkCIDR, servicesNetw
 		glog.V(5).Infof("[SDN setup] docker setup success:\n%s", out)
 	}
 
-	config := fmt.Sprintf("export OPENSHIFT_CLUSTER_SUBNET=%s", clusterNetworkCIDR)
+	config := fmt.Sprintf("export OPENSHIFT_CLUSTER_SUBNETS=%q", strings.Join(clusterNetworkCIDRs, " "))
 	err = ioutil.WriteFile("/run/openshift-sdn/config.env", []byte(config), 0644)
 	if err != nil {
 		return err
@@ -228,7 +242,9 @@ func (c *FlowController) Setup(localSubnetCIDR, clusterNetworkCIDR, servicesNetw
 	} else {
 		otx.AddFlow("table=6, priority=150, ip, nw_dst=%s, actions=goto_table:9", localSubnetCIDR)
 	}
-	otx.AddFlow("table=6, priority=100, ip, nw_dst=%s, actions=goto_table:8", clusterNetworkCIDR)
+	for _, clusterNetworkCIDR := range clusterNetworkCIDRs {
+		otx.AddFlow("table=6, priority=100, ip, nw_dst=%s, actions=goto_table:8", clusterNetworkCIDR)
+	}
 	otx.AddFlow("table=6, priority=0, ip, actions=output:2")
 
 	// Table 7; to local container with isolation; filled in by openshift-sdn-ovs
@@ -251,7 +267,9 @@ func (c *FlowController) Setup(localSubnetCIDR, clusterNetworkCIDR, servicesNetw
 	itx.AddAddress(gwCIDR)
 	defer deleteLocalSubnetRoute(TUN, localSubnetCIDR)
 	itx.SetLink("up")
-	itx.AddRoute(clusterNetworkCIDR, "proto", "kernel", "scope", "link")
+	for _, clusterNetworkCIDR := range clusterNetworkCIDRs {
+		itx.AddRoute(clusterNetworkCIDR, "proto", "kernel", "scope", "link")
+	}
 	itx.AddRoute(servicesNetworkCIDR)
 	err = itx.EndTransaction()
 	if err != nil {
@@ -43,8 +43,8 @@ func CreatePlugin(registry *osdn.Registry, multitenant bool, hostname string, se
 	}
 }
 
-func (plugin *ovsPlugin) PluginStartMaster(clusterNetworkCIDR string, clusterBitsPerSubnet uint, serviceNetworkCIDR string) error {
-	if err := plugin.SubnetStartMaster(clusterNetworkCIDR, clusterBitsPerSubnet, serviceNetworkCIDR); err != nil {
+func (plugin *ovsPlugin) PluginStartMaster(clusterNetworks []api.ClusterNetwork, serviceNetworkCIDR string) error {
+	if err := plugin.SubnetStartMaster(clusterNetworks, serviceNetworkCIDR); err != nil {
 		return err
 	}
 
@@ -2,6 +2,7 @@ package osdn
 
 import (
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -35,7 +36,7 @@ type Registry struct {
 	// These are only set if SetBaseEndpointsHandler() has been called
 	baseEndpointsHandler pconfig.EndpointsConfigHandler
 	serviceNetwork       *net.IPNet
-	clusterNetwork       *net.IPNet
+	clusterNetworks      []*net.IPNet
 }
 
 func NewRegistry(osClient *osclient.Client, kClient *kclient.Client) *Registry {
@@ -253,36 +254,51 @@ func (registry *Registry) WatchNodes(receiver chan<- *osdnapi.NodeEvent, ready c
 	}
 }
 
-func (registry *Registry) WriteNetworkConfig(network string, subnetLength uint, serviceNetwork string) error {
+func (registry *Registry) WriteNetworkConfig(clusterNetworks []osdnapi.ClusterNetwork, serviceNetwork string) error {
+	entries := make([]originapi.ClusterNetworkEntry, 0, len(clusterNetworks))
+	for _, clusterNetwork := range clusterNetworks {
+		entries = append(entries, originapi.ClusterNetworkEntry{CIDR: clusterNetwork.CIDR, HostSubnetLength: int(clusterNetwork.HostSubnetLength)})
+	}
+	// A single cluster network is only written to the fields that older nodes understand
+	if len(entries) == 1 {
+		entries = nil
+	}
+
 	cn, err := registry.oClient.ClusterNetwork().Get("default")
 	if err == nil {
-		if cn.Network == network && cn.HostSubnetLength == int(subnetLength) && cn.ServiceNetwork == serviceNetwork {
+		if cn.Network == clusterNetworks[0].CIDR && cn.HostSubnetLength == int(clusterNetworks[0].HostSubnetLength) && cn.ServiceNetwork == serviceNetwork && reflect.DeepEqual(cn.ClusterNetworks, entries) {
 			return nil
 		}
 
-		cn.Network = network
-		cn.HostSubnetLength = int(subnetLength)
+		cn.Network = clusterNetworks[0].CIDR
+		cn.HostSubnetLength = int(clusterNetworks[0].HostSubnetLength)
 		cn.ServiceNetwork = serviceNetwork
+		cn.ClusterNetworks = entries
 		_, err = registry.oClient.ClusterNetwork().Update(cn)
 		return err
 	}
 	cn = &originapi.ClusterNetwork{
 		TypeMeta:         unversioned.TypeMeta{Kind: "ClusterNetwork"},
 		ObjectMeta:       kapi.ObjectMeta{Name: "default"},
-		Network:          network,
-		HostSubnetLength: int(subnetLength),
+		Network:          clusterNetworks[0].CIDR,
+		HostSubnetLength: int(clusterNetworks[0].HostSubnetLength),
 		ServiceNetwork:   serviceNetwork,
+		ClusterNetworks:  entries,
 	}
 	_, err = registry.oClient.ClusterNetwork().Create(cn)
 	return err
 }
 
-func (registry *Registry) GetClusterNetworkCIDR() (string, error) {
+func (registry *Registry) GetClusterNetworkCIDRs() ([]string, error) {
 	cn, err := registry.oClient.ClusterNetwork().Get("default")
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	cidrs := []string{}
+	for _, entry := range originapi.GetClusterNetworkEntries(cn) {
+		cidrs = append(cidrs, entry.CIDR)
 	}
-	return cn.Network, nil
+	return cidrs, nil
 }
 
 func (registry *Registry) GetHostSubnetLength() (int, error) {
@@ -345,7 +361,16 @@ func (registry *Registry) WatchNetNamespaces(receiver chan<- *osdnapi.NetNamespa
 
 		switch eventType {
 		case watch.Added, watch.Modified:
-			receiver <- &osdnapi.NetNamespaceEvent{Type: osdnapi.Added, Name: netns.NetName, NetID: netns.NetID}
+			event := &osdnapi.NetNamespaceEvent{Type: osdnapi.Added, Name: netns.NetName, NetID: netns.NetID}
+			action, namespace, err := originapi.GetChangePodNetworkAnnotation(netns)
+			switch {
+			case err == nil:
+				event.Action = string(action)
+				event.ActionNamespace = namespace
+			case err != originapi.ErrorPodNetworkAnnotationNotFound:
+				log.Warningf("Ignoring the pod network change of namespace %q: %v", netns.NetName, err)
+			}
+			receiver <- event
 		case watch.Deleted:
 			receiver <- &osdnapi.NetNamespaceEvent{Type: osdnapi.Deleted, Name: netns.NetName}
 		}
@@ -384,6 +409,18 @@ func (registry *Registry) WriteNetNamespace(name string, id uint) error {
 	return err
 }
 
+// UpdateNetNamespace sets the network id of the given namespace and clears any pending pod network change
+func (registry *Registry) UpdateNetNamespace(name string, id uint) error {
+	netns, err := registry.oClient.NetNamespaces().Get(name)
+	if err != nil {
+		return err
+	}
+	netns.NetID = id
+	originapi.DeleteChangePodNetworkAnnotation(netns)
+	_, err = registry.oClient.NetNamespaces().Update(netns)
+	return err
+}
+
 func (registry *Registry) DeleteNetNamespace(name string) error {
 	return registry.oClient.NetNamespaces().Delete(name)
 }
@@ -607,10 +644,22 @@ func (registry *Registry) SetBaseEndpointsHandler(base pconfig.EndpointsConfigHa
 		// "can't happen"; StartNode() will already have ensured that there's no error
 		log.Fatalf("Failed to get ClusterNetwork: %v", err)
 	}
-	_, registry.clusterNetwork, _ = net.ParseCIDR(cn.Network)
+	for _, entry := range originapi.GetClusterNetworkEntries(cn) {
+		_, clusterNetwork, _ := net.ParseCIDR(entry.CIDR)
+		registry.clusterNetworks = append(registry.clusterNetworks, clusterNetwork)
+	}
 	_, registry.serviceNetwork, _ = net.ParseCIDR(cn.ServiceNetwork)
 }
 
+func (registry *Registry) inClusterNetwork(IP net.IP) bool {
+	for _, clusterNetwork := range registry.clusterNetworks {
+		if clusterNetwork.Contains(IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func (registry *Registry) OnEndpointsUpdate(allEndpoints []kapi.Endpoints) {
 	filteredEndpoints := make([]kapi.Endpoints, 0, len(allEndpoints))
 EndpointLoop:
@@ -623,7 +672,7 @@ EndpointLoop:
 					log.Warningf("Service '%s' in namespace '%s' has an Endpoint inside the service network (%s)", ep.ObjectMeta.Name, ns, addr.IP)
 					continue EndpointLoop
 				}
-				if registry.clusterNetwork.Contains(IP) {
+				if registry.inClusterNetwork(IP) {
 					podNamespace, ok := registry.namespaceOfPodIP[addr.IP]
 					if !ok {
 						log.Warningf("Service '%s' in namespace '%s' has an Endpoint pointing to non-existent pod (%s)", ep.ObjectMeta.Name, ns, addr.IP)
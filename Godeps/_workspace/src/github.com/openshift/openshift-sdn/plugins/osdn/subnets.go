@@ -11,7 +11,7 @@ import (
 	"github.com/openshift/openshift-sdn/plugins/osdn/api"
 )
 
-func (oc *OvsController) SubnetStartMaster(clusterNetworkCIDR string, clusterBitsPerSubnet uint, serviceNetworkCIDR string) error {
+func (oc *OvsController) SubnetStartMaster(clusterNetworks []api.ClusterNetwork, serviceNetworkCIDR string) error {
 	subrange := make([]string, 0)
 	subnets, _, err := oc.Registry.GetSubnets()
 	if err != nil {
@@ -22,9 +22,13 @@ func (oc *OvsController) SubnetStartMaster(clusterNetworkCIDR string, clusterBit
 		subrange = append(subrange, sub.SubnetCIDR)
 	}
 
-	oc.subnetAllocator, err = netutils.NewSubnetAllocator(clusterNetworkCIDR, clusterBitsPerSubnet, subrange)
-	if err != nil {
-		return err
+	oc.subnetAllocators = make([]*netutils.SubnetAllocator, 0, len(clusterNetworks))
+	for _, clusterNetwork := range clusterNetworks {
+		subnetAllocator, err := netutils.NewSubnetAllocator(clusterNetwork.CIDR, clusterNetwork.HostSubnetLength, subrange)
+		if err != nil {
+			return err
+		}
+		oc.subnetAllocators = append(oc.subnetAllocators, subnetAllocator)
 	}
 
 	getNodes := func(registry *Registry) (interface{}, string, error) {
@@ -58,8 +62,19 @@ func (oc *OvsController) serveExistingNodes(nodes []api.Node) error {
 	return nil
 }
 
+// allocateNetwork returns a free subnet from the first cluster network that has one
+func (oc *OvsController) allocateNetwork() (*net.IPNet, error) {
+	for _, subnetAllocator := range oc.subnetAllocators {
+		sn, err := subnetAllocator.GetNetwork()
+		if err == nil {
+			return sn, nil
+		}
+	}
+	return nil, fmt.Errorf("No subnets available.")
+}
+
 func (oc *OvsController) addNode(nodeName string, nodeIP string) error {
-	sn, err := oc.subnetAllocator.GetNetwork()
+	sn, err := oc.allocateNetwork()
 	if err != nil {
 		log.Errorf("Error creating network for node %s.", nodeName)
 		return err
@@ -92,7 +107,12 @@ func (oc *OvsController) deleteNode(nodeName string) error {
 		log.Errorf("Error parsing subnet for node %s for deletion: %s", nodeName, sub.SubnetCIDR)
 		return err
 	}
-	oc.subnetAllocator.ReleaseNetwork(ipnet)
+	// Only the allocator of the cluster network that contains the subnet can release it
+	for _, subnetAllocator := range oc.subnetAllocators {
+		if err := subnetAllocator.ReleaseNetwork(ipnet); err == nil {
+			break
+		}
+	}
 	return oc.Registry.DeleteSubnet(nodeName)
 }
 
@@ -103,7 +123,7 @@ func (oc *OvsController) SubnetStartNode(mtu uint) error {
 	}
 
 	// Assume we are working with IPv4
-	clusterNetworkCIDR, err := oc.Registry.GetClusterNetworkCIDR()
+	clusterNetworkCIDRs, err := oc.Registry.GetClusterNetworkCIDRs()
 	if err != nil {
 		log.Errorf("Failed to obtain ClusterNetwork: %v", err)
 		return err
@@ -113,7 +133,7 @@ func (oc *OvsController) SubnetStartNode(mtu uint) error {
 		log.Errorf("Failed to obtain ServicesNetwork: %v", err)
 		return err
 	}
-	err = oc.flowController.Setup(oc.localSubnet.SubnetCIDR, clusterNetworkCIDR, servicesNetworkCIDR, mtu)
+	err = oc.flowController.Setup(oc.localSubnet.SubnetCIDR, clusterNetworkCIDRs, servicesNetworkCIDR, mtu)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/openshift/openshift-sdn/pkg/netutils"
 	"github.com/openshift/openshift-sdn/plugins/osdn/api"
+	originapi "github.com/openshift/origin/pkg/sdn/api"
 	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
 )
 
@@ -49,6 +50,8 @@ func (oc *OvsController) VnidStartMaster() error {
 
 	// Handle existing namespaces
 	namespaces := result.([]string)
+	oc.vnidLock.Lock()
+	defer oc.vnidLock.Unlock()
 	for _, nsName := range namespaces {
 		// Revoke invalid VNID for admin namespaces
 		if oc.isAdminNamespace(nsName) {
@@ -70,6 +73,14 @@ func (oc *OvsController) VnidStartMaster() error {
 		}
 	}
 
+	// Apply pod network changes requested through the NetNamespaces
+	getNetNamespaces := func(registry *Registry) (interface{}, string, error) {
+		return registry.GetNetNamespaces()
+	}
+	if _, err := oc.watchAndGetResource("NetNamespace", watchNetNamespaceChanges, getNetNamespaces); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -143,6 +154,79 @@ func (oc *OvsController) revokeVNID(namespaceName string) error {
 	return nil
 }
 
+// isVNIDShared returns true if a namespace other than the given one uses netid
+func (oc *OvsController) isVNIDShared(namespaceName string, netid uint) bool {
+	for name, id := range oc.VNIDMap {
+		if name != namespaceName && id == netid {
+			return true
+		}
+	}
+	return false
+}
+
+// changePodNetwork applies a pod network change requested on the NetNamespace of the given namespace.
+// The request is always cleared, so a failed change has to be requested again.
+func (oc *OvsController) changePodNetwork(namespaceName string, action originapi.PodNetworkAction, joinNamespace string) error {
+	oc.vnidLock.Lock()
+	defer oc.vnidLock.Unlock()
+
+	oldNetID, found := oc.VNIDMap[namespaceName]
+	if !found {
+		return fmt.Errorf("Error while fetching Net ID for namespace: %s", namespaceName)
+	}
+
+	var err error
+	newNetID := oldNetID
+	allocated := false
+	switch {
+	case oc.isAdminNamespace(namespaceName):
+		err = fmt.Errorf("the network of admin namespace %s can not be changed", namespaceName)
+	case action == originapi.GlobalPodNetwork:
+		newNetID = AdminVNID
+	case action == originapi.JoinPodNetwork:
+		netid, ok := oc.VNIDMap[joinNamespace]
+		if !ok {
+			err = fmt.Errorf("Net ID not found for namespace: %s", joinNamespace)
+			break
+		}
+		newNetID = netid
+	case action == originapi.IsolatePodNetwork:
+		// a namespace that already has a network of its own keeps it
+		if oldNetID != AdminVNID && !oc.isVNIDShared(namespaceName, oldNetID) {
+			break
+		}
+		newNetID, err = oc.netIDManager.GetNetID()
+		allocated = err == nil
+	default:
+		err = fmt.Errorf("unknown pod network action %q", action)
+	}
+	if err != nil {
+		// clear the request so that the client can see that it was not applied
+		if e := oc.Registry.UpdateNetNamespace(namespaceName, oldNetID); e != nil {
+			log.Errorf("Error while clearing the pod network change of namespace %s: %v", namespaceName, e)
+		}
+		return err
+	}
+
+	if err := oc.Registry.UpdateNetNamespace(namespaceName, newNetID); err != nil {
+		if allocated {
+			if e := oc.netIDManager.ReleaseNetID(newNetID); e != nil {
+				log.Errorf("Error while releasing Net ID: %v", e)
+			}
+		}
+		return err
+	}
+	oc.VNIDMap[namespaceName] = newNetID
+
+	// release the old network id once no namespace uses it
+	if oldNetID != newNetID && oldNetID != AdminVNID && !oc.isVNIDShared(namespaceName, oldNetID) {
+		if err := oc.netIDManager.ReleaseNetID(oldNetID); err != nil {
+			return fmt.Errorf("Error while releasing Net ID: %v", err)
+		}
+	}
+	return nil
+}
+
 func watchNamespaces(oc *OvsController, ready chan<- bool, start <-chan string) {
 	nsevent := make(chan *api.NamespaceEvent)
 	stop := make(chan bool)
@@ -150,20 +234,20 @@ func watchNamespaces(oc *OvsController, ready chan<- bool, start <-chan string)
 	for {
 		select {
 		case ev := <-nsevent:
+			oc.vnidLock.Lock()
 			switch ev.Type {
 			case api.Added:
 				err := oc.assignVNID(ev.Name)
 				if err != nil {
 					log.Errorf("Error assigning Net ID: %v", err)
-					continue
 				}
 			case api.Deleted:
 				err := oc.revokeVNID(ev.Name)
 				if err != nil {
 					log.Errorf("Error revoking Net ID: %v", err)
-					continue
 				}
 			}
+			oc.vnidLock.Unlock()
 		case <-oc.sig:
 			log.Error("Signal received. Stopping watching of nodes.")
 			stop <- true
@@ -172,6 +256,30 @@ func watchNamespaces(oc *OvsController, ready chan<- bool, start <-chan string)
 	}
 }
 
+// watchNetNamespaceChanges applies the pod network changes that are requested through the
+// ChangePodNetworkAnnotation of NetNamespaces
+func watchNetNamespaceChanges(oc *OvsController, ready chan<- bool, start <-chan string) {
+	stop := make(chan bool)
+	netNsEvent := make(chan *api.NetNamespaceEvent)
+	go oc.Registry.WatchNetNamespaces(netNsEvent, ready, start, stop)
+	for {
+		select {
+		case ev := <-netNsEvent:
+			if ev.Type != api.Added || len(ev.Action) == 0 {
+				continue
+			}
+			err := oc.changePodNetwork(ev.Name, originapi.PodNetworkAction(ev.Action), ev.ActionNamespace)
+			if err != nil {
+				log.Errorf("Error changing the pod network of namespace %s: %v", ev.Name, err)
+			}
+		case <-oc.sig:
+			log.Error("Signal received. Stopping watching of NetNamespace changes.")
+			stop <- true
+			return
+		}
+	}
+}
+
 func (oc *OvsController) VnidStartNode() error {
 	getNetNamespaces := func(registry *Registry) (interface{}, string, error) {
 		return registry.GetNetNamespaces()
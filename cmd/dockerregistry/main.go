@@ -14,6 +14,27 @@ func main() {
 	runtime.GOMAXPROCS(runtime.NumCPU())
 	flag.Parse()
 
+	switch flag.Arg(0) {
+	case "report-storage":
+		if flag.NArg() != 2 {
+			fmt.Println("usage: dockerregistry report-storage CONFIG")
+			os.Exit(1)
+		}
+		if err := dockerregistry.ReportStorage(openConfig(flag.Arg(1)), os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	case "migrate-storage":
+		if flag.NArg() != 3 {
+			fmt.Println("usage: dockerregistry migrate-storage FROM_CONFIG TO_CONFIG")
+			os.Exit(1)
+		}
+		if err := dockerregistry.MigrateStorage(openConfig(flag.Arg(1)), openConfig(flag.Arg(2)), os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	// TODO convert to flags instead of a config file?
 	configurationPath := ""
 	if flag.NArg() > 0 {
@@ -30,10 +51,13 @@ func main() {
 	// Prevent a warning about unrecognized environment variable
 	os.Unsetenv("REGISTRY_CONFIGURATION_PATH")
 
-	configFile, err := os.Open(configurationPath)
+	dockerregistry.Execute(openConfig(configurationPath))
+}
+
+func openConfig(path string) *os.File {
+	configFile, err := os.Open(path)
 	if err != nil {
 		log.Fatalf("Unable to open configuration file: %s", err)
 	}
-
-	dockerregistry.Execute(configFile)
+	return configFile
 }
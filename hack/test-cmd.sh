@@ -140,6 +140,12 @@ openshift start \
 # validate config that was generated
 os::cmd::expect_success_and_text "openshift ex validate master-config ${MASTER_CONFIG_DIR}/master-config.yaml" 'SUCCESS'
 os::cmd::expect_success_and_text "openshift ex validate node-config ${NODE_CONFIG_DIR}/node-config.yaml" 'SUCCESS'
+# certificates that expire soon are warnings, and the master is not running yet
+os::cmd::expect_success_and_text "openshift ex validate master-config ${MASTER_CONFIG_DIR}/master-config.yaml --cert-expiry-warning=1000000h" 'expires at'
+os::cmd::expect_failure_and_text "openshift ex validate node-config ${NODE_CONFIG_DIR}/node-config.yaml --check-urls --timeout=1s" 'could not connect'
+# the expiration of the generated certificates can be listed
+os::cmd::expect_success_and_text "oadm ca check-expiry --cert-dir=${MASTER_CONFIG_DIR}" "openshift-router.crt"
+os::cmd::expect_success_and_text "oadm ca check-expiry --cert-dir=${MASTER_CONFIG_DIR} --expiry-warning=1000000h" "ExpiresSoon"
 # breaking the config fails the validation check
 cp ${MASTER_CONFIG_DIR}/master-config.yaml ${BASETMPDIR}/master-config-broken.yaml
 os::util::sed '7,12d' ${BASETMPDIR}/master-config-broken.yaml
@@ -227,6 +233,12 @@ os::cmd::expect_success "oc login ${KUBERNETES_MASTER} --insecure-skip-tls-verif
 temp_token=$(oc config view -o template --template='{{range .users}}{{ index .user.token }}{{end}}')
 os::cmd::expect_success_and_text "oc login --token=${temp_token}" 'using the token provided'
 os::cmd::expect_success 'oc logout'
+# logs in with a client certificate, saving the cluster and context under the given names
+os::cmd::expect_failure_and_text "oc login ${KUBERNETES_MASTER} --client-certificate='${MASTER_CONFIG_DIR}/admin.crt'" 'must be specified together'
+os::cmd::expect_failure_and_text "oc login ${KUBERNETES_MASTER} --certificate-authority='${MASTER_CONFIG_DIR}/admin.key'" 'not valid'
+os::cmd::expect_success_and_text "oc login ${KUBERNETES_MASTER} --config='${BASETMPDIR}/cert.kubeconfig' --certificate-authority='${MASTER_CONFIG_DIR}/ca.crt' --client-certificate='${MASTER_CONFIG_DIR}/admin.crt' --client-key='${MASTER_CONFIG_DIR}/admin.key' --cluster-name=local --context-name=admin" 'using the client certificate provided'
+os::cmd::expect_success_and_text "oc config view --config='${BASETMPDIR}/cert.kubeconfig'" 'current-context: admin'
+os::cmd::expect_success_and_text "oc whoami --config='${BASETMPDIR}/cert.kubeconfig'" 'system:admin'
 # properly parse server port
 os::cmd::expect_failure_and_text 'oc login https://server1:844333' 'Not a valid port'
 # properly handle trailing slash
@@ -256,6 +268,8 @@ os::cmd::expect_success_and_text 'oc whoami' 'test-user'
 os::cmd::expect_success_and_text "oc whoami --config='${MASTER_CONFIG_DIR}/admin.kubeconfig'" 'system:admin'
 os::cmd::expect_success_and_text 'oc whoami -t' '.'
 os::cmd::expect_success_and_text 'oc whoami -c' '.'
+os::cmd::expect_success_and_text 'oc api-versions' 'extensions/v1beta1'
+os::cmd::expect_success_and_text 'oc api-versions' '^v1$'
 
 # test config files from the --config flag
 os::cmd::expect_success "oc get services --config='${MASTER_CONFIG_DIR}/admin.kubeconfig'"
@@ -7,9 +7,12 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+	securityapi "github.com/openshift/origin/pkg/security/api"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 	userapi "github.com/openshift/origin/pkg/user/api"
 	pkgapi "k8s.io/kubernetes/pkg/api"
@@ -30,6 +33,8 @@ func deepCopy_api_AuthorizationAttributes(in api.AuthorizationAttributes, out *a
 	} else {
 		out.Content = newVal.(runtime.EmbeddedObject)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -169,6 +174,28 @@ func deepCopy_api_ClusterRole(in api.ClusterRole, out *api.ClusterRole, c *conve
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapi.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_api_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -251,6 +278,26 @@ func deepCopy_api_ClusterRoleList(in api.ClusterRoleList, out *api.ClusterRoleLi
 	return nil
 }
 
+func deepCopy_api_GroupRestriction(in api.GroupRestriction, out *api.GroupRestriction, c *conversion.Cloner) error {
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
 func deepCopy_api_IsPersonalSubjectAccessReview(in api.IsPersonalSubjectAccessReview, out *api.IsPersonalSubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -294,6 +341,14 @@ func deepCopy_api_LocalSubjectAccessReview(in api.LocalSubjectAccessReview, out
 	} else {
 		out.Groups = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -520,6 +575,7 @@ func deepCopy_api_ResourceAccessReviewResponse(in api.ResourceAccessReviewRespon
 	} else {
 		out.Groups = nil
 	}
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -544,6 +600,28 @@ func deepCopy_api_Role(in api.Role, out *api.Role, c *conversion.Cloner) error {
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapi.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_api_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -602,6 +680,75 @@ func deepCopy_api_RoleBindingList(in api.RoleBindingList, out *api.RoleBindingLi
 	return nil
 }
 
+func deepCopy_api_RoleBindingRestriction(in api.RoleBindingRestriction, out *api.RoleBindingRestriction, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_RoleBindingRestrictionSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_RoleBindingRestrictionList(in api.RoleBindingRestrictionList, out *api.RoleBindingRestrictionList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]api.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_RoleBindingRestriction(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_RoleBindingRestrictionSpec(in api.RoleBindingRestrictionSpec, out *api.RoleBindingRestrictionSpec, c *conversion.Cloner) error {
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(api.UserRestriction)
+		if err := deepCopy_api_UserRestriction(*in.UserRestriction, out.UserRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(api.GroupRestriction)
+		if err := deepCopy_api_GroupRestriction(*in.GroupRestriction, out.GroupRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(api.ServiceAccountRestriction)
+		if err := deepCopy_api_ServiceAccountRestriction(*in.ServiceAccountRestriction, out.ServiceAccountRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
 func deepCopy_api_RoleList(in api.RoleList, out *api.RoleList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -626,6 +773,61 @@ func deepCopy_api_RoleList(in api.RoleList, out *api.RoleList, c *conversion.Clo
 	return nil
 }
 
+func deepCopy_api_SelfSubjectRulesReview(in api.SelfSubjectRulesReview, out *api.SelfSubjectRulesReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_api_SelfSubjectRulesReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_SubjectRulesReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_SelfSubjectRulesReviewSpec(in api.SelfSubjectRulesReviewSpec, out *api.SelfSubjectRulesReviewSpec, c *conversion.Cloner) error {
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ServiceAccountReference(in api.ServiceAccountReference, out *api.ServiceAccountReference, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func deepCopy_api_ServiceAccountRestriction(in api.ServiceAccountRestriction, out *api.ServiceAccountRestriction, c *conversion.Cloner) error {
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]api.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := deepCopy_api_ServiceAccountReference(in.ServiceAccounts[i], &out.ServiceAccounts[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
 func deepCopy_api_SubjectAccessReview(in api.SubjectAccessReview, out *api.SubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -648,6 +850,14 @@ func deepCopy_api_SubjectAccessReview(in api.SubjectAccessReview, out *api.Subje
 	} else {
 		out.Groups = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -660,6 +870,60 @@ func deepCopy_api_SubjectAccessReviewResponse(in api.SubjectAccessReviewResponse
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_api_SubjectRulesReviewStatus(in api.SubjectRulesReviewStatus, out *api.SubjectRulesReviewStatus, c *conversion.Cloner) error {
+	if in.Rules != nil {
+		out.Rules = make([]api.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := deepCopy_api_PolicyRule(in.Rules[i], &out.Rules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_api_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_api_UserRestriction(in api.UserRestriction, out *api.UserRestriction, c *conversion.Cloner) error {
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
 	return nil
 }
 
@@ -686,6 +950,7 @@ func deepCopy_api_BinaryBuildRequestOptions(in buildapi.BinaryBuildRequestOption
 
 func deepCopy_api_BinaryBuildSource(in buildapi.BinaryBuildSource, out *buildapi.BinaryBuildSource, c *conversion.Cloner) error {
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -772,6 +1037,40 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 
 func deepCopy_api_BuildConfigStatus(in buildapi.BuildConfigStatus, out *buildapi.BuildConfigStatus, c *conversion.Cloner) error {
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]buildapi.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := deepCopy_api_WebHookTriggerURL(in.WebHookURLs[i], &out.WebHookURLs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildHistory(in buildapi.BuildHistory, out *buildapi.BuildHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_BuildSummary(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -845,6 +1144,12 @@ func deepCopy_api_BuildLogOptions(in buildapi.BuildLogOptions, out *buildapi.Bui
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -940,6 +1245,7 @@ func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequ
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1066,6 +1372,7 @@ func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1097,6 +1404,31 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	return nil
 }
 
+func deepCopy_api_BuildSummary(in buildapi.BuildSummary, out *buildapi.BuildSummary, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
 func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *buildapi.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -1349,17 +1681,48 @@ func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHo
 	return nil
 }
 
-func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]pkgapi.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
-				return err
-			} else {
-				out.Environment[i] = newVal.(pkgapi.EnvVar)
-			}
-		}
+func deepCopy_api_WebHookTriggerURL(in buildapi.WebHookTriggerURL, out *buildapi.WebHookTriggerURL, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_api_CanaryCheck(in deployapi.CanaryCheck, out *deployapi.CanaryCheck, c *conversion.Cloner) error {
+	if in.ExecNewPod != nil {
+		out.ExecNewPod = new(deployapi.ExecNewPodHook)
+		if err := deepCopy_api_ExecNewPodHook(*in.ExecNewPod, out.ExecNewPod, c); err != nil {
+			return err
+		}
+	} else {
+		out.ExecNewPod = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.CanaryHTTPGetAction)
+		if err := deepCopy_api_CanaryHTTPGetAction(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryHTTPGetAction(in deployapi.CanaryHTTPGetAction, out *deployapi.CanaryHTTPGetAction, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]pkgapi.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+				return err
+			} else {
+				out.Environment[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
 	} else {
 		out.Environment = nil
 	}
@@ -1578,6 +1941,12 @@ func deepCopy_api_DeploymentLogOptions(in deployapi.DeploymentLogOptions, out *d
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -1717,6 +2086,12 @@ func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.Lifec
 }
 
 func deepCopy_api_RecreateDeploymentStrategyParams(in deployapi.RecreateDeploymentStrategyParams, out *deployapi.RecreateDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1725,6 +2100,14 @@ func deepCopy_api_RecreateDeploymentStrategyParams(in deployapi.RecreateDeployme
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1787,6 +2170,14 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		out.Canary = new(deployapi.CanaryCheck)
+		if err := deepCopy_api_CanaryCheck(*in.Canary, out.Canary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -2384,6 +2775,7 @@ func deepCopy_api_TagReference(in imageapi.TagReference, out *imageapi.TagRefere
 	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	out.TrackVersion = in.TrackVersion
 	return nil
 }
 
@@ -2504,6 +2896,14 @@ func deepCopy_api_OAuthClient(in oauthapi.OAuthClient, out *oauthapi.OAuthClient
 		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -2513,6 +2913,18 @@ func deepCopy_api_OAuthClient(in oauthapi.OAuthClient, out *oauthapi.OAuthClient
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -2589,6 +3001,119 @@ func deepCopy_api_OAuthClientList(in oauthapi.OAuthClientList, out *oauthapi.OAu
 	return nil
 }
 
+func deepCopy_api_ServiceAccountTokenRequest(in oauthapi.ServiceAccountTokenRequest, out *oauthapi.ServiceAccountTokenRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func deepCopy_api_PodPreset(in podpresetapi.PodPreset, out *podpresetapi.PodPreset, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_PodPresetSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_PodPresetList(in podpresetapi.PodPresetList, out *podpresetapi.PodPresetList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]podpresetapi.PodPreset, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_PodPreset(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodPresetSpec(in podpresetapi.PodPresetSpec, out *podpresetapi.PodPresetSpec, c *conversion.Cloner) error {
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if in.Volumes != nil {
+		out.Volumes = make([]pkgapi.Volume, len(in.Volumes))
+		for i := range in.Volumes {
+			if newVal, err := c.DeepCopy(in.Volumes[i]); err != nil {
+				return err
+			} else {
+				out.Volumes[i] = newVal.(pkgapi.Volume)
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
+	if in.VolumeMounts != nil {
+		out.VolumeMounts = make([]pkgapi.VolumeMount, len(in.VolumeMounts))
+		for i := range in.VolumeMounts {
+			if newVal, err := c.DeepCopy(in.VolumeMounts[i]); err != nil {
+				return err
+			} else {
+				out.VolumeMounts[i] = newVal.(pkgapi.VolumeMount)
+			}
+		}
+	} else {
+		out.VolumeMounts = nil
+	}
+	return nil
+}
+
 func deepCopy_api_Project(in projectapi.Project, out *projectapi.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2649,6 +3174,13 @@ func deepCopy_api_ProjectRequest(in projectapi.ProjectRequest, out *projectapi.P
 	return nil
 }
 
+func deepCopy_api_ProjectSecurityAllocation(in projectapi.ProjectSecurityAllocation, out *projectapi.ProjectSecurityAllocation, c *conversion.Cloner) error {
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
 func deepCopy_api_ProjectSpec(in projectapi.ProjectSpec, out *projectapi.ProjectSpec, c *conversion.Cloner) error {
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapi.FinalizerName, len(in.Finalizers))
@@ -2663,6 +3195,119 @@ func deepCopy_api_ProjectSpec(in projectapi.ProjectSpec, out *projectapi.Project
 
 func deepCopy_api_ProjectStatus(in projectapi.ProjectStatus, out *projectapi.ProjectStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapi.ProjectSecurityAllocation)
+		if err := deepCopy_api_ProjectSecurityAllocation(*in.SecurityAllocation, out.SecurityAllocation, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterResourceQuota(in quotaapi.ClusterResourceQuota, out *quotaapi.ClusterResourceQuota, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_ClusterResourceQuotaSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ClusterResourceQuotaStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterResourceQuotaList(in quotaapi.ClusterResourceQuotaList, out *quotaapi.ClusterResourceQuotaList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]quotaapi.ClusterResourceQuota, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_ClusterResourceQuota(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterResourceQuotaSelector(in quotaapi.ClusterResourceQuotaSelector, out *quotaapi.ClusterResourceQuotaSelector, c *conversion.Cloner) error {
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.AnnotationSelector != nil {
+		out.AnnotationSelector = make(map[string]string)
+		for key, val := range in.AnnotationSelector {
+			out.AnnotationSelector[key] = val
+		}
+	} else {
+		out.AnnotationSelector = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterResourceQuotaSpec(in quotaapi.ClusterResourceQuotaSpec, out *quotaapi.ClusterResourceQuotaSpec, c *conversion.Cloner) error {
+	if err := deepCopy_api_ClusterResourceQuotaSelector(in.Selector, &out.Selector, c); err != nil {
+		return err
+	}
+	if newVal, err := c.DeepCopy(in.Quota); err != nil {
+		return err
+	} else {
+		out.Quota = newVal.(pkgapi.ResourceQuotaSpec)
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterResourceQuotaStatus(in quotaapi.ClusterResourceQuotaStatus, out *quotaapi.ClusterResourceQuotaStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Total); err != nil {
+		return err
+	} else {
+		out.Total = newVal.(pkgapi.ResourceQuotaStatus)
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]quotaapi.ResourceQuotaStatusByNamespace, len(in.Namespaces))
+		for i := range in.Namespaces {
+			if err := deepCopy_api_ResourceQuotaStatusByNamespace(in.Namespaces[i], &out.Namespaces[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ResourceQuotaStatusByNamespace(in quotaapi.ResourceQuotaStatusByNamespace, out *quotaapi.ResourceQuotaStatusByNamespace, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(pkgapi.ResourceQuotaStatus)
+	}
 	return nil
 }
 
@@ -2755,6 +3400,7 @@ func deepCopy_api_TLSConfig(in routeapi.TLSConfig, out *routeapi.TLSConfig, c *c
 	out.Certificate = in.Certificate
 	out.Key = in.Key
 	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
 	out.DestinationCACertificate = in.DestinationCACertificate
 	out.InsecureEdgeTerminationPolicy = in.InsecureEdgeTerminationPolicy
 	return nil
@@ -2774,6 +3420,22 @@ func deepCopy_api_ClusterNetwork(in sdnapi.ClusterNetwork, out *sdnapi.ClusterNe
 	out.Network = in.Network
 	out.HostSubnetLength = in.HostSubnetLength
 	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapi.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := deepCopy_api_ClusterNetworkEntry(in.ClusterNetworks[i], &out.ClusterNetworks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ClusterNetworkEntry(in sdnapi.ClusterNetworkEntry, out *sdnapi.ClusterNetworkEntry, c *conversion.Cloner) error {
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
 	return nil
 }
 
@@ -2882,6 +3544,112 @@ func deepCopy_api_NetNamespaceList(in sdnapi.NetNamespaceList, out *sdnapi.NetNa
 	return nil
 }
 
+func deepCopy_api_PodSecurityPolicyReview(in securityapi.PodSecurityPolicyReview, out *securityapi.PodSecurityPolicyReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_api_PodSecurityPolicyReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_PodSecurityPolicyReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_PodSecurityPolicyReviewSpec(in securityapi.PodSecurityPolicyReviewSpec, out *securityapi.PodSecurityPolicyReviewSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapi.PodTemplateSpec)
+	}
+	if in.ServiceAccountNames != nil {
+		out.ServiceAccountNames = make([]string, len(in.ServiceAccountNames))
+		for i := range in.ServiceAccountNames {
+			out.ServiceAccountNames[i] = in.ServiceAccountNames[i]
+		}
+	} else {
+		out.ServiceAccountNames = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodSecurityPolicyReviewStatus(in securityapi.PodSecurityPolicyReviewStatus, out *securityapi.PodSecurityPolicyReviewStatus, c *conversion.Cloner) error {
+	if in.AllowedServiceAccounts != nil {
+		out.AllowedServiceAccounts = make([]securityapi.ServiceAccountPodSecurityPolicyReviewStatus, len(in.AllowedServiceAccounts))
+		for i := range in.AllowedServiceAccounts {
+			if err := deepCopy_api_ServiceAccountPodSecurityPolicyReviewStatus(in.AllowedServiceAccounts[i], &out.AllowedServiceAccounts[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllowedServiceAccounts = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodSecurityPolicySubjectReview(in securityapi.PodSecurityPolicySubjectReview, out *securityapi.PodSecurityPolicySubjectReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_api_PodSecurityPolicySubjectReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_PodSecurityPolicySubjectReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_PodSecurityPolicySubjectReviewSpec(in securityapi.PodSecurityPolicySubjectReviewSpec, out *securityapi.PodSecurityPolicySubjectReviewSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapi.PodTemplateSpec)
+	}
+	out.User = in.User
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PodSecurityPolicySubjectReviewStatus(in securityapi.PodSecurityPolicySubjectReviewStatus, out *securityapi.PodSecurityPolicySubjectReviewStatus, c *conversion.Cloner) error {
+	if in.AllowedBy != nil {
+		if newVal, err := c.DeepCopy(in.AllowedBy); err != nil {
+			return err
+		} else {
+			out.AllowedBy = newVal.(*pkgapi.ObjectReference)
+		}
+	} else {
+		out.AllowedBy = nil
+	}
+	out.Reason = in.Reason
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapi.PodTemplateSpec)
+	}
+	return nil
+}
+
+func deepCopy_api_ServiceAccountPodSecurityPolicyReviewStatus(in securityapi.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapi.ServiceAccountPodSecurityPolicyReviewStatus, c *conversion.Cloner) error {
+	if err := deepCopy_api_PodSecurityPolicySubjectReviewStatus(in.PodSecurityPolicySubjectReviewStatus, &out.PodSecurityPolicySubjectReviewStatus, c); err != nil {
+		return err
+	}
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_api_Parameter(in templateapi.Parameter, out *templateapi.Parameter, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.DisplayName = in.DisplayName
@@ -2890,6 +3658,32 @@ func deepCopy_api_Parameter(in templateapi.Parameter, out *templateapi.Parameter
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapi.ParameterSource)
+		if err := deepCopy_api_ParameterSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ParameterSource(in templateapi.ParameterSource, out *templateapi.ParameterSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapi.SecretKeySelector)
+		if err := deepCopy_api_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func deepCopy_api_SecretKeySelector(in templateapi.SecretKeySelector, out *templateapi.SecretKeySelector, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Key = in.Key
 	return nil
 }
 
@@ -3152,6 +3946,7 @@ func init() {
 		deepCopy_api_ClusterRoleBinding,
 		deepCopy_api_ClusterRoleBindingList,
 		deepCopy_api_ClusterRoleList,
+		deepCopy_api_GroupRestriction,
 		deepCopy_api_IsPersonalSubjectAccessReview,
 		deepCopy_api_LocalResourceAccessReview,
 		deepCopy_api_LocalSubjectAccessReview,
@@ -3165,9 +3960,18 @@ func init() {
 		deepCopy_api_Role,
 		deepCopy_api_RoleBinding,
 		deepCopy_api_RoleBindingList,
+		deepCopy_api_RoleBindingRestriction,
+		deepCopy_api_RoleBindingRestrictionList,
+		deepCopy_api_RoleBindingRestrictionSpec,
 		deepCopy_api_RoleList,
+		deepCopy_api_SelfSubjectRulesReview,
+		deepCopy_api_SelfSubjectRulesReviewSpec,
+		deepCopy_api_ServiceAccountReference,
+		deepCopy_api_ServiceAccountRestriction,
 		deepCopy_api_SubjectAccessReview,
 		deepCopy_api_SubjectAccessReviewResponse,
+		deepCopy_api_SubjectRulesReviewStatus,
+		deepCopy_api_UserRestriction,
 		deepCopy_api_BinaryBuildRequestOptions,
 		deepCopy_api_BinaryBuildSource,
 		deepCopy_api_Build,
@@ -3175,6 +3979,7 @@ func init() {
 		deepCopy_api_BuildConfigList,
 		deepCopy_api_BuildConfigSpec,
 		deepCopy_api_BuildConfigStatus,
+		deepCopy_api_BuildHistory,
 		deepCopy_api_BuildList,
 		deepCopy_api_BuildLog,
 		deepCopy_api_BuildLogOptions,
@@ -3184,6 +3989,7 @@ func init() {
 		deepCopy_api_BuildSpec,
 		deepCopy_api_BuildStatus,
 		deepCopy_api_BuildStrategy,
+		deepCopy_api_BuildSummary,
 		deepCopy_api_BuildTriggerPolicy,
 		deepCopy_api_CustomBuildStrategy,
 		deepCopy_api_DockerBuildStrategy,
@@ -3198,6 +4004,9 @@ func init() {
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_WebHookTriggerURL,
+		deepCopy_api_CanaryCheck,
+		deepCopy_api_CanaryHTTPGetAction,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
@@ -3250,11 +4059,22 @@ func init() {
 		deepCopy_api_OAuthClientAuthorization,
 		deepCopy_api_OAuthClientAuthorizationList,
 		deepCopy_api_OAuthClientList,
+		deepCopy_api_ServiceAccountTokenRequest,
+		deepCopy_api_PodPreset,
+		deepCopy_api_PodPresetList,
+		deepCopy_api_PodPresetSpec,
 		deepCopy_api_Project,
 		deepCopy_api_ProjectList,
 		deepCopy_api_ProjectRequest,
+		deepCopy_api_ProjectSecurityAllocation,
 		deepCopy_api_ProjectSpec,
 		deepCopy_api_ProjectStatus,
+		deepCopy_api_ClusterResourceQuota,
+		deepCopy_api_ClusterResourceQuotaList,
+		deepCopy_api_ClusterResourceQuotaSelector,
+		deepCopy_api_ClusterResourceQuotaSpec,
+		deepCopy_api_ClusterResourceQuotaStatus,
+		deepCopy_api_ResourceQuotaStatusByNamespace,
 		deepCopy_api_Route,
 		deepCopy_api_RouteList,
 		deepCopy_api_RoutePort,
@@ -3262,12 +4082,22 @@ func init() {
 		deepCopy_api_RouteStatus,
 		deepCopy_api_TLSConfig,
 		deepCopy_api_ClusterNetwork,
+		deepCopy_api_ClusterNetworkEntry,
 		deepCopy_api_ClusterNetworkList,
 		deepCopy_api_HostSubnet,
 		deepCopy_api_HostSubnetList,
 		deepCopy_api_NetNamespace,
 		deepCopy_api_NetNamespaceList,
+		deepCopy_api_PodSecurityPolicyReview,
+		deepCopy_api_PodSecurityPolicyReviewSpec,
+		deepCopy_api_PodSecurityPolicyReviewStatus,
+		deepCopy_api_PodSecurityPolicySubjectReview,
+		deepCopy_api_PodSecurityPolicySubjectReviewSpec,
+		deepCopy_api_PodSecurityPolicySubjectReviewStatus,
+		deepCopy_api_ServiceAccountPodSecurityPolicyReviewStatus,
 		deepCopy_api_Parameter,
+		deepCopy_api_ParameterSource,
+		deepCopy_api_SecretKeySelector,
 		deepCopy_api_Template,
 		deepCopy_api_TemplateList,
 		deepCopy_api_Group,
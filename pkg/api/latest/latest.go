@@ -148,6 +148,8 @@ func init() {
 		"ClusterNetwork": true,
 		"HostSubnet":     true,
 		"NetNamespace":   true,
+
+		"ClusterResourceQuota": true,
 	}
 
 	// enumerate all supported versions, get the kinds, and register with the mapper how to address our resources
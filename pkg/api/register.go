@@ -10,9 +10,12 @@ import (
 	_ "github.com/openshift/origin/pkg/deploy/api"
 	_ "github.com/openshift/origin/pkg/image/api"
 	_ "github.com/openshift/origin/pkg/oauth/api"
+	_ "github.com/openshift/origin/pkg/podpreset/api"
 	_ "github.com/openshift/origin/pkg/project/api"
+	_ "github.com/openshift/origin/pkg/quota/api"
 	_ "github.com/openshift/origin/pkg/route/api"
 	_ "github.com/openshift/origin/pkg/sdn/api"
+	_ "github.com/openshift/origin/pkg/security/api"
 	_ "github.com/openshift/origin/pkg/template/api"
 	_ "github.com/openshift/origin/pkg/user/api"
 )
@@ -280,10 +280,23 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 					params.MaxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 				}
 				j.RollingParams = params
+			case deploy.DeploymentStrategyTypeRecreate:
+				j.RollingParams = nil
+				if j.RecreateParams == nil {
+					j.RecreateParams = &deploy.RecreateDeploymentStrategyParams{}
+					c.Fuzz(j.RecreateParams)
+				}
 			default:
 				j.RollingParams = nil
 			}
 		},
+		func(j *deploy.RecreateDeploymentStrategyParams, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			if j.TimeoutSeconds == nil {
+				s := int64(120)
+				j.TimeoutSeconds = &s
+			}
+		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			specs := []string{"", "a/b", "a/b/c", "a:5000/b/c", "a/b", "a/b"}
@@ -12,12 +12,18 @@ import (
 	imageapiv1 "github.com/openshift/origin/pkg/image/api/v1"
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	oauthapiv1 "github.com/openshift/origin/pkg/oauth/api/v1"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+	podpresetapiv1 "github.com/openshift/origin/pkg/podpreset/api/v1"
 	projectapi "github.com/openshift/origin/pkg/project/api"
 	projectapiv1 "github.com/openshift/origin/pkg/project/api/v1"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	quotaapiv1 "github.com/openshift/origin/pkg/quota/api/v1"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	routeapiv1 "github.com/openshift/origin/pkg/route/api/v1"
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 	sdnapiv1 "github.com/openshift/origin/pkg/sdn/api/v1"
+	securityapi "github.com/openshift/origin/pkg/security/api"
+	securityapiv1 "github.com/openshift/origin/pkg/security/api/v1"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 	templateapiv1 "github.com/openshift/origin/pkg/template/api/v1"
 	userapi "github.com/openshift/origin/pkg/user/api"
@@ -144,6 +150,26 @@ func autoconvert_api_ClusterRole_To_v1_ClusterRole(in *api.ClusterRole, out *v1.
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -231,6 +257,33 @@ func convert_api_ClusterRoleList_To_v1_ClusterRoleList(in *api.ClusterRoleList,
 	return autoconvert_api_ClusterRoleList_To_v1_ClusterRoleList(in, out, s)
 }
 
+func autoconvert_api_GroupRestriction_To_v1_GroupRestriction(in *api.GroupRestriction, out *v1.GroupRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.GroupRestriction))(in)
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_api_GroupRestriction_To_v1_GroupRestriction(in *api.GroupRestriction, out *v1.GroupRestriction, s conversion.Scope) error {
+	return autoconvert_api_GroupRestriction_To_v1_GroupRestriction(in, out, s)
+}
+
 func autoconvert_api_IsPersonalSubjectAccessReview_To_v1_IsPersonalSubjectAccessReview(in *api.IsPersonalSubjectAccessReview, out *v1.IsPersonalSubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.IsPersonalSubjectAccessReview))(in)
@@ -266,6 +319,14 @@ func autoconvert_api_LocalSubjectAccessReview_To_v1_LocalSubjectAccessReview(in
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -407,6 +468,7 @@ func autoconvert_api_ResourceAccessReviewResponse_To_v1_ResourceAccessReviewResp
 	out.Namespace = in.Namespace
 	// in.Users has no peer in out
 	// in.Groups has no peer in out
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -430,6 +492,26 @@ func autoconvert_api_Role_To_v1_Role(in *api.Role, out *v1.Role, s conversion.Sc
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -490,6 +572,88 @@ func convert_api_RoleBindingList_To_v1_RoleBindingList(in *api.RoleBindingList,
 	return autoconvert_api_RoleBindingList_To_v1_RoleBindingList(in, out, s)
 }
 
+func autoconvert_api_RoleBindingRestriction_To_v1_RoleBindingRestriction(in *api.RoleBindingRestriction, out *v1.RoleBindingRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestriction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_RoleBindingRestrictionSpec_To_v1_RoleBindingRestrictionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestriction_To_v1_RoleBindingRestriction(in *api.RoleBindingRestriction, out *v1.RoleBindingRestriction, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestriction_To_v1_RoleBindingRestriction(in, out, s)
+}
+
+func autoconvert_api_RoleBindingRestrictionList_To_v1_RoleBindingRestrictionList(in *api.RoleBindingRestrictionList, out *v1.RoleBindingRestrictionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestrictionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_RoleBindingRestriction_To_v1_RoleBindingRestriction(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestrictionList_To_v1_RoleBindingRestrictionList(in *api.RoleBindingRestrictionList, out *v1.RoleBindingRestrictionList, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestrictionList_To_v1_RoleBindingRestrictionList(in, out, s)
+}
+
+func autoconvert_api_RoleBindingRestrictionSpec_To_v1_RoleBindingRestrictionSpec(in *api.RoleBindingRestrictionSpec, out *v1.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestrictionSpec))(in)
+	}
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(v1.UserRestriction)
+		if err := convert_api_UserRestriction_To_v1_UserRestriction(in.UserRestriction, out.UserRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(v1.GroupRestriction)
+		if err := convert_api_GroupRestriction_To_v1_GroupRestriction(in.GroupRestriction, out.GroupRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(v1.ServiceAccountRestriction)
+		if err := convert_api_ServiceAccountRestriction_To_v1_ServiceAccountRestriction(in.ServiceAccountRestriction, out.ServiceAccountRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestrictionSpec_To_v1_RoleBindingRestrictionSpec(in *api.RoleBindingRestrictionSpec, out *v1.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestrictionSpec_To_v1_RoleBindingRestrictionSpec(in, out, s)
+}
+
 func autoconvert_api_RoleList_To_v1_RoleList(in *api.RoleList, out *v1.RoleList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.RoleList))(in)
@@ -517,6 +681,87 @@ func convert_api_RoleList_To_v1_RoleList(in *api.RoleList, out *v1.RoleList, s c
 	return autoconvert_api_RoleList_To_v1_RoleList(in, out, s)
 }
 
+func autoconvert_api_SelfSubjectRulesReview_To_v1_SelfSubjectRulesReview(in *api.SelfSubjectRulesReview, out *v1.SelfSubjectRulesReview, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SelfSubjectRulesReview))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_SelfSubjectRulesReviewSpec_To_v1_SelfSubjectRulesReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_SubjectRulesReviewStatus_To_v1_SubjectRulesReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_SelfSubjectRulesReview_To_v1_SelfSubjectRulesReview(in *api.SelfSubjectRulesReview, out *v1.SelfSubjectRulesReview, s conversion.Scope) error {
+	return autoconvert_api_SelfSubjectRulesReview_To_v1_SelfSubjectRulesReview(in, out, s)
+}
+
+func autoconvert_api_SelfSubjectRulesReviewSpec_To_v1_SelfSubjectRulesReviewSpec(in *api.SelfSubjectRulesReviewSpec, out *v1.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SelfSubjectRulesReviewSpec))(in)
+	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func convert_api_SelfSubjectRulesReviewSpec_To_v1_SelfSubjectRulesReviewSpec(in *api.SelfSubjectRulesReviewSpec, out *v1.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	return autoconvert_api_SelfSubjectRulesReviewSpec_To_v1_SelfSubjectRulesReviewSpec(in, out, s)
+}
+
+func autoconvert_api_ServiceAccountReference_To_v1_ServiceAccountReference(in *api.ServiceAccountReference, out *v1.ServiceAccountReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ServiceAccountReference))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_api_ServiceAccountReference_To_v1_ServiceAccountReference(in *api.ServiceAccountReference, out *v1.ServiceAccountReference, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountReference_To_v1_ServiceAccountReference(in, out, s)
+}
+
+func autoconvert_api_ServiceAccountRestriction_To_v1_ServiceAccountRestriction(in *api.ServiceAccountRestriction, out *v1.ServiceAccountRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ServiceAccountRestriction))(in)
+	}
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]v1.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := convert_api_ServiceAccountReference_To_v1_ServiceAccountReference(&in.ServiceAccounts[i], &out.ServiceAccounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_api_ServiceAccountRestriction_To_v1_ServiceAccountRestriction(in *api.ServiceAccountRestriction, out *v1.ServiceAccountRestriction, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountRestriction_To_v1_ServiceAccountRestriction(in, out, s)
+}
+
 func autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview(in *api.SubjectAccessReview, out *v1.SubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.SubjectAccessReview))(in)
@@ -527,6 +772,14 @@ func autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview(in *api.Subje
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -540,6 +793,7 @@ func autoconvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewRespon
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -547,6 +801,73 @@ func convert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse(i
 	return autoconvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse(in, out, s)
 }
 
+func autoconvert_api_SubjectRulesReviewStatus_To_v1_SubjectRulesReviewStatus(in *api.SubjectRulesReviewStatus, out *v1.SubjectRulesReviewStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SubjectRulesReviewStatus))(in)
+	}
+	if in.Rules != nil {
+		out.Rules = make([]v1.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := s.Convert(&in.Rules[i], &out.Rules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func convert_api_SubjectRulesReviewStatus_To_v1_SubjectRulesReviewStatus(in *api.SubjectRulesReviewStatus, out *v1.SubjectRulesReviewStatus, s conversion.Scope) error {
+	return autoconvert_api_SubjectRulesReviewStatus_To_v1_SubjectRulesReviewStatus(in, out, s)
+}
+
+func autoconvert_api_UserRestriction_To_v1_UserRestriction(in *api.UserRestriction, out *v1.UserRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.UserRestriction))(in)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_api_UserRestriction_To_v1_UserRestriction(in *api.UserRestriction, out *v1.UserRestriction, s conversion.Scope) error {
+	return autoconvert_api_UserRestriction_To_v1_UserRestriction(in, out, s)
+}
+
 func autoconvert_v1_ClusterPolicy_To_api_ClusterPolicy(in *v1.ClusterPolicy, out *api.ClusterPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.ClusterPolicy))(in)
@@ -662,6 +983,26 @@ func autoconvert_v1_ClusterRole_To_api_ClusterRole(in *v1.ClusterRole, out *api.
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -751,6 +1092,33 @@ func convert_v1_ClusterRoleList_To_api_ClusterRoleList(in *v1.ClusterRoleList, o
 	return autoconvert_v1_ClusterRoleList_To_api_ClusterRoleList(in, out, s)
 }
 
+func autoconvert_v1_GroupRestriction_To_api_GroupRestriction(in *v1.GroupRestriction, out *api.GroupRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.GroupRestriction))(in)
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_v1_GroupRestriction_To_api_GroupRestriction(in *v1.GroupRestriction, out *api.GroupRestriction, s conversion.Scope) error {
+	return autoconvert_v1_GroupRestriction_To_api_GroupRestriction(in, out, s)
+}
+
 func autoconvert_v1_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview(in *v1.IsPersonalSubjectAccessReview, out *api.IsPersonalSubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.IsPersonalSubjectAccessReview))(in)
@@ -786,9 +1154,17 @@ func autoconvert_v1_LocalSubjectAccessReview_To_api_LocalSubjectAccessReview(in
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
-	return nil
-}
-
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
 func autoconvert_v1_Policy_To_api_Policy(in *v1.Policy, out *api.Policy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.Policy))(in)
@@ -927,6 +1303,7 @@ func autoconvert_v1_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResp
 	out.Namespace = in.Namespace
 	// in.UsersSlice has no peer in out
 	// in.GroupsSlice has no peer in out
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -950,6 +1327,26 @@ func autoconvert_v1_Role_To_api_Role(in *v1.Role, out *api.Role, s conversion.Sc
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -1012,6 +1409,88 @@ func convert_v1_RoleBindingList_To_api_RoleBindingList(in *v1.RoleBindingList, o
 	return autoconvert_v1_RoleBindingList_To_api_RoleBindingList(in, out, s)
 }
 
+func autoconvert_v1_RoleBindingRestriction_To_api_RoleBindingRestriction(in *v1.RoleBindingRestriction, out *api.RoleBindingRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.RoleBindingRestriction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_RoleBindingRestriction_To_api_RoleBindingRestriction(in *v1.RoleBindingRestriction, out *api.RoleBindingRestriction, s conversion.Scope) error {
+	return autoconvert_v1_RoleBindingRestriction_To_api_RoleBindingRestriction(in, out, s)
+}
+
+func autoconvert_v1_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in *v1.RoleBindingRestrictionList, out *api.RoleBindingRestrictionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.RoleBindingRestrictionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_RoleBindingRestriction_To_api_RoleBindingRestriction(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in *v1.RoleBindingRestrictionList, out *api.RoleBindingRestrictionList, s conversion.Scope) error {
+	return autoconvert_v1_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in, out, s)
+}
+
+func autoconvert_v1_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in *v1.RoleBindingRestrictionSpec, out *api.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.RoleBindingRestrictionSpec))(in)
+	}
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(api.UserRestriction)
+		if err := convert_v1_UserRestriction_To_api_UserRestriction(in.UserRestriction, out.UserRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(api.GroupRestriction)
+		if err := convert_v1_GroupRestriction_To_api_GroupRestriction(in.GroupRestriction, out.GroupRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(api.ServiceAccountRestriction)
+		if err := convert_v1_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in.ServiceAccountRestriction, out.ServiceAccountRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
+func convert_v1_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in *v1.RoleBindingRestrictionSpec, out *api.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	return autoconvert_v1_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in, out, s)
+}
+
 func autoconvert_v1_RoleList_To_api_RoleList(in *v1.RoleList, out *api.RoleList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.RoleList))(in)
@@ -1039,6 +1518,87 @@ func convert_v1_RoleList_To_api_RoleList(in *v1.RoleList, out *api.RoleList, s c
 	return autoconvert_v1_RoleList_To_api_RoleList(in, out, s)
 }
 
+func autoconvert_v1_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in *v1.SelfSubjectRulesReview, out *api.SelfSubjectRulesReview, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.SelfSubjectRulesReview))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in *v1.SelfSubjectRulesReview, out *api.SelfSubjectRulesReview, s conversion.Scope) error {
+	return autoconvert_v1_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in, out, s)
+}
+
+func autoconvert_v1_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in *v1.SelfSubjectRulesReviewSpec, out *api.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.SelfSubjectRulesReviewSpec))(in)
+	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func convert_v1_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in *v1.SelfSubjectRulesReviewSpec, out *api.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	return autoconvert_v1_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in, out, s)
+}
+
+func autoconvert_v1_ServiceAccountReference_To_api_ServiceAccountReference(in *v1.ServiceAccountReference, out *api.ServiceAccountReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ServiceAccountReference))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_v1_ServiceAccountReference_To_api_ServiceAccountReference(in *v1.ServiceAccountReference, out *api.ServiceAccountReference, s conversion.Scope) error {
+	return autoconvert_v1_ServiceAccountReference_To_api_ServiceAccountReference(in, out, s)
+}
+
+func autoconvert_v1_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in *v1.ServiceAccountRestriction, out *api.ServiceAccountRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ServiceAccountRestriction))(in)
+	}
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]api.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := convert_v1_ServiceAccountReference_To_api_ServiceAccountReference(&in.ServiceAccounts[i], &out.ServiceAccounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_v1_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in *v1.ServiceAccountRestriction, out *api.ServiceAccountRestriction, s conversion.Scope) error {
+	return autoconvert_v1_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in, out, s)
+}
+
 func autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview(in *v1.SubjectAccessReview, out *api.SubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.SubjectAccessReview))(in)
@@ -1049,6 +1609,14 @@ func autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview(in *v1.Subjec
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1062,6 +1630,7 @@ func autoconvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewRespon
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -1069,6 +1638,73 @@ func convert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse(i
 	return autoconvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse(in, out, s)
 }
 
+func autoconvert_v1_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in *v1.SubjectRulesReviewStatus, out *api.SubjectRulesReviewStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.SubjectRulesReviewStatus))(in)
+	}
+	if in.Rules != nil {
+		out.Rules = make([]api.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := s.Convert(&in.Rules[i], &out.Rules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func convert_v1_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in *v1.SubjectRulesReviewStatus, out *api.SubjectRulesReviewStatus, s conversion.Scope) error {
+	return autoconvert_v1_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in, out, s)
+}
+
+func autoconvert_v1_UserRestriction_To_api_UserRestriction(in *v1.UserRestriction, out *api.UserRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.UserRestriction))(in)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_v1_UserRestriction_To_api_UserRestriction(in *v1.UserRestriction, out *api.UserRestriction, s conversion.Scope) error {
+	return autoconvert_v1_UserRestriction_To_api_UserRestriction(in, out, s)
+}
+
 func autoconvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions(in *buildapi.BinaryBuildRequestOptions, out *apiv1.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BinaryBuildRequestOptions))(in)
@@ -1098,6 +1734,7 @@ func autoconvert_api_BinaryBuildSource_To_v1_BinaryBuildSource(in *buildapi.Bina
 		defaulting.(func(*buildapi.BinaryBuildSource))(in)
 	}
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -1207,6 +1844,16 @@ func autoconvert_api_BuildConfigStatus_To_v1_BuildConfigStatus(in *buildapi.Buil
 		defaulting.(func(*buildapi.BuildConfigStatus))(in)
 	}
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]apiv1.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := convert_api_WebHookTriggerURL_To_v1_WebHookTriggerURL(&in.WebHookURLs[i], &out.WebHookURLs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
 	return nil
 }
 
@@ -1214,6 +1861,33 @@ func convert_api_BuildConfigStatus_To_v1_BuildConfigStatus(in *buildapi.BuildCon
 	return autoconvert_api_BuildConfigStatus_To_v1_BuildConfigStatus(in, out, s)
 }
 
+func autoconvert_api_BuildHistory_To_v1_BuildHistory(in *buildapi.BuildHistory, out *apiv1.BuildHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildHistory))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_BuildSummary_To_v1_BuildSummary(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_BuildHistory_To_v1_BuildHistory(in *buildapi.BuildHistory, out *apiv1.BuildHistory, s conversion.Scope) error {
+	return autoconvert_api_BuildHistory_To_v1_BuildHistory(in, out, s)
+}
+
 func autoconvert_api_BuildList_To_v1_BuildList(in *buildapi.BuildList, out *apiv1.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildList))(in)
@@ -1291,6 +1965,12 @@ func autoconvert_api_BuildLogOptions_To_v1_BuildLogOptions(in *buildapi.BuildLog
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -1385,6 +2065,7 @@ func autoconvert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest,
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1519,6 +2200,7 @@ func autoconvert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1554,6 +2236,34 @@ func autoconvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrateg
 	return nil
 }
 
+func autoconvert_api_BuildSummary_To_v1_BuildSummary(in *buildapi.BuildSummary, out *apiv1.BuildSummary, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildSummary))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
+func convert_api_BuildSummary_To_v1_BuildSummary(in *buildapi.BuildSummary, out *apiv1.BuildSummary, s conversion.Scope) error {
+	return autoconvert_api_BuildSummary_To_v1_BuildSummary(in, out, s)
+}
+
 func autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.BuildTriggerPolicy, out *apiv1.BuildTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildTriggerPolicy))(in)
@@ -1862,6 +2572,19 @@ func convert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger
 	return autoconvert_api_WebHookTrigger_To_v1_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_api_WebHookTriggerURL_To_v1_WebHookTriggerURL(in *buildapi.WebHookTriggerURL, out *apiv1.WebHookTriggerURL, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebHookTriggerURL))(in)
+	}
+	out.Type = apiv1.BuildTriggerType(in.Type)
+	out.URL = in.URL
+	return nil
+}
+
+func convert_api_WebHookTriggerURL_To_v1_WebHookTriggerURL(in *buildapi.WebHookTriggerURL, out *apiv1.WebHookTriggerURL, s conversion.Scope) error {
+	return autoconvert_api_WebHookTriggerURL_To_v1_WebHookTriggerURL(in, out, s)
+}
+
 func autoconvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions(in *apiv1.BinaryBuildRequestOptions, out *buildapi.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.BinaryBuildRequestOptions))(in)
@@ -1891,6 +2614,7 @@ func autoconvert_v1_BinaryBuildSource_To_api_BinaryBuildSource(in *apiv1.BinaryB
 		defaulting.(func(*apiv1.BinaryBuildSource))(in)
 	}
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -2000,6 +2724,16 @@ func autoconvert_v1_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1.BuildCo
 		defaulting.(func(*apiv1.BuildConfigStatus))(in)
 	}
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]buildapi.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := convert_v1_WebHookTriggerURL_To_api_WebHookTriggerURL(&in.WebHookURLs[i], &out.WebHookURLs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
 	return nil
 }
 
@@ -2007,7 +2741,34 @@ func convert_v1_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1.BuildConfig
 	return autoconvert_v1_BuildConfigStatus_To_api_BuildConfigStatus(in, out, s)
 }
 
-func autoconvert_v1_BuildList_To_api_BuildList(in *apiv1.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
+func autoconvert_v1_BuildHistory_To_api_BuildHistory(in *apiv1.BuildHistory, out *buildapi.BuildHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildHistory))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_BuildSummary_To_api_BuildSummary(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_BuildHistory_To_api_BuildHistory(in *apiv1.BuildHistory, out *buildapi.BuildHistory, s conversion.Scope) error {
+	return autoconvert_v1_BuildHistory_To_api_BuildHistory(in, out, s)
+}
+
+func autoconvert_v1_BuildList_To_api_BuildList(in *apiv1.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.BuildList))(in)
 	}
@@ -2084,6 +2845,12 @@ func autoconvert_v1_BuildLogOptions_To_api_BuildLogOptions(in *apiv1.BuildLogOpt
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -2178,6 +2945,7 @@ func autoconvert_v1_BuildRequest_To_api_BuildRequest(in *apiv1.BuildRequest, out
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -2313,6 +3081,7 @@ func autoconvert_v1_BuildStatus_To_api_BuildStatus(in *apiv1.BuildStatus, out *b
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -2349,6 +3118,34 @@ func autoconvert_v1_BuildStrategy_To_api_BuildStrategy(in *apiv1.BuildStrategy,
 	return nil
 }
 
+func autoconvert_v1_BuildSummary_To_api_BuildSummary(in *apiv1.BuildSummary, out *buildapi.BuildSummary, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.BuildSummary))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
+func convert_v1_BuildSummary_To_api_BuildSummary(in *apiv1.BuildSummary, out *buildapi.BuildSummary, s conversion.Scope) error {
+	return autoconvert_v1_BuildSummary_To_api_BuildSummary(in, out, s)
+}
+
 func autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1.BuildTriggerPolicy, out *buildapi.BuildTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.BuildTriggerPolicy))(in)
@@ -2658,6 +3455,19 @@ func convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *apiv1.WebHookTrigger, o
 	return autoconvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_v1_WebHookTriggerURL_To_api_WebHookTriggerURL(in *apiv1.WebHookTriggerURL, out *buildapi.WebHookTriggerURL, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.WebHookTriggerURL))(in)
+	}
+	out.Type = buildapi.BuildTriggerType(in.Type)
+	out.URL = in.URL
+	return nil
+}
+
+func convert_v1_WebHookTriggerURL_To_api_WebHookTriggerURL(in *apiv1.WebHookTriggerURL, out *buildapi.WebHookTriggerURL, s conversion.Scope) error {
+	return autoconvert_v1_WebHookTriggerURL_To_api_WebHookTriggerURL(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2940,6 +3750,12 @@ func autoconvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in *deploya
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -3109,6 +3925,12 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentSt
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.RecreateDeploymentStrategyParams))(in)
 	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3117,6 +3939,14 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentSt
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3182,6 +4012,13 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -3467,6 +4304,12 @@ func autoconvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deploya
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -3636,6 +4479,12 @@ func autoconvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentSt
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.RecreateDeploymentStrategyParams))(in)
 	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3644,6 +4493,14 @@ func autoconvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentSt
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3705,6 +4562,13 @@ func autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -4663,6 +5527,14 @@ func autoconvert_api_OAuthClient_To_v1_OAuthClient(in *oauthapi.OAuthClient, out
 		return err
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -4672,6 +5544,18 @@ func autoconvert_api_OAuthClient_To_v1_OAuthClient(in *oauthapi.OAuthClient, out
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -4761,6 +5645,33 @@ func convert_api_OAuthClientList_To_v1_OAuthClientList(in *oauthapi.OAuthClientL
 	return autoconvert_api_OAuthClientList_To_v1_OAuthClientList(in, out, s)
 }
 
+func autoconvert_api_ServiceAccountTokenRequest_To_v1_ServiceAccountTokenRequest(in *oauthapi.ServiceAccountTokenRequest, out *oauthapiv1.ServiceAccountTokenRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ServiceAccountTokenRequest))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func convert_api_ServiceAccountTokenRequest_To_v1_ServiceAccountTokenRequest(in *oauthapi.ServiceAccountTokenRequest, out *oauthapiv1.ServiceAccountTokenRequest, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountTokenRequest_To_v1_ServiceAccountTokenRequest(in, out, s)
+}
+
 func autoconvert_v1_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1.OAuthAccessToken, out *oauthapi.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapiv1.OAuthAccessToken))(in)
@@ -4889,6 +5800,14 @@ func autoconvert_v1_OAuthClient_To_api_OAuthClient(in *oauthapiv1.OAuthClient, o
 		return err
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -4898,6 +5817,18 @@ func autoconvert_v1_OAuthClient_To_api_OAuthClient(in *oauthapiv1.OAuthClient, o
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -4987,32 +5918,56 @@ func convert_v1_OAuthClientList_To_api_OAuthClientList(in *oauthapiv1.OAuthClien
 	return autoconvert_v1_OAuthClientList_To_api_OAuthClientList(in, out, s)
 }
 
-func autoconvert_api_Project_To_v1_Project(in *projectapi.Project, out *projectapiv1.Project, s conversion.Scope) error {
+func autoconvert_v1_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in *oauthapiv1.ServiceAccountTokenRequest, out *oauthapi.ServiceAccountTokenRequest, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapi.Project))(in)
+		defaulting.(func(*oauthapiv1.ServiceAccountTokenRequest))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_api_ProjectSpec_To_v1_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func convert_v1_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in *oauthapiv1.ServiceAccountTokenRequest, out *oauthapi.ServiceAccountTokenRequest, s conversion.Scope) error {
+	return autoconvert_v1_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in, out, s)
+}
+
+func autoconvert_api_PodPreset_To_v1_PodPreset(in *podpresetapi.PodPreset, out *podpresetapiv1.PodPreset, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*podpresetapi.PodPreset))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if err := convert_api_ProjectStatus_To_v1_ProjectStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_PodPresetSpec_To_v1_PodPresetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_api_Project_To_v1_Project(in *projectapi.Project, out *projectapiv1.Project, s conversion.Scope) error {
-	return autoconvert_api_Project_To_v1_Project(in, out, s)
+func convert_api_PodPreset_To_v1_PodPreset(in *podpresetapi.PodPreset, out *podpresetapiv1.PodPreset, s conversion.Scope) error {
+	return autoconvert_api_PodPreset_To_v1_PodPreset(in, out, s)
 }
 
-func autoconvert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, out *projectapiv1.ProjectList, s conversion.Scope) error {
+func autoconvert_api_PodPresetList_To_v1_PodPresetList(in *podpresetapi.PodPresetList, out *podpresetapiv1.PodPresetList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapi.ProjectList))(in)
+		defaulting.(func(*podpresetapi.PodPresetList))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5021,9 +5976,9 @@ func autoconvert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, o
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]projectapiv1.Project, len(in.Items))
+		out.Items = make([]podpresetapiv1.PodPreset, len(in.Items))
 		for i := range in.Items {
-			if err := convert_api_Project_To_v1_Project(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_api_PodPreset_To_v1_PodPreset(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -5033,63 +5988,62 @@ func autoconvert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, o
 	return nil
 }
 
-func convert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, out *projectapiv1.ProjectList, s conversion.Scope) error {
-	return autoconvert_api_ProjectList_To_v1_ProjectList(in, out, s)
+func convert_api_PodPresetList_To_v1_PodPresetList(in *podpresetapi.PodPresetList, out *podpresetapiv1.PodPresetList, s conversion.Scope) error {
+	return autoconvert_api_PodPresetList_To_v1_PodPresetList(in, out, s)
 }
 
-func autoconvert_api_ProjectRequest_To_v1_ProjectRequest(in *projectapi.ProjectRequest, out *projectapiv1.ProjectRequest, s conversion.Scope) error {
+func autoconvert_api_PodPresetSpec_To_v1_PodPresetSpec(in *podpresetapi.PodPresetSpec, out *podpresetapiv1.PodPresetSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapi.ProjectRequest))(in)
+		defaulting.(func(*podpresetapi.PodPresetSpec))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
-	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
 	}
-	out.DisplayName = in.DisplayName
-	out.Description = in.Description
-	return nil
-}
-
-func convert_api_ProjectRequest_To_v1_ProjectRequest(in *projectapi.ProjectRequest, out *projectapiv1.ProjectRequest, s conversion.Scope) error {
-	return autoconvert_api_ProjectRequest_To_v1_ProjectRequest(in, out, s)
-}
-
-func autoconvert_api_ProjectSpec_To_v1_ProjectSpec(in *projectapi.ProjectSpec, out *projectapiv1.ProjectSpec, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapi.ProjectSpec))(in)
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_api_EnvVar_To_v1_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
 	}
-	if in.Finalizers != nil {
-		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
-		for i := range in.Finalizers {
-			out.Finalizers[i] = pkgapiv1.FinalizerName(in.Finalizers[i])
+	if in.Volumes != nil {
+		out.Volumes = make([]pkgapiv1.Volume, len(in.Volumes))
+		for i := range in.Volumes {
+			if err := convert_api_Volume_To_v1_Volume(&in.Volumes[i], &out.Volumes[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Finalizers = nil
+		out.Volumes = nil
 	}
-	return nil
-}
-
-func convert_api_ProjectSpec_To_v1_ProjectSpec(in *projectapi.ProjectSpec, out *projectapiv1.ProjectSpec, s conversion.Scope) error {
-	return autoconvert_api_ProjectSpec_To_v1_ProjectSpec(in, out, s)
-}
-
-func autoconvert_api_ProjectStatus_To_v1_ProjectStatus(in *projectapi.ProjectStatus, out *projectapiv1.ProjectStatus, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapi.ProjectStatus))(in)
+	if in.VolumeMounts != nil {
+		out.VolumeMounts = make([]pkgapiv1.VolumeMount, len(in.VolumeMounts))
+		for i := range in.VolumeMounts {
+			if err := convert_api_VolumeMount_To_v1_VolumeMount(&in.VolumeMounts[i], &out.VolumeMounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.VolumeMounts = nil
 	}
-	out.Phase = pkgapiv1.NamespacePhase(in.Phase)
 	return nil
 }
 
-func convert_api_ProjectStatus_To_v1_ProjectStatus(in *projectapi.ProjectStatus, out *projectapiv1.ProjectStatus, s conversion.Scope) error {
-	return autoconvert_api_ProjectStatus_To_v1_ProjectStatus(in, out, s)
+func convert_api_PodPresetSpec_To_v1_PodPresetSpec(in *podpresetapi.PodPresetSpec, out *podpresetapiv1.PodPresetSpec, s conversion.Scope) error {
+	return autoconvert_api_PodPresetSpec_To_v1_PodPresetSpec(in, out, s)
 }
 
-func autoconvert_v1_Project_To_api_Project(in *projectapiv1.Project, out *projectapi.Project, s conversion.Scope) error {
+func autoconvert_v1_PodPreset_To_api_PodPreset(in *podpresetapiv1.PodPreset, out *podpresetapi.PodPreset, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapiv1.Project))(in)
+		defaulting.(func(*podpresetapiv1.PodPreset))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5097,22 +6051,19 @@ func autoconvert_v1_Project_To_api_Project(in *projectapiv1.Project, out *projec
 	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_ProjectSpec_To_api_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_v1_ProjectStatus_To_api_ProjectStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_v1_PodPresetSpec_To_api_PodPresetSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_v1_Project_To_api_Project(in *projectapiv1.Project, out *projectapi.Project, s conversion.Scope) error {
-	return autoconvert_v1_Project_To_api_Project(in, out, s)
+func convert_v1_PodPreset_To_api_PodPreset(in *podpresetapiv1.PodPreset, out *podpresetapi.PodPreset, s conversion.Scope) error {
+	return autoconvert_v1_PodPreset_To_api_PodPreset(in, out, s)
 }
 
-func autoconvert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList, out *projectapi.ProjectList, s conversion.Scope) error {
+func autoconvert_v1_PodPresetList_To_api_PodPresetList(in *podpresetapiv1.PodPresetList, out *podpresetapi.PodPresetList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapiv1.ProjectList))(in)
+		defaulting.(func(*podpresetapiv1.PodPresetList))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5121,9 +6072,9 @@ func autoconvert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList,
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]projectapi.Project, len(in.Items))
+		out.Items = make([]podpresetapi.PodPreset, len(in.Items))
 		for i := range in.Items {
-			if err := convert_v1_Project_To_api_Project(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_v1_PodPreset_To_api_PodPreset(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -5133,21 +6084,242 @@ func autoconvert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList,
 	return nil
 }
 
-func convert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList, out *projectapi.ProjectList, s conversion.Scope) error {
-	return autoconvert_v1_ProjectList_To_api_ProjectList(in, out, s)
+func convert_v1_PodPresetList_To_api_PodPresetList(in *podpresetapiv1.PodPresetList, out *podpresetapi.PodPresetList, s conversion.Scope) error {
+	return autoconvert_v1_PodPresetList_To_api_PodPresetList(in, out, s)
 }
 
-func autoconvert_v1_ProjectRequest_To_api_ProjectRequest(in *projectapiv1.ProjectRequest, out *projectapi.ProjectRequest, s conversion.Scope) error {
+func autoconvert_v1_PodPresetSpec_To_api_PodPresetSpec(in *podpresetapiv1.PodPresetSpec, out *podpresetapi.PodPresetSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*projectapiv1.ProjectRequest))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*podpresetapiv1.PodPresetSpec))(in)
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
 	}
-	out.DisplayName = in.DisplayName
+	if in.Env != nil {
+		out.Env = make([]pkgapi.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := convert_v1_EnvVar_To_api_EnvVar(&in.Env[i], &out.Env[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if in.Volumes != nil {
+		out.Volumes = make([]pkgapi.Volume, len(in.Volumes))
+		for i := range in.Volumes {
+			if err := convert_v1_Volume_To_api_Volume(&in.Volumes[i], &out.Volumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
+	if in.VolumeMounts != nil {
+		out.VolumeMounts = make([]pkgapi.VolumeMount, len(in.VolumeMounts))
+		for i := range in.VolumeMounts {
+			if err := convert_v1_VolumeMount_To_api_VolumeMount(&in.VolumeMounts[i], &out.VolumeMounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.VolumeMounts = nil
+	}
+	return nil
+}
+
+func convert_v1_PodPresetSpec_To_api_PodPresetSpec(in *podpresetapiv1.PodPresetSpec, out *podpresetapi.PodPresetSpec, s conversion.Scope) error {
+	return autoconvert_v1_PodPresetSpec_To_api_PodPresetSpec(in, out, s)
+}
+
+func autoconvert_api_Project_To_v1_Project(in *projectapi.Project, out *projectapiv1.Project, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.Project))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ProjectSpec_To_v1_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ProjectStatus_To_v1_ProjectStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_Project_To_v1_Project(in *projectapi.Project, out *projectapiv1.Project, s conversion.Scope) error {
+	return autoconvert_api_Project_To_v1_Project(in, out, s)
+}
+
+func autoconvert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, out *projectapiv1.ProjectList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]projectapiv1.Project, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_Project_To_v1_Project(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ProjectList_To_v1_ProjectList(in *projectapi.ProjectList, out *projectapiv1.ProjectList, s conversion.Scope) error {
+	return autoconvert_api_ProjectList_To_v1_ProjectList(in, out, s)
+}
+
+func autoconvert_api_ProjectRequest_To_v1_ProjectRequest(in *projectapi.ProjectRequest, out *projectapiv1.ProjectRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectRequest))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.DisplayName = in.DisplayName
+	out.Description = in.Description
+	return nil
+}
+
+func convert_api_ProjectRequest_To_v1_ProjectRequest(in *projectapi.ProjectRequest, out *projectapiv1.ProjectRequest, s conversion.Scope) error {
+	return autoconvert_api_ProjectRequest_To_v1_ProjectRequest(in, out, s)
+}
+
+func autoconvert_api_ProjectSecurityAllocation_To_v1_ProjectSecurityAllocation(in *projectapi.ProjectSecurityAllocation, out *projectapiv1.ProjectSecurityAllocation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectSecurityAllocation))(in)
+	}
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
+func convert_api_ProjectSecurityAllocation_To_v1_ProjectSecurityAllocation(in *projectapi.ProjectSecurityAllocation, out *projectapiv1.ProjectSecurityAllocation, s conversion.Scope) error {
+	return autoconvert_api_ProjectSecurityAllocation_To_v1_ProjectSecurityAllocation(in, out, s)
+}
+
+func autoconvert_api_ProjectSpec_To_v1_ProjectSpec(in *projectapi.ProjectSpec, out *projectapiv1.ProjectSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectSpec))(in)
+	}
+	if in.Finalizers != nil {
+		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
+		for i := range in.Finalizers {
+			out.Finalizers[i] = pkgapiv1.FinalizerName(in.Finalizers[i])
+		}
+	} else {
+		out.Finalizers = nil
+	}
+	return nil
+}
+
+func convert_api_ProjectSpec_To_v1_ProjectSpec(in *projectapi.ProjectSpec, out *projectapiv1.ProjectSpec, s conversion.Scope) error {
+	return autoconvert_api_ProjectSpec_To_v1_ProjectSpec(in, out, s)
+}
+
+func autoconvert_api_ProjectStatus_To_v1_ProjectStatus(in *projectapi.ProjectStatus, out *projectapiv1.ProjectStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectStatus))(in)
+	}
+	out.Phase = pkgapiv1.NamespacePhase(in.Phase)
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapiv1.ProjectSecurityAllocation)
+		if err := convert_api_ProjectSecurityAllocation_To_v1_ProjectSecurityAllocation(in.SecurityAllocation, out.SecurityAllocation, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
+	return nil
+}
+
+func convert_api_ProjectStatus_To_v1_ProjectStatus(in *projectapi.ProjectStatus, out *projectapiv1.ProjectStatus, s conversion.Scope) error {
+	return autoconvert_api_ProjectStatus_To_v1_ProjectStatus(in, out, s)
+}
+
+func autoconvert_v1_Project_To_api_Project(in *projectapiv1.Project, out *projectapi.Project, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapiv1.Project))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ProjectSpec_To_api_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ProjectStatus_To_api_ProjectStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_Project_To_api_Project(in *projectapiv1.Project, out *projectapi.Project, s conversion.Scope) error {
+	return autoconvert_v1_Project_To_api_Project(in, out, s)
+}
+
+func autoconvert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList, out *projectapi.ProjectList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapiv1.ProjectList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]projectapi.Project, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_Project_To_api_Project(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ProjectList_To_api_ProjectList(in *projectapiv1.ProjectList, out *projectapi.ProjectList, s conversion.Scope) error {
+	return autoconvert_v1_ProjectList_To_api_ProjectList(in, out, s)
+}
+
+func autoconvert_v1_ProjectRequest_To_api_ProjectRequest(in *projectapiv1.ProjectRequest, out *projectapi.ProjectRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapiv1.ProjectRequest))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.DisplayName = in.DisplayName
 	out.Description = in.Description
 	return nil
 }
@@ -5156,6 +6328,20 @@ func convert_v1_ProjectRequest_To_api_ProjectRequest(in *projectapiv1.ProjectReq
 	return autoconvert_v1_ProjectRequest_To_api_ProjectRequest(in, out, s)
 }
 
+func autoconvert_v1_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in *projectapiv1.ProjectSecurityAllocation, out *projectapi.ProjectSecurityAllocation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapiv1.ProjectSecurityAllocation))(in)
+	}
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
+func convert_v1_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in *projectapiv1.ProjectSecurityAllocation, out *projectapi.ProjectSecurityAllocation, s conversion.Scope) error {
+	return autoconvert_v1_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in, out, s)
+}
+
 func autoconvert_v1_ProjectSpec_To_api_ProjectSpec(in *projectapiv1.ProjectSpec, out *projectapi.ProjectSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapiv1.ProjectSpec))(in)
@@ -5180,6 +6366,14 @@ func autoconvert_v1_ProjectStatus_To_api_ProjectStatus(in *projectapiv1.ProjectS
 		defaulting.(func(*projectapiv1.ProjectStatus))(in)
 	}
 	out.Phase = pkgapi.NamespacePhase(in.Phase)
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapi.ProjectSecurityAllocation)
+		if err := convert_v1_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in.SecurityAllocation, out.SecurityAllocation, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
 	return nil
 }
 
@@ -5187,9 +6381,642 @@ func convert_v1_ProjectStatus_To_api_ProjectStatus(in *projectapiv1.ProjectStatu
 	return autoconvert_v1_ProjectStatus_To_api_ProjectStatus(in, out, s)
 }
 
-func autoconvert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s conversion.Scope) error {
+func autoconvert_api_ClusterResourceQuota_To_v1_ClusterResourceQuota(in *quotaapi.ClusterResourceQuota, out *quotaapiv1.ClusterResourceQuota, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.Route))(in)
+		defaulting.(func(*quotaapi.ClusterResourceQuota))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ClusterResourceQuota_To_v1_ClusterResourceQuota(in *quotaapi.ClusterResourceQuota, out *quotaapiv1.ClusterResourceQuota, s conversion.Scope) error {
+	return autoconvert_api_ClusterResourceQuota_To_v1_ClusterResourceQuota(in, out, s)
+}
+
+func autoconvert_api_ClusterResourceQuotaList_To_v1_ClusterResourceQuotaList(in *quotaapi.ClusterResourceQuotaList, out *quotaapiv1.ClusterResourceQuotaList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapi.ClusterResourceQuotaList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]quotaapiv1.ClusterResourceQuota, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ClusterResourceQuota_To_v1_ClusterResourceQuota(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ClusterResourceQuotaList_To_v1_ClusterResourceQuotaList(in *quotaapi.ClusterResourceQuotaList, out *quotaapiv1.ClusterResourceQuotaList, s conversion.Scope) error {
+	return autoconvert_api_ClusterResourceQuotaList_To_v1_ClusterResourceQuotaList(in, out, s)
+}
+
+func autoconvert_api_ClusterResourceQuotaSelector_To_v1_ClusterResourceQuotaSelector(in *quotaapi.ClusterResourceQuotaSelector, out *quotaapiv1.ClusterResourceQuotaSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapi.ClusterResourceQuotaSelector))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.AnnotationSelector != nil {
+		out.AnnotationSelector = make(map[string]string)
+		for key, val := range in.AnnotationSelector {
+			out.AnnotationSelector[key] = val
+		}
+	} else {
+		out.AnnotationSelector = nil
+	}
+	return nil
+}
+
+func convert_api_ClusterResourceQuotaSelector_To_v1_ClusterResourceQuotaSelector(in *quotaapi.ClusterResourceQuotaSelector, out *quotaapiv1.ClusterResourceQuotaSelector, s conversion.Scope) error {
+	return autoconvert_api_ClusterResourceQuotaSelector_To_v1_ClusterResourceQuotaSelector(in, out, s)
+}
+
+func autoconvert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec(in *quotaapi.ClusterResourceQuotaSpec, out *quotaapiv1.ClusterResourceQuotaSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapi.ClusterResourceQuotaSpec))(in)
+	}
+	if err := convert_api_ClusterResourceQuotaSelector_To_v1_ClusterResourceQuotaSelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	if err := convert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec(in *quotaapi.ClusterResourceQuotaSpec, out *quotaapiv1.ClusterResourceQuotaSpec, s conversion.Scope) error {
+	return autoconvert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec(in, out, s)
+}
+
+func autoconvert_api_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(in *quotaapi.ClusterResourceQuotaStatus, out *quotaapiv1.ClusterResourceQuotaStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapi.ClusterResourceQuotaStatus))(in)
+	}
+	if err := convert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]quotaapiv1.ResourceQuotaStatusByNamespace, len(in.Namespaces))
+		for i := range in.Namespaces {
+			if err := convert_api_ResourceQuotaStatusByNamespace_To_v1_ResourceQuotaStatusByNamespace(&in.Namespaces[i], &out.Namespaces[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_api_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(in *quotaapi.ClusterResourceQuotaStatus, out *quotaapiv1.ClusterResourceQuotaStatus, s conversion.Scope) error {
+	return autoconvert_api_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus(in, out, s)
+}
+
+func autoconvert_api_ResourceQuotaStatusByNamespace_To_v1_ResourceQuotaStatusByNamespace(in *quotaapi.ResourceQuotaStatusByNamespace, out *quotaapiv1.ResourceQuotaStatusByNamespace, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapi.ResourceQuotaStatusByNamespace))(in)
+	}
+	out.Namespace = in.Namespace
+	if err := convert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ResourceQuotaStatusByNamespace_To_v1_ResourceQuotaStatusByNamespace(in *quotaapi.ResourceQuotaStatusByNamespace, out *quotaapiv1.ResourceQuotaStatusByNamespace, s conversion.Scope) error {
+	return autoconvert_api_ResourceQuotaStatusByNamespace_To_v1_ResourceQuotaStatusByNamespace(in, out, s)
+}
+
+func autoconvert_v1_ClusterResourceQuota_To_api_ClusterResourceQuota(in *quotaapiv1.ClusterResourceQuota, out *quotaapi.ClusterResourceQuota, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ClusterResourceQuota))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ClusterResourceQuotaStatus_To_api_ClusterResourceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ClusterResourceQuota_To_api_ClusterResourceQuota(in *quotaapiv1.ClusterResourceQuota, out *quotaapi.ClusterResourceQuota, s conversion.Scope) error {
+	return autoconvert_v1_ClusterResourceQuota_To_api_ClusterResourceQuota(in, out, s)
+}
+
+func autoconvert_v1_ClusterResourceQuotaList_To_api_ClusterResourceQuotaList(in *quotaapiv1.ClusterResourceQuotaList, out *quotaapi.ClusterResourceQuotaList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ClusterResourceQuotaList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]quotaapi.ClusterResourceQuota, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ClusterResourceQuota_To_api_ClusterResourceQuota(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_ClusterResourceQuotaList_To_api_ClusterResourceQuotaList(in *quotaapiv1.ClusterResourceQuotaList, out *quotaapi.ClusterResourceQuotaList, s conversion.Scope) error {
+	return autoconvert_v1_ClusterResourceQuotaList_To_api_ClusterResourceQuotaList(in, out, s)
+}
+
+func autoconvert_v1_ClusterResourceQuotaSelector_To_api_ClusterResourceQuotaSelector(in *quotaapiv1.ClusterResourceQuotaSelector, out *quotaapi.ClusterResourceQuotaSelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ClusterResourceQuotaSelector))(in)
+	}
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.AnnotationSelector != nil {
+		out.AnnotationSelector = make(map[string]string)
+		for key, val := range in.AnnotationSelector {
+			out.AnnotationSelector[key] = val
+		}
+	} else {
+		out.AnnotationSelector = nil
+	}
+	return nil
+}
+
+func convert_v1_ClusterResourceQuotaSelector_To_api_ClusterResourceQuotaSelector(in *quotaapiv1.ClusterResourceQuotaSelector, out *quotaapi.ClusterResourceQuotaSelector, s conversion.Scope) error {
+	return autoconvert_v1_ClusterResourceQuotaSelector_To_api_ClusterResourceQuotaSelector(in, out, s)
+}
+
+func autoconvert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec(in *quotaapiv1.ClusterResourceQuotaSpec, out *quotaapi.ClusterResourceQuotaSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ClusterResourceQuotaSpec))(in)
+	}
+	if err := convert_v1_ClusterResourceQuotaSelector_To_api_ClusterResourceQuotaSelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec(in *quotaapiv1.ClusterResourceQuotaSpec, out *quotaapi.ClusterResourceQuotaSpec, s conversion.Scope) error {
+	return autoconvert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec(in, out, s)
+}
+
+func autoconvert_v1_ClusterResourceQuotaStatus_To_api_ClusterResourceQuotaStatus(in *quotaapiv1.ClusterResourceQuotaStatus, out *quotaapi.ClusterResourceQuotaStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ClusterResourceQuotaStatus))(in)
+	}
+	if err := convert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]quotaapi.ResourceQuotaStatusByNamespace, len(in.Namespaces))
+		for i := range in.Namespaces {
+			if err := convert_v1_ResourceQuotaStatusByNamespace_To_api_ResourceQuotaStatusByNamespace(&in.Namespaces[i], &out.Namespaces[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_v1_ClusterResourceQuotaStatus_To_api_ClusterResourceQuotaStatus(in *quotaapiv1.ClusterResourceQuotaStatus, out *quotaapi.ClusterResourceQuotaStatus, s conversion.Scope) error {
+	return autoconvert_v1_ClusterResourceQuotaStatus_To_api_ClusterResourceQuotaStatus(in, out, s)
+}
+
+func autoconvert_v1_ResourceQuotaStatusByNamespace_To_api_ResourceQuotaStatusByNamespace(in *quotaapiv1.ResourceQuotaStatusByNamespace, out *quotaapi.ResourceQuotaStatusByNamespace, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*quotaapiv1.ResourceQuotaStatusByNamespace))(in)
+	}
+	out.Namespace = in.Namespace
+	if err := convert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ResourceQuotaStatusByNamespace_To_api_ResourceQuotaStatusByNamespace(in *quotaapiv1.ResourceQuotaStatusByNamespace, out *quotaapi.ResourceQuotaStatusByNamespace, s conversion.Scope) error {
+	return autoconvert_v1_ResourceQuotaStatusByNamespace_To_api_ResourceQuotaStatusByNamespace(in, out, s)
+}
+
+func autoconvert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.Route))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_RouteSpec_To_v1_RouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_RouteStatus_To_v1_RouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s conversion.Scope) error {
+	return autoconvert_api_Route_To_v1_Route(in, out, s)
+}
+
+func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]routeapiv1.Route, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_Route_To_v1_Route(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
+	return autoconvert_api_RouteList_To_v1_RouteList(in, out, s)
+}
+
+func autoconvert_api_RoutePort_To_v1_RoutePort(in *routeapi.RoutePort, out *routeapiv1.RoutePort, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RoutePort))(in)
+	}
+	if err := s.Convert(&in.TargetPort, &out.TargetPort, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RoutePort_To_v1_RoutePort(in *routeapi.RoutePort, out *routeapiv1.RoutePort, s conversion.Scope) error {
+	return autoconvert_api_RoutePort_To_v1_RoutePort(in, out, s)
+}
+
+func autoconvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *routeapiv1.RouteSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteSpec))(in)
+	}
+	out.Host = in.Host
+	out.Path = in.Path
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.Port != nil {
+		out.Port = new(routeapiv1.RoutePort)
+		if err := convert_api_RoutePort_To_v1_RoutePort(in.Port, out.Port, s); err != nil {
+			return err
+		}
+	} else {
+		out.Port = nil
+	}
+	if in.TLS != nil {
+		out.TLS = new(routeapiv1.TLSConfig)
+		if err := convert_api_TLSConfig_To_v1_TLSConfig(in.TLS, out.TLS, s); err != nil {
+			return err
+		}
+	} else {
+		out.TLS = nil
+	}
+	return nil
+}
+
+func convert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *routeapiv1.RouteSpec, s conversion.Scope) error {
+	return autoconvert_api_RouteSpec_To_v1_RouteSpec(in, out, s)
+}
+
+func autoconvert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *routeapiv1.RouteStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteStatus))(in)
+	}
+	return nil
+}
+
+func convert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *routeapiv1.RouteStatus, s conversion.Scope) error {
+	return autoconvert_api_RouteStatus_To_v1_RouteStatus(in, out, s)
+}
+
+func autoconvert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.TLSConfig))(in)
+	}
+	out.Termination = routeapiv1.TLSTerminationType(in.Termination)
+	out.Certificate = in.Certificate
+	out.Key = in.Key
+	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
+	out.DestinationCACertificate = in.DestinationCACertificate
+	out.InsecureEdgeTerminationPolicy = routeapiv1.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
+	return nil
+}
+
+func convert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
+	return autoconvert_api_TLSConfig_To_v1_TLSConfig(in, out, s)
+}
+
+func autoconvert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.Route))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_RouteSpec_To_api_RouteSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_RouteStatus_To_api_RouteStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s conversion.Scope) error {
+	return autoconvert_v1_Route_To_api_Route(in, out, s)
+}
+
+func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]routeapi.Route, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_Route_To_api_Route(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
+	return autoconvert_v1_RouteList_To_api_RouteList(in, out, s)
+}
+
+func autoconvert_v1_RoutePort_To_api_RoutePort(in *routeapiv1.RoutePort, out *routeapi.RoutePort, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RoutePort))(in)
+	}
+	if err := s.Convert(&in.TargetPort, &out.TargetPort, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_RoutePort_To_api_RoutePort(in *routeapiv1.RoutePort, out *routeapi.RoutePort, s conversion.Scope) error {
+	return autoconvert_v1_RoutePort_To_api_RoutePort(in, out, s)
+}
+
+func autoconvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *routeapi.RouteSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteSpec))(in)
+	}
+	out.Host = in.Host
+	out.Path = in.Path
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	if in.Port != nil {
+		out.Port = new(routeapi.RoutePort)
+		if err := convert_v1_RoutePort_To_api_RoutePort(in.Port, out.Port, s); err != nil {
+			return err
+		}
+	} else {
+		out.Port = nil
+	}
+	if in.TLS != nil {
+		out.TLS = new(routeapi.TLSConfig)
+		if err := convert_v1_TLSConfig_To_api_TLSConfig(in.TLS, out.TLS, s); err != nil {
+			return err
+		}
+	} else {
+		out.TLS = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *routeapi.RouteSpec, s conversion.Scope) error {
+	return autoconvert_v1_RouteSpec_To_api_RouteSpec(in, out, s)
+}
+
+func autoconvert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *routeapi.RouteStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteStatus))(in)
+	}
+	return nil
+}
+
+func convert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *routeapi.RouteStatus, s conversion.Scope) error {
+	return autoconvert_v1_RouteStatus_To_api_RouteStatus(in, out, s)
+}
+
+func autoconvert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.TLSConfig))(in)
+	}
+	out.Termination = routeapi.TLSTerminationType(in.Termination)
+	out.Certificate = in.Certificate
+	out.Key = in.Key
+	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
+	out.DestinationCACertificate = in.DestinationCACertificate
+	out.InsecureEdgeTerminationPolicy = routeapi.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
+	return nil
+}
+
+func convert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
+	return autoconvert_v1_TLSConfig_To_api_TLSConfig(in, out, s)
+}
+
+func autoconvert_api_ClusterNetwork_To_v1_ClusterNetwork(in *sdnapi.ClusterNetwork, out *sdnapiv1.ClusterNetwork, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.ClusterNetwork))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Network = in.Network
+	out.HostSubnetLength = in.HostSubnetLength
+	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapiv1.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := convert_api_ClusterNetworkEntry_To_v1_ClusterNetworkEntry(&in.ClusterNetworks[i], &out.ClusterNetworks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
+	return nil
+}
+
+func convert_api_ClusterNetwork_To_v1_ClusterNetwork(in *sdnapi.ClusterNetwork, out *sdnapiv1.ClusterNetwork, s conversion.Scope) error {
+	return autoconvert_api_ClusterNetwork_To_v1_ClusterNetwork(in, out, s)
+}
+
+func autoconvert_api_ClusterNetworkEntry_To_v1_ClusterNetworkEntry(in *sdnapi.ClusterNetworkEntry, out *sdnapiv1.ClusterNetworkEntry, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.ClusterNetworkEntry))(in)
+	}
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
+	return nil
+}
+
+func convert_api_ClusterNetworkEntry_To_v1_ClusterNetworkEntry(in *sdnapi.ClusterNetworkEntry, out *sdnapiv1.ClusterNetworkEntry, s conversion.Scope) error {
+	return autoconvert_api_ClusterNetworkEntry_To_v1_ClusterNetworkEntry(in, out, s)
+}
+
+func autoconvert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in *sdnapi.ClusterNetworkList, out *sdnapiv1.ClusterNetworkList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.ClusterNetworkList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]sdnapiv1.ClusterNetwork, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_ClusterNetwork_To_v1_ClusterNetwork(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in *sdnapi.ClusterNetworkList, out *sdnapiv1.ClusterNetworkList, s conversion.Scope) error {
+	return autoconvert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in, out, s)
+}
+
+func autoconvert_api_HostSubnet_To_v1_HostSubnet(in *sdnapi.HostSubnet, out *sdnapiv1.HostSubnet, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.HostSubnet))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.Host = in.Host
+	out.HostIP = in.HostIP
+	out.Subnet = in.Subnet
+	return nil
+}
+
+func convert_api_HostSubnet_To_v1_HostSubnet(in *sdnapi.HostSubnet, out *sdnapiv1.HostSubnet, s conversion.Scope) error {
+	return autoconvert_api_HostSubnet_To_v1_HostSubnet(in, out, s)
+}
+
+func autoconvert_api_HostSubnetList_To_v1_HostSubnetList(in *sdnapi.HostSubnetList, out *sdnapiv1.HostSubnetList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.HostSubnetList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]sdnapiv1.HostSubnet, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_HostSubnet_To_v1_HostSubnet(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_HostSubnetList_To_v1_HostSubnetList(in *sdnapi.HostSubnetList, out *sdnapiv1.HostSubnetList, s conversion.Scope) error {
+	return autoconvert_api_HostSubnetList_To_v1_HostSubnetList(in, out, s)
+}
+
+func autoconvert_api_NetNamespace_To_v1_NetNamespace(in *sdnapi.NetNamespace, out *sdnapiv1.NetNamespace, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.NetNamespace))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5197,22 +7024,18 @@ func autoconvert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route
 	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_api_RouteSpec_To_v1_RouteSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_api_RouteStatus_To_v1_RouteStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+	out.NetName = in.NetName
+	out.NetID = in.NetID
 	return nil
 }
 
-func convert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s conversion.Scope) error {
-	return autoconvert_api_Route_To_v1_Route(in, out, s)
+func convert_api_NetNamespace_To_v1_NetNamespace(in *sdnapi.NetNamespace, out *sdnapiv1.NetNamespace, s conversion.Scope) error {
+	return autoconvert_api_NetNamespace_To_v1_NetNamespace(in, out, s)
 }
 
-func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
+func autoconvert_api_NetNamespaceList_To_v1_NetNamespaceList(in *sdnapi.NetNamespaceList, out *sdnapiv1.NetNamespaceList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.RouteList))(in)
+		defaulting.(func(*sdnapi.NetNamespaceList))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5221,9 +7044,9 @@ func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *rout
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]routeapiv1.Route, len(in.Items))
+		out.Items = make([]sdnapiv1.NetNamespace, len(in.Items))
 		for i := range in.Items {
-			if err := convert_api_Route_To_v1_Route(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_api_NetNamespace_To_v1_NetNamespace(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -5233,87 +7056,83 @@ func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *rout
 	return nil
 }
 
-func convert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
-	return autoconvert_api_RouteList_To_v1_RouteList(in, out, s)
+func convert_api_NetNamespaceList_To_v1_NetNamespaceList(in *sdnapi.NetNamespaceList, out *sdnapiv1.NetNamespaceList, s conversion.Scope) error {
+	return autoconvert_api_NetNamespaceList_To_v1_NetNamespaceList(in, out, s)
 }
 
-func autoconvert_api_RoutePort_To_v1_RoutePort(in *routeapi.RoutePort, out *routeapiv1.RoutePort, s conversion.Scope) error {
+func autoconvert_v1_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1.ClusterNetwork, out *sdnapi.ClusterNetwork, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.RoutePort))(in)
+		defaulting.(func(*sdnapiv1.ClusterNetwork))(in)
 	}
-	if err := s.Convert(&in.TargetPort, &out.TargetPort, 0); err != nil {
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	return nil
-}
-
-func convert_api_RoutePort_To_v1_RoutePort(in *routeapi.RoutePort, out *routeapiv1.RoutePort, s conversion.Scope) error {
-	return autoconvert_api_RoutePort_To_v1_RoutePort(in, out, s)
-}
-
-func autoconvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *routeapiv1.RouteSpec, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.RouteSpec))(in)
-	}
-	out.Host = in.Host
-	out.Path = in.Path
-	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if in.Port != nil {
-		out.Port = new(routeapiv1.RoutePort)
-		if err := convert_api_RoutePort_To_v1_RoutePort(in.Port, out.Port, s); err != nil {
-			return err
-		}
-	} else {
-		out.Port = nil
-	}
-	if in.TLS != nil {
-		out.TLS = new(routeapiv1.TLSConfig)
-		if err := convert_api_TLSConfig_To_v1_TLSConfig(in.TLS, out.TLS, s); err != nil {
-			return err
+	out.Network = in.Network
+	out.HostSubnetLength = in.HostSubnetLength
+	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapi.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := convert_v1_ClusterNetworkEntry_To_api_ClusterNetworkEntry(&in.ClusterNetworks[i], &out.ClusterNetworks[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.TLS = nil
+		out.ClusterNetworks = nil
 	}
 	return nil
 }
 
-func convert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *routeapiv1.RouteSpec, s conversion.Scope) error {
-	return autoconvert_api_RouteSpec_To_v1_RouteSpec(in, out, s)
+func convert_v1_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1.ClusterNetwork, out *sdnapi.ClusterNetwork, s conversion.Scope) error {
+	return autoconvert_v1_ClusterNetwork_To_api_ClusterNetwork(in, out, s)
 }
 
-func autoconvert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *routeapiv1.RouteStatus, s conversion.Scope) error {
+func autoconvert_v1_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in *sdnapiv1.ClusterNetworkEntry, out *sdnapi.ClusterNetworkEntry, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.RouteStatus))(in)
+		defaulting.(func(*sdnapiv1.ClusterNetworkEntry))(in)
 	}
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
 	return nil
 }
 
-func convert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *routeapiv1.RouteStatus, s conversion.Scope) error {
-	return autoconvert_api_RouteStatus_To_v1_RouteStatus(in, out, s)
+func convert_v1_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in *sdnapiv1.ClusterNetworkEntry, out *sdnapi.ClusterNetworkEntry, s conversion.Scope) error {
+	return autoconvert_v1_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in, out, s)
 }
 
-func autoconvert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
+func autoconvert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in *sdnapiv1.ClusterNetworkList, out *sdnapi.ClusterNetworkList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapi.TLSConfig))(in)
+		defaulting.(func(*sdnapiv1.ClusterNetworkList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]sdnapi.ClusterNetwork, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_ClusterNetwork_To_api_ClusterNetwork(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
 	}
-	out.Termination = routeapiv1.TLSTerminationType(in.Termination)
-	out.Certificate = in.Certificate
-	out.Key = in.Key
-	out.CACertificate = in.CACertificate
-	out.DestinationCACertificate = in.DestinationCACertificate
-	out.InsecureEdgeTerminationPolicy = routeapiv1.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
 	return nil
 }
 
-func convert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
-	return autoconvert_api_TLSConfig_To_v1_TLSConfig(in, out, s)
+func convert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in *sdnapiv1.ClusterNetworkList, out *sdnapi.ClusterNetworkList, s conversion.Scope) error {
+	return autoconvert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in, out, s)
 }
 
-func autoconvert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s conversion.Scope) error {
+func autoconvert_v1_HostSubnet_To_api_HostSubnet(in *sdnapiv1.HostSubnet, out *sdnapi.HostSubnet, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.Route))(in)
+		defaulting.(func(*sdnapiv1.HostSubnet))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5321,22 +7140,19 @@ func autoconvert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route
 	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
-	if err := convert_v1_RouteSpec_To_api_RouteSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_v1_RouteStatus_To_api_RouteStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+	out.Host = in.Host
+	out.HostIP = in.HostIP
+	out.Subnet = in.Subnet
 	return nil
 }
 
-func convert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s conversion.Scope) error {
-	return autoconvert_v1_Route_To_api_Route(in, out, s)
+func convert_v1_HostSubnet_To_api_HostSubnet(in *sdnapiv1.HostSubnet, out *sdnapi.HostSubnet, s conversion.Scope) error {
+	return autoconvert_v1_HostSubnet_To_api_HostSubnet(in, out, s)
 }
 
-func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
+func autoconvert_v1_HostSubnetList_To_api_HostSubnetList(in *sdnapiv1.HostSubnetList, out *sdnapi.HostSubnetList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.RouteList))(in)
+		defaulting.(func(*sdnapiv1.HostSubnetList))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
@@ -5345,9 +7161,9 @@ func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *ro
 		return err
 	}
 	if in.Items != nil {
-		out.Items = make([]routeapi.Route, len(in.Items))
+		out.Items = make([]sdnapi.HostSubnet, len(in.Items))
 		for i := range in.Items {
-			if err := convert_v1_Route_To_api_Route(&in.Items[i], &out.Items[i], s); err != nil {
+			if err := convert_v1_HostSubnet_To_api_HostSubnet(&in.Items[i], &out.Items[i], s); err != nil {
 				return err
 			}
 		}
@@ -5357,362 +7173,342 @@ func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *ro
 	return nil
 }
 
-func convert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
-	return autoconvert_v1_RouteList_To_api_RouteList(in, out, s)
+func convert_v1_HostSubnetList_To_api_HostSubnetList(in *sdnapiv1.HostSubnetList, out *sdnapi.HostSubnetList, s conversion.Scope) error {
+	return autoconvert_v1_HostSubnetList_To_api_HostSubnetList(in, out, s)
 }
 
-func autoconvert_v1_RoutePort_To_api_RoutePort(in *routeapiv1.RoutePort, out *routeapi.RoutePort, s conversion.Scope) error {
+func autoconvert_v1_NetNamespace_To_api_NetNamespace(in *sdnapiv1.NetNamespace, out *sdnapi.NetNamespace, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.RoutePort))(in)
+		defaulting.(func(*sdnapiv1.NetNamespace))(in)
 	}
-	if err := s.Convert(&in.TargetPort, &out.TargetPort, 0); err != nil {
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
 	}
+	out.NetName = in.NetName
+	out.NetID = in.NetID
 	return nil
 }
 
-func convert_v1_RoutePort_To_api_RoutePort(in *routeapiv1.RoutePort, out *routeapi.RoutePort, s conversion.Scope) error {
-	return autoconvert_v1_RoutePort_To_api_RoutePort(in, out, s)
+func convert_v1_NetNamespace_To_api_NetNamespace(in *sdnapiv1.NetNamespace, out *sdnapi.NetNamespace, s conversion.Scope) error {
+	return autoconvert_v1_NetNamespace_To_api_NetNamespace(in, out, s)
 }
 
-func autoconvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *routeapi.RouteSpec, s conversion.Scope) error {
+func autoconvert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespaceList, out *sdnapi.NetNamespaceList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.RouteSpec))(in)
+		defaulting.(func(*sdnapiv1.NetNamespaceList))(in)
 	}
-	out.Host = in.Host
-	out.Path = in.Path
-	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if in.Port != nil {
-		out.Port = new(routeapi.RoutePort)
-		if err := convert_v1_RoutePort_To_api_RoutePort(in.Port, out.Port, s); err != nil {
-			return err
-		}
-	} else {
-		out.Port = nil
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
 	}
-	if in.TLS != nil {
-		out.TLS = new(routeapi.TLSConfig)
-		if err := convert_v1_TLSConfig_To_api_TLSConfig(in.TLS, out.TLS, s); err != nil {
-			return err
+	if in.Items != nil {
+		out.Items = make([]sdnapi.NetNamespace, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1_NetNamespace_To_api_NetNamespace(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.TLS = nil
+		out.Items = nil
 	}
 	return nil
 }
 
-func convert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *routeapi.RouteSpec, s conversion.Scope) error {
-	return autoconvert_v1_RouteSpec_To_api_RouteSpec(in, out, s)
+func convert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespaceList, out *sdnapi.NetNamespaceList, s conversion.Scope) error {
+	return autoconvert_v1_NetNamespaceList_To_api_NetNamespaceList(in, out, s)
 }
 
-func autoconvert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *routeapi.RouteStatus, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicyReview_To_v1_PodSecurityPolicyReview(in *securityapi.PodSecurityPolicyReview, out *securityapiv1.PodSecurityPolicyReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.RouteStatus))(in)
+		defaulting.(func(*securityapi.PodSecurityPolicyReview))(in)
 	}
-	return nil
-}
-
-func convert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *routeapi.RouteStatus, s conversion.Scope) error {
-	return autoconvert_v1_RouteStatus_To_api_RouteStatus(in, out, s)
-}
-
-func autoconvert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*routeapiv1.TLSConfig))(in)
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_PodSecurityPolicyReviewSpec_To_v1_PodSecurityPolicyReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_PodSecurityPolicyReviewStatus_To_v1_PodSecurityPolicyReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
 	}
-	out.Termination = routeapi.TLSTerminationType(in.Termination)
-	out.Certificate = in.Certificate
-	out.Key = in.Key
-	out.CACertificate = in.CACertificate
-	out.DestinationCACertificate = in.DestinationCACertificate
-	out.InsecureEdgeTerminationPolicy = routeapi.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
 	return nil
 }
 
-func convert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
-	return autoconvert_v1_TLSConfig_To_api_TLSConfig(in, out, s)
+func convert_api_PodSecurityPolicyReview_To_v1_PodSecurityPolicyReview(in *securityapi.PodSecurityPolicyReview, out *securityapiv1.PodSecurityPolicyReview, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicyReview_To_v1_PodSecurityPolicyReview(in, out, s)
 }
 
-func autoconvert_api_ClusterNetwork_To_v1_ClusterNetwork(in *sdnapi.ClusterNetwork, out *sdnapiv1.ClusterNetwork, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicyReviewSpec_To_v1_PodSecurityPolicyReviewSpec(in *securityapi.PodSecurityPolicyReviewSpec, out *securityapiv1.PodSecurityPolicyReviewSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.ClusterNetwork))(in)
+		defaulting.(func(*securityapi.PodSecurityPolicyReviewSpec))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+	if err := convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+	if in.ServiceAccountNames != nil {
+		out.ServiceAccountNames = make([]string, len(in.ServiceAccountNames))
+		for i := range in.ServiceAccountNames {
+			out.ServiceAccountNames[i] = in.ServiceAccountNames[i]
+		}
+	} else {
+		out.ServiceAccountNames = nil
 	}
-	out.Network = in.Network
-	out.HostSubnetLength = in.HostSubnetLength
-	out.ServiceNetwork = in.ServiceNetwork
 	return nil
 }
 
-func convert_api_ClusterNetwork_To_v1_ClusterNetwork(in *sdnapi.ClusterNetwork, out *sdnapiv1.ClusterNetwork, s conversion.Scope) error {
-	return autoconvert_api_ClusterNetwork_To_v1_ClusterNetwork(in, out, s)
+func convert_api_PodSecurityPolicyReviewSpec_To_v1_PodSecurityPolicyReviewSpec(in *securityapi.PodSecurityPolicyReviewSpec, out *securityapiv1.PodSecurityPolicyReviewSpec, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicyReviewSpec_To_v1_PodSecurityPolicyReviewSpec(in, out, s)
 }
 
-func autoconvert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in *sdnapi.ClusterNetworkList, out *sdnapiv1.ClusterNetworkList, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicyReviewStatus_To_v1_PodSecurityPolicyReviewStatus(in *securityapi.PodSecurityPolicyReviewStatus, out *securityapiv1.PodSecurityPolicyReviewStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.ClusterNetworkList))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
-	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
-		return err
+		defaulting.(func(*securityapi.PodSecurityPolicyReviewStatus))(in)
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapiv1.ClusterNetwork, len(in.Items))
-		for i := range in.Items {
-			if err := convert_api_ClusterNetwork_To_v1_ClusterNetwork(&in.Items[i], &out.Items[i], s); err != nil {
+	if in.AllowedServiceAccounts != nil {
+		out.AllowedServiceAccounts = make([]securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, len(in.AllowedServiceAccounts))
+		for i := range in.AllowedServiceAccounts {
+			if err := convert_api_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus(&in.AllowedServiceAccounts[i], &out.AllowedServiceAccounts[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Items = nil
+		out.AllowedServiceAccounts = nil
 	}
 	return nil
 }
 
-func convert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in *sdnapi.ClusterNetworkList, out *sdnapiv1.ClusterNetworkList, s conversion.Scope) error {
-	return autoconvert_api_ClusterNetworkList_To_v1_ClusterNetworkList(in, out, s)
+func convert_api_PodSecurityPolicyReviewStatus_To_v1_PodSecurityPolicyReviewStatus(in *securityapi.PodSecurityPolicyReviewStatus, out *securityapiv1.PodSecurityPolicyReviewStatus, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicyReviewStatus_To_v1_PodSecurityPolicyReviewStatus(in, out, s)
 }
 
-func autoconvert_api_HostSubnet_To_v1_HostSubnet(in *sdnapi.HostSubnet, out *sdnapiv1.HostSubnet, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicySubjectReview_To_v1_PodSecurityPolicySubjectReview(in *securityapi.PodSecurityPolicySubjectReview, out *securityapiv1.PodSecurityPolicySubjectReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.HostSubnet))(in)
+		defaulting.(func(*securityapi.PodSecurityPolicySubjectReview))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_api_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Host = in.Host
-	out.HostIP = in.HostIP
-	out.Subnet = in.Subnet
 	return nil
 }
 
-func convert_api_HostSubnet_To_v1_HostSubnet(in *sdnapi.HostSubnet, out *sdnapiv1.HostSubnet, s conversion.Scope) error {
-	return autoconvert_api_HostSubnet_To_v1_HostSubnet(in, out, s)
+func convert_api_PodSecurityPolicySubjectReview_To_v1_PodSecurityPolicySubjectReview(in *securityapi.PodSecurityPolicySubjectReview, out *securityapiv1.PodSecurityPolicySubjectReview, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicySubjectReview_To_v1_PodSecurityPolicySubjectReview(in, out, s)
 }
 
-func autoconvert_api_HostSubnetList_To_v1_HostSubnetList(in *sdnapi.HostSubnetList, out *sdnapiv1.HostSubnetList, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec(in *securityapi.PodSecurityPolicySubjectReviewSpec, out *securityapiv1.PodSecurityPolicySubjectReviewSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.HostSubnetList))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*securityapi.PodSecurityPolicySubjectReviewSpec))(in)
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+	if err := convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapiv1.HostSubnet, len(in.Items))
-		for i := range in.Items {
-			if err := convert_api_HostSubnet_To_v1_HostSubnet(&in.Items[i], &out.Items[i], s); err != nil {
-				return err
-			}
+	out.User = in.User
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
 		}
 	} else {
-		out.Items = nil
+		out.Groups = nil
 	}
 	return nil
 }
 
-func convert_api_HostSubnetList_To_v1_HostSubnetList(in *sdnapi.HostSubnetList, out *sdnapiv1.HostSubnetList, s conversion.Scope) error {
-	return autoconvert_api_HostSubnetList_To_v1_HostSubnetList(in, out, s)
+func convert_api_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec(in *securityapi.PodSecurityPolicySubjectReviewSpec, out *securityapiv1.PodSecurityPolicySubjectReviewSpec, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec(in, out, s)
 }
 
-func autoconvert_api_NetNamespace_To_v1_NetNamespace(in *sdnapi.NetNamespace, out *sdnapiv1.NetNamespace, s conversion.Scope) error {
+func autoconvert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus(in *securityapi.PodSecurityPolicySubjectReviewStatus, out *securityapiv1.PodSecurityPolicySubjectReviewStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.NetNamespace))(in)
+		defaulting.(func(*securityapi.PodSecurityPolicySubjectReviewStatus))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+	if in.AllowedBy != nil {
+		out.AllowedBy = new(pkgapiv1.ObjectReference)
+		if err := convert_api_ObjectReference_To_v1_ObjectReference(in.AllowedBy, out.AllowedBy, s); err != nil {
+			return err
+		}
+	} else {
+		out.AllowedBy = nil
 	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	out.Reason = in.Reason
+	if err := convert_api_PodTemplateSpec_To_v1_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	out.NetName = in.NetName
-	out.NetID = in.NetID
 	return nil
 }
 
-func convert_api_NetNamespace_To_v1_NetNamespace(in *sdnapi.NetNamespace, out *sdnapiv1.NetNamespace, s conversion.Scope) error {
-	return autoconvert_api_NetNamespace_To_v1_NetNamespace(in, out, s)
+func convert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus(in *securityapi.PodSecurityPolicySubjectReviewStatus, out *securityapiv1.PodSecurityPolicySubjectReviewStatus, s conversion.Scope) error {
+	return autoconvert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus(in, out, s)
 }
 
-func autoconvert_api_NetNamespaceList_To_v1_NetNamespaceList(in *sdnapi.NetNamespaceList, out *sdnapiv1.NetNamespaceList, s conversion.Scope) error {
+func autoconvert_api_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus(in *securityapi.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapi.NetNamespaceList))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*securityapi.ServiceAccountPodSecurityPolicyReviewStatus))(in)
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+	if err := convert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus(&in.PodSecurityPolicySubjectReviewStatus, &out.PodSecurityPolicySubjectReviewStatus, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapiv1.NetNamespace, len(in.Items))
-		for i := range in.Items {
-			if err := convert_api_NetNamespace_To_v1_NetNamespace(&in.Items[i], &out.Items[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Name = in.Name
 	return nil
 }
 
-func convert_api_NetNamespaceList_To_v1_NetNamespaceList(in *sdnapi.NetNamespaceList, out *sdnapiv1.NetNamespaceList, s conversion.Scope) error {
-	return autoconvert_api_NetNamespaceList_To_v1_NetNamespaceList(in, out, s)
+func convert_api_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus(in *securityapi.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus(in, out, s)
 }
 
-func autoconvert_v1_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1.ClusterNetwork, out *sdnapi.ClusterNetwork, s conversion.Scope) error {
+func autoconvert_v1_PodSecurityPolicyReview_To_api_PodSecurityPolicyReview(in *securityapiv1.PodSecurityPolicyReview, out *securityapi.PodSecurityPolicyReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.ClusterNetwork))(in)
+		defaulting.(func(*securityapiv1.PodSecurityPolicyReview))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_v1_PodSecurityPolicyReviewSpec_To_api_PodSecurityPolicyReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_PodSecurityPolicyReviewStatus_To_api_PodSecurityPolicyReviewStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Network = in.Network
-	out.HostSubnetLength = in.HostSubnetLength
-	out.ServiceNetwork = in.ServiceNetwork
 	return nil
 }
 
-func convert_v1_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1.ClusterNetwork, out *sdnapi.ClusterNetwork, s conversion.Scope) error {
-	return autoconvert_v1_ClusterNetwork_To_api_ClusterNetwork(in, out, s)
+func convert_v1_PodSecurityPolicyReview_To_api_PodSecurityPolicyReview(in *securityapiv1.PodSecurityPolicyReview, out *securityapi.PodSecurityPolicyReview, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicyReview_To_api_PodSecurityPolicyReview(in, out, s)
 }
 
-func autoconvert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in *sdnapiv1.ClusterNetworkList, out *sdnapi.ClusterNetworkList, s conversion.Scope) error {
+func autoconvert_v1_PodSecurityPolicyReviewSpec_To_api_PodSecurityPolicyReviewSpec(in *securityapiv1.PodSecurityPolicyReviewSpec, out *securityapi.PodSecurityPolicyReviewSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.ClusterNetworkList))(in)
+		defaulting.(func(*securityapiv1.PodSecurityPolicyReviewSpec))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+	if err := convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
-		return err
+	if in.ServiceAccountNames != nil {
+		out.ServiceAccountNames = make([]string, len(in.ServiceAccountNames))
+		for i := range in.ServiceAccountNames {
+			out.ServiceAccountNames[i] = in.ServiceAccountNames[i]
+		}
+	} else {
+		out.ServiceAccountNames = nil
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapi.ClusterNetwork, len(in.Items))
-		for i := range in.Items {
-			if err := convert_v1_ClusterNetwork_To_api_ClusterNetwork(&in.Items[i], &out.Items[i], s); err != nil {
+	return nil
+}
+
+func convert_v1_PodSecurityPolicyReviewSpec_To_api_PodSecurityPolicyReviewSpec(in *securityapiv1.PodSecurityPolicyReviewSpec, out *securityapi.PodSecurityPolicyReviewSpec, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicyReviewSpec_To_api_PodSecurityPolicyReviewSpec(in, out, s)
+}
+
+func autoconvert_v1_PodSecurityPolicyReviewStatus_To_api_PodSecurityPolicyReviewStatus(in *securityapiv1.PodSecurityPolicyReviewStatus, out *securityapi.PodSecurityPolicyReviewStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*securityapiv1.PodSecurityPolicyReviewStatus))(in)
+	}
+	if in.AllowedServiceAccounts != nil {
+		out.AllowedServiceAccounts = make([]securityapi.ServiceAccountPodSecurityPolicyReviewStatus, len(in.AllowedServiceAccounts))
+		for i := range in.AllowedServiceAccounts {
+			if err := convert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_api_ServiceAccountPodSecurityPolicyReviewStatus(&in.AllowedServiceAccounts[i], &out.AllowedServiceAccounts[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Items = nil
+		out.AllowedServiceAccounts = nil
 	}
 	return nil
 }
 
-func convert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in *sdnapiv1.ClusterNetworkList, out *sdnapi.ClusterNetworkList, s conversion.Scope) error {
-	return autoconvert_v1_ClusterNetworkList_To_api_ClusterNetworkList(in, out, s)
+func convert_v1_PodSecurityPolicyReviewStatus_To_api_PodSecurityPolicyReviewStatus(in *securityapiv1.PodSecurityPolicyReviewStatus, out *securityapi.PodSecurityPolicyReviewStatus, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicyReviewStatus_To_api_PodSecurityPolicyReviewStatus(in, out, s)
 }
 
-func autoconvert_v1_HostSubnet_To_api_HostSubnet(in *sdnapiv1.HostSubnet, out *sdnapi.HostSubnet, s conversion.Scope) error {
+func autoconvert_v1_PodSecurityPolicySubjectReview_To_api_PodSecurityPolicySubjectReview(in *securityapiv1.PodSecurityPolicySubjectReview, out *securityapi.PodSecurityPolicySubjectReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.HostSubnet))(in)
+		defaulting.(func(*securityapiv1.PodSecurityPolicySubjectReview))(in)
 	}
 	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
 		return err
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	if err := convert_v1_PodSecurityPolicySubjectReviewSpec_To_api_PodSecurityPolicySubjectReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Host = in.Host
-	out.HostIP = in.HostIP
-	out.Subnet = in.Subnet
 	return nil
 }
 
-func convert_v1_HostSubnet_To_api_HostSubnet(in *sdnapiv1.HostSubnet, out *sdnapi.HostSubnet, s conversion.Scope) error {
-	return autoconvert_v1_HostSubnet_To_api_HostSubnet(in, out, s)
+func convert_v1_PodSecurityPolicySubjectReview_To_api_PodSecurityPolicySubjectReview(in *securityapiv1.PodSecurityPolicySubjectReview, out *securityapi.PodSecurityPolicySubjectReview, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicySubjectReview_To_api_PodSecurityPolicySubjectReview(in, out, s)
 }
 
-func autoconvert_v1_HostSubnetList_To_api_HostSubnetList(in *sdnapiv1.HostSubnetList, out *sdnapi.HostSubnetList, s conversion.Scope) error {
+func autoconvert_v1_PodSecurityPolicySubjectReviewSpec_To_api_PodSecurityPolicySubjectReviewSpec(in *securityapiv1.PodSecurityPolicySubjectReviewSpec, out *securityapi.PodSecurityPolicySubjectReviewSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.HostSubnetList))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*securityapiv1.PodSecurityPolicySubjectReviewSpec))(in)
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+	if err := convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapi.HostSubnet, len(in.Items))
-		for i := range in.Items {
-			if err := convert_v1_HostSubnet_To_api_HostSubnet(&in.Items[i], &out.Items[i], s); err != nil {
-				return err
-			}
+	out.User = in.User
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
 		}
 	} else {
-		out.Items = nil
+		out.Groups = nil
 	}
 	return nil
 }
 
-func convert_v1_HostSubnetList_To_api_HostSubnetList(in *sdnapiv1.HostSubnetList, out *sdnapi.HostSubnetList, s conversion.Scope) error {
-	return autoconvert_v1_HostSubnetList_To_api_HostSubnetList(in, out, s)
+func convert_v1_PodSecurityPolicySubjectReviewSpec_To_api_PodSecurityPolicySubjectReviewSpec(in *securityapiv1.PodSecurityPolicySubjectReviewSpec, out *securityapi.PodSecurityPolicySubjectReviewSpec, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicySubjectReviewSpec_To_api_PodSecurityPolicySubjectReviewSpec(in, out, s)
 }
 
-func autoconvert_v1_NetNamespace_To_api_NetNamespace(in *sdnapiv1.NetNamespace, out *sdnapi.NetNamespace, s conversion.Scope) error {
+func autoconvert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus(in *securityapiv1.PodSecurityPolicySubjectReviewStatus, out *securityapi.PodSecurityPolicySubjectReviewStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.NetNamespace))(in)
+		defaulting.(func(*securityapiv1.PodSecurityPolicySubjectReviewStatus))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+	if in.AllowedBy != nil {
+		out.AllowedBy = new(pkgapi.ObjectReference)
+		if err := convert_v1_ObjectReference_To_api_ObjectReference(in.AllowedBy, out.AllowedBy, s); err != nil {
+			return err
+		}
+	} else {
+		out.AllowedBy = nil
 	}
-	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+	out.Reason = in.Reason
+	if err := convert_v1_PodTemplateSpec_To_api_PodTemplateSpec(&in.Template, &out.Template, s); err != nil {
 		return err
 	}
-	out.NetName = in.NetName
-	out.NetID = in.NetID
 	return nil
 }
 
-func convert_v1_NetNamespace_To_api_NetNamespace(in *sdnapiv1.NetNamespace, out *sdnapi.NetNamespace, s conversion.Scope) error {
-	return autoconvert_v1_NetNamespace_To_api_NetNamespace(in, out, s)
+func convert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus(in *securityapiv1.PodSecurityPolicySubjectReviewStatus, out *securityapi.PodSecurityPolicySubjectReviewStatus, s conversion.Scope) error {
+	return autoconvert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus(in, out, s)
 }
 
-func autoconvert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespaceList, out *sdnapi.NetNamespaceList, s conversion.Scope) error {
+func autoconvert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_api_ServiceAccountPodSecurityPolicyReviewStatus(in *securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapi.ServiceAccountPodSecurityPolicyReviewStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*sdnapiv1.NetNamespaceList))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+		defaulting.(func(*securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus))(in)
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+	if err := convert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus(&in.PodSecurityPolicySubjectReviewStatus, &out.PodSecurityPolicySubjectReviewStatus, s); err != nil {
 		return err
 	}
-	if in.Items != nil {
-		out.Items = make([]sdnapi.NetNamespace, len(in.Items))
-		for i := range in.Items {
-			if err := convert_v1_NetNamespace_To_api_NetNamespace(&in.Items[i], &out.Items[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Name = in.Name
 	return nil
 }
 
-func convert_v1_NetNamespaceList_To_api_NetNamespaceList(in *sdnapiv1.NetNamespaceList, out *sdnapi.NetNamespaceList, s conversion.Scope) error {
-	return autoconvert_v1_NetNamespaceList_To_api_NetNamespaceList(in, out, s)
+func convert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_api_ServiceAccountPodSecurityPolicyReviewStatus(in *securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapi.ServiceAccountPodSecurityPolicyReviewStatus, s conversion.Scope) error {
+	return autoconvert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_api_ServiceAccountPodSecurityPolicyReviewStatus(in, out, s)
 }
 
 func autoconvert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *templateapiv1.Parameter, s conversion.Scope) error {
@@ -5726,6 +7522,14 @@ func autoconvert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *t
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapiv1.ParameterSource)
+		if err := convert_api_ParameterSource_To_v1_ParameterSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
 	return nil
 }
 
@@ -5733,6 +7537,38 @@ func convert_api_Parameter_To_v1_Parameter(in *templateapi.Parameter, out *templ
 	return autoconvert_api_Parameter_To_v1_Parameter(in, out, s)
 }
 
+func autoconvert_api_ParameterSource_To_v1_ParameterSource(in *templateapi.ParameterSource, out *templateapiv1.ParameterSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.ParameterSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapiv1.SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_api_ParameterSource_To_v1_ParameterSource(in *templateapi.ParameterSource, out *templateapiv1.ParameterSource, s conversion.Scope) error {
+	return autoconvert_api_ParameterSource_To_v1_ParameterSource(in, out, s)
+}
+
+func autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in *templateapi.SecretKeySelector, out *templateapiv1.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.SecretKeySelector))(in)
+	}
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1_SecretKeySelector(in *templateapi.SecretKeySelector, out *templateapiv1.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_api_Template_To_v1_Template(in *templateapi.Template, out *templateapiv1.Template, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.Template))(in)
@@ -5798,6 +7634,14 @@ func autoconvert_v1_Parameter_To_api_Parameter(in *templateapiv1.Parameter, out
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapi.ParameterSource)
+		if err := convert_v1_ParameterSource_To_api_ParameterSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
 	return nil
 }
 
@@ -5805,6 +7649,38 @@ func convert_v1_Parameter_To_api_Parameter(in *templateapiv1.Parameter, out *tem
 	return autoconvert_v1_Parameter_To_api_Parameter(in, out, s)
 }
 
+func autoconvert_v1_ParameterSource_To_api_ParameterSource(in *templateapiv1.ParameterSource, out *templateapi.ParameterSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.ParameterSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapi.SecretKeySelector)
+		if err := convert_v1_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_v1_ParameterSource_To_api_ParameterSource(in *templateapiv1.ParameterSource, out *templateapi.ParameterSource, s conversion.Scope) error {
+	return autoconvert_v1_ParameterSource_To_api_ParameterSource(in, out, s)
+}
+
+func autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in *templateapiv1.SecretKeySelector, out *templateapi.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1.SecretKeySelector))(in)
+	}
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1_SecretKeySelector_To_api_SecretKeySelector(in *templateapiv1.SecretKeySelector, out *templateapi.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_v1_Template_To_api_Template(in *templateapiv1.Template, out *templateapi.Template, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1.Template))(in)
@@ -7008,6 +8884,64 @@ func convert_api_RBDVolumeSource_To_v1_RBDVolumeSource(in *pkgapi.RBDVolumeSourc
 	return autoconvert_api_RBDVolumeSource_To_v1_RBDVolumeSource(in, out, s)
 }
 
+func autoconvert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec(in *pkgapi.ResourceQuotaSpec, out *pkgapiv1.ResourceQuotaSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*pkgapi.ResourceQuotaSpec))(in)
+	}
+	if in.Hard != nil {
+		out.Hard = make(pkgapiv1.ResourceList)
+		for key, val := range in.Hard {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Hard[pkgapiv1.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Hard = nil
+	}
+	return nil
+}
+
+func convert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec(in *pkgapi.ResourceQuotaSpec, out *pkgapiv1.ResourceQuotaSpec, s conversion.Scope) error {
+	return autoconvert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec(in, out, s)
+}
+
+func autoconvert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(in *pkgapi.ResourceQuotaStatus, out *pkgapiv1.ResourceQuotaStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*pkgapi.ResourceQuotaStatus))(in)
+	}
+	if in.Hard != nil {
+		out.Hard = make(pkgapiv1.ResourceList)
+		for key, val := range in.Hard {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Hard[pkgapiv1.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Hard = nil
+	}
+	if in.Used != nil {
+		out.Used = make(pkgapiv1.ResourceList)
+		for key, val := range in.Used {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Used[pkgapiv1.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Used = nil
+	}
+	return nil
+}
+
+func convert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(in *pkgapi.ResourceQuotaStatus, out *pkgapiv1.ResourceQuotaStatus, s conversion.Scope) error {
+	return autoconvert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus(in, out, s)
+}
+
 func autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements(in *pkgapi.ResourceRequirements, out *pkgapiv1.ResourceRequirements, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*pkgapi.ResourceRequirements))(in)
@@ -8054,6 +9988,64 @@ func convert_v1_RBDVolumeSource_To_api_RBDVolumeSource(in *pkgapiv1.RBDVolumeSou
 	return autoconvert_v1_RBDVolumeSource_To_api_RBDVolumeSource(in, out, s)
 }
 
+func autoconvert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec(in *pkgapiv1.ResourceQuotaSpec, out *pkgapi.ResourceQuotaSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*pkgapiv1.ResourceQuotaSpec))(in)
+	}
+	if in.Hard != nil {
+		out.Hard = make(pkgapi.ResourceList)
+		for key, val := range in.Hard {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Hard[pkgapi.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Hard = nil
+	}
+	return nil
+}
+
+func convert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec(in *pkgapiv1.ResourceQuotaSpec, out *pkgapi.ResourceQuotaSpec, s conversion.Scope) error {
+	return autoconvert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec(in, out, s)
+}
+
+func autoconvert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus(in *pkgapiv1.ResourceQuotaStatus, out *pkgapi.ResourceQuotaStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*pkgapiv1.ResourceQuotaStatus))(in)
+	}
+	if in.Hard != nil {
+		out.Hard = make(pkgapi.ResourceList)
+		for key, val := range in.Hard {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Hard[pkgapi.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Hard = nil
+	}
+	if in.Used != nil {
+		out.Used = make(pkgapi.ResourceList)
+		for key, val := range in.Used {
+			newVal := resource.Quantity{}
+			if err := s.Convert(&val, &newVal, 0); err != nil {
+				return err
+			}
+			out.Used[pkgapi.ResourceName(key)] = newVal
+		}
+	} else {
+		out.Used = nil
+	}
+	return nil
+}
+
+func convert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus(in *pkgapiv1.ResourceQuotaStatus, out *pkgapi.ResourceQuotaStatus, s conversion.Scope) error {
+	return autoconvert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus(in, out, s)
+}
+
 func autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements(in *pkgapiv1.ResourceRequirements, out *pkgapi.ResourceRequirements, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*pkgapiv1.ResourceRequirements))(in)
@@ -8349,6 +10341,7 @@ func init() {
 		autoconvert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
 		autoconvert_api_BuildConfig_To_v1_BuildConfig,
+		autoconvert_api_BuildHistory_To_v1_BuildHistory,
 		autoconvert_api_BuildList_To_v1_BuildList,
 		autoconvert_api_BuildLogOptions_To_v1_BuildLogOptions,
 		autoconvert_api_BuildLog_To_v1_BuildLog,
@@ -8358,17 +10351,24 @@ func init() {
 		autoconvert_api_BuildSpec_To_v1_BuildSpec,
 		autoconvert_api_BuildStatus_To_v1_BuildStatus,
 		autoconvert_api_BuildStrategy_To_v1_BuildStrategy,
+		autoconvert_api_BuildSummary_To_v1_BuildSummary,
 		autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		autoconvert_api_Build_To_v1_Build,
 		autoconvert_api_Capabilities_To_v1_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
+		autoconvert_api_ClusterNetworkEntry_To_v1_ClusterNetworkEntry,
 		autoconvert_api_ClusterNetworkList_To_v1_ClusterNetworkList,
 		autoconvert_api_ClusterNetwork_To_v1_ClusterNetwork,
 		autoconvert_api_ClusterPolicyBindingList_To_v1_ClusterPolicyBindingList,
 		autoconvert_api_ClusterPolicyBinding_To_v1_ClusterPolicyBinding,
 		autoconvert_api_ClusterPolicyList_To_v1_ClusterPolicyList,
 		autoconvert_api_ClusterPolicy_To_v1_ClusterPolicy,
+		autoconvert_api_ClusterResourceQuotaList_To_v1_ClusterResourceQuotaList,
+		autoconvert_api_ClusterResourceQuotaSelector_To_v1_ClusterResourceQuotaSelector,
+		autoconvert_api_ClusterResourceQuotaSpec_To_v1_ClusterResourceQuotaSpec,
+		autoconvert_api_ClusterResourceQuotaStatus_To_v1_ClusterResourceQuotaStatus,
+		autoconvert_api_ClusterResourceQuota_To_v1_ClusterResourceQuota,
 		autoconvert_api_ClusterRoleBindingList_To_v1_ClusterRoleBindingList,
 		autoconvert_api_ClusterRoleBinding_To_v1_ClusterRoleBinding,
 		autoconvert_api_ClusterRoleList_To_v1_ClusterRoleList,
@@ -8407,6 +10407,7 @@ func init() {
 		autoconvert_api_GitSourceRevision_To_v1_GitSourceRevision,
 		autoconvert_api_GlusterfsVolumeSource_To_v1_GlusterfsVolumeSource,
 		autoconvert_api_GroupList_To_v1_GroupList,
+		autoconvert_api_GroupRestriction_To_v1_GroupRestriction,
 		autoconvert_api_Group_To_v1_Group,
 		autoconvert_api_HTTPGetAction_To_v1_HTTPGetAction,
 		autoconvert_api_Handler_To_v1_Handler,
@@ -8454,8 +10455,18 @@ func init() {
 		autoconvert_api_ObjectFieldSelector_To_v1_ObjectFieldSelector,
 		autoconvert_api_ObjectMeta_To_v1_ObjectMeta,
 		autoconvert_api_ObjectReference_To_v1_ObjectReference,
+		autoconvert_api_ParameterSource_To_v1_ParameterSource,
 		autoconvert_api_Parameter_To_v1_Parameter,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource,
+		autoconvert_api_PodPresetList_To_v1_PodPresetList,
+		autoconvert_api_PodPresetSpec_To_v1_PodPresetSpec,
+		autoconvert_api_PodPreset_To_v1_PodPreset,
+		autoconvert_api_PodSecurityPolicyReviewSpec_To_v1_PodSecurityPolicyReviewSpec,
+		autoconvert_api_PodSecurityPolicyReviewStatus_To_v1_PodSecurityPolicyReviewStatus,
+		autoconvert_api_PodSecurityPolicyReview_To_v1_PodSecurityPolicyReview,
+		autoconvert_api_PodSecurityPolicySubjectReviewSpec_To_v1_PodSecurityPolicySubjectReviewSpec,
+		autoconvert_api_PodSecurityPolicySubjectReviewStatus_To_v1_PodSecurityPolicySubjectReviewStatus,
+		autoconvert_api_PodSecurityPolicySubjectReview_To_v1_PodSecurityPolicySubjectReview,
 		autoconvert_api_PodSpec_To_v1_PodSpec,
 		autoconvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
 		autoconvert_api_PolicyBindingList_To_v1_PolicyBindingList,
@@ -8466,6 +10477,7 @@ func init() {
 		autoconvert_api_Probe_To_v1_Probe,
 		autoconvert_api_ProjectList_To_v1_ProjectList,
 		autoconvert_api_ProjectRequest_To_v1_ProjectRequest,
+		autoconvert_api_ProjectSecurityAllocation_To_v1_ProjectSecurityAllocation,
 		autoconvert_api_ProjectSpec_To_v1_ProjectSpec,
 		autoconvert_api_ProjectStatus_To_v1_ProjectStatus,
 		autoconvert_api_Project_To_v1_Project,
@@ -8475,8 +10487,14 @@ func init() {
 		autoconvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus,
 		autoconvert_api_ResourceAccessReviewResponse_To_v1_ResourceAccessReviewResponse,
 		autoconvert_api_ResourceAccessReview_To_v1_ResourceAccessReview,
+		autoconvert_api_ResourceQuotaSpec_To_v1_ResourceQuotaSpec,
+		autoconvert_api_ResourceQuotaStatusByNamespace_To_v1_ResourceQuotaStatusByNamespace,
+		autoconvert_api_ResourceQuotaStatus_To_v1_ResourceQuotaStatus,
 		autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements,
 		autoconvert_api_RoleBindingList_To_v1_RoleBindingList,
+		autoconvert_api_RoleBindingRestrictionList_To_v1_RoleBindingRestrictionList,
+		autoconvert_api_RoleBindingRestrictionSpec_To_v1_RoleBindingRestrictionSpec,
+		autoconvert_api_RoleBindingRestriction_To_v1_RoleBindingRestriction,
 		autoconvert_api_RoleBinding_To_v1_RoleBinding,
 		autoconvert_api_RoleList_To_v1_RoleList,
 		autoconvert_api_Role_To_v1_Role,
@@ -8488,14 +10506,22 @@ func init() {
 		autoconvert_api_Route_To_v1_Route,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
 		autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource,
+		autoconvert_api_SecretKeySelector_To_v1_SecretKeySelector,
 		autoconvert_api_SecretSpec_To_v1_SecretSpec,
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1_SecurityContext,
+		autoconvert_api_SelfSubjectRulesReviewSpec_To_v1_SelfSubjectRulesReviewSpec,
+		autoconvert_api_SelfSubjectRulesReview_To_v1_SelfSubjectRulesReview,
+		autoconvert_api_ServiceAccountPodSecurityPolicyReviewStatus_To_v1_ServiceAccountPodSecurityPolicyReviewStatus,
+		autoconvert_api_ServiceAccountReference_To_v1_ServiceAccountReference,
+		autoconvert_api_ServiceAccountRestriction_To_v1_ServiceAccountRestriction,
+		autoconvert_api_ServiceAccountTokenRequest_To_v1_ServiceAccountTokenRequest,
 		autoconvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		autoconvert_api_SourceControlUser_To_v1_SourceControlUser,
 		autoconvert_api_SourceRevision_To_v1_SourceRevision,
 		autoconvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse,
 		autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview,
+		autoconvert_api_SubjectRulesReviewStatus_To_v1_SubjectRulesReviewStatus,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
 		autoconvert_api_TLSConfig_To_v1_TLSConfig,
 		autoconvert_api_TagImportPolicy_To_v1_TagImportPolicy,
@@ -8503,10 +10529,12 @@ func init() {
 		autoconvert_api_Template_To_v1_Template,
 		autoconvert_api_UserIdentityMapping_To_v1_UserIdentityMapping,
 		autoconvert_api_UserList_To_v1_UserList,
+		autoconvert_api_UserRestriction_To_v1_UserRestriction,
 		autoconvert_api_User_To_v1_User,
 		autoconvert_api_VolumeMount_To_v1_VolumeMount,
 		autoconvert_api_VolumeSource_To_v1_VolumeSource,
 		autoconvert_api_Volume_To_v1_Volume,
+		autoconvert_api_WebHookTriggerURL_To_v1_WebHookTriggerURL,
 		autoconvert_api_WebHookTrigger_To_v1_WebHookTrigger,
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
@@ -8515,6 +10543,7 @@ func init() {
 		autoconvert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
 		autoconvert_v1_BuildConfig_To_api_BuildConfig,
+		autoconvert_v1_BuildHistory_To_api_BuildHistory,
 		autoconvert_v1_BuildList_To_api_BuildList,
 		autoconvert_v1_BuildLogOptions_To_api_BuildLogOptions,
 		autoconvert_v1_BuildLog_To_api_BuildLog,
@@ -8524,17 +10553,24 @@ func init() {
 		autoconvert_v1_BuildSpec_To_api_BuildSpec,
 		autoconvert_v1_BuildStatus_To_api_BuildStatus,
 		autoconvert_v1_BuildStrategy_To_api_BuildStrategy,
+		autoconvert_v1_BuildSummary_To_api_BuildSummary,
 		autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoconvert_v1_Build_To_api_Build,
 		autoconvert_v1_Capabilities_To_api_Capabilities,
 		autoconvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
+		autoconvert_v1_ClusterNetworkEntry_To_api_ClusterNetworkEntry,
 		autoconvert_v1_ClusterNetworkList_To_api_ClusterNetworkList,
 		autoconvert_v1_ClusterNetwork_To_api_ClusterNetwork,
 		autoconvert_v1_ClusterPolicyBindingList_To_api_ClusterPolicyBindingList,
 		autoconvert_v1_ClusterPolicyBinding_To_api_ClusterPolicyBinding,
 		autoconvert_v1_ClusterPolicyList_To_api_ClusterPolicyList,
 		autoconvert_v1_ClusterPolicy_To_api_ClusterPolicy,
+		autoconvert_v1_ClusterResourceQuotaList_To_api_ClusterResourceQuotaList,
+		autoconvert_v1_ClusterResourceQuotaSelector_To_api_ClusterResourceQuotaSelector,
+		autoconvert_v1_ClusterResourceQuotaSpec_To_api_ClusterResourceQuotaSpec,
+		autoconvert_v1_ClusterResourceQuotaStatus_To_api_ClusterResourceQuotaStatus,
+		autoconvert_v1_ClusterResourceQuota_To_api_ClusterResourceQuota,
 		autoconvert_v1_ClusterRoleBindingList_To_api_ClusterRoleBindingList,
 		autoconvert_v1_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoconvert_v1_ClusterRoleList_To_api_ClusterRoleList,
@@ -8573,6 +10609,7 @@ func init() {
 		autoconvert_v1_GitSourceRevision_To_api_GitSourceRevision,
 		autoconvert_v1_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
 		autoconvert_v1_GroupList_To_api_GroupList,
+		autoconvert_v1_GroupRestriction_To_api_GroupRestriction,
 		autoconvert_v1_Group_To_api_Group,
 		autoconvert_v1_HTTPGetAction_To_api_HTTPGetAction,
 		autoconvert_v1_Handler_To_api_Handler,
@@ -8620,8 +10657,18 @@ func init() {
 		autoconvert_v1_ObjectFieldSelector_To_api_ObjectFieldSelector,
 		autoconvert_v1_ObjectMeta_To_api_ObjectMeta,
 		autoconvert_v1_ObjectReference_To_api_ObjectReference,
+		autoconvert_v1_ParameterSource_To_api_ParameterSource,
 		autoconvert_v1_Parameter_To_api_Parameter,
 		autoconvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoconvert_v1_PodPresetList_To_api_PodPresetList,
+		autoconvert_v1_PodPresetSpec_To_api_PodPresetSpec,
+		autoconvert_v1_PodPreset_To_api_PodPreset,
+		autoconvert_v1_PodSecurityPolicyReviewSpec_To_api_PodSecurityPolicyReviewSpec,
+		autoconvert_v1_PodSecurityPolicyReviewStatus_To_api_PodSecurityPolicyReviewStatus,
+		autoconvert_v1_PodSecurityPolicyReview_To_api_PodSecurityPolicyReview,
+		autoconvert_v1_PodSecurityPolicySubjectReviewSpec_To_api_PodSecurityPolicySubjectReviewSpec,
+		autoconvert_v1_PodSecurityPolicySubjectReviewStatus_To_api_PodSecurityPolicySubjectReviewStatus,
+		autoconvert_v1_PodSecurityPolicySubjectReview_To_api_PodSecurityPolicySubjectReview,
 		autoconvert_v1_PodSpec_To_api_PodSpec,
 		autoconvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoconvert_v1_PolicyBindingList_To_api_PolicyBindingList,
@@ -8632,6 +10679,7 @@ func init() {
 		autoconvert_v1_Probe_To_api_Probe,
 		autoconvert_v1_ProjectList_To_api_ProjectList,
 		autoconvert_v1_ProjectRequest_To_api_ProjectRequest,
+		autoconvert_v1_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation,
 		autoconvert_v1_ProjectSpec_To_api_ProjectSpec,
 		autoconvert_v1_ProjectStatus_To_api_ProjectStatus,
 		autoconvert_v1_Project_To_api_Project,
@@ -8641,8 +10689,14 @@ func init() {
 		autoconvert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus,
 		autoconvert_v1_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoconvert_v1_ResourceAccessReview_To_api_ResourceAccessReview,
+		autoconvert_v1_ResourceQuotaSpec_To_api_ResourceQuotaSpec,
+		autoconvert_v1_ResourceQuotaStatusByNamespace_To_api_ResourceQuotaStatusByNamespace,
+		autoconvert_v1_ResourceQuotaStatus_To_api_ResourceQuotaStatus,
 		autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements,
 		autoconvert_v1_RoleBindingList_To_api_RoleBindingList,
+		autoconvert_v1_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList,
+		autoconvert_v1_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec,
+		autoconvert_v1_RoleBindingRestriction_To_api_RoleBindingRestriction,
 		autoconvert_v1_RoleBinding_To_api_RoleBinding,
 		autoconvert_v1_RoleList_To_api_RoleList,
 		autoconvert_v1_Role_To_api_Role,
@@ -8654,14 +10708,22 @@ func init() {
 		autoconvert_v1_Route_To_api_Route,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
 		autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource,
+		autoconvert_v1_SecretKeySelector_To_api_SecretKeySelector,
 		autoconvert_v1_SecretSpec_To_api_SecretSpec,
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_SecurityContext_To_api_SecurityContext,
+		autoconvert_v1_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec,
+		autoconvert_v1_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview,
+		autoconvert_v1_ServiceAccountPodSecurityPolicyReviewStatus_To_api_ServiceAccountPodSecurityPolicyReviewStatus,
+		autoconvert_v1_ServiceAccountReference_To_api_ServiceAccountReference,
+		autoconvert_v1_ServiceAccountRestriction_To_api_ServiceAccountRestriction,
+		autoconvert_v1_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest,
 		autoconvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoconvert_v1_SourceControlUser_To_api_SourceControlUser,
 		autoconvert_v1_SourceRevision_To_api_SourceRevision,
 		autoconvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview,
+		autoconvert_v1_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
 		autoconvert_v1_TLSConfig_To_api_TLSConfig,
 		autoconvert_v1_TagImportPolicy_To_api_TagImportPolicy,
@@ -8669,10 +10731,12 @@ func init() {
 		autoconvert_v1_Template_To_api_Template,
 		autoconvert_v1_UserIdentityMapping_To_api_UserIdentityMapping,
 		autoconvert_v1_UserList_To_api_UserList,
+		autoconvert_v1_UserRestriction_To_api_UserRestriction,
 		autoconvert_v1_User_To_api_User,
 		autoconvert_v1_VolumeMount_To_api_VolumeMount,
 		autoconvert_v1_VolumeSource_To_api_VolumeSource,
 		autoconvert_v1_Volume_To_api_Volume,
+		autoconvert_v1_WebHookTriggerURL_To_api_WebHookTriggerURL,
 		autoconvert_v1_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
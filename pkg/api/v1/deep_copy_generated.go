@@ -7,9 +7,12 @@ import (
 	deployapiv1 "github.com/openshift/origin/pkg/deploy/api/v1"
 	imageapiv1 "github.com/openshift/origin/pkg/image/api/v1"
 	oauthapiv1 "github.com/openshift/origin/pkg/oauth/api/v1"
+	podpresetapiv1 "github.com/openshift/origin/pkg/podpreset/api/v1"
 	projectapiv1 "github.com/openshift/origin/pkg/project/api/v1"
+	quotaapiv1 "github.com/openshift/origin/pkg/quota/api/v1"
 	routeapiv1 "github.com/openshift/origin/pkg/route/api/v1"
 	sdnapiv1 "github.com/openshift/origin/pkg/sdn/api/v1"
+	securityapiv1 "github.com/openshift/origin/pkg/security/api/v1"
 	templateapiv1 "github.com/openshift/origin/pkg/template/api/v1"
 	userapiv1 "github.com/openshift/origin/pkg/user/api/v1"
 	api "k8s.io/kubernetes/pkg/api"
@@ -30,6 +33,8 @@ func deepCopy_v1_AuthorizationAttributes(in v1.AuthorizationAttributes, out *v1.
 	} else {
 		out.Content = newVal.(runtime.RawExtension)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -165,6 +170,28 @@ func deepCopy_v1_ClusterRole(in v1.ClusterRole, out *v1.ClusterRole, c *conversi
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapiv1.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -263,6 +290,26 @@ func deepCopy_v1_ClusterRoleList(in v1.ClusterRoleList, out *v1.ClusterRoleList,
 	return nil
 }
 
+func deepCopy_v1_GroupRestriction(in v1.GroupRestriction, out *v1.GroupRestriction, c *conversion.Cloner) error {
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_IsPersonalSubjectAccessReview(in v1.IsPersonalSubjectAccessReview, out *v1.IsPersonalSubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -302,6 +349,14 @@ func deepCopy_v1_LocalSubjectAccessReview(in v1.LocalSubjectAccessReview, out *v
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -532,6 +587,7 @@ func deepCopy_v1_ResourceAccessReviewResponse(in v1.ResourceAccessReviewResponse
 	} else {
 		out.GroupsSlice = nil
 	}
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -556,6 +612,28 @@ func deepCopy_v1_Role(in v1.Role, out *v1.Role, c *conversion.Cloner) error {
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapiv1.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -630,6 +708,75 @@ func deepCopy_v1_RoleBindingList(in v1.RoleBindingList, out *v1.RoleBindingList,
 	return nil
 }
 
+func deepCopy_v1_RoleBindingRestriction(in v1.RoleBindingRestriction, out *v1.RoleBindingRestriction, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_RoleBindingRestrictionSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_RoleBindingRestrictionList(in v1.RoleBindingRestrictionList, out *v1.RoleBindingRestrictionList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_RoleBindingRestriction(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_RoleBindingRestrictionSpec(in v1.RoleBindingRestrictionSpec, out *v1.RoleBindingRestrictionSpec, c *conversion.Cloner) error {
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(v1.UserRestriction)
+		if err := deepCopy_v1_UserRestriction(*in.UserRestriction, out.UserRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(v1.GroupRestriction)
+		if err := deepCopy_v1_GroupRestriction(*in.GroupRestriction, out.GroupRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(v1.ServiceAccountRestriction)
+		if err := deepCopy_v1_ServiceAccountRestriction(*in.ServiceAccountRestriction, out.ServiceAccountRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_RoleList(in v1.RoleList, out *v1.RoleList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -654,6 +801,61 @@ func deepCopy_v1_RoleList(in v1.RoleList, out *v1.RoleList, c *conversion.Cloner
 	return nil
 }
 
+func deepCopy_v1_SelfSubjectRulesReview(in v1.SelfSubjectRulesReview, out *v1.SelfSubjectRulesReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_v1_SelfSubjectRulesReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_SubjectRulesReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_SelfSubjectRulesReviewSpec(in v1.SelfSubjectRulesReviewSpec, out *v1.SelfSubjectRulesReviewSpec, c *conversion.Cloner) error {
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ServiceAccountReference(in v1.ServiceAccountReference, out *v1.ServiceAccountReference, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func deepCopy_v1_ServiceAccountRestriction(in v1.ServiceAccountRestriction, out *v1.ServiceAccountRestriction, c *conversion.Cloner) error {
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]v1.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := deepCopy_v1_ServiceAccountReference(in.ServiceAccounts[i], &out.ServiceAccounts[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_SubjectAccessReview(in v1.SubjectAccessReview, out *v1.SubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -672,6 +874,14 @@ func deepCopy_v1_SubjectAccessReview(in v1.SubjectAccessReview, out *v1.SubjectA
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -684,6 +894,60 @@ func deepCopy_v1_SubjectAccessReviewResponse(in v1.SubjectAccessReviewResponse,
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_v1_SubjectRulesReviewStatus(in v1.SubjectRulesReviewStatus, out *v1.SubjectRulesReviewStatus, c *conversion.Cloner) error {
+	if in.Rules != nil {
+		out.Rules = make([]v1.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := deepCopy_v1_PolicyRule(in.Rules[i], &out.Rules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_v1_UserRestriction(in v1.UserRestriction, out *v1.UserRestriction, c *conversion.Cloner) error {
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
 	return nil
 }
 
@@ -710,6 +974,7 @@ func deepCopy_v1_BinaryBuildRequestOptions(in apiv1.BinaryBuildRequestOptions, o
 
 func deepCopy_v1_BinaryBuildSource(in apiv1.BinaryBuildSource, out *apiv1.BinaryBuildSource, c *conversion.Cloner) error {
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -796,6 +1061,40 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 
 func deepCopy_v1_BuildConfigStatus(in apiv1.BuildConfigStatus, out *apiv1.BuildConfigStatus, c *conversion.Cloner) error {
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]apiv1.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := deepCopy_v1_WebHookTriggerURL(in.WebHookURLs[i], &out.WebHookURLs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildHistory(in apiv1.BuildHistory, out *apiv1.BuildHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_BuildSummary(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -869,6 +1168,12 @@ func deepCopy_v1_BuildLogOptions(in apiv1.BuildLogOptions, out *apiv1.BuildLogOp
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -964,6 +1269,7 @@ func deepCopy_v1_BuildRequest(in apiv1.BuildRequest, out *apiv1.BuildRequest, c
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1091,6 +1397,7 @@ func deepCopy_v1_BuildStatus(in apiv1.BuildStatus, out *apiv1.BuildStatus, c *co
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1123,6 +1430,31 @@ func deepCopy_v1_BuildStrategy(in apiv1.BuildStrategy, out *apiv1.BuildStrategy,
 	return nil
 }
 
+func deepCopy_v1_BuildSummary(in apiv1.BuildSummary, out *apiv1.BuildSummary, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
 func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -1376,17 +1708,48 @@ func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigg
 	return nil
 }
 
-func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]pkgapiv1.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
-				return err
-			} else {
-				out.Environment[i] = newVal.(pkgapiv1.EnvVar)
-			}
-		}
+func deepCopy_v1_WebHookTriggerURL(in apiv1.WebHookTriggerURL, out *apiv1.WebHookTriggerURL, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_v1_CanaryCheck(in deployapiv1.CanaryCheck, out *deployapiv1.CanaryCheck, c *conversion.Cloner) error {
+	if in.ExecNewPod != nil {
+		out.ExecNewPod = new(deployapiv1.ExecNewPodHook)
+		if err := deepCopy_v1_ExecNewPodHook(*in.ExecNewPod, out.ExecNewPod, c); err != nil {
+			return err
+		}
+	} else {
+		out.ExecNewPod = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.CanaryHTTPGetAction)
+		if err := deepCopy_v1_CanaryHTTPGetAction(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryHTTPGetAction(in deployapiv1.CanaryHTTPGetAction, out *deployapiv1.CanaryHTTPGetAction, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]pkgapiv1.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if newVal, err := c.DeepCopy(in.Environment[i]); err != nil {
+				return err
+			} else {
+				out.Environment[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
 	} else {
 		out.Environment = nil
 	}
@@ -1605,6 +1968,12 @@ func deepCopy_v1_DeploymentLogOptions(in deployapiv1.DeploymentLogOptions, out *
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -1744,6 +2113,12 @@ func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.Li
 }
 
 func deepCopy_v1_RecreateDeploymentStrategyParams(in deployapiv1.RecreateDeploymentStrategyParams, out *deployapiv1.RecreateDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1752,6 +2127,14 @@ func deepCopy_v1_RecreateDeploymentStrategyParams(in deployapiv1.RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1822,6 +2205,14 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		out.Canary = new(deployapiv1.CanaryCheck)
+		if err := deepCopy_v1_CanaryCheck(*in.Canary, out.Canary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -2204,6 +2595,7 @@ func deepCopy_v1_NamedTagReference(in imageapiv1.NamedTagReference, out *imageap
 	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	out.TrackVersion = in.TrackVersion
 	return nil
 }
 
@@ -2396,6 +2788,14 @@ func deepCopy_v1_OAuthClient(in oauthapiv1.OAuthClient, out *oauthapiv1.OAuthCli
 		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -2405,6 +2805,18 @@ func deepCopy_v1_OAuthClient(in oauthapiv1.OAuthClient, out *oauthapiv1.OAuthCli
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -2481,6 +2893,119 @@ func deepCopy_v1_OAuthClientList(in oauthapiv1.OAuthClientList, out *oauthapiv1.
 	return nil
 }
 
+func deepCopy_v1_ServiceAccountTokenRequest(in oauthapiv1.ServiceAccountTokenRequest, out *oauthapiv1.ServiceAccountTokenRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func deepCopy_v1_PodPreset(in podpresetapiv1.PodPreset, out *podpresetapiv1.PodPreset, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_PodPresetSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_PodPresetList(in podpresetapiv1.PodPresetList, out *podpresetapiv1.PodPresetList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]podpresetapiv1.PodPreset, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_PodPreset(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PodPresetSpec(in podpresetapiv1.PodPresetSpec, out *podpresetapiv1.PodPresetSpec, c *conversion.Cloner) error {
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]pkgapiv1.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if newVal, err := c.DeepCopy(in.Env[i]); err != nil {
+				return err
+			} else {
+				out.Env[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	if in.Volumes != nil {
+		out.Volumes = make([]pkgapiv1.Volume, len(in.Volumes))
+		for i := range in.Volumes {
+			if newVal, err := c.DeepCopy(in.Volumes[i]); err != nil {
+				return err
+			} else {
+				out.Volumes[i] = newVal.(pkgapiv1.Volume)
+			}
+		}
+	} else {
+		out.Volumes = nil
+	}
+	if in.VolumeMounts != nil {
+		out.VolumeMounts = make([]pkgapiv1.VolumeMount, len(in.VolumeMounts))
+		for i := range in.VolumeMounts {
+			if newVal, err := c.DeepCopy(in.VolumeMounts[i]); err != nil {
+				return err
+			} else {
+				out.VolumeMounts[i] = newVal.(pkgapiv1.VolumeMount)
+			}
+		}
+	} else {
+		out.VolumeMounts = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_Project(in projectapiv1.Project, out *projectapiv1.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2541,6 +3066,13 @@ func deepCopy_v1_ProjectRequest(in projectapiv1.ProjectRequest, out *projectapiv
 	return nil
 }
 
+func deepCopy_v1_ProjectSecurityAllocation(in projectapiv1.ProjectSecurityAllocation, out *projectapiv1.ProjectSecurityAllocation, c *conversion.Cloner) error {
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
 func deepCopy_v1_ProjectSpec(in projectapiv1.ProjectSpec, out *projectapiv1.ProjectSpec, c *conversion.Cloner) error {
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
@@ -2555,6 +3087,119 @@ func deepCopy_v1_ProjectSpec(in projectapiv1.ProjectSpec, out *projectapiv1.Proj
 
 func deepCopy_v1_ProjectStatus(in projectapiv1.ProjectStatus, out *projectapiv1.ProjectStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapiv1.ProjectSecurityAllocation)
+		if err := deepCopy_v1_ProjectSecurityAllocation(*in.SecurityAllocation, out.SecurityAllocation, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterResourceQuota(in quotaapiv1.ClusterResourceQuota, out *quotaapiv1.ClusterResourceQuota, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_ClusterResourceQuotaSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_ClusterResourceQuotaStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterResourceQuotaList(in quotaapiv1.ClusterResourceQuotaList, out *quotaapiv1.ClusterResourceQuotaList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]quotaapiv1.ClusterResourceQuota, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_ClusterResourceQuota(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterResourceQuotaSelector(in quotaapiv1.ClusterResourceQuotaSelector, out *quotaapiv1.ClusterResourceQuotaSelector, c *conversion.Cloner) error {
+	if in.LabelSelector != nil {
+		out.LabelSelector = make(map[string]string)
+		for key, val := range in.LabelSelector {
+			out.LabelSelector[key] = val
+		}
+	} else {
+		out.LabelSelector = nil
+	}
+	if in.AnnotationSelector != nil {
+		out.AnnotationSelector = make(map[string]string)
+		for key, val := range in.AnnotationSelector {
+			out.AnnotationSelector[key] = val
+		}
+	} else {
+		out.AnnotationSelector = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterResourceQuotaSpec(in quotaapiv1.ClusterResourceQuotaSpec, out *quotaapiv1.ClusterResourceQuotaSpec, c *conversion.Cloner) error {
+	if err := deepCopy_v1_ClusterResourceQuotaSelector(in.Selector, &out.Selector, c); err != nil {
+		return err
+	}
+	if newVal, err := c.DeepCopy(in.Quota); err != nil {
+		return err
+	} else {
+		out.Quota = newVal.(pkgapiv1.ResourceQuotaSpec)
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterResourceQuotaStatus(in quotaapiv1.ClusterResourceQuotaStatus, out *quotaapiv1.ClusterResourceQuotaStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Total); err != nil {
+		return err
+	} else {
+		out.Total = newVal.(pkgapiv1.ResourceQuotaStatus)
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]quotaapiv1.ResourceQuotaStatusByNamespace, len(in.Namespaces))
+		for i := range in.Namespaces {
+			if err := deepCopy_v1_ResourceQuotaStatusByNamespace(in.Namespaces[i], &out.Namespaces[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ResourceQuotaStatusByNamespace(in quotaapiv1.ResourceQuotaStatusByNamespace, out *quotaapiv1.ResourceQuotaStatusByNamespace, c *conversion.Cloner) error {
+	out.Namespace = in.Namespace
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(pkgapiv1.ResourceQuotaStatus)
+	}
 	return nil
 }
 
@@ -2647,6 +3292,7 @@ func deepCopy_v1_TLSConfig(in routeapiv1.TLSConfig, out *routeapiv1.TLSConfig, c
 	out.Certificate = in.Certificate
 	out.Key = in.Key
 	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
 	out.DestinationCACertificate = in.DestinationCACertificate
 	out.InsecureEdgeTerminationPolicy = in.InsecureEdgeTerminationPolicy
 	return nil
@@ -2666,6 +3312,22 @@ func deepCopy_v1_ClusterNetwork(in sdnapiv1.ClusterNetwork, out *sdnapiv1.Cluste
 	out.Network = in.Network
 	out.HostSubnetLength = in.HostSubnetLength
 	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapiv1.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := deepCopy_v1_ClusterNetworkEntry(in.ClusterNetworks[i], &out.ClusterNetworks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ClusterNetworkEntry(in sdnapiv1.ClusterNetworkEntry, out *sdnapiv1.ClusterNetworkEntry, c *conversion.Cloner) error {
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
 	return nil
 }
 
@@ -2774,6 +3436,112 @@ func deepCopy_v1_NetNamespaceList(in sdnapiv1.NetNamespaceList, out *sdnapiv1.Ne
 	return nil
 }
 
+func deepCopy_v1_PodSecurityPolicyReview(in securityapiv1.PodSecurityPolicyReview, out *securityapiv1.PodSecurityPolicyReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_v1_PodSecurityPolicyReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_PodSecurityPolicyReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_PodSecurityPolicyReviewSpec(in securityapiv1.PodSecurityPolicyReviewSpec, out *securityapiv1.PodSecurityPolicyReviewSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapiv1.PodTemplateSpec)
+	}
+	if in.ServiceAccountNames != nil {
+		out.ServiceAccountNames = make([]string, len(in.ServiceAccountNames))
+		for i := range in.ServiceAccountNames {
+			out.ServiceAccountNames[i] = in.ServiceAccountNames[i]
+		}
+	} else {
+		out.ServiceAccountNames = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PodSecurityPolicyReviewStatus(in securityapiv1.PodSecurityPolicyReviewStatus, out *securityapiv1.PodSecurityPolicyReviewStatus, c *conversion.Cloner) error {
+	if in.AllowedServiceAccounts != nil {
+		out.AllowedServiceAccounts = make([]securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, len(in.AllowedServiceAccounts))
+		for i := range in.AllowedServiceAccounts {
+			if err := deepCopy_v1_ServiceAccountPodSecurityPolicyReviewStatus(in.AllowedServiceAccounts[i], &out.AllowedServiceAccounts[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllowedServiceAccounts = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PodSecurityPolicySubjectReview(in securityapiv1.PodSecurityPolicySubjectReview, out *securityapiv1.PodSecurityPolicySubjectReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_v1_PodSecurityPolicySubjectReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_PodSecurityPolicySubjectReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_PodSecurityPolicySubjectReviewSpec(in securityapiv1.PodSecurityPolicySubjectReviewSpec, out *securityapiv1.PodSecurityPolicySubjectReviewSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapiv1.PodTemplateSpec)
+	}
+	out.User = in.User
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PodSecurityPolicySubjectReviewStatus(in securityapiv1.PodSecurityPolicySubjectReviewStatus, out *securityapiv1.PodSecurityPolicySubjectReviewStatus, c *conversion.Cloner) error {
+	if in.AllowedBy != nil {
+		if newVal, err := c.DeepCopy(in.AllowedBy); err != nil {
+			return err
+		} else {
+			out.AllowedBy = newVal.(*pkgapiv1.ObjectReference)
+		}
+	} else {
+		out.AllowedBy = nil
+	}
+	out.Reason = in.Reason
+	if newVal, err := c.DeepCopy(in.Template); err != nil {
+		return err
+	} else {
+		out.Template = newVal.(pkgapiv1.PodTemplateSpec)
+	}
+	return nil
+}
+
+func deepCopy_v1_ServiceAccountPodSecurityPolicyReviewStatus(in securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, out *securityapiv1.ServiceAccountPodSecurityPolicyReviewStatus, c *conversion.Cloner) error {
+	if err := deepCopy_v1_PodSecurityPolicySubjectReviewStatus(in.PodSecurityPolicySubjectReviewStatus, &out.PodSecurityPolicySubjectReviewStatus, c); err != nil {
+		return err
+	}
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_v1_Parameter(in templateapiv1.Parameter, out *templateapiv1.Parameter, c *conversion.Cloner) error {
 	out.Name = in.Name
 	out.DisplayName = in.DisplayName
@@ -2782,6 +3550,32 @@ func deepCopy_v1_Parameter(in templateapiv1.Parameter, out *templateapiv1.Parame
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapiv1.ParameterSource)
+		if err := deepCopy_v1_ParameterSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ParameterSource(in templateapiv1.ParameterSource, out *templateapiv1.ParameterSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapiv1.SecretKeySelector)
+		if err := deepCopy_v1_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_SecretKeySelector(in templateapiv1.SecretKeySelector, out *templateapiv1.SecretKeySelector, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Key = in.Key
 	return nil
 }
 
@@ -3042,6 +3836,7 @@ func init() {
 		deepCopy_v1_ClusterRoleBinding,
 		deepCopy_v1_ClusterRoleBindingList,
 		deepCopy_v1_ClusterRoleList,
+		deepCopy_v1_GroupRestriction,
 		deepCopy_v1_IsPersonalSubjectAccessReview,
 		deepCopy_v1_LocalResourceAccessReview,
 		deepCopy_v1_LocalSubjectAccessReview,
@@ -3059,9 +3854,18 @@ func init() {
 		deepCopy_v1_Role,
 		deepCopy_v1_RoleBinding,
 		deepCopy_v1_RoleBindingList,
+		deepCopy_v1_RoleBindingRestriction,
+		deepCopy_v1_RoleBindingRestrictionList,
+		deepCopy_v1_RoleBindingRestrictionSpec,
 		deepCopy_v1_RoleList,
+		deepCopy_v1_SelfSubjectRulesReview,
+		deepCopy_v1_SelfSubjectRulesReviewSpec,
+		deepCopy_v1_ServiceAccountReference,
+		deepCopy_v1_ServiceAccountRestriction,
 		deepCopy_v1_SubjectAccessReview,
 		deepCopy_v1_SubjectAccessReviewResponse,
+		deepCopy_v1_SubjectRulesReviewStatus,
+		deepCopy_v1_UserRestriction,
 		deepCopy_v1_BinaryBuildRequestOptions,
 		deepCopy_v1_BinaryBuildSource,
 		deepCopy_v1_Build,
@@ -3069,6 +3873,7 @@ func init() {
 		deepCopy_v1_BuildConfigList,
 		deepCopy_v1_BuildConfigSpec,
 		deepCopy_v1_BuildConfigStatus,
+		deepCopy_v1_BuildHistory,
 		deepCopy_v1_BuildList,
 		deepCopy_v1_BuildLog,
 		deepCopy_v1_BuildLogOptions,
@@ -3078,6 +3883,7 @@ func init() {
 		deepCopy_v1_BuildSpec,
 		deepCopy_v1_BuildStatus,
 		deepCopy_v1_BuildStrategy,
+		deepCopy_v1_BuildSummary,
 		deepCopy_v1_BuildTriggerPolicy,
 		deepCopy_v1_CustomBuildStrategy,
 		deepCopy_v1_DockerBuildStrategy,
@@ -3092,6 +3898,9 @@ func init() {
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_WebHookTriggerURL,
+		deepCopy_v1_CanaryCheck,
+		deepCopy_v1_CanaryHTTPGetAction,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
@@ -3142,11 +3951,22 @@ func init() {
 		deepCopy_v1_OAuthClientAuthorization,
 		deepCopy_v1_OAuthClientAuthorizationList,
 		deepCopy_v1_OAuthClientList,
+		deepCopy_v1_ServiceAccountTokenRequest,
+		deepCopy_v1_PodPreset,
+		deepCopy_v1_PodPresetList,
+		deepCopy_v1_PodPresetSpec,
 		deepCopy_v1_Project,
 		deepCopy_v1_ProjectList,
 		deepCopy_v1_ProjectRequest,
+		deepCopy_v1_ProjectSecurityAllocation,
 		deepCopy_v1_ProjectSpec,
 		deepCopy_v1_ProjectStatus,
+		deepCopy_v1_ClusterResourceQuota,
+		deepCopy_v1_ClusterResourceQuotaList,
+		deepCopy_v1_ClusterResourceQuotaSelector,
+		deepCopy_v1_ClusterResourceQuotaSpec,
+		deepCopy_v1_ClusterResourceQuotaStatus,
+		deepCopy_v1_ResourceQuotaStatusByNamespace,
 		deepCopy_v1_Route,
 		deepCopy_v1_RouteList,
 		deepCopy_v1_RoutePort,
@@ -3154,12 +3974,22 @@ func init() {
 		deepCopy_v1_RouteStatus,
 		deepCopy_v1_TLSConfig,
 		deepCopy_v1_ClusterNetwork,
+		deepCopy_v1_ClusterNetworkEntry,
 		deepCopy_v1_ClusterNetworkList,
 		deepCopy_v1_HostSubnet,
 		deepCopy_v1_HostSubnetList,
 		deepCopy_v1_NetNamespace,
 		deepCopy_v1_NetNamespaceList,
+		deepCopy_v1_PodSecurityPolicyReview,
+		deepCopy_v1_PodSecurityPolicyReviewSpec,
+		deepCopy_v1_PodSecurityPolicyReviewStatus,
+		deepCopy_v1_PodSecurityPolicySubjectReview,
+		deepCopy_v1_PodSecurityPolicySubjectReviewSpec,
+		deepCopy_v1_PodSecurityPolicySubjectReviewStatus,
+		deepCopy_v1_ServiceAccountPodSecurityPolicyReviewStatus,
 		deepCopy_v1_Parameter,
+		deepCopy_v1_ParameterSource,
+		deepCopy_v1_SecretKeySelector,
 		deepCopy_v1_Template,
 		deepCopy_v1_TemplateList,
 		deepCopy_v1_Group,
@@ -10,9 +10,12 @@ import (
 	_ "github.com/openshift/origin/pkg/deploy/api/v1"
 	_ "github.com/openshift/origin/pkg/image/api/v1"
 	_ "github.com/openshift/origin/pkg/oauth/api/v1"
+	_ "github.com/openshift/origin/pkg/podpreset/api/v1"
 	_ "github.com/openshift/origin/pkg/project/api/v1"
+	_ "github.com/openshift/origin/pkg/quota/api/v1"
 	_ "github.com/openshift/origin/pkg/route/api/v1"
 	_ "github.com/openshift/origin/pkg/sdn/api/v1"
+	_ "github.com/openshift/origin/pkg/security/api/v1"
 	_ "github.com/openshift/origin/pkg/template/api/v1"
 	_ "github.com/openshift/origin/pkg/user/api/v1"
 )
@@ -148,6 +148,26 @@ func autoconvert_api_ClusterRole_To_v1beta3_ClusterRole(in *api.ClusterRole, out
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1beta3.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -235,6 +255,33 @@ func convert_api_ClusterRoleList_To_v1beta3_ClusterRoleList(in *api.ClusterRoleL
 	return autoconvert_api_ClusterRoleList_To_v1beta3_ClusterRoleList(in, out, s)
 }
 
+func autoconvert_api_GroupRestriction_To_v1beta3_GroupRestriction(in *api.GroupRestriction, out *v1beta3.GroupRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.GroupRestriction))(in)
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_api_GroupRestriction_To_v1beta3_GroupRestriction(in *api.GroupRestriction, out *v1beta3.GroupRestriction, s conversion.Scope) error {
+	return autoconvert_api_GroupRestriction_To_v1beta3_GroupRestriction(in, out, s)
+}
+
 func autoconvert_api_IsPersonalSubjectAccessReview_To_v1beta3_IsPersonalSubjectAccessReview(in *api.IsPersonalSubjectAccessReview, out *v1beta3.IsPersonalSubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.IsPersonalSubjectAccessReview))(in)
@@ -270,6 +317,14 @@ func autoconvert_api_LocalSubjectAccessReview_To_v1beta3_LocalSubjectAccessRevie
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -411,6 +466,7 @@ func autoconvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessRevie
 	out.Namespace = in.Namespace
 	// in.Users has no peer in out
 	// in.Groups has no peer in out
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -434,6 +490,26 @@ func autoconvert_api_Role_To_v1beta3_Role(in *api.Role, out *v1beta3.Role, s con
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1beta3.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -494,6 +570,88 @@ func convert_api_RoleBindingList_To_v1beta3_RoleBindingList(in *api.RoleBindingL
 	return autoconvert_api_RoleBindingList_To_v1beta3_RoleBindingList(in, out, s)
 }
 
+func autoconvert_api_RoleBindingRestriction_To_v1beta3_RoleBindingRestriction(in *api.RoleBindingRestriction, out *v1beta3.RoleBindingRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestriction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_RoleBindingRestrictionSpec_To_v1beta3_RoleBindingRestrictionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestriction_To_v1beta3_RoleBindingRestriction(in *api.RoleBindingRestriction, out *v1beta3.RoleBindingRestriction, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestriction_To_v1beta3_RoleBindingRestriction(in, out, s)
+}
+
+func autoconvert_api_RoleBindingRestrictionList_To_v1beta3_RoleBindingRestrictionList(in *api.RoleBindingRestrictionList, out *v1beta3.RoleBindingRestrictionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestrictionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]v1beta3.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_RoleBindingRestriction_To_v1beta3_RoleBindingRestriction(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestrictionList_To_v1beta3_RoleBindingRestrictionList(in *api.RoleBindingRestrictionList, out *v1beta3.RoleBindingRestrictionList, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestrictionList_To_v1beta3_RoleBindingRestrictionList(in, out, s)
+}
+
+func autoconvert_api_RoleBindingRestrictionSpec_To_v1beta3_RoleBindingRestrictionSpec(in *api.RoleBindingRestrictionSpec, out *v1beta3.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.RoleBindingRestrictionSpec))(in)
+	}
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(v1beta3.UserRestriction)
+		if err := convert_api_UserRestriction_To_v1beta3_UserRestriction(in.UserRestriction, out.UserRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(v1beta3.GroupRestriction)
+		if err := convert_api_GroupRestriction_To_v1beta3_GroupRestriction(in.GroupRestriction, out.GroupRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(v1beta3.ServiceAccountRestriction)
+		if err := convert_api_ServiceAccountRestriction_To_v1beta3_ServiceAccountRestriction(in.ServiceAccountRestriction, out.ServiceAccountRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
+func convert_api_RoleBindingRestrictionSpec_To_v1beta3_RoleBindingRestrictionSpec(in *api.RoleBindingRestrictionSpec, out *v1beta3.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	return autoconvert_api_RoleBindingRestrictionSpec_To_v1beta3_RoleBindingRestrictionSpec(in, out, s)
+}
+
 func autoconvert_api_RoleList_To_v1beta3_RoleList(in *api.RoleList, out *v1beta3.RoleList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.RoleList))(in)
@@ -521,6 +679,87 @@ func convert_api_RoleList_To_v1beta3_RoleList(in *api.RoleList, out *v1beta3.Rol
 	return autoconvert_api_RoleList_To_v1beta3_RoleList(in, out, s)
 }
 
+func autoconvert_api_SelfSubjectRulesReview_To_v1beta3_SelfSubjectRulesReview(in *api.SelfSubjectRulesReview, out *v1beta3.SelfSubjectRulesReview, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SelfSubjectRulesReview))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_SelfSubjectRulesReviewSpec_To_v1beta3_SelfSubjectRulesReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_SubjectRulesReviewStatus_To_v1beta3_SubjectRulesReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_SelfSubjectRulesReview_To_v1beta3_SelfSubjectRulesReview(in *api.SelfSubjectRulesReview, out *v1beta3.SelfSubjectRulesReview, s conversion.Scope) error {
+	return autoconvert_api_SelfSubjectRulesReview_To_v1beta3_SelfSubjectRulesReview(in, out, s)
+}
+
+func autoconvert_api_SelfSubjectRulesReviewSpec_To_v1beta3_SelfSubjectRulesReviewSpec(in *api.SelfSubjectRulesReviewSpec, out *v1beta3.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SelfSubjectRulesReviewSpec))(in)
+	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func convert_api_SelfSubjectRulesReviewSpec_To_v1beta3_SelfSubjectRulesReviewSpec(in *api.SelfSubjectRulesReviewSpec, out *v1beta3.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	return autoconvert_api_SelfSubjectRulesReviewSpec_To_v1beta3_SelfSubjectRulesReviewSpec(in, out, s)
+}
+
+func autoconvert_api_ServiceAccountReference_To_v1beta3_ServiceAccountReference(in *api.ServiceAccountReference, out *v1beta3.ServiceAccountReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ServiceAccountReference))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_api_ServiceAccountReference_To_v1beta3_ServiceAccountReference(in *api.ServiceAccountReference, out *v1beta3.ServiceAccountReference, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountReference_To_v1beta3_ServiceAccountReference(in, out, s)
+}
+
+func autoconvert_api_ServiceAccountRestriction_To_v1beta3_ServiceAccountRestriction(in *api.ServiceAccountRestriction, out *v1beta3.ServiceAccountRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.ServiceAccountRestriction))(in)
+	}
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]v1beta3.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := convert_api_ServiceAccountReference_To_v1beta3_ServiceAccountReference(&in.ServiceAccounts[i], &out.ServiceAccounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_api_ServiceAccountRestriction_To_v1beta3_ServiceAccountRestriction(in *api.ServiceAccountRestriction, out *v1beta3.ServiceAccountRestriction, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountRestriction_To_v1beta3_ServiceAccountRestriction(in, out, s)
+}
+
 func autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview(in *api.SubjectAccessReview, out *v1beta3.SubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*api.SubjectAccessReview))(in)
@@ -531,6 +770,14 @@ func autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview(in *api.
 	// in.Action has no peer in out
 	out.User = in.User
 	// in.Groups has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -544,6 +791,7 @@ func autoconvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewR
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -551,6 +799,73 @@ func convert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewRespo
 	return autoconvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse(in, out, s)
 }
 
+func autoconvert_api_SubjectRulesReviewStatus_To_v1beta3_SubjectRulesReviewStatus(in *api.SubjectRulesReviewStatus, out *v1beta3.SubjectRulesReviewStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.SubjectRulesReviewStatus))(in)
+	}
+	if in.Rules != nil {
+		out.Rules = make([]v1beta3.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := s.Convert(&in.Rules[i], &out.Rules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func convert_api_SubjectRulesReviewStatus_To_v1beta3_SubjectRulesReviewStatus(in *api.SubjectRulesReviewStatus, out *v1beta3.SubjectRulesReviewStatus, s conversion.Scope) error {
+	return autoconvert_api_SubjectRulesReviewStatus_To_v1beta3_SubjectRulesReviewStatus(in, out, s)
+}
+
+func autoconvert_api_UserRestriction_To_v1beta3_UserRestriction(in *api.UserRestriction, out *v1beta3.UserRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*api.UserRestriction))(in)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_api_UserRestriction_To_v1beta3_UserRestriction(in *api.UserRestriction, out *v1beta3.UserRestriction, s conversion.Scope) error {
+	return autoconvert_api_UserRestriction_To_v1beta3_UserRestriction(in, out, s)
+}
+
 func autoconvert_v1beta3_ClusterPolicy_To_api_ClusterPolicy(in *v1beta3.ClusterPolicy, out *api.ClusterPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.ClusterPolicy))(in)
@@ -670,6 +985,26 @@ func autoconvert_v1beta3_ClusterRole_To_api_ClusterRole(in *v1beta3.ClusterRole,
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -759,6 +1094,33 @@ func convert_v1beta3_ClusterRoleList_To_api_ClusterRoleList(in *v1beta3.ClusterR
 	return autoconvert_v1beta3_ClusterRoleList_To_api_ClusterRoleList(in, out, s)
 }
 
+func autoconvert_v1beta3_GroupRestriction_To_api_GroupRestriction(in *v1beta3.GroupRestriction, out *api.GroupRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.GroupRestriction))(in)
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_GroupRestriction_To_api_GroupRestriction(in *v1beta3.GroupRestriction, out *api.GroupRestriction, s conversion.Scope) error {
+	return autoconvert_v1beta3_GroupRestriction_To_api_GroupRestriction(in, out, s)
+}
+
 func autoconvert_v1beta3_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview(in *v1beta3.IsPersonalSubjectAccessReview, out *api.IsPersonalSubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.IsPersonalSubjectAccessReview))(in)
@@ -794,6 +1156,14 @@ func autoconvert_v1beta3_LocalSubjectAccessReview_To_api_LocalSubjectAccessRevie
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -936,6 +1306,7 @@ func autoconvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessRevie
 	out.Namespace = in.Namespace
 	// in.UsersSlice has no peer in out
 	// in.GroupsSlice has no peer in out
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -959,6 +1330,26 @@ func autoconvert_v1beta3_Role_To_api_Role(in *v1beta3.Role, out *api.Role, s con
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapi.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.IncludedRoles[i], &out.IncludedRoles[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -1021,6 +1412,88 @@ func convert_v1beta3_RoleBindingList_To_api_RoleBindingList(in *v1beta3.RoleBind
 	return autoconvert_v1beta3_RoleBindingList_To_api_RoleBindingList(in, out, s)
 }
 
+func autoconvert_v1beta3_RoleBindingRestriction_To_api_RoleBindingRestriction(in *v1beta3.RoleBindingRestriction, out *api.RoleBindingRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.RoleBindingRestriction))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_RoleBindingRestriction_To_api_RoleBindingRestriction(in *v1beta3.RoleBindingRestriction, out *api.RoleBindingRestriction, s conversion.Scope) error {
+	return autoconvert_v1beta3_RoleBindingRestriction_To_api_RoleBindingRestriction(in, out, s)
+}
+
+func autoconvert_v1beta3_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in *v1beta3.RoleBindingRestrictionList, out *api.RoleBindingRestrictionList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.RoleBindingRestrictionList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]api.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta3_RoleBindingRestriction_To_api_RoleBindingRestriction(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in *v1beta3.RoleBindingRestrictionList, out *api.RoleBindingRestrictionList, s conversion.Scope) error {
+	return autoconvert_v1beta3_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList(in, out, s)
+}
+
+func autoconvert_v1beta3_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in *v1beta3.RoleBindingRestrictionSpec, out *api.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.RoleBindingRestrictionSpec))(in)
+	}
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(api.UserRestriction)
+		if err := convert_v1beta3_UserRestriction_To_api_UserRestriction(in.UserRestriction, out.UserRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(api.GroupRestriction)
+		if err := convert_v1beta3_GroupRestriction_To_api_GroupRestriction(in.GroupRestriction, out.GroupRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(api.ServiceAccountRestriction)
+		if err := convert_v1beta3_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in.ServiceAccountRestriction, out.ServiceAccountRestriction, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in *v1beta3.RoleBindingRestrictionSpec, out *api.RoleBindingRestrictionSpec, s conversion.Scope) error {
+	return autoconvert_v1beta3_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec(in, out, s)
+}
+
 func autoconvert_v1beta3_RoleList_To_api_RoleList(in *v1beta3.RoleList, out *api.RoleList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.RoleList))(in)
@@ -1048,6 +1521,87 @@ func convert_v1beta3_RoleList_To_api_RoleList(in *v1beta3.RoleList, out *api.Rol
 	return autoconvert_v1beta3_RoleList_To_api_RoleList(in, out, s)
 }
 
+func autoconvert_v1beta3_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in *v1beta3.SelfSubjectRulesReview, out *api.SelfSubjectRulesReview, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SelfSubjectRulesReview))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in *v1beta3.SelfSubjectRulesReview, out *api.SelfSubjectRulesReview, s conversion.Scope) error {
+	return autoconvert_v1beta3_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview(in, out, s)
+}
+
+func autoconvert_v1beta3_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in *v1beta3.SelfSubjectRulesReviewSpec, out *api.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SelfSubjectRulesReviewSpec))(in)
+	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in *v1beta3.SelfSubjectRulesReviewSpec, out *api.SelfSubjectRulesReviewSpec, s conversion.Scope) error {
+	return autoconvert_v1beta3_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec(in, out, s)
+}
+
+func autoconvert_v1beta3_ServiceAccountReference_To_api_ServiceAccountReference(in *v1beta3.ServiceAccountReference, out *api.ServiceAccountReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.ServiceAccountReference))(in)
+	}
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func convert_v1beta3_ServiceAccountReference_To_api_ServiceAccountReference(in *v1beta3.ServiceAccountReference, out *api.ServiceAccountReference, s conversion.Scope) error {
+	return autoconvert_v1beta3_ServiceAccountReference_To_api_ServiceAccountReference(in, out, s)
+}
+
+func autoconvert_v1beta3_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in *v1beta3.ServiceAccountRestriction, out *api.ServiceAccountRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.ServiceAccountRestriction))(in)
+	}
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]api.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := convert_v1beta3_ServiceAccountReference_To_api_ServiceAccountReference(&in.ServiceAccounts[i], &out.ServiceAccounts[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in *v1beta3.ServiceAccountRestriction, out *api.ServiceAccountRestriction, s conversion.Scope) error {
+	return autoconvert_v1beta3_ServiceAccountRestriction_To_api_ServiceAccountRestriction(in, out, s)
+}
+
 func autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview(in *v1beta3.SubjectAccessReview, out *api.SubjectAccessReview, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.SubjectAccessReview))(in)
@@ -1058,6 +1612,14 @@ func autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview(in *v1be
 	// in.AuthorizationAttributes has no peer in out
 	out.User = in.User
 	// in.GroupsSlice has no peer in out
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -1071,6 +1633,7 @@ func autoconvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewR
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -1078,6 +1641,73 @@ func convert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewRespo
 	return autoconvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse(in, out, s)
 }
 
+func autoconvert_v1beta3_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in *v1beta3.SubjectRulesReviewStatus, out *api.SubjectRulesReviewStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SubjectRulesReviewStatus))(in)
+	}
+	if in.Rules != nil {
+		out.Rules = make([]api.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := s.Convert(&in.Rules[i], &out.Rules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]api.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := s.Convert(&in.DenyRules[i], &out.DenyRules[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func convert_v1beta3_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in *v1beta3.SubjectRulesReviewStatus, out *api.SubjectRulesReviewStatus, s conversion.Scope) error {
+	return autoconvert_v1beta3_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus(in, out, s)
+}
+
+func autoconvert_v1beta3_UserRestriction_To_api_UserRestriction(in *v1beta3.UserRestriction, out *api.UserRestriction, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.UserRestriction))(in)
+	}
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_UserRestriction_To_api_UserRestriction(in *v1beta3.UserRestriction, out *api.UserRestriction, s conversion.Scope) error {
+	return autoconvert_v1beta3_UserRestriction_To_api_UserRestriction(in, out, s)
+}
+
 func autoconvert_api_BinaryBuildRequestOptions_To_v1beta3_BinaryBuildRequestOptions(in *buildapi.BinaryBuildRequestOptions, out *apiv1beta3.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BinaryBuildRequestOptions))(in)
@@ -1107,6 +1737,7 @@ func autoconvert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource(in *buildapi
 		defaulting.(func(*buildapi.BinaryBuildSource))(in)
 	}
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -1216,6 +1847,16 @@ func autoconvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus(in *buildapi
 		defaulting.(func(*buildapi.BuildConfigStatus))(in)
 	}
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]apiv1beta3.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := convert_api_WebHookTriggerURL_To_v1beta3_WebHookTriggerURL(&in.WebHookURLs[i], &out.WebHookURLs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
 	return nil
 }
 
@@ -1223,6 +1864,33 @@ func convert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus(in *buildapi.Bui
 	return autoconvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus(in, out, s)
 }
 
+func autoconvert_api_BuildHistory_To_v1beta3_BuildHistory(in *buildapi.BuildHistory, out *apiv1beta3.BuildHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildHistory))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1beta3.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := convert_api_BuildSummary_To_v1beta3_BuildSummary(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_api_BuildHistory_To_v1beta3_BuildHistory(in *buildapi.BuildHistory, out *apiv1beta3.BuildHistory, s conversion.Scope) error {
+	return autoconvert_api_BuildHistory_To_v1beta3_BuildHistory(in, out, s)
+}
+
 func autoconvert_api_BuildList_To_v1beta3_BuildList(in *buildapi.BuildList, out *apiv1beta3.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildList))(in)
@@ -1300,6 +1968,12 @@ func autoconvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions(in *buildapi.Bui
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -1394,6 +2068,7 @@ func autoconvert_api_BuildRequest_To_v1beta3_BuildRequest(in *buildapi.BuildRequ
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1528,6 +2203,7 @@ func autoconvert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1563,6 +2239,34 @@ func autoconvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildSt
 	return nil
 }
 
+func autoconvert_api_BuildSummary_To_v1beta3_BuildSummary(in *buildapi.BuildSummary, out *apiv1beta3.BuildSummary, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildSummary))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1beta3.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
+func convert_api_BuildSummary_To_v1beta3_BuildSummary(in *buildapi.BuildSummary, out *apiv1beta3.BuildSummary, s conversion.Scope) error {
+	return autoconvert_api_BuildSummary_To_v1beta3_BuildSummary(in, out, s)
+}
+
 func autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *buildapi.BuildTriggerPolicy, out *apiv1beta3.BuildTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildTriggerPolicy))(in)
@@ -1863,12 +2567,25 @@ func autoconvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHo
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
-	out.Secret = in.Secret
+	out.Secret = in.Secret
+	return nil
+}
+
+func convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1beta3.WebHookTrigger, s conversion.Scope) error {
+	return autoconvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in, out, s)
+}
+
+func autoconvert_api_WebHookTriggerURL_To_v1beta3_WebHookTriggerURL(in *buildapi.WebHookTriggerURL, out *apiv1beta3.WebHookTriggerURL, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebHookTriggerURL))(in)
+	}
+	out.Type = apiv1beta3.BuildTriggerType(in.Type)
+	out.URL = in.URL
 	return nil
 }
 
-func convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *apiv1beta3.WebHookTrigger, s conversion.Scope) error {
-	return autoconvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in, out, s)
+func convert_api_WebHookTriggerURL_To_v1beta3_WebHookTriggerURL(in *buildapi.WebHookTriggerURL, out *apiv1beta3.WebHookTriggerURL, s conversion.Scope) error {
+	return autoconvert_api_WebHookTriggerURL_To_v1beta3_WebHookTriggerURL(in, out, s)
 }
 
 func autoconvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions(in *apiv1beta3.BinaryBuildRequestOptions, out *buildapi.BinaryBuildRequestOptions, s conversion.Scope) error {
@@ -1900,6 +2617,7 @@ func autoconvert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource(in *apiv1bet
 		defaulting.(func(*apiv1beta3.BinaryBuildSource))(in)
 	}
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -2009,6 +2727,16 @@ func autoconvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1bet
 		defaulting.(func(*apiv1beta3.BuildConfigStatus))(in)
 	}
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]buildapi.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := convert_v1beta3_WebHookTriggerURL_To_api_WebHookTriggerURL(&in.WebHookURLs[i], &out.WebHookURLs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
 	return nil
 }
 
@@ -2016,6 +2744,33 @@ func convert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(in *apiv1beta3.B
 	return autoconvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus(in, out, s)
 }
 
+func autoconvert_v1beta3_BuildHistory_To_api_BuildHistory(in *apiv1beta3.BuildHistory, out *buildapi.BuildHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildHistory))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]buildapi.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := convert_v1beta3_BuildSummary_To_api_BuildSummary(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BuildHistory_To_api_BuildHistory(in *apiv1beta3.BuildHistory, out *buildapi.BuildHistory, s conversion.Scope) error {
+	return autoconvert_v1beta3_BuildHistory_To_api_BuildHistory(in, out, s)
+}
+
 func autoconvert_v1beta3_BuildList_To_api_BuildList(in *apiv1beta3.BuildList, out *buildapi.BuildList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.BuildList))(in)
@@ -2093,6 +2848,12 @@ func autoconvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in *apiv1beta3.B
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -2187,6 +2948,7 @@ func autoconvert_v1beta3_BuildRequest_To_api_BuildRequest(in *apiv1beta3.BuildRe
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -2322,6 +3084,7 @@ func autoconvert_v1beta3_BuildStatus_To_api_BuildStatus(in *apiv1beta3.BuildStat
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -2358,6 +3121,34 @@ func autoconvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *apiv1beta3.Build
 	return nil
 }
 
+func autoconvert_v1beta3_BuildSummary_To_api_BuildSummary(in *apiv1beta3.BuildSummary, out *buildapi.BuildSummary, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.BuildSummary))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	if in.StartTimestamp != nil {
+		if err := s.Convert(&in.StartTimestamp, &out.StartTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if err := s.Convert(&in.CompletionTimestamp, &out.CompletionTimestamp, 0); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
+func convert_v1beta3_BuildSummary_To_api_BuildSummary(in *apiv1beta3.BuildSummary, out *buildapi.BuildSummary, s conversion.Scope) error {
+	return autoconvert_v1beta3_BuildSummary_To_api_BuildSummary(in, out, s)
+}
+
 func autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1beta3.BuildTriggerPolicy, out *buildapi.BuildTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.BuildTriggerPolicy))(in)
@@ -2667,6 +3458,19 @@ func convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *apiv1beta3.WebHook
 	return autoconvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_v1beta3_WebHookTriggerURL_To_api_WebHookTriggerURL(in *apiv1beta3.WebHookTriggerURL, out *buildapi.WebHookTriggerURL, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.WebHookTriggerURL))(in)
+	}
+	out.Type = buildapi.BuildTriggerType(in.Type)
+	out.URL = in.URL
+	return nil
+}
+
+func convert_v1beta3_WebHookTriggerURL_To_api_WebHookTriggerURL(in *apiv1beta3.WebHookTriggerURL, out *buildapi.WebHookTriggerURL, s conversion.Scope) error {
+	return autoconvert_v1beta3_WebHookTriggerURL_To_api_WebHookTriggerURL(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1beta3_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2949,6 +3753,12 @@ func autoconvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *de
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -3118,6 +3928,12 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1beta3_RecreateDeploym
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.RecreateDeploymentStrategyParams))(in)
 	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1beta3.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3126,6 +3942,14 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1beta3_RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3191,6 +4015,13 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -3476,6 +4307,12 @@ func autoconvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in *de
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -3645,6 +4482,12 @@ func autoconvert_v1beta3_RecreateDeploymentStrategyParams_To_api_RecreateDeploym
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.RecreateDeploymentStrategyParams))(in)
 	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapi.LifecycleHook)
 		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
@@ -3653,6 +4496,14 @@ func autoconvert_v1beta3_RecreateDeploymentStrategyParams_To_api_RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3714,6 +4565,13 @@ func autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -4236,6 +5094,14 @@ func autoconvert_api_OAuthClient_To_v1beta3_OAuthClient(in *oauthapi.OAuthClient
 		return err
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -4245,6 +5111,18 @@ func autoconvert_api_OAuthClient_To_v1beta3_OAuthClient(in *oauthapi.OAuthClient
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -4334,6 +5212,33 @@ func convert_api_OAuthClientList_To_v1beta3_OAuthClientList(in *oauthapi.OAuthCl
 	return autoconvert_api_OAuthClientList_To_v1beta3_OAuthClientList(in, out, s)
 }
 
+func autoconvert_api_ServiceAccountTokenRequest_To_v1beta3_ServiceAccountTokenRequest(in *oauthapi.ServiceAccountTokenRequest, out *oauthapiv1beta3.ServiceAccountTokenRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapi.ServiceAccountTokenRequest))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func convert_api_ServiceAccountTokenRequest_To_v1beta3_ServiceAccountTokenRequest(in *oauthapi.ServiceAccountTokenRequest, out *oauthapiv1beta3.ServiceAccountTokenRequest, s conversion.Scope) error {
+	return autoconvert_api_ServiceAccountTokenRequest_To_v1beta3_ServiceAccountTokenRequest(in, out, s)
+}
+
 func autoconvert_v1beta3_OAuthAccessToken_To_api_OAuthAccessToken(in *oauthapiv1beta3.OAuthAccessToken, out *oauthapi.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapiv1beta3.OAuthAccessToken))(in)
@@ -4462,6 +5367,14 @@ func autoconvert_v1beta3_OAuthClient_To_api_OAuthClient(in *oauthapiv1beta3.OAut
 		return err
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -4471,6 +5384,18 @@ func autoconvert_v1beta3_OAuthClient_To_api_OAuthClient(in *oauthapiv1beta3.OAut
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -4560,6 +5485,33 @@ func convert_v1beta3_OAuthClientList_To_api_OAuthClientList(in *oauthapiv1beta3.
 	return autoconvert_v1beta3_OAuthClientList_To_api_OAuthClientList(in, out, s)
 }
 
+func autoconvert_v1beta3_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in *oauthapiv1beta3.ServiceAccountTokenRequest, out *oauthapi.ServiceAccountTokenRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*oauthapiv1beta3.ServiceAccountTokenRequest))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
+func convert_v1beta3_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in *oauthapiv1beta3.ServiceAccountTokenRequest, out *oauthapi.ServiceAccountTokenRequest, s conversion.Scope) error {
+	return autoconvert_v1beta3_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest(in, out, s)
+}
+
 func autoconvert_api_Project_To_v1beta3_Project(in *projectapi.Project, out *projectapiv1beta3.Project, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapi.Project))(in)
@@ -4629,6 +5581,20 @@ func convert_api_ProjectRequest_To_v1beta3_ProjectRequest(in *projectapi.Project
 	return autoconvert_api_ProjectRequest_To_v1beta3_ProjectRequest(in, out, s)
 }
 
+func autoconvert_api_ProjectSecurityAllocation_To_v1beta3_ProjectSecurityAllocation(in *projectapi.ProjectSecurityAllocation, out *projectapiv1beta3.ProjectSecurityAllocation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapi.ProjectSecurityAllocation))(in)
+	}
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
+func convert_api_ProjectSecurityAllocation_To_v1beta3_ProjectSecurityAllocation(in *projectapi.ProjectSecurityAllocation, out *projectapiv1beta3.ProjectSecurityAllocation, s conversion.Scope) error {
+	return autoconvert_api_ProjectSecurityAllocation_To_v1beta3_ProjectSecurityAllocation(in, out, s)
+}
+
 func autoconvert_api_ProjectSpec_To_v1beta3_ProjectSpec(in *projectapi.ProjectSpec, out *projectapiv1beta3.ProjectSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapi.ProjectSpec))(in)
@@ -4653,6 +5619,14 @@ func autoconvert_api_ProjectStatus_To_v1beta3_ProjectStatus(in *projectapi.Proje
 		defaulting.(func(*projectapi.ProjectStatus))(in)
 	}
 	out.Phase = pkgapiv1beta3.NamespacePhase(in.Phase)
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapiv1beta3.ProjectSecurityAllocation)
+		if err := convert_api_ProjectSecurityAllocation_To_v1beta3_ProjectSecurityAllocation(in.SecurityAllocation, out.SecurityAllocation, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
 	return nil
 }
 
@@ -4729,6 +5703,20 @@ func convert_v1beta3_ProjectRequest_To_api_ProjectRequest(in *projectapiv1beta3.
 	return autoconvert_v1beta3_ProjectRequest_To_api_ProjectRequest(in, out, s)
 }
 
+func autoconvert_v1beta3_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in *projectapiv1beta3.ProjectSecurityAllocation, out *projectapi.ProjectSecurityAllocation, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*projectapiv1beta3.ProjectSecurityAllocation))(in)
+	}
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
+func convert_v1beta3_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in *projectapiv1beta3.ProjectSecurityAllocation, out *projectapi.ProjectSecurityAllocation, s conversion.Scope) error {
+	return autoconvert_v1beta3_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in, out, s)
+}
+
 func autoconvert_v1beta3_ProjectSpec_To_api_ProjectSpec(in *projectapiv1beta3.ProjectSpec, out *projectapi.ProjectSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*projectapiv1beta3.ProjectSpec))(in)
@@ -4753,6 +5741,14 @@ func autoconvert_v1beta3_ProjectStatus_To_api_ProjectStatus(in *projectapiv1beta
 		defaulting.(func(*projectapiv1beta3.ProjectStatus))(in)
 	}
 	out.Phase = pkgapi.NamespacePhase(in.Phase)
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapi.ProjectSecurityAllocation)
+		if err := convert_v1beta3_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation(in.SecurityAllocation, out.SecurityAllocation, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
 	return nil
 }
 
@@ -4875,6 +5871,7 @@ func autoconvert_api_TLSConfig_To_v1beta3_TLSConfig(in *routeapi.TLSConfig, out
 	out.Certificate = in.Certificate
 	out.Key = in.Key
 	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
 	out.DestinationCACertificate = in.DestinationCACertificate
 	out.InsecureEdgeTerminationPolicy = routeapiv1beta3.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
 	return nil
@@ -4999,6 +5996,7 @@ func autoconvert_v1beta3_TLSConfig_To_api_TLSConfig(in *routeapiv1beta3.TLSConfi
 	out.Certificate = in.Certificate
 	out.Key = in.Key
 	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
 	out.DestinationCACertificate = in.DestinationCACertificate
 	out.InsecureEdgeTerminationPolicy = routeapi.InsecureEdgeTerminationPolicyType(in.InsecureEdgeTerminationPolicy)
 	return nil
@@ -5021,6 +6019,16 @@ func autoconvert_api_ClusterNetwork_To_v1beta3_ClusterNetwork(in *sdnapi.Cluster
 	out.Network = in.Network
 	out.HostSubnetLength = in.HostSubnetLength
 	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapiv1beta3.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := convert_api_ClusterNetworkEntry_To_v1beta3_ClusterNetworkEntry(&in.ClusterNetworks[i], &out.ClusterNetworks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
 	return nil
 }
 
@@ -5028,6 +6036,19 @@ func convert_api_ClusterNetwork_To_v1beta3_ClusterNetwork(in *sdnapi.ClusterNetw
 	return autoconvert_api_ClusterNetwork_To_v1beta3_ClusterNetwork(in, out, s)
 }
 
+func autoconvert_api_ClusterNetworkEntry_To_v1beta3_ClusterNetworkEntry(in *sdnapi.ClusterNetworkEntry, out *sdnapiv1beta3.ClusterNetworkEntry, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapi.ClusterNetworkEntry))(in)
+	}
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
+	return nil
+}
+
+func convert_api_ClusterNetworkEntry_To_v1beta3_ClusterNetworkEntry(in *sdnapi.ClusterNetworkEntry, out *sdnapiv1beta3.ClusterNetworkEntry, s conversion.Scope) error {
+	return autoconvert_api_ClusterNetworkEntry_To_v1beta3_ClusterNetworkEntry(in, out, s)
+}
+
 func autoconvert_api_ClusterNetworkList_To_v1beta3_ClusterNetworkList(in *sdnapi.ClusterNetworkList, out *sdnapiv1beta3.ClusterNetworkList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*sdnapi.ClusterNetworkList))(in)
@@ -5161,6 +6182,16 @@ func autoconvert_v1beta3_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1beta3.
 	out.Network = in.Network
 	out.HostSubnetLength = in.HostSubnetLength
 	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapi.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := convert_v1beta3_ClusterNetworkEntry_To_api_ClusterNetworkEntry(&in.ClusterNetworks[i], &out.ClusterNetworks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
 	return nil
 }
 
@@ -5168,6 +6199,19 @@ func convert_v1beta3_ClusterNetwork_To_api_ClusterNetwork(in *sdnapiv1beta3.Clus
 	return autoconvert_v1beta3_ClusterNetwork_To_api_ClusterNetwork(in, out, s)
 }
 
+func autoconvert_v1beta3_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in *sdnapiv1beta3.ClusterNetworkEntry, out *sdnapi.ClusterNetworkEntry, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*sdnapiv1beta3.ClusterNetworkEntry))(in)
+	}
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
+	return nil
+}
+
+func convert_v1beta3_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in *sdnapiv1beta3.ClusterNetworkEntry, out *sdnapi.ClusterNetworkEntry, s conversion.Scope) error {
+	return autoconvert_v1beta3_ClusterNetworkEntry_To_api_ClusterNetworkEntry(in, out, s)
+}
+
 func autoconvert_v1beta3_ClusterNetworkList_To_api_ClusterNetworkList(in *sdnapiv1beta3.ClusterNetworkList, out *sdnapi.ClusterNetworkList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*sdnapiv1beta3.ClusterNetworkList))(in)
@@ -5299,6 +6343,14 @@ func autoconvert_api_Parameter_To_v1beta3_Parameter(in *templateapi.Parameter, o
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapiv1beta3.ParameterSource)
+		if err := convert_api_ParameterSource_To_v1beta3_ParameterSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
 	return nil
 }
 
@@ -5306,6 +6358,38 @@ func convert_api_Parameter_To_v1beta3_Parameter(in *templateapi.Parameter, out *
 	return autoconvert_api_Parameter_To_v1beta3_Parameter(in, out, s)
 }
 
+func autoconvert_api_ParameterSource_To_v1beta3_ParameterSource(in *templateapi.ParameterSource, out *templateapiv1beta3.ParameterSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.ParameterSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapiv1beta3.SecretKeySelector)
+		if err := convert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_api_ParameterSource_To_v1beta3_ParameterSource(in *templateapi.ParameterSource, out *templateapiv1beta3.ParameterSource, s conversion.Scope) error {
+	return autoconvert_api_ParameterSource_To_v1beta3_ParameterSource(in, out, s)
+}
+
+func autoconvert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in *templateapi.SecretKeySelector, out *templateapiv1beta3.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapi.SecretKeySelector))(in)
+	}
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+func convert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in *templateapi.SecretKeySelector, out *templateapiv1beta3.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_api_SecretKeySelector_To_v1beta3_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_api_Template_To_v1beta3_Template(in *templateapi.Template, out *templateapiv1beta3.Template, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapi.Template))(in)
@@ -5371,6 +6455,14 @@ func autoconvert_v1beta3_Parameter_To_api_Parameter(in *templateapiv1beta3.Param
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapi.ParameterSource)
+		if err := convert_v1beta3_ParameterSource_To_api_ParameterSource(in.ValueFrom, out.ValueFrom, s); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
 	return nil
 }
 
@@ -5378,6 +6470,38 @@ func convert_v1beta3_Parameter_To_api_Parameter(in *templateapiv1beta3.Parameter
 	return autoconvert_v1beta3_Parameter_To_api_Parameter(in, out, s)
 }
 
+func autoconvert_v1beta3_ParameterSource_To_api_ParameterSource(in *templateapiv1beta3.ParameterSource, out *templateapi.ParameterSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1beta3.ParameterSource))(in)
+	}
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapi.SecretKeySelector)
+		if err := convert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in.SecretKeyRef, out.SecretKeyRef, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_ParameterSource_To_api_ParameterSource(in *templateapiv1beta3.ParameterSource, out *templateapi.ParameterSource, s conversion.Scope) error {
+	return autoconvert_v1beta3_ParameterSource_To_api_ParameterSource(in, out, s)
+}
+
+func autoconvert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in *templateapiv1beta3.SecretKeySelector, out *templateapi.SecretKeySelector, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*templateapiv1beta3.SecretKeySelector))(in)
+	}
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+func convert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in *templateapiv1beta3.SecretKeySelector, out *templateapi.SecretKeySelector, s conversion.Scope) error {
+	return autoconvert_v1beta3_SecretKeySelector_To_api_SecretKeySelector(in, out, s)
+}
+
 func autoconvert_v1beta3_Template_To_api_Template(in *templateapiv1beta3.Template, out *templateapi.Template, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*templateapiv1beta3.Template))(in)
@@ -7630,6 +8754,7 @@ func init() {
 		autoconvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
 		autoconvert_api_BuildConfig_To_v1beta3_BuildConfig,
+		autoconvert_api_BuildHistory_To_v1beta3_BuildHistory,
 		autoconvert_api_BuildList_To_v1beta3_BuildList,
 		autoconvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions,
 		autoconvert_api_BuildLog_To_v1beta3_BuildLog,
@@ -7639,10 +8764,12 @@ func init() {
 		autoconvert_api_BuildSpec_To_v1beta3_BuildSpec,
 		autoconvert_api_BuildStatus_To_v1beta3_BuildStatus,
 		autoconvert_api_BuildStrategy_To_v1beta3_BuildStrategy,
+		autoconvert_api_BuildSummary_To_v1beta3_BuildSummary,
 		autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy,
 		autoconvert_api_Build_To_v1beta3_Build,
 		autoconvert_api_CephFSVolumeSource_To_v1beta3_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1beta3_CinderVolumeSource,
+		autoconvert_api_ClusterNetworkEntry_To_v1beta3_ClusterNetworkEntry,
 		autoconvert_api_ClusterNetworkList_To_v1beta3_ClusterNetworkList,
 		autoconvert_api_ClusterNetwork_To_v1beta3_ClusterNetwork,
 		autoconvert_api_ClusterPolicyBindingList_To_v1beta3_ClusterPolicyBindingList,
@@ -7686,6 +8813,7 @@ func init() {
 		autoconvert_api_GitSourceRevision_To_v1beta3_GitSourceRevision,
 		autoconvert_api_GlusterfsVolumeSource_To_v1beta3_GlusterfsVolumeSource,
 		autoconvert_api_GroupList_To_v1beta3_GroupList,
+		autoconvert_api_GroupRestriction_To_v1beta3_GroupRestriction,
 		autoconvert_api_Group_To_v1beta3_Group,
 		autoconvert_api_HTTPGetAction_To_v1beta3_HTTPGetAction,
 		autoconvert_api_Handler_To_v1beta3_Handler,
@@ -7727,6 +8855,7 @@ func init() {
 		autoconvert_api_ObjectFieldSelector_To_v1beta3_ObjectFieldSelector,
 		autoconvert_api_ObjectMeta_To_v1beta3_ObjectMeta,
 		autoconvert_api_ObjectReference_To_v1beta3_ObjectReference,
+		autoconvert_api_ParameterSource_To_v1beta3_ParameterSource,
 		autoconvert_api_Parameter_To_v1beta3_Parameter,
 		autoconvert_api_PersistentVolumeClaimVolumeSource_To_v1beta3_PersistentVolumeClaimVolumeSource,
 		autoconvert_api_PodSpec_To_v1beta3_PodSpec,
@@ -7738,6 +8867,7 @@ func init() {
 		autoconvert_api_Policy_To_v1beta3_Policy,
 		autoconvert_api_ProjectList_To_v1beta3_ProjectList,
 		autoconvert_api_ProjectRequest_To_v1beta3_ProjectRequest,
+		autoconvert_api_ProjectSecurityAllocation_To_v1beta3_ProjectSecurityAllocation,
 		autoconvert_api_ProjectSpec_To_v1beta3_ProjectSpec,
 		autoconvert_api_ProjectStatus_To_v1beta3_ProjectStatus,
 		autoconvert_api_Project_To_v1beta3_Project,
@@ -7747,6 +8877,9 @@ func init() {
 		autoconvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
 		autoconvert_api_ResourceRequirements_To_v1beta3_ResourceRequirements,
 		autoconvert_api_RoleBindingList_To_v1beta3_RoleBindingList,
+		autoconvert_api_RoleBindingRestrictionList_To_v1beta3_RoleBindingRestrictionList,
+		autoconvert_api_RoleBindingRestrictionSpec_To_v1beta3_RoleBindingRestrictionSpec,
+		autoconvert_api_RoleBindingRestriction_To_v1beta3_RoleBindingRestriction,
 		autoconvert_api_RoleBinding_To_v1beta3_RoleBinding,
 		autoconvert_api_RoleList_To_v1beta3_RoleList,
 		autoconvert_api_Role_To_v1beta3_Role,
@@ -7757,23 +8890,32 @@ func init() {
 		autoconvert_api_RouteStatus_To_v1beta3_RouteStatus,
 		autoconvert_api_Route_To_v1beta3_Route,
 		autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
+		autoconvert_api_SecretKeySelector_To_v1beta3_SecretKeySelector,
 		autoconvert_api_SecretSpec_To_v1beta3_SecretSpec,
 		autoconvert_api_SecretVolumeSource_To_v1beta3_SecretVolumeSource,
+		autoconvert_api_SelfSubjectRulesReviewSpec_To_v1beta3_SelfSubjectRulesReviewSpec,
+		autoconvert_api_SelfSubjectRulesReview_To_v1beta3_SelfSubjectRulesReview,
+		autoconvert_api_ServiceAccountReference_To_v1beta3_ServiceAccountReference,
+		autoconvert_api_ServiceAccountRestriction_To_v1beta3_ServiceAccountRestriction,
+		autoconvert_api_ServiceAccountTokenRequest_To_v1beta3_ServiceAccountTokenRequest,
 		autoconvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy,
 		autoconvert_api_SourceControlUser_To_v1beta3_SourceControlUser,
 		autoconvert_api_SourceRevision_To_v1beta3_SourceRevision,
 		autoconvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse,
 		autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview,
+		autoconvert_api_SubjectRulesReviewStatus_To_v1beta3_SubjectRulesReviewStatus,
 		autoconvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
 		autoconvert_api_TLSConfig_To_v1beta3_TLSConfig,
 		autoconvert_api_TemplateList_To_v1beta3_TemplateList,
 		autoconvert_api_Template_To_v1beta3_Template,
 		autoconvert_api_UserIdentityMapping_To_v1beta3_UserIdentityMapping,
 		autoconvert_api_UserList_To_v1beta3_UserList,
+		autoconvert_api_UserRestriction_To_v1beta3_UserRestriction,
 		autoconvert_api_User_To_v1beta3_User,
 		autoconvert_api_VolumeMount_To_v1beta3_VolumeMount,
 		autoconvert_api_VolumeSource_To_v1beta3_VolumeSource,
 		autoconvert_api_Volume_To_v1beta3_Volume,
+		autoconvert_api_WebHookTriggerURL_To_v1beta3_WebHookTriggerURL,
 		autoconvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger,
 		autoconvert_v1beta3_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
@@ -7782,6 +8924,7 @@ func init() {
 		autoconvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
 		autoconvert_v1beta3_BuildConfig_To_api_BuildConfig,
+		autoconvert_v1beta3_BuildHistory_To_api_BuildHistory,
 		autoconvert_v1beta3_BuildList_To_api_BuildList,
 		autoconvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions,
 		autoconvert_v1beta3_BuildLog_To_api_BuildLog,
@@ -7791,10 +8934,12 @@ func init() {
 		autoconvert_v1beta3_BuildSpec_To_api_BuildSpec,
 		autoconvert_v1beta3_BuildStatus_To_api_BuildStatus,
 		autoconvert_v1beta3_BuildStrategy_To_api_BuildStrategy,
+		autoconvert_v1beta3_BuildSummary_To_api_BuildSummary,
 		autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoconvert_v1beta3_Build_To_api_Build,
 		autoconvert_v1beta3_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1beta3_CinderVolumeSource_To_api_CinderVolumeSource,
+		autoconvert_v1beta3_ClusterNetworkEntry_To_api_ClusterNetworkEntry,
 		autoconvert_v1beta3_ClusterNetworkList_To_api_ClusterNetworkList,
 		autoconvert_v1beta3_ClusterNetwork_To_api_ClusterNetwork,
 		autoconvert_v1beta3_ClusterPolicyBindingList_To_api_ClusterPolicyBindingList,
@@ -7838,6 +8983,7 @@ func init() {
 		autoconvert_v1beta3_GitSourceRevision_To_api_GitSourceRevision,
 		autoconvert_v1beta3_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
 		autoconvert_v1beta3_GroupList_To_api_GroupList,
+		autoconvert_v1beta3_GroupRestriction_To_api_GroupRestriction,
 		autoconvert_v1beta3_Group_To_api_Group,
 		autoconvert_v1beta3_HTTPGetAction_To_api_HTTPGetAction,
 		autoconvert_v1beta3_Handler_To_api_Handler,
@@ -7879,6 +9025,7 @@ func init() {
 		autoconvert_v1beta3_ObjectFieldSelector_To_api_ObjectFieldSelector,
 		autoconvert_v1beta3_ObjectMeta_To_api_ObjectMeta,
 		autoconvert_v1beta3_ObjectReference_To_api_ObjectReference,
+		autoconvert_v1beta3_ParameterSource_To_api_ParameterSource,
 		autoconvert_v1beta3_Parameter_To_api_Parameter,
 		autoconvert_v1beta3_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
 		autoconvert_v1beta3_PodSpec_To_api_PodSpec,
@@ -7890,6 +9037,7 @@ func init() {
 		autoconvert_v1beta3_Policy_To_api_Policy,
 		autoconvert_v1beta3_ProjectList_To_api_ProjectList,
 		autoconvert_v1beta3_ProjectRequest_To_api_ProjectRequest,
+		autoconvert_v1beta3_ProjectSecurityAllocation_To_api_ProjectSecurityAllocation,
 		autoconvert_v1beta3_ProjectSpec_To_api_ProjectSpec,
 		autoconvert_v1beta3_ProjectStatus_To_api_ProjectStatus,
 		autoconvert_v1beta3_Project_To_api_Project,
@@ -7899,6 +9047,9 @@ func init() {
 		autoconvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
 		autoconvert_v1beta3_ResourceRequirements_To_api_ResourceRequirements,
 		autoconvert_v1beta3_RoleBindingList_To_api_RoleBindingList,
+		autoconvert_v1beta3_RoleBindingRestrictionList_To_api_RoleBindingRestrictionList,
+		autoconvert_v1beta3_RoleBindingRestrictionSpec_To_api_RoleBindingRestrictionSpec,
+		autoconvert_v1beta3_RoleBindingRestriction_To_api_RoleBindingRestriction,
 		autoconvert_v1beta3_RoleBinding_To_api_RoleBinding,
 		autoconvert_v1beta3_RoleList_To_api_RoleList,
 		autoconvert_v1beta3_Role_To_api_Role,
@@ -7909,23 +9060,32 @@ func init() {
 		autoconvert_v1beta3_RouteStatus_To_api_RouteStatus,
 		autoconvert_v1beta3_Route_To_api_Route,
 		autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
+		autoconvert_v1beta3_SecretKeySelector_To_api_SecretKeySelector,
 		autoconvert_v1beta3_SecretSpec_To_api_SecretSpec,
 		autoconvert_v1beta3_SecretVolumeSource_To_api_SecretVolumeSource,
+		autoconvert_v1beta3_SelfSubjectRulesReviewSpec_To_api_SelfSubjectRulesReviewSpec,
+		autoconvert_v1beta3_SelfSubjectRulesReview_To_api_SelfSubjectRulesReview,
+		autoconvert_v1beta3_ServiceAccountReference_To_api_ServiceAccountReference,
+		autoconvert_v1beta3_ServiceAccountRestriction_To_api_ServiceAccountRestriction,
+		autoconvert_v1beta3_ServiceAccountTokenRequest_To_api_ServiceAccountTokenRequest,
 		autoconvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoconvert_v1beta3_SourceControlUser_To_api_SourceControlUser,
 		autoconvert_v1beta3_SourceRevision_To_api_SourceRevision,
 		autoconvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview,
+		autoconvert_v1beta3_SubjectRulesReviewStatus_To_api_SubjectRulesReviewStatus,
 		autoconvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
 		autoconvert_v1beta3_TLSConfig_To_api_TLSConfig,
 		autoconvert_v1beta3_TemplateList_To_api_TemplateList,
 		autoconvert_v1beta3_Template_To_api_Template,
 		autoconvert_v1beta3_UserIdentityMapping_To_api_UserIdentityMapping,
 		autoconvert_v1beta3_UserList_To_api_UserList,
+		autoconvert_v1beta3_UserRestriction_To_api_UserRestriction,
 		autoconvert_v1beta3_User_To_api_User,
 		autoconvert_v1beta3_VolumeMount_To_api_VolumeMount,
 		autoconvert_v1beta3_VolumeSource_To_api_VolumeSource,
 		autoconvert_v1beta3_Volume_To_api_Volume,
+		autoconvert_v1beta3_WebHookTriggerURL_To_api_WebHookTriggerURL,
 		autoconvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
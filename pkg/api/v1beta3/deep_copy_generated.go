@@ -30,6 +30,8 @@ func deepCopy_v1beta3_AuthorizationAttributes(in v1beta3.AuthorizationAttributes
 	} else {
 		out.Content = newVal.(runtime.RawExtension)
 	}
+	out.IsNonResourceURL = in.IsNonResourceURL
+	out.Path = in.Path
 	return nil
 }
 
@@ -165,6 +167,28 @@ func deepCopy_v1beta3_ClusterRole(in v1beta3.ClusterRole, out *v1beta3.ClusterRo
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1beta3.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapiv1beta3.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1beta3_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -263,6 +287,26 @@ func deepCopy_v1beta3_ClusterRoleList(in v1beta3.ClusterRoleList, out *v1beta3.C
 	return nil
 }
 
+func deepCopy_v1beta3_GroupRestriction(in v1beta3.GroupRestriction, out *v1beta3.GroupRestriction, c *conversion.Cloner) error {
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_IsPersonalSubjectAccessReview(in v1beta3.IsPersonalSubjectAccessReview, out *v1beta3.IsPersonalSubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -302,6 +346,14 @@ func deepCopy_v1beta3_LocalSubjectAccessReview(in v1beta3.LocalSubjectAccessRevi
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -540,6 +592,7 @@ func deepCopy_v1beta3_ResourceAccessReviewResponse(in v1beta3.ResourceAccessRevi
 	} else {
 		out.GroupsSlice = nil
 	}
+	out.EvaluationError = in.EvaluationError
 	return nil
 }
 
@@ -564,6 +617,28 @@ func deepCopy_v1beta3_Role(in v1beta3.Role, out *v1beta3.Role, c *conversion.Clo
 	} else {
 		out.Rules = nil
 	}
+	if in.IncludedRoles != nil {
+		out.IncludedRoles = make([]pkgapiv1beta3.ObjectReference, len(in.IncludedRoles))
+		for i := range in.IncludedRoles {
+			if newVal, err := c.DeepCopy(in.IncludedRoles[i]); err != nil {
+				return err
+			} else {
+				out.IncludedRoles[i] = newVal.(pkgapiv1beta3.ObjectReference)
+			}
+		}
+	} else {
+		out.IncludedRoles = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1beta3_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
 	return nil
 }
 
@@ -638,6 +713,75 @@ func deepCopy_v1beta3_RoleBindingList(in v1beta3.RoleBindingList, out *v1beta3.R
 	return nil
 }
 
+func deepCopy_v1beta3_RoleBindingRestriction(in v1beta3.RoleBindingRestriction, out *v1beta3.RoleBindingRestriction, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	if err := deepCopy_v1beta3_RoleBindingRestrictionSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RoleBindingRestrictionList(in v1beta3.RoleBindingRestrictionList, out *v1beta3.RoleBindingRestrictionList, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ListMeta); err != nil {
+		return err
+	} else {
+		out.ListMeta = newVal.(unversioned.ListMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]v1beta3.RoleBindingRestriction, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1beta3_RoleBindingRestriction(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RoleBindingRestrictionSpec(in v1beta3.RoleBindingRestrictionSpec, out *v1beta3.RoleBindingRestrictionSpec, c *conversion.Cloner) error {
+	if in.UserRestriction != nil {
+		out.UserRestriction = new(v1beta3.UserRestriction)
+		if err := deepCopy_v1beta3_UserRestriction(*in.UserRestriction, out.UserRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.UserRestriction = nil
+	}
+	if in.GroupRestriction != nil {
+		out.GroupRestriction = new(v1beta3.GroupRestriction)
+		if err := deepCopy_v1beta3_GroupRestriction(*in.GroupRestriction, out.GroupRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.GroupRestriction = nil
+	}
+	if in.ServiceAccountRestriction != nil {
+		out.ServiceAccountRestriction = new(v1beta3.ServiceAccountRestriction)
+		if err := deepCopy_v1beta3_ServiceAccountRestriction(*in.ServiceAccountRestriction, out.ServiceAccountRestriction, c); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRestriction = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_RoleList(in v1beta3.RoleList, out *v1beta3.RoleList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -662,6 +806,61 @@ func deepCopy_v1beta3_RoleList(in v1beta3.RoleList, out *v1beta3.RoleList, c *co
 	return nil
 }
 
+func deepCopy_v1beta3_SelfSubjectRulesReview(in v1beta3.SelfSubjectRulesReview, out *v1beta3.SelfSubjectRulesReview, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if err := deepCopy_v1beta3_SelfSubjectRulesReviewSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1beta3_SubjectRulesReviewStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_SelfSubjectRulesReviewSpec(in v1beta3.SelfSubjectRulesReviewSpec, out *v1beta3.SelfSubjectRulesReviewSpec, c *conversion.Cloner) error {
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ServiceAccountReference(in v1beta3.ServiceAccountReference, out *v1beta3.ServiceAccountReference, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+func deepCopy_v1beta3_ServiceAccountRestriction(in v1beta3.ServiceAccountRestriction, out *v1beta3.ServiceAccountRestriction, c *conversion.Cloner) error {
+	if in.ServiceAccounts != nil {
+		out.ServiceAccounts = make([]v1beta3.ServiceAccountReference, len(in.ServiceAccounts))
+		for i := range in.ServiceAccounts {
+			if err := deepCopy_v1beta3_ServiceAccountReference(in.ServiceAccounts[i], &out.ServiceAccounts[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ServiceAccounts = nil
+	}
+	if in.Namespaces != nil {
+		out.Namespaces = make([]string, len(in.Namespaces))
+		for i := range in.Namespaces {
+			out.Namespaces[i] = in.Namespaces[i]
+		}
+	} else {
+		out.Namespaces = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_SubjectAccessReview(in v1beta3.SubjectAccessReview, out *v1beta3.SubjectAccessReview, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -680,6 +879,14 @@ func deepCopy_v1beta3_SubjectAccessReview(in v1beta3.SubjectAccessReview, out *v
 	} else {
 		out.GroupsSlice = nil
 	}
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
 	return nil
 }
 
@@ -692,6 +899,60 @@ func deepCopy_v1beta3_SubjectAccessReviewResponse(in v1beta3.SubjectAccessReview
 	out.Namespace = in.Namespace
 	out.Allowed = in.Allowed
 	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_v1beta3_SubjectRulesReviewStatus(in v1beta3.SubjectRulesReviewStatus, out *v1beta3.SubjectRulesReviewStatus, c *conversion.Cloner) error {
+	if in.Rules != nil {
+		out.Rules = make([]v1beta3.PolicyRule, len(in.Rules))
+		for i := range in.Rules {
+			if err := deepCopy_v1beta3_PolicyRule(in.Rules[i], &out.Rules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	if in.DenyRules != nil {
+		out.DenyRules = make([]v1beta3.PolicyRule, len(in.DenyRules))
+		for i := range in.DenyRules {
+			if err := deepCopy_v1beta3_PolicyRule(in.DenyRules[i], &out.DenyRules[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DenyRules = nil
+	}
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+func deepCopy_v1beta3_UserRestriction(in v1beta3.UserRestriction, out *v1beta3.UserRestriction, c *conversion.Cloner) error {
+	if in.Users != nil {
+		out.Users = make([]string, len(in.Users))
+		for i := range in.Users {
+			out.Users[i] = in.Users[i]
+		}
+	} else {
+		out.Users = nil
+	}
+	if in.Groups != nil {
+		out.Groups = make([]string, len(in.Groups))
+		for i := range in.Groups {
+			out.Groups[i] = in.Groups[i]
+		}
+	} else {
+		out.Groups = nil
+	}
+	if in.Selector != nil {
+		out.Selector = make(map[string]string)
+		for key, val := range in.Selector {
+			out.Selector[key] = val
+		}
+	} else {
+		out.Selector = nil
+	}
 	return nil
 }
 
@@ -718,6 +979,7 @@ func deepCopy_v1beta3_BinaryBuildRequestOptions(in apiv1beta3.BinaryBuildRequest
 
 func deepCopy_v1beta3_BinaryBuildSource(in apiv1beta3.BinaryBuildSource, out *apiv1beta3.BinaryBuildSource, c *conversion.Cloner) error {
 	out.AsFile = in.AsFile
+	out.WithArchive = in.WithArchive
 	return nil
 }
 
@@ -804,6 +1066,40 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 
 func deepCopy_v1beta3_BuildConfigStatus(in apiv1beta3.BuildConfigStatus, out *apiv1beta3.BuildConfigStatus, c *conversion.Cloner) error {
 	out.LastVersion = in.LastVersion
+	if in.WebHookURLs != nil {
+		out.WebHookURLs = make([]apiv1beta3.WebHookTriggerURL, len(in.WebHookURLs))
+		for i := range in.WebHookURLs {
+			if err := deepCopy_v1beta3_WebHookTriggerURL(in.WebHookURLs[i], &out.WebHookURLs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WebHookURLs = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildHistory(in apiv1beta3.BuildHistory, out *apiv1beta3.BuildHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	if in.Items != nil {
+		out.Items = make([]apiv1beta3.BuildSummary, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1beta3_BuildSummary(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -877,6 +1173,12 @@ func deepCopy_v1beta3_BuildLogOptions(in apiv1beta3.BuildLogOptions, out *apiv1b
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -972,6 +1274,7 @@ func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.B
 	} else {
 		out.Env = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1099,6 +1402,7 @@ func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.Bui
 	} else {
 		out.Config = nil
 	}
+	out.FromImageDigest = in.FromImageDigest
 	return nil
 }
 
@@ -1131,6 +1435,31 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	return nil
 }
 
+func deepCopy_v1beta3_BuildSummary(in apiv1beta3.BuildSummary, out *apiv1beta3.BuildSummary, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	out.Duration = in.Duration
+	return nil
+}
+
 func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *apiv1beta3.BuildTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.GitHubWebHook != nil {
@@ -1384,6 +1713,37 @@ func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_WebHookTriggerURL(in apiv1beta3.WebHookTriggerURL, out *apiv1beta3.WebHookTriggerURL, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryCheck(in deployapiv1beta3.CanaryCheck, out *deployapiv1beta3.CanaryCheck, c *conversion.Cloner) error {
+	if in.ExecNewPod != nil {
+		out.ExecNewPod = new(deployapiv1beta3.ExecNewPodHook)
+		if err := deepCopy_v1beta3_ExecNewPodHook(*in.ExecNewPod, out.ExecNewPod, c); err != nil {
+			return err
+		}
+	} else {
+		out.ExecNewPod = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1beta3.CanaryHTTPGetAction)
+		if err := deepCopy_v1beta3_CanaryHTTPGetAction(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryHTTPGetAction(in deployapiv1beta3.CanaryHTTPGetAction, out *deployapiv1beta3.CanaryHTTPGetAction, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
 func deepCopy_v1beta3_CustomDeploymentStrategyParams(in deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1613,6 +1973,12 @@ func deepCopy_v1beta3_DeploymentLogOptions(in deployapiv1beta3.DeploymentLogOpti
 	} else {
 		out.LimitBytes = nil
 	}
+	if in.OffsetBytes != nil {
+		out.OffsetBytes = new(int64)
+		*out.OffsetBytes = *in.OffsetBytes
+	} else {
+		out.OffsetBytes = nil
+	}
 	out.NoWait = in.NoWait
 	if in.Version != nil {
 		out.Version = new(int64)
@@ -1752,6 +2118,12 @@ func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *depl
 }
 
 func deepCopy_v1beta3_RecreateDeploymentStrategyParams(in deployapiv1beta3.RecreateDeploymentStrategyParams, out *deployapiv1beta3.RecreateDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
 	if in.Pre != nil {
 		out.Pre = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
@@ -1760,6 +2132,14 @@ func deepCopy_v1beta3_RecreateDeploymentStrategyParams(in deployapiv1beta3.Recre
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1830,6 +2210,14 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.Post = nil
 	}
+	if in.Canary != nil {
+		out.Canary = new(deployapiv1beta3.CanaryCheck)
+		if err := deepCopy_v1beta3_CanaryCheck(*in.Canary, out.Canary, c); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
 	return nil
 }
 
@@ -2081,6 +2469,7 @@ func deepCopy_v1beta3_NamedTagReference(in imageapiv1beta3.NamedTagReference, ou
 	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
 		return err
 	}
+	out.TrackVersion = in.TrackVersion
 	return nil
 }
 
@@ -2233,6 +2622,14 @@ func deepCopy_v1beta3_OAuthClient(in oauthapiv1beta3.OAuthClient, out *oauthapiv
 		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
 	}
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		out.AdditionalSecrets = make([]string, len(in.AdditionalSecrets))
+		for i := range in.AdditionalSecrets {
+			out.AdditionalSecrets[i] = in.AdditionalSecrets[i]
+		}
+	} else {
+		out.AdditionalSecrets = nil
+	}
 	out.RespondWithChallenges = in.RespondWithChallenges
 	if in.RedirectURIs != nil {
 		out.RedirectURIs = make([]string, len(in.RedirectURIs))
@@ -2242,6 +2639,18 @@ func deepCopy_v1beta3_OAuthClient(in oauthapiv1beta3.OAuthClient, out *oauthapiv
 	} else {
 		out.RedirectURIs = nil
 	}
+	if in.AccessTokenMaxAgeSeconds != nil {
+		out.AccessTokenMaxAgeSeconds = new(int32)
+		*out.AccessTokenMaxAgeSeconds = *in.AccessTokenMaxAgeSeconds
+	} else {
+		out.AccessTokenMaxAgeSeconds = nil
+	}
+	if in.AuthorizeTokenMaxAgeSeconds != nil {
+		out.AuthorizeTokenMaxAgeSeconds = new(int32)
+		*out.AuthorizeTokenMaxAgeSeconds = *in.AuthorizeTokenMaxAgeSeconds
+	} else {
+		out.AuthorizeTokenMaxAgeSeconds = nil
+	}
 	return nil
 }
 
@@ -2318,6 +2727,30 @@ func deepCopy_v1beta3_OAuthClientList(in oauthapiv1beta3.OAuthClientList, out *o
 	return nil
 }
 
+func deepCopy_v1beta3_ServiceAccountTokenRequest(in oauthapiv1beta3.ServiceAccountTokenRequest, out *oauthapiv1beta3.ServiceAccountTokenRequest, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	out.ExpiresIn = in.ExpiresIn
+	if in.Scopes != nil {
+		out.Scopes = make([]string, len(in.Scopes))
+		for i := range in.Scopes {
+			out.Scopes[i] = in.Scopes[i]
+		}
+	} else {
+		out.Scopes = nil
+	}
+	out.Token = in.Token
+	return nil
+}
+
 func deepCopy_v1beta3_Project(in projectapiv1beta3.Project, out *projectapiv1beta3.Project, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2378,6 +2811,13 @@ func deepCopy_v1beta3_ProjectRequest(in projectapiv1beta3.ProjectRequest, out *p
 	return nil
 }
 
+func deepCopy_v1beta3_ProjectSecurityAllocation(in projectapiv1beta3.ProjectSecurityAllocation, out *projectapiv1beta3.ProjectSecurityAllocation, c *conversion.Cloner) error {
+	out.UIDRange = in.UIDRange
+	out.SupplementalGroups = in.SupplementalGroups
+	out.MCSLabel = in.MCSLabel
+	return nil
+}
+
 func deepCopy_v1beta3_ProjectSpec(in projectapiv1beta3.ProjectSpec, out *projectapiv1beta3.ProjectSpec, c *conversion.Cloner) error {
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapiv1beta3.FinalizerName, len(in.Finalizers))
@@ -2392,6 +2832,14 @@ func deepCopy_v1beta3_ProjectSpec(in projectapiv1beta3.ProjectSpec, out *project
 
 func deepCopy_v1beta3_ProjectStatus(in projectapiv1beta3.ProjectStatus, out *projectapiv1beta3.ProjectStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.SecurityAllocation != nil {
+		out.SecurityAllocation = new(projectapiv1beta3.ProjectSecurityAllocation)
+		if err := deepCopy_v1beta3_ProjectSecurityAllocation(*in.SecurityAllocation, out.SecurityAllocation, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecurityAllocation = nil
+	}
 	return nil
 }
 
@@ -2484,6 +2932,7 @@ func deepCopy_v1beta3_TLSConfig(in routeapiv1beta3.TLSConfig, out *routeapiv1bet
 	out.Certificate = in.Certificate
 	out.Key = in.Key
 	out.CACertificate = in.CACertificate
+	out.SecretName = in.SecretName
 	out.DestinationCACertificate = in.DestinationCACertificate
 	out.InsecureEdgeTerminationPolicy = in.InsecureEdgeTerminationPolicy
 	return nil
@@ -2503,6 +2952,22 @@ func deepCopy_v1beta3_ClusterNetwork(in sdnapiv1beta3.ClusterNetwork, out *sdnap
 	out.Network = in.Network
 	out.HostSubnetLength = in.HostSubnetLength
 	out.ServiceNetwork = in.ServiceNetwork
+	if in.ClusterNetworks != nil {
+		out.ClusterNetworks = make([]sdnapiv1beta3.ClusterNetworkEntry, len(in.ClusterNetworks))
+		for i := range in.ClusterNetworks {
+			if err := deepCopy_v1beta3_ClusterNetworkEntry(in.ClusterNetworks[i], &out.ClusterNetworks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ClusterNetworks = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ClusterNetworkEntry(in sdnapiv1beta3.ClusterNetworkEntry, out *sdnapiv1beta3.ClusterNetworkEntry, c *conversion.Cloner) error {
+	out.CIDR = in.CIDR
+	out.HostSubnetLength = in.HostSubnetLength
 	return nil
 }
 
@@ -2619,6 +3084,32 @@ func deepCopy_v1beta3_Parameter(in templateapiv1beta3.Parameter, out *templateap
 	out.Generate = in.Generate
 	out.From = in.From
 	out.Required = in.Required
+	if in.ValueFrom != nil {
+		out.ValueFrom = new(templateapiv1beta3.ParameterSource)
+		if err := deepCopy_v1beta3_ParameterSource(*in.ValueFrom, out.ValueFrom, c); err != nil {
+			return err
+		}
+	} else {
+		out.ValueFrom = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ParameterSource(in templateapiv1beta3.ParameterSource, out *templateapiv1beta3.ParameterSource, c *conversion.Cloner) error {
+	if in.SecretKeyRef != nil {
+		out.SecretKeyRef = new(templateapiv1beta3.SecretKeySelector)
+		if err := deepCopy_v1beta3_SecretKeySelector(*in.SecretKeyRef, out.SecretKeyRef, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretKeyRef = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_SecretKeySelector(in templateapiv1beta3.SecretKeySelector, out *templateapiv1beta3.SecretKeySelector, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Key = in.Key
 	return nil
 }
 
@@ -2879,6 +3370,7 @@ func init() {
 		deepCopy_v1beta3_ClusterRoleBinding,
 		deepCopy_v1beta3_ClusterRoleBindingList,
 		deepCopy_v1beta3_ClusterRoleList,
+		deepCopy_v1beta3_GroupRestriction,
 		deepCopy_v1beta3_IsPersonalSubjectAccessReview,
 		deepCopy_v1beta3_LocalResourceAccessReview,
 		deepCopy_v1beta3_LocalSubjectAccessReview,
@@ -2896,9 +3388,18 @@ func init() {
 		deepCopy_v1beta3_Role,
 		deepCopy_v1beta3_RoleBinding,
 		deepCopy_v1beta3_RoleBindingList,
+		deepCopy_v1beta3_RoleBindingRestriction,
+		deepCopy_v1beta3_RoleBindingRestrictionList,
+		deepCopy_v1beta3_RoleBindingRestrictionSpec,
 		deepCopy_v1beta3_RoleList,
+		deepCopy_v1beta3_SelfSubjectRulesReview,
+		deepCopy_v1beta3_SelfSubjectRulesReviewSpec,
+		deepCopy_v1beta3_ServiceAccountReference,
+		deepCopy_v1beta3_ServiceAccountRestriction,
 		deepCopy_v1beta3_SubjectAccessReview,
 		deepCopy_v1beta3_SubjectAccessReviewResponse,
+		deepCopy_v1beta3_SubjectRulesReviewStatus,
+		deepCopy_v1beta3_UserRestriction,
 		deepCopy_v1beta3_BinaryBuildRequestOptions,
 		deepCopy_v1beta3_BinaryBuildSource,
 		deepCopy_v1beta3_Build,
@@ -2906,6 +3407,7 @@ func init() {
 		deepCopy_v1beta3_BuildConfigList,
 		deepCopy_v1beta3_BuildConfigSpec,
 		deepCopy_v1beta3_BuildConfigStatus,
+		deepCopy_v1beta3_BuildHistory,
 		deepCopy_v1beta3_BuildList,
 		deepCopy_v1beta3_BuildLog,
 		deepCopy_v1beta3_BuildLogOptions,
@@ -2915,6 +3417,7 @@ func init() {
 		deepCopy_v1beta3_BuildSpec,
 		deepCopy_v1beta3_BuildStatus,
 		deepCopy_v1beta3_BuildStrategy,
+		deepCopy_v1beta3_BuildSummary,
 		deepCopy_v1beta3_BuildTriggerPolicy,
 		deepCopy_v1beta3_CustomBuildStrategy,
 		deepCopy_v1beta3_DockerBuildStrategy,
@@ -2929,6 +3432,9 @@ func init() {
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_WebHookTriggerURL,
+		deepCopy_v1beta3_CanaryCheck,
+		deepCopy_v1beta3_CanaryHTTPGetAction,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
@@ -2972,9 +3478,11 @@ func init() {
 		deepCopy_v1beta3_OAuthClientAuthorization,
 		deepCopy_v1beta3_OAuthClientAuthorizationList,
 		deepCopy_v1beta3_OAuthClientList,
+		deepCopy_v1beta3_ServiceAccountTokenRequest,
 		deepCopy_v1beta3_Project,
 		deepCopy_v1beta3_ProjectList,
 		deepCopy_v1beta3_ProjectRequest,
+		deepCopy_v1beta3_ProjectSecurityAllocation,
 		deepCopy_v1beta3_ProjectSpec,
 		deepCopy_v1beta3_ProjectStatus,
 		deepCopy_v1beta3_Route,
@@ -2984,12 +3492,15 @@ func init() {
 		deepCopy_v1beta3_RouteStatus,
 		deepCopy_v1beta3_TLSConfig,
 		deepCopy_v1beta3_ClusterNetwork,
+		deepCopy_v1beta3_ClusterNetworkEntry,
 		deepCopy_v1beta3_ClusterNetworkList,
 		deepCopy_v1beta3_HostSubnet,
 		deepCopy_v1beta3_HostSubnetList,
 		deepCopy_v1beta3_NetNamespace,
 		deepCopy_v1beta3_NetNamespaceList,
 		deepCopy_v1beta3_Parameter,
+		deepCopy_v1beta3_ParameterSource,
+		deepCopy_v1beta3_SecretKeySelector,
 		deepCopy_v1beta3_Template,
 		deepCopy_v1beta3_TemplateList,
 		deepCopy_v1beta3_Group,
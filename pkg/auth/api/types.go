@@ -13,6 +13,19 @@ const (
 	// This is useful when the immutable providerUserName is different than the login used to authenticate
 	// If present, this extra value is used as the preferred username
 	IdentityPreferredUsernameKey = "preferred_username"
+
+	// ImpersonateUserHeader is the header a request sets to act as another user
+	ImpersonateUserHeader = "Impersonate-User"
+	// ImpersonateGroupHeader is the header a request sets, once per group, to act as a member of the given groups.
+	// It may only be used together with ImpersonateUserHeader
+	ImpersonateGroupHeader = "Impersonate-Group"
+
+	// ServiceAccountTokenScopesAnnotation is the annotation of a service account token secret that lists, separated by
+	// commas, the scopes restricting what its token allows
+	ServiceAccountTokenScopesAnnotation = "openshift.io/token-scopes"
+	// ScopesAnnotation is the annotation of the user returned for users/~ that lists, separated by commas, the scopes
+	// restricting the token of the request
+	ScopesAnnotation = "openshift.io/scopes"
 )
 
 // UserIdentityInfo contains information about an identity.  Identities are distinct from users.  An authentication server of
@@ -79,3 +92,37 @@ func (i *DefaultUserIdentityInfo) GetProviderUserName() string {
 func (i *DefaultUserIdentityInfo) GetExtra() map[string]string {
 	return i.Extra
 }
+
+// ScopedUserInfo is a user.Info whose access is limited to the scopes of the token it authenticated with
+type ScopedUserInfo interface {
+	user.Info
+	// GetScopes returns the scopes that restrict what the user may do. No scopes means no restriction
+	GetScopes() []string
+}
+
+// DefaultScopedUserInfo provides a simple ScopedUserInfo implementation
+type DefaultScopedUserInfo struct {
+	user.DefaultInfo
+	Scopes []string
+}
+
+func (i *DefaultScopedUserInfo) GetScopes() []string {
+	return i.Scopes
+}
+
+// GetScopes returns the scopes restricting the given user, or nil if the user is not restricted
+func GetScopes(u user.Info) []string {
+	if scoped, ok := u.(ScopedUserInfo); ok {
+		return scoped.GetScopes()
+	}
+	return nil
+}
+
+// WithScopes returns a user.Info with the given name, uid and groups that keeps the scopes of the original user
+func WithScopes(original user.Info, name, uid string, groups []string) user.Info {
+	info := user.DefaultInfo{Name: name, UID: uid, Groups: groups}
+	if scopes := GetScopes(original); len(scopes) > 0 {
+		return &DefaultScopedUserInfo{DefaultInfo: info, Scopes: scopes}
+	}
+	return &info
+}
@@ -1,6 +1,7 @@
 package basicauthpassword
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,13 +25,36 @@ import (
 //   {"id":"userid"}
 // A successful response may also include name and/or email:
 //   {"id":"userid", "name": "User Name", "email":"user@example.com"}
+// The keys read from a successful response can be customized with an AttributeMapping.
 type Authenticator struct {
 	providerName string
 	url          string
 	client       *http.Client
+	attributes   AttributeMapping
 	mapper       authapi.UserIdentityMapper
 }
 
+// AttributeMapping lists the keys of a successful response to read identity information from.
+// For each identity field, the first key with a non-empty value is used.
+type AttributeMapping struct {
+	// ID is the list of keys whose values should be used as the user ID
+	ID []string
+	// PreferredUsername is the list of keys whose values should be used as the preferred username
+	PreferredUsername []string
+	// Name is the list of keys whose values should be used as the display name
+	Name []string
+	// Email is the list of keys whose values should be used as the email address
+	Email []string
+}
+
+// DefaultAttributeMapping reads the keys of RemoteUserData
+var DefaultAttributeMapping = AttributeMapping{
+	ID:                []string{"sub"},
+	PreferredUsername: []string{"preferred_username"},
+	Name:              []string{"name"},
+	Email:             []string{"email"},
+}
+
 // RemoteUserData holds user data returned from a remote basic-auth protected endpoint.
 // These field names can not be changed unless external integrators are also updated.
 // Names are based on standard OpenID Connect claims: http://openid.net/specs/openid-connect-core-1_0.html#StandardClaims
@@ -54,13 +78,26 @@ type RemoteError struct {
 
 // New returns an authenticator which will make a basic auth call to the given url.
 // A custom transport can be provided (typically to customize TLS options like trusted roots or present a client certificate).
-// If no transport is provided, http.DefaultTransport is used
-func New(providerName string, url string, transport http.RoundTripper, mapper authapi.UserIdentityMapper) authenticator.Password {
+// If no transport is provided, http.DefaultTransport is used. The transport is shared by all requests, so connections are reused.
+// Identity fields left empty in attributes are read from the keys in DefaultAttributeMapping.
+func New(providerName string, url string, transport http.RoundTripper, attributes AttributeMapping, mapper authapi.UserIdentityMapper) authenticator.Password {
 	if transport == nil {
 		transport = http.DefaultTransport
 	}
+	if len(attributes.ID) == 0 {
+		attributes.ID = DefaultAttributeMapping.ID
+	}
+	if len(attributes.PreferredUsername) == 0 {
+		attributes.PreferredUsername = DefaultAttributeMapping.PreferredUsername
+	}
+	if len(attributes.Name) == 0 {
+		attributes.Name = DefaultAttributeMapping.Name
+	}
+	if len(attributes.Email) == 0 {
+		attributes.Email = DefaultAttributeMapping.Email
+	}
 	client := &http.Client{Transport: transport}
-	return &Authenticator{providerName, url, client, mapper}
+	return &Authenticator{providerName, url, client, attributes, mapper}
 }
 
 func (a *Authenticator) AuthenticatePassword(username, password string) (user.Info, bool, error) {
@@ -84,15 +121,16 @@ func (a *Authenticator) AuthenticatePassword(username, password string) (user.In
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusUnauthorized {
-		return nil, false, nil
-	}
-
+	// Read the whole body before checking the status, so the connection can be reused
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, false, err
 	}
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, false, nil
+	}
+
 	remoteError := RemoteError{}
 	json.Unmarshal(body, &remoteError)
 	if remoteError.Error != "" {
@@ -103,25 +141,27 @@ func (a *Authenticator) AuthenticatePassword(username, password string) (user.In
 		return nil, false, fmt.Errorf("An error occurred while authenticating (%d)", resp.StatusCode)
 	}
 
-	remoteUserData := RemoteUserData{}
-	err = json.Unmarshal(body, &remoteUserData)
-	if err != nil {
+	remoteUserData := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&remoteUserData); err != nil {
 		return nil, false, err
 	}
 
-	if len(remoteUserData.Subject) == 0 {
+	id := firstValue(remoteUserData, a.attributes.ID)
+	if len(id) == 0 {
 		return nil, false, errors.New("Could not retrieve user data")
 	}
-	identity := authapi.NewDefaultUserIdentityInfo(a.providerName, remoteUserData.Subject)
+	identity := authapi.NewDefaultUserIdentityInfo(a.providerName, id)
 
-	if len(remoteUserData.Name) > 0 {
-		identity.Extra[authapi.IdentityDisplayNameKey] = remoteUserData.Name
+	if name := firstValue(remoteUserData, a.attributes.Name); len(name) > 0 {
+		identity.Extra[authapi.IdentityDisplayNameKey] = name
 	}
-	if len(remoteUserData.PreferredUsername) > 0 {
-		identity.Extra[authapi.IdentityPreferredUsernameKey] = remoteUserData.PreferredUsername
+	if preferredUsername := firstValue(remoteUserData, a.attributes.PreferredUsername); len(preferredUsername) > 0 {
+		identity.Extra[authapi.IdentityPreferredUsernameKey] = preferredUsername
 	}
-	if len(remoteUserData.Email) > 0 {
-		identity.Extra[authapi.IdentityEmailKey] = remoteUserData.Email
+	if email := firstValue(remoteUserData, a.attributes.Email); len(email) > 0 {
+		identity.Extra[authapi.IdentityEmailKey] = email
 	}
 
 	user, err := a.mapper.UserFor(identity)
@@ -132,3 +172,18 @@ func (a *Authenticator) AuthenticatePassword(username, password string) (user.In
 
 	return user, true, nil
 }
+
+// firstValue returns the first non-empty string or number value of the given keys
+func firstValue(data map[string]interface{}, keys []string) string {
+	for _, key := range keys {
+		switch value := data[key].(type) {
+		case string:
+			if len(value) > 0 {
+				return value
+			}
+		case json.Number:
+			return value.String()
+		}
+	}
+	return ""
+}
@@ -3,7 +3,14 @@ package basicauthpassword
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
 )
 
 func TestUnmarshal(t *testing.T) {
@@ -43,3 +50,93 @@ func TestUnmarshal(t *testing.T) {
 	}
 
 }
+
+type testUserIdentityMapper struct {
+	identity authapi.UserIdentityInfo
+}
+
+func (m *testUserIdentityMapper) UserFor(identityInfo authapi.UserIdentityInfo) (user.Info, error) {
+	m.identity = identityInfo
+	return &user.DefaultInfo{Name: identityInfo.GetProviderUserName()}, nil
+}
+
+func TestAuthenticatePassword(t *testing.T) {
+	testCases := map[string]struct {
+		attributes AttributeMapping
+		status     int
+		response   string
+
+		expectOK    bool
+		expectErr   bool
+		expectID    string
+		expectExtra map[string]string
+	}{
+		"default keys": {
+			status:   http.StatusOK,
+			response: `{"sub":"12345","name":"My Name","email":"me@example.com","preferred_username":"me"}`,
+			expectOK: true,
+			expectID: "12345",
+			expectExtra: map[string]string{
+				authapi.IdentityDisplayNameKey:       "My Name",
+				authapi.IdentityEmailKey:             "me@example.com",
+				authapi.IdentityPreferredUsernameKey: "me",
+			},
+		},
+		"custom keys": {
+			attributes: AttributeMapping{ID: []string{"uid", "id"}, Email: []string{"mail"}},
+			status:     http.StatusOK,
+			response:   `{"sub":"ignored","id":12345,"mail":"me@example.com","name":"My Name"}`,
+			expectOK:   true,
+			expectID:   "12345",
+			expectExtra: map[string]string{
+				authapi.IdentityDisplayNameKey: "My Name",
+				authapi.IdentityEmailKey:       "me@example.com",
+			},
+		},
+		"missing id": {
+			attributes: AttributeMapping{ID: []string{"uid"}},
+			status:     http.StatusOK,
+			response:   `{"sub":"12345"}`,
+			expectErr:  true,
+		},
+		"unauthorized": {
+			status:   http.StatusUnauthorized,
+			response: `{}`,
+		},
+		"remote error": {
+			status:    http.StatusOK,
+			response:  `{"error":"Account locked"}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(tc.status)
+			fmt.Fprint(w, tc.response)
+		}))
+
+		mapper := &testUserIdentityMapper{}
+		auth := New("basicauth", server.URL, nil, tc.attributes, mapper)
+		_, ok, err := auth.AuthenticatePassword("user", "password")
+		server.Close()
+
+		if tc.expectErr != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", name, tc.expectErr, err)
+			continue
+		}
+		if tc.expectOK != ok {
+			t.Errorf("%s: expected ok=%v, got %v", name, tc.expectOK, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if mapper.identity.GetProviderUserName() != tc.expectID {
+			t.Errorf("%s: expected id %q, got %q", name, tc.expectID, mapper.identity.GetProviderUserName())
+		}
+		if !reflect.DeepEqual(mapper.identity.GetExtra(), tc.expectExtra) {
+			t.Errorf("%s: expected extra %#v, got %#v", name, tc.expectExtra, mapper.identity.GetExtra())
+		}
+	}
+}
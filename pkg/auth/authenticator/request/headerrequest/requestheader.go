@@ -13,6 +13,12 @@ import (
 type Config struct {
 	// UserNameHeaders lists the headers to check (in order, case-insensitively) for a username. The first header with a value wins.
 	UserNameHeaders []string
+	// PreferredUserNameHeaders lists the headers to check (in order, case-insensitively) for a preferred username. The first header with a value wins.
+	PreferredUserNameHeaders []string
+	// NameHeaders lists the headers to check (in order, case-insensitively) for a display name. The first header with a value wins.
+	NameHeaders []string
+	// EmailHeaders lists the headers to check (in order, case-insensitively) for an email address. The first header with a value wins.
+	EmailHeaders []string
 }
 
 func NewDefaultConfig() *Config {
@@ -32,22 +38,22 @@ func NewAuthenticator(providerName string, config *Config, mapper authapi.UserId
 }
 
 func (a *Authenticator) AuthenticateRequest(req *http.Request) (user.Info, bool, error) {
-	username := ""
-	for _, header := range a.config.UserNameHeaders {
-		header = strings.TrimSpace(header)
-		if len(header) == 0 {
-			continue
-		}
-		username = req.Header.Get(header)
-		if len(username) != 0 {
-			break
-		}
-	}
+	username := headerValue(req.Header, a.config.UserNameHeaders)
 	if len(username) == 0 {
 		return nil, false, nil
 	}
 
 	identity := authapi.NewDefaultUserIdentityInfo(a.providerName, username)
+	if preferredUsername := headerValue(req.Header, a.config.PreferredUserNameHeaders); len(preferredUsername) > 0 {
+		identity.Extra[authapi.IdentityPreferredUsernameKey] = preferredUsername
+	}
+	if name := headerValue(req.Header, a.config.NameHeaders); len(name) > 0 {
+		identity.Extra[authapi.IdentityDisplayNameKey] = name
+	}
+	if email := headerValue(req.Header, a.config.EmailHeaders); len(email) > 0 {
+		identity.Extra[authapi.IdentityEmailKey] = email
+	}
+
 	user, err := a.mapper.UserFor(identity)
 	if err != nil {
 		return nil, false, err
@@ -56,3 +62,17 @@ func (a *Authenticator) AuthenticateRequest(req *http.Request) (user.Info, bool,
 
 	return user, true, nil
 }
+
+// headerValue returns the first non-empty value of the given headers
+func headerValue(h http.Header, headerNames []string) string {
+	for _, headerName := range headerNames {
+		headerName = strings.TrimSpace(headerName)
+		if len(headerName) == 0 {
+			continue
+		}
+		if value := h.Get(headerName); len(value) != 0 {
+			return value
+		}
+	}
+	return ""
+}
@@ -2,15 +2,19 @@ package headerrequest
 
 import (
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/openshift/origin/pkg/auth/api"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
 
-type TestUserIdentityMapper struct{}
+type TestUserIdentityMapper struct {
+	Identity api.UserIdentityInfo
+}
 
 func (m *TestUserIdentityMapper) UserFor(identityInfo api.UserIdentityInfo) (user.Info, error) {
+	m.Identity = identityInfo
 	return &user.DefaultInfo{Name: identityInfo.GetProviderUserName()}, nil
 }
 
@@ -61,7 +65,7 @@ func TestRequestHeader(t *testing.T) {
 
 	for k, testcase := range testcases {
 		mapper := &TestUserIdentityMapper{}
-		auth := NewAuthenticator("testprovider", &Config{testcase.ConfiguredHeaders}, mapper)
+		auth := NewAuthenticator("testprovider", &Config{UserNameHeaders: testcase.ConfiguredHeaders}, mapper)
 		req := &http.Request{Header: testcase.RequestHeaders}
 
 		user, ok, err := auth.AuthenticateRequest(req)
@@ -87,3 +91,60 @@ func TestRequestHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestHeaderExtra(t *testing.T) {
+	config := &Config{
+		UserNameHeaders:          []string{"X-Remote-User"},
+		PreferredUserNameHeaders: []string{"X-Remote-Login", "X-Remote-Preferred-User"},
+		NameHeaders:              []string{"X-Remote-Name"},
+		EmailHeaders:             []string{"X-Remote-Email"},
+	}
+
+	testcases := map[string]struct {
+		RequestHeaders http.Header
+		ExpectedExtra  map[string]string
+	}{
+		"no extra headers": {
+			RequestHeaders: http.Header{"X-Remote-User": {"12345"}},
+			ExpectedExtra:  map[string]string{},
+		},
+		"all extra headers": {
+			RequestHeaders: http.Header{
+				"X-Remote-User":           {"12345"},
+				"X-Remote-Preferred-User": {"bob"},
+				"X-Remote-Name":           {"Bob Smith"},
+				"X-Remote-Email":          {"bob@example.com"},
+			},
+			ExpectedExtra: map[string]string{
+				api.IdentityPreferredUsernameKey: "bob",
+				api.IdentityDisplayNameKey:       "Bob Smith",
+				api.IdentityEmailKey:             "bob@example.com",
+			},
+		},
+		"first preferred username header": {
+			RequestHeaders: http.Header{
+				"X-Remote-User":           {"12345"},
+				"X-Remote-Login":          {"bsmith"},
+				"X-Remote-Preferred-User": {"bob"},
+			},
+			ExpectedExtra: map[string]string{
+				api.IdentityPreferredUsernameKey: "bsmith",
+			},
+		},
+	}
+
+	for k, testcase := range testcases {
+		mapper := &TestUserIdentityMapper{}
+		auth := NewAuthenticator("testprovider", config, mapper)
+		req := &http.Request{Header: testcase.RequestHeaders}
+
+		_, ok, err := auth.AuthenticateRequest(req)
+		if err != nil || !ok {
+			t.Errorf("%s: Expected user, got ok=%v, err=%v", k, ok, err)
+			continue
+		}
+		if !reflect.DeepEqual(testcase.ExpectedExtra, mapper.Identity.GetExtra()) {
+			t.Errorf("%s: Expected extra %#v, got %#v", k, testcase.ExpectedExtra, mapper.Identity.GetExtra())
+		}
+	}
+}
@@ -3,11 +3,13 @@ package x509request
 import (
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"net/http"
 
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/user"
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
 )
 
 // UserConversion defines an interface for extracting user info from a client certificate chain
@@ -68,10 +70,16 @@ func (a *Authenticator) AuthenticateRequest(req *http.Request) (user.Info, bool,
 type Verifier struct {
 	opts x509.VerifyOptions
 	auth authenticator.Request
+
+	// allowedCommonNames contains the common names which a verified certificate is allowed to have.
+	// If empty, all verified certificates are allowed.
+	allowedCommonNames sets.String
 }
 
-func NewVerifier(opts x509.VerifyOptions, auth authenticator.Request) authenticator.Request {
-	return &Verifier{opts, auth}
+// NewVerifier returns a request.Authenticator that verifies client certs using the provided opts,
+// requires the cert to have one of the allowedCommonNames (if any are given), then delegates to the wrapped auth
+func NewVerifier(opts x509.VerifyOptions, auth authenticator.Request, allowedCommonNames sets.String) authenticator.Request {
+	return &Verifier{opts, auth, allowedCommonNames}
 }
 
 // AuthenticateRequest verifies the presented client certificates, then delegates to the wrapped auth
@@ -87,11 +95,23 @@ func (a *Verifier) AuthenticateRequest(req *http.Request) (user.Info, bool, erro
 			errlist = append(errlist, err)
 			continue
 		}
+		if err := a.verifySubject(cert.Subject); err != nil {
+			errlist = append(errlist, err)
+			continue
+		}
 		return a.auth.AuthenticateRequest(req)
 	}
 	return nil, false, kerrors.NewAggregate(errlist)
 }
 
+// verifySubject returns an error if the subject's common name is not one of the allowed common names
+func (a *Verifier) verifySubject(subject pkix.Name) error {
+	if len(a.allowedCommonNames) == 0 || a.allowedCommonNames.Has(subject.CommonName) {
+		return nil
+	}
+	return fmt.Errorf("x509: subject with cn=%s is not in the allowed list: %v", subject.CommonName, a.allowedCommonNames.List())
+}
+
 // DefaultVerifyOptions returns VerifyOptions that use the system root certificates, current time,
 // and requires certificates to be valid for client auth (x509.ExtKeyUsageClientAuth)
 func DefaultVerifyOptions() x509.VerifyOptions {
@@ -1,9 +1,12 @@
 package remotemaster
 
 import (
+	"fmt"
+
 	"k8s.io/kubernetes/pkg/auth/user"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
@@ -40,6 +43,11 @@ func (a *Authenticator) AuthenticateToken(value string) (user.Info, bool, error)
 		return nil, false, err
 	}
 
+	// node authorization does not restrict users to the scopes of their token, so scoped tokens are not accepted
+	if scopes := u.Annotations[authapi.ScopesAnnotation]; len(scopes) > 0 {
+		return nil, false, fmt.Errorf("tokens restricted to scopes are not accepted by nodes: %s", scopes)
+	}
+
 	return &user.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
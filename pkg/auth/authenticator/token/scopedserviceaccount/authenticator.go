@@ -0,0 +1,62 @@
+package scopedserviceaccount
+
+import (
+	"crypto/rsa"
+	"errors"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+// TokenAuthenticator authenticates service account tokens, and restricts their users to the scopes annotated on the
+// secret holding the token.
+type TokenAuthenticator struct {
+	keys    []*rsa.PublicKey
+	secrets serviceaccount.ServiceAccountTokenGetter
+}
+
+// NewTokenAuthenticator returns a TokenAuthenticator verifying tokens with any of keys, and looking up their service
+// accounts and secrets through secrets.
+func NewTokenAuthenticator(keys []*rsa.PublicKey, secrets serviceaccount.ServiceAccountTokenGetter) *TokenAuthenticator {
+	return &TokenAuthenticator{keys: keys, secrets: secrets}
+}
+
+func (a *TokenAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	// the secret looked up to check that the token was not invalidated holds its scopes
+	secrets := &recordingSecretGetter{ServiceAccountTokenGetter: a.secrets}
+	u, ok, err := serviceaccount.JWTTokenAuthenticator(a.keys, true, secrets).AuthenticateToken(value)
+	if !ok || err != nil {
+		return u, ok, err
+	}
+	if secrets.secret == nil {
+		return nil, false, errors.New("the secret of the service account token was not looked up")
+	}
+
+	scopes := []string{}
+	for _, scope := range strings.Split(secrets.secret.Annotations[authapi.ServiceAccountTokenScopesAnnotation], ",") {
+		if scope = strings.TrimSpace(scope); len(scope) > 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return u, true, nil
+	}
+	info := user.DefaultInfo{Name: u.GetName(), UID: u.GetUID(), Groups: u.GetGroups()}
+	return &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: scopes}, true, nil
+}
+
+// recordingSecretGetter keeps the secret it last returned. It is used for a single token.
+type recordingSecretGetter struct {
+	serviceaccount.ServiceAccountTokenGetter
+	secret *kapi.Secret
+}
+
+func (g *recordingSecretGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	secret, err := g.ServiceAccountTokenGetter.GetSecret(namespace, name)
+	g.secret = secret
+	return secret, err
+}
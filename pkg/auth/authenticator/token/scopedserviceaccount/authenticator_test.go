@@ -0,0 +1,125 @@
+package scopedserviceaccount
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+)
+
+type fakeSecretGetter struct {
+	serviceAccount *kapi.ServiceAccount
+	secret         *kapi.Secret
+	secretLookups  int
+}
+
+func (g *fakeSecretGetter) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	if namespace != g.serviceAccount.Namespace || name != g.serviceAccount.Name {
+		return nil, kerrors.NewNotFound("serviceaccount", name)
+	}
+	return g.serviceAccount, nil
+}
+
+func (g *fakeSecretGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	g.secretLookups++
+	if namespace != g.secret.Namespace || name != g.secret.Name {
+		return nil, kerrors.NewNotFound("secret", name)
+	}
+	return g.secret, nil
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	robot := kapi.ServiceAccount{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test", UID: "1"}}
+	token := func(key *rsa.PrivateKey, secretName string) string {
+		value, err := serviceaccount.JWTTokenGenerator(key).GenerateToken(robot, kapi.Secret{ObjectMeta: kapi.ObjectMeta{Name: secretName, Namespace: "test"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		token          string
+		authenticated  bool
+		expectedScopes []string
+		expectErr      bool
+	}{
+		{
+			name:          "unscoped token",
+			token:         token(key, "robot-token"),
+			authenticated: true,
+		},
+		{
+			name:           "scoped token",
+			annotations:    map[string]string{authapi.ServiceAccountTokenScopesAnnotation: "user:info, role:system:image-puller:test"},
+			token:          token(key, "robot-token"),
+			authenticated:  true,
+			expectedScopes: []string{"user:info", "role:system:image-puller:test"},
+		},
+		{
+			name:      "token of a deleted secret",
+			token:     token(key, "other-token"),
+			expectErr: true,
+		},
+		{
+			name:      "token signed with another key",
+			token:     token(otherKey, "robot-token"),
+			expectErr: true,
+		},
+		{
+			name:  "unauthenticated token",
+			token: "unknown",
+		},
+	}
+
+	for _, test := range tests {
+		secret := &kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Name: "robot-token", Namespace: "test", Annotations: test.annotations},
+			Data:       map[string][]byte{kapi.ServiceAccountTokenKey: []byte(test.token)},
+		}
+		getter := &fakeSecretGetter{serviceAccount: &robot, secret: secret}
+		authenticator := NewTokenAuthenticator([]*rsa.PublicKey{&key.PublicKey}, getter)
+		u, ok, err := authenticator.AuthenticateToken(test.token)
+		switch {
+		case test.expectErr:
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		case err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		case ok != test.authenticated:
+			t.Errorf("%s: expected authenticated %v, got %v", test.name, test.authenticated, ok)
+			continue
+		case !ok:
+			continue
+		}
+		if u.GetName() != "system:serviceaccount:test:robot" || u.GetUID() != "1" {
+			t.Errorf("%s: unexpected user %#v", test.name, u)
+		}
+		if scopes := authapi.GetScopes(u); !reflect.DeepEqual(scopes, test.expectedScopes) {
+			t.Errorf("%s: expected scopes %v, got %v", test.name, test.expectedScopes, scopes)
+		}
+		if getter.secretLookups != 1 {
+			t.Errorf("%s: expected the secret to be looked up once, got %d lookups", test.name, getter.secretLookups)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package group
 import (
 	"net/http"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
@@ -18,11 +19,8 @@ func (g *GroupAdder) AuthenticateRequest(req *http.Request) (user.Info, bool, er
 	if err != nil || !ok {
 		return nil, ok, err
 	}
-	return &user.DefaultInfo{
-		Name:   u.GetName(),
-		UID:    u.GetUID(),
-		Groups: append(u.GetGroups(), g.Groups...),
-	}, true, nil
+	// Keep any scopes restricting the user
+	return authapi.WithScopes(u, u.GetName(), u.GetUID(), append(u.GetGroups(), g.Groups...)), true, nil
 }
 
 func NewGroupAdder(auth authenticator.Request, groups []string) *GroupAdder {
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/authenticator"
 	"k8s.io/kubernetes/pkg/auth/user"
 )
@@ -24,3 +25,19 @@ func TestGroupAdder(t *testing.T) {
 		t.Errorf("Expected original,added groups, got %#v", user.GetGroups())
 	}
 }
+
+func TestGroupAdderKeepsScopes(t *testing.T) {
+	adder := authenticator.Request(
+		NewGroupAdder(
+			authenticator.RequestFunc(func(req *http.Request) (user.Info, bool, error) {
+				return &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "user"}, Scopes: []string{"user:info"}}, true, nil
+			}),
+			[]string{"added"},
+		),
+	)
+
+	user, _, _ := adder.AuthenticateRequest(nil)
+	if !reflect.DeepEqual(authapi.GetScopes(user), []string{"user:info"}) {
+		t.Errorf("Expected user:info scope, got %#v", authapi.GetScopes(user))
+	}
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/RangelReale/osincli"
 	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/oauth/external"
@@ -18,11 +21,21 @@ const (
 	githubAuthorizeURL = "https://github.com/login/oauth/authorize"
 	githubTokenURL     = "https://github.com/login/oauth/access_token"
 	githubUserApiURL   = "https://api.github.com/user"
+	githubUserOrgURL   = "https://api.github.com/user/orgs"
+	githubUserTeamURL  = "https://api.github.com/user/teams"
 	githubOAuthScope   = "user:email"
+	githubOrgScope     = "read:org"
 )
 
+// linkNextRegex matches the URL of the next page in the Link header of paginated GitHub API responses
+var linkNextRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
 type provider struct {
 	providerName, clientID, clientSecret string
+	// allowedOrganizations, if not empty, restricts login to members of these organizations
+	allowedOrganizations sets.String
+	// allowedTeams, if not empty, restricts login to members of these teams, given as <org>/<team>
+	allowedTeams sets.String
 }
 
 type githubUser struct {
@@ -32,8 +45,35 @@ type githubUser struct {
 	Name  string
 }
 
-func NewProvider(providerName, clientID, clientSecret string) external.Provider {
-	return provider{providerName, clientID, clientSecret}
+type githubOrg struct {
+	ID    uint64
+	Login string
+}
+
+type githubTeam struct {
+	ID           uint64
+	Slug         string
+	Organization githubOrg
+}
+
+// NewProvider returns a GitHub provider. If organizations or teams are given, only members of at least one
+// of them are allowed to log in. Organization and team names are compared case-insensitively.
+func NewProvider(providerName, clientID, clientSecret string, organizations, teams []string) external.Provider {
+	allowedOrganizations := sets.NewString()
+	for _, org := range organizations {
+		allowedOrganizations.Insert(strings.ToLower(org))
+	}
+	allowedTeams := sets.NewString()
+	for _, team := range teams {
+		allowedTeams.Insert(strings.ToLower(team))
+	}
+	return provider{
+		providerName:         providerName,
+		clientID:             clientID,
+		clientSecret:         clientSecret,
+		allowedOrganizations: allowedOrganizations,
+		allowedTeams:         allowedTeams,
+	}
 }
 
 func (p provider) GetTransport() (http.RoundTripper, error) {
@@ -42,6 +82,11 @@ func (p provider) GetTransport() (http.RoundTripper, error) {
 
 // NewConfig implements external/interfaces/Provider.NewConfig
 func (p provider) NewConfig() (*osincli.ClientConfig, error) {
+	scopes := []string{githubOAuthScope}
+	if len(p.allowedOrganizations) > 0 || len(p.allowedTeams) > 0 {
+		// membership of private organizations and teams is only visible with the read:org scope
+		scopes = append(scopes, githubOrgScope)
+	}
 	config := &osincli.ClientConfig{
 		ClientId:                 p.clientID,
 		ClientSecret:             p.clientSecret,
@@ -49,7 +94,7 @@ func (p provider) NewConfig() (*osincli.ClientConfig, error) {
 		SendClientSecretInParams: true,
 		AuthorizeUrl:             githubAuthorizeURL,
 		TokenUrl:                 githubTokenURL,
-		Scope:                    githubOAuthScope,
+		Scope:                    strings.Join(scopes, " "),
 	}
 	return config, nil
 }
@@ -60,23 +105,8 @@ func (p provider) AddCustomParameters(req *osincli.AuthorizeRequest) {
 
 // GetUserIdentity implements external/interfaces/Provider.GetUserIdentity
 func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
-	req, _ := http.NewRequest("GET", githubUserApiURL, nil)
-	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", data.AccessToken))
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, false, err
-	}
-	defer res.Body.Close()
-
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, false, err
-	}
-
 	userdata := githubUser{}
-	err = json.Unmarshal(body, &userdata)
-	if err != nil {
+	if _, err := getJSON(githubUserApiURL, data.AccessToken, &userdata); err != nil {
 		return nil, false, err
 	}
 
@@ -84,6 +114,10 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 		return nil, false, errors.New("Could not retrieve GitHub id")
 	}
 
+	if err := p.checkMembership(userdata.Login, data.AccessToken); err != nil {
+		return nil, false, err
+	}
+
 	identity := authapi.NewDefaultUserIdentityInfo(p.providerName, fmt.Sprintf("%d", userdata.ID))
 	if len(userdata.Name) > 0 {
 		identity.Extra[authapi.IdentityDisplayNameKey] = userdata.Name
@@ -98,3 +132,72 @@ func (p provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentit
 
 	return identity, true, nil
 }
+
+// checkMembership returns an error if login is restricted to organizations or teams the user is not a member of
+func (p provider) checkMembership(login, accessToken string) error {
+	if len(p.allowedOrganizations) > 0 {
+		for url := githubUserOrgURL; len(url) > 0; {
+			orgs := []githubOrg{}
+			next, err := getJSON(url, accessToken, &orgs)
+			if err != nil {
+				return err
+			}
+			for _, org := range orgs {
+				if p.allowedOrganizations.Has(strings.ToLower(org.Login)) {
+					return nil
+				}
+			}
+			url = next
+		}
+		return fmt.Errorf("User %s is not a member of any allowed organization %v", login, p.allowedOrganizations.List())
+	}
+
+	if len(p.allowedTeams) > 0 {
+		for url := githubUserTeamURL; len(url) > 0; {
+			teams := []githubTeam{}
+			next, err := getJSON(url, accessToken, &teams)
+			if err != nil {
+				return err
+			}
+			for _, team := range teams {
+				if p.allowedTeams.Has(strings.ToLower(team.Organization.Login + "/" + team.Slug)) {
+					return nil
+				}
+			}
+			url = next
+		}
+		return fmt.Errorf("User %s is not a member of any allowed team %v", login, p.allowedTeams.List())
+	}
+
+	return nil
+}
+
+// getJSON decodes the response of a GitHub API request into data, and returns the URL of the next page, if any
+func getJSON(url, accessToken string, data interface{}) (string, error) {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", accessToken))
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Non-200 response from GitHub API call %s: %d", url, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(body, data); err != nil {
+		return "", err
+	}
+
+	next := ""
+	if matches := linkNextRegex.FindStringSubmatch(res.Header.Get("Link")); len(matches) == 2 {
+		next = matches[1]
+	}
+	return next, nil
+}
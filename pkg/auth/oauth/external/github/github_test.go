@@ -7,5 +7,38 @@ import (
 )
 
 func TestGitHub(t *testing.T) {
-	_ = external.Provider(NewProvider("github", "clientid", "clientsecret"))
+	_ = external.Provider(NewProvider("github", "clientid", "clientsecret", nil, nil))
+}
+
+func TestGitHubScopes(t *testing.T) {
+	testCases := map[string]struct {
+		organizations []string
+		teams         []string
+		scope         string
+	}{
+		"unrestricted":  {scope: "user:email"},
+		"organizations": {organizations: []string{"openshift"}, scope: "user:email read:org"},
+		"teams":         {teams: []string{"openshift/team"}, scope: "user:email read:org"},
+	}
+
+	for name, tc := range testCases {
+		config, err := NewProvider("github", "clientid", "clientsecret", tc.organizations, tc.teams).NewConfig()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if config.Scope != tc.scope {
+			t.Errorf("%s: expected scope %q, got %q", name, tc.scope, config.Scope)
+		}
+	}
+}
+
+func TestLinkNext(t *testing.T) {
+	link := `<https://api.github.com/user/orgs?page=2>; rel="next", <https://api.github.com/user/orgs?page=3>; rel="last"`
+	matches := linkNextRegex.FindStringSubmatch(link)
+	if len(matches) != 2 || matches[1] != "https://api.github.com/user/orgs?page=2" {
+		t.Errorf("unexpected next link: %v", matches)
+	}
+	if matches := linkNextRegex.FindStringSubmatch(`<https://api.github.com/user/orgs?page=1>; rel="prev"`); len(matches) != 0 {
+		t.Errorf("unexpected next link: %v", matches)
+	}
 }
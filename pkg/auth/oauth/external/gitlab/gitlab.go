@@ -0,0 +1,189 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/RangelReale/osincli"
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/external"
+)
+
+const (
+	// Uses the GitLab OAuth provider (http://doc.gitlab.com/ce/integration/oauth_provider.html),
+	// the current user API (http://doc.gitlab.com/ce/api/users.html#current-user)
+	// and the groups API (http://doc.gitlab.com/ce/api/groups.html)
+	gitlabAuthorizePath = "/oauth/authorize"
+	gitlabTokenPath     = "/oauth/token"
+	gitlabUserAPIPath   = "/api/v3/user"
+	gitlabGroupsAPIPath = "/api/v3/groups"
+	gitlabOAuthScope    = "api"
+	gitlabPageSize      = "100"
+)
+
+type provider struct {
+	providerName string
+	transport    http.RoundTripper
+	authorizeURL string
+	tokenURL     string
+	userAPIURL   string
+	groupsAPIURL string
+	clientID     string
+	clientSecret string
+	// allowedGroups, if not empty, restricts login to members of these groups
+	allowedGroups sets.String
+}
+
+type gitlabUser struct {
+	ID       uint64
+	Username string
+	Email    string
+	Name     string
+}
+
+type gitlabGroup struct {
+	ID   uint64
+	Path string
+}
+
+// NewProvider returns a GitLab provider for the GitLab server at serverURL. If groups are given, only members
+// of at least one of them are allowed to log in.
+func NewProvider(providerName string, transport http.RoundTripper, serverURL, clientID, clientSecret string, groups []string) (external.Provider, error) {
+	// Create service URLs
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, errors.New("Host URL is invalid")
+	}
+
+	// GitLab group paths are not case sensitive
+	allowedGroups := sets.NewString()
+	for _, group := range groups {
+		allowedGroups.Insert(strings.ToLower(group))
+	}
+
+	return &provider{
+		providerName:  providerName,
+		transport:     transport,
+		authorizeURL:  appendPath(*u, gitlabAuthorizePath),
+		tokenURL:      appendPath(*u, gitlabTokenPath),
+		userAPIURL:    appendPath(*u, gitlabUserAPIPath),
+		groupsAPIURL:  appendPath(*u, gitlabGroupsAPIPath),
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		allowedGroups: allowedGroups,
+	}, nil
+}
+
+func appendPath(u url.URL, subpath string) string {
+	u.Path = strings.TrimSuffix(u.Path, "/") + subpath
+	return u.String()
+}
+
+func (p *provider) GetTransport() (http.RoundTripper, error) {
+	return p.transport, nil
+}
+
+// NewConfig implements external/interfaces/Provider.NewConfig
+func (p *provider) NewConfig() (*osincli.ClientConfig, error) {
+	config := &osincli.ClientConfig{
+		ClientId:                 p.clientID,
+		ClientSecret:             p.clientSecret,
+		ErrorsInStatusCode:       true,
+		SendClientSecretInParams: true,
+		AuthorizeUrl:             p.authorizeURL,
+		TokenUrl:                 p.tokenURL,
+		Scope:                    gitlabOAuthScope,
+	}
+	return config, nil
+}
+
+// AddCustomParameters implements external/interfaces/Provider.AddCustomParameters
+func (p *provider) AddCustomParameters(req *osincli.AuthorizeRequest) {
+}
+
+// GetUserIdentity implements external/interfaces/Provider.GetUserIdentity
+func (p *provider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
+	userdata := gitlabUser{}
+	if _, err := p.getJSON(p.userAPIURL, data.AccessToken, &userdata); err != nil {
+		return nil, false, err
+	}
+
+	if userdata.ID == 0 {
+		return nil, false, errors.New("Could not retrieve GitLab id")
+	}
+
+	if err := p.checkMembership(userdata.Username, data.AccessToken); err != nil {
+		return nil, false, err
+	}
+
+	identity := authapi.NewDefaultUserIdentityInfo(p.providerName, fmt.Sprintf("%d", userdata.ID))
+	if len(userdata.Name) > 0 {
+		identity.Extra[authapi.IdentityDisplayNameKey] = userdata.Name
+	}
+	if len(userdata.Username) > 0 {
+		identity.Extra[authapi.IdentityPreferredUsernameKey] = userdata.Username
+	}
+	if len(userdata.Email) > 0 {
+		identity.Extra[authapi.IdentityEmailKey] = userdata.Email
+	}
+	glog.V(4).Infof("Got identity=%#v", identity)
+
+	return identity, true, nil
+}
+
+// checkMembership returns an error if login is restricted to groups the user is not a member of
+func (p *provider) checkMembership(username, accessToken string) error {
+	if len(p.allowedGroups) == 0 {
+		return nil
+	}
+
+	for page := "1"; len(page) > 0; {
+		groups := []gitlabGroup{}
+		next, err := p.getJSON(p.groupsAPIURL+"?per_page="+gitlabPageSize+"&page="+page, accessToken, &groups)
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			if p.allowedGroups.Has(strings.ToLower(group.Path)) {
+				return nil
+			}
+		}
+		page = next
+	}
+	return fmt.Errorf("User %s is not a member of any allowed group %v", username, p.allowedGroups.List())
+}
+
+// getJSON decodes the response of a GitLab API request into data, and returns the number of the next page, if any
+func (p *provider) getJSON(url, accessToken string, data interface{}) (string, error) {
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", accessToken))
+
+	client := &http.Client{Transport: p.transport}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Non-200 response from GitLab API call %s: %d", url, res.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(body, data); err != nil {
+		return "", err
+	}
+
+	return res.Header.Get("X-Next-Page"), nil
+}
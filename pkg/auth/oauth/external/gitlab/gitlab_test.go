@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RangelReale/osincli"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/external"
+)
+
+func TestGitLab(t *testing.T) {
+	p, err := NewProvider("gitlab", nil, "https://gitlab.com/", "clientid", "clientsecret", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = external.Provider(p)
+
+	config, err := p.NewConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.AuthorizeUrl != "https://gitlab.com/oauth/authorize" || config.TokenUrl != "https://gitlab.com/oauth/token" {
+		t.Errorf("unexpected config: %#v", config)
+	}
+}
+
+func TestGitLabGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case gitlabUserAPIPath:
+			fmt.Fprint(w, `{"id":1,"username":"alice","name":"Alice","email":"alice@example.com"}`)
+		case gitlabGroupsAPIPath:
+			if req.URL.Query().Get("page") == "1" {
+				w.Header().Set("X-Next-Page", "2")
+				fmt.Fprint(w, `[{"id":1,"path":"developers"}]`)
+				return
+			}
+			fmt.Fprint(w, `[{"id":2,"path":"admins"}]`)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	testCases := map[string]struct {
+		groups  []string
+		allowed bool
+	}{
+		"no restriction":   {allowed: true},
+		"member":           {groups: []string{"admins"}, allowed: true},
+		"not a member":     {groups: []string{"testers"}, allowed: false},
+		"member of one of": {groups: []string{"testers", "developers"}, allowed: true},
+		"case insensitive": {groups: []string{"Admins"}, allowed: true},
+	}
+
+	for name, tc := range testCases {
+		p, err := NewProvider("gitlab", nil, server.URL, "clientid", "clientsecret", tc.groups)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		identity, ok, err := p.GetUserIdentity(&osincli.AccessData{AccessToken: "token"})
+		if !tc.allowed {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil || !ok {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if identity.GetProviderUserName() != "1" || identity.GetExtra()[authapi.IdentityPreferredUsernameKey] != "alice" {
+			t.Errorf("%s: unexpected identity: %#v", name, identity)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package openid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RangelReale/osincli"
+	"github.com/golang/glog"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/oauth/external"
+)
+
+// DiscoveryPath is the path of the provider configuration document, relative to the issuer
+// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfig
+const DiscoveryPath = "/.well-known/openid-configuration"
+
+// discoveryTimeout bounds the request for the provider configuration document
+const discoveryTimeout = 30 * time.Second
+
+// ProviderMetadata is the subset of the provider configuration document used to configure a provider
+// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+// Discover fetches the provider configuration document of the issuer
+func Discover(issuer string, transport http.RoundTripper) (*ProviderMetadata, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + DiscoveryPath
+
+	client := &http.Client{Transport: transport, Timeout: discoveryTimeout}
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Non-200 response from %s: %d", discoveryURL, resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &ProviderMetadata{}
+	if err := json.Unmarshal(data, metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing provider configuration from %s: %v", discoveryURL, err)
+	}
+
+	// The issuer value returned MUST be identical to the Issuer URL that was used to retrieve the configuration
+	// http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfigurationValidation
+	if metadata.Issuer != issuer {
+		return nil, fmt.Errorf("Provider configuration from %s is for issuer %q, expected %q", discoveryURL, metadata.Issuer, issuer)
+	}
+	if len(metadata.AuthorizationEndpoint) == 0 {
+		return nil, fmt.Errorf("Provider configuration from %s does not contain an authorization_endpoint", discoveryURL)
+	}
+	if len(metadata.TokenEndpoint) == 0 {
+		return nil, fmt.Errorf("Provider configuration from %s does not contain a token_endpoint", discoveryURL)
+	}
+	for _, endpoint := range []struct{ name, value string }{
+		{"authorization_endpoint", metadata.AuthorizationEndpoint},
+		{"token_endpoint", metadata.TokenEndpoint},
+		{"userinfo_endpoint", metadata.UserInfoEndpoint},
+	} {
+		if len(endpoint.value) == 0 {
+			continue
+		}
+		if u, err := url.Parse(endpoint.value); err != nil || u.Scheme != "https" {
+			return nil, fmt.Errorf("Provider configuration from %s has %s %q, which is not an https URL", discoveryURL, endpoint.name, endpoint.value)
+		}
+	}
+
+	return metadata, nil
+}
+
+// discoveringProvider is an OpenID Connect provider whose URLs are discovered from its issuer. An issuer that
+// cannot be reached must not prevent the master from starting, so discovery is retried each time the provider
+// is used until it succeeds.
+type discoveringProvider struct {
+	providerName string
+	transport    http.RoundTripper
+	issuer       string
+	config       Config
+
+	lock     sync.Mutex
+	provider external.Provider
+}
+
+// NewDiscoveringProvider returns an OpenID Connect provider that uses the URLs that are not set in config
+// from the provider configuration of the issuer, and ensures that id_tokens were issued by the issuer.
+func NewDiscoveringProvider(providerName string, transport http.RoundTripper, issuer string, config Config) external.Provider {
+	p := &discoveringProvider{
+		providerName: providerName,
+		transport:    transport,
+		issuer:       issuer,
+		config:       config,
+	}
+	if _, err := p.discover(); err != nil {
+		glog.Warningf("Identity provider %s will retry discovery when it is used: %v", providerName, err)
+	}
+	return p
+}
+
+// discover returns the provider once the configuration of the issuer has been discovered. The lock is not held
+// while the issuer is contacted, so a slow issuer does not block every request waiting for it.
+func (p *discoveringProvider) discover() (external.Provider, error) {
+	p.lock.Lock()
+	provider := p.provider
+	p.lock.Unlock()
+	if provider != nil {
+		return provider, nil
+	}
+
+	metadata, err := Discover(p.issuer, p.transport)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover the configuration of OpenID issuer %s: %v", p.issuer, err)
+	}
+	// explicitly configured URLs take precedence over the discovered ones
+	config := p.config
+	if len(config.AuthorizeURL) == 0 {
+		config.AuthorizeURL = metadata.AuthorizationEndpoint
+	}
+	if len(config.TokenURL) == 0 {
+		config.TokenURL = metadata.TokenEndpoint
+	}
+	if len(config.UserInfoURL) == 0 {
+		config.UserInfoURL = metadata.UserInfoEndpoint
+	}
+	config.IDTokenValidator = IssuerValidator(p.issuer)
+
+	provider, err = NewProvider(p.providerName, p.transport, config)
+	if err != nil {
+		return nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	// keep the provider of a concurrent discovery that finished first
+	if p.provider == nil {
+		p.provider = provider
+	}
+	return p.provider, nil
+}
+
+// NewConfig implements external/interfaces/Provider.NewConfig
+func (p *discoveringProvider) NewConfig() (*osincli.ClientConfig, error) {
+	provider, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	return provider.NewConfig()
+}
+
+func (p *discoveringProvider) GetTransport() (http.RoundTripper, error) {
+	return p.transport, nil
+}
+
+// AddCustomParameters implements external/interfaces/Provider.AddCustomParameters
+func (p *discoveringProvider) AddCustomParameters(req *osincli.AuthorizeRequest) {
+	for k, v := range p.config.ExtraAuthorizeParameters {
+		req.CustomParameters[k] = v
+	}
+}
+
+// GetUserIdentity implements external/interfaces/Provider.GetUserIdentity
+func (p *discoveringProvider) GetUserIdentity(data *osincli.AccessData) (authapi.UserIdentityInfo, bool, error) {
+	provider, err := p.discover()
+	if err != nil {
+		return nil, false, err
+	}
+	return provider.GetUserIdentity(data)
+}
+
+// IssuerValidator returns a TokenValidator that ensures id_tokens were issued by the given issuer
+// http://openid.net/specs/openid-connect-core-1_0.html#IDTokenValidation
+func IssuerValidator(issuer string) TokenValidator {
+	return func(claims map[string]interface{}) error {
+		if iss, _ := claims["iss"].(string); iss != issuer {
+			return fmt.Errorf("id_token was issued by %q, expected %q", iss, issuer)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,122 @@
+package openid
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	var issuer string
+	var document string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != DiscoveryPath {
+			http.NotFound(w, req)
+			return
+		}
+		fmt.Fprintf(w, document, issuer)
+	}))
+	defer server.Close()
+	issuer = server.URL
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+
+	testCases := map[string]struct {
+		document  string
+		expectErr bool
+	}{
+		"valid": {
+			document: `{"issuer":%q,"authorization_endpoint":"https://example.com/auth","token_endpoint":"https://example.com/token","userinfo_endpoint":"https://example.com/userinfo"}`,
+		},
+		"mismatched issuer": {
+			document:  `{"issuer":"https://other.example.com","authorization_endpoint":"https://example.com/auth","token_endpoint":"https://example.com/token"}%.0s`,
+			expectErr: true,
+		},
+		"missing token endpoint": {
+			document:  `{"issuer":%q,"authorization_endpoint":"https://example.com/auth"}`,
+			expectErr: true,
+		},
+		"http endpoint": {
+			document:  `{"issuer":%q,"authorization_endpoint":"https://example.com/auth","token_endpoint":"http://example.com/token"}`,
+			expectErr: true,
+		},
+		"invalid document": {
+			document:  `<html>%s</html>`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		document = tc.document
+		metadata, err := Discover(issuer, transport)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if metadata.AuthorizationEndpoint != "https://example.com/auth" || metadata.TokenEndpoint != "https://example.com/token" || metadata.UserInfoEndpoint != "https://example.com/userinfo" {
+			t.Errorf("%s: unexpected metadata: %#v", name, metadata)
+		}
+	}
+}
+
+func TestDiscoveringProvider(t *testing.T) {
+	var issuer string
+	available := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !available {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintf(w, `{"issuer":%q,"authorization_endpoint":"https://example.com/auth","token_endpoint":"https://example.com/token"}`, issuer)
+	}))
+	defer server.Close()
+	issuer = server.URL
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+
+	config := Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scopes:       []string{"openid"},
+		TokenURL:     "https://example.com/configured-token",
+		IDClaims:     []string{"sub"},
+	}
+	provider := NewDiscoveringProvider("openid", transport, issuer, config)
+	if _, err := provider.NewConfig(); err == nil {
+		t.Fatalf("expected an error while the issuer is unavailable")
+	}
+
+	available = true
+	clientConfig, err := provider.NewConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clientConfig.AuthorizeUrl != "https://example.com/auth" || clientConfig.TokenUrl != "https://example.com/configured-token" {
+		t.Errorf("expected the discovered authorize URL and the configured token URL, got %#v", clientConfig)
+	}
+
+	// the discovered configuration is kept
+	available = false
+	if _, err := provider.NewConfig(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestIssuerValidator(t *testing.T) {
+	validator := IssuerValidator("https://example.com")
+	if err := validator(map[string]interface{}{"iss": "https://example.com"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validator(map[string]interface{}{"iss": "https://other.example.com"}); err == nil {
+		t.Errorf("expected an error for a different issuer")
+	}
+	if err := validator(map[string]interface{}{}); err == nil {
+		t.Errorf("expected an error for a missing issuer")
+	}
+}
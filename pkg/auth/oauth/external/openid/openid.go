@@ -58,11 +58,8 @@ type provider struct {
 // See http://openid.net/specs/openid-connect-core-1_0.html#CodeFlowAuth
 // ID Token decryption is not supported
 // UserInfo decryption is not supported
+// Use NewDiscoveringProvider to discover the URLs from the provider configuration document
 func NewProvider(providerName string, transport http.RoundTripper, config Config) (external.Provider, error) {
-	// TODO: Add support for discovery documents
-	// see http://openid.net/specs/openid-connect-discovery-1_0.html#ProviderConfig
-	// e.g. https://accounts.google.com/.well-known/openid-configuration
-
 	// Validate client id/secret
 	if len(config.ClientID) == 0 {
 		return nil, errors.New("ClientID is required")
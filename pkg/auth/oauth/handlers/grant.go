@@ -8,7 +8,11 @@ import (
 	"github.com/RangelReale/osin"
 
 	"github.com/openshift/origin/pkg/auth/api"
+	oauthvalidation "github.com/openshift/origin/pkg/oauth/api/validation"
+	"github.com/openshift/origin/pkg/oauth/scope"
 	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	"k8s.io/kubernetes/pkg/util/validation/field"
 )
 
 // GrantCheck implements osinserver.AuthorizeHandler to ensure requested scopes have been authorized
@@ -43,6 +47,13 @@ func (h *GrantCheck) HandleAuthorize(ar *osin.AuthorizeRequest, w http.ResponseW
 		return h.errorHandler.GrantError(errors.New("the provided user data is not user.Info"), w, ar.HttpRequest)
 	}
 
+	// Service account clients are rejected before the user is asked to grant scopes they may not request
+	if _, _, err := serviceaccount.SplitUsername(ar.Client.GetId()); err == nil {
+		if errs := oauthvalidation.ValidateScopes(scope.Split(ar.Scope), ar.Client.GetId(), field.NewPath("scope")); len(errs) > 0 {
+			return h.errorHandler.GrantError(errs.ToAggregate(), w, ar.HttpRequest)
+		}
+	}
+
 	grant := &api.Grant{
 		Client:      ar.Client,
 		Scope:       ar.Scope,
@@ -122,3 +133,23 @@ func (g *redirectGrant) GrantNeeded(user user.Info, grant *api.Grant, w http.Res
 	http.Redirect(w, req, redirectURL.String(), http.StatusFound)
 	return false, true, nil
 }
+
+type clientSelectorGrant struct {
+	selects  func(client osin.Client) bool
+	selected GrantHandler
+	fallback GrantHandler
+}
+
+// NewClientSelectorGrant returns a grant handler that uses the selected handler for clients matched by selects,
+// and the fallback handler for all other clients
+func NewClientSelectorGrant(selects func(client osin.Client) bool, selected, fallback GrantHandler) GrantHandler {
+	return &clientSelectorGrant{selects, selected, fallback}
+}
+
+// GrantNeeded implements the GrantHandler interface
+func (g *clientSelectorGrant) GrantNeeded(user user.Info, grant *api.Grant, w http.ResponseWriter, req *http.Request) (bool, bool, error) {
+	if g.selects(grant.Client) {
+		return g.selected.GrantNeeded(user, grant, w, req)
+	}
+	return g.fallback.GrantNeeded(user, grant, w, req)
+}
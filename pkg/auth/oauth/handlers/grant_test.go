@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/RangelReale/osin"
+
+	"github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+	"k8s.io/kubernetes/pkg/auth/user"
 )
 
 func TestGrant(t *testing.T) {
@@ -21,3 +26,78 @@ func TestAutoGrant(t *testing.T) {
 func TestRedirectGrant(t *testing.T) {
 	_ = NewRedirectGrant("/")
 }
+
+func TestClientSelectorGrant(t *testing.T) {
+	selects := func(client osin.Client) bool { return client.GetId() == "selected" }
+	handler := NewClientSelectorGrant(selects, NewEmptyGrant(), NewAutoGrant())
+
+	granted, _, err := handler.GrantNeeded(nil, &api.Grant{Client: &osin.DefaultClient{Id: "selected"}}, nil, nil)
+	if err != nil || granted {
+		t.Errorf("expected the selected handler to deny the grant, got %v %v", granted, err)
+	}
+	granted, _, err = handler.GrantNeeded(nil, &api.Grant{Client: &osin.DefaultClient{Id: "other"}}, nil, nil)
+	if err != nil || !granted {
+		t.Errorf("expected the fallback handler to approve the grant, got %v %v", granted, err)
+	}
+}
+
+type testGrantChecker struct {
+	checked bool
+}
+
+func (c *testGrantChecker) HasAuthorizedClient(user user.Info, grant *api.Grant) (bool, error) {
+	c.checked = true
+	return true, nil
+}
+
+type testGrantErrorHandler struct {
+	err error
+}
+
+func (h *testGrantErrorHandler) GrantError(err error, w http.ResponseWriter, req *http.Request) (bool, error) {
+	h.err = err
+	return false, err
+}
+
+func TestGrantCheckServiceAccountScopes(t *testing.T) {
+	testCases := map[string]struct {
+		scope      string
+		authorized bool
+	}{
+		"no scopes":           {scope: "", authorized: false},
+		"allowed scopes":      {scope: "user:info role:edit:foo", authorized: true},
+		"full access":         {scope: "user:full", authorized: false},
+		"listing projects":    {scope: "user:info user:list-projects", authorized: false},
+		"role in another ns":  {scope: "role:edit:bar", authorized: false},
+		"role in every ns":    {scope: "role:edit:*", authorized: false},
+		"escalating role":     {scope: "role:admin:foo:!", authorized: false},
+		"unknown scope":       {scope: "user:bogus", authorized: false},
+		"check access":        {scope: "user:check-access", authorized: true},
+		"check access + info": {scope: "user:check-access user:info", authorized: true},
+	}
+
+	for k, tc := range testCases {
+		checker := &testGrantChecker{}
+		errorHandler := &testGrantErrorHandler{}
+		check := NewGrantCheck(checker, NewEmptyGrant(), errorHandler)
+
+		ar := &osin.AuthorizeRequest{
+			Authorized: true,
+			Client:     &osin.DefaultClient{Id: "system:serviceaccount:foo:jenkins"},
+			Scope:      tc.scope,
+			UserData:   &user.DefaultInfo{Name: "bob"},
+		}
+		if _, err := check.HandleAuthorize(ar, nil); err != nil && tc.authorized {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if ar.Authorized != tc.authorized {
+			t.Errorf("%s: expected authorized=%v, got %v", k, tc.authorized, ar.Authorized)
+		}
+		if checker.checked != tc.authorized {
+			t.Errorf("%s: expected the grant to be checked only for allowed scopes", k)
+		}
+		if (errorHandler.err == nil) == !tc.authorized {
+			t.Errorf("%s: expected a grant error only for disallowed scopes, got %v", k, errorHandler.err)
+		}
+	}
+}
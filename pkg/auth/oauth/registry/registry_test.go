@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 
@@ -332,3 +333,55 @@ func TestAuthenticateTokenValidated(t *testing.T) {
 		t.Error("Did not get a user!")
 	}
 }
+
+func TestAuthenticateTokenWithoutExpiration(t *testing.T) {
+	tokenRegistry := &test.AccessTokenRegistry{
+		Err: nil,
+		AccessToken: &oapi.OAuthAccessToken{
+			ObjectMeta: kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now().Add(-24 * time.Hour)}},
+			ExpiresIn:  0, // never expires
+			UserName:   "foo",
+			UserUID:    string("bar"),
+		},
+	}
+	userRegistry := usertest.NewUserRegistry()
+	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
+	if !found {
+		t.Error("Did not find a token!")
+	}
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if userInfo == nil {
+		t.Error("Did not get a user!")
+	}
+}
+
+func TestAuthenticateScopedToken(t *testing.T) {
+	tokenRegistry := &test.AccessTokenRegistry{
+		Err: nil,
+		AccessToken: &oapi.OAuthAccessToken{
+			ObjectMeta: kapi.ObjectMeta{CreationTimestamp: unversioned.Time{Time: time.Now()}},
+			ExpiresIn:  600, // 10 minutes
+			UserName:   "foo",
+			UserUID:    string("bar"),
+			Scopes:     []string{"user:info"},
+		},
+	}
+	userRegistry := usertest.NewUserRegistry()
+	userRegistry.Get["foo"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{UID: "bar"}}
+
+	tokenAuthenticator := NewTokenAuthenticator(tokenRegistry, userRegistry, identitymapper.NoopGroupMapper{})
+
+	userInfo, found, err := tokenAuthenticator.AuthenticateToken("token")
+	if !found || err != nil {
+		t.Fatalf("Unexpected result: %v %v", found, err)
+	}
+	if scopes := api.GetScopes(userInfo); !reflect.DeepEqual(scopes, []string{"user:info"}) {
+		t.Errorf("Expected the token scopes on the user, got %v", scopes)
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	"github.com/openshift/origin/pkg/user/registry/user"
 	"k8s.io/kubernetes/pkg/api"
 	kuser "k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 )
 
 type TokenAuthenticator struct {
@@ -31,12 +34,13 @@ func NewTokenAuthenticator(tokens oauthaccesstoken.Registry, users user.Registry
 func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool, error) {
 	ctx := api.NewContext()
 
-	token, err := a.tokens.GetAccessToken(ctx, value)
+	token, err := getValidToken(ctx, a.tokens, value)
 	if err != nil {
 		return nil, false, err
 	}
-	if token.CreationTimestamp.Time.Add(time.Duration(token.ExpiresIn) * time.Second).Before(time.Now()) {
-		return nil, false, ErrExpired
+	if _, _, err := serviceaccount.SplitUsername(token.UserName); err == nil {
+		// tokens of service accounts are authenticated by the ServiceAccountTokenAuthenticator
+		return nil, false, nil
 	}
 
 	u, err := a.users.GetUser(ctx, token.UserName)
@@ -57,9 +61,73 @@ func (a *TokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool,
 	}
 	groupNames = append(groupNames, u.Groups...)
 
-	return &kuser.DefaultInfo{
+	info := kuser.DefaultInfo{
 		Name:   u.Name,
 		UID:    string(u.UID),
 		Groups: groupNames,
-	}, true, nil
+	}
+	return scopedUser(info, token), true, nil
+}
+
+// ServiceAccountTokenAuthenticator authenticates the access tokens minted for service accounts. Tokens of other users
+// are left to the TokenAuthenticator.
+type ServiceAccountTokenAuthenticator struct {
+	tokens          oauthaccesstoken.Registry
+	serviceAccounts serviceaccount.ServiceAccountTokenGetter
+}
+
+func NewServiceAccountTokenAuthenticator(tokens oauthaccesstoken.Registry, serviceAccounts serviceaccount.ServiceAccountTokenGetter) *ServiceAccountTokenAuthenticator {
+	return &ServiceAccountTokenAuthenticator{
+		tokens:          tokens,
+		serviceAccounts: serviceAccounts,
+	}
+}
+
+func (a *ServiceAccountTokenAuthenticator) AuthenticateToken(value string) (kuser.Info, bool, error) {
+	ctx := api.NewContext()
+
+	token, err := getValidToken(ctx, a.tokens, value)
+	if err != nil {
+		return nil, false, err
+	}
+	namespace, name, err := serviceaccount.SplitUsername(token.UserName)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	serviceAccount, err := a.serviceAccounts.GetServiceAccount(namespace, name)
+	if err != nil {
+		return nil, false, err
+	}
+	if string(serviceAccount.UID) != token.UserUID {
+		return nil, false, fmt.Errorf("serviceAccount.UID (%s) does not match token.userUID (%s)", serviceAccount.UID, token.UserUID)
+	}
+
+	info := kuser.DefaultInfo{
+		Name:   token.UserName,
+		UID:    token.UserUID,
+		Groups: serviceaccount.MakeGroupNames(namespace, name),
+	}
+	return scopedUser(info, token), true, nil
+}
+
+// getValidToken returns the access token with the given value if it has not expired
+func getValidToken(ctx api.Context, tokens oauthaccesstoken.Registry, value string) (*oauthapi.OAuthAccessToken, error) {
+	token, err := tokens.GetAccessToken(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	// A token with no ExpiresIn does not expire
+	if token.ExpiresIn > 0 && token.CreationTimestamp.Time.Add(time.Duration(token.ExpiresIn)*time.Second).Before(time.Now()) {
+		return nil, ErrExpired
+	}
+	return token, nil
+}
+
+func scopedUser(info kuser.DefaultInfo, token *oauthapi.OAuthAccessToken) kuser.Info {
+	if len(token.Scopes) > 0 {
+		// Scoped tokens only allow what their scopes cover
+		return &authapi.DefaultScopedUserInfo{DefaultInfo: info, Scopes: token.Scopes}
+	}
+	return &info
 }
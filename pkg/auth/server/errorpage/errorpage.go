@@ -0,0 +1,125 @@
+package errorpage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/auth/oauth/handlers"
+)
+
+const (
+	// AuthenticationErrorCode is displayed when authenticating the user fails
+	AuthenticationErrorCode = "authentication_error"
+	// GrantErrorCode is displayed when checking or obtaining a grant fails
+	GrantErrorCode = "grant_error"
+)
+
+// errorMessages holds the message displayed for each error code. The underlying errors are logged
+// rather than displayed, since they can contain details about the server that users should not see.
+var errorMessages = map[string]string{
+	AuthenticationErrorCode: "An authentication error occurred.",
+	GrantErrorCode:          "An error occurred while granting access.",
+}
+
+type ErrorPageRenderer interface {
+	Render(data ErrorData, w http.ResponseWriter, req *http.Request)
+}
+
+// ErrorPage renders authentication and grant errors as an HTML page
+type ErrorPage struct {
+	render ErrorPageRenderer
+}
+
+var _ = handlers.AuthenticationErrorHandler(&ErrorPage{})
+var _ = handlers.GrantErrorHandler(&ErrorPage{})
+
+func NewErrorPageHandler(render ErrorPageRenderer) *ErrorPage {
+	return &ErrorPage{render: render}
+}
+
+type ErrorData struct {
+	// Error is a message suitable for display to the user
+	Error string
+	// ErrorCode identifies the kind of error
+	ErrorCode string
+}
+
+// NewErrorPageTemplateRenderer creates an error page renderer that takes in an optional custom template to
+// allow branding of the page. Uses the default if customErrorPageTemplateFile is not set.
+func NewErrorPageTemplateRenderer(customErrorPageTemplateFile string) (*errorPageTemplateRenderer, error) {
+	r := &errorPageTemplateRenderer{}
+	if len(customErrorPageTemplateFile) > 0 {
+		customTemplate, err := template.ParseFiles(customErrorPageTemplateFile)
+		if err != nil {
+			return nil, err
+		}
+		r.errorPageTemplate = customTemplate
+	} else {
+		r.errorPageTemplate = defaultErrorPageTemplate
+	}
+
+	return r, nil
+}
+
+func (p *ErrorPage) AuthenticationError(err error, w http.ResponseWriter, req *http.Request) (bool, error) {
+	glog.Errorf("AuthenticationError: %v", err)
+	p.render.Render(newErrorData(AuthenticationErrorCode), w, req)
+	return true, nil
+}
+
+func (p *ErrorPage) GrantError(err error, w http.ResponseWriter, req *http.Request) (bool, error) {
+	glog.Errorf("GrantError: %v", err)
+	p.render.Render(newErrorData(GrantErrorCode), w, req)
+	return true, nil
+}
+
+func newErrorData(errorCode string) ErrorData {
+	return ErrorData{Error: errorMessages[errorCode], ErrorCode: errorCode}
+}
+
+func ValidateErrorPageTemplate(templateContent []byte) []error {
+	var allErrs []error
+
+	template, err := template.New("errorPageTemplateTest").Parse(string(templateContent))
+	if err != nil {
+		return append(allErrs, err)
+	}
+
+	// Execute the template with dummy values and check if they're there.
+	errorData := ErrorData{
+		Error:     "error_message",
+		ErrorCode: "error_code",
+	}
+
+	var buffer bytes.Buffer
+	err = template.Execute(&buffer, errorData)
+	if err != nil {
+		return append(allErrs, err)
+	}
+	output := buffer.Bytes()
+
+	// We only care that the error message is displayed, the error code is optional
+	if !bytes.Contains(output, []byte(errorData.Error)) {
+		allErrs = append(allErrs, errors.New("template must use the {{ .Error }} message"))
+	}
+
+	return allErrs
+}
+
+type errorPageTemplateRenderer struct {
+	errorPageTemplate *template.Template
+}
+
+func (r errorPageTemplateRenderer) Render(data ErrorData, w http.ResponseWriter, req *http.Request) {
+	w.Header().Add("Content-Type", "text/html")
+	w.WriteHeader(http.StatusInternalServerError)
+	if err := r.errorPageTemplate.Execute(w, data); err != nil {
+		util.HandleError(fmt.Errorf("unable to render error page template: %v", err))
+	}
+}
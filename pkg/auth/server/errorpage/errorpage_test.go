@@ -0,0 +1,101 @@
+package errorpage
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestErrorPage(t *testing.T) {
+	renderer, err := NewErrorPageTemplateRenderer("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	errorPage := NewErrorPageHandler(renderer)
+
+	testCases := map[string]struct {
+		Handle            func(w http.ResponseWriter) (bool, error)
+		ExpectContains    []string
+		ExpectNotContains []string
+	}{
+		"authentication error": {
+			Handle: func(w http.ResponseWriter) (bool, error) {
+				return errorPage.AuthenticationError(errors.New("secret details"), w, &http.Request{})
+			},
+			ExpectContains:    []string{errorMessages[AuthenticationErrorCode], AuthenticationErrorCode},
+			ExpectNotContains: []string{"secret details"},
+		},
+		"grant error": {
+			Handle: func(w http.ResponseWriter) (bool, error) {
+				return errorPage.GrantError(errors.New("secret details"), w, &http.Request{})
+			},
+			ExpectContains:    []string{errorMessages[GrantErrorCode], GrantErrorCode},
+			ExpectNotContains: []string{"secret details"},
+		},
+	}
+
+	for k, testCase := range testCases {
+		resp := httptest.NewRecorder()
+		handled, err := testCase.Handle(resp)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if !handled {
+			t.Errorf("%s: expected the error to be handled", k)
+			continue
+		}
+		if resp.Code != http.StatusInternalServerError {
+			t.Errorf("%s: unexpected status code: %d", k, resp.Code)
+		}
+
+		body := resp.Body.String()
+		for _, s := range testCase.ExpectContains {
+			if !strings.Contains(body, s) {
+				t.Errorf("%s: did not find expected value %s: %s", k, s, body)
+			}
+		}
+		for _, s := range testCase.ExpectNotContains {
+			if strings.Contains(body, s) {
+				t.Errorf("%s: found unexpected value %s: %s", k, s, body)
+			}
+		}
+	}
+}
+
+func TestValidateErrorPageTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		Template      string
+		TemplateValid bool
+	}{
+		"default error page template": {
+			Template:      defaultErrorPageTemplateString,
+			TemplateValid: true,
+		},
+		"error page template example": {
+			Template:      ErrorPageTemplateExample,
+			TemplateValid: true,
+		},
+		"template without the error message": {
+			Template:      `<html><body>Error code: {{ .ErrorCode }}</body></html>`,
+			TemplateValid: false,
+		},
+		"template with an unknown field": {
+			Template:      `<html><body>{{ .Error }} {{ .Unknown }}</body></html>`,
+			TemplateValid: false,
+		},
+	}
+
+	for k, testCase := range testCases {
+		allErrs := ValidateErrorPageTemplate([]byte(testCase.Template))
+		if testCase.TemplateValid {
+			for _, err := range allErrs {
+				t.Errorf("%s: template validation failed when it should have succeeded: %v", k, err)
+			}
+		} else if len(allErrs) == 0 {
+			t.Errorf("%s: template validation succeeded when it should have failed", k)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package restrictusers
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+)
+
+// PluginName is the name of the admission plugin that restricts the subjects of role bindings.
+const PluginName = "RestrictSubjectBindings"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewRestrictUsersAdmission(), nil
+	})
+}
+
+type restrictUsersAdmission struct {
+	*admission.Handler
+	client client.Interface
+}
+
+// ensure that the required Openshift admission interfaces are implemented
+var _ = oadmission.WantsOpenshiftClient(&restrictUsersAdmission{})
+var _ = oadmission.Validator(&restrictUsersAdmission{})
+
+// NewRestrictUsersAdmission returns an admission control that rejects role bindings in a namespace with role binding
+// restrictions to subjects none of those restrictions match.
+func NewRestrictUsersAdmission() admission.Interface {
+	return &restrictUsersAdmission{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+var (
+	roleBindingsResource   = authorizationapi.Resource("rolebindings")
+	policyBindingsResource = authorizationapi.Resource("policybindings")
+)
+
+// Admit checks the subjects a role binding or policy binding adds against the role binding restrictions of its
+// namespace. Subjects that were already bound are not checked again, so restrictions created after a binding do not
+// prevent it from being updated. Cluster role bindings are not restricted.
+func (a *restrictUsersAdmission) Admit(attr admission.Attributes) error {
+	if len(attr.GetSubresource()) != 0 || len(attr.GetNamespace()) == 0 {
+		return nil
+	}
+
+	var subjects, oldSubjects []kapi.ObjectReference
+	switch attr.GetResource() {
+	case roleBindingsResource:
+		binding, ok := attr.GetObject().(*authorizationapi.RoleBinding)
+		if !ok {
+			return nil
+		}
+		subjects = binding.Subjects
+		if attr.GetOperation() == admission.Update {
+			old, err := a.client.RoleBindings(attr.GetNamespace()).Get(attr.GetName())
+			if err != nil && !kerrors.IsNotFound(err) {
+				return admission.NewForbidden(attr, err)
+			}
+			if err == nil {
+				oldSubjects = old.Subjects
+			}
+		}
+
+	case policyBindingsResource:
+		binding, ok := attr.GetObject().(*authorizationapi.PolicyBinding)
+		if !ok {
+			return nil
+		}
+		subjects = policyBindingSubjects(binding)
+		if attr.GetOperation() == admission.Update {
+			old, err := a.client.PolicyBindings(attr.GetNamespace()).Get(attr.GetName())
+			if err != nil && !kerrors.IsNotFound(err) {
+				return admission.NewForbidden(attr, err)
+			}
+			if err == nil {
+				oldSubjects = policyBindingSubjects(old)
+			}
+		}
+
+	default:
+		return nil
+	}
+
+	added := addedSubjects(subjects, oldSubjects, attr.GetNamespace())
+	if len(added) == 0 {
+		return nil
+	}
+
+	restrictions, err := a.client.RoleBindingRestrictions(attr.GetNamespace()).List(kapi.ListOptions{})
+	if err != nil {
+		return admission.NewForbidden(attr, err)
+	}
+	if len(restrictions.Items) == 0 {
+		return nil
+	}
+
+	checker := newSubjectChecker(a.client, attr.GetNamespace(), restrictions.Items)
+	for _, subject := range added {
+		allowed, err := checker.allowed(subject)
+		if err != nil {
+			return admission.NewForbidden(attr, err)
+		}
+		if !allowed {
+			return admission.NewForbidden(attr, fmt.Errorf("rolebindings to %s %q are not allowed in project %q", subject.Kind, subject.Name, attr.GetNamespace()))
+		}
+	}
+	return nil
+}
+
+func (a *restrictUsersAdmission) SetOpenshiftClient(client client.Interface) {
+	a.client = client
+}
+
+func (a *restrictUsersAdmission) Validate() error {
+	if a.client == nil {
+		return fmt.Errorf("%s plugin requires an Openshift client", PluginName)
+	}
+	return nil
+}
+
+// policyBindingSubjects returns the subjects of all the role bindings of the policy binding
+func policyBindingSubjects(binding *authorizationapi.PolicyBinding) []kapi.ObjectReference {
+	subjects := []kapi.ObjectReference{}
+	for _, roleBinding := range binding.RoleBindings {
+		subjects = append(subjects, roleBinding.Subjects...)
+	}
+	return subjects
+}
+
+// addedSubjects returns the subjects that are not in old. Service accounts without a namespace are in the namespace
+// of the binding.
+func addedSubjects(subjects, old []kapi.ObjectReference, namespace string) []kapi.ObjectReference {
+	key := func(subject kapi.ObjectReference) kapi.ObjectReference {
+		ref := kapi.ObjectReference{Kind: subject.Kind, Name: subject.Name}
+		if subject.Kind == authorizationapi.ServiceAccountKind {
+			ref.Namespace = subject.Namespace
+			if len(ref.Namespace) == 0 {
+				ref.Namespace = namespace
+			}
+		}
+		return ref
+	}
+
+	existing := map[kapi.ObjectReference]bool{}
+	for _, subject := range old {
+		existing[key(subject)] = true
+	}
+	added := []kapi.ObjectReference{}
+	for _, subject := range subjects {
+		ref := key(subject)
+		if existing[ref] {
+			continue
+		}
+		existing[ref] = true
+		added = append(added, ref)
+	}
+	return added
+}
@@ -0,0 +1,246 @@
+package restrictusers
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+func TestAdmit(t *testing.T) {
+	restrictions := []authorizationapi.RoleBindingRestriction{
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "users", Namespace: "project"},
+			Spec: authorizationapi.RoleBindingRestrictionSpec{
+				UserRestriction: &authorizationapi.UserRestriction{
+					Users:    []string{"alice", "system:admin"},
+					Groups:   []string{"staff"},
+					Selector: map[string]string{"company": "acme"},
+				},
+			},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "groups", Namespace: "project"},
+			Spec: authorizationapi.RoleBindingRestrictionSpec{
+				GroupRestriction: &authorizationapi.GroupRestriction{
+					Groups:   []string{"staff"},
+					Selector: map[string]string{"company": "acme"},
+				},
+			},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "serviceaccounts", Namespace: "project"},
+			Spec: authorizationapi.RoleBindingRestrictionSpec{
+				ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{
+					ServiceAccounts: []authorizationapi.ServiceAccountReference{{Name: "builder"}, {Name: "deployer", Namespace: "other"}},
+					Namespaces:      []string{"ci"},
+				},
+			},
+		},
+	}
+	users := map[string]*userapi.User{
+		"bob":   {ObjectMeta: kapi.ObjectMeta{Name: "bob", Labels: map[string]string{"company": "acme"}}},
+		"carol": {ObjectMeta: kapi.ObjectMeta{Name: "carol"}},
+		"dave":  {ObjectMeta: kapi.ObjectMeta{Name: "dave"}},
+		"erin":  {ObjectMeta: kapi.ObjectMeta{Name: "erin"}, Groups: []string{"staff"}},
+	}
+	groups := []userapi.Group{
+		{ObjectMeta: kapi.ObjectMeta{Name: "staff"}, Users: []string{"carol"}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "acme-devs", Labels: map[string]string{"company": "acme"}}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "contractors"}, Users: []string{"dave"}},
+	}
+
+	roleBinding := func(subjects ...kapi.ObjectReference) *authorizationapi.RoleBinding {
+		return &authorizationapi.RoleBinding{ObjectMeta: kapi.ObjectMeta{Name: "binding", Namespace: "project"}, Subjects: subjects}
+	}
+	userSubject := func(name string) kapi.ObjectReference {
+		return kapi.ObjectReference{Kind: authorizationapi.UserKind, Name: name}
+	}
+
+	tests := []struct {
+		name           string
+		clusterScoped  bool
+		object         runtime.Object
+		resource       string
+		operation      admission.Operation
+		oldBinding     *authorizationapi.RoleBinding
+		noRestrictions bool
+		expectAllowed  bool
+	}{
+		{
+			name:          "user by name",
+			object:        roleBinding(userSubject("alice")),
+			expectAllowed: true,
+		},
+		{
+			name:          "user by label",
+			object:        roleBinding(userSubject("bob")),
+			expectAllowed: true,
+		},
+		{
+			name:          "user by group object",
+			object:        roleBinding(userSubject("carol")),
+			expectAllowed: true,
+		},
+		{
+			name:          "user by groups of the user object",
+			object:        roleBinding(userSubject("erin")),
+			expectAllowed: true,
+		},
+		{
+			name:   "user not allowed",
+			object: roleBinding(userSubject("dave")),
+		},
+		{
+			name:   "user that does not exist",
+			object: roleBinding(userSubject("mallory")),
+		},
+		{
+			name:          "system user by name",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.SystemUserKind, Name: "system:admin"}),
+			expectAllowed: true,
+		},
+		{
+			name:          "group by name",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.GroupKind, Name: "staff"}),
+			expectAllowed: true,
+		},
+		{
+			name:          "group by label",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.GroupKind, Name: "acme-devs"}),
+			expectAllowed: true,
+		},
+		{
+			name:   "group not allowed",
+			object: roleBinding(kapi.ObjectReference{Kind: authorizationapi.GroupKind, Name: "contractors"}),
+		},
+		{
+			name:   "system group not allowed",
+			object: roleBinding(kapi.ObjectReference{Kind: authorizationapi.SystemGroupKind, Name: "system:authenticated"}),
+		},
+		{
+			name:          "service account of the namespace",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.ServiceAccountKind, Name: "builder"}),
+			expectAllowed: true,
+		},
+		{
+			name:          "service account of another namespace",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.ServiceAccountKind, Name: "deployer", Namespace: "other"}),
+			expectAllowed: true,
+		},
+		{
+			name:          "service account by namespace",
+			object:        roleBinding(kapi.ObjectReference{Kind: authorizationapi.ServiceAccountKind, Name: "robot", Namespace: "ci"}),
+			expectAllowed: true,
+		},
+		{
+			name:   "service account not allowed",
+			object: roleBinding(kapi.ObjectReference{Kind: authorizationapi.ServiceAccountKind, Name: "deployer"}),
+		},
+		{
+			name:   "one subject not allowed",
+			object: roleBinding(userSubject("alice"), userSubject("dave")),
+		},
+		{
+			name:          "subject already bound",
+			object:        roleBinding(userSubject("alice"), userSubject("dave")),
+			operation:     admission.Update,
+			oldBinding:    roleBinding(userSubject("dave")),
+			expectAllowed: true,
+		},
+		{
+			name:       "subject added on update",
+			object:     roleBinding(userSubject("alice"), userSubject("dave")),
+			operation:  admission.Update,
+			oldBinding: roleBinding(userSubject("alice")),
+		},
+		{
+			name: "policy binding",
+			object: &authorizationapi.PolicyBinding{
+				ObjectMeta:   kapi.ObjectMeta{Name: ":default", Namespace: "project"},
+				RoleBindings: map[string]*authorizationapi.RoleBinding{"admin": roleBinding(userSubject("alice")), "view": roleBinding(userSubject("dave"))},
+			},
+			resource: "policybindings",
+		},
+		{
+			name:           "namespace without restrictions",
+			object:         roleBinding(userSubject("dave")),
+			noRestrictions: true,
+			expectAllowed:  true,
+		},
+		{
+			name:          "cluster role binding",
+			clusterScoped: true,
+			object:        &authorizationapi.ClusterRoleBinding{ObjectMeta: kapi.ObjectMeta{Name: "binding"}, Subjects: []kapi.ObjectReference{userSubject("dave")}},
+			resource:      "clusterrolebindings",
+			expectAllowed: true,
+		},
+	}
+
+	for _, test := range tests {
+		if len(test.resource) == 0 {
+			test.resource = "rolebindings"
+		}
+		if len(test.operation) == 0 {
+			test.operation = admission.Create
+		}
+		namespace := "project"
+		if test.clusterScoped {
+			namespace = ""
+		}
+
+		client := &testclient.Fake{}
+		client.AddReactor("list", "rolebindingrestrictions", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if test.noRestrictions {
+				return true, &authorizationapi.RoleBindingRestrictionList{}, nil
+			}
+			return true, &authorizationapi.RoleBindingRestrictionList{Items: restrictions}, nil
+		})
+		client.AddReactor("get", "rolebindings", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if test.oldBinding == nil {
+				return true, nil, kerrors.NewNotFound("RoleBinding", "binding")
+			}
+			return true, test.oldBinding, nil
+		})
+		client.AddReactor("get", "users", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			name := action.(ktestclient.GetAction).GetName()
+			if user, ok := users[name]; ok {
+				return true, user, nil
+			}
+			return true, nil, kerrors.NewNotFound("User", name)
+		})
+		client.AddReactor("get", "groups", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			name := action.(ktestclient.GetAction).GetName()
+			for i := range groups {
+				if groups[i].Name == name {
+					return true, &groups[i], nil
+				}
+			}
+			return true, nil, kerrors.NewNotFound("Group", name)
+		})
+		client.AddReactor("list", "groups", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, &userapi.GroupList{Items: groups}, nil
+		})
+
+		plugin := NewRestrictUsersAdmission()
+		plugin.(*restrictUsersAdmission).SetOpenshiftClient(client)
+		attrs := admission.NewAttributesRecord(test.object, authorizationapi.Kind("RoleBinding"), namespace, "binding", authorizationapi.Resource(test.resource), "", test.operation, &user.DefaultInfo{Name: "admin"})
+		err := plugin.Admit(attrs)
+		switch {
+		case test.expectAllowed && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case !test.expectAllowed && err == nil:
+			t.Errorf("%s: expected the binding to be rejected", test.name)
+		case !test.expectAllowed && !kerrors.IsForbidden(err):
+			t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+		}
+	}
+}
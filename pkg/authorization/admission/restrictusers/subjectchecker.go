@@ -0,0 +1,190 @@
+package restrictusers
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+// subjectChecker matches subjects against the role binding restrictions of a namespace. Users, groups, and group
+// memberships are only looked up when a restriction needs them, and at most once.
+type subjectChecker struct {
+	client       client.Interface
+	namespace    string
+	restrictions []authorizationapi.RoleBindingRestriction
+
+	users  map[string]*userapi.User
+	groups map[string]*userapi.Group
+	// allGroups is the list of groups, used to find the members of groups
+	allGroups []userapi.Group
+}
+
+func newSubjectChecker(client client.Interface, namespace string, restrictions []authorizationapi.RoleBindingRestriction) *subjectChecker {
+	return &subjectChecker{
+		client:       client,
+		namespace:    namespace,
+		restrictions: restrictions,
+		users:        map[string]*userapi.User{},
+		groups:       map[string]*userapi.Group{},
+	}
+}
+
+// allowed returns true if any restriction matches the subject
+func (c *subjectChecker) allowed(subject kapi.ObjectReference) (bool, error) {
+	for i := range c.restrictions {
+		spec := c.restrictions[i].Spec
+		var matches bool
+		var err error
+		switch subject.Kind {
+		case authorizationapi.UserKind, authorizationapi.SystemUserKind:
+			if spec.UserRestriction != nil {
+				matches, err = c.matchesUser(spec.UserRestriction, subject)
+			}
+		case authorizationapi.GroupKind, authorizationapi.SystemGroupKind:
+			if spec.GroupRestriction != nil {
+				matches, err = c.matchesGroup(spec.GroupRestriction, subject)
+			}
+		case authorizationapi.ServiceAccountKind:
+			if spec.ServiceAccountRestriction != nil {
+				matches = c.matchesServiceAccount(spec.ServiceAccountRestriction, subject)
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *subjectChecker) matchesUser(restriction *authorizationapi.UserRestriction, subject kapi.ObjectReference) (bool, error) {
+	if sets.NewString(restriction.Users...).Has(subject.Name) {
+		return true, nil
+	}
+	// system users have no user object, so they can only be matched by name
+	if subject.Kind != authorizationapi.UserKind {
+		return false, nil
+	}
+
+	if len(restriction.Groups) > 0 {
+		groups, err := c.groupsOf(subject.Name)
+		if err != nil {
+			return false, err
+		}
+		if groups.HasAny(restriction.Groups...) {
+			return true, nil
+		}
+	}
+
+	if len(restriction.Selector) > 0 {
+		user, err := c.getUser(subject.Name)
+		if err != nil || user == nil {
+			return false, err
+		}
+		if labels.SelectorFromSet(restriction.Selector).Matches(labels.Set(user.Labels)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c *subjectChecker) matchesGroup(restriction *authorizationapi.GroupRestriction, subject kapi.ObjectReference) (bool, error) {
+	if sets.NewString(restriction.Groups...).Has(subject.Name) {
+		return true, nil
+	}
+	// system groups have no group object, so they can only be matched by name
+	if subject.Kind != authorizationapi.GroupKind || len(restriction.Selector) == 0 {
+		return false, nil
+	}
+
+	group, err := c.getGroup(subject.Name)
+	if err != nil || group == nil {
+		return false, err
+	}
+	return labels.SelectorFromSet(restriction.Selector).Matches(labels.Set(group.Labels)), nil
+}
+
+func (c *subjectChecker) matchesServiceAccount(restriction *authorizationapi.ServiceAccountRestriction, subject kapi.ObjectReference) bool {
+	if sets.NewString(restriction.Namespaces...).Has(subject.Namespace) {
+		return true
+	}
+	for _, serviceAccount := range restriction.ServiceAccounts {
+		namespace := serviceAccount.Namespace
+		if len(namespace) == 0 {
+			namespace = c.namespace
+		}
+		if serviceAccount.Name == subject.Name && namespace == subject.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// getUser returns the named user, or nil if it does not exist
+func (c *subjectChecker) getUser(name string) (*userapi.User, error) {
+	if user, ok := c.users[name]; ok {
+		return user, nil
+	}
+	user, err := c.client.Users().Get(name)
+	if kerrors.IsNotFound(err) {
+		user, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.users[name] = user
+	return user, nil
+}
+
+// getGroup returns the named group, or nil if it does not exist
+func (c *subjectChecker) getGroup(name string) (*userapi.Group, error) {
+	if group, ok := c.groups[name]; ok {
+		return group, nil
+	}
+	group, err := c.client.Groups().Get(name)
+	if kerrors.IsNotFound(err) {
+		group, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.groups[name] = group
+	return group, nil
+}
+
+// groupsOf returns the names of the groups the user is a member of, either through the groups of its user object or
+// as a member of a group object
+func (c *subjectChecker) groupsOf(userName string) (sets.String, error) {
+	groups := sets.NewString()
+
+	user, err := c.getUser(userName)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		groups.Insert(user.Groups...)
+	}
+
+	if c.allGroups == nil {
+		list, err := c.client.Groups().List(kapi.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		c.allGroups = list.Items
+	}
+	for _, group := range c.allGroups {
+		if sets.NewString(group.Users...).Has(userName) {
+			groups.Insert(group.Name)
+		}
+	}
+
+	return groups, nil
+}
@@ -54,6 +54,8 @@ func ToRole(in *ClusterRole) *Role {
 	ret := &Role{}
 	ret.ObjectMeta = in.ObjectMeta
 	ret.Rules = in.Rules
+	ret.IncludedRoles = in.IncludedRoles
+	ret.DenyRules = in.DenyRules
 
 	return ret
 }
@@ -106,6 +108,8 @@ func ToClusterRole(in *Role) *ClusterRole {
 	ret := &ClusterRole{}
 	ret.ObjectMeta = in.ObjectMeta
 	ret.Rules = in.Rules
+	ret.IncludedRoles = in.IncludedRoles
+	ret.DenyRules = in.DenyRules
 
 	return ret
 }
@@ -54,3 +54,12 @@ func RoleBindingToSelectableFields(roleBinding *RoleBinding) fields.Set {
 		"metadata.namespace": roleBinding.Namespace,
 	}
 }
+
+// RoleBindingRestrictionToSelectableFields returns a label set that represents the object
+// changes to the returned keys require registering conversions for existing versions using Scheme.AddFieldLabelConversionFunc
+func RoleBindingRestrictionToSelectableFields(restriction *RoleBindingRestriction) fields.Set {
+	return fields.Set{
+		"metadata.name":      restriction.Name,
+		"metadata.namespace": restriction.Namespace,
+	}
+}
@@ -33,6 +33,7 @@ func init() {
 		&SubjectAccessReview{},
 		&LocalResourceAccessReview{},
 		&LocalSubjectAccessReview{},
+		&SelfSubjectRulesReview{},
 		&ResourceAccessReviewResponse{},
 		&SubjectAccessReviewResponse{},
 		&IsPersonalSubjectAccessReview{},
@@ -45,6 +46,9 @@ func init() {
 		&ClusterPolicyBindingList{},
 		&ClusterRoleBindingList{},
 		&ClusterRoleList{},
+
+		&RoleBindingRestriction{},
+		&RoleBindingRestrictionList{},
 	)
 }
 
@@ -70,6 +74,10 @@ func (*ResourceAccessReview) IsAnAPIObject()          {}
 func (*SubjectAccessReview) IsAnAPIObject()           {}
 func (*LocalResourceAccessReview) IsAnAPIObject()     {}
 func (*LocalSubjectAccessReview) IsAnAPIObject()      {}
+func (*SelfSubjectRulesReview) IsAnAPIObject()        {}
 func (*ResourceAccessReviewResponse) IsAnAPIObject()  {}
 func (*SubjectAccessReviewResponse) IsAnAPIObject()   {}
 func (*IsPersonalSubjectAccessReview) IsAnAPIObject() {}
+
+func (*RoleBindingRestriction) IsAnAPIObject()     {}
+func (*RoleBindingRestrictionList) IsAnAPIObject() {}
@@ -27,6 +27,13 @@ const (
 	ServiceAccountKind = "ServiceAccount"
 	SystemUserKind     = "SystemUser"
 	SystemGroupKind    = "SystemGroup"
+
+	// ReconcileProtectAnnotation is set to "true" on a cluster role to keep the reconcile commands from modifying it
+	ReconcileProtectAnnotation = "openshift.io/reconcile-protect"
+
+	// BootstrapPolicyVersionAnnotation is set on the cluster-admin cluster role to the version of the bootstrap policy
+	// the cluster roles and role bindings were last migrated to
+	BootstrapPolicyVersionAnnotation = "openshift.io/bootstrap-policy-version"
 )
 
 const (
@@ -74,22 +81,23 @@ const (
 
 var (
 	GroupsToResources = map[string][]string{
-		BuildGroupName:       {"builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "builds/log", "builds/clone", "buildconfigs/webhooks"},
+		BuildGroupName:       {"builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "buildconfigs/history", "builds/log", "builds/clone", "buildconfigs/webhooks"},
 		ImageGroupName:       {"imagestreams", "imagestreammappings", "imagestreamtags", "imagestreamimages", "imagestreamimports"},
 		DeploymentGroupName:  {"deployments", "deploymentconfigs", "generatedeploymentconfigs", "deploymentconfigrollbacks", "deploymentconfigs/log", "deploymentconfigs/scale"},
 		SDNGroupName:         {"clusternetworks", "hostsubnets", "netnamespaces"},
-		TemplateGroupName:    {"templates", "templateconfigs", "processedtemplates"},
+		TemplateGroupName:    {"templates", "templateconfigs", "processedtemplates", "sharedtemplates"},
 		UserGroupName:        {"identities", "users", "useridentitymappings", "groups"},
-		OAuthGroupName:       {"oauthauthorizetokens", "oauthaccesstokens", "oauthclients", "oauthclientauthorizations"},
+		OAuthGroupName:       {"oauthauthorizetokens", "oauthaccesstokens", "useroauthaccesstokens", "oauthclients", "oauthclientauthorizations"},
 		PolicyOwnerGroupName: {"policies", "policybindings"},
 
 		// RAR and SAR are in this list to support backwards compatibility with clients that expect access to those resource in a namespace scope and a cluster scope.
 		// TODO remove once we have eliminated the namespace scoped resource.
 		PermissionGrantingGroupName: {"roles", "rolebindings", "resourceaccessreviews" /* cluster scoped*/, "subjectaccessreviews" /* cluster scoped*/, "localresourceaccessreviews", "localsubjectaccessreviews"},
-		OpenshiftExposedGroupName:   {BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes"},
+		OpenshiftExposedGroupName:   {BuildGroupName, ImageGroupName, DeploymentGroupName, TemplateGroupName, "routes", "podpresets"},
 		OpenshiftAllGroupName: {OpenshiftExposedGroupName, UserGroupName, OAuthGroupName, PolicyOwnerGroupName, SDNGroupName, PermissionGrantingGroupName, OpenshiftStatusGroupName, "projects",
-			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets"},
-		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status"},
+			"clusterroles", "clusterrolebindings", "clusterpolicies", "clusterpolicybindings", "images" /* cluster scoped*/, "projectrequests", "builds/details", "imagestreams/secrets", "clusterresourcequotas",
+			"podsecuritypolicysubjectreviews", "podsecuritypolicyreviews", "rolebindingrestrictions", "selfsubjectrulesreviews"},
+		OpenshiftStatusGroupName: {"imagestreams/status", "routes/status", "clusterresourcequotas/status"},
 
 		QuotaGroupName:         {"limitranges", "resourcequotas", "resourcequotausages"},
 		KubeExposedGroupName:   {"pods", "replicationcontrollers", "serviceaccounts", "services", "endpoints", "persistentvolumeclaims", "pods/log"},
@@ -97,7 +105,7 @@ var (
 		KubeAllGroupName:       {KubeInternalsGroupName, KubeExposedGroupName, QuotaGroupName},
 		KubeStatusGroupName:    {"pods/status", "resourcequotas/status", "namespaces/status", "replicationcontrollers/status"},
 
-		OpenshiftEscalatingViewableGroupName: {"oauthauthorizetokens", "oauthaccesstokens", "imagestreams/secrets"},
+		OpenshiftEscalatingViewableGroupName: {"oauthauthorizetokens", "oauthaccesstokens", "useroauthaccesstokens", "imagestreams/secrets"},
 		KubeEscalatingViewableGroupName:      {"secrets"},
 		EscalatingResourcesGroupName:         {OpenshiftEscalatingViewableGroupName, KubeEscalatingViewableGroupName},
 
@@ -147,6 +155,12 @@ type Role struct {
 
 	// Rules holds all the PolicyRules for this Role
 	Rules []PolicyRule
+	// IncludedRoles references other roles whose rules are also granted by this Role.  A reference with an empty
+	// namespace refers to a ClusterRole, otherwise it must refer to a Role in this Role's namespace.
+	IncludedRoles []kapi.ObjectReference
+	// DenyRules holds the PolicyRules this Role denies.  A request matched by a deny rule of any role bound to the user is
+	// forbidden, even if other rules allow it.
+	DenyRules []PolicyRule
 }
 
 // RoleBinding references a Role, but not contain it.  It can reference any Role in the same namespace or in the global namespace.
@@ -203,6 +217,10 @@ type ResourceAccessReviewResponse struct {
 	Users sets.String
 	// Groups is the list of groups who can perform the action
 	Groups sets.String
+	// EvaluationError is an indication that some error occurred during resolution, but partial results can still be returned.
+	// It is entirely possible to get an error and be able to continue determine authorization status in spite of it.  This is
+	// most common when a bound role is missing, but enough roles are still present and bound to reason about the request.
+	EvaluationError string
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -224,6 +242,10 @@ type SubjectAccessReviewResponse struct {
 	Allowed bool
 	// Reason is optional.  It indicates why a request was allowed or denied.
 	Reason string
+	// EvaluationError is an indication that some error occurred during the authorization check.
+	// It is entirely possible to get an error and be able to continue determine authorization status in spite of it.  This is
+	// most common when a bound role is missing, but enough roles are still present and bound to reason about the request.
+	EvaluationError string
 }
 
 // SubjectAccessReview is an object for requesting information about whether a user or group can perform an action
@@ -236,6 +258,10 @@ type SubjectAccessReview struct {
 	User string
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	Groups sets.String
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string
 }
 
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
@@ -256,6 +282,39 @@ type LocalSubjectAccessReview struct {
 	User string
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	Groups sets.String
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string
+}
+
+// SelfSubjectRulesReview is a means to request the set of actions the current user can perform in a namespace
+type SelfSubjectRulesReview struct {
+	unversioned.TypeMeta
+
+	// Spec adds information about how to conduct the check
+	Spec SelfSubjectRulesReviewSpec
+
+	// Status is completed by the server to tell which permissions you have
+	Status SubjectRulesReviewStatus
+}
+
+// SelfSubjectRulesReviewSpec adds information about how to conduct the check
+type SelfSubjectRulesReviewSpec struct {
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil means "use the scopes on this request".
+	Scopes []string
+}
+
+// SubjectRulesReviewStatus is contains the result of a rules check
+type SubjectRulesReviewStatus struct {
+	// Rules is the list of rules (no particular sort) that are allowed for the subject
+	Rules []PolicyRule
+	// DenyRules is the list of rules (no particular sort) that are denied to the subject.  They take precedence over Rules.
+	DenyRules []PolicyRule
+	// EvaluationError can appear in combination with Rules.  It means some error happened during evaluation
+	// that may have prevented additional rules from being populated.
+	EvaluationError string
 }
 
 type AuthorizationAttributes struct {
@@ -269,6 +328,11 @@ type AuthorizationAttributes struct {
 	ResourceName string
 	// Content is the actual content of the request for create and update
 	Content kruntime.EmbeddedObject
+
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool
+	// Path is the path of a non resource URL
+	Path string
 }
 
 // PolicyList is a collection of Policies
@@ -314,6 +378,11 @@ type ClusterRole struct {
 
 	// Rules holds all the PolicyRules for this ClusterRole
 	Rules []PolicyRule
+	// IncludedRoles references other ClusterRoles whose rules are also granted by this ClusterRole
+	IncludedRoles []kapi.ObjectReference
+	// DenyRules holds the PolicyRules this ClusterRole denies.  A request matched by a deny rule of any role bound to the
+	// user is forbidden, even if other rules allow it.
+	DenyRules []PolicyRule
 }
 
 // ClusterRoleBinding references a ClusterRole, but not contain it.  It can reference any ClusterRole in the same namespace or in the global namespace.
@@ -395,3 +464,67 @@ type ClusterRoleList struct {
 	// Items is a list of ClusterRoles
 	Items []ClusterRole
 }
+
+// RoleBindingRestriction limits the subjects role bindings in its namespace may bind to. Once a namespace has any
+// restriction, a subject may only be added to a role binding when one of the restrictions matches it. Restrictions
+// are set by cluster administrators to keep project administrators from granting access to arbitrary users.
+type RoleBindingRestriction struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec defines the subjects the restriction matches
+	Spec RoleBindingRestrictionSpec
+}
+
+// RoleBindingRestrictionSpec defines the subjects a restriction matches. Exactly one of the restrictions is set.
+type RoleBindingRestrictionSpec struct {
+	// UserRestriction matches users
+	UserRestriction *UserRestriction
+	// GroupRestriction matches groups
+	GroupRestriction *GroupRestriction
+	// ServiceAccountRestriction matches service accounts
+	ServiceAccountRestriction *ServiceAccountRestriction
+}
+
+// UserRestriction matches users by name, by the groups they are a member of, or by the labels of their user object
+type UserRestriction struct {
+	// Users are the names of the users matched
+	Users []string
+	// Groups matches the users that are members of any of these groups
+	Groups []string
+	// Selector matches the users with these labels
+	Selector map[string]string
+}
+
+// GroupRestriction matches groups by name or by the labels of their group object
+type GroupRestriction struct {
+	// Groups are the names of the groups matched
+	Groups []string
+	// Selector matches the groups with these labels
+	Selector map[string]string
+}
+
+// ServiceAccountRestriction matches service accounts by name or by namespace
+type ServiceAccountRestriction struct {
+	// ServiceAccounts are the service accounts matched
+	ServiceAccounts []ServiceAccountReference
+	// Namespaces matches all the service accounts of these namespaces
+	Namespaces []string
+}
+
+// ServiceAccountReference names a service account
+type ServiceAccountReference struct {
+	// Name is the name of the service account
+	Name string
+	// Namespace is the namespace of the service account. Empty means the namespace of the restriction.
+	Namespace string
+}
+
+// RoleBindingRestrictionList is a collection of RoleBindingRestrictions
+type RoleBindingRestrictionList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	// Items is a list of RoleBindingRestrictions
+	Items []RoleBindingRestriction
+}
@@ -463,4 +463,10 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+
+	if err := api.Scheme.AddFieldLabelConversionFunc("v1", "RoleBindingRestriction",
+		oapi.GetFieldLabelConversionFunc(newer.RoleBindingRestrictionToSelectableFields(&newer.RoleBindingRestriction{}), nil),
+	); err != nil {
+		panic(err)
+	}
 }
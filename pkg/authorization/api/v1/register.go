@@ -27,6 +27,7 @@ func init() {
 		&SubjectAccessReview{},
 		&LocalResourceAccessReview{},
 		&LocalSubjectAccessReview{},
+		&SelfSubjectRulesReview{},
 		&ResourceAccessReviewResponse{},
 		&SubjectAccessReviewResponse{},
 		&IsPersonalSubjectAccessReview{},
@@ -39,6 +40,9 @@ func init() {
 		&ClusterPolicyBindingList{},
 		&ClusterRoleBindingList{},
 		&ClusterRoleList{},
+
+		&RoleBindingRestriction{},
+		&RoleBindingRestrictionList{},
 	)
 }
 
@@ -64,6 +68,10 @@ func (*ResourceAccessReview) IsAnAPIObject()          {}
 func (*SubjectAccessReview) IsAnAPIObject()           {}
 func (*LocalResourceAccessReview) IsAnAPIObject()     {}
 func (*LocalSubjectAccessReview) IsAnAPIObject()      {}
+func (*SelfSubjectRulesReview) IsAnAPIObject()        {}
 func (*ResourceAccessReviewResponse) IsAnAPIObject()  {}
 func (*SubjectAccessReviewResponse) IsAnAPIObject()   {}
 func (*IsPersonalSubjectAccessReview) IsAnAPIObject() {}
+
+func (*RoleBindingRestriction) IsAnAPIObject()     {}
+func (*RoleBindingRestrictionList) IsAnAPIObject() {}
@@ -46,6 +46,12 @@ type Role struct {
 
 	// Rules holds all the PolicyRules for this Role
 	Rules []PolicyRule `json:"rules" description:"all the rules for this role"`
+	// IncludedRoles references other roles whose rules are also granted by this Role.  A reference with an empty
+	// namespace refers to a ClusterRole, otherwise it must refer to a Role in this Role's namespace.
+	IncludedRoles []kapi.ObjectReference `json:"includedRoles,omitempty" description:"other roles whose rules are also granted by this role"`
+	// DenyRules holds the PolicyRules this Role denies.  A request matched by a deny rule of any role bound to the user is
+	// forbidden, even if other rules allow it.
+	DenyRules []PolicyRule `json:"denyRules,omitempty" description:"rules for requests this role denies, even if other rules allow them"`
 }
 
 // RoleBinding references a Role, but not contain it.  It can reference any Role in the same namespace or in the global namespace.
@@ -116,6 +122,10 @@ type ResourceAccessReviewResponse struct {
 	UsersSlice []string `json:"users" description:"list of users who can perform the action"`
 	// GroupsSlice is the list of groups who can perform the action
 	GroupsSlice []string `json:"groups" description:"list of groups who can perform the action"`
+	// EvaluationError is an indication that some error occurred during resolution, but partial results can still be returned.
+	// It is entirely possible to get an error and be able to continue determine authorization status in spite of it.  This is
+	// most common when a bound role is missing, but enough roles are still present and bound to reason about the request.
+	EvaluationError string `json:"evaluationError,omitempty" description:"indicates that some error occurred during resolution, but partial results can still be returned"`
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -137,6 +147,10 @@ type SubjectAccessReviewResponse struct {
 	Allowed bool `json:"allowed" description:"true if the action would be allowed, false otherwise"`
 	// Reason is optional.  It indicates why a request was allowed or denied.
 	Reason string `json:"reason,omitempty" description:"reason is optional, it indicates why a request was allowed or denied"`
+	// EvaluationError is an indication that some error occurred during the authorization check.
+	// It is entirely possible to get an error and be able to continue determine authorization status in spite of it.  This is
+	// most common when a bound role is missing, but enough roles are still present and bound to reason about the request.
+	EvaluationError string `json:"evaluationError,omitempty" description:"indicates that some error occurred during the authorization check"`
 }
 
 // SubjectAccessReview is an object for requesting information about whether a user or group can perform an action
@@ -149,6 +163,10 @@ type SubjectAccessReview struct {
 	User string `json:"user" description:"optional, if both user and groups are empty, the current authenticated user is used"`
 	// GroupsSlice is optional. Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups" description:"optional, list of groups to which the user belongs"`
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string `json:"scopes" description:"optional, scopes to use for the evaluation, empty means the unscoped permissions of the user and null for a review of your own access means the scopes of the request"`
 }
 
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
@@ -169,6 +187,39 @@ type LocalSubjectAccessReview struct {
 	User string `json:"user" description:"optional, if both user and groups are empty, the current authenticated user is used"`
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups" description:"optional, list of groups to which the user belongs"`
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string `json:"scopes" description:"optional, scopes to use for the evaluation, empty means the unscoped permissions of the user and null for a review of your own access means the scopes of the request"`
+}
+
+// SelfSubjectRulesReview is a means to request the set of actions the current user can perform in a namespace
+type SelfSubjectRulesReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec adds information about how to conduct the check
+	Spec SelfSubjectRulesReviewSpec `json:"spec" description:"adds information about how to conduct the check"`
+
+	// Status is completed by the server to tell which permissions you have
+	Status SubjectRulesReviewStatus `json:"status,omitempty" description:"completed by the server to tell which permissions you have"`
+}
+
+// SelfSubjectRulesReviewSpec adds information about how to conduct the check
+type SelfSubjectRulesReviewSpec struct {
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil means "use the scopes on this request".
+	Scopes []string `json:"scopes" description:"scopes to use for the evaluation, empty means the unscoped permissions of the user, null means the scopes of the request"`
+}
+
+// SubjectRulesReviewStatus is contains the result of a rules check
+type SubjectRulesReviewStatus struct {
+	// Rules is the list of rules (no particular sort) that are allowed for the subject
+	Rules []PolicyRule `json:"rules" description:"the rules that are allowed for the subject, in no particular order"`
+	// DenyRules is the list of rules (no particular sort) that are denied to the subject.  They take precedence over Rules.
+	DenyRules []PolicyRule `json:"denyRules,omitempty" description:"the rules that are denied to the subject even if rules allow them, in no particular order"`
+	// EvaluationError can appear in combination with Rules.  It means some error happened during evaluation
+	// that may have prevented additional rules from being populated.
+	EvaluationError string `json:"evaluationError,omitempty" description:"an error during evaluation that may have prevented additional rules from being populated"`
 }
 
 type AuthorizationAttributes struct {
@@ -182,6 +233,11 @@ type AuthorizationAttributes struct {
 	ResourceName string `json:"resourceName" description:"name of the resource being requested for a get or delete"`
 	// Content is the actual content of the request for create and update
 	Content kruntime.RawExtension `json:"content,omitempty" description:"actual content of the request for create and update"`
+
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool `json:"isNonResourceURL,omitempty" description:"true if this is a request for a non-resource URL (outside of the resource hierarchy)"`
+	// Path is the path of a non resource URL
+	Path string `json:"path,omitempty" description:"path of a non resource URL"`
 }
 
 // PolicyList is a collection of Policies
@@ -227,6 +283,11 @@ type ClusterRole struct {
 
 	// Rules holds all the PolicyRules for this ClusterRole
 	Rules []PolicyRule `json:"rules" description:"list of policy rules"`
+	// IncludedRoles references other ClusterRoles whose rules are also granted by this ClusterRole
+	IncludedRoles []kapi.ObjectReference `json:"includedRoles,omitempty" description:"other cluster roles whose rules are also granted by this cluster role"`
+	// DenyRules holds the PolicyRules this ClusterRole denies.  A request matched by a deny rule of any role bound to the
+	// user is forbidden, even if other rules allow it.
+	DenyRules []PolicyRule `json:"denyRules,omitempty" description:"rules for requests this cluster role denies, even if other rules allow them"`
 }
 
 // ClusterRoleBinding references a ClusterRole, but not contain it.  It can reference any ClusterRole in the same namespace or in the global namespace.
@@ -322,3 +383,66 @@ type ClusterRoleList struct {
 	// Items is a list of ClusterRoles
 	Items []ClusterRole `json:"items" description:"list of cluster roles"`
 }
+
+// RoleBindingRestriction limits the subjects role bindings in its namespace may bind to. Once a namespace has any
+// restriction, a subject may only be added to a role binding when one of the restrictions matches it.
+type RoleBindingRestriction struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec defines the subjects the restriction matches
+	Spec RoleBindingRestrictionSpec `json:"spec" description:"the subjects the restriction matches"`
+}
+
+// RoleBindingRestrictionSpec defines the subjects a restriction matches. Exactly one of the restrictions is set.
+type RoleBindingRestrictionSpec struct {
+	// UserRestriction matches users
+	UserRestriction *UserRestriction `json:"userrestriction,omitempty" description:"matches users"`
+	// GroupRestriction matches groups
+	GroupRestriction *GroupRestriction `json:"grouprestriction,omitempty" description:"matches groups"`
+	// ServiceAccountRestriction matches service accounts
+	ServiceAccountRestriction *ServiceAccountRestriction `json:"serviceaccountrestriction,omitempty" description:"matches service accounts"`
+}
+
+// UserRestriction matches users by name, by the groups they are a member of, or by the labels of their user object
+type UserRestriction struct {
+	// Users are the names of the users matched
+	Users []string `json:"users,omitempty" description:"names of the users matched"`
+	// Groups matches the users that are members of any of these groups
+	Groups []string `json:"groups,omitempty" description:"matches the members of these groups"`
+	// Selector matches the users with these labels
+	Selector map[string]string `json:"selector,omitempty" description:"matches the users with these labels"`
+}
+
+// GroupRestriction matches groups by name or by the labels of their group object
+type GroupRestriction struct {
+	// Groups are the names of the groups matched
+	Groups []string `json:"groups,omitempty" description:"names of the groups matched"`
+	// Selector matches the groups with these labels
+	Selector map[string]string `json:"selector,omitempty" description:"matches the groups with these labels"`
+}
+
+// ServiceAccountRestriction matches service accounts by name or by namespace
+type ServiceAccountRestriction struct {
+	// ServiceAccounts are the service accounts matched
+	ServiceAccounts []ServiceAccountReference `json:"serviceaccounts,omitempty" description:"service accounts matched"`
+	// Namespaces matches all the service accounts of these namespaces
+	Namespaces []string `json:"namespaces,omitempty" description:"matches all the service accounts of these namespaces"`
+}
+
+// ServiceAccountReference names a service account
+type ServiceAccountReference struct {
+	// Name is the name of the service account
+	Name string `json:"name" description:"name of the service account"`
+	// Namespace is the namespace of the service account. Empty means the namespace of the restriction.
+	Namespace string `json:"namespace,omitempty" description:"namespace of the service account, defaults to the namespace of the restriction"`
+}
+
+// RoleBindingRestrictionList is a collection of RoleBindingRestrictions
+type RoleBindingRestrictionList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of RoleBindingRestrictions
+	Items []RoleBindingRestriction `json:"items" description:"list of role binding restrictions"`
+}
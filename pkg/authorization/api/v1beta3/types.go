@@ -49,6 +49,10 @@ type Role struct {
 
 	// Rules holds all the PolicyRules for this Role
 	Rules []PolicyRule `json:"rules"`
+	// IncludedRoles references other roles whose rules are also granted by this Role
+	IncludedRoles []kapi.ObjectReference `json:"includedRoles,omitempty"`
+	// DenyRules holds the PolicyRules this Role denies, even if other rules allow them
+	DenyRules []PolicyRule `json:"denyRules,omitempty"`
 }
 
 // RoleBinding references a Role, but not contain it.  It can reference any Role in the same namespace or in the global namespace.
@@ -119,6 +123,8 @@ type ResourceAccessReviewResponse struct {
 	UsersSlice []string `json:"users"`
 	// Groups is the list of groups who can perform the action
 	GroupsSlice []string `json:"groups"`
+	// EvaluationError is an indication that some error occurred during resolution, but partial results can still be returned.
+	EvaluationError string `json:"evaluationError,omitempty"`
 }
 
 // ResourceAccessReview is a means to request a list of which users and groups are authorized to perform the
@@ -140,6 +146,8 @@ type SubjectAccessReviewResponse struct {
 	Allowed bool `json:"allowed"`
 	// Reason is optional.  It indicates why a request was allowed or denied.
 	Reason string `json:"reason,omitempty"`
+	// EvaluationError is an indication that some error occurred during the authorization check.
+	EvaluationError string `json:"evaluationError,omitempty"`
 }
 
 // SubjectAccessReview is an object for requesting information about whether a user or group can perform an action
@@ -152,6 +160,10 @@ type SubjectAccessReview struct {
 	User string `json:"user"`
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups"`
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string `json:"scopes"`
 }
 
 // LocalResourceAccessReview is a means to request a list of which users and groups are authorized to perform the action specified by spec in a particular namespace
@@ -172,6 +184,39 @@ type LocalSubjectAccessReview struct {
 	User string `json:"user"`
 	// Groups is optional.  Groups is the list of groups to which the User belongs.
 	GroupsSlice []string `json:"groups"`
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil for a self-SAR, means "use the scopes on this request".
+	// Nil for a regular SAR, means the same as empty.
+	Scopes []string `json:"scopes"`
+}
+
+// SelfSubjectRulesReview is a means to request the set of actions the current user can perform in a namespace
+type SelfSubjectRulesReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec adds information about how to conduct the check
+	Spec SelfSubjectRulesReviewSpec `json:"spec"`
+
+	// Status is completed by the server to tell which permissions you have
+	Status SubjectRulesReviewStatus `json:"status,omitempty"`
+}
+
+// SelfSubjectRulesReviewSpec adds information about how to conduct the check
+type SelfSubjectRulesReviewSpec struct {
+	// Scopes to use for the evaluation.  Empty means "use the unscoped (full) permissions of the user/groups".
+	// Nil means "use the scopes on this request".
+	Scopes []string `json:"scopes"`
+}
+
+// SubjectRulesReviewStatus is contains the result of a rules check
+type SubjectRulesReviewStatus struct {
+	// Rules is the list of rules (no particular sort) that are allowed for the subject
+	Rules []PolicyRule `json:"rules"`
+	// DenyRules is the list of rules (no particular sort) that are denied to the subject.  They take precedence over Rules.
+	DenyRules []PolicyRule `json:"denyRules,omitempty"`
+	// EvaluationError can appear in combination with Rules.  It means some error happened during evaluation
+	// that may have prevented additional rules from being populated.
+	EvaluationError string `json:"evaluationError,omitempty"`
 }
 
 type AuthorizationAttributes struct {
@@ -185,6 +230,11 @@ type AuthorizationAttributes struct {
 	ResourceName string `json:"resourceName"`
 	// Content is the actual content of the request for create and update
 	Content kruntime.RawExtension `json:"content,omitempty"`
+
+	// IsNonResourceURL is true if this is a request for a non-resource URL (outside of the resource hierarchy)
+	IsNonResourceURL bool `json:"isNonResourceURL,omitempty"`
+	// Path is the path of a non resource URL
+	Path string `json:"path,omitempty"`
 }
 
 // PolicyList is a collection of Policies
@@ -222,6 +272,10 @@ type ClusterRole struct {
 
 	// Rules holds all the PolicyRules for this ClusterRole
 	Rules []PolicyRule `json:"rules"`
+	// IncludedRoles references other ClusterRoles whose rules are also granted by this ClusterRole
+	IncludedRoles []kapi.ObjectReference `json:"includedRoles,omitempty"`
+	// DenyRules holds the PolicyRules this ClusterRole denies, even if other rules allow them
+	DenyRules []PolicyRule `json:"denyRules,omitempty"`
 }
 
 // ClusterRoleBinding references a ClusterRole, but not contain it.  It can reference any ClusterRole in the same namespace or in the global namespace.
@@ -309,3 +363,50 @@ type ClusterRoleList struct {
 	unversioned.ListMeta `json:"metadata,omitempty"`
 	Items                []ClusterRole `json:"items"`
 }
+
+// RoleBindingRestriction limits the subjects role bindings in its namespace may bind to
+type RoleBindingRestriction struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec RoleBindingRestrictionSpec `json:"spec"`
+}
+
+// RoleBindingRestrictionSpec defines the subjects a restriction matches
+type RoleBindingRestrictionSpec struct {
+	UserRestriction           *UserRestriction           `json:"userrestriction,omitempty"`
+	GroupRestriction          *GroupRestriction          `json:"grouprestriction,omitempty"`
+	ServiceAccountRestriction *ServiceAccountRestriction `json:"serviceaccountrestriction,omitempty"`
+}
+
+// UserRestriction matches users by name, by the groups they are a member of, or by label
+type UserRestriction struct {
+	Users    []string          `json:"users,omitempty"`
+	Groups   []string          `json:"groups,omitempty"`
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// GroupRestriction matches groups by name or by label
+type GroupRestriction struct {
+	Groups   []string          `json:"groups,omitempty"`
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
+// ServiceAccountRestriction matches service accounts by name or by namespace
+type ServiceAccountRestriction struct {
+	ServiceAccounts []ServiceAccountReference `json:"serviceaccounts,omitempty"`
+	Namespaces      []string                  `json:"namespaces,omitempty"`
+}
+
+// ServiceAccountReference names a service account
+type ServiceAccountReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RoleBindingRestrictionList is a collection of RoleBindingRestrictions
+type RoleBindingRestrictionList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+	Items                []RoleBindingRestriction `json:"items"`
+}
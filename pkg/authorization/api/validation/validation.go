@@ -8,61 +8,82 @@ import (
 
 	oapi "github.com/openshift/origin/pkg/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	scopeauthorizer "github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	uservalidation "github.com/openshift/origin/pkg/user/api/validation"
 )
 
 func ValidateSubjectAccessReview(review *authorizationapi.SubjectAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
-
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb")))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource")))
-	}
-
+	allErrs := validateAuthorizationAttributes(review.Action)
+	allErrs = append(allErrs, validateScopes(review.Scopes, field.NewPath("scopes"))...)
 	return allErrs
 }
 
 func ValidateResourceAccessReview(review *authorizationapi.ResourceAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
-
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb")))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource")))
-	}
+	return validateAuthorizationAttributes(review.Action)
+}
 
+func ValidateLocalSubjectAccessReview(review *authorizationapi.LocalSubjectAccessReview) field.ErrorList {
+	allErrs := validateLocalAuthorizationAttributes(review.Action)
+	allErrs = append(allErrs, validateScopes(review.Scopes, field.NewPath("scopes"))...)
 	return allErrs
 }
 
-func ValidateLocalSubjectAccessReview(review *authorizationapi.LocalSubjectAccessReview) field.ErrorList {
-	allErrs := field.ErrorList{}
+func ValidateLocalResourceAccessReview(review *authorizationapi.LocalResourceAccessReview) field.ErrorList {
+	return validateLocalAuthorizationAttributes(review.Action)
+}
 
-	if len(review.Action.Verb) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("verb")))
-	}
-	if len(review.Action.Resource) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("resource")))
-	}
+func ValidateSelfSubjectRulesReview(review *authorizationapi.SelfSubjectRulesReview) field.ErrorList {
+	return validateScopes(review.Spec.Scopes, field.NewPath("spec", "scopes"))
+}
 
+func validateScopes(scopes []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, scope := range scopes {
+		for _, err := range scopeauthorizer.ValidateScopes([]string{scope}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), scope, err.Error()))
+		}
+	}
 	return allErrs
 }
 
-func ValidateLocalResourceAccessReview(review *authorizationapi.LocalResourceAccessReview) field.ErrorList {
+func validateAuthorizationAttributes(action authorizationapi.AuthorizationAttributes) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if len(review.Action.Verb) == 0 {
+	if len(action.Verb) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("verb")))
 	}
-	if len(review.Action.Resource) == 0 {
+
+	if action.IsNonResourceURL {
+		if len(action.Path) == 0 {
+			allErrs = append(allErrs, field.Required(field.NewPath("path")))
+		}
+		if len(action.Resource) > 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("resource"), action.Resource, "may not be specified for non-resource URLs"))
+		}
+		if len(action.ResourceName) > 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("resourceName"), action.ResourceName, "may not be specified for non-resource URLs"))
+		}
+		return allErrs
+	}
+
+	if len(action.Resource) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("resource")))
 	}
+	if len(action.Path) > 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("path"), action.Path, "may only be specified for non-resource URLs"))
+	}
 
 	return allErrs
 }
 
+// validateLocalAuthorizationAttributes rejects non-resource URLs, since they are not namespaced
+func validateLocalAuthorizationAttributes(action authorizationapi.AuthorizationAttributes) field.ErrorList {
+	if action.IsNonResourceURL {
+		return field.ErrorList{field.Invalid(field.NewPath("isNonResourceURL"), action.IsNonResourceURL, "non-resource URLs may not be checked in a namespace")}
+	}
+	return validateAuthorizationAttributes(action)
+}
+
 func ValidatePolicyName(name string, prefix bool) (bool, string) {
 	if ok, reason := oapi.MinimalNameRequirements(name, prefix); !ok {
 		return ok, reason
@@ -210,7 +231,46 @@ func ValidateRole(role *authorizationapi.Role, isNamespaced bool) field.ErrorLis
 }
 
 func validateRole(role *authorizationapi.Role, isNamespaced bool, fldPath *field.Path) field.ErrorList {
-	return validation.ValidateObjectMeta(&role.ObjectMeta, isNamespaced, oapi.MinimalNameRequirements, fldPath.Child("metadata"))
+	allErrs := validation.ValidateObjectMeta(&role.ObjectMeta, isNamespaced, oapi.MinimalNameRequirements, fldPath.Child("metadata"))
+
+	includedRolesPath := fldPath.Child("includedRoles")
+	for i, includedRole := range role.IncludedRoles {
+		allErrs = append(allErrs, validateIncludedRole(role, includedRole, isNamespaced, includedRolesPath.Index(i))...)
+	}
+
+	// attribute restrictions narrow what a rule allows, which has no meaning for a rule that denies
+	denyRulesPath := fldPath.Child("denyRules")
+	for i, rule := range role.DenyRules {
+		if rule.AttributeRestrictions.Object != nil {
+			allErrs = append(allErrs, field.Invalid(denyRulesPath.Index(i).Child("attributeRestrictions"), rule.AttributeRestrictions.Object, "deny rules may not have attribute restrictions"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIncludedRole(role *authorizationapi.Role, includedRole kapi.ObjectReference, isNamespaced bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(includedRole.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name")))
+	} else if valid, err := oapi.MinimalNameRequirements(includedRole.Name, false); !valid {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), includedRole.Name, err))
+	}
+
+	// included roles are either cluster roles, or roles in the namespace of the including role
+	switch {
+	case !isNamespaced && len(includedRole.Namespace) > 0:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), includedRole.Namespace, "cluster roles may only include other cluster roles"))
+	case isNamespaced && len(includedRole.Namespace) > 0 && includedRole.Namespace != role.Namespace:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), includedRole.Namespace, "must be empty or "+role.Namespace))
+	}
+
+	if includedRole.Name == role.Name && (len(includedRole.Namespace) > 0) == isNamespaced {
+		allErrs = append(allErrs, field.Invalid(fldPath, includedRole.Name, "a role may not include itself"))
+	}
+
+	return allErrs
 }
 
 func ValidateRoleUpdate(role *authorizationapi.Role, oldRole *authorizationapi.Role, isNamespaced bool) field.ErrorList {
@@ -332,3 +392,94 @@ func ValidateRoleBindingUpdate(roleBinding *authorizationapi.RoleBinding, oldRol
 
 	return allErrs
 }
+
+// ValidateRoleBindingRestriction tests that exactly one restriction is set and that it names valid subjects
+func ValidateRoleBindingRestriction(restriction *authorizationapi.RoleBindingRestriction) field.ErrorList {
+	allErrs := validation.ValidateObjectMeta(&restriction.ObjectMeta, true, oapi.GetNameValidationFunc(validation.NameIsDNSSubdomain), field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	spec := restriction.Spec
+	set := 0
+	if spec.UserRestriction != nil {
+		set++
+		allErrs = append(allErrs, validateUserRestriction(spec.UserRestriction, specPath.Child("userrestriction"))...)
+	}
+	if spec.GroupRestriction != nil {
+		set++
+		allErrs = append(allErrs, validateGroupRestriction(spec.GroupRestriction, specPath.Child("grouprestriction"))...)
+	}
+	if spec.ServiceAccountRestriction != nil {
+		set++
+		allErrs = append(allErrs, validateServiceAccountRestriction(spec.ServiceAccountRestriction, specPath.Child("serviceaccountrestriction"))...)
+	}
+	if set != 1 {
+		allErrs = append(allErrs, field.Invalid(specPath, "", "exactly one of userrestriction, grouprestriction, or serviceaccountrestriction must be set"))
+	}
+
+	return allErrs
+}
+
+func ValidateRoleBindingRestrictionUpdate(restriction, oldRestriction *authorizationapi.RoleBindingRestriction) field.ErrorList {
+	allErrs := ValidateRoleBindingRestriction(restriction)
+	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&restriction.ObjectMeta, &oldRestriction.ObjectMeta, field.NewPath("metadata"))...)
+	return allErrs
+}
+
+func validateUserRestriction(restriction *authorizationapi.UserRestriction, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(restriction.Users) == 0 && len(restriction.Groups) == 0 && len(restriction.Selector) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("users")))
+	}
+	allErrs = append(allErrs, validateRestrictedNames(restriction.Users, fldPath.Child("users"))...)
+	allErrs = append(allErrs, validateRestrictedNames(restriction.Groups, fldPath.Child("groups"))...)
+	allErrs = append(allErrs, validation.ValidateLabels(restriction.Selector, fldPath.Child("selector"))...)
+	return allErrs
+}
+
+func validateGroupRestriction(restriction *authorizationapi.GroupRestriction, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(restriction.Groups) == 0 && len(restriction.Selector) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groups")))
+	}
+	allErrs = append(allErrs, validateRestrictedNames(restriction.Groups, fldPath.Child("groups"))...)
+	allErrs = append(allErrs, validation.ValidateLabels(restriction.Selector, fldPath.Child("selector"))...)
+	return allErrs
+}
+
+func validateServiceAccountRestriction(restriction *authorizationapi.ServiceAccountRestriction, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(restriction.ServiceAccounts) == 0 && len(restriction.Namespaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceaccounts")))
+	}
+	for i, serviceAccount := range restriction.ServiceAccounts {
+		idxPath := fldPath.Child("serviceaccounts").Index(i)
+		if len(serviceAccount.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name")))
+		} else if valid, reason := validation.ValidateServiceAccountName(serviceAccount.Name, false); !valid {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), serviceAccount.Name, reason))
+		}
+		if len(serviceAccount.Namespace) > 0 {
+			if valid, reason := validation.ValidateNamespaceName(serviceAccount.Namespace, false); !valid {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), serviceAccount.Namespace, reason))
+			}
+		}
+	}
+	for i, namespace := range restriction.Namespaces {
+		if valid, reason := validation.ValidateNamespaceName(namespace, false); !valid {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, reason))
+		}
+	}
+	return allErrs
+}
+
+// validateRestrictedNames checks the names of users or groups. System users and groups may be named, so only empty
+// names are rejected.
+func validateRestrictedNames(names []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, name := range names {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i)))
+		}
+	}
+	return allErrs
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -370,6 +372,40 @@ func TestValidateRole(t *testing.T) {
 			T: field.ErrorTypeRequired,
 			F: "metadata.name",
 		},
+		"included role without name": {
+			A: authorizationapi.Role{
+				ObjectMeta:    kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "master"},
+				IncludedRoles: []kapi.ObjectReference{{}},
+			},
+			T: field.ErrorTypeRequired,
+			F: "includedRoles[0].name",
+		},
+		"included role in another namespace": {
+			A: authorizationapi.Role{
+				ObjectMeta:    kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "master"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "view", Namespace: "other"}},
+			},
+			T: field.ErrorTypeInvalid,
+			F: "includedRoles[0].namespace",
+		},
+		"including itself": {
+			A: authorizationapi.Role{
+				ObjectMeta:    kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "master"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "master", Namespace: kapi.NamespaceDefault}},
+			},
+			T: field.ErrorTypeInvalid,
+			F: "includedRoles[0]",
+		},
+		"deny rule with attribute restrictions": {
+			A: authorizationapi.Role{
+				ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "master"},
+				DenyRules: []authorizationapi.PolicyRule{
+					{Verbs: sets.NewString("create"), Resources: sets.NewString("subjectaccessreviews"), AttributeRestrictions: runtime.EmbeddedObject{Object: &authorizationapi.IsPersonalSubjectAccessReview{}}},
+				},
+			},
+			T: field.ErrorTypeInvalid,
+			F: "denyRules[0].attributeRestrictions",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateRole(&v.A, true)
@@ -419,3 +455,139 @@ func TestValidateClusterPolicyBinding(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSubjectAccessReview(t *testing.T) {
+	successCases := map[string]authorizationapi.AuthorizationAttributes{
+		"resource":         {Verb: "get", Resource: "pods"},
+		"non-resource URL": {Verb: "get", IsNonResourceURL: true, Path: "/metrics"},
+	}
+	for k, v := range successCases {
+		if errs := ValidateSubjectAccessReview(&authorizationapi.SubjectAccessReview{Action: v}); len(errs) != 0 {
+			t.Errorf("%s: expected success: %v", k, errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		A authorizationapi.AuthorizationAttributes
+		T field.ErrorType
+		F string
+	}{
+		"zero-length verb": {
+			A: authorizationapi.AuthorizationAttributes{Resource: "pods"},
+			T: field.ErrorTypeRequired,
+			F: "verb",
+		},
+		"zero-length resource": {
+			A: authorizationapi.AuthorizationAttributes{Verb: "get"},
+			T: field.ErrorTypeRequired,
+			F: "resource",
+		},
+		"path on resource": {
+			A: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "pods", Path: "/metrics"},
+			T: field.ErrorTypeInvalid,
+			F: "path",
+		},
+		"zero-length path": {
+			A: authorizationapi.AuthorizationAttributes{Verb: "get", IsNonResourceURL: true},
+			T: field.ErrorTypeRequired,
+			F: "path",
+		},
+		"resource on non-resource URL": {
+			A: authorizationapi.AuthorizationAttributes{Verb: "get", IsNonResourceURL: true, Path: "/metrics", Resource: "pods"},
+			T: field.ErrorTypeInvalid,
+			F: "resource",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateSubjectAccessReview(&authorizationapi.SubjectAccessReview{Action: v.A})
+		if len(errs) == 0 {
+			t.Errorf("expected failure %s for %v", k, v.A)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Type != v.T {
+				t.Errorf("%s: expected errors to have type %s: %v", k, v.T, errs[i])
+			}
+			if errs[i].Field != v.F {
+				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
+			}
+		}
+	}
+
+	if errs := ValidateLocalSubjectAccessReview(&authorizationapi.LocalSubjectAccessReview{Action: successCases["non-resource URL"]}); len(errs) == 0 {
+		t.Errorf("expected non-resource URLs to be rejected in local reviews")
+	}
+}
+
+func TestValidateRoleBindingRestriction(t *testing.T) {
+	meta := kapi.ObjectMeta{Name: "restriction", Namespace: "project"}
+	valid := []authorizationapi.RoleBindingRestrictionSpec{
+		{UserRestriction: &authorizationapi.UserRestriction{Users: []string{"alice", "system:admin"}}},
+		{UserRestriction: &authorizationapi.UserRestriction{Groups: []string{"staff"}}},
+		{UserRestriction: &authorizationapi.UserRestriction{Selector: map[string]string{"company": "acme"}}},
+		{GroupRestriction: &authorizationapi.GroupRestriction{Groups: []string{"staff"}}},
+		{ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{ServiceAccounts: []authorizationapi.ServiceAccountReference{{Name: "builder"}, {Name: "deployer", Namespace: "other"}}}},
+		{ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{Namespaces: []string{"ci"}}},
+	}
+	for i, spec := range valid {
+		if errs := ValidateRoleBindingRestriction(&authorizationapi.RoleBindingRestriction{ObjectMeta: meta, Spec: spec}); len(errs) != 0 {
+			t.Errorf("%d: expected success: %v", i, errs)
+		}
+	}
+
+	invalid := map[string]struct {
+		meta  kapi.ObjectMeta
+		spec  authorizationapi.RoleBindingRestrictionSpec
+		field string
+	}{
+		"missing namespace": {
+			meta:  kapi.ObjectMeta{Name: "restriction"},
+			spec:  valid[0],
+			field: "metadata.namespace",
+		},
+		"no restriction": {
+			meta:  meta,
+			field: "spec",
+		},
+		"two restrictions": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{UserRestriction: valid[0].UserRestriction, GroupRestriction: valid[3].GroupRestriction},
+			field: "spec",
+		},
+		"empty user restriction": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{UserRestriction: &authorizationapi.UserRestriction{}},
+			field: "spec.userrestriction.users",
+		},
+		"empty user name": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{UserRestriction: &authorizationapi.UserRestriction{Users: []string{""}}},
+			field: "spec.userrestriction.users[0]",
+		},
+		"invalid group selector": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{GroupRestriction: &authorizationapi.GroupRestriction{Selector: map[string]string{"company": "acme corp"}}},
+			field: "spec.grouprestriction.selector",
+		},
+		"invalid service account": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{ServiceAccounts: []authorizationapi.ServiceAccountReference{{Name: "Builder"}}}},
+			field: "spec.serviceaccountrestriction.serviceaccounts[0].name",
+		},
+		"invalid namespace": {
+			meta:  meta,
+			spec:  authorizationapi.RoleBindingRestrictionSpec{ServiceAccountRestriction: &authorizationapi.ServiceAccountRestriction{Namespaces: []string{"CI"}}},
+			field: "spec.serviceaccountrestriction.namespaces[0]",
+		},
+	}
+	for k, v := range invalid {
+		errs := ValidateRoleBindingRestriction(&authorizationapi.RoleBindingRestriction{ObjectMeta: v.meta, Spec: v.spec})
+		if len(errs) == 0 {
+			t.Errorf("%s: expected failure", k)
+			continue
+		}
+		if errs[0].Field != v.field {
+			t.Errorf("%s: expected errors to have field %s: %v", k, v.field, errs)
+		}
+	}
+}
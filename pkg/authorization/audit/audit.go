@@ -0,0 +1,171 @@
+package audit
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+)
+
+const (
+	DecisionAllowed = "allowed"
+	DecisionDenied  = "denied"
+)
+
+// policyResources are the resources whose changes are always audited
+var policyResources = sets.NewString(
+	"policies", "policybindings", "roles", "rolebindings",
+	"clusterpolicies", "clusterpolicybindings", "clusterroles", "clusterrolebindings",
+)
+
+// mutatingVerbs are the verbs that change a resource
+var mutatingVerbs = sets.NewString("create", "update", "patch", "delete", "deletecollection")
+
+// Event is a single audit record
+type Event struct {
+	Time   time.Time `json:"time"`
+	User   string    `json:"user"`
+	Groups []string  `json:"groups,omitempty"`
+
+	Verb         string `json:"verb"`
+	Namespace    string `json:"namespace,omitempty"`
+	Resource     string `json:"resource,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	// Path is set for requests against non-resource URLs
+	Path string `json:"path,omitempty"`
+
+	// Decision is DecisionAllowed or DecisionDenied
+	Decision string `json:"decision"`
+	// Reason is the explanation given by the authorizer
+	Reason string `json:"reason,omitempty"`
+	// Code is the HTTP status code returned for an allowed request
+	Code int `json:"code,omitempty"`
+
+	// Container is the container an exec, attach, or port-forward session was opened with
+	Container string `json:"container,omitempty"`
+	// Command is the command requested by an exec session
+	Command []string `json:"command,omitempty"`
+}
+
+// NewEvent builds an audit record for the user in ctx performing the request described by attributes.  The
+// container and command of an interactive session are read from the request held by attributes.
+func NewEvent(ctx kapi.Context, attributes authorizer.AuthorizationAttributes, decision, reason string) Event {
+	event := Event{
+		Time:      time.Now(),
+		Verb:      attributes.GetVerb(),
+		Namespace: kapi.NamespaceValue(ctx),
+		Decision:  decision,
+		Reason:    reason,
+	}
+	if user, ok := kapi.UserFrom(ctx); ok {
+		event.User = user.GetName()
+		event.Groups = user.GetGroups()
+	}
+	if attributes.IsNonResourceURL() {
+		event.Path = attributes.GetURL()
+	} else {
+		event.Resource = attributes.GetResource()
+		event.ResourceName = attributes.GetResourceName()
+	}
+	if req, ok := attributes.GetRequestAttributes().(*http.Request); ok && IsInteractiveAccess(attributes) && req.URL != nil {
+		query := req.URL.Query()
+		event.Container = query.Get("container")
+		event.Command = query[kapi.ExecCommandParamm]
+	}
+	return event
+}
+
+// Backend stores audit records
+type Backend interface {
+	Write(event Event) error
+}
+
+// Auditor records every change to policy, every interactive session with a pod and, optionally, a sample of authorization denials
+type Auditor struct {
+	backend Backend
+
+	// denialSampleInterval is the number of denials between each recorded denial.  Zero disables auditing of denials.
+	denialSampleInterval uint64
+	denials              uint64
+}
+
+// NewAuditor returns an Auditor that writes to backend and records one in every denialSampleInterval denials
+func NewAuditor(backend Backend, denialSampleInterval int) *Auditor {
+	if denialSampleInterval < 0 {
+		denialSampleInterval = 0
+	}
+	return &Auditor{backend: backend, denialSampleInterval: uint64(denialSampleInterval)}
+}
+
+// IsPolicyChange returns true if the request described by attributes modifies policy, roles, or role bindings
+func IsPolicyChange(attributes authorizer.AuthorizationAttributes) bool {
+	if attributes.IsNonResourceURL() {
+		return false
+	}
+	return mutatingVerbs.Has(attributes.GetVerb()) && policyResources.Has(attributes.GetResource())
+}
+
+// IsInteractiveAccess returns true if the request described by attributes opens an exec, attach, or
+// port-forward session with a pod
+func IsInteractiveAccess(attributes authorizer.AuthorizationAttributes) bool {
+	if attributes.IsNonResourceURL() {
+		return false
+	}
+	return authorizer.InteractiveResources.Has(attributes.GetResource())
+}
+
+// Denied records a denied request if it falls on the sampling interval
+func (a *Auditor) Denied(ctx kapi.Context, attributes authorizer.AuthorizationAttributes, reason string) {
+	if a.denialSampleInterval == 0 {
+		return
+	}
+	if (atomic.AddUint64(&a.denials, 1)-1)%a.denialSampleInterval != 0 {
+		return
+	}
+	a.write(NewEvent(ctx, attributes, DecisionDenied, reason))
+}
+
+// Handle serves an allowed request and records its outcome if it changes policy.  Interactive sessions
+// are recorded, with the container and command requested, before they are opened: the connection is
+// upgraded and handed to the node, so there is no response status to wait for.
+func (a *Auditor) Handle(ctx kapi.Context, attributes authorizer.AuthorizationAttributes, reason string, handler http.Handler, w http.ResponseWriter, req *http.Request) {
+	if IsInteractiveAccess(attributes) {
+		a.write(NewEvent(ctx, attributes, DecisionAllowed, reason))
+		handler.ServeHTTP(w, req)
+		return
+	}
+	if !IsPolicyChange(attributes) {
+		handler.ServeHTTP(w, req)
+		return
+	}
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	handler.ServeHTTP(recorder, req)
+
+	event := NewEvent(ctx, attributes, DecisionAllowed, reason)
+	event.Code = recorder.status
+	a.write(event)
+}
+
+func (a *Auditor) write(event Event) {
+	if err := a.backend.Write(event); err != nil {
+		glog.Errorf("Unable to write audit record for %s %s by %s: %v", event.Verb, event.Resource, event.User, err)
+	}
+}
+
+// statusRecorder remembers the status code written to the wrapped ResponseWriter
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
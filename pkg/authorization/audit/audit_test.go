@@ -0,0 +1,209 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+)
+
+type recordingBackend struct {
+	events []Event
+}
+
+func (b *recordingBackend) Write(event Event) error {
+	b.events = append(b.events, event)
+	return nil
+}
+
+func newContext() kapi.Context {
+	return kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "myproject"), &user.DefaultInfo{Name: "alice", Groups: []string{"developers"}})
+}
+
+func newRequest(t *testing.T, path string) *http.Request {
+	req, err := http.NewRequest("POST", "https://localhost:8443"+path, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	testCases := map[string]struct {
+		attributes     authorizer.AuthorizationAttributes
+		status         int
+		expectedEvents []Event
+	}{
+		"policy change": {
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "create", Resource: "rolebindings", ResourceName: "admins"},
+			status:     http.StatusCreated,
+			expectedEvents: []Event{{
+				User: "alice", Groups: []string{"developers"}, Verb: "create", Namespace: "myproject",
+				Resource: "rolebindings", ResourceName: "admins", Decision: DecisionAllowed, Reason: "allowed by rule", Code: http.StatusCreated,
+			}},
+		},
+		"failed policy change": {
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "clusterroles", ResourceName: "admin"},
+			status:     http.StatusNotFound,
+			expectedEvents: []Event{{
+				User: "alice", Groups: []string{"developers"}, Verb: "delete", Namespace: "myproject",
+				Resource: "clusterroles", ResourceName: "admin", Decision: DecisionAllowed, Reason: "allowed by rule", Code: http.StatusNotFound,
+			}},
+		},
+		"policy read": {
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "rolebindings", ResourceName: "admins"},
+			status:     http.StatusOK,
+		},
+		"other resource": {
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "create", Resource: "pods"},
+			status:     http.StatusCreated,
+		},
+		"exec session": {
+			attributes: authorizer.DefaultAuthorizationAttributes{
+				Verb: "create", Resource: "pods/exec", ResourceName: "web-1",
+				RequestAttributes: newRequest(t, "/api/v1/namespaces/myproject/pods/web-1/exec?container=ruby&command=cat&command=%2Fetc%2Fpasswd"),
+			},
+			status: http.StatusSwitchingProtocols,
+			expectedEvents: []Event{{
+				User: "alice", Groups: []string{"developers"}, Verb: "create", Namespace: "myproject",
+				Resource: "pods/exec", ResourceName: "web-1", Decision: DecisionAllowed, Reason: "allowed by rule",
+				Container: "ruby", Command: []string{"cat", "/etc/passwd"},
+			}},
+		},
+		"port-forward session": {
+			attributes: authorizer.DefaultAuthorizationAttributes{
+				Verb: "create", Resource: "pods/portforward", ResourceName: "web-1",
+				RequestAttributes: newRequest(t, "/api/v1/namespaces/myproject/pods/web-1/portforward"),
+			},
+			status: http.StatusSwitchingProtocols,
+			expectedEvents: []Event{{
+				User: "alice", Groups: []string{"developers"}, Verb: "create", Namespace: "myproject",
+				Resource: "pods/portforward", ResourceName: "web-1", Decision: DecisionAllowed, Reason: "allowed by rule",
+			}},
+		},
+		"non-resource URL": {
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "create", NonResourceURL: true, URL: "/roles"},
+			status:     http.StatusOK,
+		},
+	}
+
+	for k, tc := range testCases {
+		backend := &recordingBackend{}
+		auditor := NewAuditor(backend, 0)
+
+		handled := false
+		handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			handled = true
+			w.WriteHeader(tc.status)
+		})
+		resp := httptest.NewRecorder()
+		auditor.Handle(newContext(), tc.attributes, "allowed by rule", handler, resp, &http.Request{})
+
+		if !handled {
+			t.Errorf("%s: the request was not handled", k)
+		}
+		if resp.Code != tc.status {
+			t.Errorf("%s: expected status %d, got %d", k, tc.status, resp.Code)
+		}
+		for i := range backend.events {
+			if backend.events[i].Time.IsZero() {
+				t.Errorf("%s: expected the event time to be set", k)
+			}
+			backend.events[i].Time = time.Time{}
+		}
+		if !reflect.DeepEqual(backend.events, tc.expectedEvents) {
+			t.Errorf("%s: expected %#v, got %#v", k, tc.expectedEvents, backend.events)
+		}
+	}
+}
+
+func TestDeniedSampling(t *testing.T) {
+	testCases := map[string]struct {
+		interval       int
+		denials        int
+		expectedEvents int
+	}{
+		"disabled": {
+			interval:       0,
+			denials:        10,
+			expectedEvents: 0,
+		},
+		"every denial": {
+			interval:       1,
+			denials:        10,
+			expectedEvents: 10,
+		},
+		"sampled": {
+			interval:       4,
+			denials:        10,
+			expectedEvents: 3,
+		},
+	}
+
+	attributes := authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets"}
+	for k, tc := range testCases {
+		backend := &recordingBackend{}
+		auditor := NewAuditor(backend, tc.interval)
+		for i := 0; i < tc.denials; i++ {
+			auditor.Denied(newContext(), attributes, "denied")
+		}
+
+		if len(backend.events) != tc.expectedEvents {
+			t.Errorf("%s: expected %d events, got %d", k, tc.expectedEvents, len(backend.events))
+		}
+		for _, event := range backend.events {
+			if event.Decision != DecisionDenied || event.Resource != "secrets" || event.User != "alice" {
+				t.Errorf("%s: unexpected event %#v", k, event)
+			}
+		}
+	}
+}
+
+func TestFileBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	backend, err := NewFileBackend(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		if err := backend.Write(Event{User: name, Verb: "update", Resource: "roles", Decision: DecisionAllowed}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	users := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event := Event{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		users = append(users, event.User)
+	}
+	if len(users) != 2 || users[0] != "alice" || users[1] != "bob" {
+		t.Errorf("expected one record per line for alice and bob, got %v", users)
+	}
+}
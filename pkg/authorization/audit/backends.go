@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+)
+
+// fileBackend appends each audit record to a file as a single line of JSON
+type fileBackend struct {
+	lock    sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewFileBackend returns a Backend that appends audit records to the file at path, creating it if needed
+func NewFileBackend(path string) (Backend, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileBackend{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+func (b *fileBackend) Write(event Event) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.encoder.Encode(event)
+}
+
+// webhookQueueLength is the number of audit records that may wait to be sent before new records are dropped
+const webhookQueueLength = 1000
+
+// webhookBackend POSTs each audit record as JSON to a URL.  Records are sent in the background so
+// that a slow or unavailable webhook does not hold up API requests.
+type webhookBackend struct {
+	url    string
+	client *http.Client
+	queue  chan Event
+}
+
+// NewWebhookBackend returns a Backend that sends audit records to url
+func NewWebhookBackend(url string) Backend {
+	b := &webhookBackend{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		queue:  make(chan Event, webhookQueueLength),
+	}
+	go b.run()
+	return b
+}
+
+func (b *webhookBackend) Write(event Event) error {
+	select {
+	case b.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("the queue for %s is full", b.url)
+	}
+}
+
+func (b *webhookBackend) run() {
+	for event := range b.queue {
+		if err := b.send(event); err != nil {
+			glog.Errorf("Unable to send audit record to %s: %v", b.url, err)
+		}
+	}
+}
+
+func (b *webhookBackend) send(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := b.client.Post(b.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+	return nil
+}
+
+// logBackend writes audit records to the server log
+type logBackend struct{}
+
+// NewLogBackend returns a Backend that writes audit records to the server log
+func NewLogBackend() Backend {
+	return logBackend{}
+}
+
+func (logBackend) Write(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	glog.Infof("AUDIT: %s", body)
+	return nil
+}
+
+// multiBackend writes each audit record to every backend it holds
+type multiBackend []Backend
+
+// NewMultiBackend returns a Backend that writes audit records to all of the given backends
+func NewMultiBackend(backends ...Backend) Backend {
+	if len(backends) == 1 {
+		return backends[0]
+	}
+	return multiBackend(backends)
+}
+
+func (m multiBackend) Write(event Event) error {
+	errs := []error{}
+	for _, backend := range m {
+		if err := backend.Write(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
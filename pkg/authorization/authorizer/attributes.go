@@ -25,9 +25,11 @@ type DefaultAuthorizationAttributes struct {
 // because the authorizer takes that information on the context
 func ToDefaultAuthorizationAttributes(in authorizationapi.AuthorizationAttributes) DefaultAuthorizationAttributes {
 	return DefaultAuthorizationAttributes{
-		Verb:         in.Verb,
-		Resource:     in.Resource,
-		ResourceName: in.ResourceName,
+		Verb:           in.Verb,
+		Resource:       in.Resource,
+		ResourceName:   in.ResourceName,
+		NonResourceURL: in.IsNonResourceURL,
+		URL:            in.Path,
 	}
 }
 
@@ -6,8 +6,14 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kapiserver "k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/util/sets"
 )
 
+// InteractiveResources are the subresources that open a session with a container of a pod.  They are
+// always authorized with the "create" verb, because the websocket form of these requests arrives as a
+// GET and must not be allowed to anyone who can only read the pod.
+var InteractiveResources = sets.NewString("pods/exec", "pods/attach", "pods/portforward")
+
 type openshiftAuthorizationAttributeBuilder struct {
 	contextMapper kapi.RequestContextMapper
 	infoResolver  *kapiserver.RequestInfoResolver
@@ -36,8 +42,13 @@ func (a *openshiftAuthorizationAttributeBuilder) GetAttributes(req *http.Request
 		resource = requestInfo.Resource + "/" + requestInfo.Subresource
 	}
 
+	verb := requestInfo.Verb
+	if InteractiveResources.Has(resource) {
+		verb = "create"
+	}
+
 	return DefaultAuthorizationAttributes{
-		Verb:              requestInfo.Verb,
+		Verb:              verb,
 		APIGroup:          requestInfo.APIGroup,
 		APIVersion:        requestInfo.APIVersion,
 		Resource:          resource,
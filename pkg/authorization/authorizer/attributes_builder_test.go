@@ -0,0 +1,70 @@
+package authorizer
+
+import (
+	"net/http"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapiserver "k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func TestInteractiveResourceVerb(t *testing.T) {
+	builder := NewAuthorizationAttributeBuilder(kapi.NewRequestContextMapper(), &kapiserver.RequestInfoResolver{
+		APIPrefixes:          sets.NewString("api", "oapi"),
+		GrouplessAPIPrefixes: sets.NewString("api", "oapi"),
+	})
+
+	testCases := map[string]struct {
+		method           string
+		path             string
+		expectedVerb     string
+		expectedResource string
+	}{
+		"exec over SPDY": {
+			method:           "POST",
+			path:             "/api/v1/namespaces/myproject/pods/web-1/exec",
+			expectedVerb:     "create",
+			expectedResource: "pods/exec",
+		},
+		"exec over websockets": {
+			method:           "GET",
+			path:             "/api/v1/namespaces/myproject/pods/web-1/exec",
+			expectedVerb:     "create",
+			expectedResource: "pods/exec",
+		},
+		"attach over websockets": {
+			method:           "GET",
+			path:             "/api/v1/namespaces/myproject/pods/web-1/attach",
+			expectedVerb:     "create",
+			expectedResource: "pods/attach",
+		},
+		"port-forward": {
+			method:           "GET",
+			path:             "/api/v1/namespaces/myproject/pods/web-1/portforward",
+			expectedVerb:     "create",
+			expectedResource: "pods/portforward",
+		},
+		"logs": {
+			method:           "GET",
+			path:             "/api/v1/namespaces/myproject/pods/web-1/log",
+			expectedVerb:     "get",
+			expectedResource: "pods/log",
+		},
+	}
+
+	for k, tc := range testCases {
+		req, err := http.NewRequest(tc.method, "https://localhost:8443"+tc.path, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+		attributes, err := builder.GetAttributes(req)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if attributes.GetVerb() != tc.expectedVerb || attributes.GetResource() != tc.expectedResource {
+			t.Errorf("%s: expected %s %s, got %s %s", k, tc.expectedVerb, tc.expectedResource, attributes.GetVerb(), attributes.GetResource())
+		}
+	}
+}
@@ -6,6 +6,8 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationinterfaces "github.com/openshift/origin/pkg/authorization/interfaces"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
 )
 
@@ -26,6 +28,15 @@ func (a *openshiftAuthorizer) Authorize(ctx kapi.Context, passedAttributes Autho
 	// This is most common when a bound role is missing, but enough roles are still present and bound to authorize the request.
 	errs := []error{}
 
+	// deny rules take precedence over every rule that allows the request, wherever either is bound
+	deniedReason, err := a.denyWithRules(ctx, attributes)
+	if err != nil {
+		return false, "", err
+	}
+	if len(deniedReason) > 0 {
+		return false, deniedReason, nil
+	}
+
 	masterContext := kapi.WithNamespace(ctx, kapi.NamespaceNone)
 	globalAllowed, globalReason, err := a.authorizeWithNamespaceRules(masterContext, attributes)
 	if globalAllowed {
@@ -63,15 +74,25 @@ func (a *openshiftAuthorizer) Authorize(ctx kapi.Context, passedAttributes Autho
 // If we got an error, then the list of subjects may not be complete, but it does not contain any incorrect names.
 // This is done because policy rules are purely additive and policy determinations
 // can be made on the basis of those rules that are found.
+// Subjects bound to a role that denies the action are removed, but users are still returned when only one of their groups
+// is denied the action, since group membership is not known here.
 func (a *openshiftAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes AuthorizationAttributes) (sets.String, sets.String, error) {
 	errs := []error{}
 
 	masterContext := kapi.WithNamespace(ctx, kapi.NamespaceNone)
-	globalUsers, globalGroups, err := a.getAllowedSubjectsFromNamespaceBindings(masterContext, attributes)
+	globalUsers, globalGroups, err := a.getSubjectsFromNamespaceBindings(masterContext, attributes, authorizationinterfaces.Role.Rules)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	localUsers, localGroups, err := a.getSubjectsFromNamespaceBindings(ctx, attributes, authorizationinterfaces.Role.Rules)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	globalDeniedUsers, globalDeniedGroups, err := a.getSubjectsFromNamespaceBindings(masterContext, attributes, authorizationinterfaces.Role.DenyRules)
 	if err != nil {
 		errs = append(errs, err)
 	}
-	localUsers, localGroups, err := a.getAllowedSubjectsFromNamespaceBindings(ctx, attributes)
+	localDeniedUsers, localDeniedGroups, err := a.getSubjectsFromNamespaceBindings(ctx, attributes, authorizationinterfaces.Role.DenyRules)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -79,15 +100,20 @@ func (a *openshiftAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes Au
 	users := sets.String{}
 	users.Insert(globalUsers.List()...)
 	users.Insert(localUsers.List()...)
+	users.Delete(globalDeniedUsers.List()...)
+	users.Delete(localDeniedUsers.List()...)
 
 	groups := sets.String{}
 	groups.Insert(globalGroups.List()...)
 	groups.Insert(localGroups.List()...)
+	groups.Delete(globalDeniedGroups.List()...)
+	groups.Delete(localDeniedGroups.List()...)
 
 	return users, groups, kerrors.NewAggregate(errs)
 }
 
-func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(ctx kapi.Context, passedAttributes AuthorizationAttributes) (sets.String, sets.String, error) {
+// getSubjectsFromNamespaceBindings returns the subjects bound to a role for which roleRules selects a rule matching the action
+func (a *openshiftAuthorizer) getSubjectsFromNamespaceBindings(ctx kapi.Context, passedAttributes AuthorizationAttributes, roleRules func(authorizationinterfaces.Role) []authorizationapi.PolicyRule) (sets.String, sets.String, error) {
 	attributes := coerceToDefaultAuthorizationAttributes(passedAttributes)
 
 	errs := []error{}
@@ -109,7 +135,7 @@ func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(ctx kapi.C
 			continue
 		}
 
-		for _, rule := range role.Rules() {
+		for _, rule := range roleRules(role) {
 			matches, err := attributes.RuleMatches(rule)
 			if err != nil {
 				errs = append(errs, err)
@@ -126,6 +152,38 @@ func (a *openshiftAuthorizer) getAllowedSubjectsFromNamespaceBindings(ctx kapi.C
 	return users, groups, kerrors.NewAggregate(errs)
 }
 
+// denyWithRules returns the reason the action is denied if a cluster deny rule or a deny rule in the namespace of ctx matches
+// it, and an empty reason otherwise.  Missing roles deny nothing, but if the deny rules cannot be retrieved otherwise, an error
+// is returned so that the action is not allowed.
+func (a *openshiftAuthorizer) denyWithRules(ctx kapi.Context, attributes *DefaultAuthorizationAttributes) (string, error) {
+	namespaces := []string{kapi.NamespaceNone}
+	if namespace := kapi.NamespaceValue(ctx); len(namespace) != 0 {
+		namespaces = append(namespaces, namespace)
+	}
+
+	errs := []error{}
+	for _, namespace := range namespaces {
+		denyRules, err := a.ruleResolver.GetEffectiveDenyRules(kapi.WithNamespace(ctx, namespace))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, rule := range denyRules {
+			matches, err := attributes.RuleMatches(rule)
+			if err != nil {
+				return "", err
+			}
+			if matches {
+				if len(namespace) == 0 {
+					return "denied by cluster rule", nil
+				}
+				return "denied by rule in " + namespace, nil
+			}
+		}
+	}
+
+	return "", kerrors.NewAggregate(errs)
+}
+
 // authorizeWithNamespaceRules returns isAllowed, reason, and error.  If an error is returned, isAllowed and reason are still valid.  This seems strange
 // but errors are not always fatal to the authorization process.  It is entirely possible to get an error and be able to continue determine authorization
 // status in spite of it.  This is most common when a bound role is missing, but enough roles are still present and bound to authorize the request.
@@ -151,6 +209,23 @@ func (a *openshiftAuthorizer) authorizeWithNamespaceRules(ctx kapi.Context, pass
 	return false, "", ruleRetrievalError
 }
 
+// RulesAllow returns true if any of the rules match the attributes
+func RulesAllow(passedAttributes AuthorizationAttributes, rules ...authorizationapi.PolicyRule) (bool, error) {
+	attributes := coerceToDefaultAuthorizationAttributes(passedAttributes)
+
+	for _, rule := range rules {
+		matches, err := attributes.RuleMatches(rule)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // TODO this may or may not be the behavior we want for managing rules.  As a for instance, a verb might be specified
 // that our attributes builder will never satisfy.  For now, I think gets us close.  Maybe a warning message of some kind?
 func coerceToDefaultAuthorizationAttributes(passedAttributes AuthorizationAttributes) *DefaultAuthorizationAttributes {
@@ -286,6 +286,93 @@ func TestAllowedWithMissingBinding(t *testing.T) {
 	test.test(t)
 }
 
+func TestDenyRuleOutranksClusterRule(t *testing.T) {
+	test := &authorizeTest{
+		context: kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "adze"), &user.DefaultInfo{Name: "ClusterAdmin"}),
+		attributes: &DefaultAuthorizationAttributes{
+			Verb:     "get",
+			Resource: "secrets",
+		},
+		expectedAllowed: false,
+		expectedReason:  "denied by rule in adze",
+	}
+	test.clusterPolicies = newDefaultClusterPolicies()
+	test.policies = newAdzePolicies()
+	test.clusterBindings = newDefaultClusterPolicyBindings()
+	test.bindings = newAdzeBindings()
+	addAdzeSecretsDenial(test.policies, test.bindings, "ClusterAdmin")
+
+	test.test(t)
+}
+
+func TestDenyRuleOnlyDeniesMatchingRequests(t *testing.T) {
+	test := &authorizeTest{
+		context: kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "adze"), &user.DefaultInfo{Name: "ClusterAdmin"}),
+		attributes: &DefaultAuthorizationAttributes{
+			Verb:     "get",
+			Resource: "pods",
+		},
+		expectedAllowed: true,
+		expectedReason:  "allowed by cluster rule",
+	}
+	test.clusterPolicies = newDefaultClusterPolicies()
+	test.policies = newAdzePolicies()
+	test.clusterBindings = newDefaultClusterPolicyBindings()
+	test.bindings = newAdzeBindings()
+	addAdzeSecretsDenial(test.policies, test.bindings, "ClusterAdmin")
+
+	test.test(t)
+}
+
+func TestDenyRuleDoesNotApplyInOtherNamespaces(t *testing.T) {
+	test := &authorizeTest{
+		context: kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "mallet"), &user.DefaultInfo{Name: "ClusterAdmin"}),
+		attributes: &DefaultAuthorizationAttributes{
+			Verb:     "get",
+			Resource: "secrets",
+		},
+		expectedAllowed: true,
+		expectedReason:  "allowed by cluster rule",
+	}
+	test.clusterPolicies = newDefaultClusterPolicies()
+	test.policies = newAdzePolicies()
+	test.clusterBindings = newDefaultClusterPolicyBindings()
+	test.bindings = newAdzeBindings()
+	addAdzeSecretsDenial(test.policies, test.bindings, "ClusterAdmin")
+
+	test.test(t)
+}
+
+// denyRulesErrorResolver fails to retrieve the deny rules of one namespace.
+type denyRulesErrorResolver struct {
+	rulevalidation.AuthorizationRuleResolver
+	namespace string
+}
+
+func (r denyRulesErrorResolver) GetEffectiveDenyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error) {
+	if kapi.NamespaceValue(ctx) == r.namespace {
+		return nil, errors.New("unable to list bindings")
+	}
+	return r.AuthorizationRuleResolver.GetEffectiveDenyRules(ctx)
+}
+
+func TestDenyRulesRetrievalErrorDoesNotAllow(t *testing.T) {
+	policyRegistry := testpolicyregistry.NewPolicyRegistry(newAdzePolicies(), nil)
+	policyBindingRegistry := testpolicyregistry.NewPolicyBindingRegistry(newAdzeBindings(), nil)
+	clusterPolicyRegistry := testpolicyregistry.NewClusterPolicyRegistry(newDefaultClusterPolicies(), nil)
+	clusterPolicyBindingRegistry := testpolicyregistry.NewClusterPolicyBindingRegistry(newDefaultClusterPolicyBindings(), nil)
+	resolver := denyRulesErrorResolver{
+		AuthorizationRuleResolver: rulevalidation.NewDefaultRuleResolver(policyRegistry, policyBindingRegistry, clusterPolicyRegistry, clusterPolicyBindingRegistry),
+		namespace:                 "adze",
+	}
+	authorizer := NewAuthorizer(resolver, NewForbiddenMessageResolver(""))
+
+	ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "adze"), &user.DefaultInfo{Name: "ClusterAdmin"})
+	allowed, _, err := authorizer.Authorize(ctx, &DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets"})
+	matchBool(false, allowed, "allowed", t)
+	matchError("unable to list bindings", err, "error", t)
+}
+
 func TestHealthAllow(t *testing.T) {
 	test := &authorizeTest{
 		context: kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: "no-one", Groups: []string{"system:unauthenticated"}}),
@@ -718,3 +805,27 @@ func newAdzeBindings() []authorizationapi.PolicyBinding {
 		},
 	}
 }
+
+// addAdzeSecretsDenial adds a role that denies reading secrets to the adze policies and binds it to user
+func addAdzeSecretsDenial(policies []authorizationapi.Policy, bindings []authorizationapi.PolicyBinding, user string) {
+	policies[0].Roles["secretsDenier"] = &authorizationapi.Role{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "secretsDenier",
+			Namespace: "adze",
+		},
+		DenyRules: []authorizationapi.PolicyRule{
+			{Verbs: sets.NewString("get", "list", "watch"), Resources: sets.NewString("secrets")},
+		},
+	}
+	bindings[1].RoleBindings["secretsDeniers"] = &authorizationapi.RoleBinding{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "secretsDeniers",
+			Namespace: "adze",
+		},
+		RoleRef: kapi.ObjectReference{
+			Name:      "secretsDenier",
+			Namespace: "adze",
+		},
+		Subjects: []kapi.ObjectReference{{Kind: authorizationapi.UserKind, Name: user}},
+	}
+}
@@ -0,0 +1,63 @@
+package scope
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+type scopeAuthorizer struct {
+	delegate     authorizer.Authorizer
+	ruleResolver rulevalidation.AuthorizationRuleResolver
+}
+
+// NewAuthorizer returns an authorizer that denies requests from scoped users that their scopes do not allow,
+// and passes all other requests to the delegate. Scopes only ever restrict what the user could otherwise do.
+// The roles of role scopes are resolved with the ruleResolver.
+func NewAuthorizer(delegate authorizer.Authorizer, ruleResolver rulevalidation.AuthorizationRuleResolver) authorizer.Authorizer {
+	return &scopeAuthorizer{delegate: delegate, ruleResolver: ruleResolver}
+}
+
+func (a *scopeAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	user, exists := kapi.UserFrom(ctx)
+	if !exists {
+		return a.delegate.Authorize(ctx, passedAttributes)
+	}
+	scopes := authapi.GetScopes(user)
+	if len(scopes) == 0 {
+		return a.delegate.Authorize(ctx, passedAttributes)
+	}
+
+	namespace := kapi.NamespaceValue(ctx)
+	// Unknown scopes and missing roles only remove rules, so the rules that could be resolved are still used
+	rules, denyRules, rulesErr := ScopesToRules(scopes, namespace, a.ruleResolver)
+	allowed, err := authorizer.RulesAllow(passedAttributes, rules...)
+	if err != nil {
+		return false, "", err
+	}
+	denied, err := authorizer.RulesAllow(passedAttributes, denyRules...)
+	if err != nil {
+		return false, "", err
+	}
+	if denied {
+		return false, fmt.Sprintf("scopes %v deny this action", scopes), nil
+	}
+	if !allowed {
+		if rulesErr != nil {
+			return false, "", rulesErr
+		}
+		return false, fmt.Sprintf("scopes %v prevent this action", scopes), nil
+	}
+
+	return a.delegate.Authorize(ctx, passedAttributes)
+}
+
+// GetAllowedSubjects returns the subjects known to the delegate. Scopes restrict tokens, not subjects.
+func (a *scopeAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return a.delegate.GetAllowedSubjects(ctx, attributes)
+}
@@ -0,0 +1,173 @@
+package scope
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+type allowAllAuthorizer struct {
+	called bool
+}
+
+func (a *allowAllAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	a.called = true
+	return true, "allowed", nil
+}
+
+func (a *allowAllAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return sets.NewString(), sets.NewString(), nil
+}
+
+type testClusterPolicyGetter struct {
+	policy *authorizationapi.ClusterPolicy
+}
+
+func (g *testClusterPolicyGetter) GetClusterPolicy(ctx kapi.Context, name string) (*authorizationapi.ClusterPolicy, error) {
+	return g.policy, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	clusterPolicyGetter := &testClusterPolicyGetter{
+		policy: &authorizationapi.ClusterPolicy{
+			Roles: map[string]*authorizationapi.ClusterRole{
+				"view": {
+					Rules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("get", "list"), Resources: sets.NewString("pods")},
+					},
+				},
+				"edit": {
+					IncludedRoles: []kapi.ObjectReference{{Name: "view"}},
+					Rules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("get", "delete"), Resources: sets.NewString("pods", "secrets")},
+					},
+					DenyRules: []authorizationapi.PolicyRule{
+						{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets"), ResourceNames: sets.NewString("token")},
+					},
+				},
+			},
+		},
+	}
+	ruleResolver := rulevalidation.NewDefaultRuleResolver(nil, nil, clusterPolicyGetter, nil)
+
+	testCases := map[string]struct {
+		user       user.Info
+		namespace  string
+		attributes authorizer.DefaultAuthorizationAttributes
+		allowed    bool
+	}{
+		"unscoped user": {
+			user:       &user.DefaultInfo{Name: "bob"},
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"},
+			allowed:    true,
+		},
+		"user info scope": {
+			user:       scopedUser(UserInfo),
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "users", ResourceName: "~"},
+			allowed:    true,
+		},
+		"user info scope for other users": {
+			user:       scopedUser(UserInfo),
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "users", ResourceName: "alice"},
+		},
+		"discovery": {
+			user:       scopedUser(UserInfo),
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", NonResourceURL: true, URL: "/oapi/v1"},
+			allowed:    true,
+		},
+		"role scope in namespace": {
+			user:       scopedUser("role:view:foo"),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+			allowed:    true,
+		},
+		"role scope verb not in role": {
+			user:       scopedUser("role:view:foo"),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"},
+		},
+		"role scope in other namespace": {
+			user:       scopedUser("role:view:foo"),
+			namespace:  "bar",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+		},
+		"role scope in all namespaces": {
+			user:       scopedUser("role:view:*"),
+			namespace:  "bar",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+			allowed:    true,
+		},
+		"role scope with rules of an included role": {
+			user:       scopedUser("role:edit:foo"),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "list", Resource: "pods"},
+			allowed:    true,
+		},
+		"role scope denied by the role": {
+			user:       scopedUser("role:edit:foo"),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets", ResourceName: "token"},
+		},
+		"role scope denied by another role": {
+			user:       scopedUser("role:edit:foo", UserFull),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets", ResourceName: "token"},
+		},
+		"role scope not denied for other names": {
+			user:       scopedUser("role:edit:foo"),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets", ResourceName: "other"},
+			allowed:    true,
+		},
+		"full scope": {
+			user:       scopedUser(UserFull),
+			namespace:  "foo",
+			attributes: authorizer.DefaultAuthorizationAttributes{Verb: "delete", Resource: "pods"},
+			allowed:    true,
+		},
+	}
+
+	for name, tc := range testCases {
+		delegate := &allowAllAuthorizer{}
+		scopeAuthorizer := NewAuthorizer(delegate, ruleResolver)
+
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), tc.namespace), tc.user)
+		allowed, reason, err := scopeAuthorizer.Authorize(ctx, &tc.attributes)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if allowed != tc.allowed {
+			t.Errorf("%s: expected allowed=%t, got %t: %s", name, tc.allowed, allowed, reason)
+		}
+		if delegate.called != tc.allowed {
+			t.Errorf("%s: expected the delegate to be called only when the scopes allow the request", name)
+		}
+	}
+}
+
+func TestValidateScopes(t *testing.T) {
+	valid := []string{UserInfo, UserAccessCheck, UserListProject, UserFull, "role:view:foo", "role:edit:*", "role:system:image-puller:foo"}
+	if errs := ValidateScopes(valid); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	invalid := []string{"user:unknown", "role:view", "role::foo", "role:view:", "unknown"}
+	for _, scope := range invalid {
+		if errs := ValidateScopes([]string{scope}); len(errs) != 1 {
+			t.Errorf("expected an error for %q, got %v", scope, errs)
+		}
+	}
+}
+
+func scopedUser(scopes ...string) user.Info {
+	return &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "bob"}, Scopes: scopes}
+}
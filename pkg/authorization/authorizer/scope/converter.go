@@ -0,0 +1,148 @@
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationinterfaces "github.com/openshift/origin/pkg/authorization/interfaces"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+const (
+	// UserIndicator is the prefix of scopes describing access to information about the user
+	UserIndicator = "user:"
+	// ClusterRoleIndicator is the prefix of scopes limiting access to what a cluster role allows in a namespace.
+	// The format is role:<cluster role name>:<namespace>, where a namespace of * means all namespaces.
+	ClusterRoleIndicator = "role:"
+)
+
+const (
+	// UserInfo gives read access to the user's own information
+	UserInfo = UserIndicator + "info"
+	// UserAccessCheck gives access to check what the user can do
+	UserAccessCheck = UserIndicator + "check-access"
+	// UserListProject gives access to list the projects the user can see
+	UserListProject = UserIndicator + "list-projects"
+	// UserFull gives everything the user can do
+	UserFull = UserIndicator + "full"
+
+	// allNamespaces is used in role scopes to apply the role in every namespace
+	allNamespaces = "*"
+)
+
+// userScopes maps each user scope to the rules it grants
+var userScopes = map[string][]authorizationapi.PolicyRule{
+	UserInfo: {
+		{Verbs: sets.NewString("get"), Resources: sets.NewString("users"), ResourceNames: sets.NewString("~")},
+	},
+	UserAccessCheck: {
+		{Verbs: sets.NewString("create"), Resources: sets.NewString("subjectaccessreviews", "localsubjectaccessreviews"), AttributeRestrictions: runtime.EmbeddedObject{Object: &authorizationapi.IsPersonalSubjectAccessReview{}}},
+		{Verbs: sets.NewString("create"), Resources: sets.NewString("selfsubjectrulesreviews")},
+	},
+	UserListProject: {
+		{Verbs: sets.NewString("list", "watch"), Resources: sets.NewString("projects")},
+	},
+	UserFull: {
+		{Verbs: sets.NewString(authorizationapi.VerbAll), Resources: sets.NewString(authorizationapi.ResourceAll)},
+		{Verbs: sets.NewString(authorizationapi.VerbAll), NonResourceURLs: sets.NewString(authorizationapi.NonResourceAll)},
+	},
+}
+
+// discoveryRule allows every scoped token to discover the server's API versions
+var discoveryRule = authorizationapi.PolicyRule{
+	Verbs: sets.NewString("get"),
+	NonResourceURLs: sets.NewString(
+		"/healthz", "/healthz/*",
+		"/version",
+		"/api", "/api/*",
+		"/apis", "/apis/*",
+		"/osapi", "/osapi/*",
+		"/oapi", "/oapi/*",
+	),
+}
+
+// ValidateScopes returns an error for each scope that is not understood
+func ValidateScopes(scopes []string) []error {
+	errs := []error{}
+	for _, scope := range scopes {
+		switch {
+		case strings.HasPrefix(scope, UserIndicator):
+			if _, ok := userScopes[scope]; !ok {
+				errs = append(errs, fmt.Errorf("unknown user scope %q", scope))
+			}
+
+		case strings.HasPrefix(scope, ClusterRoleIndicator):
+			if _, _, err := parseClusterRoleScope(scope); err != nil {
+				errs = append(errs, err)
+			}
+
+		default:
+			errs = append(errs, fmt.Errorf("unknown scope %q", scope))
+		}
+	}
+	return errs
+}
+
+// ScopesToRules returns the rules the scopes allow in the given namespace, and the rules they deny.  Cluster roles
+// referenced by role scopes are resolved with the ruleResolver, so the rules of the roles they include are allowed
+// and their deny rules are returned as well.  Like the deny rules of bound roles, a deny rule takes precedence over
+// the rules of every scope.
+func ScopesToRules(scopes []string, namespace string, ruleResolver rulevalidation.AuthorizationRuleResolver) ([]authorizationapi.PolicyRule, []authorizationapi.PolicyRule, error) {
+	rules := []authorizationapi.PolicyRule{discoveryRule}
+	denyRules := []authorizationapi.PolicyRule{}
+
+	errs := []error{}
+	for _, scope := range scopes {
+		switch {
+		case strings.HasPrefix(scope, UserIndicator):
+			userRules, ok := userScopes[scope]
+			if !ok {
+				errs = append(errs, fmt.Errorf("unknown user scope %q", scope))
+				continue
+			}
+			rules = append(rules, userRules...)
+
+		case strings.HasPrefix(scope, ClusterRoleIndicator):
+			roleName, roleNamespace, err := parseClusterRoleScope(scope)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if roleNamespace != allNamespaces && roleNamespace != namespace {
+				continue
+			}
+
+			role, err := ruleResolver.GetRole(authorizationinterfaces.NewClusterRoleBindingAdapter(&authorizationapi.ClusterRoleBinding{
+				RoleRef: kapi.ObjectReference{Name: roleName},
+			}))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cluster role %q referenced by scope %q could not be resolved: %v", roleName, scope, err))
+				continue
+			}
+			rules = append(rules, role.Rules()...)
+			denyRules = append(denyRules, role.DenyRules()...)
+
+		default:
+			errs = append(errs, fmt.Errorf("unknown scope %q", scope))
+		}
+	}
+
+	return rules, denyRules, kerrors.NewAggregate(errs)
+}
+
+// parseClusterRoleScope returns the cluster role name and namespace of a role:<name>:<namespace> scope
+func parseClusterRoleScope(scope string) (string, string, error) {
+	// cluster role names may contain colons, namespaces may not
+	value := strings.TrimPrefix(scope, ClusterRoleIndicator)
+	i := strings.LastIndex(value, ":")
+	if i <= 0 || i == len(value)-1 {
+		return "", "", fmt.Errorf("bad format for scope %q, must be %s<cluster role name>:<namespace or %s>", scope, ClusterRoleIndicator, allNamespaces)
+	}
+	return value[:i], value[i+1:], nil
+}
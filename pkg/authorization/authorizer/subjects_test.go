@@ -45,6 +45,25 @@ func TestSubjects(t *testing.T) {
 	test.test(t)
 }
 
+func TestSubjectsWithDenyRule(t *testing.T) {
+	test := &subjectsTest{
+		context: kapi.WithNamespace(kapi.NewContext(), "adze"),
+		attributes: &DefaultAuthorizationAttributes{
+			Verb:     "get",
+			Resource: "secrets",
+		},
+		expectedUsers:  sets.NewString("Anna", "Ellen", "system:serviceaccount:foo:default"),
+		expectedGroups: sets.NewString("RootUsers", "system:cluster-admins", "system:masters", "system:nodes"),
+	}
+	test.clusterPolicies = newDefaultClusterPolicies()
+	test.policies = newAdzePolicies()
+	test.clusterBindings = newDefaultClusterPolicyBindings()
+	test.bindings = newAdzeBindings()
+	addAdzeSecretsDenial(test.policies, test.bindings, "ClusterAdmin")
+
+	test.test(t)
+}
+
 func (test *subjectsTest) test(t *testing.T) {
 	policyRegistry := testpolicyregistry.NewPolicyRegistry(test.policies, test.policyRetrievalError)
 	policyBindingRegistry := testpolicyregistry.NewPolicyBindingRegistry(test.bindings, test.bindingRetrievalError)
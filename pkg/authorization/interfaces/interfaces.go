@@ -27,6 +27,7 @@ type Role interface {
 	Namespace() string
 
 	Rules() []authorizationapi.PolicyRule
+	DenyRules() []authorizationapi.PolicyRule
 }
 
 type RoleBinding interface {
@@ -121,6 +122,10 @@ func (a RoleAdapter) Rules() []authorizationapi.PolicyRule {
 	return a.role.Rules
 }
 
+func (a RoleAdapter) DenyRules() []authorizationapi.PolicyRule {
+	return a.role.DenyRules
+}
+
 type ClusterPolicyAdapter struct {
 	policy *authorizationapi.ClusterPolicy
 
@@ -162,6 +167,10 @@ func (a ClusterRoleAdapter) Rules() []authorizationapi.PolicyRule {
 	return a.role.Rules
 }
 
+func (a ClusterRoleAdapter) DenyRules() []authorizationapi.PolicyRule {
+	return a.role.DenyRules
+}
+
 type PolicyBindingAdapter struct {
 	policyBinding *authorizationapi.PolicyBinding
 
@@ -17,6 +17,7 @@ import (
 type subjectAccessTest struct {
 	authorizer    *testAuthorizer
 	reviewRequest *authorizationapi.LocalSubjectAccessReview
+	expectedErr   string
 }
 
 type testAuthorizer struct {
@@ -53,7 +54,6 @@ func TestNoNamespace(t *testing.T) {
 	test := &subjectAccessTest{
 		authorizer: &testAuthorizer{
 			allowed: false,
-			err:     "namespace is required on this type: ",
 		},
 		reviewRequest: &authorizationapi.LocalSubjectAccessReview{
 			Action: authorizationapi.AuthorizationAttributes{
@@ -64,6 +64,28 @@ func TestNoNamespace(t *testing.T) {
 			User:   "foo",
 			Groups: sets.NewString(),
 		},
+		expectedErr: "namespace is required on this type: ",
+	}
+
+	test.runTest(t)
+}
+
+func TestNonResourceURL(t *testing.T) {
+	test := &subjectAccessTest{
+		authorizer: &testAuthorizer{
+			allowed: true,
+		},
+		reviewRequest: &authorizationapi.LocalSubjectAccessReview{
+			Action: authorizationapi.AuthorizationAttributes{
+				Namespace:        "unittest",
+				Verb:             "get",
+				IsNonResourceURL: true,
+				Path:             "/metrics",
+			},
+			User:   "foo",
+			Groups: sets.NewString(),
+		},
+		expectedErr: ` "" is invalid: isNonResourceURL: invalid value 'true', Details: non-resource URLs may not be checked in a namespace`,
 	}
 
 	test.runTest(t)
@@ -159,20 +181,22 @@ func (r *subjectAccessTest) runTest(t *testing.T) {
 		Namespace: r.reviewRequest.Action.Namespace,
 		Allowed:   r.authorizer.allowed,
 		Reason:    r.authorizer.reason,
+
+		EvaluationError: r.authorizer.err,
 	}
 
 	expectedAttributes := authorizer.ToDefaultAuthorizationAttributes(r.reviewRequest.Action)
 
 	ctx := kapi.WithNamespace(kapi.NewContext(), r.reviewRequest.Action.Namespace)
 	obj, err := storage.Create(ctx, r.reviewRequest)
-	if err != nil && len(r.authorizer.err) == 0 {
+	if err != nil && len(r.expectedErr) == 0 {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(r.authorizer.err) != 0 {
+	if len(r.expectedErr) != 0 {
 		if err == nil {
 			t.Fatalf("unexpected non-error: %v", err)
 		}
-		if e, a := r.authorizer.err, err.Error(); e != a {
+		if e, a := r.expectedErr, err.Error(); e != a {
 			t.Fatalf("expected %v, got %v", e, a)
 		}
 
@@ -185,9 +209,7 @@ func (r *subjectAccessTest) runTest(t *testing.T) {
 			t.Errorf("diff %v", util.ObjectGoPrintDiff(expectedResponse, obj))
 		}
 	case nil:
-		if len(r.authorizer.err) == 0 {
-			t.Fatal("unexpected nil object")
-		}
+		t.Fatal("unexpected nil object")
 
 	default:
 		t.Errorf("Unexpected obj type: %v", obj)
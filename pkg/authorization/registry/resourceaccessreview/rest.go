@@ -51,13 +51,16 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 
 	requestContext := kapi.WithNamespace(ctx, resourceAccessReview.Action.Namespace)
 	attributes := authorizer.ToDefaultAuthorizationAttributes(resourceAccessReview.Action)
-	users, groups, _ := r.authorizer.GetAllowedSubjects(requestContext, attributes)
+	users, groups, err := r.authorizer.GetAllowedSubjects(requestContext, attributes)
 
 	response := &authorizationapi.ResourceAccessReviewResponse{
 		Namespace: resourceAccessReview.Action.Namespace,
 		Users:     users,
 		Groups:    groups,
 	}
+	if err != nil {
+		response.EvaluationError = err.Error()
+	}
 
 	return response, nil
 }
@@ -13,6 +13,7 @@ import (
 
 	oapi "github.com/openshift/origin/pkg/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationinterfaces "github.com/openshift/origin/pkg/authorization/interfaces"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
 	clusterpolicybindingregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicybinding"
 	policyregistry "github.com/openshift/origin/pkg/authorization/registry/policy"
@@ -245,17 +246,19 @@ func (m *VirtualStorage) getReferencedRole(roleRef kapi.ObjectReference) (*autho
 }
 
 func (m *VirtualStorage) confirmNoEscalation(ctx kapi.Context, roleBinding *authorizationapi.RoleBinding) error {
-	modifyingRole, err := m.getReferencedRole(roleBinding.RoleRef)
-	if err != nil {
-		return err
-	}
-
 	ruleResolver := rulevalidation.NewDefaultRuleResolver(
 		m.PolicyRegistry,
 		m.BindingRegistry,
 		m.ClusterPolicyRegistry,
 		m.ClusterPolicyBindingRegistry,
 	)
+
+	// the resolved role includes the rules of the roles it includes, so those are covered by the check as well
+	modifyingRole, err := ruleResolver.GetRole(authorizationinterfaces.NewLocalRoleBindingAdapter(roleBinding))
+	if err != nil {
+		return err
+	}
+
 	ownerLocalRules, err := ruleResolver.GetEffectivePolicyRules(ctx)
 	if err != nil {
 		return kapierrors.NewInternalError(err)
@@ -270,12 +273,31 @@ func (m *VirtualStorage) confirmNoEscalation(ctx kapi.Context, roleBinding *auth
 	ownerRules = append(ownerRules, ownerLocalRules...)
 	ownerRules = append(ownerRules, ownerGlobalRules...)
 
-	ownerRightsCover, missingRights := rulevalidation.Covers(ownerRules, modifyingRole.Rules)
+	ownerRightsCover, missingRights := rulevalidation.Covers(ownerRules, modifyingRole.Rules())
 	if !ownerRightsCover {
 		user, _ := kapi.UserFrom(ctx)
 		return kapierrors.NewUnauthorized(fmt.Sprintf("attempt to grant extra privileges: %v user=%v ownerrules=%v", missingRights, user, ownerRules))
 	}
 
+	// an owner may not grant what it is denied, even when one of its roles allows it
+	ownerLocalDenyRules, err := ruleResolver.GetEffectiveDenyRules(ctx)
+	if err != nil {
+		return kapierrors.NewInternalError(err)
+	}
+	ownerGlobalDenyRules, err := ruleResolver.GetEffectiveDenyRules(masterContext)
+	if err != nil {
+		return kapierrors.NewInternalError(err)
+	}
+
+	ownerDenyRules := make([]authorizationapi.PolicyRule, 0, len(ownerGlobalDenyRules)+len(ownerLocalDenyRules))
+	ownerDenyRules = append(ownerDenyRules, ownerLocalDenyRules...)
+	ownerDenyRules = append(ownerDenyRules, ownerGlobalDenyRules...)
+
+	if overlaps, deniedRights := rulevalidation.Overlaps(modifyingRole.Rules(), ownerDenyRules); overlaps {
+		user, _ := kapi.UserFrom(ctx)
+		return kapierrors.NewUnauthorized(fmt.Sprintf("attempt to grant denied privileges: %v user=%v ownerdenyrules=%v", deniedRights, user, ownerDenyRules))
+	}
+
 	return nil
 }
 
@@ -135,6 +135,43 @@ func TestCreateValid(t *testing.T) {
 	}
 }
 
+func TestCreateDeniedEscalation(t *testing.T) {
+	clusterPolicies := testNewClusterPolicies()
+	clusterPolicies[0].Roles["secrets-denied"] = &authorizationapi.ClusterRole{
+		ObjectMeta: kapi.ObjectMeta{Name: "secrets-denied"},
+		DenyRules:  []authorizationapi.PolicyRule{{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets")}},
+	}
+	clusterBindings := testNewClusterBindings()
+	clusterBindings[0].RoleBindings["cluster-admins"].Subjects = append(clusterBindings[0].RoleBindings["cluster-admins"].Subjects, kapi.ObjectReference{Kind: authorizationapi.UserKind, Name: "denied-admin"})
+	clusterBindings[0].RoleBindings["secrets-denied"] = &authorizationapi.ClusterRoleBinding{
+		ObjectMeta: kapi.ObjectMeta{Name: "secrets-denied"},
+		RoleRef:    kapi.ObjectReference{Name: "secrets-denied"},
+		Subjects:   []kapi.ObjectReference{{Kind: authorizationapi.UserKind, Name: "denied-admin"}},
+	}
+	storage := NewVirtualStorage(
+		test.NewPolicyRegistry([]authorizationapi.Policy{}, nil),
+		test.NewPolicyBindingRegistry(testNewLocalBindings(), nil),
+		test.NewClusterPolicyRegistry(clusterPolicies, nil),
+		test.NewClusterPolicyBindingRegistry(clusterBindings, nil),
+	)
+
+	roleBinding := &authorizationapi.RoleBinding{
+		ObjectMeta: kapi.ObjectMeta{Name: "my-roleBinding"},
+		RoleRef:    kapi.ObjectReference{Name: "admin"},
+	}
+
+	ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "unittest"), &user.DefaultInfo{Name: "denied-admin"})
+	_, err := storage.Create(ctx, roleBinding)
+	if err == nil || !strings.Contains(err.Error(), "attempt to grant denied privileges") {
+		t.Errorf("expected the denied privileges not to be granted, got %v", err)
+	}
+
+	ctx = kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "unittest"), &user.DefaultInfo{Name: "system:admin"})
+	if _, err := storage.Create(ctx, roleBinding); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "unittest"), &user.DefaultInfo{Name: "system:admin"})
 
@@ -0,0 +1,49 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/registry/rolebindingrestriction"
+)
+
+// REST implements a RESTStorage for role binding restrictions against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+const etcdPrefix = "/rolebindingrestrictions"
+
+// NewREST returns a RESTStorage object that will work against role binding restrictions.
+func NewREST(s storage.Interface) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.RoleBindingRestriction{} },
+		NewListFunc: func() runtime.Object { return &api.RoleBindingRestrictionList{} },
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, etcdPrefix)
+		},
+		KeyFunc: func(ctx kapi.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, etcdPrefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.RoleBindingRestriction).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return rolebindingrestriction.Matcher(label, field)
+		},
+		EndpointName: "rolebindingrestrictions",
+
+		CreateStrategy: rolebindingrestriction.Strategy,
+		UpdateStrategy: rolebindingrestriction.Strategy,
+
+		Storage: s,
+	}
+
+	return &REST{store}
+}
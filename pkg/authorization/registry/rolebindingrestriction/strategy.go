@@ -0,0 +1,75 @@
+package rolebindingrestriction
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/api/validation"
+)
+
+// strategy implements behavior for RoleBindingRestrictions
+type strategy struct {
+	runtime.ObjectTyper
+}
+
+// Strategy is the default logic that applies when creating and updating RoleBindingRestriction
+// objects via the REST API.
+var Strategy = strategy{kapi.Scheme}
+
+// NamespaceScoped is true for role binding restrictions
+func (strategy) NamespaceScoped() bool {
+	return true
+}
+
+func (strategy) GenerateName(base string) string {
+	return base
+}
+
+// AllowCreateOnUpdate is false for role binding restrictions
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (strategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (strategy) PrepareForCreate(obj runtime.Object) {
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (strategy) PrepareForUpdate(obj, old runtime.Object) {
+}
+
+// Canonicalize normalizes the object after validation.
+func (strategy) Canonicalize(obj runtime.Object) {
+}
+
+// Validate validates a new role binding restriction
+func (strategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateRoleBindingRestriction(obj.(*api.RoleBindingRestriction))
+}
+
+// ValidateUpdate is the default update validation for a role binding restriction
+func (strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateRoleBindingRestrictionUpdate(obj.(*api.RoleBindingRestriction), old.(*api.RoleBindingRestriction))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		restriction, ok := obj.(*api.RoleBindingRestriction)
+		if !ok {
+			return false, fmt.Errorf("not a RoleBindingRestriction")
+		}
+		return label.Matches(labels.Set(restriction.Labels)) && field.Matches(api.RoleBindingRestrictionToSelectableFields(restriction)), nil
+	})
+}
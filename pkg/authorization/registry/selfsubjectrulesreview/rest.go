@@ -0,0 +1,133 @@
+package selfsubjectrulesreview
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/runtime"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+// REST implements the RESTStorage interface for SelfSubjectRulesReviews
+type REST struct {
+	ruleResolver rulevalidation.AuthorizationRuleResolver
+}
+
+// NewREST returns a RESTStorage object that lists the rules of the current user in a namespace
+func NewREST(ruleResolver rulevalidation.AuthorizationRuleResolver) *REST {
+	return &REST{ruleResolver: ruleResolver}
+}
+
+func (r *REST) New() runtime.Object {
+	return &authorizationapi.SelfSubjectRulesReview{}
+}
+
+// Create returns the review with the rules and deny rules the current user has in the namespace, both from cluster policy
+// and from the policy of the namespace.  When the review or the request is scoped, only the rules the scopes allow are
+// returned, and the deny rules of the roles of the scopes are added to the deny rules.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	review, ok := obj.(*authorizationapi.SelfSubjectRulesReview)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("not a selfSubjectRulesReview: %#v", obj))
+	}
+	if errs := authorizationvalidation.ValidateSelfSubjectRulesReview(review); len(errs) > 0 {
+		return nil, kapierrors.NewInvalid("SelfSubjectRulesReview", "", errs)
+	}
+	namespace := kapi.NamespaceValue(ctx)
+	if len(namespace) == 0 {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("namespace is required on this type: %v", namespace))
+	}
+	user, exists := kapi.UserFrom(ctx)
+	if !exists {
+		return nil, kapierrors.NewBadRequest("user missing from context")
+	}
+
+	scopes := review.Spec.Scopes
+	if scopes == nil {
+		scopes = authapi.GetScopes(user)
+	}
+
+	// rules are purely additive, so the rules that could be resolved are returned even if some could not be
+	errs := []error{}
+	rules, err := r.ruleResolver.GetEffectivePolicyRules(kapi.WithNamespace(ctx, kapi.NamespaceNone))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	namespaceRules, err := r.ruleResolver.GetEffectivePolicyRules(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	rules = append(rules, namespaceRules...)
+
+	// deny rules take precedence over the rules, so they are returned whatever the scopes are
+	denyRules, err := r.ruleResolver.GetEffectiveDenyRules(kapi.WithNamespace(ctx, kapi.NamespaceNone))
+	if err != nil {
+		errs = append(errs, err)
+	}
+	namespaceDenyRules, err := r.ruleResolver.GetEffectiveDenyRules(ctx)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	denyRules = append(denyRules, namespaceDenyRules...)
+
+	if len(scopes) > 0 {
+		scopeRules, scopeDenyRules, err := scope.ScopesToRules(scopes, namespace, r.ruleResolver)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		rules = intersectRules(rules, scopeRules)
+		denyRules = append(denyRules, scopeDenyRules...)
+	}
+
+	review.Status = authorizationapi.SubjectRulesReviewStatus{Rules: rules, DenyRules: denyRules}
+	if err := kerrors.NewAggregate(errs); err != nil {
+		review.Status.EvaluationError = err.Error()
+	}
+	return review, nil
+}
+
+// intersectRules returns the rules allowed by both lists.  Rules are not split, so a rule is only returned if it is
+// entirely allowed by the other list, which means the result may understate what is allowed.
+func intersectRules(rules, scopeRules []authorizationapi.PolicyRule) []authorizationapi.PolicyRule {
+	allowed := []authorizationapi.PolicyRule{}
+	for _, rule := range rules {
+		if covers(scopeRules, rule) {
+			allowed = append(allowed, rule)
+		}
+	}
+	for _, rule := range scopeRules {
+		if covers(rules, rule) && !covers(allowed, rule) {
+			allowed = append(allowed, rule)
+		}
+	}
+	return allowed
+}
+
+// covers returns true if one of the owner rules allows everything the rule does
+func covers(ownerRules []authorizationapi.PolicyRule, rule authorizationapi.PolicyRule) bool {
+	for _, owner := range ownerRules {
+		if !owner.Verbs.Has(authorizationapi.VerbAll) && !owner.Verbs.HasAll(rule.Verbs.List()...) {
+			continue
+		}
+		if len(rule.NonResourceURLs) > 0 {
+			if owner.NonResourceURLs.Has(authorizationapi.NonResourceAll) || owner.NonResourceURLs.HasAll(rule.NonResourceURLs.List()...) {
+				return true
+			}
+			continue
+		}
+		if len(owner.NonResourceURLs) > 0 {
+			continue
+		}
+		if covered, _ := rulevalidation.Covers([]authorizationapi.PolicyRule{owner}, []authorizationapi.PolicyRule{rule}); covered {
+			return true
+		}
+	}
+	return false
+}
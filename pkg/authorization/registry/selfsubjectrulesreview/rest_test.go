@@ -0,0 +1,159 @@
+package selfsubjectrulesreview
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationinterfaces "github.com/openshift/origin/pkg/authorization/interfaces"
+)
+
+type testRuleResolver struct {
+	rules     map[string][]authorizationapi.PolicyRule
+	denyRules map[string][]authorizationapi.PolicyRule
+	errs      map[string]error
+}
+
+func (r *testRuleResolver) GetRoleBindings(ctx kapi.Context) ([]authorizationinterfaces.RoleBinding, error) {
+	return nil, nil
+}
+
+func (r *testRuleResolver) GetRole(roleBinding authorizationinterfaces.RoleBinding) (authorizationinterfaces.Role, error) {
+	role, ok := testClusterRoles[roleBinding.RoleRef().Name]
+	if !ok {
+		return nil, kapierrors.NewNotFound("role", roleBinding.RoleRef().Name)
+	}
+	return authorizationinterfaces.NewClusterRoleAdapter(role), nil
+}
+
+func (r *testRuleResolver) GetEffectivePolicyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error) {
+	namespace := kapi.NamespaceValue(ctx)
+	return r.rules[namespace], r.errs[namespace]
+}
+
+func (r *testRuleResolver) GetEffectiveDenyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error) {
+	return r.denyRules[kapi.NamespaceValue(ctx)], nil
+}
+
+var testClusterRoles = map[string]*authorizationapi.ClusterRole{
+	"view": {
+		Rules:     []authorizationapi.PolicyRule{rule("get", "pods")},
+		DenyRules: []authorizationapi.PolicyRule{rule("delete", "pods")},
+	},
+}
+
+func rule(verb, resource string) authorizationapi.PolicyRule {
+	return authorizationapi.PolicyRule{Verbs: sets.NewString(verb), Resources: sets.NewString(resource)}
+}
+
+func TestCreate(t *testing.T) {
+	clusterRules := []authorizationapi.PolicyRule{
+		{Verbs: sets.NewString("get"), Resources: sets.NewString("users"), ResourceNames: sets.NewString("~")},
+		rule("list", "projects"),
+	}
+	namespaceRules := []authorizationapi.PolicyRule{rule("get", "pods"), rule("create", "builds")}
+	// deny rules are returned whatever the scopes are, followed by the deny rules of the roles of the scopes
+	namespaceDenyRules := []authorizationapi.PolicyRule{rule("get", "secrets")}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		user          user.Info
+		scopes        []string
+		errs          map[string]error
+		expectedRules []authorizationapi.PolicyRule
+		// expectedScopeDenyRules are the deny rules of the roles of the scopes
+		expectedScopeDenyRules []authorizationapi.PolicyRule
+		expectedError          string
+		expectErr              bool
+	}{
+		{
+			name:          "cluster and namespace rules",
+			namespace:     "project",
+			user:          &user.DefaultInfo{Name: "alice"},
+			expectedRules: append(append([]authorizationapi.PolicyRule{}, clusterRules...), namespaceRules...),
+		},
+		{
+			name:          "evaluation error",
+			namespace:     "project",
+			user:          &user.DefaultInfo{Name: "alice"},
+			errs:          map[string]error{"project": errors.New("role not found")},
+			expectedRules: append(append([]authorizationapi.PolicyRule{}, clusterRules...), namespaceRules...),
+			expectedError: "role not found",
+		},
+		{
+			name:                   "scopes of the request",
+			namespace:              "project",
+			user:                   &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "alice"}, Scopes: []string{"user:info", "role:view:project"}},
+			expectedRules:          []authorizationapi.PolicyRule{clusterRules[0], rule("get", "pods")},
+			expectedScopeDenyRules: []authorizationapi.PolicyRule{rule("delete", "pods")},
+		},
+		{
+			name:          "scopes of the review",
+			namespace:     "project",
+			user:          &user.DefaultInfo{Name: "alice"},
+			scopes:        []string{"user:list-projects"},
+			expectedRules: []authorizationapi.PolicyRule{clusterRules[1]},
+		},
+		{
+			name:          "empty scopes of the review",
+			namespace:     "project",
+			user:          &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "alice"}, Scopes: []string{"user:info"}},
+			scopes:        []string{},
+			expectedRules: append(append([]authorizationapi.PolicyRule{}, clusterRules...), namespaceRules...),
+		},
+		{
+			name:      "invalid scope",
+			namespace: "project",
+			user:      &user.DefaultInfo{Name: "alice"},
+			scopes:    []string{"unknown"},
+			expectErr: true,
+		},
+		{
+			name:      "no namespace",
+			user:      &user.DefaultInfo{Name: "alice"},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		resolver := &testRuleResolver{
+			rules:     map[string][]authorizationapi.PolicyRule{"": clusterRules, "project": namespaceRules},
+			denyRules: map[string][]authorizationapi.PolicyRule{"project": namespaceDenyRules},
+			errs:      test.errs,
+		}
+		storage := NewREST(resolver)
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), test.namespace), test.user)
+
+		obj, err := storage.Create(ctx, &authorizationapi.SelfSubjectRulesReview{Spec: authorizationapi.SelfSubjectRulesReviewSpec{Scopes: test.scopes}})
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		review := obj.(*authorizationapi.SelfSubjectRulesReview)
+		if !reflect.DeepEqual(review.Status.Rules, test.expectedRules) {
+			t.Errorf("%s: expected rules %v, got %v", test.name, test.expectedRules, review.Status.Rules)
+		}
+		expectedDenyRules := append(append([]authorizationapi.PolicyRule{}, namespaceDenyRules...), test.expectedScopeDenyRules...)
+		if !reflect.DeepEqual(review.Status.DenyRules, expectedDenyRules) {
+			t.Errorf("%s: expected deny rules %v, got %v", test.name, expectedDenyRules, review.Status.DenyRules)
+		}
+		if review.Status.EvaluationError != test.expectedError {
+			t.Errorf("%s: expected evaluation error %q, got %q", test.name, test.expectedError, review.Status.EvaluationError)
+		}
+	}
+}
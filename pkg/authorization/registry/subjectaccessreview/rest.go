@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/runtime"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	authorizationvalidation "github.com/openshift/origin/pkg/authorization/api/validation"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
@@ -58,27 +59,31 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 			return nil, kapierrors.NewBadRequest("user missing from context")
 		}
 		userToCheck = ctxUser
+		// scopes on the review replace the scopes of the request
+		if subjectAccessReview.Scopes != nil {
+			userToCheck = withScopes(&user.DefaultInfo{Name: ctxUser.GetName(), UID: ctxUser.GetUID(), Groups: ctxUser.GetGroups()}, subjectAccessReview.Scopes)
+		}
 
 	} else {
-		userToCheck = &user.DefaultInfo{
+		userToCheck = withScopes(&user.DefaultInfo{
 			Name:   subjectAccessReview.User,
 			Groups: subjectAccessReview.Groups.List(),
-		}
+		}, subjectAccessReview.Scopes)
 
 	}
 
 	requestContext := kapi.WithNamespace(kapi.WithUser(ctx, userToCheck), subjectAccessReview.Action.Namespace)
 	attributes := authorizer.ToDefaultAuthorizationAttributes(subjectAccessReview.Action)
 	allowed, reason, err := r.authorizer.Authorize(requestContext, attributes)
-	if err != nil {
-		return nil, err
-	}
 
 	response := &authorizationapi.SubjectAccessReviewResponse{
 		Namespace: subjectAccessReview.Action.Namespace,
 		Allowed:   allowed,
 		Reason:    reason,
 	}
+	if err != nil {
+		response.EvaluationError = err.Error()
+	}
 
 	return response, nil
 }
@@ -103,3 +108,11 @@ func (r *REST) isAllowed(ctx kapi.Context, sar *authorizationapi.SubjectAccessRe
 
 	return nil
 }
+
+// withScopes returns the user restricted to the scopes, or the user itself if there are none
+func withScopes(info *user.DefaultInfo, scopes []string) user.Info {
+	if len(scopes) == 0 {
+		return info
+	}
+	return &authapi.DefaultScopedUserInfo{DefaultInfo: *info, Scopes: scopes}
+}
@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
 )
@@ -16,6 +18,7 @@ import (
 type subjectAccessTest struct {
 	authorizer    *testAuthorizer
 	reviewRequest *authorizationapi.SubjectAccessReview
+	expectedErr   string
 }
 
 type testAuthorizer struct {
@@ -25,6 +28,7 @@ type testAuthorizer struct {
 	deniedNamespaces sets.String
 
 	actualAttributes authorizer.DefaultAuthorizationAttributes
+	actualUser       user.Info
 }
 
 func (a *testAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer.AuthorizationAttributes) (allowed bool, reason string, err error) {
@@ -43,6 +47,7 @@ func (a *testAuthorizer) Authorize(ctx kapi.Context, passedAttributes authorizer
 	}
 
 	a.actualAttributes = attributes
+	a.actualUser, _ = kapi.UserFrom(ctx)
 
 	if len(a.err) == 0 {
 		return a.allowed, a.reason, nil
@@ -57,7 +62,6 @@ func TestDeniedNamespace(t *testing.T) {
 	test := &subjectAccessTest{
 		authorizer: &testAuthorizer{
 			allowed:          false,
-			err:              "denied initial check",
 			deniedNamespaces: sets.NewString("foo"),
 		},
 		reviewRequest: &authorizationapi.SubjectAccessReview{
@@ -69,6 +73,7 @@ func TestDeniedNamespace(t *testing.T) {
 			User:   "foo",
 			Groups: sets.NewString(),
 		},
+		expectedErr: "denied initial check",
 	}
 
 	test.runTest(t)
@@ -129,6 +134,84 @@ func TestErrors(t *testing.T) {
 	test.runTest(t)
 }
 
+func TestNonResourceURL(t *testing.T) {
+	test := &subjectAccessTest{
+		authorizer: &testAuthorizer{
+			allowed: true,
+			reason:  "because metrics are public",
+		},
+		reviewRequest: &authorizationapi.SubjectAccessReview{
+			Action: authorizationapi.AuthorizationAttributes{
+				Verb:             "get",
+				IsNonResourceURL: true,
+				Path:             "/metrics",
+			},
+			User:   "foo",
+			Groups: sets.NewString(),
+		},
+	}
+
+	test.runTest(t)
+}
+
+func TestScopes(t *testing.T) {
+	scopedUser := &authapi.DefaultScopedUserInfo{DefaultInfo: user.DefaultInfo{Name: "alice", Groups: []string{"staff"}}, Scopes: []string{"user:info"}}
+
+	tests := []struct {
+		name           string
+		review         *authorizationapi.SubjectAccessReview
+		expectedUser   string
+		expectedScopes []string
+	}{
+		{
+			name:           "scopes of the request",
+			review:         &authorizationapi.SubjectAccessReview{},
+			expectedUser:   "alice",
+			expectedScopes: []string{"user:info"},
+		},
+		{
+			name:           "scopes of the review",
+			review:         &authorizationapi.SubjectAccessReview{Scopes: []string{"user:check-access"}},
+			expectedUser:   "alice",
+			expectedScopes: []string{"user:check-access"},
+		},
+		{
+			name:         "unscoped",
+			review:       &authorizationapi.SubjectAccessReview{Scopes: []string{}},
+			expectedUser: "alice",
+		},
+		{
+			name:         "another user",
+			review:       &authorizationapi.SubjectAccessReview{User: "bob"},
+			expectedUser: "bob",
+		},
+		{
+			name:           "another user with scopes",
+			review:         &authorizationapi.SubjectAccessReview{User: "bob", Scopes: []string{"role:view:*"}},
+			expectedUser:   "bob",
+			expectedScopes: []string{"role:view:*"},
+		},
+	}
+
+	for _, test := range tests {
+		testAuthorizer := &testAuthorizer{allowed: true}
+		storage := NewREST(testAuthorizer)
+		test.review.Action = authorizationapi.AuthorizationAttributes{Namespace: "project", Verb: "get", Resource: "pods"}
+
+		ctx := kapi.WithUser(kapi.WithNamespace(kapi.NewContext(), "project"), scopedUser)
+		if _, err := storage.Create(ctx, test.review); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if testAuthorizer.actualUser.GetName() != test.expectedUser {
+			t.Errorf("%s: expected user %s, got %s", test.name, test.expectedUser, testAuthorizer.actualUser.GetName())
+		}
+		if scopes := authapi.GetScopes(testAuthorizer.actualUser); !reflect.DeepEqual(scopes, test.expectedScopes) {
+			t.Errorf("%s: expected scopes %v, got %v", test.name, test.expectedScopes, scopes)
+		}
+	}
+}
+
 func (r *subjectAccessTest) runTest(t *testing.T) {
 	storage := REST{r.authorizer}
 
@@ -136,20 +219,22 @@ func (r *subjectAccessTest) runTest(t *testing.T) {
 		Namespace: r.reviewRequest.Action.Namespace,
 		Allowed:   r.authorizer.allowed,
 		Reason:    r.authorizer.reason,
+
+		EvaluationError: r.authorizer.err,
 	}
 
 	expectedAttributes := authorizer.ToDefaultAuthorizationAttributes(r.reviewRequest.Action)
 
 	ctx := kapi.WithNamespace(kapi.NewContext(), kapi.NamespaceAll)
 	obj, err := storage.Create(ctx, r.reviewRequest)
-	if err != nil && len(r.authorizer.err) == 0 {
+	if err != nil && len(r.expectedErr) == 0 {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(r.authorizer.err) != 0 {
+	if len(r.expectedErr) != 0 {
 		if err == nil {
 			t.Fatalf("unexpected non-error: %v", err)
 		}
-		if e, a := r.authorizer.err, err.Error(); e != a {
+		if e, a := r.expectedErr, err.Error(); e != a {
 			t.Fatalf("expected %v, got %v", e, a)
 		}
 
@@ -162,9 +247,7 @@ func (r *subjectAccessTest) runTest(t *testing.T) {
 			t.Errorf("diff %v", util.ObjectGoPrintDiff(expectedResponse, obj))
 		}
 	case nil:
-		if len(r.authorizer.err) == 0 {
-			t.Fatal("unexpected nil object")
-		}
+		t.Fatal("unexpected nil object")
 
 	default:
 		t.Errorf("Unexpected obj type: %v", obj)
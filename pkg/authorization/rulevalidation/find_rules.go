@@ -2,6 +2,7 @@ package rulevalidation
 
 import (
 	"errors"
+	"fmt"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kapierror "k8s.io/kubernetes/pkg/api/errors"
@@ -33,6 +34,10 @@ type AuthorizationRuleResolver interface {
 	// PolicyRules may not be complete, but it contains all retrievable rules.  This is done because policy rules are purely additive and policy determinations
 	// can be made on the basis of those rules that are found.
 	GetEffectivePolicyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error)
+	// GetEffectiveDenyRules returns the list of deny rules that apply to a given user in a given namespace and error.  Missing
+	// roles have no deny rules.  Any other error means that some deny rules could not be retrieved, so the slice may be
+	// incomplete and callers must not allow actions on the basis of it.
+	GetEffectiveDenyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error)
 }
 
 type PolicyGetter interface {
@@ -83,9 +88,21 @@ func (a *DefaultRuleResolver) GetRoleBindings(ctx kapi.Context) ([]authorization
 	return ret, nil
 }
 
+// GetRole returns the role referenced by the binding.  The rules of any roles it includes are added to its own rules.
 func (a *DefaultRuleResolver) GetRole(roleBinding authorizationinterfaces.RoleBinding) (authorizationinterfaces.Role, error) {
-	namespace := roleBinding.RoleRef().Namespace
-	name := roleBinding.RoleRef().Name
+	return a.getRole(roleBinding.RoleRef().Namespace, roleBinding.RoleRef().Name, sets.NewString())
+}
+
+// getRole returns the named role with the rules of its included roles.  resolving holds the roles currently being resolved,
+// so that a role including itself, directly or through other roles, is reported instead of recursing forever.
+func (a *DefaultRuleResolver) getRole(namespace, name string, resolving sets.String) (authorizationinterfaces.Role, error) {
+	key := namespace + "/" + name
+	if resolving.Has(key) {
+		return nil, fmt.Errorf("role %s includes itself", key)
+	}
+	resolving.Insert(key)
+	defer resolving.Delete(key)
+
 	ctx := kapi.WithNamespace(kapi.NewContext(), namespace)
 
 	if len(namespace) == 0 {
@@ -102,8 +119,18 @@ func (a *DefaultRuleResolver) GetRole(roleBinding authorizationinterfaces.RoleBi
 		if !exists {
 			return nil, kapierror.NewNotFound("role", name)
 		}
+		if len(role.IncludedRoles) == 0 {
+			return authorizationinterfaces.NewClusterRoleAdapter(role), nil
+		}
 
-		return authorizationinterfaces.NewClusterRoleAdapter(role), nil
+		rules, denyRules, err := a.addIncludedRules(role.Rules, role.DenyRules, role.IncludedRoles, namespace, resolving)
+		if err != nil {
+			return nil, err
+		}
+		expandedRole := *role
+		expandedRole.Rules = rules
+		expandedRole.DenyRules = denyRules
+		return authorizationinterfaces.NewClusterRoleAdapter(&expandedRole), nil
 	}
 
 	policy, err := a.policyGetter.GetPolicy(ctx, authorizationapi.PolicyName)
@@ -118,15 +145,60 @@ func (a *DefaultRuleResolver) GetRole(roleBinding authorizationinterfaces.RoleBi
 	if !exists {
 		return nil, kapierror.NewNotFound("role", name)
 	}
+	if len(role.IncludedRoles) == 0 {
+		return authorizationinterfaces.NewLocalRoleAdapter(role), nil
+	}
 
-	return authorizationinterfaces.NewLocalRoleAdapter(role), nil
+	rules, denyRules, err := a.addIncludedRules(role.Rules, role.DenyRules, role.IncludedRoles, namespace, resolving)
+	if err != nil {
+		return nil, err
+	}
+	expandedRole := *role
+	expandedRole.Rules = rules
+	expandedRole.DenyRules = denyRules
+	return authorizationinterfaces.NewLocalRoleAdapter(&expandedRole), nil
+}
 
+// addIncludedRules returns rules and denyRules followed by the rules and deny rules of every included role.  An included role with an
+// empty namespace is a cluster role, any other included role is looked up in namespace, the namespace of the including role.
+func (a *DefaultRuleResolver) addIncludedRules(rules, denyRules []authorizationapi.PolicyRule, includedRoles []kapi.ObjectReference, namespace string, resolving sets.String) ([]authorizationapi.PolicyRule, []authorizationapi.PolicyRule, error) {
+	retRules := make([]authorizationapi.PolicyRule, 0, len(rules))
+	retRules = append(retRules, rules...)
+	retDenyRules := append([]authorizationapi.PolicyRule{}, denyRules...)
+
+	for _, includedRole := range includedRoles {
+		includedNamespace := ""
+		if len(includedRole.Namespace) > 0 {
+			includedNamespace = namespace
+		}
+
+		role, err := a.getRole(includedNamespace, includedRole.Name, resolving)
+		if err != nil {
+			return nil, nil, err
+		}
+		retRules = append(retRules, role.Rules()...)
+		retDenyRules = append(retDenyRules, role.DenyRules()...)
+	}
+
+	return retRules, retDenyRules, nil
 }
 
 // GetEffectivePolicyRules returns the list of rules that apply to a given user in a given namespace and error.  If an error is returned, the slice of
 // PolicyRules may not be complete, but it contains all retrievable rules.  This is done because policy rules are purely additive and policy determinations
 // can be made on the basis of those rules that are found.
 func (a *DefaultRuleResolver) GetEffectivePolicyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error) {
+	return a.getEffectiveRules(ctx, authorizationinterfaces.Role.Rules, false)
+}
+
+// GetEffectiveDenyRules returns the list of deny rules that apply to a given user in a given namespace and error.  Roles that do not exist
+// are skipped, since they deny nothing.  Any other error is returned, because deny rules that cannot be retrieved could deny the action.
+func (a *DefaultRuleResolver) GetEffectiveDenyRules(ctx kapi.Context) ([]authorizationapi.PolicyRule, error) {
+	return a.getEffectiveRules(ctx, authorizationinterfaces.Role.DenyRules, true)
+}
+
+// getEffectiveRules returns the rules roleRules selects from every role bound to the user in the namespace of ctx.  Roles that do not
+// exist are reported as errors unless skipMissingRoles is set.
+func (a *DefaultRuleResolver) getEffectiveRules(ctx kapi.Context, roleRules func(authorizationinterfaces.Role) []authorizationapi.PolicyRule, skipMissingRoles bool) ([]authorizationapi.PolicyRule, error) {
 	roleBindings, err := a.GetRoleBindings(ctx)
 	if err != nil {
 		return nil, err
@@ -145,13 +217,13 @@ func (a *DefaultRuleResolver) GetEffectivePolicyRules(ctx kapi.Context) ([]autho
 
 		role, err := a.GetRole(roleBinding)
 		if err != nil {
-			errs = append(errs, err)
+			if !skipMissingRoles || !kapierror.IsNotFound(err) {
+				errs = append(errs, err)
+			}
 			continue
 		}
 
-		for _, curr := range role.Rules() {
-			rules = append(rules, curr)
-		}
+		rules = append(rules, roleRules(role)...)
 	}
 
 	return rules, kerrors.NewAggregate(errs)
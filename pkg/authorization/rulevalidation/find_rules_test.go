@@ -0,0 +1,160 @@
+package rulevalidation
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierror "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	authorizationinterfaces "github.com/openshift/origin/pkg/authorization/interfaces"
+)
+
+type testPolicyGetter struct {
+	policies map[string]*authorizationapi.Policy
+}
+
+func (g *testPolicyGetter) GetPolicy(ctx kapi.Context, id string) (*authorizationapi.Policy, error) {
+	policy, exists := g.policies[kapi.NamespaceValue(ctx)]
+	if !exists {
+		return nil, kapierror.NewNotFound("policy", id)
+	}
+	return policy, nil
+}
+
+type testClusterPolicyGetter struct {
+	policy *authorizationapi.ClusterPolicy
+}
+
+func (g *testClusterPolicyGetter) GetClusterPolicy(ctx kapi.Context, id string) (*authorizationapi.ClusterPolicy, error) {
+	return g.policy, nil
+}
+
+func rule(verb, resource string) authorizationapi.PolicyRule {
+	return authorizationapi.PolicyRule{Verbs: sets.NewString(verb), Resources: sets.NewString(resource)}
+}
+
+func TestGetRoleIncludedRoles(t *testing.T) {
+	clusterPolicy := &authorizationapi.ClusterPolicy{
+		Roles: map[string]*authorizationapi.ClusterRole{
+			"view": {
+				ObjectMeta: kapi.ObjectMeta{Name: "view"},
+				Rules:      []authorizationapi.PolicyRule{rule("get", "pods")},
+			},
+			"build-admin": {
+				ObjectMeta: kapi.ObjectMeta{Name: "build-admin"},
+				Rules:      []authorizationapi.PolicyRule{rule("create", "builds")},
+			},
+			"developer": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "developer"},
+				Rules:         []authorizationapi.PolicyRule{rule("update", "pods")},
+				IncludedRoles: []kapi.ObjectReference{{Name: "view"}, {Name: "build-admin"}},
+			},
+			"nested": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "nested"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "developer"}, {Name: "view"}},
+			},
+			"missing": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "missing"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "does-not-exist"}},
+			},
+			"secret-denier": {
+				ObjectMeta: kapi.ObjectMeta{Name: "secret-denier"},
+				DenyRules:  []authorizationapi.PolicyRule{rule("get", "secrets")},
+			},
+			"restricted-developer": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "restricted-developer"},
+				DenyRules:     []authorizationapi.PolicyRule{rule("delete", "builds")},
+				IncludedRoles: []kapi.ObjectReference{{Name: "developer"}, {Name: "secret-denier"}},
+			},
+			"cycle-a": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "cycle-a"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "cycle-b"}},
+			},
+			"cycle-b": {
+				ObjectMeta:    kapi.ObjectMeta{Name: "cycle-b"},
+				IncludedRoles: []kapi.ObjectReference{{Name: "cycle-a"}},
+			},
+		},
+	}
+	policies := map[string]*authorizationapi.Policy{
+		"ns1": {
+			Roles: map[string]*authorizationapi.Role{
+				"quota-view": {
+					ObjectMeta: kapi.ObjectMeta{Name: "quota-view", Namespace: "ns1"},
+					Rules:      []authorizationapi.PolicyRule{rule("get", "resourcequotas")},
+				},
+				"local-developer": {
+					ObjectMeta:    kapi.ObjectMeta{Name: "local-developer", Namespace: "ns1"},
+					IncludedRoles: []kapi.ObjectReference{{Name: "developer"}, {Name: "quota-view", Namespace: "ns1"}},
+				},
+			},
+		},
+	}
+	resolver := NewDefaultRuleResolver(&testPolicyGetter{policies}, nil, &testClusterPolicyGetter{clusterPolicy}, nil)
+
+	testCases := map[string]struct {
+		roleRef           kapi.ObjectReference
+		expectedRules     []authorizationapi.PolicyRule
+		expectedDenyRules []authorizationapi.PolicyRule
+		expectedErr       bool
+	}{
+		"no included roles": {
+			roleRef:       kapi.ObjectReference{Name: "view"},
+			expectedRules: []authorizationapi.PolicyRule{rule("get", "pods")},
+		},
+		"included roles": {
+			roleRef:       kapi.ObjectReference{Name: "developer"},
+			expectedRules: []authorizationapi.PolicyRule{rule("update", "pods"), rule("get", "pods"), rule("create", "builds")},
+		},
+		"nested included roles": {
+			roleRef:       kapi.ObjectReference{Name: "nested"},
+			expectedRules: []authorizationapi.PolicyRule{rule("update", "pods"), rule("get", "pods"), rule("create", "builds"), rule("get", "pods")},
+		},
+		"local role including cluster and local roles": {
+			roleRef:       kapi.ObjectReference{Name: "local-developer", Namespace: "ns1"},
+			expectedRules: []authorizationapi.PolicyRule{rule("update", "pods"), rule("get", "pods"), rule("create", "builds"), rule("get", "resourcequotas")},
+		},
+		"included deny rules": {
+			roleRef:           kapi.ObjectReference{Name: "restricted-developer"},
+			expectedRules:     []authorizationapi.PolicyRule{rule("update", "pods"), rule("get", "pods"), rule("create", "builds")},
+			expectedDenyRules: []authorizationapi.PolicyRule{rule("delete", "builds"), rule("get", "secrets")},
+		},
+		"missing included role": {
+			roleRef:     kapi.ObjectReference{Name: "missing"},
+			expectedErr: true,
+		},
+		"cycle": {
+			roleRef:     kapi.ObjectReference{Name: "cycle-a"},
+			expectedErr: true,
+		},
+	}
+
+	for k, testCase := range testCases {
+		binding := authorizationinterfaces.NewLocalRoleBindingAdapter(&authorizationapi.RoleBinding{RoleRef: testCase.roleRef})
+		role, err := resolver.GetRole(binding)
+		if testCase.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", k)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(testCase.expectedRules, role.Rules()) {
+			t.Errorf("%s: expected %v, got %v", k, testCase.expectedRules, role.Rules())
+		}
+		if (len(testCase.expectedDenyRules) > 0 || len(role.DenyRules()) > 0) && !reflect.DeepEqual(testCase.expectedDenyRules, role.DenyRules()) {
+			t.Errorf("%s: expected deny rules %v, got %v", k, testCase.expectedDenyRules, role.DenyRules())
+		}
+	}
+
+	// resolving included roles must not modify the stored roles
+	if rules := clusterPolicy.Roles["developer"].Rules; len(rules) != 1 {
+		t.Errorf("expected the stored role to be unchanged, got %v", rules)
+	}
+}
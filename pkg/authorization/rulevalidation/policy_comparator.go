@@ -100,3 +100,49 @@ func ruleCovers(ownerRule, subrule authorizationapi.PolicyRule) bool {
 
 	return verbMatches && resourceMatches && resourceNameMatches && groupMatches
 }
+
+// Overlaps determines whether any of the rules allows an action that one of the deniedRules matches.
+// It returns whether or not the rules overlap the deniedRules and a list of the overlapping parts of the rules.
+func Overlaps(rules, deniedRules []authorizationapi.PolicyRule) (bool, []authorizationapi.PolicyRule) {
+	subrules := []authorizationapi.PolicyRule{}
+	for _, rule := range rules {
+		subrules = append(subrules, breakdownRule(rule)...)
+	}
+
+	overlappingRules := []authorizationapi.PolicyRule{}
+	for _, subrule := range subrules {
+		for _, deniedRule := range deniedRules {
+			if ruleOverlaps(deniedRule, subrule) {
+				overlappingRules = append(overlappingRules, subrule)
+				break
+			}
+		}
+	}
+
+	return (len(overlappingRules) != 0), overlappingRules
+}
+
+// ruleOverlaps determines whether the deniedRule matches any action the subrule (which may only contain at most one
+// verb, resource, and resourceName) allows.  Unlike ruleCovers, a wildcard or a missing resource name in the subrule
+// overlaps every value of the deniedRule.
+func ruleOverlaps(deniedRule, subrule authorizationapi.PolicyRule) bool {
+	groupOverlaps := setsOverlap(apiGroups(deniedRule), apiGroups(subrule), authorizationapi.APIGroupAll)
+	verbOverlaps := setsOverlap(deniedRule.Verbs, subrule.Verbs, authorizationapi.VerbAll)
+	resourceOverlaps := setsOverlap(authorizationapi.NormalizeResources(deniedRule.Resources), authorizationapi.NormalizeResources(subrule.Resources), authorizationapi.ResourceAll)
+	resourceNameOverlaps := len(deniedRule.ResourceNames) == 0 || len(subrule.ResourceNames) == 0 || deniedRule.ResourceNames.HasAny(subrule.ResourceNames.List()...)
+
+	return groupOverlaps && verbOverlaps && resourceOverlaps && resourceNameOverlaps
+}
+
+// apiGroups returns the API groups of the rule, where no groups means the default group.
+func apiGroups(rule authorizationapi.PolicyRule) sets.String {
+	if len(rule.APIGroups) == 0 {
+		return sets.NewString("")
+	}
+	return sets.NewString(rule.APIGroups...)
+}
+
+// setsOverlap determines whether the sets share a value, or either of them holds the wildcard.
+func setsOverlap(a, b sets.String, wildcard string) bool {
+	return a.Has(wildcard) || b.Has(wildcard) || a.HasAny(b.List()...)
+}
@@ -122,7 +122,7 @@ func TestResourceGroupCoveringEnumerated(t *testing.T) {
 func TestEnumeratedCoveringResourceGroup(t *testing.T) {
 	escalationTest{
 		ownerRules: []authorizationapi.PolicyRule{
-			{Verbs: sets.NewString("delete", "update"), Resources: sets.NewString("builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "builds/log", "builds/clone", "buildconfigs/webhooks")},
+			{Verbs: sets.NewString("delete", "update"), Resources: sets.NewString("builds", "buildconfigs", "buildlogs", "buildconfigs/instantiate", "buildconfigs/instantiatebinary", "buildconfigs/history", "builds/log", "builds/clone", "buildconfigs/webhooks")},
 		},
 		servantRules: []authorizationapi.PolicyRule{
 			{Verbs: sets.NewString("delete", "update"), Resources: sets.NewString("resourcegroup:builds")},
@@ -150,6 +150,8 @@ func TestEnumeratedMissingPartOfResourceGroup(t *testing.T) {
 			{Verbs: sets.NewString("update"), Resources: sets.NewString("buildconfigs/instantiate")},
 			{Verbs: sets.NewString("delete"), Resources: sets.NewString("buildconfigs/instantiatebinary")},
 			{Verbs: sets.NewString("update"), Resources: sets.NewString("buildconfigs/instantiatebinary")},
+			{Verbs: sets.NewString("delete"), Resources: sets.NewString("buildconfigs/history")},
+			{Verbs: sets.NewString("update"), Resources: sets.NewString("buildconfigs/history")},
 			{Verbs: sets.NewString("delete"), Resources: sets.NewString("builds/log")},
 			{Verbs: sets.NewString("update"), Resources: sets.NewString("builds/log")},
 			{Verbs: sets.NewString("delete"), Resources: sets.NewString("builds/clone")},
@@ -354,3 +356,59 @@ func rulesMatch(expectedRules, actualRules []authorizationapi.PolicyRule) bool {
 
 	return true
 }
+
+func TestOverlaps(t *testing.T) {
+	deniedRules := []authorizationapi.PolicyRule{
+		{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets"), ResourceNames: sets.NewString("token")},
+		{APIGroups: []string{"extensions"}, Verbs: sets.NewString("delete"), Resources: sets.NewString("jobs")},
+	}
+	tests := map[string]struct {
+		rules               []authorizationapi.PolicyRule
+		expectedOverlapping []authorizationapi.PolicyRule
+	}{
+		"unrelated": {
+			rules: []authorizationapi.PolicyRule{
+				{Verbs: sets.NewString("get"), Resources: sets.NewString("pods", "builds")},
+			},
+			expectedOverlapping: []authorizationapi.PolicyRule{},
+		},
+		"other resource name": {
+			rules: []authorizationapi.PolicyRule{
+				{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets"), ResourceNames: sets.NewString("other")},
+			},
+			expectedOverlapping: []authorizationapi.PolicyRule{},
+		},
+		"every resource name": {
+			rules: []authorizationapi.PolicyRule{
+				{Verbs: sets.NewString("get", "list"), Resources: sets.NewString("secrets")},
+			},
+			expectedOverlapping: []authorizationapi.PolicyRule{
+				{APIGroups: nil, Verbs: sets.NewString("get"), Resources: sets.NewString("secrets")},
+			},
+		},
+		"wildcard verb": {
+			rules: []authorizationapi.PolicyRule{
+				{APIGroups: []string{"extensions"}, Verbs: sets.NewString("*"), Resources: sets.NewString("jobs")},
+			},
+			expectedOverlapping: []authorizationapi.PolicyRule{
+				{APIGroups: []string{"extensions"}, Verbs: sets.NewString("*"), Resources: sets.NewString("jobs")},
+			},
+		},
+		"other group": {
+			rules: []authorizationapi.PolicyRule{
+				{Verbs: sets.NewString("delete"), Resources: sets.NewString("jobs")},
+			},
+			expectedOverlapping: []authorizationapi.PolicyRule{},
+		},
+	}
+
+	for name, test := range tests {
+		overlaps, overlapping := Overlaps(test.rules, deniedRules)
+		if overlaps != (len(test.expectedOverlapping) != 0) {
+			t.Errorf("%s: expected overlap %v, got %v", name, len(test.expectedOverlapping) != 0, overlaps)
+		}
+		if !rulesMatch(test.expectedOverlapping, overlapping) {
+			t.Errorf("%s: expected %v, got %v", name, test.expectedOverlapping, overlapping)
+		}
+	}
+}
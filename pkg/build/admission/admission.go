@@ -3,17 +3,22 @@ package admission
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
 func init() {
@@ -106,7 +111,10 @@ func (a *buildByStrategy) checkBuildAuthorization(build *buildapi.Build, attr ad
 		User:   attr.GetUserInfo().GetName(),
 		Groups: sets.NewString(attr.GetUserInfo().GetGroups()...),
 	}
-	return a.checkAccess(strategy, subjectAccessReview, attr)
+	if err := a.checkAccess(strategy, subjectAccessReview, attr); err != nil {
+		return err
+	}
+	return a.checkImageAccess(build.Spec, attr)
 }
 
 func (a *buildByStrategy) checkBuildConfigAuthorization(buildConfig *buildapi.BuildConfig, attr admission.Attributes) error {
@@ -121,7 +129,10 @@ func (a *buildByStrategy) checkBuildConfigAuthorization(buildConfig *buildapi.Bu
 		User:   attr.GetUserInfo().GetName(),
 		Groups: sets.NewString(attr.GetUserInfo().GetGroups()...),
 	}
-	return a.checkAccess(strategy, subjectAccessReview, attr)
+	if err := a.checkAccess(strategy, subjectAccessReview, attr); err != nil {
+		return err
+	}
+	return a.checkImageAccess(buildConfig.Spec.BuildSpec, attr)
 }
 
 func (a *buildByStrategy) checkBuildRequestAuthorization(req *buildapi.BuildRequest, attr admission.Attributes) error {
@@ -154,6 +165,51 @@ func (a *buildByStrategy) checkAccess(strategy buildapi.BuildStrategy, subjectAc
 	return nil
 }
 
+// checkImageAccess verifies on creation that the service account of a build may pull the image
+// stream its strategy is built from, if the image stream is in another namespace. Otherwise the
+// build would only fail once its pod cannot pull the image.
+func (a *buildByStrategy) checkImageAccess(spec buildapi.BuildSpec, attr admission.Attributes) error {
+	if attr.GetOperation() != admission.Create {
+		return nil
+	}
+	from := buildutil.GetImageStreamForStrategy(spec.Strategy)
+	if from == nil || len(from.Namespace) == 0 || from.Namespace == attr.GetNamespace() {
+		return nil
+	}
+	var streamName string
+	switch from.Kind {
+	case "ImageStreamTag":
+		streamName, _, _ = imageapi.SplitImageStreamTag(from.Name)
+	case "ImageStreamImage":
+		streamName = strings.SplitN(from.Name, "@", 2)[0]
+	default:
+		return nil
+	}
+
+	serviceAccount := spec.ServiceAccount
+	if len(serviceAccount) == 0 {
+		serviceAccount = bootstrappolicy.BuilderServiceAccountName
+	}
+	subjectAccessReview := &authorizationapi.LocalSubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:         "get",
+			Resource:     "imagestreams/layers",
+			ResourceName: streamName,
+		},
+		User: serviceaccount.MakeUsername(attr.GetNamespace(), serviceAccount),
+		// service accounts are authenticated users, which may pull the shared images of the openshift namespace
+		Groups: sets.NewString(append(serviceaccount.MakeGroupNames(attr.GetNamespace(), serviceAccount), bootstrappolicy.AuthenticatedGroup)...),
+	}
+	resp, err := a.client.LocalSubjectAccessReviews(from.Namespace).Create(subjectAccessReview)
+	if err != nil {
+		return err
+	}
+	if !resp.Allowed {
+		return admission.NewForbidden(attr, fmt.Errorf("service account %s cannot pull images from the image stream %s/%s", serviceAccount, from.Namespace, streamName))
+	}
+	return nil
+}
+
 func notAllowed(strategy buildapi.BuildStrategy, attr admission.Attributes) error {
 	return admission.NewForbidden(attr, fmt.Errorf("build strategy %s is not allowed", buildapi.StrategyType(strategy)))
 }
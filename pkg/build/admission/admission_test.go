@@ -11,6 +11,7 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -163,6 +164,114 @@ func TestBuildAdmission(t *testing.T) {
 	}
 }
 
+func TestBuildAdmissionImageAccess(t *testing.T) {
+	tests := []struct {
+		name           string
+		from           kapi.ObjectReference
+		serviceAccount string
+		op             admission.Operation
+		allowed        bool
+		// allowedGroup is a group the reviewed image stream is shared with
+		allowedGroup   string
+		expectedReview *authorizationapi.LocalSubjectAccessReview
+		expectAccept   bool
+	}{
+		{
+			name:         "image stream tag in the same namespace",
+			from:         kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:latest"},
+			op:           admission.Create,
+			expectAccept: true,
+		},
+		{
+			name:         "docker image",
+			from:         kapi.ObjectReference{Kind: "DockerImage", Namespace: "other", Name: "ruby"},
+			op:           admission.Create,
+			expectAccept: true,
+		},
+		{
+			name:    "allowed image stream tag in another namespace",
+			from:    kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "shared", Name: "ruby:2.0"},
+			op:      admission.Create,
+			allowed: true,
+			expectedReview: &authorizationapi.LocalSubjectAccessReview{
+				Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "imagestreams/layers", ResourceName: "ruby"},
+				User:   "system:serviceaccount:default:builder",
+				Groups: sets.NewString("system:serviceaccounts", "system:serviceaccounts:default", "system:authenticated"),
+			},
+			expectAccept: true,
+		},
+		{
+			name:         "image stream tag shared with authenticated users in the openshift namespace",
+			from:         kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.2"},
+			op:           admission.Create,
+			allowedGroup: "system:authenticated",
+			expectedReview: &authorizationapi.LocalSubjectAccessReview{
+				Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "imagestreams/layers", ResourceName: "ruby"},
+				User:   "system:serviceaccount:default:builder",
+				Groups: sets.NewString("system:serviceaccounts", "system:serviceaccounts:default", "system:authenticated"),
+			},
+			expectAccept: true,
+		},
+		{
+			name:           "forbidden image stream image in another namespace",
+			from:           kapi.ObjectReference{Kind: "ImageStreamImage", Namespace: "shared", Name: "ruby@sha256:abc"},
+			serviceAccount: "deployer",
+			op:             admission.Create,
+			expectedReview: &authorizationapi.LocalSubjectAccessReview{
+				Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "imagestreams/layers", ResourceName: "ruby"},
+				User:   "system:serviceaccount:default:deployer",
+				Groups: sets.NewString("system:serviceaccounts", "system:serviceaccounts:default", "system:authenticated"),
+			},
+			expectAccept: false,
+		},
+		{
+			name:         "update of a build from another namespace",
+			from:         kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "shared", Name: "ruby:2.0"},
+			op:           admission.Update,
+			expectAccept: true,
+		},
+	}
+
+	for _, test := range tests {
+		var review *authorizationapi.LocalSubjectAccessReview
+		fake := &testclient.Fake{}
+		fake.AddReactor("create", "localsubjectaccessreviews", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			sar := action.(ktestclient.CreateAction).GetObject().(*authorizationapi.LocalSubjectAccessReview)
+			if sar.Action.Resource == authorizationapi.SourceBuildResource {
+				return true, reviewResponse(true, ""), nil
+			}
+			if action.GetNamespace() != test.from.Namespace {
+				t.Errorf("%s: unexpected review namespace %s", test.name, action.GetNamespace())
+			}
+			review = sar
+			return true, reviewResponse(test.allowed || sar.Groups.Has(test.allowedGroup), ""), nil
+		})
+		c := NewBuildByStrategy()
+		c.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(fake)
+
+		build := testBuild(buildapi.BuildStrategy{SourceStrategy: &buildapi.SourceBuildStrategy{From: test.from}})
+		build.Spec.ServiceAccount = test.serviceAccount
+		attrs := admission.NewAttributesRecord(build, buildapi.Kind("Build"), "default", "name", buildsResource, "", test.op, fakeUser())
+		err := c.Admit(attrs)
+		switch {
+		case test.expectAccept && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case !test.expectAccept && !apierrors.IsForbidden(err):
+			t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+		}
+		if test.expectedReview == nil {
+			if review != nil {
+				t.Errorf("%s: unexpected image access review %#v", test.name, review)
+			}
+			continue
+		}
+		if review == nil || review.Action.Verb != test.expectedReview.Action.Verb || review.Action.Resource != test.expectedReview.Action.Resource ||
+			review.Action.ResourceName != test.expectedReview.Action.ResourceName || review.User != test.expectedReview.User || !review.Groups.Equal(test.expectedReview.Groups) {
+			t.Errorf("%s: expected review %#v, got %#v", test.name, test.expectedReview, review)
+		}
+	}
+}
+
 type fakeObject struct{}
 
 func (*fakeObject) IsAnAPIObject() {}
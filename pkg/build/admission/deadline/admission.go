@@ -0,0 +1,112 @@
+package deadline
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	configlatest "github.com/openshift/origin/pkg/cmd/server/api/latest"
+)
+
+// PluginName is the name of the build completion deadline plug-in.
+const PluginName = "BuildCompletionDeadline"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(client kclient.Interface, config io.Reader) (admission.Interface, error) {
+		pluginConfig, err := readConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return NewBuildCompletionDeadline(pluginConfig), nil
+	})
+}
+
+func readConfig(reader io.Reader) (*BuildCompletionDeadlineConfig, error) {
+	if reader == nil || reflect.ValueOf(reader).IsNil() {
+		return &BuildCompletionDeadlineConfig{}, nil
+	}
+
+	configBytes, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	config := &BuildCompletionDeadlineConfig{}
+	err = configlatest.ReadYAML(configBytes, config)
+	if err != nil {
+		return nil, err
+	}
+	errs := ValidateBuildCompletionDeadlineConfig(config)
+	if len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return config, nil
+}
+
+type buildCompletionDeadline struct {
+	*admission.Handler
+	config *BuildCompletionDeadlineConfig
+}
+
+// NewBuildCompletionDeadline returns an admission control that defaults the completion deadline
+// of new builds and build configs, and rejects those that request a longer deadline than allowed.
+func NewBuildCompletionDeadline(config *BuildCompletionDeadlineConfig) admission.Interface {
+	return &buildCompletionDeadline{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		config:  config,
+	}
+}
+
+var (
+	buildsResource       = buildapi.Resource("builds")
+	buildConfigsResource = buildapi.Resource("buildconfigs")
+)
+
+// Admit sets the default completion deadline of builds and build configs without one, and
+// verifies that the deadline does not exceed the maximum. Builds are only admitted on creation,
+// since their spec cannot change afterwards. The builds instantiated from a build config inherit
+// its deadline, and are admitted by the build generator, so build configs created before the
+// deadline was configured also get the default and the cap.
+func (a *buildCompletionDeadline) Admit(attr admission.Attributes) error {
+	if len(attr.GetSubresource()) != 0 {
+		return nil
+	}
+	var spec *buildapi.BuildSpec
+	switch attr.GetResource() {
+	case buildsResource:
+		build, ok := attr.GetObject().(*buildapi.Build)
+		if !ok || attr.GetOperation() != admission.Create {
+			return nil
+		}
+		spec = &build.Spec
+	case buildConfigsResource:
+		config, ok := attr.GetObject().(*buildapi.BuildConfig)
+		if !ok {
+			return nil
+		}
+		spec = &config.Spec.BuildSpec
+	default:
+		return nil
+	}
+
+	if spec.CompletionDeadlineSeconds == nil {
+		deadline := a.config.DefaultCompletionDeadlineSeconds
+		if deadline == nil {
+			deadline = a.config.MaxCompletionDeadlineSeconds
+		}
+		if deadline != nil {
+			seconds := *deadline
+			spec.CompletionDeadlineSeconds = &seconds
+		}
+	}
+
+	max := a.config.MaxCompletionDeadlineSeconds
+	if max != nil && *spec.CompletionDeadlineSeconds > *max {
+		return admission.NewForbidden(attr, fmt.Errorf("completionDeadlineSeconds %d exceeds the maximum of %d", *spec.CompletionDeadlineSeconds, *max))
+	}
+	return nil
+}
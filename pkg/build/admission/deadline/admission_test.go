@@ -0,0 +1,156 @@
+package deadline
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+func seconds(s int64) *int64 {
+	return &s
+}
+
+func testBuild(deadline *int64) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Name: "build"},
+		Spec:       buildapi.BuildSpec{CompletionDeadlineSeconds: deadline},
+	}
+}
+
+func testBuildConfig(deadline *int64) *buildapi.BuildConfig {
+	return &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config"},
+		Spec:       buildapi.BuildConfigSpec{BuildSpec: buildapi.BuildSpec{CompletionDeadlineSeconds: deadline}},
+	}
+}
+
+func deadlineOf(obj runtime.Object) *int64 {
+	switch t := obj.(type) {
+	case *buildapi.Build:
+		return t.Spec.CompletionDeadlineSeconds
+	case *buildapi.BuildConfig:
+		return t.Spec.CompletionDeadlineSeconds
+	}
+	return nil
+}
+
+func TestBuildCompletionDeadline(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           *BuildCompletionDeadlineConfig
+		object           runtime.Object
+		resource         string
+		op               admission.Operation
+		expectedDeadline *int64
+		expectForbidden  bool
+	}{
+		{
+			name:     "no configuration",
+			config:   &BuildCompletionDeadlineConfig{},
+			object:   testBuild(nil),
+			resource: "builds",
+			op:       admission.Create,
+		},
+		{
+			name:             "default deadline",
+			config:           &BuildCompletionDeadlineConfig{DefaultCompletionDeadlineSeconds: seconds(600), MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:           testBuild(nil),
+			resource:         "builds",
+			op:               admission.Create,
+			expectedDeadline: seconds(600),
+		},
+		{
+			name:             "maximum as default",
+			config:           &BuildCompletionDeadlineConfig{MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:           testBuildConfig(nil),
+			resource:         "buildconfigs",
+			op:               admission.Create,
+			expectedDeadline: seconds(3600),
+		},
+		{
+			name:             "requested deadline",
+			config:           &BuildCompletionDeadlineConfig{DefaultCompletionDeadlineSeconds: seconds(600), MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:           testBuild(seconds(1800)),
+			resource:         "builds",
+			op:               admission.Create,
+			expectedDeadline: seconds(1800),
+		},
+		{
+			name:            "build above the maximum",
+			config:          &BuildCompletionDeadlineConfig{MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:          testBuild(seconds(7200)),
+			resource:        "builds",
+			op:              admission.Create,
+			expectForbidden: true,
+		},
+		{
+			name:            "build config updated above the maximum",
+			config:          &BuildCompletionDeadlineConfig{MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:          testBuildConfig(seconds(7200)),
+			resource:        "buildconfigs",
+			op:              admission.Update,
+			expectForbidden: true,
+		},
+		{
+			name:             "build updated above the maximum",
+			config:           &BuildCompletionDeadlineConfig{MaxCompletionDeadlineSeconds: seconds(3600)},
+			object:           testBuild(seconds(7200)),
+			resource:         "builds",
+			op:               admission.Update,
+			expectedDeadline: seconds(7200),
+		},
+	}
+
+	for _, test := range tests {
+		plugin := NewBuildCompletionDeadline(test.config)
+		attrs := admission.NewAttributesRecord(test.object, buildapi.Kind("Build"), "default", "name", buildapi.Resource(test.resource), "", test.op, &user.DefaultInfo{Name: "user"})
+		err := plugin.Admit(attrs)
+		if test.expectForbidden {
+			if !apierrors.IsForbidden(err) {
+				t.Errorf("%s: expected a forbidden error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		deadline := deadlineOf(test.object)
+		switch {
+		case test.expectedDeadline == nil && deadline != nil:
+			t.Errorf("%s: expected no deadline, got %d", test.name, *deadline)
+		case test.expectedDeadline != nil && (deadline == nil || *deadline != *test.expectedDeadline):
+			t.Errorf("%s: expected deadline %d, got %v", test.name, *test.expectedDeadline, deadline)
+		}
+	}
+}
+
+func TestReadConfig(t *testing.T) {
+	config, err := readConfig(bytes.NewBufferString(`apiVersion: v1
+kind: BuildCompletionDeadlineConfig
+defaultCompletionDeadlineSeconds: 600
+maxCompletionDeadlineSeconds: 3600
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *config.DefaultCompletionDeadlineSeconds != 600 || *config.MaxCompletionDeadlineSeconds != 3600 {
+		t.Errorf("unexpected config: %#v", config)
+	}
+
+	_, err = readConfig(bytes.NewBufferString(`apiVersion: v1
+kind: BuildCompletionDeadlineConfig
+defaultCompletionDeadlineSeconds: 7200
+maxCompletionDeadlineSeconds: 3600
+`))
+	if err == nil {
+		t.Errorf("expected a default above the maximum to be rejected")
+	}
+}
@@ -0,0 +1,5 @@
+package latest
+
+import (
+	_ "github.com/openshift/origin/pkg/build/admission/deadline/v1"
+)
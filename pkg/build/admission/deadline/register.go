@@ -0,0 +1,19 @@
+package deadline
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	_ "github.com/openshift/origin/pkg/build/admission/deadline/latest"
+	"github.com/openshift/origin/pkg/cmd/server/api"
+)
+
+// SchemeGroupVersion is group version used to register these objects
+var SchemeGroupVersion = unversioned.GroupVersion{Group: "", Version: ""}
+
+func init() {
+	api.Scheme.AddKnownTypes(SchemeGroupVersion,
+		&BuildCompletionDeadlineConfig{},
+	)
+}
+
+func (*BuildCompletionDeadlineConfig) IsAnAPIObject() {}
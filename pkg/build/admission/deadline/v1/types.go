@@ -0,0 +1,20 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// BuildCompletionDeadlineConfig is the configuration for the build completion deadline plug-in.
+// It defaults and caps the completion deadline of the builds and build configs that are created,
+// so that builds cannot occupy nodes for longer than the cluster allows.
+type BuildCompletionDeadlineConfig struct {
+	unversioned.TypeMeta
+
+	// DefaultCompletionDeadlineSeconds is the completion deadline of builds and build configs that
+	// are created without one. If nil, MaxCompletionDeadlineSeconds is used.
+	DefaultCompletionDeadlineSeconds *int64 `json:"defaultCompletionDeadlineSeconds,omitempty" description:"completion deadline of builds created without one, defaults to the maximum"`
+
+	// MaxCompletionDeadlineSeconds is the longest completion deadline a build or build config may
+	// request. If nil, there is no limit.
+	MaxCompletionDeadlineSeconds *int64 `json:"maxCompletionDeadlineSeconds,omitempty" description:"longest completion deadline of builds, unlimited if nil"`
+}
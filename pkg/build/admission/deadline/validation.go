@@ -0,0 +1,20 @@
+package deadline
+
+import (
+	"k8s.io/kubernetes/pkg/util/validation/field"
+)
+
+func ValidateBuildCompletionDeadlineConfig(config *BuildCompletionDeadlineConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config.DefaultCompletionDeadlineSeconds != nil && *config.DefaultCompletionDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("defaultCompletionDeadlineSeconds"), *config.DefaultCompletionDeadlineSeconds, "must be a positive number"))
+	}
+	if config.MaxCompletionDeadlineSeconds != nil && *config.MaxCompletionDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("maxCompletionDeadlineSeconds"), *config.MaxCompletionDeadlineSeconds, "must be a positive number"))
+	}
+	if len(allErrs) == 0 && config.DefaultCompletionDeadlineSeconds != nil && config.MaxCompletionDeadlineSeconds != nil &&
+		*config.DefaultCompletionDeadlineSeconds > *config.MaxCompletionDeadlineSeconds {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("defaultCompletionDeadlineSeconds"), *config.DefaultCompletionDeadlineSeconds, "may not exceed maxCompletionDeadlineSeconds"))
+	}
+	return allErrs
+}
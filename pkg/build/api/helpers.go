@@ -8,13 +8,19 @@ import (
 // Currently BuildLogOptions.Container and BuildLogOptions.Previous aren't used
 // so they won't be copied to PodLogOptions.
 func BuildToPodLogOptions(opts *BuildLogOptions) *kapi.PodLogOptions {
+	limitBytes := opts.LimitBytes
+	// the skipped bytes are read from the pod log too
+	if opts.LimitBytes != nil && opts.OffsetBytes != nil {
+		limit := *opts.LimitBytes + *opts.OffsetBytes
+		limitBytes = &limit
+	}
 	return &kapi.PodLogOptions{
 		Follow:       opts.Follow,
 		SinceSeconds: opts.SinceSeconds,
 		SinceTime:    opts.SinceTime,
 		Timestamps:   opts.Timestamps,
 		TailLines:    opts.TailLines,
-		LimitBytes:   opts.LimitBytes,
+		LimitBytes:   limitBytes,
 	}
 }
 
@@ -28,6 +28,7 @@ func init() {
 		&BuildRequest{},
 		&BuildLogOptions{},
 		&BinaryBuildRequestOptions{},
+		&BuildHistory{},
 	)
 }
 
@@ -39,3 +40,4 @@ func (*BuildLog) IsAnAPIObject()                  {}
 func (*BuildRequest) IsAnAPIObject()              {}
 func (*BuildLogOptions) IsAnAPIObject()           {}
 func (*BinaryBuildRequestOptions) IsAnAPIObject() {}
+func (*BuildHistory) IsAnAPIObject()              {}
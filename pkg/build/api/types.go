@@ -101,6 +101,11 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference
+
+	// FromImageDigest is the digest of the image the build strategy runs from, resolved when
+	// the build was created. It is empty if the strategy image is referenced by a tag outside
+	// of an image stream.
+	FromImageDigest string
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -262,8 +267,23 @@ type BinaryBuildSource struct {
 	// The custom strategy receives this binary as standard input. This filename may not
 	// contain slashes or be '..' or '.'.
 	AsFile string
+
+	// WithArchive indicates that the binary input also provides an archive, which is extracted
+	// into the build input alongside the file named by AsFile. It is set when a binary build is
+	// started with a multipart upload, and requires AsFile. The archive may not contain a file
+	// with the name of AsFile.
+	WithArchive bool
 }
 
+const (
+	// BinaryBuildFilePart is the name of the part of a multipart binary upload that holds the
+	// file named by AsFile.
+	BinaryBuildFilePart = "file"
+	// BinaryBuildArchivePart is the name of the part of a multipart binary upload that holds the
+	// archive extracted alongside the file.
+	BinaryBuildArchivePart = "archive"
+)
+
 // SourceRevision is the revision or commit information from the source for the build
 type SourceRevision struct {
 	// Git contains information about git-based build source
@@ -463,6 +483,19 @@ type BuildConfigSpec struct {
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
 	LastVersion int
+
+	// WebHookURLs are the URLs that invoke the webhook triggers of the build config, with the
+	// secret of each trigger replaced by <secret>. They are set by the server when the build
+	// config is read and are never stored.
+	WebHookURLs []WebHookTriggerURL
+}
+
+// WebHookTriggerURL is the URL that invokes a webhook trigger.
+type WebHookTriggerURL struct {
+	// Type of the webhook trigger
+	Type BuildTriggerType
+	// URL that invokes the trigger
+	URL string
 }
 
 // WebHookTrigger is a trigger that gets invoked using a webhook type of post
@@ -479,8 +512,10 @@ type ImageChangeTrigger struct {
 
 	// From is a reference to an ImageStreamTag that will trigger a build when updated
 	// It is optional. If no From is specified, the From image from the build strategy
-	// will be used. Only one ImageChangeTrigger with an empty From reference is allowed in
-	// a build configuration.
+	// will be used, or for a Docker strategy without From, the image stream tag named by
+	// the FROM instruction of the Dockerfile in the source, if that image stream exists.
+	// Only one ImageChangeTrigger with an empty From reference is allowed in a build
+	// configuration.
 	From *kapi.ObjectReference
 }
 
@@ -603,6 +638,11 @@ type BuildRequest struct {
 
 	// Env contains additional environment variables you want to pass into a builder container
 	Env []kapi.EnvVar
+
+	// FromImageDigest (optional) pins the image of the build strategy to the image with this
+	// digest, in the repository the build config resolves its strategy image from, so that a
+	// build can be repeated after the tag it was built from moved.
+	FromImageDigest string
 }
 
 type BinaryBuildRequestOptions struct {
@@ -663,6 +703,11 @@ type BuildLogOptions struct {
 	// log output. This may not display a complete final line of logging, and may return
 	// slightly more or slightly less than the specified limit.
 	LimitBytes *int64
+	// If set, the number of bytes at the beginning of the log output to skip. A client that was
+	// disconnected may resume the build log by passing the number of bytes it already received
+	// together with the options of the interrupted request. LimitBytes counts the bytes after the
+	// offset. May not be combined with sinceSeconds or tailLines.
+	OffsetBytes *int64
 
 	// NoWait if true causes the call to return immediately even if the build
 	// is not available yet. Otherwise the server will wait until the build has started.
@@ -672,6 +717,30 @@ type BuildLogOptions struct {
 	Version *int64
 }
 
+// BuildHistory summarizes the builds of a build config, so that their phases and durations can
+// be shown without listing and watching the builds themselves.
+type BuildHistory struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Items are the summaries of the builds of the build config, newest first.
+	Items []BuildSummary
+}
+
+// BuildSummary is the outcome and timing of a single build.
+type BuildSummary struct {
+	// Name of the build
+	Name string
+	// Phase is the point in the build lifecycle.
+	Phase BuildPhase
+	// StartTimestamp is the server time when the build started running in a pod.
+	StartTimestamp *unversioned.Time
+	// CompletionTimestamp is the server time when the pod running the build stopped running.
+	CompletionTimestamp *unversioned.Time
+	// Duration is the time the build has been running.
+	Duration time.Duration
+}
+
 // SecretSpec specifies a secret to be included in a build pod and its corresponding mount point
 type SecretSpec struct {
 	// SecretSource is a reference to the secret
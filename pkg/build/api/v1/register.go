@@ -22,6 +22,7 @@ func init() {
 		&BuildRequest{},
 		&BuildLogOptions{},
 		&BinaryBuildRequestOptions{},
+		&BuildHistory{},
 	)
 }
 
@@ -33,3 +34,4 @@ func (*BuildLog) IsAnAPIObject()                  {}
 func (*BuildRequest) IsAnAPIObject()              {}
 func (*BuildLogOptions) IsAnAPIObject()           {}
 func (*BinaryBuildRequestOptions) IsAnAPIObject() {}
+func (*BuildHistory) IsAnAPIObject()              {}
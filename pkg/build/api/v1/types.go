@@ -85,6 +85,11 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference `json:"config,omitempty" description:"reference to build config from which this build was derived"`
+
+	// FromImageDigest is the digest of the image the build strategy runs from, resolved when
+	// the build was created. It is empty if the strategy image is referenced by a tag outside
+	// of an image stream.
+	FromImageDigest string `json:"fromImageDigest,omitempty" description:"digest of the image the build strategy runs from, when known"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -232,6 +237,12 @@ type BinaryBuildSource struct {
 	// The custom strategy receives this binary as standard input. This filename may not
 	// contain slashes or be '..' or '.'.
 	AsFile string `json:"asFile,omitempty" description:"indicate the provided binary should be considered a single file placed within the root of the input; must be a valid filename with no path segments"`
+
+	// WithArchive indicates that the binary input also provides an archive, which is extracted
+	// into the build input alongside the file named by AsFile. It is set when a binary build is
+	// started with a multipart upload, and requires AsFile. The archive may not contain a file
+	// with the name of AsFile.
+	WithArchive bool `json:"withArchive,omitempty" description:"indicate the binary input also provides an archive extracted alongside the file named by asFile; requires asFile"`
 }
 
 // SourceRevision is the revision or commit information from the source for the build
@@ -434,6 +445,19 @@ type BuildConfigSpec struct {
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
 	LastVersion int `json:"lastVersion" description:"used to inform about number of last triggered build"`
+
+	// WebHookURLs are the URLs that invoke the webhook triggers of the build config, with the
+	// secret of each trigger replaced by <secret>. They are set by the server when the build
+	// config is read and are never stored.
+	WebHookURLs []WebHookTriggerURL `json:"webHookURLs,omitempty" description:"URLs that invoke the webhook triggers of the build config, with the secret replaced by <secret>; set by the server"`
+}
+
+// WebHookTriggerURL is the URL that invokes a webhook trigger.
+type WebHookTriggerURL struct {
+	// Type of the webhook trigger
+	Type BuildTriggerType `json:"type" description:"type of the webhook trigger"`
+	// URL that invokes the trigger
+	URL string `json:"url" description:"URL that invokes the trigger"`
 }
 
 // WebHookTrigger is a trigger that gets invoked using a webhook type of post
@@ -450,8 +474,10 @@ type ImageChangeTrigger struct {
 
 	// From is a reference to an ImageStreamTag that will trigger a build when updated
 	// It is optional. If no From is specified, the From image from the build strategy
-	// will be used. Only one ImageChangeTrigger with an empty From reference is allowed in
-	// a build configuration.
+	// will be used, or for a Docker strategy without From, the image stream tag named by
+	// the FROM instruction of the Dockerfile in the source, if that image stream exists.
+	// Only one ImageChangeTrigger with an empty From reference is allowed in a build
+	// configuration.
 	From *kapi.ObjectReference `json:"from,omitempty" description:"reference to an ImageStreamTag that will trigger the build"`
 }
 
@@ -556,6 +582,11 @@ type BuildRequest struct {
 
 	// Env contains additional environment variables you want to pass into a builder container
 	Env []kapi.EnvVar `json:"env,omitempty" description:"additional environment variables you want to pass into a builder container"`
+
+	// FromImageDigest (optional) pins the image of the build strategy to the image with this
+	// digest, in the repository the build config resolves its strategy image from, so that a
+	// build can be repeated after the tag it was built from moved.
+	FromImageDigest string `json:"fromImageDigest,omitempty" description:"digest of the image to run the build strategy from, in the repository the build config resolves its strategy image from"`
 }
 
 type BinaryBuildRequestOptions struct {
@@ -616,6 +647,11 @@ type BuildLogOptions struct {
 	// log output. This may not display a complete final line of logging, and may return
 	// slightly more or slightly less than the specified limit.
 	LimitBytes *int64 `json:"limitBytes,omitempty" description:"the number of bytes to read from the server before terminating the log output"`
+	// If set, the number of bytes at the beginning of the log output to skip. A client that was
+	// disconnected may resume the build log by passing the number of bytes it already received
+	// together with the options of the interrupted request. LimitBytes counts the bytes after the
+	// offset. May not be combined with sinceSeconds or tailLines.
+	OffsetBytes *int64 `json:"offsetBytes,omitempty" description:"the number of bytes at the beginning of the log output to skip; used to resume a log stream"`
 
 	// NoWait if true causes the call to return immediately even if the build
 	// is not available yet. Otherwise the server will wait until the build has started.
@@ -626,6 +662,30 @@ type BuildLogOptions struct {
 	Version *int64 `json:"version,omitempty" description:"the version of the build for which to view logs"`
 }
 
+// BuildHistory summarizes the builds of a build config, so that their phases and durations can
+// be shown without listing and watching the builds themselves.
+type BuildHistory struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Items are the summaries of the builds of the build config, newest first.
+	Items []BuildSummary `json:"items" description:"summaries of the builds of the build config, newest first"`
+}
+
+// BuildSummary is the outcome and timing of a single build.
+type BuildSummary struct {
+	// Name of the build
+	Name string `json:"name" description:"name of the build"`
+	// Phase is the point in the build lifecycle.
+	Phase BuildPhase `json:"phase" description:"observed point in the build lifecycle"`
+	// StartTimestamp is the server time when the build started running in a pod.
+	StartTimestamp *unversioned.Time `json:"startTimestamp,omitempty" description:"server time when the build started running in a pod"`
+	// CompletionTimestamp is the server time when the pod running the build stopped running.
+	CompletionTimestamp *unversioned.Time `json:"completionTimestamp,omitempty" description:"server time when the pod running the build stopped running"`
+	// Duration is the time the build has been running.
+	Duration time.Duration `json:"duration,omitempty" description:"amount of time the build has been running"`
+}
+
 // SecretSpec specifies a secret to be included in a build pod and its corresponding mount point
 type SecretSpec struct {
 	// SecretSource is a reference to the secret
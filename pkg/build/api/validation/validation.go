@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/docker/distribution/digest"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
@@ -57,21 +59,36 @@ func refKey(namespace string, ref *kapi.ObjectReference) string {
 
 // ValidateBuildConfig tests required fields for a Build.
 func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
+	return validateBuildConfig(config, nil)
+}
+
+// validateBuildConfig tests required fields for a Build.  older is the build config being updated, or nil on create.
+// Checks added after build configs could be stored are only applied to new build configs and to changed fields, so
+// that existing build configs can still be updated.
+func validateBuildConfig(config, older *buildapi.BuildConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validation.ValidateObjectMeta(&config.ObjectMeta, true, validation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
 
 	// image change triggers that refer
 	fromRefs := map[string]struct{}{}
+	configChange := false
+	checkConfigChange := older == nil || !kapi.Semantic.DeepEqual(config.Spec.Triggers, older.Spec.Triggers)
 	specPath := field.NewPath("spec")
 	triggersPath := specPath.Child("triggers")
 	for i, trg := range config.Spec.Triggers {
 		allErrs = append(allErrs, validateTrigger(&trg, triggersPath.Index(i))...)
+		if trg.Type == buildapi.ConfigChangeBuildTriggerType && checkConfigChange {
+			if configChange {
+				allErrs = append(allErrs, field.Duplicate(triggersPath.Index(i).Child("type"), trg.Type))
+			}
+			configChange = true
+		}
 		if trg.Type != buildapi.ImageChangeBuildTriggerType || trg.ImageChange == nil {
 			continue
 		}
 		from := trg.ImageChange.From
 		if from == nil {
-			from = buildutil.GetImageStreamForStrategy(config.Spec.Strategy)
+			from = buildutil.GetImageStreamForBuildSpec(config.Spec.BuildSpec)
 		}
 		fromKey := refKey(config.Namespace, from)
 		_, exists := fromRefs[fromKey]
@@ -83,9 +100,10 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec, specPath)...)
 
-	// validate ImageChangeTriggers of DockerStrategy builds
+	// validate ImageChangeTriggers of DockerStrategy builds, which may take their image from the
+	// FROM instruction of the Dockerfile in the source
 	strategy := config.Spec.BuildSpec.Strategy
-	if strategy.DockerStrategy != nil && strategy.DockerStrategy.From == nil {
+	if strategy.DockerStrategy != nil && buildutil.GetImageStreamForBuildSpec(config.Spec.BuildSpec) == nil {
 		for i, trigger := range config.Spec.Triggers {
 			if trigger.Type == buildapi.ImageChangeBuildTriggerType && (trigger.ImageChange == nil || trigger.ImageChange.From == nil) {
 				allErrs = append(allErrs, field.Required(triggersPath.Index(i).Child("imageChange", "from")))
@@ -99,13 +117,19 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.BuildConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&config.ObjectMeta, &older.ObjectMeta, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, ValidateBuildConfig(config)...)
+	allErrs = append(allErrs, validateBuildConfig(config, older)...)
 	return allErrs
 }
 
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) field.ErrorList {
-	return validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	if len(request.FromImageDigest) != 0 {
+		if _, err := digest.ParseDigest(request.FromImageDigest); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("fromImageDigest"), request.FromImageDigest, err.Error()))
+		}
+	}
+	return allErrs
 }
 
 func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.ErrorList {
@@ -292,6 +316,9 @@ func validateBinarySource(source *buildapi.BinaryBuildSource, fldPath *field.Pat
 			source.AsFile = cleaned
 		}
 	}
+	if source.WithArchive && len(source.AsFile) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("asFile")))
+	}
 	return allErrs
 }
 
@@ -516,6 +543,19 @@ func ValidateBuildLogOptions(opts *buildapi.BuildLogOptions) field.ErrorList {
 	if errs := validation.ValidatePodLogOptions(popts); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if opts.OffsetBytes != nil {
+		offsetPath := field.NewPath("offsetBytes")
+		if *opts.OffsetBytes < 0 {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, "must be non-negative"))
+		}
+		// the log output these select changes between the interrupted and the resumed request
+		if opts.SinceSeconds != nil {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, "may not be combined with sinceSeconds"))
+		}
+		if opts.TailLines != nil {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, "may not be combined with tailLines"))
+		}
+	}
 
 	if opts.Version != nil && *opts.Version <= 0 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("version"), *opts.Version, "build version must be greater than 0"))
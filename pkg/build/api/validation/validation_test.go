@@ -289,6 +289,62 @@ func TestBuildConfigDockerStrategyImageChangeTrigger(t *testing.T) {
 	}
 }
 
+// TestBuildConfigDockerfileFromImageChangeTrigger ensures that an ImageChangeTrigger
+// without From is valid for a Docker strategy without From when the Dockerfile in the
+// source names an image stream tag in its FROM instruction.
+func TestBuildConfigDockerfileFromImageChangeTrigger(t *testing.T) {
+	tests := map[string]struct {
+		dockerfile     string
+		expectedErrors int
+	}{
+		"image stream tag": {
+			dockerfile: "FROM ruby:2.2\nRUN make",
+		},
+		"image stream tag in another namespace": {
+			dockerfile: "FROM openshift/ruby\nRUN make",
+		},
+		"image in another registry": {
+			dockerfile:     "FROM registry.example.com/openshift/ruby:2.2\nRUN make",
+			expectedErrors: 1,
+		},
+		"no FROM": {
+			dockerfile:     "RUN make",
+			expectedErrors: 1,
+		},
+	}
+	for name, test := range tests {
+		dockerfile := test.dockerfile
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Dockerfile: &dockerfile,
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+				Triggers: []buildapi.BuildTriggerPolicy{
+					{
+						Type:        buildapi.ImageChangeBuildTriggerType,
+						ImageChange: &buildapi.ImageChangeTrigger{},
+					},
+				},
+			},
+		}
+		if errors := ValidateBuildConfig(buildConfig); len(errors) != test.expectedErrors {
+			t.Errorf("%s: expected %d validation errors, got %v", name, test.expectedErrors, errors)
+		}
+	}
+}
+
 func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	buildConfig := &buildapi.BuildConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "", Namespace: "foo"},
@@ -470,6 +526,23 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 			expectError: true,
 			errorType:   field.ErrorTypeInvalid,
 		},
+		{
+			name: "duplicate config change triggers",
+			triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type:        buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{},
+				},
+				{
+					Type: buildapi.ConfigChangeBuildTriggerType,
+				},
+				{
+					Type: buildapi.ConfigChangeBuildTriggerType,
+				},
+			},
+			expectError: true,
+			errorType:   field.ErrorTypeDuplicate,
+		},
 		{
 			name: "imagestreamtag references with same name, different ns",
 			triggers: []buildapi.BuildTriggerPolicy{
@@ -569,6 +642,41 @@ func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	}
 }
 
+func TestBuildConfigUpdateDuplicateConfigChangeTriggers(t *testing.T) {
+	older := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "bar", Namespace: "foo", ResourceVersion: "1"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+			},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{Type: buildapi.ConfigChangeBuildTriggerType},
+				{Type: buildapi.ConfigChangeBuildTriggerType},
+			},
+		},
+	}
+
+	// build configs stored before duplicates were rejected can still be updated
+	config := *older
+	config.Labels = map[string]string{"app": "bar"}
+	if errs := ValidateBuildConfigUpdate(&config, older); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	config.Spec.Triggers = append(config.Spec.Triggers, buildapi.BuildTriggerPolicy{Type: buildapi.GenericWebHookBuildTriggerType, GenericWebHook: &buildapi.WebHookTrigger{Secret: "secret"}})
+	errs := ValidateBuildConfigUpdate(&config, older)
+	if len(errs) != 1 || errs[0].Type != field.ErrorTypeDuplicate {
+		t.Errorf("expected a duplicate trigger error, got %v", errs)
+	}
+}
+
 func TestBuildConfigValidationOutputFailure(t *testing.T) {
 	buildConfig := &buildapi.BuildConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: ""},
@@ -602,6 +710,10 @@ func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(field.ErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
 		string(field.ErrorTypeRequired) + "metadata.name":      {ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault}},
+		string(field.ErrorTypeInvalid) + "fromImageDigest": {
+			ObjectMeta:      kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			FromImageDigest: "latest",
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -929,6 +1041,21 @@ func TestValidateSource(t *testing.T) {
 				},
 			},
 		},
+		// 22 - an archive requires a file
+		{
+			t:    field.ErrorTypeRequired,
+			path: "binary.asFile",
+			source: &buildapi.BuildSource{
+				Binary: &buildapi.BinaryBuildSource{WithArchive: true},
+			},
+		},
+		// 23
+		{
+			source: &buildapi.BuildSource{
+				Binary: &buildapi.BinaryBuildSource{AsFile: "app.war", WithArchive: true},
+			},
+			ok: true,
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateSource(tc.source, false, false, nil)
@@ -1727,3 +1854,40 @@ func TestValidateToImageReference(t *testing.T) {
 		t.Errorf("Error on wrong field, expected %s, got %s", "namespace", err.Field)
 	}
 }
+
+func TestValidateBuildLogOptionsOffset(t *testing.T) {
+	offset, negative, lines, seconds := int64(100), int64(-1), int64(10), int64(60)
+	tests := map[string]struct {
+		opts     *buildapi.BuildLogOptions
+		expected int
+	}{
+		"offset": {
+			opts:     &buildapi.BuildLogOptions{Follow: true, OffsetBytes: &offset},
+			expected: 0,
+		},
+		"negative offset": {
+			opts:     &buildapi.BuildLogOptions{OffsetBytes: &negative},
+			expected: 1,
+		},
+		"offset with tail lines": {
+			opts:     &buildapi.BuildLogOptions{OffsetBytes: &offset, TailLines: &lines},
+			expected: 1,
+		},
+		"offset with since seconds": {
+			opts:     &buildapi.BuildLogOptions{OffsetBytes: &offset, SinceSeconds: &seconds},
+			expected: 1,
+		},
+	}
+	for desc, test := range tests {
+		errs := ValidateBuildLogOptions(test.opts)
+		if len(errs) != test.expected {
+			t.Errorf("%s: expected %d errors, got %v", desc, test.expected, errs)
+			continue
+		}
+		for _, err := range errs {
+			if err.Field != "offsetBytes" {
+				t.Errorf("%s: unexpected error field %s", desc, err.Field)
+			}
+		}
+	}
+}
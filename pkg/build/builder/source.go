@@ -1,9 +1,11 @@
 package builder
 
 import (
+	"bufio"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -157,6 +159,9 @@ func extractInputBinary(in io.Reader, source *api.BinaryBuildSource, dir string)
 	if source == nil {
 		return nil
 	}
+	if source.WithArchive {
+		return extractInputParts(in, source, dir)
+	}
 
 	var path string
 	if len(source.AsFile) > 0 {
@@ -177,13 +182,81 @@ func extractInputBinary(in io.Reader, source *api.BinaryBuildSource, dir string)
 	}
 
 	glog.V(2).Infof("Receiving source from STDIN as archive")
+	if err := extractArchive(in, dir); err != nil {
+		return fmt.Errorf("unable to extract binary build input, must be a zip, tar, or gzipped tar, or specified as a file: %v", err)
+	}
+	return nil
+}
+
+// extractInputParts processes an input stream holding a multipart upload of the file named by
+// AsFile and of an archive that is extracted alongside it. The stream starts with the boundary
+// of its parts. The file is placed after the archive was extracted, so that the archive cannot
+// overwrite it.
+func extractInputParts(in io.Reader, source *api.BinaryBuildSource, dir string) error {
+	glog.V(2).Infof("Receiving source from STDIN as file %s and archive", source.AsFile)
+
+	buffered := bufio.NewReader(in)
+	firstLine, err := buffered.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("unable to read binary build input: %v", err)
+	}
+	boundary := strings.TrimSpace(strings.TrimPrefix(firstLine, "--"))
+	parts := multipart.NewReader(io.MultiReader(strings.NewReader(firstLine), buffered), boundary)
+
+	f, err := ioutil.TempFile(filepath.Dir(dir), "binary")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	hasFile := false
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read binary build input: %v", err)
+		}
+		switch part.FormName() {
+		case api.BinaryBuildFilePart:
+			n, err := io.Copy(f, part)
+			if err != nil {
+				return err
+			}
+			glog.V(4).Infof("Received %d bytes for %s", n, source.AsFile)
+			hasFile = true
+		case api.BinaryBuildArchivePart:
+			if err := extractArchive(part, dir); err != nil {
+				return fmt.Errorf("unable to extract the archive of the binary build input, must be a zip, tar, or gzipped tar: %v", err)
+			}
+		default:
+			return fmt.Errorf("unexpected part %q in binary build input", part.FormName())
+		}
+	}
+	if !hasFile {
+		return fmt.Errorf("binary build input has no file to place as %s", source.AsFile)
+	}
 
+	path := filepath.Join(dir, source.AsFile)
+	if _, err := os.Lstat(path); err == nil {
+		return fmt.Errorf("the archive of the binary build input contains %s, which collides with the uploaded file", source.AsFile)
+	}
+	if err := f.Chmod(0664); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// extractArchive extracts the zip, tar, or gzipped tar read from in into dir.
+func extractArchive(in io.Reader, dir string) error {
 	cmd := exec.Command("bsdtar", "-x", "-o", "-m", "-f", "-", "-C", dir)
 	cmd.Stdin = in
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		glog.V(2).Infof("Extracting...\n%s", string(out))
-		return fmt.Errorf("unable to extract binary build input, must be a zip, tar, or gzipped tar, or specified as a file: %v", err)
+		return err
 	}
 	return nil
 }
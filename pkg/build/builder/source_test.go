@@ -1,13 +1,20 @@
 package builder
 
 import (
+	"archive/tar"
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/generate/git"
 )
 
@@ -41,3 +48,55 @@ func TestCheckRemoteGit(t *testing.T) {
 		t.Errorf("unexpected error %q", err)
 	}
 }
+
+// binaryParts returns a multipart binary build input with the file contents and a tar archive
+// holding files.
+func binaryParts(t *testing.T, contents string, files map[string]string) *bytes.Buffer {
+	archive := &bytes.Buffer{}
+	tw := tar.NewWriter(archive)
+	for name, data := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data))}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte(data))
+	}
+	tw.Close()
+
+	input := &bytes.Buffer{}
+	mw := multipart.NewWriter(input)
+	w, _ := mw.CreateFormField(api.BinaryBuildArchivePart)
+	w.Write(archive.Bytes())
+	w, _ = mw.CreateFormField(api.BinaryBuildFilePart)
+	w.Write([]byte(contents))
+	mw.Close()
+	return input
+}
+
+func TestExtractInputParts(t *testing.T) {
+	base, err := ioutil.TempDir("", "binary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(base)
+	dir := filepath.Join(base, "input")
+	os.Mkdir(dir, 0755)
+
+	source := &api.BinaryBuildSource{AsFile: "app.war", WithArchive: true}
+	input := binaryParts(t, "war", map[string]string{"config/app.properties": "key=value"})
+	if err := extractInputBinary(input, source, dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, expected := range map[string]string{"app.war": "war", "config/app.properties": "key=value"} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(data) != expected {
+			t.Errorf("expected %s to contain %q, got %q: %v", name, expected, string(data), err)
+		}
+	}
+
+	collision := filepath.Join(base, "collision")
+	os.Mkdir(collision, 0755)
+	input = binaryParts(t, "war", map[string]string{"app.war": "other"})
+	if err := extractInputBinary(input, source, collision); err == nil || !strings.Contains(err.Error(), "collides") {
+		t.Errorf("expected a collision error, got %v", err)
+	}
+}
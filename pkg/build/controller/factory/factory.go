@@ -2,9 +2,10 @@ package factory
 
 import (
 	"fmt"
-	"github.com/golang/glog"
 	"time"
 
+	"github.com/golang/glog"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
@@ -27,7 +28,17 @@ import (
 	errors "github.com/openshift/origin/pkg/util/errors"
 )
 
-const maxRetries = 60
+const (
+	maxRetries = 60
+
+	// buildWorkers and buildPodWorkers are the number of builds and build pods handled at once
+	buildWorkers    = 5
+	buildPodWorkers = 5
+
+	// orphanCheckInterval is how often the build controllers look for the pods of deleted builds
+	// and for the builds of deleted pods that were deleted while the controllers were not running
+	orphanCheckInterval = 5 * time.Minute
+)
 
 // limitedLogAndRetry stops retrying after maxTimeout, failing the build.
 func limitedLogAndRetry(buildupdater buildclient.BuildUpdater, maxTimeout time.Duration) controller.RetryFunc {
@@ -62,12 +73,22 @@ type BuildControllerFactory struct {
 	CustomBuildStrategy *strategy.CustomBuildStrategy
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
+
+	// buildInformer is the watch of builds shared by the controllers created by this factory
+	buildInformer *controller.SharedInformer
+}
+
+// builds returns the shared watch of builds
+func (factory *BuildControllerFactory) builds() *controller.SharedInformer {
+	if factory.buildInformer == nil {
+		factory.buildInformer = controller.NewSharedInformer(&buildLW{client: factory.OSClient}, &buildapi.Build{}, 2*time.Minute)
+	}
+	return factory.buildInformer
 }
 
 // Create constructs a BuildController
 func (factory *BuildControllerFactory) Create() controller.RunnableController {
-	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
-	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+	builds := factory.builds()
 
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
@@ -85,62 +106,93 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 		Recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
 	}
 
-	return &controller.RetryController{
-		Queue: queue,
-		RetryManager: controller.NewQueueRetryManager(
-			queue,
-			cache.MetaNamespaceKeyFunc,
-			limitedLogAndRetry(factory.BuildUpdater, 30*time.Minute),
-			kutil.NewTokenBucketRateLimiter(1, 10)),
-		Handle: func(obj interface{}) error {
-			build := obj.(*buildapi.Build)
-			err := buildController.HandleBuild(build)
-			if err != nil {
-				// Update the build status message only if it changed.
-				if msg := errors.ErrorToSentence(err); build.Status.Message != msg {
-					// Set default Reason.
-					if len(build.Status.Reason) == 0 {
-						build.Status.Reason = buildapi.StatusReasonError
-					}
-					build.Status.Message = msg
-					if err := buildController.BuildUpdater.Update(build.Namespace, build); err != nil {
-						glog.V(2).Infof("Failed to update status message of Build %s/%s: %v", build.Namespace, build.Name, err)
-					}
-					buildController.Recorder.Eventf(build, kapi.EventTypeWarning, "HandleBuildError", "Build has error: %v", err)
+	queue := controller.NewWorkQueueController(builds.Store(), limitedLogAndRetry(factory.BuildUpdater, 30*time.Minute), buildWorkers)
+	queue.Handle = func(obj interface{}) error {
+		build := obj.(*buildapi.Build)
+		err := buildController.HandleBuild(build)
+		if err != nil {
+			// Update the build status message only if it changed.
+			if msg := errors.ErrorToSentence(err); build.Status.Message != msg {
+				// Set default Reason.
+				if len(build.Status.Reason) == 0 {
+					build.Status.Reason = buildapi.StatusReasonError
 				}
+				build.Status.Message = msg
+				if err := buildController.BuildUpdater.Update(build.Namespace, build); err != nil {
+					glog.V(2).Infof("Failed to update status message of Build %s/%s: %v", build.Namespace, build.Name, err)
+				}
+				buildController.Recorder.Eventf(build, kapi.EventTypeWarning, "HandleBuildError", "Build has error: %v", err)
 			}
-			return err
-		},
+		}
+		return err
 	}
+	builds.AddEventHandler(queue.EventHandler())
+	builds.RunUntil(factory.Stop)
+
+	return queue
 }
 
 // CreateDeleteController constructs a BuildDeleteController
 func (factory *BuildControllerFactory) CreateDeleteController() controller.RunnableController {
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
-	queue := cache.NewDeltaFIFO(cache.MetaNamespaceKeyFunc, nil, keyListerGetter{})
-	cache.NewReflector(&buildDeleteLW{client, queue}, &buildapi.Build{}, queue, 5*time.Minute).RunUntil(factory.Stop)
+	builds := factory.builds()
 
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
 	}
 
-	return &controller.RetryController{
-		Queue: queue,
-		RetryManager: controller.NewQueueRetryManager(
-			queue,
-			cache.MetaNamespaceKeyFunc,
-			controller.RetryNever,
-			kutil.NewTokenBucketRateLimiter(1, 10)),
-		Handle: func(obj interface{}) error {
-			deltas := obj.(cache.Deltas)
-			for _, delta := range deltas {
-				if delta.Type == cache.Deleted {
-					return buildDeleteController.HandleBuildDeletion(delta.Object.(*buildapi.Build))
-				}
-			}
-			return nil
-		},
+	queue := controller.NewWorkQueueController(builds.Store(), controller.RetryNever, 1)
+	queue.HandleDeleted = func(obj interface{}) error {
+		return buildDeleteController.HandleBuildDeletion(obj.(*buildapi.Build))
+	}
+	builds.AddEventHandler(queue.EventHandler())
+	builds.RunUntil(factory.Stop)
+
+	// builds deleted while the controller was not running are found through their pods
+	go kutil.Until(func() {
+		if !builds.HasSynced() {
+			return
+		}
+		deleted, err := deletedBuildsOfPods(factory.KubeClient, builds.Store())
+		if err != nil {
+			glog.V(4).Infof("Failed to check for deleted builds: %v", err)
+			return
+		}
+		for _, build := range deleted {
+			queue.EnqueueDeleted(build)
+		}
+	}, orphanCheckInterval, factory.Stop)
+
+	return queue
+}
+
+// deletedBuildsOfPods returns the builds of the build pods that are not in the store of builds
+func deletedBuildsOfPods(client kclient.Interface, builds cache.Store) ([]*buildapi.Build, error) {
+	glog.V(5).Info("Checking for deleted builds")
+	podList, err := listPods(client)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []*buildapi.Build{}
+	for _, pod := range podList.Items {
+		buildName := pod.Labels[buildapi.BuildLabel]
+		if len(buildName) == 0 {
+			continue
+		}
+		build := &buildapi.Build{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      buildName,
+				Namespace: pod.Namespace,
+			},
+		}
+		if _, exists, err := builds.Get(build); err != nil || exists {
+			continue
+		}
+		glog.V(4).Infof("No build found for build pod %s/%s, deleting pod", pod.Namespace, pod.Name)
+		deleted = append(deleted, build)
 	}
+	return deleted, nil
 }
 
 // BuildPodControllerFactory construct BuildPodController objects
@@ -151,7 +203,19 @@ type BuildPodControllerFactory struct {
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 
-	buildStore cache.Store
+	// buildInformer and podInformer are the watches of builds and build pods shared by the
+	// controllers created by this factory
+	buildInformer *controller.SharedInformer
+	podInformer   *controller.SharedInformer
+}
+
+// informers returns the shared watches of builds and build pods
+func (factory *BuildPodControllerFactory) informers() (*controller.SharedInformer, *controller.SharedInformer) {
+	if factory.buildInformer == nil {
+		factory.buildInformer = controller.NewSharedInformer(&buildLW{client: factory.OSClient}, &buildapi.Build{}, 2*time.Minute)
+		factory.podInformer = controller.NewSharedInformer(&podLW{client: factory.KubeClient}, &kapi.Pod{}, 2*time.Minute)
+	}
+	return factory.buildInformer, factory.podInformer
 }
 
 // retryFunc returns a function to retry a controller event
@@ -178,85 +242,85 @@ func retryFunc(kind string, isFatal func(err error) bool) controller.RetryFunc {
 
 // Create constructs a BuildPodController
 func (factory *BuildPodControllerFactory) Create() controller.RunnableController {
-	factory.buildStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
-	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, factory.buildStore, 2*time.Minute).RunUntil(factory.Stop)
-
-	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
-	cache.NewReflector(&podLW{client: factory.KubeClient}, &kapi.Pod{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+	builds, pods := factory.informers()
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
+		BuildStore:   builds.Store(),
 		BuildUpdater: factory.BuildUpdater,
 		PodManager:   client,
 	}
 
-	return &controller.RetryController{
-		Queue: queue,
-		RetryManager: controller.NewQueueRetryManager(
-			queue,
-			cache.MetaNamespaceKeyFunc,
-			retryFunc("BuildPod", nil),
-			kutil.NewTokenBucketRateLimiter(1, 10)),
-		Handle: func(obj interface{}) error {
-			pod := obj.(*kapi.Pod)
-			return buildPodController.HandlePod(pod)
-		},
+	queue := controller.NewWorkQueueController(pods.Store(), retryFunc("BuildPod", nil), buildPodWorkers)
+	queue.Handle = func(obj interface{}) error {
+		return buildPodController.HandlePod(obj.(*kapi.Pod))
 	}
-}
+	pods.AddEventHandler(queue.EventHandler())
+	builds.RunUntil(factory.Stop)
+	pods.RunUntil(factory.Stop)
 
-// keyListerGetter is a dummy implementation of a KeyListerGetter
-// which always returns a fake object and true for gets, and
-// returns no items for list.  This forces the DeltaFIFO queue
-// to always queue delete events it receives from etcd.  Our
-// client will properly handle duplicated events and this is more
-// efficient than maintaining a local cache of all the build pods
-// so the DeltaFIFO can perform a proper diff.
-type keyListerGetter struct {
-	client osclient.Interface
-}
-
-// ListKeys is a dummy implementation of a KeyListerGetter interface returning
-// empty string array; used only to force DeltaFIFO to always queue delete events.
-func (keyListerGetter) ListKeys() []string {
-	return []string{}
-}
-
-// GetByKey is a dummy implementation of a KeyListerGetter interface returning
-// always "", true, nil; used only to force DeltaFIFO to always queue delete events.
-func (keyListerGetter) GetByKey(key string) (interface{}, bool, error) {
-	return "", true, nil
+	return queue
 }
 
 // CreateDeleteController constructs a BuildPodDeleteController
 func (factory *BuildPodControllerFactory) CreateDeleteController() controller.RunnableController {
-
-	client := ControllerClient{factory.KubeClient, factory.OSClient}
-	queue := cache.NewDeltaFIFO(cache.MetaNamespaceKeyFunc, nil, keyListerGetter{})
-	cache.NewReflector(&buildPodDeleteLW{client, queue}, &kapi.Pod{}, queue, 5*time.Minute).RunUntil(factory.Stop)
+	builds, pods := factory.informers()
 
 	buildPodDeleteController := &buildcontroller.BuildPodDeleteController{
-		BuildStore:   factory.buildStore,
+		BuildStore:   builds.Store(),
 		BuildUpdater: factory.BuildUpdater,
 	}
 
-	return &controller.RetryController{
-		Queue: queue,
-		RetryManager: controller.NewQueueRetryManager(
-			queue,
-			cache.MetaNamespaceKeyFunc,
-			controller.RetryNever,
-			kutil.NewTokenBucketRateLimiter(1, 10)),
-		Handle: func(obj interface{}) error {
-			deltas := obj.(cache.Deltas)
-			for _, delta := range deltas {
-				if delta.Type == cache.Deleted {
-					return buildPodDeleteController.HandleBuildPodDeletion(delta.Object.(*kapi.Pod))
-				}
-			}
-			return nil
-		},
+	queue := controller.NewWorkQueueController(pods.Store(), controller.RetryNever, 1)
+	queue.HandleDeleted = func(obj interface{}) error {
+		return buildPodDeleteController.HandleBuildPodDeletion(obj.(*kapi.Pod))
 	}
+	pods.AddEventHandler(queue.EventHandler())
+	builds.RunUntil(factory.Stop)
+	pods.RunUntil(factory.Stop)
+
+	// build pods deleted while the controller was not running are found through their builds
+	go kutil.Until(func() {
+		if !builds.HasSynced() || !pods.HasSynced() {
+			return
+		}
+		for _, pod := range deletedPodsOfBuilds(builds.Store(), pods.Store()) {
+			queue.EnqueueDeleted(pod)
+		}
+	}, orphanCheckInterval, factory.Stop)
+
+	return queue
+}
+
+// deletedPodsOfBuilds returns the pods of the pending and running builds that are not in the
+// store of pods
+func deletedPodsOfBuilds(builds, pods cache.Store) []*kapi.Pod {
+	glog.V(5).Info("Checking for deleted build pods")
+	deleted := []*kapi.Pod{}
+	for _, obj := range builds.List() {
+		build := obj.(*buildapi.Build)
+		// new builds do not have a pod yet
+		if build.Status.Phase != buildapi.BuildPhasePending && build.Status.Phase != buildapi.BuildPhaseRunning {
+			continue
+		}
+		podName := buildutil.GetBuildPodName(build)
+		podObj, exists, err := pods.GetByKey(build.Namespace + "/" + podName)
+		if err != nil {
+			continue
+		}
+		if exists && podObj.(*kapi.Pod).Labels[buildapi.BuildLabel] == build.Name {
+			continue
+		}
+		glog.V(4).Infof("No build pod found for build %s/%s, sending delete event for build pod", build.Namespace, build.Name)
+		deleted = append(deleted, &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        podName,
+				Namespace:   build.Namespace,
+				Annotations: map[string]string{buildapi.BuildAnnotation: build.Name},
+			},
+		})
+	}
+	return deleted
 }
 
 // ImageChangeControllerFactory can create an ImageChangeController which obtains ImageStreams
@@ -452,61 +516,6 @@ func (lw *buildLW) Watch(options kapi.ListOptions) (watch.Interface, error) {
 	return lw.client.Builds(kapi.NamespaceAll).Watch(options)
 }
 
-// buildDeleteLW is a ListWatcher implementation that watches for builds being deleted
-type buildDeleteLW struct {
-	ControllerClient
-	store cache.Store
-}
-
-// List returns an empty list but adds delete events to the store for all Builds that have been deleted but still have pods.
-func (lw *buildDeleteLW) List(options kapi.ListOptions) (runtime.Object, error) {
-	glog.V(5).Info("Checking for deleted builds")
-	podList, err := listPods(lw.KubeClient)
-	if err != nil {
-		glog.V(4).Infof("Failed to find any pods due to error %v", err)
-		return nil, err
-	}
-
-	for _, pod := range podList.Items {
-		buildName := pod.Labels[buildapi.BuildLabel]
-		if len(buildName) == 0 {
-			continue
-		}
-		glog.V(5).Infof("Found build pod %s/%s", pod.Namespace, pod.Name)
-
-		build, err := lw.Client.Builds(pod.Namespace).Get(buildName)
-		if err != nil && !kerrors.IsNotFound(err) {
-			glog.V(4).Infof("Error getting build for pod %s/%s: %v", pod.Namespace, pod.Name, err)
-			return nil, err
-		}
-		if err != nil && kerrors.IsNotFound(err) {
-			build = nil
-
-		}
-		if build == nil {
-			deletedBuild := &buildapi.Build{
-				ObjectMeta: kapi.ObjectMeta{
-					Name:      buildName,
-					Namespace: pod.Namespace,
-				},
-			}
-			glog.V(4).Infof("No build found for build pod %s/%s, deleting pod", pod.Namespace, pod.Name)
-			err := lw.store.Delete(deletedBuild)
-			if err != nil {
-				glog.V(4).Infof("Error queuing delete event: %v", err)
-			}
-		} else {
-			glog.V(5).Infof("Found build %s/%s for pod %s", build.Namespace, build.Name, pod.Name)
-		}
-	}
-	return &buildapi.BuildList{}, nil
-}
-
-// Watch watches all Builds.
-func (lw *buildDeleteLW) Watch(options kapi.ListOptions) (watch.Interface, error) {
-	return lw.Client.Builds(kapi.NamespaceAll).Watch(options)
-}
-
 // buildConfigLW is a ListWatcher implementation for BuildConfigs.
 type buildConfigLW struct {
 	client osclient.Interface
@@ -537,72 +546,6 @@ func (lw *imageStreamLW) Watch(options kapi.ListOptions) (watch.Interface, error
 	return lw.client.ImageStreams(kapi.NamespaceAll).Watch(options)
 }
 
-// buildPodDeleteLW is a ListWatcher implementation that watches for Pods(that are associated with a Build) being deleted
-type buildPodDeleteLW struct {
-	ControllerClient
-	store cache.Store
-}
-
-// List lists all Pods associated with a Build.
-func (lw *buildPodDeleteLW) List(options kapi.ListOptions) (runtime.Object, error) {
-	glog.V(5).Info("Checking for deleted build pods")
-	buildList, err := lw.Client.Builds(kapi.NamespaceAll).List(options)
-	if err != nil {
-		glog.V(4).Infof("Failed to find any builds due to error %v", err)
-		return nil, err
-	}
-	for _, build := range buildList.Items {
-		glog.V(5).Infof("Found build %s/%s", build.Namespace, build.Name)
-		if buildutil.IsBuildComplete(&build) {
-			glog.V(5).Infof("Ignoring build %s/%s because it is complete", build.Namespace, build.Name)
-			continue
-		}
-		pod, err := lw.KubeClient.Pods(build.Namespace).Get(buildutil.GetBuildPodName(&build))
-		if err != nil {
-			if !kerrors.IsNotFound(err) {
-				glog.V(4).Infof("Error getting pod for build %s/%s: %v", build.Namespace, build.Name, err)
-				return nil, err
-			} else {
-				pod = nil
-			}
-		} else {
-			if buildName := pod.Labels[buildapi.BuildLabel]; buildName != build.Name {
-				pod = nil
-			}
-		}
-		if pod == nil {
-			deletedPod := &kapi.Pod{
-				ObjectMeta: kapi.ObjectMeta{
-					Name:      buildutil.GetBuildPodName(&build),
-					Namespace: build.Namespace,
-				},
-			}
-			glog.V(4).Infof("No build pod found for build %s/%s, sending delete event for build pod", build.Namespace, build.Name)
-			err := lw.store.Delete(deletedPod)
-			if err != nil {
-				glog.V(4).Infof("Error queuing delete event: %v", err)
-			}
-		} else {
-			glog.V(5).Infof("Found build pod %s/%s for build %s", pod.Namespace, pod.Name, build.Name)
-		}
-	}
-	return &kapi.PodList{}, nil
-}
-
-// Watch watches all Pods that have a build label, for deletion
-func (lw *buildPodDeleteLW) Watch(options kapi.ListOptions) (watch.Interface, error) {
-	// FIXME: since we cannot have OR on label name we'll just get builds with new label
-	sel, err := labels.Parse(buildapi.BuildLabel)
-	if err != nil {
-		return nil, err
-	}
-	opts := kapi.ListOptions{
-		LabelSelector:   sel,
-		ResourceVersion: options.ResourceVersion,
-	}
-	return lw.KubeClient.Pods(kapi.NamespaceAll).Watch(opts)
-}
-
 // ControllerClient implements the common interfaces needed for build controllers
 type ControllerClient struct {
 	KubeClient kclient.Interface
@@ -9,6 +9,8 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	controller "github.com/openshift/origin/pkg/controller"
@@ -125,3 +127,61 @@ func TestControllerRetryFunc(t *testing.T) {
 		}
 	}
 }
+
+func testBuild(name string, phase buildapi.BuildPhase) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name},
+		Status:     buildapi.BuildStatus{Phase: phase},
+	}
+}
+
+func testBuildPod(build *buildapi.Build) *kapi.Pod {
+	return &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   build.Namespace,
+			Name:        buildapi.GetBuildPodName(build),
+			Labels:      map[string]string{buildapi.BuildLabel: build.Name},
+			Annotations: map[string]string{buildapi.BuildAnnotation: build.Name},
+		},
+	}
+}
+
+func TestDeletedBuildsOfPods(t *testing.T) {
+	existing := testBuild("existing", buildapi.BuildPhaseRunning)
+	removed := testBuild("removed", buildapi.BuildPhaseRunning)
+	client := ktestclient.NewSimpleFake(testBuildPod(existing), testBuildPod(removed))
+	builds := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	builds.Add(existing)
+
+	deleted, err := deletedBuildsOfPods(client, builds)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].Namespace != "test" || deleted[0].Name != "removed" {
+		t.Errorf("unexpected deleted builds: %#v", deleted)
+	}
+}
+
+func TestDeletedPodsOfBuilds(t *testing.T) {
+	withPod := testBuild("with-pod", buildapi.BuildPhaseRunning)
+	withoutPod := testBuild("without-pod", buildapi.BuildPhasePending)
+	builds := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, build := range []*buildapi.Build{
+		withPod,
+		withoutPod,
+		testBuild("new", buildapi.BuildPhaseNew),
+		testBuild("complete", buildapi.BuildPhaseComplete),
+	} {
+		builds.Add(build)
+	}
+	pods := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	pods.Add(testBuildPod(withPod))
+
+	deleted := deletedPodsOfBuilds(builds, pods)
+	if len(deleted) != 1 {
+		t.Fatalf("expected one deleted pod, got %#v", deleted)
+	}
+	if name := deleted[0].Annotations[buildapi.BuildAnnotation]; name != "without-pod" {
+		t.Errorf("expected the pod of build without-pod to be deleted, got the pod of %q", name)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/glog"
 
+	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
@@ -47,6 +48,9 @@ type BuildGenerator struct {
 	DefaultServiceAccountName string
 	ServiceAccounts           kclient.ServiceAccountsNamespacer
 	Secrets                   kclient.SecretsNamespacer
+	// Admission, if set, admits the builds the generator creates.  Those builds are stored directly, so they do not
+	// pass the admission chain of the builds resource otherwise.
+	Admission admission.Interface
 }
 
 // GeneratorClient is the API client used by the generator
@@ -142,7 +146,7 @@ func findImageChangeTrigger(bc *buildapi.BuildConfig, ref *kapi.ObjectReference)
 		imageChange := trigger.ImageChange
 		triggerRef := imageChange.From
 		if triggerRef == nil {
-			triggerRef = buildutil.GetImageStreamForStrategy(bc.Spec.Strategy)
+			triggerRef = buildutil.GetImageStreamForBuildSpec(bc.Spec.BuildSpec)
 			if triggerRef == nil || triggerRef.Kind != "ImageStreamTag" {
 				continue
 			}
@@ -234,6 +238,12 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 		return nil, err
 	}
 
+	if len(request.FromImageDigest) > 0 {
+		if err := g.pinStrategyImage(ctx, bc, newBuild, request.FromImageDigest); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(request.Env) > 0 {
 		updateBuildEnv(&newBuild.Spec.Strategy, request.Env)
 	}
@@ -290,7 +300,12 @@ func (g *BuildGenerator) updateImageTriggers(ctx kapi.Context, bc *buildapi.Buil
 			triggerImageRef = buildutil.GetImageStreamForStrategy(bc.Spec.Strategy)
 		}
 		if triggerImageRef == nil {
-			glog.Warningf("Could not get ImageStream reference for default ImageChangeTrigger on BuildConfig %s/%s", bc.Namespace, bc.Name)
+			// A Docker strategy without From is triggered by the image stream tag in the Dockerfile
+			image, err := g.resolveDockerfileImage(ctx, bc)
+			if err != nil {
+				return err
+			}
+			trigger.ImageChange.LastTriggeredImageID = image
 			continue
 		}
 		image, err := g.resolveImageStreamReference(ctx, *triggerImageRef, bc.Namespace)
@@ -348,6 +363,14 @@ func (g *BuildGenerator) createBuild(ctx kapi.Context, build *buildapi.Build) (*
 	}
 	kapi.FillObjectMetaSystemFields(ctx, &build.ObjectMeta)
 
+	if g.Admission != nil {
+		user, _ := kapi.UserFrom(ctx)
+		attributes := admission.NewAttributesRecord(build, buildapi.Kind("Build"), build.Namespace, build.Name, buildapi.Resource("builds"), "", admission.Create, user)
+		if err := g.Admission.Admit(attributes); err != nil {
+			return nil, err
+		}
+	}
+
 	err := g.Client.CreateBuild(ctx, build)
 	if err != nil {
 		return nil, err
@@ -461,14 +484,21 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		if build.Spec.Strategy.SourceStrategy.PullSecret == nil {
 			build.Spec.Strategy.SourceStrategy.PullSecret = g.resolveImageSecret(ctx, builderSecrets, &build.Spec.Strategy.SourceStrategy.From, bc.Namespace)
 		}
-	case build.Spec.Strategy.DockerStrategy != nil &&
-		build.Spec.Strategy.DockerStrategy.From != nil:
+	case build.Spec.Strategy.DockerStrategy != nil:
 		if image == "" {
-			image, err = g.resolveImageStreamReference(ctx, *build.Spec.Strategy.DockerStrategy.From, build.Status.Config.Namespace)
+			if build.Spec.Strategy.DockerStrategy.From != nil {
+				image, err = g.resolveImageStreamReference(ctx, *build.Spec.Strategy.DockerStrategy.From, build.Status.Config.Namespace)
+			} else {
+				image, err = g.resolveDockerfileImage(ctx, bc)
+			}
 			if err != nil {
 				return nil, err
 			}
 		}
+		if image == "" {
+			// the FROM of the Dockerfile is pulled as it is
+			break
+		}
 		build.Spec.Strategy.DockerStrategy.From = &kapi.ObjectReference{
 			Kind: "DockerImage",
 			Name: image,
@@ -492,9 +522,64 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		}
 		updateCustomImageEnv(build.Spec.Strategy.CustomStrategy, image)
 	}
+	if from := buildutil.GetImageStreamForStrategy(build.Spec.Strategy); from != nil {
+		build.Status.FromImageDigest = imageDigest(from.Name)
+	}
 	return build, nil
 }
 
+// pinStrategyImage runs the strategy of build from the image with digest, looked up in the image
+// stream or the repository that the strategy of the build config refers to.
+func (g *BuildGenerator) pinStrategyImage(ctx kapi.Context, bc *buildapi.BuildConfig, build *buildapi.Build, digest string) error {
+	configFrom := buildutil.GetImageStreamForStrategy(bc.Spec.Strategy)
+	buildFrom := buildutil.GetImageStreamForStrategy(build.Spec.Strategy)
+	if configFrom == nil || buildFrom == nil {
+		return errors.NewBadRequest(fmt.Sprintf("build config %s/%s has no strategy image to pin to %s", bc.Namespace, bc.Name, digest))
+	}
+
+	var image string
+	switch configFrom.Kind {
+	case "ImageStreamTag", "ImageStreamImage":
+		name := configFrom.Name
+		if i := strings.IndexAny(name, ":@"); i != -1 {
+			name = name[:i]
+		}
+		pinned := kapi.ObjectReference{Kind: "ImageStreamImage", Name: name + "@" + digest, Namespace: configFrom.Namespace}
+		resolved, err := g.resolveImageStreamReference(ctx, pinned, bc.Namespace)
+		if err != nil {
+			return err
+		}
+		image = resolved
+	case "DockerImage":
+		ref, err := imageapi.ParseDockerImageReference(configFrom.Name)
+		if err != nil {
+			return err
+		}
+		ref.Tag, ref.ID = "", digest
+		image = ref.Exact()
+	default:
+		return fmt.Errorf("Unknown From Kind %s", configFrom.Kind)
+	}
+
+	glog.V(4).Infof("Pinned the strategy image of build %s/%s to %s", bc.Namespace, build.Name, image)
+	buildFrom.Name = image
+	if build.Spec.Strategy.CustomStrategy != nil {
+		updateCustomImageEnv(build.Spec.Strategy.CustomStrategy, image)
+	}
+	build.Status.FromImageDigest = digest
+	return nil
+}
+
+// imageDigest returns the digest of a docker pull spec, or an empty string if the pull spec
+// refers to a tag.
+func imageDigest(spec string) string {
+	ref, err := imageapi.ParseDockerImageReference(spec)
+	if err != nil {
+		return ""
+	}
+	return ref.ID
+}
+
 // resolveImageStreamReference looks up the ImageStream[Tag/Image] and converts it to a
 // docker pull spec that can be used in an Image field.
 func (g *BuildGenerator) resolveImageStreamReference(ctx kapi.Context, from kapi.ObjectReference, defaultNamespace string) (string, error) {
@@ -538,6 +623,30 @@ func (g *BuildGenerator) resolveImageStreamReference(ctx kapi.Context, from kapi
 	}
 }
 
+// resolveDockerfileImage resolves the image stream tag named by the FROM instruction of the
+// Dockerfile of a Docker build config without a strategy From. The FROM is only an image stream
+// tag if the image stream exists; otherwise it is a Docker image, for instance from Docker Hub,
+// which the build pulls as it is, and an empty image is returned.
+func (g *BuildGenerator) resolveDockerfileImage(ctx kapi.Context, bc *buildapi.BuildConfig) (string, error) {
+	ref := buildutil.GetImageStreamForBuildSpec(bc.Spec.BuildSpec)
+	if ref == nil || bc.Spec.Strategy.DockerStrategy == nil || bc.Spec.Strategy.DockerStrategy.From != nil {
+		return "", nil
+	}
+	namespace := bc.Namespace
+	if len(ref.Namespace) != 0 {
+		namespace = ref.Namespace
+	}
+	name, _, _ := imageapi.SplitImageStreamTag(ref.Name)
+	if _, err := g.Client.GetImageStream(kapi.WithNamespace(ctx, namespace), name); err != nil {
+		if errors.IsNotFound(err) {
+			glog.V(2).Infof("Dockerfile FROM of BuildConfig %s/%s names no image stream %s/%s and is pulled as it is", bc.Namespace, bc.Name, namespace, name)
+			return "", nil
+		}
+		return "", err
+	}
+	return g.resolveImageStreamReference(ctx, *ref, bc.Namespace)
+}
+
 // resolveImageStreamDockerRepository looks up the ImageStream[Tag/Image] and converts it to a
 // the docker repository reference with no tag information
 func (g *BuildGenerator) resolveImageStreamDockerRepository(ctx kapi.Context, from kapi.ObjectReference, defaultNamespace string) (string, error) {
@@ -653,8 +762,9 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 			Annotations: buildCopy.ObjectMeta.Annotations,
 		},
 		Status: buildapi.BuildStatus{
-			Phase:  buildapi.BuildPhaseNew,
-			Config: buildCopy.Status.Config,
+			Phase:           buildapi.BuildPhaseNew,
+			Config:          buildCopy.Status.Config,
+			FromImageDigest: buildCopy.Status.FromImageDigest,
 		},
 	}
 	if newBuild.Annotations == nil {
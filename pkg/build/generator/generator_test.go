@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
 
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
@@ -309,6 +311,115 @@ func TestInstantiateWithImageTrigger(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithDockerfileImageTrigger(t *testing.T) {
+	imageID := "the-image-id-12345"
+	dockerfile := "FROM image3:tag3\nRUN make"
+	bc := &buildapi.BuildConfig{
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Dockerfile: &dockerfile,
+				},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{},
+				},
+			},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{
+					Type:        buildapi.ImageChangeBuildTriggerType,
+					ImageChange: &buildapi.ImageChangeTrigger{},
+				},
+			},
+		},
+	}
+	var build *buildapi.Build
+	generator := mockBuildGeneratorForInstantiate()
+	client := generator.Client.(Client)
+	client.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		return bc, nil
+	}
+	client.CreateBuildFunc = func(ctx kapi.Context, newBuild *buildapi.Build) error {
+		build = newBuild
+		return nil
+	}
+	generator.Client = client
+
+	req := &buildapi.BuildRequest{
+		TriggeredByImage: &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: imageID,
+		},
+		From: &kapi.ObjectReference{
+			Kind: "ImageStreamTag",
+			Name: "image3:tag3",
+		},
+	}
+	if _, err := generator.Instantiate(kapi.NewDefaultContext(), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bc.Spec.Triggers[0].ImageChange.LastTriggeredImageID != imageID {
+		t.Errorf("expected the trigger to contain imageID %s, got %s", imageID, bc.Spec.Triggers[0].ImageChange.LastTriggeredImageID)
+	}
+	if from := build.Spec.Strategy.DockerStrategy.From; from == nil || from.Kind != "DockerImage" || from.Name != imageID {
+		t.Errorf("expected the build to use the triggering image %s, got %#v", imageID, from)
+	}
+}
+
+func TestInstantiateWithDockerfileImage(t *testing.T) {
+	tests := map[string]struct {
+		dockerfile string
+		expected   *kapi.ObjectReference
+	}{
+		"image stream tag": {
+			dockerfile: "FROM image3:tag3\nRUN make",
+			expected:   &kapi.ObjectReference{Kind: "DockerImage", Name: "ref@image3:tag3"},
+		},
+		"docker hub image": {
+			dockerfile: "FROM centos:7\nRUN make",
+		},
+	}
+	for name, test := range tests {
+		dockerfile := test.dockerfile
+		bc := &buildapi.BuildConfig{
+			Spec: buildapi.BuildConfigSpec{
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Dockerfile: &dockerfile,
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+				},
+			},
+		}
+		var build *buildapi.Build
+		generator := mockBuildGeneratorForInstantiate()
+		client := generator.Client.(Client)
+		client.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+			return bc, nil
+		}
+		client.GetImageStreamFunc = func(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+			if name != "image3" {
+				return nil, errors.NewNotFound("ImageStream", name)
+			}
+			return &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: name}}, nil
+		}
+		client.CreateBuildFunc = func(ctx kapi.Context, newBuild *buildapi.Build) error {
+			build = newBuild
+			return nil
+		}
+		generator.Client = client
+
+		if _, err := generator.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{}); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if from := build.Spec.Strategy.DockerStrategy.From; !reflect.DeepEqual(from, test.expected) {
+			t.Errorf("%s: expected the build to use %#v, got %#v", name, test.expected, from)
+		}
+	}
+}
+
 func TestInstantiateWithLastVersion(t *testing.T) {
 	g := mockBuildGenerator()
 	c := g.Client.(Client)
@@ -340,6 +451,72 @@ func TestInstantiateWithLastVersion(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithFromImageDigest(t *testing.T) {
+	digest := "sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238"
+	g := mockBuildGenerator()
+	c := g.Client.(Client)
+	c.GetImageStreamTagFunc = func(ctx kapi.Context, name string) (*imageapi.ImageStreamTag, error) {
+		return &imageapi.ImageStreamTag{
+			Image: imageapi.Image{DockerImageReference: "registry/testns/testRepo@sha256:latest"},
+		}, nil
+	}
+	var requested string
+	c.GetImageStreamImageFunc = func(ctx kapi.Context, name string) (*imageapi.ImageStreamImage, error) {
+		requested = name
+		return &imageapi.ImageStreamImage{
+			Image: imageapi.Image{DockerImageReference: "registry/testns/testRepo@" + digest},
+		}, nil
+	}
+	var build *buildapi.Build
+	c.CreateBuildFunc = func(ctx kapi.Context, created *buildapi.Build) error {
+		build = created
+		return nil
+	}
+	g.Client = c
+
+	_, err := g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.FromImageDigest != "sha256:latest" {
+		t.Errorf("Expected the digest of the resolved image to be recorded, got %q", build.Status.FromImageDigest)
+	}
+
+	_, err = g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{FromImageDigest: digest})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e := imageRepoName + "@" + digest; requested != e {
+		t.Errorf("Expected the image stream image %s to be resolved, got %s", e, requested)
+	}
+	if e, a := "registry/testns/testRepo@"+digest, build.Spec.Strategy.SourceStrategy.From.Name; e != a {
+		t.Errorf("Expected the strategy to be pinned to %s, got %s", e, a)
+	}
+	if build.Status.FromImageDigest != digest {
+		t.Errorf("Expected the pinned digest to be recorded, got %q", build.Status.FromImageDigest)
+	}
+
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		return mocks.MockBuildConfig(mocks.MockSource(), mockDockerStrategyForDockerImage("centos:7"), mocks.MockOutput()), nil
+	}
+	g.Client = c
+	_, err = g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{FromImageDigest: digest})
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := "centos@"+digest, build.Spec.Strategy.DockerStrategy.From.Name; e != a {
+		t.Errorf("Expected the strategy to be pinned to %s, got %s", e, a)
+	}
+
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		return mocks.MockBuildConfig(mocks.MockSource(), mockDockerStrategyForNilImage(), mocks.MockOutput()), nil
+	}
+	g.Client = c
+	if _, err := g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{FromImageDigest: digest}); !errors.IsBadRequest(err) {
+		t.Errorf("Expected a bad request without a strategy image, got %v", err)
+	}
+}
+
 func TestFindImageTrigger(t *testing.T) {
 	defaultTrigger := &buildapi.ImageChangeTrigger{}
 	image1Trigger := &buildapi.ImageChangeTrigger{
@@ -566,6 +743,60 @@ func TestCreateBuildCreateError(t *testing.T) {
 	}
 }
 
+// fakeAdmission records the builds it admits and rejects those with a completion deadline
+type fakeAdmission struct {
+	admitted []string
+}
+
+func (a *fakeAdmission) Admit(attributes admission.Attributes) error {
+	build := attributes.GetObject().(*buildapi.Build)
+	a.admitted = append(a.admitted, build.Name)
+	if build.Spec.CompletionDeadlineSeconds != nil {
+		return admission.NewForbidden(attributes, fmt.Errorf("deadline-error"))
+	}
+	return nil
+}
+
+func (a *fakeAdmission) Handles(operation admission.Operation) bool {
+	return operation == admission.Create
+}
+
+func TestCreateBuildAdmission(t *testing.T) {
+	created := false
+	admit := &fakeAdmission{}
+	generator := BuildGenerator{
+		Client: Client{
+			CreateBuildFunc: func(ctx kapi.Context, build *buildapi.Build) error {
+				created = true
+				return nil
+			},
+			GetBuildFunc: func(ctx kapi.Context, name string) (*buildapi.Build, error) {
+				return &buildapi.Build{}, nil
+			},
+		},
+		Admission: admit,
+	}
+
+	build := &buildapi.Build{ObjectMeta: kapi.ObjectMeta{Name: "test-build", Namespace: kapi.NamespaceDefault}}
+	if _, err := generator.createBuild(kapi.NewDefaultContext(), build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !created || !reflect.DeepEqual(admit.admitted, []string{"test-build"}) {
+		t.Errorf("Expected the build to be admitted and created, admitted %v, created %t", admit.admitted, created)
+	}
+
+	created = false
+	seconds := int64(60)
+	build = &buildapi.Build{ObjectMeta: kapi.ObjectMeta{Name: "rejected-build", Namespace: kapi.NamespaceDefault}}
+	build.Spec.CompletionDeadlineSeconds = &seconds
+	if _, err := generator.createBuild(kapi.NewDefaultContext(), build); err == nil || !strings.Contains(err.Error(), "deadline-error") {
+		t.Errorf("Expected deadline-error, got %v", err)
+	}
+	if created {
+		t.Errorf("Expected the rejected build not to be created")
+	}
+}
+
 func TestGenerateBuildFromConfig(t *testing.T) {
 	source := mocks.MockSource()
 	strategy := mockDockerStrategyForDockerImage(originalImage)
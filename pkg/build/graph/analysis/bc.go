@@ -9,6 +9,7 @@ import (
 	"github.com/gonum/graph/topo"
 
 	osgraph "github.com/openshift/origin/pkg/api/graph"
+	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildedges "github.com/openshift/origin/pkg/build/graph"
 	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
@@ -22,6 +23,7 @@ const (
 	MissingRequiredRegistryErr = "MissingRequiredRegistry"
 	MissingImageStreamErr      = "MissingImageStream"
 	CyclicBuildConfigWarning   = "CyclicBuildConfig"
+	MissingSecretWarning       = "MissingSecret"
 )
 
 // FindUnpushableBuildConfigs checks all build configs that will output to an IST backed by an ImageStream and checks to make sure their builds can push.
@@ -43,6 +45,7 @@ bc:
 						Key:      MissingImageStreamErr,
 						Message: fmt.Sprintf("%s is pushing to %s that is using %s, but that image stream does not exist.",
 							f.ResourceName(bcNode), f.ResourceName(istNode), f.ResourceName(imageStreamNode)),
+						Suggestion: osgraph.Suggestion(fmt.Sprintf("oc create imagestream %s -n %s", imageStreamNode.Name, imageStreamNode.Namespace)),
 					})
 
 					continue
@@ -72,8 +75,8 @@ bc:
 func FindCircularBuilds(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
 	// Filter out all but ImageStreamTag and BuildConfig nodes
 	nodeFn := osgraph.NodesOfKind(imagegraph.ImageStreamTagNodeKind, buildgraph.BuildConfigNodeKind)
-	// Filter out all but BuildInputImage and BuildOutput edges
-	edgeFn := osgraph.EdgesOfKind(buildedges.BuildInputImageEdgeKind, buildedges.BuildOutputEdgeKind)
+	// Filter out all but BuildInputImage, BuildTriggerImage, and BuildOutput edges
+	edgeFn := osgraph.EdgesOfKind(buildedges.BuildInputImageEdgeKind, buildedges.BuildTriggerImageEdgeKind, buildedges.BuildOutputEdgeKind)
 
 	// Create desired subgraph
 	sub := g.Subgraph(nodeFn, edgeFn)
@@ -87,20 +90,86 @@ func FindCircularBuilds(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
 			nodeNames = append(nodeNames, f.ResourceName(node))
 		}
 
-		markers = append(markers, osgraph.Marker{
+		marker := osgraph.Marker{
 			Node:         cycle[0],
 			RelatedNodes: cycle,
 
 			Severity: osgraph.WarningSeverity,
 			Key:      CyclicBuildConfigWarning,
 			Message:  fmt.Sprintf("Cycle detected in build configurations: %s", strings.Join(nodeNames, " -> ")),
-		})
+		}
+
+		// a trigger in the cycle makes every build start the next one, forever
+		for i := 0; i+1 < len(cycle); i++ {
+			istNode, isTag := cycle[i].(*imagegraph.ImageStreamTagNode)
+			bcNode, isBuildConfig := cycle[i+1].(*buildgraph.BuildConfigNode)
+			if !isTag || !isBuildConfig {
+				continue
+			}
+			if edge := g.Edge(istNode, bcNode); edge == nil || !g.EdgeKinds(edge).Has(buildedges.BuildTriggerImageEdgeKind) {
+				continue
+			}
+			from := istNode.Name
+			if istNode.Namespace != bcNode.BuildConfig.Namespace {
+				from = istNode.Namespace + "/" + from
+			}
+			marker.Message = fmt.Sprintf("Cycle detected in build configurations: %s. The builds will trigger each other indefinitely.", strings.Join(nodeNames, " -> "))
+			marker.Suggestion = osgraph.Suggestion(fmt.Sprintf("oc set triggers %s --from-image=%s --remove", f.ResourceName(bcNode), from))
+			break
+		}
+
+		markers = append(markers, marker)
+	}
+
+	return markers
+}
 
+// FindMissingSecrets checks all build configs for secrets that their builds use but that do not exist.
+func FindMissingSecrets(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastBcNode := range g.NodesByKind(buildgraph.BuildConfigNodeKind) {
+		bcNode := uncastBcNode.(*buildgraph.BuildConfigNode)
+		for _, uncastSecretNode := range g.SuccessorNodesByNodeAndEdgeKind(bcNode, kubegraph.SecretNodeKind, buildedges.BuildSecretEdgeKind) {
+			secretNode := uncastSecretNode.(*kubegraph.SecretNode)
+			if secretNode.Found() {
+				continue
+			}
+
+			markers = append(markers, osgraph.Marker{
+				Node:         bcNode,
+				RelatedNodes: []graph.Node{secretNode},
+
+				Severity:   osgraph.WarningSeverity,
+				Key:        MissingSecretWarning,
+				Message:    fmt.Sprintf("%s uses %s, but that secret does not exist. Its builds will fail.", f.ResourceName(bcNode), f.ResourceName(secretNode)),
+				Suggestion: missingSecretSuggestion(bcNode.BuildConfig, secretNode.Name),
+			})
+		}
 	}
 
 	return markers
 }
 
+// missingSecretSuggestion returns the command that creates the kind of secret the build config
+// expects to find under name.
+func missingSecretSuggestion(bc *buildapi.BuildConfig, name string) osgraph.Suggestion {
+	source := bc.Spec.Source
+	if source.SourceSecret != nil && source.SourceSecret.Name == name {
+		if source.Git != nil && (strings.HasPrefix(source.Git.URI, "http://") || strings.HasPrefix(source.Git.URI, "https://")) {
+			return osgraph.Suggestion(fmt.Sprintf("oc secrets new-basicauth %s --username=USERNAME --password=PASSWORD", name))
+		}
+		return osgraph.Suggestion(fmt.Sprintf("oc secrets new-sshauth %s --ssh-privatekey=~/.ssh/id_rsa", name))
+	}
+	for _, secret := range source.Secrets {
+		if secret.Secret.Name == name {
+			return osgraph.Suggestion(fmt.Sprintf("oc secrets new %s FILE...", name))
+		}
+	}
+	// the remaining secrets authenticate to image registries
+	return osgraph.Suggestion(fmt.Sprintf("oc secrets new-dockercfg %s --docker-server=DOCKER_REGISTRY_SERVER --docker-username=DOCKER_USER --docker-password=DOCKER_PASSWORD --docker-email=DOCKER_EMAIL", name))
+}
+
 // FindPendingTags inspects all imageStreamTags that serve as outputs to builds.
 //
 // Precedence of failures:
@@ -1,10 +1,12 @@
 package analysis
 
 import (
+	"strings"
 	"testing"
 
 	osgraph "github.com/openshift/origin/pkg/api/graph"
 	osgraphtest "github.com/openshift/origin/pkg/api/graph/test"
+	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
 	buildedges "github.com/openshift/origin/pkg/build/graph"
 	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
 	imageedges "github.com/openshift/origin/pkg/image/graph"
@@ -91,9 +93,14 @@ func TestCircularDeps(t *testing.T) {
 	}
 	buildedges.AddAllInputOutputEdges(g)
 
-	if len(FindCircularBuilds(g, osgraph.DefaultNamer)) != 1 {
+	markers := FindCircularBuilds(g, osgraph.DefaultNamer)
+	if len(markers) != 1 {
 		t.Fatalf("expected having circular dependencies")
 	}
+	// the image change triggers of the build configs close the cycle
+	if len(markers[0].Suggestion) == 0 {
+		t.Errorf("expected a suggestion to remove a trigger: %#v", markers[0])
+	}
 
 	not, _, err := osgraphtest.BuildGraph("../../../api/graph/test/circular-not.yaml")
 	if err != nil {
@@ -149,3 +156,36 @@ func TestLatestBuildFailed(t *testing.T) {
 		t.Fatalf("expected marker key %q, got %q", expected, got)
 	}
 }
+
+func TestMissingSecrets(t *testing.T) {
+	g, _, err := osgraphtest.BuildGraph("../../../api/graph/test/bc-missing-secrets.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	buildedges.AddAllSecretEdges(g)
+
+	markers := FindMissingSecrets(g, osgraph.DefaultNamer)
+	if e, a := 3, len(markers); e != a {
+		t.Fatalf("expected %v, got %v: %#v", e, a, markers)
+	}
+
+	expected := map[string]string{
+		"github":   "oc secrets new-basicauth github",
+		"quay":     "oc secrets new-dockercfg quay",
+		"settings": "oc secrets new settings",
+	}
+	for _, marker := range markers {
+		if got, expected := marker.Key, MissingSecretWarning; got != expected {
+			t.Errorf("expected marker key %q, got %q", expected, got)
+		}
+		secretNode := marker.RelatedNodes[0].(*kubegraph.SecretNode)
+		suggestion, ok := expected[secretNode.Name]
+		if !ok {
+			t.Errorf("unexpected missing secret %s", secretNode.Name)
+			continue
+		}
+		if !strings.HasPrefix(string(marker.Suggestion), suggestion) {
+			t.Errorf("%s: expected suggestion %q, got %q", secretNode.Name, suggestion, marker.Suggestion)
+		}
+	}
+}
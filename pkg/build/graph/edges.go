@@ -5,6 +5,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 
 	osgraph "github.com/openshift/origin/pkg/api/graph"
+	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
 	buildutil "github.com/openshift/origin/pkg/build/util"
@@ -34,6 +35,10 @@ const (
 
 	// BuildEdgeKind goes from a BuildConfigNode to a BuildNode and indicates that the buildConfig owns the build
 	BuildEdgeKind = "Build"
+
+	// BuildSecretEdgeKind is an edge from a BuildConfig to a Secret that its builds use to pull or
+	// push images, to fetch the source repository, or as part of the build input.
+	BuildSecretEdgeKind = "BuildSecret"
 )
 
 // AddBuildEdges adds edges that connect a BuildConfig to Builds to the given graph
@@ -108,7 +113,7 @@ func AddTriggerEdges(g osgraph.MutableUniqueGraph, node *buildgraph.BuildConfigN
 		}
 		from := trigger.ImageChange.From
 		if trigger.ImageChange.From == nil {
-			from = buildutil.GetImageStreamForStrategy(node.BuildConfig.Spec.Strategy)
+			from = buildutil.GetImageStreamForBuildSpec(node.BuildConfig.Spec.BuildSpec)
 		}
 		triggerNode := imageRefNode(g, from, node.BuildConfig)
 		g.AddEdge(triggerNode, node, BuildTriggerImageEdgeKind)
@@ -131,3 +136,24 @@ func AddAllInputOutputEdges(g osgraph.MutableUniqueGraph) {
 		}
 	}
 }
+
+// AddSecretEdges links the build config to the secrets its builds use.
+func AddSecretEdges(g osgraph.MutableUniqueGraph, node *buildgraph.BuildConfigNode) {
+	for _, name := range buildutil.GetSecretNames(&node.BuildConfig.Spec.BuildSpec) {
+		syntheticSecret := &kapi.Secret{}
+		syntheticSecret.Namespace = node.BuildConfig.Namespace
+		syntheticSecret.Name = name
+
+		secretNode := kubegraph.FindOrCreateSyntheticSecretNode(g, syntheticSecret)
+		g.AddEdge(node, secretNode, BuildSecretEdgeKind)
+	}
+}
+
+// AddAllSecretEdges adds secret edges to all BuildConfig nodes in the given graph
+func AddAllSecretEdges(g osgraph.MutableUniqueGraph) {
+	for _, node := range g.(graph.Graph).Nodes() {
+		if bcNode, ok := node.(*buildgraph.BuildConfigNode); ok {
+			AddSecretEdges(g, bcNode)
+		}
+	}
+}
@@ -17,8 +17,10 @@ type REST struct {
 	*etcdgeneric.Etcd
 }
 
-// NewStorage returns a RESTStorage object that will work against nodes.
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a RESTStorage object that will work against build configs. The webhook URLs
+// of the build configs are relative to webHookAPIURL, the public URL of the API version serving
+// the webhooks.
+func NewREST(s storage.Interface, webHookAPIURL string) *REST {
 	prefix := "/buildconfigs"
 
 	store := &etcdgeneric.Etcd{
@@ -41,6 +43,7 @@ func NewREST(s storage.Interface) *REST {
 		CreateStrategy:      buildconfig.Strategy,
 		UpdateStrategy:      buildconfig.Strategy,
 		DeleteStrategy:      buildconfig.Strategy,
+		Decorator:           buildconfig.NewWebHookURLDecorator(webHookAPIURL),
 		ReturnDeletedObject: false,
 		Storage:             s,
 	}
@@ -16,7 +16,7 @@ import (
 
 func newStorage(t *testing.T) (*REST, *etcdtesting.EtcdTestServer) {
 	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
-	storage := NewREST(etcdStorage)
+	storage := NewREST(etcdStorage, "https://localhost:8443/oapi/v1")
 	return storage, server
 }
 
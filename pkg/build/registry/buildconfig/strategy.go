@@ -40,6 +40,8 @@ func (strategy) AllowUnconditionalUpdate() bool {
 func (strategy) PrepareForCreate(obj runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	// the webhook URLs are set when the build config is read
+	bc.Status.WebHookURLs = nil
 }
 
 // Canonicalize normalizes the object after validation.
@@ -50,6 +52,8 @@ func (strategy) Canonicalize(obj runtime.Object) {
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	// the webhook URLs are set when the build config is read
+	bc.Status.WebHookURLs = nil
 }
 
 // Validate validates a new policy.
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/client"
@@ -14,11 +17,12 @@ import (
 	"github.com/openshift/origin/pkg/util/rest"
 )
 
-func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, plugins map[string]webhook.Plugin) *rest.WebHook {
+func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, plugins map[string]webhook.Plugin, limiter *webhook.Limiter) *rest.WebHook {
 	controller := &controller{
 		registry:     registry,
 		instantiator: instantiator,
 		plugins:      plugins,
+		limiter:      limiter,
 	}
 	return rest.NewWebHook(controller, false)
 }
@@ -27,6 +31,7 @@ type controller struct {
 	registry     Registry
 	instantiator client.BuildConfigInstantiator
 	plugins      map[string]webhook.Plugin
+	limiter      *webhook.Limiter
 }
 
 // ServeHTTP implements rest.HookHandler
@@ -62,12 +67,69 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kap
 		return nil
 	}
 
+	// the delivery is only remembered once it started a build, so that a rejected delivery can be retried
+	deliveryID := webhook.DeliveryID(req)
+	if c.limiter.Replayed(config.Namespace, name, deliveryID) {
+		return errors.NewConflict("BuildConfigHook", name, fmt.Errorf("the delivery %q was already received", deliveryID))
+	}
+	if !c.limiter.Allow(config.Namespace, name) {
+		c.limiter.Forget(config.Namespace, name, deliveryID)
+		return newTooManyRequests(name, c.limiter.Interval())
+	}
+
 	request := &buildapi.BuildRequest{
 		ObjectMeta: kapi.ObjectMeta{Name: name},
 		Revision:   revision,
 	}
 	if _, err := c.instantiator.Instantiate(config.Namespace, request); err != nil {
+		c.limiter.Forget(config.Namespace, name, deliveryID)
 		return errors.NewInternalError(fmt.Errorf("could not generate a build: %v", err))
 	}
 	return nil
 }
+
+// newTooManyRequests returns the error for a webhook delivery rejected by the rate limit of the
+// build config, asking the sender to retry after interval.
+func newTooManyRequests(name string, interval time.Duration) error {
+	return &errors.StatusError{ErrStatus: unversioned.Status{
+		Status:  unversioned.StatusFailure,
+		Code:    errors.StatusTooManyRequests,
+		Reason:  webhook.StatusReasonTooManyRequests,
+		Message: fmt.Sprintf("too many webhook deliveries for %q, try again later", name),
+		Details: &unversioned.StatusDetails{
+			Kind:              "BuildConfigHook",
+			Name:              name,
+			RetryAfterSeconds: int32(interval / time.Second),
+		},
+	}}
+}
+
+// NewWebHookURLDecorator returns a decorator that sets the URLs of the webhook triggers of a build
+// config in its status. apiURL is the public URL of the API version serving the webhooks, for
+// example https://master:8443/oapi/v1. The secrets of the triggers are not part of the URLs.
+func NewWebHookURLDecorator(apiURL string) func(runtime.Object) error {
+	apiURL = strings.TrimRight(apiURL, "/")
+	return func(obj runtime.Object) error {
+		config, ok := obj.(*buildapi.BuildConfig)
+		if !ok {
+			return errors.NewBadRequest(fmt.Sprintf("not a build config: %v", obj))
+		}
+		config.Status.WebHookURLs = nil
+		for _, trigger := range config.Spec.Triggers {
+			var hookType string
+			switch trigger.Type {
+			case buildapi.GitHubWebHookBuildTriggerType:
+				hookType = "github"
+			case buildapi.GenericWebHookBuildTriggerType:
+				hookType = "generic"
+			default:
+				continue
+			}
+			config.Status.WebHookURLs = append(config.Status.WebHookURLs, buildapi.WebHookTriggerURL{
+				Type: trigger.Type,
+				URL:  fmt.Sprintf("%s/namespaces/%s/buildconfigs/%s/webhooks/<secret>/%s", apiURL, config.Namespace, config.Name, hookType),
+			})
+		}
+		return nil
+	}
+}
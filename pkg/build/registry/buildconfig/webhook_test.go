@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
@@ -40,6 +41,10 @@ func (p *plugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *ht
 }
 
 func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigRegistry) {
+	return newStorageWithLimiter(webhook.NewLimiter(0, 0, false))
+}
+
+func newStorageWithLimiter(limiter *webhook.Limiter) (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigRegistry) {
 	mockRegistry := &test.BuildConfigRegistry{}
 	bci := &buildConfigInstantiator{}
 	hook := NewWebHookREST(mockRegistry, bci, map[string]webhook.Plugin{
@@ -47,7 +52,7 @@ func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigReg
 		"errsecret": &plugin{Err: webhook.ErrSecretMismatch},
 		"errhook":   &plugin{Err: webhook.ErrHookNotEnabled},
 		"err":       &plugin{Err: fmt.Errorf("test error")},
-	})
+	}, limiter)
 	return hook, bci, mockRegistry
 }
 
@@ -165,3 +170,88 @@ func TestConnectWebHook(t *testing.T) {
 		}
 	}
 }
+
+func TestConnectWebHookLimits(t *testing.T) {
+	hook, bci, registry := newStorageWithLimiter(webhook.NewLimiter(2, time.Hour, true))
+	registry.BuildConfig = &api.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	deliveries := []struct {
+		deliveryID string
+		errFn      func(error) bool
+	}{
+		{deliveryID: "1", errFn: func(err error) bool { return err == nil }},
+		{deliveryID: "1", errFn: errors.IsConflict},
+		{errFn: func(err error) bool { return err == nil }},
+		{deliveryID: "2", errFn: isTooManyRequests},
+		// a rate limited delivery is not remembered, so its retry is not a replay
+		{deliveryID: "2", errFn: isTooManyRequests},
+	}
+	for i, delivery := range deliveries {
+		bci.Request = nil
+		responder := &fakeResponder{}
+		handler, err := hook.Connect(kapi.NewDefaultContext(), "test", &kapi.PodProxyOptions{Path: "secret/ok"}, responder)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req := &http.Request{Header: http.Header{}}
+		if len(delivery.deliveryID) > 0 {
+			req.Header.Set(webhook.DeliveryIDHeader, delivery.deliveryID)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if !delivery.errFn(responder.err) {
+			t.Errorf("%d: unexpected error: %v", i, responder.err)
+		}
+		if (responder.err == nil) != (bci.Request != nil) {
+			t.Errorf("%d: expected a build only for an accepted delivery", i)
+		}
+	}
+}
+
+func isTooManyRequests(err error) bool {
+	status, ok := err.(*errors.StatusError)
+	return ok && status.ErrStatus.Code == errors.StatusTooManyRequests && status.ErrStatus.Reason == webhook.StatusReasonTooManyRequests && status.ErrStatus.Details.RetryAfterSeconds == 3600
+}
+
+func TestConnectWebHookFailedInstantiateIsNotReplay(t *testing.T) {
+	hook, bci, registry := newStorageWithLimiter(webhook.NewLimiter(0, 0, true))
+	registry.BuildConfig = &api.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	for i, instantiateErr := range []error{fmt.Errorf("failed"), nil} {
+		bci.Err = instantiateErr
+		responder := &fakeResponder{}
+		handler, err := hook.Connect(kapi.NewDefaultContext(), "test", &kapi.PodProxyOptions{Path: "secret/ok"}, responder)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req := &http.Request{Header: http.Header{}}
+		req.Header.Set(webhook.DeliveryIDHeader, "1")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		if (responder.err == nil) != (instantiateErr == nil) {
+			t.Errorf("%d: unexpected error: %v", i, responder.err)
+		}
+	}
+}
+
+func TestWebHookURLDecorator(t *testing.T) {
+	config := &api.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "app"},
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{Type: api.GitHubWebHookBuildTriggerType, GitHubWebHook: &api.WebHookTrigger{Secret: "secret101"}},
+				{Type: api.ConfigChangeBuildTriggerType},
+				{Type: api.GenericWebHookBuildTriggerType, GenericWebHook: &api.WebHookTrigger{Secret: "secret102"}},
+			},
+		},
+		Status: api.BuildConfigStatus{WebHookURLs: []api.WebHookTriggerURL{{URL: "stale"}}},
+	}
+	if err := NewWebHookURLDecorator("https://master:8443/oapi/v1/")(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []api.WebHookTriggerURL{
+		{Type: api.GitHubWebHookBuildTriggerType, URL: "https://master:8443/oapi/v1/namespaces/test/buildconfigs/app/webhooks/<secret>/github"},
+		{Type: api.GenericWebHookBuildTriggerType, URL: "https://master:8443/oapi/v1/namespaces/test/buildconfigs/app/webhooks/<secret>/generic"},
+	}
+	if !kapi.Semantic.DeepEqual(config.Status.WebHookURLs, expected) {
+		t.Errorf("expected %#v, got %#v", expected, config.Status.WebHookURLs)
+	}
+}
@@ -0,0 +1,76 @@
+package buildconfighistory
+
+import (
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildregistry "github.com/openshift/origin/pkg/build/registry/build"
+	buildconfigregistry "github.com/openshift/origin/pkg/build/registry/buildconfig"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// REST summarizes the builds of a build config, so that clients like the web console can chart
+// their outcome and duration with a single request instead of watching every build.
+type REST struct {
+	buildConfigs buildconfigregistry.Registry
+	builds       buildregistry.Registry
+}
+
+var _ = rest.Getter(&REST{})
+
+// NewREST returns a REST summarizing the builds of builds by the build configs of buildConfigs.
+func NewREST(buildConfigs buildconfigregistry.Registry, builds buildregistry.Registry) *REST {
+	return &REST{buildConfigs: buildConfigs, builds: builds}
+}
+
+// New returns an empty build history.
+func (r *REST) New() runtime.Object {
+	return &buildapi.BuildHistory{}
+}
+
+// Get returns the history of the build config with name, newest build first.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	config, err := r.buildConfigs.GetBuildConfig(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	builds := []buildapi.Build{}
+	for _, selector := range []unversioned.LabelSelector{
+		{Selector: buildutil.BuildConfigSelector(name)},
+		{Selector: buildutil.BuildConfigSelectorDeprecated(name)},
+	} {
+		list, err := r.builds.ListBuilds(ctx, &unversioned.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		builds = append(builds, list.Items...)
+	}
+	sort.Sort(sort.Reverse(buildapi.BuildSliceByCreationTimestamp(builds)))
+
+	history := &buildapi.BuildHistory{
+		ObjectMeta: config.ObjectMeta,
+		Items:      []buildapi.BuildSummary{},
+	}
+	seen := map[string]bool{}
+	for _, build := range builds {
+		// builds with both the current and the deprecated label are listed twice
+		if seen[build.Name] {
+			continue
+		}
+		seen[build.Name] = true
+		history.Items = append(history.Items, buildapi.BuildSummary{
+			Name:                build.Name,
+			Phase:               build.Status.Phase,
+			StartTimestamp:      build.Status.StartTimestamp,
+			CompletionTimestamp: build.Status.CompletionTimestamp,
+			Duration:            build.Status.Duration,
+		})
+	}
+	return history, nil
+}
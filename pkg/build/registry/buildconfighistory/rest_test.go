@@ -0,0 +1,58 @@
+package buildconfighistory
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/registry/test"
+)
+
+func testBuild(name string, created time.Time, phase buildapi.BuildPhase, duration time.Duration) buildapi.Build {
+	return buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Name: name, CreationTimestamp: unversioned.NewTime(created)},
+		Status:     buildapi.BuildStatus{Phase: phase, Duration: duration},
+	}
+}
+
+func TestGetHistory(t *testing.T) {
+	now := time.Now()
+	configs := &test.BuildConfigRegistry{BuildConfig: &buildapi.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "app", Namespace: "test"}}}
+	builds := &test.BuildRegistry{Builds: &buildapi.BuildList{Items: []buildapi.Build{
+		testBuild("app-1", now.Add(-2*time.Hour), buildapi.BuildPhaseFailed, time.Minute),
+		testBuild("app-3", now, buildapi.BuildPhaseRunning, 0),
+		testBuild("app-2", now.Add(-time.Hour), buildapi.BuildPhaseComplete, 2*time.Minute),
+	}}}
+
+	obj, err := NewREST(configs, builds).Get(kapi.NewDefaultContext(), "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	history := obj.(*buildapi.BuildHistory)
+	if history.Name != "app" || history.Namespace != "test" {
+		t.Errorf("expected the metadata of the build config, got %#v", history.ObjectMeta)
+	}
+	if len(history.Items) != 3 {
+		t.Fatalf("expected each build to be summarized once, got %#v", history.Items)
+	}
+	for i, name := range []string{"app-3", "app-2", "app-1"} {
+		if history.Items[i].Name != name {
+			t.Errorf("expected build %s at %d, got %s", name, i, history.Items[i].Name)
+		}
+	}
+	if summary := history.Items[1]; summary.Phase != buildapi.BuildPhaseComplete || summary.Duration != 2*time.Minute {
+		t.Errorf("unexpected summary: %#v", summary)
+	}
+}
+
+func TestGetHistoryMissingConfig(t *testing.T) {
+	configs := &test.BuildConfigRegistry{Err: kerrors.NewNotFound("buildconfig", "app")}
+	_, err := NewREST(configs, &test.BuildRegistry{}).Get(kapi.NewDefaultContext(), "app")
+	if !kerrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -3,6 +3,8 @@ package buildconfiginstantiate
 import (
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"reflect"
 	"time"
@@ -18,6 +20,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/pod"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/httpstream/spdy"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/generator"
@@ -108,7 +111,18 @@ var _ http.Handler = &binaryInstantiateHandler{}
 
 func (h *binaryInstantiateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer r.Body.Close()
-	build, err := h.handle(r.Body)
+	var in io.Reader = r.Body
+	withArchive := false
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil && mediaType == "multipart/form-data" {
+		parts, err := r.MultipartReader()
+		if err != nil {
+			h.responder.Error(errors.NewBadRequest(fmt.Sprintf("unable to read the multipart upload: %v", err)))
+			return
+		}
+		in = binaryPartsReader(parts)
+		withArchive = true
+	}
+	build, err := h.handle(in, withArchive)
 	if err != nil {
 		h.responder.Error(err)
 		return
@@ -116,12 +130,59 @@ func (h *binaryInstantiateHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	h.responder.Object(http.StatusCreated, build)
 }
 
-func (h *binaryInstantiateHandler) handle(r io.Reader) (runtime.Object, error) {
+// binaryPartsReader returns the parts of a multipart binary upload encoded as a new multipart
+// stream that starts with its boundary, as expected by the builder. Only a file part and an
+// archive part are accepted, and the file part is required.
+func binaryPartsReader(parts *multipart.Reader) io.Reader {
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(copyBinaryParts(w, parts))
+	}()
+	return r
+}
+
+func copyBinaryParts(w io.Writer, parts *multipart.Reader) error {
+	out := multipart.NewWriter(w)
+	seen := sets.NewString()
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := part.FormName()
+		if name != buildapi.BinaryBuildFilePart && name != buildapi.BinaryBuildArchivePart {
+			return fmt.Errorf("unexpected part %q in the binary upload, only %q and %q are accepted", name, buildapi.BinaryBuildFilePart, buildapi.BinaryBuildArchivePart)
+		}
+		if seen.Has(name) {
+			return fmt.Errorf("the part %q may only be uploaded once", name)
+		}
+		seen.Insert(name)
+		partWriter, err := out.CreateFormField(name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(partWriter, part); err != nil {
+			return err
+		}
+	}
+	if !seen.Has(buildapi.BinaryBuildFilePart) {
+		return fmt.Errorf("the binary upload has no %q part", buildapi.BinaryBuildFilePart)
+	}
+	return out.Close()
+}
+
+func (h *binaryInstantiateHandler) handle(r io.Reader, withArchive bool) (runtime.Object, error) {
 	h.options.Name = h.name
 	if err := rest.BeforeCreate(BinaryStrategy, h.ctx, h.options); err != nil {
 		glog.Infof("failed to validate binary: %#v", h.options)
 		return nil, err
 	}
+	if withArchive && len(h.options.AsFile) == 0 {
+		return nil, errors.NewBadRequest("asFile must be set to upload a file and an archive")
+	}
 
 	request := &buildapi.BuildRequest{}
 	request.Name = h.name
@@ -142,7 +203,8 @@ func (h *binaryInstantiateHandler) handle(r io.Reader) (runtime.Object, error) {
 		}
 	}
 	request.Binary = &buildapi.BinaryBuildSource{
-		AsFile: h.options.AsFile,
+		AsFile:      h.options.AsFile,
+		WithArchive: withArchive,
 	}
 	build, err := h.r.Generator.Instantiate(h.ctx, request)
 	if err != nil {
@@ -1,6 +1,9 @@
 package buildconfiginstantiate
 
 import (
+	"bytes"
+	"mime/multipart"
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -60,3 +63,33 @@ func TestCreateInstantiateValidationError(t *testing.T) {
 		t.Error("Expected object got none!")
 	}
 }
+
+func TestCopyBinaryParts(t *testing.T) {
+	upload := func(names ...string) *multipart.Reader {
+		body := &bytes.Buffer{}
+		w := multipart.NewWriter(body)
+		for _, name := range names {
+			part, _ := w.CreateFormField(name)
+			part.Write([]byte("contents of " + name))
+		}
+		w.Close()
+		return multipart.NewReader(body, w.Boundary())
+	}
+
+	out := &bytes.Buffer{}
+	if err := copyBinaryParts(out, upload("archive", "file")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "--") {
+		t.Errorf("expected the parts to start with their boundary, got %q", out.String())
+	}
+	if !strings.Contains(out.String(), "contents of archive") || !strings.Contains(out.String(), "contents of file") {
+		t.Errorf("expected the contents of the parts, got %q", out.String())
+	}
+
+	for _, names := range [][]string{{"archive"}, {"file", "file"}, {"file", "other"}} {
+		if err := copyBinaryParts(&bytes.Buffer{}, upload(names...)); err == nil {
+			t.Errorf("expected an error for the parts %v", names)
+		}
+	}
+}
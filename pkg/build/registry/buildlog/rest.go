@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/origin/pkg/build/api/validation"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
+	utilrest "github.com/openshift/origin/pkg/util/rest"
 )
 
 // REST is an implementation of RESTStorage for the api server.
@@ -125,13 +126,17 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 		}
 		return nil, errors.NewBadRequest(err.Error())
 	}
-	return &genericrest.LocationStreamer{
+	streamer := &genericrest.LocationStreamer{
 		Location:        location,
 		Transport:       transport,
 		ContentType:     "text/plain",
 		Flush:           buildLogOpts.Follow,
 		ResponseChecker: genericrest.NewGenericHttpResponseChecker("Pod", buildPodName),
-	}, nil
+	}
+	if buildLogOpts.OffsetBytes != nil {
+		return &utilrest.OffsetStreamer{ResourceStreamer: streamer, Offset: *buildLogOpts.OffsetBytes}, nil
+	}
+	return streamer, nil
 }
 
 // NewGetOptions returns a new options object for build logs
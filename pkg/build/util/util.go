@@ -5,10 +5,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/docker/docker/builder/parser"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/util/docker/dockerfile"
 )
 
 const (
@@ -45,6 +49,113 @@ func GetImageStreamForStrategy(strategy buildapi.BuildStrategy) *kapi.ObjectRefe
 	}
 }
 
+// GetImageStreamForBuildSpec returns the ImageStream[Tag/Image] ObjectReference associated
+// with the build. A Docker strategy without a from uses the image stream tag named by the
+// FROM instruction of the Dockerfile given in the source, so that it can be watched by an
+// ImageChange trigger without repeating the FROM in the strategy.
+func GetImageStreamForBuildSpec(spec buildapi.BuildSpec) *kapi.ObjectReference {
+	if from := GetImageStreamForStrategy(spec.Strategy); from != nil {
+		return from
+	}
+	if spec.Strategy.DockerStrategy == nil || spec.Source.Dockerfile == nil {
+		return nil
+	}
+	return dockerfileImageStreamTag(*spec.Source.Dockerfile)
+}
+
+// dockerfileImageStreamTag returns the image stream tag named by the last FROM instruction
+// of the Dockerfile, read as [namespace/]name[:tag]. Images from an explicit registry, or
+// referenced by ID, are not image stream tags and return nil.
+func dockerfileImageStreamTag(contents string) *kapi.ObjectReference {
+	node, err := parser.Parse(strings.NewReader(contents))
+	if err != nil {
+		return nil
+	}
+	base := dockerfile.LastBaseImage(node)
+	if len(base) == 0 {
+		return nil
+	}
+	ref, err := imageapi.ParseDockerImageReference(base)
+	if err != nil || len(ref.Registry) > 0 || len(ref.ID) > 0 {
+		return nil
+	}
+	return &kapi.ObjectReference{
+		Kind:      "ImageStreamTag",
+		Namespace: ref.Namespace,
+		Name:      imageapi.JoinImageStreamTag(ref.Name, ref.Tag),
+	}
+}
+
+// GetStrategyEnv returns the environment variables passed to the builder container of the
+// strategy.
+func GetStrategyEnv(strategy buildapi.BuildStrategy) []kapi.EnvVar {
+	switch {
+	case strategy.SourceStrategy != nil:
+		return strategy.SourceStrategy.Env
+	case strategy.DockerStrategy != nil:
+		return strategy.DockerStrategy.Env
+	case strategy.CustomStrategy != nil:
+		return strategy.CustomStrategy.Env
+	default:
+		return nil
+	}
+}
+
+// SetStrategyEnv replaces the environment variables passed to the builder container of the
+// strategy. It returns false if the strategy is not set.
+func SetStrategyEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) bool {
+	switch {
+	case strategy.SourceStrategy != nil:
+		strategy.SourceStrategy.Env = env
+	case strategy.DockerStrategy != nil:
+		strategy.DockerStrategy.Env = env
+	case strategy.CustomStrategy != nil:
+		strategy.CustomStrategy.Env = env
+	default:
+		return false
+	}
+	return true
+}
+
+// GetStrategyPullSecret returns the secret used to pull the builder image of the strategy.
+func GetStrategyPullSecret(strategy buildapi.BuildStrategy) *kapi.LocalObjectReference {
+	switch {
+	case strategy.SourceStrategy != nil:
+		return strategy.SourceStrategy.PullSecret
+	case strategy.DockerStrategy != nil:
+		return strategy.DockerStrategy.PullSecret
+	case strategy.CustomStrategy != nil:
+		return strategy.CustomStrategy.PullSecret
+	default:
+		return nil
+	}
+}
+
+// GetSecretNames returns the names of the secrets a build uses: the source secret, the pull
+// secrets of the strategy and of the image sources, the push secret, and the secrets added to
+// the build input. Each name is returned once.
+func GetSecretNames(spec *buildapi.BuildSpec) []string {
+	refs := []*kapi.LocalObjectReference{spec.Source.SourceSecret, GetStrategyPullSecret(spec.Strategy)}
+	for i := range spec.Source.Images {
+		refs = append(refs, spec.Source.Images[i].PullSecret)
+	}
+	refs = append(refs, spec.Output.PushSecret)
+	for i := range spec.Source.Secrets {
+		refs = append(refs, &spec.Source.Secrets[i].Secret)
+	}
+
+	names := []string{}
+	seen := sets.NewString()
+	for _, ref := range refs {
+		if ref == nil || len(ref.Name) == 0 || seen.Has(ref.Name) {
+			continue
+		}
+		seen.Insert(ref.Name)
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
 // NameFromImageStream returns a concatenated name representing an ImageStream[Tag/Image]
 // reference.  If the reference does not contain a Namespace, the namespace parameter
 // is used instead.
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"reflect"
 	"testing"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -12,3 +13,112 @@ func TestGetBuildPodName(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected, actual)
 	}
 }
+
+func TestStrategyEnv(t *testing.T) {
+	env := []kapi.EnvVar{{Name: "A", Value: "1"}}
+	strategies := []buildapi.BuildStrategy{
+		{SourceStrategy: &buildapi.SourceBuildStrategy{}},
+		{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+		{CustomStrategy: &buildapi.CustomBuildStrategy{}},
+	}
+	for _, strategy := range strategies {
+		if !SetStrategyEnv(&strategy, env) {
+			t.Errorf("expected the environment of %#v to be set", strategy)
+			continue
+		}
+		if actual := GetStrategyEnv(strategy); len(actual) != 1 || actual[0] != env[0] {
+			t.Errorf("unexpected environment: %#v", actual)
+		}
+	}
+
+	empty := buildapi.BuildStrategy{}
+	if SetStrategyEnv(&empty, env) {
+		t.Errorf("expected no environment to be set without a strategy")
+	}
+	if actual := GetStrategyEnv(empty); actual != nil {
+		t.Errorf("unexpected environment: %#v", actual)
+	}
+}
+
+func TestGetImageStreamForBuildSpec(t *testing.T) {
+	dockerfile := func(contents string) buildapi.BuildSource {
+		return buildapi.BuildSource{Dockerfile: &contents}
+	}
+	tests := map[string]struct {
+		spec     buildapi.BuildSpec
+		expected *kapi.ObjectReference
+	}{
+		"strategy from": {
+			spec: buildapi.BuildSpec{
+				Source:   dockerfile("FROM ruby"),
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{From: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "centos:7"}}},
+			},
+			expected: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "centos:7"},
+		},
+		"dockerfile from": {
+			spec: buildapi.BuildSpec{
+				Source:   dockerfile("FROM ruby\nRUN make"),
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+			expected: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:latest"},
+		},
+		"last dockerfile from": {
+			spec: buildapi.BuildSpec{
+				Source:   dockerfile("FROM centos\nFROM openshift/ruby:2.2"),
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+			expected: &kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "ruby:2.2"},
+		},
+		"dockerfile from another registry": {
+			spec: buildapi.BuildSpec{
+				Source:   dockerfile("FROM docker.io/centos:7"),
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+		},
+		"dockerfile from an image ID": {
+			spec: buildapi.BuildSpec{
+				Source:   dockerfile("FROM centos@sha256:3c8f1c4dbe65b4a5c4f7be6f5dfab6bb5d4f14c6de5c7f1ba8a4bd3cbd8d6a3f"),
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+		},
+		"no dockerfile": {
+			spec: buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+		},
+	}
+	for name, test := range tests {
+		if actual := GetImageStreamForBuildSpec(test.spec); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, test.expected, actual)
+		}
+	}
+}
+
+func TestGetSecretNames(t *testing.T) {
+	spec := &buildapi.BuildSpec{
+		Source: buildapi.BuildSource{
+			SourceSecret: &kapi.LocalObjectReference{Name: "source"},
+			Images: []buildapi.ImageSource{
+				{PullSecret: &kapi.LocalObjectReference{Name: "pull"}},
+				{PullSecret: &kapi.LocalObjectReference{Name: "image"}},
+			},
+			Secrets: []buildapi.SecretBuildSource{
+				{Secret: kapi.LocalObjectReference{Name: "input"}},
+				{Secret: kapi.LocalObjectReference{Name: "source"}},
+			},
+		},
+		Strategy: buildapi.BuildStrategy{
+			DockerStrategy: &buildapi.DockerBuildStrategy{PullSecret: &kapi.LocalObjectReference{Name: "pull"}},
+		},
+		Output: buildapi.BuildOutput{
+			PushSecret: &kapi.LocalObjectReference{Name: "push"},
+		},
+	}
+	expected := []string{"source", "pull", "image", "push", "input"}
+	if actual := GetSecretNames(spec); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+	if actual := GetSecretNames(&buildapi.BuildSpec{}); len(actual) != 0 {
+		t.Errorf("expected no secrets, got %v", actual)
+	}
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kutil "k8s.io/kubernetes/pkg/util"
+)
+
+const (
+	// DeliveryIDHeader is the header GitHub sets to a unique ID for each webhook delivery
+	DeliveryIDHeader = "X-GitHub-Delivery"
+
+	// limiterCacheSize is the number of build configs, and of delivery IDs, that are remembered
+	limiterCacheSize = 10000
+
+	// StatusReasonTooManyRequests is the reason of the status returned for a delivery that was
+	// rejected by the rate limit of its build config
+	StatusReasonTooManyRequests unversioned.StatusReason = "TooManyRequests"
+)
+
+// DeliveryID returns the unique ID the sender gave to a webhook delivery, or an empty string
+// if the sender does not identify its deliveries.
+func DeliveryID(req *http.Request) string {
+	return req.Header.Get(DeliveryIDHeader)
+}
+
+// Limiter rate limits the webhook deliveries of each build config and rejects deliveries that
+// were already received. The limits and the delivery IDs are kept in the memory of the process,
+// so each master enforces its own limits, and a delivery replayed to another master or after a
+// restart is accepted again.
+type Limiter struct {
+	lock sync.Mutex
+
+	burst    int
+	interval time.Duration
+	// buckets holds the rate limiter of each build config
+	buckets *lru.Cache
+	// deliveries holds the delivery IDs received for each build config, or is nil when replayed
+	// deliveries are accepted
+	deliveries *lru.Cache
+}
+
+// NewLimiter returns a Limiter that accepts burst deliveries at once for each build config and one
+// more every interval. A burst of zero disables rate limiting. If rejectReplays is set, a delivery ID
+// is only accepted once for each build config.
+func NewLimiter(burst int, interval time.Duration, rejectReplays bool) *Limiter {
+	l := &Limiter{burst: burst, interval: interval}
+	if burst > 0 {
+		l.buckets, _ = lru.New(limiterCacheSize)
+	}
+	if rejectReplays {
+		l.deliveries, _ = lru.New(limiterCacheSize)
+	}
+	return l
+}
+
+// Interval returns the time after which a rate limited build config accepts another delivery
+func (l *Limiter) Interval() time.Duration {
+	return l.interval
+}
+
+// Replayed records the delivery of a build config and returns true if it was already received.
+// A delivery that is not accepted after all must be forgotten with Forget, so that the sender
+// can retry it.
+func (l *Limiter) Replayed(namespace, name, deliveryID string) bool {
+	if l.deliveries == nil || len(deliveryID) == 0 {
+		return false
+	}
+	seen, _ := l.deliveries.ContainsOrAdd(namespace+"/"+name+"/"+deliveryID, struct{}{})
+	return seen
+}
+
+// Forget removes a delivery of a build config recorded by Replayed
+func (l *Limiter) Forget(namespace, name, deliveryID string) {
+	if l.deliveries == nil || len(deliveryID) == 0 {
+		return
+	}
+	l.deliveries.Remove(namespace + "/" + name + "/" + deliveryID)
+}
+
+// Allow returns true if the build config accepts another delivery, and takes it from its limit
+func (l *Limiter) Allow(namespace, name string) bool {
+	if l.buckets == nil {
+		return true
+	}
+	key := namespace + "/" + name
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	bucket, ok := l.buckets.Get(key)
+	if !ok {
+		bucket = kutil.NewTokenBucketRateLimiter(float32(time.Second)/float32(l.interval), l.burst)
+		l.buckets.Add(key, bucket)
+	}
+	return bucket.(kutil.RateLimiter).TryAccept()
+}
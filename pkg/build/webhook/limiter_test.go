@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(2, time.Hour, true)
+	for i, expected := range []bool{true, true, false} {
+		if allowed := l.Allow("ns", "bc1"); allowed != expected {
+			t.Errorf("delivery %d to bc1: expected allowed to be %t", i, expected)
+		}
+	}
+	if !l.Allow("ns", "bc2") {
+		t.Errorf("expected each build config to have its own limit")
+	}
+
+	if l.Replayed("ns", "bc1", "a") {
+		t.Errorf("expected the first delivery not to be a replay")
+	}
+	if !l.Replayed("ns", "bc1", "a") {
+		t.Errorf("expected the second delivery to be a replay")
+	}
+	if l.Replayed("ns", "bc2", "a") {
+		t.Errorf("expected deliveries to be tracked for each build config")
+	}
+	l.Forget("ns", "bc1", "a")
+	if l.Replayed("ns", "bc1", "a") {
+		t.Errorf("expected a forgotten delivery not to be a replay")
+	}
+	if l.Replayed("ns", "bc1", "") || l.Replayed("ns", "bc1", "") {
+		t.Errorf("expected deliveries without an ID to never be replays")
+	}
+
+	disabled := NewLimiter(0, 0, false)
+	for i := 0; i < 10; i++ {
+		if !disabled.Allow("ns", "bc1") || disabled.Replayed("ns", "bc1", "a") {
+			t.Fatalf("expected a disabled limiter to accept every delivery")
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kcache "k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/controller"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// InformerFactory creates the shared informers of origin resources. Each resource is listed and
+// watched in all namespaces at most once, however many controllers use its informer.
+type InformerFactory struct {
+	client osclient.Interface
+	resync time.Duration
+
+	lock      sync.Mutex
+	informers map[string]*controller.SharedInformer
+	// started holds the stop channel once Start was called
+	started <-chan struct{}
+}
+
+// NewInformerFactory returns an InformerFactory whose informers use client and relist their
+// objects every resync.
+func NewInformerFactory(client osclient.Interface, resync time.Duration) *InformerFactory {
+	return &InformerFactory{
+		client:    client,
+		resync:    resync,
+		informers: map[string]*controller.SharedInformer{},
+	}
+}
+
+// Start starts the informers that were requested so far, and those requested later, until stopCh
+// is closed.
+func (f *InformerFactory) Start(stopCh <-chan struct{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.started = stopCh
+	for _, informer := range f.informers {
+		informer.RunUntil(stopCh)
+	}
+}
+
+// informer returns the shared informer of resource, creating it with lw if needed.
+func (f *InformerFactory) informer(resource string, objType runtime.Object, lw kcache.ListerWatcher) *controller.SharedInformer {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	informer, ok := f.informers[resource]
+	if !ok {
+		informer = controller.NewSharedInformer(lw, objType, f.resync)
+		f.informers[resource] = informer
+		if f.started != nil {
+			informer.RunUntil(f.started)
+		}
+	}
+	return informer
+}
+
+// Builds returns the shared informer of builds.
+func (f *InformerFactory) Builds() *BuildInformer {
+	return &BuildInformer{f.informer("builds", &buildapi.Build{}, &kcache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.client.Builds(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.client.Builds(kapi.NamespaceAll).Watch(options)
+		},
+	})}
+}
+
+// BuildConfigs returns the shared informer of build configs.
+func (f *InformerFactory) BuildConfigs() *BuildConfigInformer {
+	return &BuildConfigInformer{f.informer("buildconfigs", &buildapi.BuildConfig{}, &kcache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.client.BuildConfigs(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.client.BuildConfigs(kapi.NamespaceAll).Watch(options)
+		},
+	})}
+}
+
+// ImageStreams returns the shared informer of image streams.
+func (f *InformerFactory) ImageStreams() *ImageStreamInformer {
+	return &ImageStreamInformer{f.informer("imagestreams", &imageapi.ImageStream{}, &kcache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.client.ImageStreams(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.client.ImageStreams(kapi.NamespaceAll).Watch(options)
+		},
+	})}
+}
+
+// DeploymentConfigs returns the shared informer of deployment configs.
+func (f *InformerFactory) DeploymentConfigs() *DeploymentConfigInformer {
+	return &DeploymentConfigInformer{f.informer("deploymentconfigs", &deployapi.DeploymentConfig{}, &kcache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.client.DeploymentConfigs(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.client.DeploymentConfigs(kapi.NamespaceAll).Watch(options)
+		},
+	})}
+}
+
+// Routes returns the shared informer of routes.
+func (f *InformerFactory) Routes() *RouteInformer {
+	return &RouteInformer{f.informer("routes", &routeapi.Route{}, &kcache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return f.client.Routes(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return f.client.Routes(kapi.NamespaceAll).Watch(options)
+		},
+	})}
+}
+
+// BuildInformer is the shared informer of builds.
+type BuildInformer struct {
+	*controller.SharedInformer
+}
+
+// Lister returns a lister of the cached builds.
+func (i *BuildInformer) Lister() *StoreToBuildLister {
+	return &StoreToBuildLister{i.Store()}
+}
+
+// BuildConfigInformer is the shared informer of build configs.
+type BuildConfigInformer struct {
+	*controller.SharedInformer
+}
+
+// Lister returns a lister of the cached build configs.
+func (i *BuildConfigInformer) Lister() *StoreToBuildConfigLister {
+	return &StoreToBuildConfigLister{i.Store()}
+}
+
+// ImageStreamInformer is the shared informer of image streams.
+type ImageStreamInformer struct {
+	*controller.SharedInformer
+}
+
+// Lister returns a lister of the cached image streams.
+func (i *ImageStreamInformer) Lister() *StoreToImageStreamLister {
+	return &StoreToImageStreamLister{i.Store()}
+}
+
+// DeploymentConfigInformer is the shared informer of deployment configs.
+type DeploymentConfigInformer struct {
+	*controller.SharedInformer
+}
+
+// Lister returns a lister of the cached deployment configs.
+func (i *DeploymentConfigInformer) Lister() *StoreToDeploymentConfigLister {
+	return &StoreToDeploymentConfigLister{i.Store()}
+}
+
+// RouteInformer is the shared informer of routes.
+type RouteInformer struct {
+	*controller.SharedInformer
+}
+
+// Lister returns a lister of the cached routes.
+func (i *RouteInformer) Lister() *StoreToRouteLister {
+	return &StoreToRouteLister{i.Store()}
+}
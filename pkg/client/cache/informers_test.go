@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+func testBuild(namespace, name string, labels map[string]string) buildapi.Build {
+	return buildapi.Build{ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+}
+
+func TestInformerFactorySharesInformers(t *testing.T) {
+	client := &testclient.Fake{}
+	client.AddReactor("list", "builds", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		// informers are synced once they list a resource version
+		return true, &buildapi.BuildList{
+			ListMeta: unversioned.ListMeta{ResourceVersion: "1"},
+			Items: []buildapi.Build{
+				testBuild("a", "build-1", map[string]string{"app": "one"}),
+				testBuild("a", "build-2", map[string]string{"app": "two"}),
+				testBuild("b", "build-1", map[string]string{"app": "one"}),
+			},
+		}, nil
+	})
+	client.AddReactor("list", "buildconfigs", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &buildapi.BuildConfigList{ListMeta: unversioned.ListMeta{ResourceVersion: "1"}}, nil
+	})
+	client.AddWatchReactor("*", func(action ktestclient.Action) (bool, watch.Interface, error) {
+		return true, watch.NewFake(), nil
+	})
+	factory := NewInformerFactory(client, 0)
+
+	builds := factory.Builds()
+	if builds.SharedInformer != factory.Builds().SharedInformer {
+		t.Fatalf("expected the builds informer to be shared")
+	}
+	if builds.SharedInformer == factory.BuildConfigs().SharedInformer {
+		t.Fatalf("expected the build configs to have their own informer")
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	factory.Start(stop)
+	for i := 0; !builds.HasSynced(); i++ {
+		if i > 100 {
+			t.Fatalf("builds were not listed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	lister := builds.Lister()
+	all, _ := lister.List(labels.Everything())
+	if len(all) != 3 {
+		t.Errorf("expected 3 builds, got %d", len(all))
+	}
+	selected, _ := lister.List(labels.SelectorFromSet(labels.Set{"app": "one"}))
+	if len(selected) != 2 {
+		t.Errorf("expected 2 selected builds, got %d", len(selected))
+	}
+	inNamespace, _ := lister.Builds("a").List(labels.Everything())
+	if len(inNamespace) != 2 {
+		t.Errorf("expected 2 builds in namespace a, got %d", len(inNamespace))
+	}
+	build, err := lister.Builds("b").Get("build-1")
+	if err != nil || build.Namespace != "b" {
+		t.Errorf("unexpected build %#v: %v", build, err)
+	}
+	if _, err := lister.Builds("b").Get("build-2"); !kerrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	// informers requested after the factory was started are started too
+	configs := factory.BuildConfigs()
+	for i := 0; !configs.HasSynced(); i++ {
+		if i > 100 {
+			t.Fatalf("build configs were not listed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
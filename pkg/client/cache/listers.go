@@ -0,0 +1,236 @@
+package cache
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kcache "k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// The listers read the objects cached in a store, which must only contain objects of the listed
+// type. The returned objects are shared with the store and must not be changed; copy them with
+// kapi.Scheme.Copy before changing them.
+
+// listNamespace calls fn with the objects of store in namespace whose labels match selector.
+func listNamespace(store kcache.Store, namespace string, selector labels.Selector, fn func(obj interface{})) {
+	for _, obj := range store.List() {
+		meta, err := kapi.ObjectMetaFor(obj.(runtime.Object))
+		if err != nil {
+			continue
+		}
+		if namespace != kapi.NamespaceAll && meta.Namespace != namespace {
+			continue
+		}
+		if selector.Matches(labels.Set(meta.Labels)) {
+			fn(obj)
+		}
+	}
+}
+
+// getNamespaced returns the object of store with namespace and name, or a NotFound error
+func getNamespaced(store kcache.Store, resource, namespace, name string) (interface{}, error) {
+	obj, exists, err := store.GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, kerrors.NewNotFound(resource, name)
+	}
+	return obj, nil
+}
+
+// StoreToBuildLister lists the builds cached in a store.
+type StoreToBuildLister struct {
+	kcache.Store
+}
+
+// List returns the builds of all namespaces whose labels match selector.
+func (s *StoreToBuildLister) List(selector labels.Selector) ([]*buildapi.Build, error) {
+	return s.Builds(kapi.NamespaceAll).List(selector)
+}
+
+// Builds returns a lister of the builds in namespace.
+func (s *StoreToBuildLister) Builds(namespace string) storeBuildsNamespacer {
+	return storeBuildsNamespacer{s.Store, namespace}
+}
+
+type storeBuildsNamespacer struct {
+	store     kcache.Store
+	namespace string
+}
+
+// List returns the builds of the namespace whose labels match selector.
+func (s storeBuildsNamespacer) List(selector labels.Selector) ([]*buildapi.Build, error) {
+	builds := []*buildapi.Build{}
+	listNamespace(s.store, s.namespace, selector, func(obj interface{}) {
+		builds = append(builds, obj.(*buildapi.Build))
+	})
+	return builds, nil
+}
+
+// Get returns the build of the namespace with name.
+func (s storeBuildsNamespacer) Get(name string) (*buildapi.Build, error) {
+	obj, err := getNamespaced(s.store, "build", s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*buildapi.Build), nil
+}
+
+// StoreToBuildConfigLister lists the build configs cached in a store.
+type StoreToBuildConfigLister struct {
+	kcache.Store
+}
+
+// List returns the build configs of all namespaces whose labels match selector.
+func (s *StoreToBuildConfigLister) List(selector labels.Selector) ([]*buildapi.BuildConfig, error) {
+	return s.BuildConfigs(kapi.NamespaceAll).List(selector)
+}
+
+// BuildConfigs returns a lister of the build configs in namespace.
+func (s *StoreToBuildConfigLister) BuildConfigs(namespace string) storeBuildConfigsNamespacer {
+	return storeBuildConfigsNamespacer{s.Store, namespace}
+}
+
+type storeBuildConfigsNamespacer struct {
+	store     kcache.Store
+	namespace string
+}
+
+// List returns the build configs of the namespace whose labels match selector.
+func (s storeBuildConfigsNamespacer) List(selector labels.Selector) ([]*buildapi.BuildConfig, error) {
+	configs := []*buildapi.BuildConfig{}
+	listNamespace(s.store, s.namespace, selector, func(obj interface{}) {
+		configs = append(configs, obj.(*buildapi.BuildConfig))
+	})
+	return configs, nil
+}
+
+// Get returns the build config of the namespace with name.
+func (s storeBuildConfigsNamespacer) Get(name string) (*buildapi.BuildConfig, error) {
+	obj, err := getNamespaced(s.store, "buildconfig", s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*buildapi.BuildConfig), nil
+}
+
+// StoreToImageStreamLister lists the image streams cached in a store.
+type StoreToImageStreamLister struct {
+	kcache.Store
+}
+
+// List returns the image streams of all namespaces whose labels match selector.
+func (s *StoreToImageStreamLister) List(selector labels.Selector) ([]*imageapi.ImageStream, error) {
+	return s.ImageStreams(kapi.NamespaceAll).List(selector)
+}
+
+// ImageStreams returns a lister of the image streams in namespace.
+func (s *StoreToImageStreamLister) ImageStreams(namespace string) storeImageStreamsNamespacer {
+	return storeImageStreamsNamespacer{s.Store, namespace}
+}
+
+type storeImageStreamsNamespacer struct {
+	store     kcache.Store
+	namespace string
+}
+
+// List returns the image streams of the namespace whose labels match selector.
+func (s storeImageStreamsNamespacer) List(selector labels.Selector) ([]*imageapi.ImageStream, error) {
+	streams := []*imageapi.ImageStream{}
+	listNamespace(s.store, s.namespace, selector, func(obj interface{}) {
+		streams = append(streams, obj.(*imageapi.ImageStream))
+	})
+	return streams, nil
+}
+
+// Get returns the image stream of the namespace with name.
+func (s storeImageStreamsNamespacer) Get(name string) (*imageapi.ImageStream, error) {
+	obj, err := getNamespaced(s.store, "imagestream", s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*imageapi.ImageStream), nil
+}
+
+// StoreToDeploymentConfigLister lists the deployment configs cached in a store.
+type StoreToDeploymentConfigLister struct {
+	kcache.Store
+}
+
+// List returns the deployment configs of all namespaces whose labels match selector.
+func (s *StoreToDeploymentConfigLister) List(selector labels.Selector) ([]*deployapi.DeploymentConfig, error) {
+	return s.DeploymentConfigs(kapi.NamespaceAll).List(selector)
+}
+
+// DeploymentConfigs returns a lister of the deployment configs in namespace.
+func (s *StoreToDeploymentConfigLister) DeploymentConfigs(namespace string) storeDeploymentConfigsNamespacer {
+	return storeDeploymentConfigsNamespacer{s.Store, namespace}
+}
+
+type storeDeploymentConfigsNamespacer struct {
+	store     kcache.Store
+	namespace string
+}
+
+// List returns the deployment configs of the namespace whose labels match selector.
+func (s storeDeploymentConfigsNamespacer) List(selector labels.Selector) ([]*deployapi.DeploymentConfig, error) {
+	configs := []*deployapi.DeploymentConfig{}
+	listNamespace(s.store, s.namespace, selector, func(obj interface{}) {
+		configs = append(configs, obj.(*deployapi.DeploymentConfig))
+	})
+	return configs, nil
+}
+
+// Get returns the deployment config of the namespace with name.
+func (s storeDeploymentConfigsNamespacer) Get(name string) (*deployapi.DeploymentConfig, error) {
+	obj, err := getNamespaced(s.store, "deploymentconfig", s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*deployapi.DeploymentConfig), nil
+}
+
+// StoreToRouteLister lists the routes cached in a store.
+type StoreToRouteLister struct {
+	kcache.Store
+}
+
+// List returns the routes of all namespaces whose labels match selector.
+func (s *StoreToRouteLister) List(selector labels.Selector) ([]*routeapi.Route, error) {
+	return s.Routes(kapi.NamespaceAll).List(selector)
+}
+
+// Routes returns a lister of the routes in namespace.
+func (s *StoreToRouteLister) Routes(namespace string) storeRoutesNamespacer {
+	return storeRoutesNamespacer{s.Store, namespace}
+}
+
+type storeRoutesNamespacer struct {
+	store     kcache.Store
+	namespace string
+}
+
+// List returns the routes of the namespace whose labels match selector.
+func (s storeRoutesNamespacer) List(selector labels.Selector) ([]*routeapi.Route, error) {
+	routes := []*routeapi.Route{}
+	listNamespace(s.store, s.namespace, selector, func(obj interface{}) {
+		routes = append(routes, obj.(*routeapi.Route))
+	})
+	return routes, nil
+}
+
+// Get returns the route of the namespace with name.
+func (s storeRoutesNamespacer) Get(name string) (*routeapi.Route, error) {
+	obj, err := getNamespaced(s.store, "route", s.namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*routeapi.Route), nil
+}
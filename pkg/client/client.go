@@ -37,15 +37,25 @@ type Interface interface {
 	UserIdentityMappingsInterface
 	ProjectsInterface
 	ProjectRequestsInterface
+	ClusterResourceQuotasInterface
+	PodPresetsNamespacer
+	RoleBindingRestrictionsNamespacer
 	LocalSubjectAccessReviewsImpersonator
 	SubjectAccessReviewsImpersonator
 	LocalResourceAccessReviewsNamespacer
 	ResourceAccessReviews
 	SubjectAccessReviews
 	LocalSubjectAccessReviewsNamespacer
+	SelfSubjectRulesReviewsNamespacer
+	PodSecurityPolicySubjectReviewsNamespacer
+	PodSecurityPolicyReviewsNamespacer
 	TemplatesNamespacer
 	TemplateConfigsNamespacer
+	SharedTemplatesNamespacer
 	OAuthAccessTokensInterface
+	OAuthAuthorizeTokensInterface
+	UserOAuthAccessTokensInterface
+	ServiceAccountTokenRequestsNamespacer
 	PoliciesNamespacer
 	PolicyBindingsNamespacer
 	RolesNamespacer
@@ -161,6 +171,21 @@ func (c *Client) ProjectRequests() ProjectRequestInterface {
 	return newProjectRequests(c)
 }
 
+// ClusterResourceQuotas provides a REST client for ClusterResourceQuotas
+func (c *Client) ClusterResourceQuotas() ClusterResourceQuotaInterface {
+	return newClusterResourceQuotas(c)
+}
+
+// PodPresets provides a REST client for PodPresets
+func (c *Client) PodPresets(namespace string) PodPresetInterface {
+	return newPodPresets(c, namespace)
+}
+
+// RoleBindingRestrictions provides a REST client for RoleBindingRestrictions
+func (c *Client) RoleBindingRestrictions(namespace string) RoleBindingRestrictionInterface {
+	return newRoleBindingRestrictions(c, namespace)
+}
+
 // TemplateConfigs provides a REST client for TemplateConfig
 func (c *Client) TemplateConfigs(namespace string) TemplateConfigInterface {
 	return newTemplateConfigs(c, namespace)
@@ -171,6 +196,11 @@ func (c *Client) Templates(namespace string) TemplateInterface {
 	return newTemplates(c, namespace)
 }
 
+// SharedTemplates provides a REST client for the templates shared with a namespace
+func (c *Client) SharedTemplates(namespace string) SharedTemplateInterface {
+	return newSharedTemplates(c, namespace)
+}
+
 // Policies provides a REST client for Policies
 func (c *Client) Policies(namespace string) PolicyInterface {
 	return newPolicies(c, namespace)
@@ -196,6 +226,16 @@ func (c *Client) LocalResourceAccessReviews(namespace string) LocalResourceAcces
 	return newLocalResourceAccessReviews(c, namespace)
 }
 
+// PodSecurityPolicySubjectReviews provides a REST client for PodSecurityPolicySubjectReviews
+func (c *Client) PodSecurityPolicySubjectReviews(namespace string) PodSecurityPolicySubjectReviewInterface {
+	return newPodSecurityPolicySubjectReviews(c, namespace)
+}
+
+// PodSecurityPolicyReviews provides a REST client for PodSecurityPolicyReviews
+func (c *Client) PodSecurityPolicyReviews(namespace string) PodSecurityPolicyReviewInterface {
+	return newPodSecurityPolicyReviews(c, namespace)
+}
+
 // ClusterResourceAccessReviews provides a REST client for ClusterResourceAccessReviews
 func (c *Client) ResourceAccessReviews() ResourceAccessReviewInterface {
 	return newResourceAccessReviews(c)
@@ -216,6 +256,11 @@ func (c *Client) LocalSubjectAccessReviews(namespace string) LocalSubjectAccessR
 	return newLocalSubjectAccessReviews(c, namespace)
 }
 
+// SelfSubjectRulesReviews provides a REST client for SelfSubjectRulesReviews
+func (c *Client) SelfSubjectRulesReviews(namespace string) SelfSubjectRulesReviewInterface {
+	return newSelfSubjectRulesReviews(c, namespace)
+}
+
 // SubjectAccessReviews provides a REST client for SubjectAccessReviews
 func (c *Client) SubjectAccessReviews() SubjectAccessReviewInterface {
 	return newSubjectAccessReviews(c)
@@ -226,6 +271,21 @@ func (c *Client) OAuthAccessTokens() OAuthAccessTokenInterface {
 	return newOAuthAccessTokens(c)
 }
 
+// OAuthAuthorizeTokens provides a REST client for OAuthAuthorizeTokens
+func (c *Client) OAuthAuthorizeTokens() OAuthAuthorizeTokenInterface {
+	return newOAuthAuthorizeTokens(c)
+}
+
+// UserOAuthAccessTokens provides a REST client for the OAuthAccessTokens of the current user
+func (c *Client) UserOAuthAccessTokens() UserOAuthAccessTokenInterface {
+	return newUserOAuthAccessTokens(c)
+}
+
+// ServiceAccountTokenRequests provides a REST client for ServiceAccountTokenRequests
+func (c *Client) ServiceAccountTokenRequests(namespace string) ServiceAccountTokenRequestInterface {
+	return newServiceAccountTokenRequests(c, namespace)
+}
+
 func (c *Client) ClusterPolicies() ClusterPolicyInterface {
 	return newClusterPolicies(c)
 }
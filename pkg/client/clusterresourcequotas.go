@@ -0,0 +1,89 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+// ClusterResourceQuotasInterface has methods to work with ClusterResourceQuota resources
+type ClusterResourceQuotasInterface interface {
+	ClusterResourceQuotas() ClusterResourceQuotaInterface
+}
+
+// ClusterResourceQuotaInterface exposes methods on ClusterResourceQuota resources.
+type ClusterResourceQuotaInterface interface {
+	List(opts kapi.ListOptions) (*quotaapi.ClusterResourceQuotaList, error)
+	Get(name string) (*quotaapi.ClusterResourceQuota, error)
+	Create(quota *quotaapi.ClusterResourceQuota) (*quotaapi.ClusterResourceQuota, error)
+	Update(quota *quotaapi.ClusterResourceQuota) (*quotaapi.ClusterResourceQuota, error)
+	UpdateStatus(quota *quotaapi.ClusterResourceQuota) (*quotaapi.ClusterResourceQuota, error)
+	Delete(name string) error
+	Watch(opts kapi.ListOptions) (watch.Interface, error)
+}
+
+// clusterResourceQuotas implements ClusterResourceQuotaInterface interface
+type clusterResourceQuotas struct {
+	r *Client
+}
+
+// newClusterResourceQuotas returns a clusterResourceQuotas
+func newClusterResourceQuotas(c *Client) *clusterResourceQuotas {
+	return &clusterResourceQuotas{
+		r: c,
+	}
+}
+
+// List returns a list of ClusterResourceQuotas that match the label and field selectors.
+func (c *clusterResourceQuotas) List(opts kapi.ListOptions) (result *quotaapi.ClusterResourceQuotaList, err error) {
+	result = &quotaapi.ClusterResourceQuotaList{}
+	err = c.r.Get().
+		Resource("clusterResourceQuotas").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns information about a particular ClusterResourceQuota or an error
+func (c *clusterResourceQuotas) Get(name string) (result *quotaapi.ClusterResourceQuota, err error) {
+	result = &quotaapi.ClusterResourceQuota{}
+	err = c.r.Get().Resource("clusterResourceQuotas").Name(name).Do().Into(result)
+	return
+}
+
+// Create creates a new ClusterResourceQuota. Returns the server's representation of the quota and error if one occurs.
+func (c *clusterResourceQuotas) Create(quota *quotaapi.ClusterResourceQuota) (result *quotaapi.ClusterResourceQuota, err error) {
+	result = &quotaapi.ClusterResourceQuota{}
+	err = c.r.Post().Resource("clusterResourceQuotas").Body(quota).Do().Into(result)
+	return
+}
+
+// Update updates the ClusterResourceQuota. Returns the server's representation of the quota and error if one occurs.
+func (c *clusterResourceQuotas) Update(quota *quotaapi.ClusterResourceQuota) (result *quotaapi.ClusterResourceQuota, err error) {
+	result = &quotaapi.ClusterResourceQuota{}
+	err = c.r.Put().Resource("clusterResourceQuotas").Name(quota.Name).Body(quota).Do().Into(result)
+	return
+}
+
+// UpdateStatus updates the status of the ClusterResourceQuota. Returns the server's representation of the quota and error if one occurs.
+func (c *clusterResourceQuotas) UpdateStatus(quota *quotaapi.ClusterResourceQuota) (result *quotaapi.ClusterResourceQuota, err error) {
+	result = &quotaapi.ClusterResourceQuota{}
+	err = c.r.Put().Resource("clusterResourceQuotas").Name(quota.Name).SubResource("status").Body(quota).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the ClusterResourceQuota, and returns an error if one occurs during deletion of the quota
+func (c *clusterResourceQuotas) Delete(name string) error {
+	return c.r.Delete().Resource("clusterResourceQuotas").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested ClusterResourceQuotas
+func (c *clusterResourceQuotas) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Resource("clusterResourceQuotas").
+		VersionedParams(&opts, kapi.Scheme).
+		Watch()
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+// DiscoveryClient discovers the API groups, versions and resources served by both Kubernetes and OpenShift.
+// OpenShift resources are served in the legacy group under /oapi, so they are reported along with the
+// Kubernetes resources of the legacy group served under /api.
+type DiscoveryClient struct {
+	*kclient.DiscoveryClient
+}
+
+var _ kclient.DiscoveryInterface = &DiscoveryClient{}
+
+// NewDiscoveryClient creates a new DiscoveryClient for the given config.
+func NewDiscoveryClient(c *kclient.Config) (*DiscoveryClient, error) {
+	client, err := kclient.NewDiscoveryClient(c)
+	if err != nil {
+		return nil, err
+	}
+	return &DiscoveryClient{client}, nil
+}
+
+// ServerGroups returns the supported groups.  Versions served only under /oapi are added to the legacy group, whose
+// preferred version is the one preferred by /api if it serves any.
+func (d *DiscoveryClient) ServerGroups() (*unversioned.APIGroupList, error) {
+	apiGroupList, err := d.DiscoveryClient.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	originVersions := &unversioned.APIVersions{}
+	err = d.Get().AbsPath("/oapi").Do().Into(originVersions)
+	if err != nil {
+		// ignore 403 or 404 errors, so that Kubernetes servers can be discovered
+		if errors.IsNotFound(err) || errors.IsForbidden(err) {
+			return apiGroupList, nil
+		}
+		return nil, err
+	}
+
+	for i := range apiGroupList.Groups {
+		legacyGroup := &apiGroupList.Groups[i]
+		if len(legacyGroup.Name) != 0 {
+			continue
+		}
+
+		known := sets.NewString()
+		for _, version := range legacyGroup.Versions {
+			known.Insert(version.Version)
+		}
+		for _, version := range originVersions.Versions {
+			if known.Has(version) {
+				continue
+			}
+			legacyGroup.Versions = append(legacyGroup.Versions, unversioned.GroupVersionForDiscovery{GroupVersion: version, Version: version})
+		}
+		if len(legacyGroup.PreferredVersion.Version) == 0 && len(legacyGroup.Versions) > 0 {
+			legacyGroup.PreferredVersion = legacyGroup.Versions[0]
+		}
+	}
+	return apiGroupList, nil
+}
+
+// ServerResourcesForGroupVersion returns the supported resources for a group and version.  The resources of a legacy
+// version include those served under /oapi.
+func (d *DiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*unversioned.APIResourceList, error) {
+	gv, err := unversioned.ParseGroupVersion(groupVersion)
+	if err != nil {
+		return nil, err
+	}
+	if len(gv.Group) != 0 {
+		return d.DiscoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	}
+
+	resources := &unversioned.APIResourceList{GroupVersion: groupVersion}
+	// the Kubernetes discovery client only knows the legacy version v1
+	if gv.Version == "v1" {
+		kubeResources, err := d.DiscoveryClient.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return nil, err
+		}
+		resources.APIResources = append(resources.APIResources, kubeResources.APIResources...)
+	}
+
+	originResources := &unversioned.APIResourceList{}
+	err = d.Get().AbsPath("/oapi/" + gv.Version).Do().Into(originResources)
+	if err != nil {
+		// ignore 403 or 404 errors, so that Kubernetes servers can be discovered
+		if errors.IsNotFound(err) || errors.IsForbidden(err) {
+			return resources, nil
+		}
+		return nil, err
+	}
+	resources.APIResources = append(resources.APIResources, originResources.APIResources...)
+	return resources, nil
+}
+
+// ServerResources returns the supported resources for all groups and versions.
+func (d *DiscoveryClient) ServerResources() (map[string]*unversioned.APIResourceList, error) {
+	apiGroups, err := d.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]*unversioned.APIResourceList{}
+	for _, groupVersion := range kclient.ExtractGroupVersions(apiGroups) {
+		resources, err := d.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return nil, err
+		}
+		result[groupVersion] = resources
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+)
+
+func TestDiscoveryClient(t *testing.T) {
+	responses := map[string]interface{}{
+		"/api":  &unversioned.APIVersions{Versions: []string{"v1"}},
+		"/apis": &unversioned.APIGroupList{Groups: []unversioned.APIGroup{{Name: "extensions", Versions: []unversioned.GroupVersionForDiscovery{{GroupVersion: "extensions/v1beta1", Version: "v1beta1"}}}}},
+		"/oapi": &unversioned.APIVersions{Versions: []string{"v1", "v2"}},
+		"/api/v1": &unversioned.APIResourceList{GroupVersion: "v1", APIResources: []unversioned.APIResource{
+			{Name: "pods", Namespaced: true},
+		}},
+		"/apis/extensions/v1beta1": &unversioned.APIResourceList{GroupVersion: "extensions/v1beta1", APIResources: []unversioned.APIResource{
+			{Name: "jobs", Namespaced: true},
+		}},
+		"/oapi/v1": &unversioned.APIResourceList{GroupVersion: "v1", APIResources: []unversioned.APIResource{
+			{Name: "builds", Namespaced: true},
+			{Name: "users", Namespaced: false},
+		}},
+		"/oapi/v2": &unversioned.APIResourceList{GroupVersion: "v2", APIResources: []unversioned.APIResource{
+			{Name: "routes", Namespaced: true},
+		}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		response, ok := responses[req.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewDiscoveryClient(&kclient.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	groups, err := client.ServerGroups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedGroupVersions := []string{"extensions/v1beta1", "v1", "v2"}
+	if groupVersions := kclient.ExtractGroupVersions(groups); !reflect.DeepEqual(groupVersions, expectedGroupVersions) {
+		t.Errorf("expected group versions %v, got %v", expectedGroupVersions, groupVersions)
+	}
+	if preferred := groups.Groups[1].PreferredVersion.GroupVersion; preferred != "v1" {
+		t.Errorf("expected the legacy group to prefer v1, got %q", preferred)
+	}
+
+	resources, err := client.ServerResources()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]string{
+		"extensions/v1beta1": {"jobs"},
+		"v1":                 {"pods", "builds", "users"},
+		"v2":                 {"routes"},
+	}
+	if len(resources) != len(expected) {
+		t.Errorf("expected resources for %d group versions, got %#v", len(expected), resources)
+	}
+	for groupVersion, names := range expected {
+		list, ok := resources[groupVersion]
+		if !ok {
+			t.Errorf("%s: missing resources", groupVersion)
+			continue
+		}
+		actual := []string{}
+		for _, resource := range list.APIResources {
+			actual = append(actual, resource.Name)
+		}
+		if !reflect.DeepEqual(actual, names) {
+			t.Errorf("%s: expected resources %v, got %v", groupVersion, names, actual)
+		}
+	}
+}
+
+func TestDiscoveryClientKubernetesServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var response interface{}
+		switch req.URL.Path {
+		case "/api":
+			response = &unversioned.APIVersions{Versions: []string{"v1"}}
+		case "/api/v1":
+			response = &unversioned.APIResourceList{GroupVersion: "v1", APIResources: []unversioned.APIResource{{Name: "pods", Namespaced: true}}}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := NewDiscoveryClient(&kclient.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resources, err := client.ServerResourcesForGroupVersion("v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources.APIResources) != 1 || resources.APIResources[0].Name != "pods" {
+		t.Errorf("expected only the Kubernetes resources, got %#v", resources.APIResources)
+	}
+}
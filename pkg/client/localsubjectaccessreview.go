@@ -60,6 +60,7 @@ func (c *localSubjectAccessReviews) Create(sar *authorizationapi.LocalSubjectAcc
 			Action: sar.Action,
 			User:   sar.User,
 			Groups: sar.Groups,
+			Scopes: sar.Scopes,
 		}
 		deprecatedResponse := &authorizationapi.SubjectAccessReviewResponse{}
 
@@ -1,5 +1,11 @@
 package client
 
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
 // OAuthAccessTokensInterface has methods to work with OAuthAccessTokens resources in a namespace
 type OAuthAccessTokensInterface interface {
 	OAuthAccessTokens() OAuthAccessTokenInterface
@@ -7,6 +13,7 @@ type OAuthAccessTokensInterface interface {
 
 // OAuthAccessTokenInterface exposes methods on OAuthAccessTokens resources.
 type OAuthAccessTokenInterface interface {
+	List(opts kapi.ListOptions) (*oauthapi.OAuthAccessTokenList, error)
 	Delete(name string) error
 }
 
@@ -20,6 +27,17 @@ func newOAuthAccessTokens(c *Client) *oauthAccessTokenInterface {
 	}
 }
 
+// List returns a list of OAuthAccessTokens that match the label and field selectors.
+func (c *oauthAccessTokenInterface) List(opts kapi.ListOptions) (result *oauthapi.OAuthAccessTokenList, err error) {
+	result = &oauthapi.OAuthAccessTokenList{}
+	err = c.r.Get().
+		Resource("oAuthAccessTokens").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete removes the OAuthAccessToken on server
 func (c *oauthAccessTokenInterface) Delete(name string) (err error) {
 	err = c.r.Delete().Resource("oAuthAccessTokens").Name(name).Do().Error()
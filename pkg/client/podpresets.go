@@ -0,0 +1,85 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+// PodPresetsNamespacer has methods to work with PodPreset resources in a namespace
+type PodPresetsNamespacer interface {
+	PodPresets(namespace string) PodPresetInterface
+}
+
+// PodPresetInterface exposes methods on PodPreset resources
+type PodPresetInterface interface {
+	List(opts kapi.ListOptions) (*podpresetapi.PodPresetList, error)
+	Get(name string) (*podpresetapi.PodPreset, error)
+	Create(preset *podpresetapi.PodPreset) (*podpresetapi.PodPreset, error)
+	Update(preset *podpresetapi.PodPreset) (*podpresetapi.PodPreset, error)
+	Delete(name string) error
+	Watch(opts kapi.ListOptions) (watch.Interface, error)
+}
+
+// podPresets implements PodPresetInterface interface
+type podPresets struct {
+	r  *Client
+	ns string
+}
+
+// newPodPresets returns a podPresets
+func newPodPresets(c *Client, namespace string) *podPresets {
+	return &podPresets{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes a label and field selector, and returns the list of pod presets that match that selectors
+func (c *podPresets) List(opts kapi.ListOptions) (result *podpresetapi.PodPresetList, err error) {
+	result = &podpresetapi.PodPresetList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("podpresets").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the pod preset, and returns the corresponding PodPreset object, and an error if it occurs
+func (c *podPresets) Get(name string) (result *podpresetapi.PodPreset, err error) {
+	result = &podpresetapi.PodPreset{}
+	err = c.r.Get().Namespace(c.ns).Resource("podpresets").Name(name).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the pod preset, and returns an error if one occurs
+func (c *podPresets) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("podpresets").Name(name).Do().Error()
+}
+
+// Create takes the representation of a pod preset.  Returns the server's representation of the pod preset, and an error, if it occurs
+func (c *podPresets) Create(preset *podpresetapi.PodPreset) (result *podpresetapi.PodPreset, err error) {
+	result = &podpresetapi.PodPreset{}
+	err = c.r.Post().Namespace(c.ns).Resource("podpresets").Body(preset).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a pod preset to update.  Returns the server's representation of the pod preset, and an error, if it occurs
+func (c *podPresets) Update(preset *podpresetapi.PodPreset) (result *podpresetapi.PodPreset, err error) {
+	result = &podpresetapi.PodPreset{}
+	err = c.r.Put().Namespace(c.ns).Resource("podpresets").Name(preset.Name).Body(preset).Do().Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested pod presets.
+func (c *podPresets) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("podpresets").
+		VersionedParams(&opts, kapi.Scheme).
+		Watch()
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	securityapi "github.com/openshift/origin/pkg/security/api"
+)
+
+// PodSecurityPolicySubjectReviewsNamespacer has methods to work with PodSecurityPolicySubjectReview resources in a namespace
+type PodSecurityPolicySubjectReviewsNamespacer interface {
+	PodSecurityPolicySubjectReviews(namespace string) PodSecurityPolicySubjectReviewInterface
+}
+
+// PodSecurityPolicySubjectReviewInterface exposes methods on PodSecurityPolicySubjectReview resources.
+type PodSecurityPolicySubjectReviewInterface interface {
+	Create(review *securityapi.PodSecurityPolicySubjectReview) (*securityapi.PodSecurityPolicySubjectReview, error)
+}
+
+// podSecurityPolicySubjectReviews implements PodSecurityPolicySubjectReviewsNamespacer interface
+type podSecurityPolicySubjectReviews struct {
+	r  *Client
+	ns string
+}
+
+// newPodSecurityPolicySubjectReviews returns a podSecurityPolicySubjectReviews
+func newPodSecurityPolicySubjectReviews(c *Client, namespace string) *podSecurityPolicySubjectReviews {
+	return &podSecurityPolicySubjectReviews{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+func (c *podSecurityPolicySubjectReviews) Create(review *securityapi.PodSecurityPolicySubjectReview) (result *securityapi.PodSecurityPolicySubjectReview, err error) {
+	result = &securityapi.PodSecurityPolicySubjectReview{}
+	err = c.r.Post().Namespace(c.ns).Resource("podSecurityPolicySubjectReviews").Body(review).Do().Into(result)
+	return
+}
+
+// PodSecurityPolicyReviewsNamespacer has methods to work with PodSecurityPolicyReview resources in a namespace
+type PodSecurityPolicyReviewsNamespacer interface {
+	PodSecurityPolicyReviews(namespace string) PodSecurityPolicyReviewInterface
+}
+
+// PodSecurityPolicyReviewInterface exposes methods on PodSecurityPolicyReview resources.
+type PodSecurityPolicyReviewInterface interface {
+	Create(review *securityapi.PodSecurityPolicyReview) (*securityapi.PodSecurityPolicyReview, error)
+}
+
+// podSecurityPolicyReviews implements PodSecurityPolicyReviewsNamespacer interface
+type podSecurityPolicyReviews struct {
+	r  *Client
+	ns string
+}
+
+// newPodSecurityPolicyReviews returns a podSecurityPolicyReviews
+func newPodSecurityPolicyReviews(c *Client, namespace string) *podSecurityPolicyReviews {
+	return &podSecurityPolicyReviews{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+func (c *podSecurityPolicyReviews) Create(review *securityapi.PodSecurityPolicyReview) (result *securityapi.PodSecurityPolicyReview, err error) {
+	result = &securityapi.PodSecurityPolicyReview{}
+	err = c.r.Post().Namespace(c.ns).Resource("podSecurityPolicyReviews").Body(review).Do().Into(result)
+	return
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/watch"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// RoleBindingRestrictionsNamespacer has methods to work with RoleBindingRestriction resources in a namespace
+type RoleBindingRestrictionsNamespacer interface {
+	RoleBindingRestrictions(namespace string) RoleBindingRestrictionInterface
+}
+
+// RoleBindingRestrictionInterface exposes methods on RoleBindingRestriction resources
+type RoleBindingRestrictionInterface interface {
+	List(opts kapi.ListOptions) (*authorizationapi.RoleBindingRestrictionList, error)
+	Get(name string) (*authorizationapi.RoleBindingRestriction, error)
+	Create(restriction *authorizationapi.RoleBindingRestriction) (*authorizationapi.RoleBindingRestriction, error)
+	Update(restriction *authorizationapi.RoleBindingRestriction) (*authorizationapi.RoleBindingRestriction, error)
+	Delete(name string) error
+	Watch(opts kapi.ListOptions) (watch.Interface, error)
+}
+
+// roleBindingRestrictions implements RoleBindingRestrictionInterface interface
+type roleBindingRestrictions struct {
+	r  *Client
+	ns string
+}
+
+// newRoleBindingRestrictions returns a roleBindingRestrictions
+func newRoleBindingRestrictions(c *Client, namespace string) *roleBindingRestrictions {
+	return &roleBindingRestrictions{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes a label and field selector, and returns the list of role binding restrictions that match that selectors
+func (c *roleBindingRestrictions) List(opts kapi.ListOptions) (result *authorizationapi.RoleBindingRestrictionList, err error) {
+	result = &authorizationapi.RoleBindingRestrictionList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("roleBindingRestrictions").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the role binding restriction, and returns the corresponding RoleBindingRestriction object, and an error if it occurs
+func (c *roleBindingRestrictions) Get(name string) (result *authorizationapi.RoleBindingRestriction, err error) {
+	result = &authorizationapi.RoleBindingRestriction{}
+	err = c.r.Get().Namespace(c.ns).Resource("roleBindingRestrictions").Name(name).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the role binding restriction, and returns an error if one occurs
+func (c *roleBindingRestrictions) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("roleBindingRestrictions").Name(name).Do().Error()
+}
+
+// Create takes the representation of a role binding restriction.  Returns the server's representation of the role binding restriction, and an error, if it occurs
+func (c *roleBindingRestrictions) Create(restriction *authorizationapi.RoleBindingRestriction) (result *authorizationapi.RoleBindingRestriction, err error) {
+	result = &authorizationapi.RoleBindingRestriction{}
+	err = c.r.Post().Namespace(c.ns).Resource("roleBindingRestrictions").Body(restriction).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a role binding restriction to update.  Returns the server's representation of the role binding restriction, and an error, if it occurs
+func (c *roleBindingRestrictions) Update(restriction *authorizationapi.RoleBindingRestriction) (result *authorizationapi.RoleBindingRestriction, err error) {
+	result = &authorizationapi.RoleBindingRestriction{}
+	err = c.r.Put().Namespace(c.ns).Resource("roleBindingRestrictions").Name(restriction.Name).Body(restriction).Do().Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested role binding restrictions.
+func (c *roleBindingRestrictions) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("roleBindingRestrictions").
+		VersionedParams(&opts, kapi.Scheme).
+		Watch()
+}
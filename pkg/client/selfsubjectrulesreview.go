@@ -0,0 +1,35 @@
+package client
+
+import (
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+// SelfSubjectRulesReviewsNamespacer has methods to work with SelfSubjectRulesReview resources in a namespace
+type SelfSubjectRulesReviewsNamespacer interface {
+	SelfSubjectRulesReviews(namespace string) SelfSubjectRulesReviewInterface
+}
+
+// SelfSubjectRulesReviewInterface exposes methods on SelfSubjectRulesReview resources.
+type SelfSubjectRulesReviewInterface interface {
+	Create(*authorizationapi.SelfSubjectRulesReview) (*authorizationapi.SelfSubjectRulesReview, error)
+}
+
+// selfSubjectRulesReviews implements SelfSubjectRulesReviewsNamespacer interface
+type selfSubjectRulesReviews struct {
+	r  *Client
+	ns string
+}
+
+// newSelfSubjectRulesReviews returns a selfSubjectRulesReviews
+func newSelfSubjectRulesReviews(c *Client, namespace string) *selfSubjectRulesReviews {
+	return &selfSubjectRulesReviews{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+func (c *selfSubjectRulesReviews) Create(inObj *authorizationapi.SelfSubjectRulesReview) (*authorizationapi.SelfSubjectRulesReview, error) {
+	result := &authorizationapi.SelfSubjectRulesReview{}
+	err := c.r.Post().Namespace(c.ns).Resource("selfSubjectRulesReviews").Body(inObj).Do().Into(result)
+	return result, err
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// ServiceAccountTokenRequestsNamespacer has methods to work with ServiceAccountTokenRequest resources in a namespace
+type ServiceAccountTokenRequestsNamespacer interface {
+	ServiceAccountTokenRequests(namespace string) ServiceAccountTokenRequestInterface
+}
+
+// ServiceAccountTokenRequestInterface exposes methods on ServiceAccountTokenRequest resources.
+type ServiceAccountTokenRequestInterface interface {
+	Create(request *oauthapi.ServiceAccountTokenRequest) (*oauthapi.ServiceAccountTokenRequest, error)
+}
+
+// serviceAccountTokenRequests implements ServiceAccountTokenRequestsNamespacer interface
+type serviceAccountTokenRequests struct {
+	r  *Client
+	ns string
+}
+
+// newServiceAccountTokenRequests returns a serviceAccountTokenRequests
+func newServiceAccountTokenRequests(c *Client, namespace string) *serviceAccountTokenRequests {
+	return &serviceAccountTokenRequests{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Create mints an access token for the service account named by the request
+func (c *serviceAccountTokenRequests) Create(request *oauthapi.ServiceAccountTokenRequest) (result *oauthapi.ServiceAccountTokenRequest, err error) {
+	result = &oauthapi.ServiceAccountTokenRequest{}
+	err = c.r.Post().Namespace(c.ns).Resource("serviceAccountTokenRequests").Body(request).Do().Into(result)
+	return
+}
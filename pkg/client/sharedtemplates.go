@@ -0,0 +1,44 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// SharedTemplatesNamespacer has methods to work with the templates shared with a namespace
+type SharedTemplatesNamespacer interface {
+	SharedTemplates(namespace string) SharedTemplateInterface
+}
+
+// SharedTemplateInterface exposes methods on the templates shared with a namespace
+type SharedTemplateInterface interface {
+	List(opts kapi.ListOptions) (*templateapi.TemplateList, error)
+}
+
+// sharedTemplates implements SharedTemplatesNamespacer interface
+type sharedTemplates struct {
+	r  *Client
+	ns string
+}
+
+// newSharedTemplates returns a sharedTemplates
+func newSharedTemplates(c *Client, namespace string) *sharedTemplates {
+	return &sharedTemplates{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List returns the templates of all projects shared with the namespace that match the label and
+// field selectors.
+func (c *sharedTemplates) List(opts kapi.ListOptions) (result *templateapi.TemplateList, err error) {
+	result = &templateapi.TemplateList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("sharedTemplates").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
@@ -200,6 +200,11 @@ func (c *Fake) Templates(namespace string) client.TemplateInterface {
 	return &FakeTemplates{Fake: c, Namespace: namespace}
 }
 
+// SharedTemplates provides a fake REST client for the templates shared with a namespace
+func (c *Fake) SharedTemplates(namespace string) client.SharedTemplateInterface {
+	return &FakeSharedTemplates{Fake: c, Namespace: namespace}
+}
+
 // TemplateConfigs provides a fake REST client for TemplateConfigs
 func (c *Fake) TemplateConfigs(namespace string) client.TemplateConfigInterface {
 	return &FakeTemplateConfigs{Fake: c, Namespace: namespace}
@@ -235,6 +240,21 @@ func (c *Fake) ProjectRequests() client.ProjectRequestInterface {
 	return &FakeProjectRequests{Fake: c}
 }
 
+// ClusterResourceQuotas provides a fake REST client for ClusterResourceQuotas
+func (c *Fake) ClusterResourceQuotas() client.ClusterResourceQuotaInterface {
+	return &FakeClusterResourceQuotas{Fake: c}
+}
+
+// PodPresets provides a fake REST client for PodPresets
+func (c *Fake) PodPresets(namespace string) client.PodPresetInterface {
+	return &FakePodPresets{Fake: c, Namespace: namespace}
+}
+
+// RoleBindingRestrictions provides a fake REST client for RoleBindingRestrictions
+func (c *Fake) RoleBindingRestrictions(namespace string) client.RoleBindingRestrictionInterface {
+	return &FakeRoleBindingRestrictions{Fake: c, Namespace: namespace}
+}
+
 // Policies provides a fake REST client for Policies
 func (c *Fake) Policies(namespace string) client.PolicyInterface {
 	return &FakePolicies{Fake: c, Namespace: namespace}
@@ -260,6 +280,16 @@ func (c *Fake) LocalResourceAccessReviews(namespace string) client.LocalResource
 	return &FakeLocalResourceAccessReviews{Fake: c}
 }
 
+// PodSecurityPolicySubjectReviews provides a fake REST client for PodSecurityPolicySubjectReviews
+func (c *Fake) PodSecurityPolicySubjectReviews(namespace string) client.PodSecurityPolicySubjectReviewInterface {
+	return &FakePodSecurityPolicySubjectReviews{Fake: c, Namespace: namespace}
+}
+
+// PodSecurityPolicyReviews provides a fake REST client for PodSecurityPolicyReviews
+func (c *Fake) PodSecurityPolicyReviews(namespace string) client.PodSecurityPolicyReviewInterface {
+	return &FakePodSecurityPolicyReviews{Fake: c, Namespace: namespace}
+}
+
 // ResourceAccessReviews provides a fake REST client for ClusterResourceAccessReviews
 func (c *Fake) ResourceAccessReviews() client.ResourceAccessReviewInterface {
 	return &FakeClusterResourceAccessReviews{Fake: c}
@@ -280,9 +310,29 @@ func (c *Fake) OAuthAccessTokens() client.OAuthAccessTokenInterface {
 	return &FakeOAuthAccessTokens{Fake: c}
 }
 
+// OAuthAuthorizeTokens provides a fake REST client for OAuthAuthorizeTokens
+func (c *Fake) OAuthAuthorizeTokens() client.OAuthAuthorizeTokenInterface {
+	return &FakeOAuthAuthorizeTokens{Fake: c}
+}
+
+// UserOAuthAccessTokens provides a fake REST client for the OAuthAccessTokens of the current user
+func (c *Fake) UserOAuthAccessTokens() client.UserOAuthAccessTokenInterface {
+	return &FakeUserOAuthAccessTokens{Fake: c}
+}
+
+// ServiceAccountTokenRequests provides a fake REST client for ServiceAccountTokenRequests
+func (c *Fake) ServiceAccountTokenRequests(namespace string) client.ServiceAccountTokenRequestInterface {
+	return &FakeServiceAccountTokenRequests{Fake: c, Namespace: namespace}
+}
+
 // LocalSubjectAccessReviews provides a fake REST client for SubjectAccessReviews
 func (c *Fake) LocalSubjectAccessReviews(namespace string) client.LocalSubjectAccessReviewInterface {
-	return &FakeLocalSubjectAccessReviews{Fake: c}
+	return &FakeLocalSubjectAccessReviews{Fake: c, Namespace: namespace}
+}
+
+// SelfSubjectRulesReviews provides a fake REST client for SelfSubjectRulesReviews
+func (c *Fake) SelfSubjectRulesReviews(namespace string) client.SelfSubjectRulesReviewInterface {
+	return &FakeSelfSubjectRulesReviews{Fake: c, Namespace: namespace}
 }
 
 // SubjectAccessReviews provides a fake REST client for ClusterSubjectAccessReviews
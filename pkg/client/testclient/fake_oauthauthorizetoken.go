@@ -0,0 +1,28 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// FakeOAuthAuthorizeTokens implements OAuthAuthorizeTokenInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeOAuthAuthorizeTokens struct {
+	Fake *Fake
+}
+
+func (c *FakeOAuthAuthorizeTokens) List(opts kapi.ListOptions) (*oauthapi.OAuthAuthorizeTokenList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewRootListAction("oauthauthorizetokens", opts), &oauthapi.OAuthAuthorizeTokenList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*oauthapi.OAuthAuthorizeTokenList), err
+}
+
+func (c *FakeOAuthAuthorizeTokens) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewRootDeleteAction("oauthauthorizetokens", name), &oauthapi.OAuthAuthorizeToken{})
+	return err
+}
@@ -0,0 +1,61 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/watch"
+
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+// FakePodPresets implements PodPresetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakePodPresets struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakePodPresets) Get(name string) (*podpresetapi.PodPreset, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("podpresets", c.Namespace, name), &podpresetapi.PodPreset{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*podpresetapi.PodPreset), err
+}
+
+func (c *FakePodPresets) List(opts kapi.ListOptions) (*podpresetapi.PodPresetList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("podpresets", c.Namespace, opts), &podpresetapi.PodPresetList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*podpresetapi.PodPresetList), err
+}
+
+func (c *FakePodPresets) Create(inObj *podpresetapi.PodPreset) (*podpresetapi.PodPreset, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("podpresets", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*podpresetapi.PodPreset), err
+}
+
+func (c *FakePodPresets) Update(inObj *podpresetapi.PodPreset) (*podpresetapi.PodPreset, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("podpresets", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*podpresetapi.PodPreset), err
+}
+
+func (c *FakePodPresets) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("podpresets", c.Namespace, name), &podpresetapi.PodPreset{})
+	return err
+}
+
+func (c *FakePodPresets) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("podpresets", c.Namespace, opts))
+}
@@ -0,0 +1,33 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	securityapi "github.com/openshift/origin/pkg/security/api"
+)
+
+type FakePodSecurityPolicySubjectReviews struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakePodSecurityPolicySubjectReviews) Create(inObj *securityapi.PodSecurityPolicySubjectReview) (*securityapi.PodSecurityPolicySubjectReview, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("podsecuritypolicysubjectreviews", c.Namespace, inObj), inObj)
+	if cast, ok := obj.(*securityapi.PodSecurityPolicySubjectReview); ok {
+		return cast, err
+	}
+	return nil, err
+}
+
+type FakePodSecurityPolicyReviews struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakePodSecurityPolicyReviews) Create(inObj *securityapi.PodSecurityPolicyReview) (*securityapi.PodSecurityPolicyReview, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("podsecuritypolicyreviews", c.Namespace, inObj), inObj)
+	if cast, ok := obj.(*securityapi.PodSecurityPolicyReview); ok {
+		return cast, err
+	}
+	return nil, err
+}
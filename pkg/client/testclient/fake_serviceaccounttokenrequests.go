@@ -0,0 +1,22 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// FakeServiceAccountTokenRequests implements ServiceAccountTokenRequestInterface. Meant to be embedded into a struct to
+// get a default implementation. This makes faking out just the methods you want to test easier.
+type FakeServiceAccountTokenRequests struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeServiceAccountTokenRequests) Create(inObj *oauthapi.ServiceAccountTokenRequest) (*oauthapi.ServiceAccountTokenRequest, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("serviceaccounttokenrequests", c.Namespace, inObj), inObj)
+	if cast, ok := obj.(*oauthapi.ServiceAccountTokenRequest); ok {
+		return cast, err
+	}
+	return nil, err
+}
@@ -0,0 +1,24 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// FakeSharedTemplates implements SharedTemplateInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeSharedTemplates struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeSharedTemplates) List(opts kapi.ListOptions) (*templateapi.TemplateList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("sharedtemplates", c.Namespace, opts), &templateapi.TemplateList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*templateapi.TemplateList), err
+}
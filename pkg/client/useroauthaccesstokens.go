@@ -0,0 +1,54 @@
+package client
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+)
+
+// UserOAuthAccessTokensInterface has methods to work with the OAuthAccessTokens of the current user
+type UserOAuthAccessTokensInterface interface {
+	UserOAuthAccessTokens() UserOAuthAccessTokenInterface
+}
+
+// UserOAuthAccessTokenInterface exposes methods on the OAuthAccessTokens of the current user.
+type UserOAuthAccessTokenInterface interface {
+	List(opts kapi.ListOptions) (*oauthapi.OAuthAccessTokenList, error)
+	Get(name string) (*oauthapi.OAuthAccessToken, error)
+	Delete(name string) error
+}
+
+// userOAuthAccessTokens implements UserOAuthAccessTokenInterface interface
+type userOAuthAccessTokens struct {
+	r *Client
+}
+
+// newUserOAuthAccessTokens returns a userOAuthAccessTokens
+func newUserOAuthAccessTokens(c *Client) *userOAuthAccessTokens {
+	return &userOAuthAccessTokens{
+		r: c,
+	}
+}
+
+// List returns the access tokens of the current user that match the label and field selectors.
+func (c *userOAuthAccessTokens) List(opts kapi.ListOptions) (result *oauthapi.OAuthAccessTokenList, err error) {
+	result = &oauthapi.OAuthAccessTokenList{}
+	err = c.r.Get().
+		Resource("userOAuthAccessTokens").
+		VersionedParams(&opts, kapi.Scheme).
+		Do().
+		Into(result)
+	return
+}
+
+// Get returns a particular access token of the current user or an error
+func (c *userOAuthAccessTokens) Get(name string) (result *oauthapi.OAuthAccessToken, err error) {
+	result = &oauthapi.OAuthAccessToken{}
+	err = c.r.Get().Resource("userOAuthAccessTokens").Name(name).Do().Into(result)
+	return
+}
+
+// Delete revokes an access token of the current user
+func (c *userOAuthAccessTokens) Delete(name string) error {
+	return c.r.Delete().Resource("userOAuthAccessTokens").Name(name).Do().Error()
+}
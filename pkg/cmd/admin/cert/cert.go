@@ -25,6 +25,8 @@ func NewCmdCert(name, fullName string, out io.Writer) *cobra.Command {
 	cmds.AddCommand(admin.NewCommandCreateKeyPair(admin.CreateKeyPairCommandName, fullName+" "+admin.CreateKeyPairCommandName, out))
 	cmds.AddCommand(admin.NewCommandCreateServerCert(admin.CreateServerCertCommandName, fullName+" "+admin.CreateServerCertCommandName, out))
 	cmds.AddCommand(admin.NewCommandCreateSignerCert(admin.CreateSignerCertCommandName, fullName+" "+admin.CreateSignerCertCommandName, out))
+	cmds.AddCommand(admin.NewCommandCheckExpiry(admin.CheckExpiryCommandName, fullName+" "+admin.CheckExpiryCommandName, out))
+	cmds.AddCommand(admin.NewCommandReissueCert(admin.ReissueCertCommandName, fullName+" "+admin.ReissueCertCommandName, out))
 
 	return cmds
 }
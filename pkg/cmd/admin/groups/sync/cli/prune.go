@@ -34,6 +34,10 @@ The path to a sync configuration file that was used for syncing the groups in qu
 describe how data is requested from the external record store. Default behavior is to indicate all OpenShift groups
 for which the external record does not exist, to run the pruning process and commit the results, use the --confirm
 flag.
+
+With --identity-provider, the identities that the named LDAP identity provider created for users whose LDAP entry
+no longer exists are pruned as well, together with their users if they have no other identity. The provider must
+identify users by the DN of their LDAP entry. Whitelists and blacklists only apply to groups.
 `
 	pruneExamples = `  # Prune all orphaned groups
   $ %[1]s --sync-config=/path/to/ldap-sync-config.yaml --confirm
@@ -46,6 +50,9 @@ flag.
 
   # Prune all orphaned groups from a list of specific groups specified in a whitelist
   $ %[1]s groups/group_name groups/other_name --sync-config=/path/to/ldap-sync-config.yaml --confirm
+
+  # Prune all orphaned groups, and the identities and users of the "ldap" identity provider without an LDAP entry
+  $ %[1]s --identity-provider=ldap --sync-config=/path/to/ldap-sync-config.yaml --confirm
 `
 )
 
@@ -62,9 +69,18 @@ type PruneOptions struct {
 	// Confirm determines whether or not to write to OpenShift
 	Confirm bool
 
+	// IdentityProvider is the name of the identity provider whose identities are pruned, if set
+	IdentityProvider string
+
 	// GroupsInterface is the interface used to interact with OpenShift Group objects
 	GroupInterface osclient.GroupInterface
 
+	// IdentityInterface is the interface used to interact with OpenShift Identity objects
+	IdentityInterface osclient.IdentityInterface
+
+	// UserInterface is the interface used to interact with OpenShift User objects
+	UserInterface osclient.UserInterface
+
 	// Stderr is the writer to write warnings and errors to
 	Stderr io.Writer
 
@@ -125,6 +141,7 @@ func NewCmdPrune(name, fullName string, f *clientcmd.Factory, out io.Writer) *co
 	cmd.MarkFlagFilename("sync-config", "yaml", "yml")
 
 	cmd.Flags().BoolVar(&options.Confirm, "confirm", false, "if true, modify OpenShift groups; if false, display groups")
+	cmd.Flags().StringVar(&options.IdentityProvider, "identity-provider", options.IdentityProvider, "if set, also prune the identities of this LDAP identity provider, and their users, whose LDAP entry no longer exists")
 
 	return cmd
 }
@@ -151,6 +168,8 @@ func (o *PruneOptions) Complete(whitelistFile, blacklistFile, configFile string,
 		return err
 	}
 	o.GroupInterface = osClient.Groups()
+	o.IdentityInterface = osClient.Identities()
+	o.UserInterface = osClient.Users()
 
 	return nil
 }
@@ -160,6 +179,14 @@ func (o *PruneOptions) Validate() error {
 	if o.GroupInterface == nil {
 		results.Errors = append(results.Errors, field.Required(field.NewPath("groupInterface")))
 	}
+	if len(o.IdentityProvider) > 0 {
+		if o.IdentityInterface == nil {
+			results.Errors = append(results.Errors, field.Required(field.NewPath("identityInterface")))
+		}
+		if o.UserInterface == nil {
+			results.Errors = append(results.Errors, field.Required(field.NewPath("userInterface")))
+		}
+	}
 	// TODO(skuznets): pretty-print validation results
 	if len(results.Errors) > 0 {
 		return fmt.Errorf("validation of LDAP sync config failed: %v", results.Errors.ToAggregate())
@@ -204,8 +231,21 @@ func (o *PruneOptions) Run(cmd *cobra.Command, f *clientcmd.Factory) error {
 
 	// Now we run the pruner and report any errors
 	pruneErrors := pruner.Prune()
-	return kerrs.NewAggregate(pruneErrors)
 
+	if len(o.IdentityProvider) > 0 {
+		identityPruner := &syncgroups.LDAPIdentityPruner{
+			ProviderName:   o.IdentityProvider,
+			UserDetector:   syncgroups.NewDNUserDetector(clientConfig),
+			IdentityClient: o.IdentityInterface,
+			UserClient:     o.UserInterface,
+			DryRun:         !o.Confirm,
+
+			Out: o.Out,
+			Err: os.Stderr,
+		}
+		pruneErrors = append(pruneErrors, identityPruner.Prune()...)
+	}
+	return kerrs.NewAggregate(pruneErrors)
 }
 
 func buildPruneBuilder(clientConfig ldapclient.Config, pruneConfig *api.LDAPSyncConfig) (PruneBuilder, error) {
@@ -0,0 +1,150 @@
+package syncgroups
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+	"gopkg.in/ldap.v2"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+
+	"github.com/openshift/origin/pkg/auth/ldaputil"
+	"github.com/openshift/origin/pkg/auth/ldaputil/ldapclient"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/admin/groups/sync/interfaces"
+)
+
+// LDAPIdentityPruner prunes the Identities of an LDAP identity provider whose LDAP user entry no
+// longer exists, together with their Users if they have no other Identity
+type LDAPIdentityPruner struct {
+	// ProviderName is the name of the identity provider whose Identities are pruned
+	ProviderName string
+	// Determines if the LDAP user entry of an Identity exists
+	UserDetector interfaces.LDAPUserDetector
+	// Allows the Pruner to search for and delete OpenShift Identities
+	IdentityClient client.IdentityInterface
+	// Allows the Pruner to search for and delete OpenShift Users
+	UserClient client.UserInterface
+	// DryRun indicates that no changes should be made.
+	DryRun bool
+
+	// Out is used to provide output while the prune job is happening
+	Out io.Writer
+	Err io.Writer
+}
+
+// Prune deletes the Identities of the provider whose LDAP user entry does not exist anymore
+func (s *LDAPIdentityPruner) Prune() []error {
+	var errors []error
+
+	identities, err := s.IdentityClient.List(kapi.ListOptions{})
+	if err != nil {
+		errors = append(errors, err)
+		return errors
+	}
+
+	for i := range identities.Items {
+		identity := &identities.Items[i]
+		if identity.ProviderName != s.ProviderName {
+			continue
+		}
+		glog.V(1).Infof("Checking LDAP user %v", identity.ProviderUserName)
+
+		exists, err := s.UserDetector.Exists(identity.ProviderUserName)
+		if err != nil {
+			fmt.Fprintf(s.Err, "Error determining LDAP user existence for identity %q: %v.\n", identity.Name, err)
+			errors = append(errors, err)
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		// if the LDAP entry that the identity was created for doesn't exist, prune it
+		if !s.DryRun {
+			if err := s.IdentityClient.Delete(identity.Name); err != nil && !kerrs.IsNotFound(err) {
+				fmt.Fprintf(s.Err, "Error pruning OpenShift identity %q: %v.\n", identity.Name, err)
+				errors = append(errors, err)
+				continue
+			}
+		}
+		fmt.Fprintf(s.Out, "identity/%s\n", identity.Name)
+
+		if err := s.pruneUser(identity.Name, identity.User); err != nil {
+			fmt.Fprintf(s.Err, "Error pruning OpenShift user %q: %v.\n", identity.User.Name, err)
+			errors = append(errors, err)
+		}
+	}
+
+	return errors
+}
+
+// pruneUser deletes the user of a pruned identity if it has no other identity
+func (s *LDAPIdentityPruner) pruneUser(identityName string, userRef kapi.ObjectReference) error {
+	if len(userRef.Name) == 0 {
+		return nil
+	}
+	user, err := s.UserClient.Get(userRef.Name)
+	if kerrs.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the user was deleted and created again, or gained other identities
+	if user.UID != userRef.UID {
+		return nil
+	}
+	for _, other := range user.Identities {
+		if other != identityName {
+			return nil
+		}
+	}
+
+	if !s.DryRun {
+		if err := s.UserClient.Delete(user.Name); err != nil && !kerrs.IsNotFound(err) {
+			return err
+		}
+	}
+	fmt.Fprintf(s.Out, "user/%s\n", user.Name)
+	return nil
+}
+
+// NewDNUserDetector returns an LDAPUserDetector for identity providers that identify users by the
+// DN of their LDAP entry
+func NewDNUserDetector(clientConfig ldapclient.Config) interfaces.LDAPUserDetector {
+	return &DNUserDetector{clientConfig: clientConfig}
+}
+
+// DNUserDetector is an LDAPUserDetector that determines user existence by searching for the LDAP
+// entry with the DN given as the LDAP user UID
+type DNUserDetector struct {
+	clientConfig ldapclient.Config
+}
+
+func (d *DNUserDetector) Exists(ldapUserUID string) (bool, error) {
+	if _, err := ldap.ParseDN(ldapUserUID); err != nil {
+		return false, fmt.Errorf("could not search by dn, invalid dn value: %v", err)
+	}
+	query := ldap.NewSearchRequest(
+		ldapUserUID,
+		ldap.ScopeBaseObject,
+		ldap.NeverDerefAliases,
+		0,                 // allowed return size - indicates no limit
+		0,                 // no time limit
+		false,             // not types only
+		"(objectClass=*)", // filter that returns all values
+		[]string{"dn"},
+		nil, // no controls
+	)
+	entries, err := ldaputil.QueryForEntries(d.clientConfig, query)
+	if ldaputil.IsNoSuchObjectError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return len(entries) > 0, nil
+}
@@ -0,0 +1,148 @@
+package syncgroups
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/admin/groups/sync/interfaces"
+	userapi "github.com/openshift/origin/pkg/user/api"
+)
+
+const (
+	User1DN = "cn=user1,ou=users,dc=example,dc=com"
+	User2DN = "cn=user2,ou=users,dc=example,dc=com"
+	User3DN = "cn=user3,ou=users,dc=example,dc=com"
+)
+
+func TestIdentityPrune(t *testing.T) {
+	pruner, tc := newTestIdentityPruner()
+	errs := pruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	// user2 is gone from LDAP, user3 is gone too but its user has another identity
+	checkDeleted(tc, "identities", []string{"ldap:" + User2DN, "ldap:" + User3DN}, t)
+	checkDeleted(tc, "users", []string{"user2"}, t)
+}
+
+func TestIdentityPruneDryRun(t *testing.T) {
+	pruner, tc := newTestIdentityPruner()
+	pruner.DryRun = true
+	errs := pruner.Prune()
+	for _, err := range errs {
+		t.Errorf("unexpected prune error: %v", err)
+	}
+
+	checkDeleted(tc, "identities", []string{}, t)
+	checkDeleted(tc, "users", []string{}, t)
+}
+
+// TestIdentityPruneDetectFails tests that a failure locating a user does not fail the entire prune
+// job, or cause it to prune that identity
+func TestIdentityPruneDetectFails(t *testing.T) {
+	pruner, tc := newTestIdentityPruner()
+	detectErr := fmt.Errorf("error during location for user: %s", User2DN)
+	pruner.UserDetector.(*TestUserDetector).SourceOfErrors[User2DN] = detectErr
+
+	errs := pruner.Prune()
+	if len(errs) != 1 || errs[0] != detectErr {
+		t.Errorf("unexpected prune errors: %v", errs)
+	}
+
+	checkDeleted(tc, "identities", []string{"ldap:" + User3DN}, t)
+	checkDeleted(tc, "users", []string{}, t)
+}
+
+func checkDeleted(tc *testclient.Fake, resource string, expected []string, t *testing.T) {
+	actual := sets.NewString()
+	for _, genericAction := range tc.Actions() {
+		if action, ok := genericAction.(ktestclient.DeleteAction); ok && action.GetVerb() == "delete" && action.GetResource() == resource {
+			actual.Insert(action.GetName())
+		}
+	}
+	if wanted := sets.NewString(expected...); !actual.Equal(wanted) {
+		t.Errorf("did not delete correct %s:\n\twanted:\n\t%v\n\tgot:\n\t%v\n", resource, wanted.List(), actual.List())
+	}
+}
+
+func newTestIdentity(providerName, providerUserName, userName string) *userapi.Identity {
+	return &userapi.Identity{
+		ObjectMeta:       kapi.ObjectMeta{Name: providerName + ":" + providerUserName},
+		ProviderName:     providerName,
+		ProviderUserName: providerUserName,
+		User:             kapi.ObjectReference{Name: userName, UID: types.UID(userName + "-uid")},
+	}
+}
+
+func newTestUser(name string, identities ...string) *userapi.User {
+	return &userapi.User{
+		ObjectMeta: kapi.ObjectMeta{Name: name, UID: types.UID(name + "-uid")},
+		Identities: identities,
+	}
+}
+
+func newTestIdentityPruner() (*LDAPIdentityPruner, *testclient.Fake) {
+	identities := &userapi.IdentityList{
+		Items: []userapi.Identity{
+			*newTestIdentity("ldap", User1DN, "user1"),
+			*newTestIdentity("ldap", User2DN, "user2"),
+			*newTestIdentity("ldap", User3DN, "user3"),
+			// identities of other providers are never pruned
+			*newTestIdentity("github", "user4", "user4"),
+		},
+	}
+	users := map[string]*userapi.User{
+		"user1": newTestUser("user1", "ldap:"+User1DN),
+		"user2": newTestUser("user2", "ldap:"+User2DN),
+		"user3": newTestUser("user3", "ldap:"+User3DN, "github:user3"),
+		"user4": newTestUser("user4", "github:user4"),
+	}
+
+	tc := &testclient.Fake{}
+	tc.AddReactor("list", "identities", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, identities, nil
+	})
+	tc.AddReactor("get", "users", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		if user, ok := users[name]; ok {
+			return true, user, nil
+		}
+		return true, nil, kerrs.NewNotFound("User", name)
+	})
+	tc.AddReactor("delete", "*", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, nil
+	})
+
+	return &LDAPIdentityPruner{
+		ProviderName: "ldap",
+		UserDetector: &TestUserDetector{
+			SourceOfTruth:  map[string]bool{User1DN: true, "user4": false},
+			SourceOfErrors: map[string]error{},
+		},
+		IdentityClient: tc.Identities(),
+		UserClient:     tc.Users(),
+		Out:            ioutil.Discard,
+		Err:            ioutil.Discard,
+	}, tc
+}
+
+var _ interfaces.LDAPUserDetector = &TestUserDetector{}
+
+type TestUserDetector struct {
+	SourceOfTruth  map[string]bool
+	SourceOfErrors map[string]error
+}
+
+func (l *TestUserDetector) Exists(ldapUserUID string) (bool, error) {
+	return l.SourceOfTruth[ldapUserUID], l.SourceOfErrors[ldapUserUID]
+}
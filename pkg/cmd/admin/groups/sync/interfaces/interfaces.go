@@ -40,3 +40,8 @@ type LDAPGroupListerNameMapper interface {
 type LDAPGroupDetector interface {
 	Exists(ldapGroupUID string) (exists bool, err error)
 }
+
+// LDAPUserDetector determines if a user identified by an LDAP user UID exists on the LDAP server
+type LDAPUserDetector interface {
+	Exists(ldapUserUID string) (exists bool, err error)
+}
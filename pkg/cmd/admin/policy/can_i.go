@@ -0,0 +1,224 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/cli/describe"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const CanIRecommendedName = "can-i"
+
+const canILong = `Check whether you can perform the specified action on a resource
+
+Prints yes if you are allowed to perform VERB on RESOURCE in the current namespace, and no otherwise.  The
+command exits with status 0 if the action is allowed and 1 if it is not, so --quiet can be used to check access
+from scripts.  A subresource is checked by naming it after the resource, as in pods/log.  If NAME is given, only
+access to the resource with that name is checked.  A non-resource URL, such as /healthz, may be checked instead
+of a resource; non-resource URLs are always checked for the whole cluster.
+
+--scopes checks what a token with those scopes could do instead of what your current token can do; pass an
+empty value to check your unscoped access.  --user, --groups, and --serviceaccount check the access of someone
+else, which requires permission to review their access.
+
+With --list, the rules you have in the current namespace are listed instead, from both cluster and project
+policy.  If you are logged in with a scoped token, only the rules its scopes allow are listed.`
+
+const canIExample = `  # Check whether you can create pods in the current namespace
+  $ %[1]s create pods
+
+  # Check whether you can read the logs of the pod named frontend
+  $ %[1]s get pods/log frontend
+
+  # Check whether a token that can only view the current namespace could delete deployment configs
+  $ %[1]s delete deploymentconfigs --scopes=role:view:myproject
+
+  # Check whether the deployer service account can update replication controllers, from a script
+  $ %[1]s update replicationcontrollers -z deployer -q && echo allowed
+
+  # List everything you can do in the current namespace
+  $ %[1]s --list`
+
+type canIOptions struct {
+	list      bool
+	quiet     bool
+	namespace string
+	client    client.Interface
+
+	verb           string
+	resource       string
+	resourceName   string
+	nonResourceURL string
+
+	// scopes is nil unless scopes were requested, in which case an empty list means no scopes
+	scopes         []string
+	user           string
+	groups         []string
+	serviceAccount string
+
+	out io.Writer
+}
+
+// NewCmdCanI implements the OpenShift cli can-i command
+func NewCmdCanI(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &canIOptions{out: out}
+	scopes := ""
+
+	cmd := &cobra.Command{
+		Use:     name + " VERB (RESOURCE[/SUBRESOURCE] [NAME] | NONRESOURCEURL) | --list",
+		Short:   "Check whether you can perform the specified action on a resource",
+		Long:    canILong,
+		Example: fmt.Sprintf(canIExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if cmd.Flags().Lookup("scopes").Changed {
+				options.scopes = splitScopes(scopes)
+			}
+			if err := options.complete(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			var err error
+			options.client, _, err = f.Clients()
+			kcmdutil.CheckErr(err)
+
+			options.namespace, _, err = f.DefaultNamespace()
+			kcmdutil.CheckErr(err)
+
+			allowed, err := options.run()
+			kcmdutil.CheckErr(err)
+			if !allowed {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.list, "list", options.list, "If true, list all the rules you have in the current namespace.")
+	cmd.Flags().BoolVarP(&options.quiet, "quiet", "q", options.quiet, "If true, print nothing and only report the result through the exit status.")
+	cmd.Flags().StringVar(&scopes, "scopes", scopes, "Comma separated scopes to check access as if restricted to.  An empty value checks unscoped access.")
+	cmd.Flags().StringVar(&options.user, "user", options.user, "Check the access of this user instead of your own.")
+	cmd.Flags().StringSliceVar(&options.groups, "groups", options.groups, "Check the access of a user in these groups instead of your own.")
+	cmd.Flags().StringVarP(&options.serviceAccount, "serviceaccount", "z", options.serviceAccount, "Check the access of this service account in the current namespace instead of your own.")
+
+	return cmd
+}
+
+func (o *canIOptions) complete(args []string) error {
+	if len(o.serviceAccount) > 0 && (len(o.user) > 0 || len(o.groups) > 0) {
+		return errors.New("--serviceaccount may not be combined with --user or --groups")
+	}
+
+	if o.list {
+		if len(args) != 0 {
+			return errors.New("no arguments may be specified with --list")
+		}
+		if len(o.user) > 0 || len(o.groups) > 0 || len(o.serviceAccount) > 0 {
+			return errors.New("--list only lists your own rules and may not be combined with --user, --groups, or --serviceaccount")
+		}
+		return nil
+	}
+
+	if len(args) < 2 || len(args) > 3 {
+		return errors.New("you must specify two or three arguments: verb, resource, and optional resourceName")
+	}
+
+	o.verb = args[0]
+	if strings.HasPrefix(args[1], "/") {
+		if len(args) == 3 {
+			return errors.New("a resource name may not be specified with a non-resource URL")
+		}
+		o.nonResourceURL = args[1]
+		return nil
+	}
+
+	o.resource = args[1]
+	if len(args) == 3 {
+		o.resourceName = args[2]
+	}
+	return nil
+}
+
+// run prints the result of the check and returns whether the action is allowed.  Listing rules always succeeds.
+func (o *canIOptions) run() (bool, error) {
+	if o.list {
+		return true, o.listRules()
+	}
+
+	action := authorizationapi.AuthorizationAttributes{
+		Verb:         o.verb,
+		Resource:     o.resource,
+		ResourceName: o.resourceName,
+	}
+	user, groups := o.user, sets.NewString(o.groups...)
+	if len(o.serviceAccount) > 0 {
+		user = serviceaccount.MakeUsername(o.namespace, o.serviceAccount)
+		// service accounts are authenticated like any other user
+		groups = sets.NewString(serviceaccount.MakeGroupNames(o.namespace, o.serviceAccount)...)
+		groups.Insert(bootstrappolicy.AuthenticatedGroup)
+	}
+
+	var response *authorizationapi.SubjectAccessReviewResponse
+	var err error
+	// non-resource URLs are not namespaced, so they can only be checked for the whole cluster
+	if len(o.nonResourceURL) > 0 {
+		action.IsNonResourceURL = true
+		action.Path = o.nonResourceURL
+		response, err = o.client.SubjectAccessReviews().Create(&authorizationapi.SubjectAccessReview{Action: action, User: user, Groups: groups, Scopes: o.scopes})
+	} else {
+		response, err = o.client.LocalSubjectAccessReviews(o.namespace).Create(&authorizationapi.LocalSubjectAccessReview{Action: action, User: user, Groups: groups, Scopes: o.scopes})
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !o.quiet {
+		if response.Allowed {
+			fmt.Fprintln(o.out, "yes")
+		} else {
+			fmt.Fprintln(o.out, "no")
+		}
+	}
+	return response.Allowed, nil
+}
+
+func (o *canIOptions) listRules() error {
+	review, err := o.client.SelfSubjectRulesReviews(o.namespace).Create(&authorizationapi.SelfSubjectRulesReview{
+		Spec: authorizationapi.SelfSubjectRulesReviewSpec{Scopes: o.scopes},
+	})
+	if err != nil {
+		return err
+	}
+	if o.quiet {
+		return nil
+	}
+
+	description, err := describe.DescribeSelfSubjectRulesReview(review)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.out, description)
+	return nil
+}
+
+// splitScopes returns the comma separated scopes, which is an empty list rather than nil if there are none
+func splitScopes(value string) []string {
+	scopes := []string{}
+	for _, scope := range strings.Split(value, ",") {
+		if scope = strings.TrimSpace(scope); len(scope) > 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,161 @@
+package policy
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+func TestCanIComplete(t *testing.T) {
+	tests := map[string]struct {
+		list     bool
+		options  canIOptions
+		args     []string
+		expected canIOptions
+		err      bool
+	}{
+		"resource with name": {
+			args:     []string{"get", "pods", "mypod"},
+			expected: canIOptions{verb: "get", resource: "pods", resourceName: "mypod"},
+		},
+		"non-resource URL": {
+			args:     []string{"get", "/metrics"},
+			expected: canIOptions{verb: "get", nonResourceURL: "/metrics"},
+		},
+		"list": {
+			list:     true,
+			expected: canIOptions{list: true},
+		},
+		"list with arguments": {
+			list: true,
+			args: []string{"get", "pods"},
+			err:  true,
+		},
+		"missing resource": {
+			args: []string{"get"},
+			err:  true,
+		},
+		"service account and user": {
+			options: canIOptions{serviceAccount: "deployer", user: "alice"},
+			args:    []string{"get", "pods"},
+			err:     true,
+		},
+		"list for another user": {
+			options: canIOptions{list: true, user: "alice"},
+			err:     true,
+		},
+	}
+
+	for name, test := range tests {
+		options := &test.options
+		if test.list {
+			options.list = true
+		}
+		err := options.complete(test.args)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(*options, test.expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, test.expected, *options)
+		}
+	}
+}
+
+func TestCanIRun(t *testing.T) {
+	var lastReview *authorizationapi.LocalSubjectAccessReview
+	client := &testclient.Fake{}
+	client.AddReactor("create", "localsubjectaccessreviews", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		lastReview = action.(ktestclient.CreateAction).GetObject().(*authorizationapi.LocalSubjectAccessReview)
+		return true, &authorizationapi.SubjectAccessReviewResponse{Allowed: lastReview.Action.Resource == "pods"}, nil
+	})
+	client.AddReactor("create", "selfsubjectrulesreviews", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &authorizationapi.SelfSubjectRulesReview{
+			Status: authorizationapi.SubjectRulesReviewStatus{
+				Rules: []authorizationapi.PolicyRule{{Verbs: sets.NewString("get"), Resources: sets.NewString("pods")}},
+			},
+		}, nil
+	})
+
+	tests := []struct {
+		options         canIOptions
+		expected        string
+		expectedAllowed bool
+		expectedReview  *authorizationapi.LocalSubjectAccessReview
+	}{
+		{options: canIOptions{verb: "get", resource: "pods"}, expected: "yes\n", expectedAllowed: true},
+		{options: canIOptions{verb: "get", resource: "secrets"}, expected: "no\n"},
+		{options: canIOptions{verb: "get", resource: "secrets", quiet: true}},
+		{options: canIOptions{list: true}, expected: "[get]", expectedAllowed: true},
+		{
+			options:         canIOptions{verb: "get", resource: "pods", scopes: []string{}},
+			expected:        "yes\n",
+			expectedAllowed: true,
+			expectedReview: &authorizationapi.LocalSubjectAccessReview{
+				Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "pods"},
+				Groups: sets.NewString(),
+				Scopes: []string{},
+			},
+		},
+		{
+			options:         canIOptions{verb: "get", resource: "pods", serviceAccount: "deployer", quiet: true},
+			expectedAllowed: true,
+			expectedReview: &authorizationapi.LocalSubjectAccessReview{
+				Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "pods"},
+				User:   "system:serviceaccount:project:deployer",
+				Groups: sets.NewString("system:serviceaccounts", "system:serviceaccounts:project", "system:authenticated"),
+			},
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		lastReview = nil
+		test.options.namespace = "project"
+		test.options.client = client
+		test.options.out = out
+		allowed, err := test.options.run()
+		if err != nil {
+			t.Errorf("%#v: unexpected error: %v", test.options, err)
+			continue
+		}
+		if allowed != test.expectedAllowed {
+			t.Errorf("%#v: expected allowed %v, got %v", test.options, test.expectedAllowed, allowed)
+		}
+		if len(test.expected) == 0 && out.Len() != 0 {
+			t.Errorf("%#v: expected no output, got %q", test.options, out.String())
+		}
+		if !strings.Contains(out.String(), test.expected) {
+			t.Errorf("%#v: expected output containing %q, got %q", test.options, test.expected, out.String())
+		}
+		if test.expectedReview != nil && !reflect.DeepEqual(lastReview, test.expectedReview) {
+			t.Errorf("%#v: expected review %#v, got %#v", test.options, test.expectedReview, lastReview)
+		}
+	}
+}
+
+func TestSplitScopes(t *testing.T) {
+	tests := map[string][]string{
+		"":                        {},
+		"user:info":               {"user:info"},
+		"user:info, role:view:ns": {"user:info", "role:view:ns"},
+	}
+	for value, expected := range tests {
+		if actual := splitScopes(value); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("%q: expected %v, got %v", value, expected, actual)
+		}
+	}
+}
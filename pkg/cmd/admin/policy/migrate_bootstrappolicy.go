@@ -0,0 +1,260 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+// MigrateBootstrapPolicyRecommendedName is the recommended command name
+const MigrateBootstrapPolicyRecommendedName = "migrate-bootstrap-policy"
+
+type MigrateBootstrapPolicyOptions struct {
+	Confirmed bool
+
+	Out io.Writer
+
+	RoleClient        client.ClusterRoleInterface
+	RoleBindingClient client.ClusterRoleBindingInterface
+}
+
+const (
+	migrateBootstrapPolicyLong = `
+Migrate cluster roles and role bindings to the current bootstrap policy
+
+The bootstrap policy is versioned. The version a cluster was created with or last migrated to is recorded
+in the openshift.io/bootstrap-policy-version annotation of the cluster-admin cluster role. Each newer version
+comes with a migration that makes its changes to the cluster roles and role bindings, leaving customizations
+that do not conflict with it in place. Cluster roles protected by the openshift.io/reconcile-protect annotation
+are not changed.
+
+By default the changes each pending migration would make are listed without being made. Migrations are
+applied in order and the version is recorded after each of them, so an interrupted migration can be resumed
+by running the command again.`
+
+	migrateBootstrapPolicyExample = `  # Display the changes the pending migrations would make
+  $ %[1]s
+
+  # Migrate the cluster roles and role bindings to the current bootstrap policy
+  $ %[1]s --confirm`
+)
+
+// NewCmdMigrateBootstrapPolicy implements the OpenShift cli migrate-bootstrap-policy command
+func NewCmdMigrateBootstrapPolicy(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	o := &MigrateBootstrapPolicyOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Migrate cluster roles and role bindings to the current bootstrap policy",
+		Long:    migrateBootstrapPolicyLong,
+		Example: fmt.Sprintf(migrateBootstrapPolicyExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := o.Complete(f, args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			if err := o.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			if err := o.MigrateBootstrapPolicy(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.Confirmed, "confirm", o.Confirmed, "Specify that the migrations should be applied. Defaults to false, displaying what would be changed but not actually changing anything.")
+
+	return cmd
+}
+
+func (o *MigrateBootstrapPolicyOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are allowed")
+	}
+
+	oclient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.RoleClient = oclient.ClusterRoles()
+	o.RoleBindingClient = oclient.ClusterRoleBindings()
+
+	return nil
+}
+
+func (o *MigrateBootstrapPolicyOptions) Validate() error {
+	if o.RoleClient == nil {
+		return errors.New("a role client is required")
+	}
+	if o.RoleBindingClient == nil {
+		return errors.New("a role binding client is required")
+	}
+	return nil
+}
+
+// MigrateBootstrapPolicy runs the migrations newer than the version of the bootstrap policy recorded on the cluster,
+// reporting the changes each of them makes
+func (o *MigrateBootstrapPolicyOptions) MigrateBootstrapPolicy() error {
+	clusterAdmin, err := o.RoleClient.Get(bootstrappolicy.ClusterAdminRoleName)
+	if err != nil {
+		return err
+	}
+	version, err := bootstrappolicy.GetPolicyVersion(clusterAdmin)
+	if err != nil {
+		return err
+	}
+
+	migrations := bootstrappolicy.PendingPolicyMigrations(version)
+	if len(migrations) == 0 {
+		fmt.Fprintf(o.Out, "The bootstrap policy is at version %d, there is nothing to migrate\n", version)
+		return nil
+	}
+
+	state, err := o.getState()
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		fmt.Fprintf(o.Out, "Version %d: %s\n", migration.Version, migration.Description)
+
+		migrated, err := state.Copy()
+		if err != nil {
+			return err
+		}
+		if err := migration.Migrate(migrated); err != nil {
+			return fmt.Errorf("unable to migrate to version %d: %v", migration.Version, err)
+		}
+		if err := o.applyChanges(state, migrated); err != nil {
+			return err
+		}
+
+		if o.Confirmed {
+			if err := o.setVersion(migration.Version); err != nil {
+				return err
+			}
+		}
+		state = migrated
+	}
+
+	if !o.Confirmed {
+		fmt.Fprintf(o.Out, "Run with --confirm to migrate from version %d to %d\n", version, migrations[len(migrations)-1].Version)
+	}
+	return nil
+}
+
+// getState returns the current cluster roles and cluster role bindings
+func (o *MigrateBootstrapPolicyOptions) getState() (*bootstrappolicy.PolicyState, error) {
+	state := &bootstrappolicy.PolicyState{
+		ClusterRoles:        map[string]*authorizationapi.ClusterRole{},
+		ClusterRoleBindings: map[string]*authorizationapi.ClusterRoleBinding{},
+	}
+
+	roles, err := o.RoleClient.List(kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range roles.Items {
+		state.ClusterRoles[roles.Items[i].Name] = &roles.Items[i]
+	}
+
+	bindings, err := o.RoleBindingClient.List(kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range bindings.Items {
+		state.ClusterRoleBindings[bindings.Items[i].Name] = &bindings.Items[i]
+	}
+
+	return state, nil
+}
+
+// applyChanges reports the differences between the two states, and makes them unless this is a dry run
+func (o *MigrateBootstrapPolicyOptions) applyChanges(from, to *bootstrappolicy.PolicyState) error {
+	for _, name := range sets.StringKeySet(from.ClusterRoles).Union(sets.StringKeySet(to.ClusterRoles)).List() {
+		oldRole, newRole := from.ClusterRoles[name], to.ClusterRoles[name]
+		var err error
+		switch {
+		case oldRole == nil:
+			o.report("clusterrole", name, "created")
+			if o.Confirmed {
+				_, err = o.RoleClient.Create(newRole)
+			}
+		case newRole == nil:
+			o.report("clusterrole", name, "deleted")
+			if o.Confirmed {
+				err = o.RoleClient.Delete(name)
+			}
+		case !kapi.Semantic.DeepEqual(oldRole, newRole):
+			o.report("clusterrole", name, "updated")
+			if o.Confirmed {
+				_, err = o.RoleClient.Update(newRole)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sets.StringKeySet(from.ClusterRoleBindings).Union(sets.StringKeySet(to.ClusterRoleBindings)).List() {
+		oldBinding, newBinding := from.ClusterRoleBindings[name], to.ClusterRoleBindings[name]
+		var err error
+		switch {
+		case oldBinding == nil:
+			o.report("clusterrolebinding", name, "created")
+			if o.Confirmed {
+				_, err = o.RoleBindingClient.Create(newBinding)
+			}
+		case newBinding == nil:
+			o.report("clusterrolebinding", name, "deleted")
+			if o.Confirmed {
+				err = o.RoleBindingClient.Delete(name)
+			}
+		case !kapi.Semantic.DeepEqual(oldBinding, newBinding):
+			o.report("clusterrolebinding", name, "updated")
+			if o.Confirmed {
+				_, err = o.RoleBindingClient.Update(newBinding)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setVersion records the version of the bootstrap policy on the cluster-admin role, which the migrations may have
+// changed
+func (o *MigrateBootstrapPolicyOptions) setVersion(version int) error {
+	clusterAdmin, err := o.RoleClient.Get(bootstrappolicy.ClusterAdminRoleName)
+	if kapierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to record version %d of the bootstrap policy: clusterrole/%s was not found", version, bootstrappolicy.ClusterAdminRoleName)
+	}
+	if err != nil {
+		return err
+	}
+	bootstrappolicy.SetPolicyVersion(clusterAdmin, version)
+	_, err = o.RoleClient.Update(clusterAdmin)
+	return err
+}
+
+func (o *MigrateBootstrapPolicyOptions) report(resource, name, change string) {
+	if o.Confirmed {
+		fmt.Fprintf(o.Out, "  %s/%s: %s\n", resource, name, change)
+		return
+	}
+	fmt.Fprintf(o.Out, "  %s/%s: would be %s\n", resource, name, change)
+}
@@ -0,0 +1,114 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+)
+
+func TestMigrateBootstrapPolicy(t *testing.T) {
+	// the admin role before service account token requests were added
+	admin := &authorizationapi.ClusterRole{
+		ObjectMeta: kapi.ObjectMeta{Name: bootstrappolicy.AdminRoleName},
+		Rules:      []authorizationapi.PolicyRule{{Verbs: sets.NewString("get"), Resources: sets.NewString("pods")}},
+	}
+	current := bootstrapClusterRole(bootstrappolicy.ClusterAdminRoleName)
+	old := bootstrapClusterRole(bootstrappolicy.ClusterAdminRoleName)
+	old.Annotations = nil
+
+	tests := map[string]struct {
+		ClusterAdmin    *authorizationapi.ClusterRole
+		Confirmed       bool
+		ExpectedOutput  []string
+		ExpectedUpdates []string
+	}{
+		"current version": {
+			ClusterAdmin:   current,
+			ExpectedOutput: []string{fmt.Sprintf("The bootstrap policy is at version %d, there is nothing to migrate", bootstrappolicy.BootstrapPolicyVersion())},
+		},
+		"dry run": {
+			ClusterAdmin:   old,
+			ExpectedOutput: []string{"Version 1: ", "  clusterrole/admin: would be updated", fmt.Sprintf("Run with --confirm to migrate from version 0 to %d", bootstrappolicy.BootstrapPolicyVersion())},
+		},
+		"confirmed": {
+			ClusterAdmin:    old,
+			Confirmed:       true,
+			ExpectedOutput:  []string{"Version 1: ", "  clusterrole/admin: updated"},
+			ExpectedUpdates: []string{bootstrappolicy.AdminRoleName, bootstrappolicy.ClusterAdminRoleName},
+		},
+	}
+
+	for k, tc := range tests {
+		roles := map[string]authorizationapi.ClusterRole{tc.ClusterAdmin.Name: *tc.ClusterAdmin, admin.Name: *admin}
+		fakeClient := &testclient.Fake{}
+		fakeClient.AddReactor("get", "clusterroles", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			role := roles[action.(ktestclient.GetAction).GetName()]
+			return true, &role, nil
+		})
+		fakeClient.AddReactor("list", "clusterroles", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, &authorizationapi.ClusterRoleList{Items: []authorizationapi.ClusterRole{roles[tc.ClusterAdmin.Name], roles[admin.Name]}}, nil
+		})
+		fakeClient.AddReactor("update", "clusterroles", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			role := action.(ktestclient.UpdateAction).GetObject().(*authorizationapi.ClusterRole)
+			roles[role.Name] = *role
+			return true, role, nil
+		})
+		fakeClient.AddReactor("list", "clusterrolebindings", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			return true, &authorizationapi.ClusterRoleBindingList{}, nil
+		})
+		out := &bytes.Buffer{}
+		o := &MigrateBootstrapPolicyOptions{
+			Confirmed:         tc.Confirmed,
+			Out:               out,
+			RoleClient:        fakeClient.ClusterRoles(),
+			RoleBindingClient: fakeClient.ClusterRoleBindings(),
+		}
+
+		if err := o.MigrateBootstrapPolicy(); err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+
+		for _, expected := range tc.ExpectedOutput {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("%s: expected output to contain %q, got\n%s", k, expected, out.String())
+			}
+		}
+
+		updates := []string{}
+		for _, action := range fakeClient.Actions() {
+			if update, ok := action.(ktestclient.UpdateAction); ok {
+				updates = append(updates, update.GetObject().(*authorizationapi.ClusterRole).Name)
+			}
+		}
+		if !tc.Confirmed {
+			if len(updates) != 0 {
+				t.Errorf("%s: unexpected updates of %v", k, updates)
+			}
+			continue
+		}
+
+		clusterAdmin := roles[bootstrappolicy.ClusterAdminRoleName]
+		if version, err := bootstrappolicy.GetPolicyVersion(&clusterAdmin); err != nil || version != bootstrappolicy.BootstrapPolicyVersion() {
+			t.Errorf("%s: expected version %d to be recorded, got %d: %v", k, bootstrappolicy.BootstrapPolicyVersion(), version, err)
+		}
+		tokenRequests := authorizationapi.PolicyRule{Verbs: sets.NewString("create"), Resources: sets.NewString("serviceaccounttokenrequests")}
+		if covered, _ := rulevalidation.Covers(roles[bootstrappolicy.AdminRoleName].Rules, []authorizationapi.PolicyRule{tokenRequests}); !covered {
+			t.Errorf("%s: expected the admin role to be migrated, got rules %v", k, roles[bootstrappolicy.AdminRoleName].Rules)
+		}
+		if !sets.NewString(updates...).Equal(sets.NewString(tc.ExpectedUpdates...)) {
+			t.Errorf("%s: expected updates of %v, got %v", k, tc.ExpectedUpdates, updates)
+		}
+	}
+}
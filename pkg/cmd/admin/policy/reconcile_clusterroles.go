@@ -32,6 +32,7 @@ type ReconcileClusterRolesOptions struct {
 	Union     bool
 
 	Out    io.Writer
+	ErrOut io.Writer
 	Output string
 
 	RoleClient client.ClusterRoleInterface
@@ -45,11 +46,18 @@ This command will inspect the cluster roles against the recommended bootstrap po
 that does not match will be replaced by the recommended bootstrap role.  This command will not remove
 any additional cluster role.
 
-You can see which cluster role have recommended changed by choosing an output type.`
+Cluster roles that have been customized can be protected from being replaced by setting the
+openshift.io/reconcile-protect annotation to "true".  Protected cluster roles are skipped.
+
+You can see which cluster role have recommended changed by choosing an output type.  The "diff" output
+type lists the rules that would be added (+) and removed (-) for each changed cluster role.`
 
 	reconcileExample = `  # Display the cluster roles that would be modified
   $ %[1]s
 
+  # Display the rules that would be added or removed for each cluster role
+  $ %[1]s -o diff
+
   # Replace cluster roles that don't match the current defaults
   $ %[1]s --confirm
 
@@ -96,6 +104,7 @@ func (o *ReconcileClusterRolesOptions) Complete(cmd *cobra.Command, f *clientcmd
 		return err
 	}
 	o.RoleClient = oclient.ClusterRoles()
+	o.ErrOut = cmd.Out()
 
 	o.Output = kcmdutil.GetFlagString(cmd, "output")
 
@@ -122,7 +131,7 @@ func (o *ReconcileClusterRolesOptions) Validate() error {
 	if o.RoleClient == nil {
 		return errors.New("a role client is required")
 	}
-	if o.Output != "yaml" && o.Output != "json" && o.Output != "" {
+	if o.Output != "yaml" && o.Output != "json" && o.Output != "diff" && o.Output != "" {
 		return fmt.Errorf("unknown output specified: %s", o.Output)
 	}
 	return nil
@@ -130,15 +139,23 @@ func (o *ReconcileClusterRolesOptions) Validate() error {
 
 // RunReconcileClusterRoles contains all the necessary functionality for the OpenShift cli reconcile-cluster-roles command
 func (o *ReconcileClusterRolesOptions) RunReconcileClusterRoles(cmd *cobra.Command, f *clientcmd.Factory) error {
-	changedClusterRoles, err := o.ChangedClusterRoles()
+	changedClusterRoles, skippedClusterRoles, err := o.ChangedClusterRoles()
 	if err != nil {
 		return err
 	}
 
+	for _, name := range skippedClusterRoles {
+		fmt.Fprintf(o.ErrOut, "Skipped clusterrole/%s: the %s annotation is set to true\n", name, authorizationapi.ReconcileProtectAnnotation)
+	}
+
 	if len(changedClusterRoles) == 0 {
 		return nil
 	}
 
+	if o.Output == "diff" && !o.Confirmed {
+		return o.printDiff(changedClusterRoles)
+	}
+
 	if (len(o.Output) != 0) && !o.Confirmed {
 		list := &kapi.List{}
 		for _, item := range changedClusterRoles {
@@ -158,9 +175,11 @@ func (o *ReconcileClusterRolesOptions) RunReconcileClusterRoles(cmd *cobra.Comma
 }
 
 // ChangedClusterRoles returns the roles that must be created and/or updated to
-// match the recommended bootstrap policy
-func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationapi.ClusterRole, error) {
+// match the recommended bootstrap policy, and the names of the roles that differ but
+// were skipped because they are protected by the ReconcileProtectAnnotation
+func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationapi.ClusterRole, []string, error) {
 	changedRoles := []*authorizationapi.ClusterRole{}
+	skippedRoles := []string{}
 
 	rolesToReconcile := sets.NewString(o.RolesToReconcile...)
 	rolesNotFound := sets.NewString(o.RolesToReconcile...)
@@ -178,7 +197,7 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 			continue
 		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Copy any existing labels/annotations, so the displayed update is correct
@@ -187,7 +206,16 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 		expectedClusterRole.Labels = actualClusterRole.Labels
 		expectedClusterRole.Annotations = actualClusterRole.Annotations
 
-		if !kapi.Semantic.DeepEqual(expectedClusterRole.Rules, actualClusterRole.Rules) {
+		// bootstrap roles do not include other roles, so any included roles are customizations
+		if o.Union {
+			expectedClusterRole.IncludedRoles = actualClusterRole.IncludedRoles
+		}
+
+		if !kapi.Semantic.DeepEqual(expectedClusterRole.Rules, actualClusterRole.Rules) || !kapi.Semantic.DeepEqual(expectedClusterRole.IncludedRoles, actualClusterRole.IncludedRoles) {
+			if actualClusterRole.Annotations[authorizationapi.ReconcileProtectAnnotation] == "true" {
+				skippedRoles = append(skippedRoles, actualClusterRole.Name)
+				continue
+			}
 			if o.Union {
 				_, missingRules := rulevalidation.Covers(expectedClusterRole.Rules, actualClusterRole.Rules)
 				expectedClusterRole.Rules = append(expectedClusterRole.Rules, missingRules...)
@@ -198,10 +226,43 @@ func (o *ReconcileClusterRolesOptions) ChangedClusterRoles() ([]*authorizationap
 
 	if len(rolesNotFound) != 0 {
 		// return the known changes and the error so that a caller can decide if he wants a partial update
-		return changedRoles, fmt.Errorf("did not find requested cluster role %s", rolesNotFound.List())
+		return changedRoles, skippedRoles, fmt.Errorf("did not find requested cluster role %s", rolesNotFound.List())
 	}
 
-	return changedRoles, nil
+	return changedRoles, skippedRoles, nil
+}
+
+// printDiff writes the rules and included roles that reconciling would add (+) to and remove (-) from each changed role
+func (o *ReconcileClusterRolesOptions) printDiff(changedRoles []*authorizationapi.ClusterRole) error {
+	for _, changedRole := range changedRoles {
+		actualRole, err := o.RoleClient.Get(changedRole.Name)
+		if kapierrors.IsNotFound(err) {
+			fmt.Fprintf(o.Out, "clusterrole/%s: missing, would be created\n", changedRole.Name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(o.Out, "clusterrole/%s:\n", changedRole.Name)
+		_, addedRules := rulevalidation.Covers(actualRole.Rules, changedRole.Rules)
+		for _, rule := range addedRules {
+			fmt.Fprintf(o.Out, "+ %v\n", rule)
+		}
+		_, removedRules := rulevalidation.Covers(changedRole.Rules, actualRole.Rules)
+		for _, rule := range removedRules {
+			fmt.Fprintf(o.Out, "- %v\n", rule)
+		}
+		onlyChanged, onlyActual := DiffObjectReferenceLists(changedRole.IncludedRoles, actualRole.IncludedRoles)
+		for _, includedRole := range onlyChanged {
+			fmt.Fprintf(o.Out, "+ includes clusterrole/%s\n", includedRole.Name)
+		}
+		for _, includedRole := range onlyActual {
+			fmt.Fprintf(o.Out, "- includes clusterrole/%s\n", includedRole.Name)
+		}
+	}
+
+	return nil
 }
 
 // ReplaceChangedRoles will reconcile all the changed roles back to the recommended bootstrap policy
@@ -223,6 +284,7 @@ func (o *ReconcileClusterRolesOptions) ReplaceChangedRoles(changedRoles []*autho
 		}
 
 		role.Rules = changedRoles[i].Rules
+		role.IncludedRoles = changedRoles[i].IncludedRoles
 		updatedRole, err := o.RoleClient.Update(role)
 		if err != nil {
 			return err
@@ -0,0 +1,110 @@
+package policy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+)
+
+func bootstrapClusterRole(name string) *authorizationapi.ClusterRole {
+	for _, role := range bootstrappolicy.GetBootstrapClusterRoles() {
+		if role.Name == name {
+			return &role
+		}
+	}
+	return nil
+}
+
+func TestChangedClusterRoles(t *testing.T) {
+	extraRule := authorizationapi.PolicyRule{Verbs: sets.NewString("get"), Resources: sets.NewString("secrets")}
+
+	modified := bootstrapClusterRole(bootstrappolicy.BasicUserRoleName)
+	modified.Rules = append(modified.Rules, extraRule)
+
+	protected := bootstrapClusterRole(bootstrappolicy.BasicUserRoleName)
+	protected.Rules = append(protected.Rules, extraRule)
+	protected.Annotations = map[string]string{authorizationapi.ReconcileProtectAnnotation: "true"}
+
+	included := bootstrapClusterRole(bootstrappolicy.BasicUserRoleName)
+	included.IncludedRoles = []kapi.ObjectReference{{Name: "other"}}
+
+	tests := map[string]struct {
+		Actual          *authorizationapi.ClusterRole
+		Union           bool
+		ExpectedChanged bool
+		ExpectedSkipped bool
+		ExpectedDiff    []string
+	}{
+		"unchanged": {
+			Actual: bootstrapClusterRole(bootstrappolicy.BasicUserRoleName),
+		},
+		"missing": {
+			ExpectedChanged: true,
+			ExpectedDiff:    []string{"clusterrole/basic-user: missing, would be created"},
+		},
+		"extra rule": {
+			Actual:          modified,
+			ExpectedChanged: true,
+			ExpectedDiff:    []string{"clusterrole/basic-user:", "- " + extraRule.String()},
+		},
+		"protected": {
+			Actual:          protected,
+			ExpectedSkipped: true,
+		},
+		"included role": {
+			Actual:          included,
+			ExpectedChanged: true,
+			ExpectedDiff:    []string{"clusterrole/basic-user:", "- includes clusterrole/other"},
+		},
+		"included role with union": {
+			Actual: included,
+			Union:  true,
+		},
+	}
+
+	for k, tc := range tests {
+		fakeClient := testclient.NewSimpleFake()
+		if tc.Actual != nil {
+			fakeClient = testclient.NewSimpleFake(tc.Actual)
+		}
+		out := &bytes.Buffer{}
+		o := &ReconcileClusterRolesOptions{
+			RolesToReconcile: []string{bootstrappolicy.BasicUserRoleName},
+			Union:            tc.Union,
+			Out:              out,
+			ErrOut:           &bytes.Buffer{},
+			RoleClient:       fakeClient.ClusterRoles(),
+		}
+
+		changed, skipped, err := o.ChangedClusterRoles()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if (len(changed) > 0) != tc.ExpectedChanged {
+			t.Errorf("%s: expected changed=%v, got %#v", k, tc.ExpectedChanged, changed)
+		}
+		if (len(skipped) > 0) != tc.ExpectedSkipped {
+			t.Errorf("%s: expected skipped=%v, got %v", k, tc.ExpectedSkipped, skipped)
+		}
+
+		if err := o.printDiff(changed); err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		var expectedDiff string
+		if len(tc.ExpectedDiff) > 0 {
+			expectedDiff = strings.Join(tc.ExpectedDiff, "\n") + "\n"
+		}
+		if out.String() != expectedDiff {
+			t.Errorf("%s: expected diff\n%s\ngot\n%s", k, expectedDiff, out.String())
+		}
+	}
+}
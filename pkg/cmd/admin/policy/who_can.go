@@ -9,7 +9,9 @@ import (
 	"github.com/spf13/cobra"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/client"
@@ -18,23 +20,44 @@ import (
 
 const WhoCanRecommendedName = "who-can"
 
+const whoCanLong = `List who can perform the specified action on a resource
+
+Users, service accounts, and groups that are allowed to perform VERB on RESOURCE in the current namespace
+are listed, based on the policy bindings and cluster roles that apply to that namespace.  If NAME is given,
+only access to the resource with that name is checked.  A non-resource URL, such as /healthz, may be checked
+instead of a resource; non-resource URLs are always checked for the whole cluster.`
+
+const whoCanExample = `  # List who can get pods in the current namespace
+  $ %[1]s get pods
+
+  # List who can update the deployment config named frontend in every namespace
+  $ %[1]s update deploymentconfigs frontend --all-namespaces
+
+  # List who can get the /metrics URL
+  $ %[1]s get /metrics`
+
 type whoCanOptions struct {
 	allNamespaces    bool
 	bindingNamespace string
 	client           *client.Client
 
-	verb     string
-	resource string
+	verb           string
+	resource       string
+	resourceName   string
+	nonResourceURL string
+
+	out io.Writer
 }
 
 // NewCmdWhoCan implements the OpenShift cli who-can command
 func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
-	options := &whoCanOptions{}
+	options := &whoCanOptions{out: out}
 
 	cmd := &cobra.Command{
-		Use:   "who-can VERB RESOURCE",
-		Short: "List who can perform the specified action on a resource",
-		Long:  "List who can perform the specified action on a resource",
+		Use:     "who-can VERB (RESOURCE [NAME] | NONRESOURCEURL)",
+		Short:   "List who can perform the specified action on a resource",
+		Long:    whoCanLong,
+		Example: fmt.Sprintf(whoCanExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := options.complete(args); err != nil {
 				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
@@ -58,24 +81,41 @@ func NewCmdWhoCan(name, fullName string, f *clientcmd.Factory, out io.Writer) *c
 }
 
 func (o *whoCanOptions) complete(args []string) error {
-	if len(args) != 2 {
-		return errors.New("you must specify two arguments: verb and resource")
+	if len(args) < 2 || len(args) > 3 {
+		return errors.New("you must specify two or three arguments: verb, resource, and optional resourceName")
 	}
 
 	o.verb = args[0]
+	if strings.HasPrefix(args[1], "/") {
+		if len(args) == 3 {
+			return errors.New("a resource name may not be specified with a non-resource URL")
+		}
+		o.nonResourceURL = args[1]
+		return nil
+	}
+
 	o.resource = args[1]
+	if len(args) == 3 {
+		o.resourceName = args[2]
+	}
 	return nil
 }
 
 func (o *whoCanOptions) run() error {
 	authorizationAttributes := authorizationapi.AuthorizationAttributes{
-		Resource: o.resource,
-		Verb:     o.verb,
+		Verb:         o.verb,
+		Resource:     o.resource,
+		ResourceName: o.resourceName,
+	}
+	if len(o.nonResourceURL) > 0 {
+		authorizationAttributes.IsNonResourceURL = true
+		authorizationAttributes.Path = o.nonResourceURL
 	}
 
 	resourceAccessReviewResponse := &authorizationapi.ResourceAccessReviewResponse{}
 	var err error
-	if o.allNamespaces {
+	// non-resource URLs are not namespaced, so they can only be checked for the whole cluster
+	if o.allNamespaces || authorizationAttributes.IsNonResourceURL {
 		resourceAccessReviewResponse, err = o.client.ResourceAccessReviews().Create(&authorizationapi.ResourceAccessReview{Action: authorizationAttributes})
 	} else {
 		resourceAccessReviewResponse, err = o.client.LocalResourceAccessReviews(o.bindingNamespace).Create(&authorizationapi.LocalResourceAccessReview{Action: authorizationAttributes})
@@ -85,24 +125,54 @@ func (o *whoCanOptions) run() error {
 		return err
 	}
 
-	if resourceAccessReviewResponse.Namespace == kapi.NamespaceAll {
-		fmt.Printf("Namespace: <all>\n")
+	if authorizationAttributes.IsNonResourceURL {
+		fmt.Fprintf(o.out, "Verb:             %s\n", o.verb)
+		fmt.Fprintf(o.out, "Non-Resource URL: %s\n\n", o.nonResourceURL)
 	} else {
-		fmt.Printf("Namespace: %s\n", resourceAccessReviewResponse.Namespace)
-	}
-	fmt.Printf("Verb:      %s\n", o.verb)
-	fmt.Printf("Resource:  %s\n\n", o.resource)
-	if len(resourceAccessReviewResponse.Users) == 0 {
-		fmt.Printf("Users:  none\n\n")
-	} else {
-		fmt.Printf("Users:  %s\n\n", strings.Join(resourceAccessReviewResponse.Users.List(), "\n        "))
+		if resourceAccessReviewResponse.Namespace == kapi.NamespaceAll {
+			fmt.Fprintf(o.out, "Namespace: <all>\n")
+		} else {
+			fmt.Fprintf(o.out, "Namespace: %s\n", resourceAccessReviewResponse.Namespace)
+		}
+		fmt.Fprintf(o.out, "Verb:      %s\n", o.verb)
+		fmt.Fprintf(o.out, "Resource:  %s\n", o.resource)
+		if len(o.resourceName) > 0 {
+			fmt.Fprintf(o.out, "Name:      %s\n", o.resourceName)
+		}
+		fmt.Fprintf(o.out, "\n")
 	}
 
-	if len(resourceAccessReviewResponse.Groups) == 0 {
-		fmt.Printf("Groups: none\n\n")
-	} else {
-		fmt.Printf("Groups: %s\n\n", strings.Join(resourceAccessReviewResponse.Groups.List(), "\n        "))
+	if len(resourceAccessReviewResponse.EvaluationError) > 0 {
+		fmt.Fprintf(o.out, "Error during evaluation, results may not be complete: %s\n\n", resourceAccessReviewResponse.EvaluationError)
 	}
 
+	users, serviceAccounts := splitServiceAccounts(resourceAccessReviewResponse.Users)
+	printSubjects(o.out, "Users:           ", users)
+	printSubjects(o.out, "Service accounts:", serviceAccounts)
+	printSubjects(o.out, "Groups:          ", resourceAccessReviewResponse.Groups)
+
 	return nil
 }
+
+// splitServiceAccounts separates the service account user names from the other users
+func splitServiceAccounts(users sets.String) (sets.String, sets.String) {
+	otherUsers := sets.NewString()
+	serviceAccounts := sets.NewString()
+	for user := range users {
+		if _, _, err := serviceaccount.SplitUsername(user); err == nil {
+			serviceAccounts.Insert(user)
+		} else {
+			otherUsers.Insert(user)
+		}
+	}
+	return otherUsers, serviceAccounts
+}
+
+func printSubjects(out io.Writer, label string, subjects sets.String) {
+	if len(subjects) == 0 {
+		fmt.Fprintf(out, "%s none\n\n", label)
+		return
+	}
+	indent := "\n" + strings.Repeat(" ", len(label)+1)
+	fmt.Fprintf(out, "%s %s\n\n", label, strings.Join(subjects.List(), indent))
+}
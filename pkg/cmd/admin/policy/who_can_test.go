@@ -0,0 +1,80 @@
+package policy
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+)
+
+func TestWhoCanComplete(t *testing.T) {
+	tests := map[string]struct {
+		args     []string
+		expected whoCanOptions
+		err      bool
+	}{
+		"resource": {
+			args:     []string{"get", "pods"},
+			expected: whoCanOptions{verb: "get", resource: "pods"},
+		},
+		"resource with name": {
+			args:     []string{"get", "pods", "mypod"},
+			expected: whoCanOptions{verb: "get", resource: "pods", resourceName: "mypod"},
+		},
+		"non-resource URL": {
+			args:     []string{"get", "/metrics"},
+			expected: whoCanOptions{verb: "get", nonResourceURL: "/metrics"},
+		},
+		"non-resource URL with name": {
+			args: []string{"get", "/metrics", "mypod"},
+			err:  true,
+		},
+		"missing resource": {
+			args: []string{"get"},
+			err:  true,
+		},
+		"too many arguments": {
+			args: []string{"get", "pods", "mypod", "other"},
+			err:  true,
+		},
+	}
+
+	for name, test := range tests {
+		options := &whoCanOptions{}
+		err := options.complete(test.args)
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if *options != test.expected {
+			t.Errorf("%s: expected %#v, got %#v", name, test.expected, *options)
+		}
+	}
+}
+
+func TestWhoCanPrintSubjects(t *testing.T) {
+	users, serviceAccounts := splitServiceAccounts(sets.NewString("alice", "system:serviceaccount:ns1:builder", "system:admin"))
+
+	out := &bytes.Buffer{}
+	printSubjects(out, "Users:           ", users)
+	printSubjects(out, "Service accounts:", serviceAccounts)
+	printSubjects(out, "Groups:          ", sets.NewString())
+
+	expected := `Users:            alice
+                  system:admin
+
+Service accounts: system:serviceaccount:ns1:builder
+
+Groups:           none
+
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+}
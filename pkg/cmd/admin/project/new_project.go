@@ -4,13 +4,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
 	errorsutil "k8s.io/kubernetes/pkg/util/errors"
+	kyaml "k8s.io/kubernetes/pkg/util/yaml"
 
+	"github.com/openshift/origin/pkg/api/latest"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/admin/policy"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
@@ -20,16 +28,29 @@ import (
 
 const NewProjectRecommendedName = "new-project"
 
+const (
+	// DefaultQuotaName is the name of the ResourceQuota created from the --quota flag
+	DefaultQuotaName = "default-quota"
+	// DefaultLimitRangeName is the name of the LimitRange created from the --default-container-limits flag
+	DefaultLimitRangeName = "default-limits"
+)
+
 type NewProjectOptions struct {
 	ProjectName  string
 	DisplayName  string
 	Description  string
 	NodeSelector string
 
-	Client client.Interface
+	Client     client.Interface
+	KubeClient kclient.Interface
 
 	AdminRole string
 	AdminUser string
+
+	// ResourceQuota, if set, is created in the new project
+	ResourceQuota *kapi.ResourceQuota
+	// LimitRange, if set, is created in the new project
+	LimitRange *kapi.LimitRange
 }
 
 const newProjectLong = `
@@ -37,23 +58,41 @@ Create a new project
 
 Use this command to create a project. You may optionally specify metadata about the project,
 an admin user (and role, if you want to use a non-default admin role), and a node selector
-to restrict which nodes pods in this project can be scheduled to.`
+to restrict which nodes pods in this project can be scheduled to.
+
+A resource quota and a limit range may also be created in the project, either from flags or
+from files containing a ResourceQuota or a LimitRange. If any of the objects can not be created,
+the project is deleted again.`
+
+const newProjectExample = `  # Create a project with an admin user
+  $ %[1]s myproject --admin=alice
+
+  # Create a project that may run at most 10 pods using at most 4Gi of memory
+  $ %[1]s myproject --quota=pods=10,memory=4Gi --default-container-limits=memory=512Mi
+
+  # Create a project using the quota and limit range defined in files
+  $ %[1]s myproject --quota-file=quota.yaml --limit-range-file=limits.yaml`
 
 // NewCmdNewProject implements the OpenShift cli new-project command
 func NewCmdNewProject(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	options := &NewProjectOptions{}
+	var quota, quotaFile, limits, limitRangeFile string
 
 	cmd := &cobra.Command{
-		Use:   name + " NAME [--display-name=DISPLAYNAME] [--description=DESCRIPTION]",
-		Short: "Create a new project",
-		Long:  newProjectLong,
+		Use:     name + " NAME [--display-name=DISPLAYNAME] [--description=DESCRIPTION]",
+		Short:   "Create a new project",
+		Long:    newProjectLong,
+		Example: fmt.Sprintf(newProjectExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := options.complete(args); err != nil {
 				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
 			}
+			if err := options.completeResources(quota, quotaFile, limits, limitRangeFile); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
 
 			var err error
-			if options.Client, _, err = f.Clients(); err != nil {
+			if options.Client, options.KubeClient, err = f.Clients(); err != nil {
 				kcmdutil.CheckErr(err)
 			}
 
@@ -72,6 +111,10 @@ func NewCmdNewProject(name, fullName string, f *clientcmd.Factory, out io.Writer
 	cmd.Flags().StringVar(&options.DisplayName, "display-name", "", "Project display name")
 	cmd.Flags().StringVar(&options.Description, "description", "", "Project description")
 	cmd.Flags().StringVar(&options.NodeSelector, "node-selector", "", "Restrict pods onto nodes matching given label selector. Format: '<key1>=<value1>, <key2>=<value2>...'. Specifying \"\" means any node, not default. If unspecified, cluster default node selector will be used.")
+	cmd.Flags().StringVar(&quota, "quota", "", "Create a resource quota with the given hard limits. Format: '<resource1>=<quantity1>,<resource2>=<quantity2>...'")
+	cmd.Flags().StringVar(&quotaFile, "quota-file", "", "Create the ResourceQuota contained in the given file")
+	cmd.Flags().StringVar(&limits, "default-container-limits", "", "Create a limit range with the given default container limits. Format: '<resource1>=<quantity1>,<resource2>=<quantity2>...'")
+	cmd.Flags().StringVar(&limitRangeFile, "limit-range-file", "", "Create the LimitRange contained in the given file")
 
 	return cmd
 }
@@ -85,7 +128,91 @@ func (o *NewProjectOptions) complete(args []string) error {
 	return nil
 }
 
+// completeResources builds the ResourceQuota and LimitRange of the project from either flags or files
+func (o *NewProjectOptions) completeResources(quota, quotaFile, limits, limitRangeFile string) error {
+	if len(quota) > 0 && len(quotaFile) > 0 {
+		return errors.New("--quota and --quota-file may not both be specified")
+	}
+	if len(limits) > 0 && len(limitRangeFile) > 0 {
+		return errors.New("--default-container-limits and --limit-range-file may not both be specified")
+	}
+
+	switch {
+	case len(quota) > 0:
+		hard, err := parseResourceList(quota)
+		if err != nil {
+			return fmt.Errorf("invalid --quota: %v", err)
+		}
+		o.ResourceQuota = &kapi.ResourceQuota{
+			ObjectMeta: kapi.ObjectMeta{Name: DefaultQuotaName},
+			Spec:       kapi.ResourceQuotaSpec{Hard: hard},
+		}
+	case len(quotaFile) > 0:
+		o.ResourceQuota = &kapi.ResourceQuota{}
+		if err := readObject(quotaFile, o.ResourceQuota); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case len(limits) > 0:
+		defaults, err := parseResourceList(limits)
+		if err != nil {
+			return fmt.Errorf("invalid --default-container-limits: %v", err)
+		}
+		o.LimitRange = &kapi.LimitRange{
+			ObjectMeta: kapi.ObjectMeta{Name: DefaultLimitRangeName},
+			Spec: kapi.LimitRangeSpec{
+				Limits: []kapi.LimitRangeItem{{Type: kapi.LimitTypeContainer, Default: defaults}},
+			},
+		}
+	case len(limitRangeFile) > 0:
+		o.LimitRange = &kapi.LimitRange{}
+		if err := readObject(limitRangeFile, o.LimitRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseResourceList parses strings of the form <resource1>=<quantity1>,<resource2>=<quantity2>
+func parseResourceList(spec string) (kapi.ResourceList, error) {
+	list := kapi.ResourceList{}
+	for _, statement := range strings.Split(spec, ",") {
+		parts := strings.Split(strings.TrimSpace(statement), "=")
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("%q must be of the form <resource>=<quantity>", statement)
+		}
+		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%q has an invalid quantity: %v", statement, err)
+		}
+		list[kapi.ResourceName(parts[0])] = *quantity
+	}
+	return list, nil
+}
+
+// readObject decodes the JSON or YAML file into obj
+func readObject(filename string, obj runtime.Object) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	data, err = kyaml.ToJSON(data)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %v", filename, err)
+	}
+	if err := latest.Codec.DecodeInto(data, obj); err != nil {
+		return fmt.Errorf("unable to read %s: %v", filename, err)
+	}
+	return nil
+}
+
 func (o *NewProjectOptions) Run(useNodeSelector bool) error {
+	if (o.ResourceQuota != nil || o.LimitRange != nil) && o.KubeClient == nil {
+		return errors.New("a Kubernetes client is required to create a resource quota or limit range")
+	}
+
 	if _, err := o.Client.Projects().Get(o.ProjectName); err != nil {
 		if !kerrors.IsNotFound(err) {
 			return err
@@ -107,34 +234,53 @@ func (o *NewProjectOptions) Run(useNodeSelector bool) error {
 		return err
 	}
 
+	if err := o.populate(project.Name); err != nil {
+		// delete the project so that it is either created completely or not at all
+		if deleteErr := o.Client.Projects().Delete(project.Name); deleteErr != nil {
+			return errorsutil.NewAggregate([]error{err, fmt.Errorf("project %v could not be deleted: %v", project.Name, deleteErr)})
+		}
+		return fmt.Errorf("project %v was not created: %v", project.Name, err)
+	}
+
 	fmt.Printf("Created project %v\n", o.ProjectName)
+	return nil
+}
 
-	errs := []error{}
+// populate creates the role bindings, quota and limit range of the new project
+func (o *NewProjectOptions) populate(namespace string) error {
 	if len(o.AdminUser) != 0 {
 		adduser := &policy.RoleModificationOptions{
 			RoleName:            o.AdminRole,
-			RoleBindingAccessor: policy.NewLocalRoleBindingAccessor(project.Name, o.Client),
+			RoleBindingAccessor: policy.NewLocalRoleBindingAccessor(namespace, o.Client),
 			Users:               []string{o.AdminUser},
 		}
 
 		if err := adduser.AddRole(); err != nil {
-			fmt.Printf("%v could not be added to the %v role: %v\n", o.AdminUser, o.AdminRole, err)
-			errs = append(errs, err)
+			return fmt.Errorf("%v could not be added to the %v role: %v", o.AdminUser, o.AdminRole, err)
 		}
 	}
 
-	for _, binding := range bootstrappolicy.GetBootstrapServiceAccountProjectRoleBindings(o.ProjectName) {
+	for _, binding := range bootstrappolicy.GetBootstrapServiceAccountProjectRoleBindings(namespace) {
 		addRole := &policy.RoleModificationOptions{
 			RoleName:            binding.RoleRef.Name,
 			RoleNamespace:       binding.RoleRef.Namespace,
-			RoleBindingAccessor: policy.NewLocalRoleBindingAccessor(o.ProjectName, o.Client),
+			RoleBindingAccessor: policy.NewLocalRoleBindingAccessor(namespace, o.Client),
 			Subjects:            binding.Subjects,
 		}
 		if err := addRole.AddRole(); err != nil {
-			fmt.Printf("Could not add service accounts to the %v role: %v\n", binding.RoleRef.Name, err)
-			errs = append(errs, err)
+			return fmt.Errorf("could not add service accounts to the %v role: %v", binding.RoleRef.Name, err)
 		}
 	}
 
-	return errorsutil.NewAggregate(errs)
+	if o.ResourceQuota != nil {
+		if _, err := o.KubeClient.ResourceQuotas(namespace).Create(o.ResourceQuota); err != nil {
+			return fmt.Errorf("resource quota %v could not be created: %v", o.ResourceQuota.Name, err)
+		}
+	}
+	if o.LimitRange != nil {
+		if _, err := o.KubeClient.LimitRanges(namespace).Create(o.LimitRange); err != nil {
+			return fmt.Errorf("limit range %v could not be created: %v", o.LimitRange.Name, err)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,143 @@
+package project
+
+import (
+	"errors"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+func newTestClient() *testclient.Fake {
+	client := &testclient.Fake{}
+	client.AddReactor("get", "projects", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, kapierrors.NewNotFound("Project", action.(ktestclient.GetAction).GetName())
+	})
+	client.AddReactor("create", "projects", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, action.(ktestclient.CreateAction).GetObject(), nil
+	})
+	client.AddReactor("list", "rolebindings", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &authorizationapi.RoleBindingList{}, nil
+	})
+	client.AddReactor("create", "rolebindings", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, action.(ktestclient.CreateAction).GetObject(), nil
+	})
+	return client
+}
+
+func deletedProject(client *testclient.Fake) bool {
+	for _, action := range client.Actions() {
+		if action.Matches("delete", "projects") {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNewProjectCreatesQuotaAndLimits(t *testing.T) {
+	client := newTestClient()
+	kubeClient := &ktestclient.Fake{}
+
+	options := &NewProjectOptions{
+		ProjectName: "foo",
+		Client:      client,
+		KubeClient:  kubeClient,
+	}
+	if err := options.completeResources("pods=10,memory=1Gi", "", "memory=512Mi", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := options.Run(false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var quota *kapi.ResourceQuota
+	var limitRange *kapi.LimitRange
+	for _, action := range kubeClient.Actions() {
+		if action.GetNamespace() != "foo" {
+			t.Errorf("unexpected action in namespace %q: %#v", action.GetNamespace(), action)
+		}
+		switch {
+		case action.Matches("create", "resourcequotas"):
+			quota = action.(ktestclient.CreateAction).GetObject().(*kapi.ResourceQuota)
+		case action.Matches("create", "limitranges"):
+			limitRange = action.(ktestclient.CreateAction).GetObject().(*kapi.LimitRange)
+		}
+	}
+	if quota == nil || len(quota.Spec.Hard) != 2 {
+		t.Errorf("unexpected resource quota: %#v", quota)
+	}
+	if limitRange == nil || len(limitRange.Spec.Limits) != 1 {
+		t.Errorf("unexpected limit range: %#v", limitRange)
+	}
+	if deletedProject(client) {
+		t.Errorf("unexpected project deletion: %#v", client.Actions())
+	}
+}
+
+func TestNewProjectRollsBackOnFailure(t *testing.T) {
+	client := newTestClient()
+	kubeClient := &ktestclient.Fake{}
+	kubeClient.AddReactor("create", "limitranges", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("limit range failure")
+	})
+
+	options := &NewProjectOptions{
+		ProjectName: "foo",
+		Client:      client,
+		KubeClient:  kubeClient,
+		LimitRange:  &kapi.LimitRange{ObjectMeta: kapi.ObjectMeta{Name: DefaultLimitRangeName}},
+	}
+	if err := options.Run(false); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if !deletedProject(client) {
+		t.Errorf("expected the project to be deleted: %#v", client.Actions())
+	}
+}
+
+func TestNewProjectCompleteResources(t *testing.T) {
+	testCases := map[string]struct {
+		quota, quotaFile, limits, limitRangeFile string
+		expectErr                                bool
+	}{
+		"no resources": {},
+		"quota flag": {
+			quota: "pods=10",
+		},
+		"quota flag and file": {
+			quota:     "pods=10",
+			quotaFile: "quota.yaml",
+			expectErr: true,
+		},
+		"limits flag and file": {
+			limits:         "cpu=1",
+			limitRangeFile: "limits.yaml",
+			expectErr:      true,
+		},
+		"invalid quantity": {
+			quota:     "pods=ten",
+			expectErr: true,
+		},
+		"missing quantity": {
+			limits:    "cpu",
+			expectErr: true,
+		},
+		"missing file": {
+			quotaFile: "/does/not/exist.yaml",
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		options := &NewProjectOptions{}
+		err := options.completeResources(tc.quota, tc.quotaFile, tc.limits, tc.limitRangeFile)
+		if tc.expectErr != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", name, tc.expectErr, err)
+		}
+	}
+}
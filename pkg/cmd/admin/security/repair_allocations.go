@@ -0,0 +1,130 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	securitycontroller "github.com/openshift/origin/pkg/security/controller"
+)
+
+const RepairAllocationsRecommendedName = "repair-security-allocations"
+
+const (
+	repairAllocationsLong = `
+Detect and fix conflicting project security allocations
+
+Every project is allocated a range of UIDs, a range of supplemental groups, and an SELinux MCS
+label that separate its pods from the pods of other projects. This command finds projects whose
+allocation overlaps the allocation of an older project, or whose allocation cannot be parsed.
+
+By default, the command performs a dry run and only lists the conflicts. With --confirm, the
+allocation of each conflicting project is removed so that the master allocates a new one. Pods
+that are already running keep their old UIDs and labels until they are recreated.`
+
+	repairAllocationsExample = `  # List the projects with conflicting security allocations
+  $ %[1]s
+
+  # Reallocate the projects with conflicting security allocations
+  $ %[1]s --confirm`
+)
+
+type RepairAllocationsOptions struct {
+	Confirm bool
+
+	Client kclient.NamespaceInterface
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// NewCmdRepairAllocations implements the OpenShift cli repair-security-allocations command
+func NewCmdRepairAllocations(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &RepairAllocationsOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Detect and fix conflicting project security allocations",
+		Long:    repairAllocationsLong,
+		Example: fmt.Sprintf(repairAllocationsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) > 0 {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "no arguments are allowed to this command"))
+			}
+
+			_, kClient, err := f.Clients()
+			if err != nil {
+				kcmdutil.CheckErr(err)
+			}
+			options.Client = kClient.Namespaces()
+			options.ErrOut = cmd.Out()
+
+			if err := options.Run(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Confirm, "confirm", options.Confirm, "Specify that the conflicting allocations should be removed. Defaults to false, displaying the conflicts but not changing anything.")
+
+	return cmd
+}
+
+// Run lists the conflicting allocations and, if Confirm is set, clears them.
+func (o *RepairAllocationsOptions) Run() error {
+	namespaces, err := o.Client.List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	conflicts := securitycontroller.FindAllocationConflicts(namespaces.Items)
+	if len(conflicts) == 0 {
+		fmt.Fprintln(o.Out, "No conflicting security allocations found")
+		return nil
+	}
+
+	if !o.Confirm {
+		fmt.Fprintln(o.ErrOut, "Dry run enabled - no modifications will be made. Add --confirm to reallocate the projects")
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tCONFLICTS WITH\tREASON")
+	for _, conflict := range conflicts {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", conflict.Namespace, conflict.ConflictsWith, conflict.Reason)
+	}
+	w.Flush()
+
+	if !o.Confirm {
+		return nil
+	}
+
+	failed := false
+	for _, conflict := range conflicts {
+		if err := o.clearAllocation(conflict.Namespace); err != nil {
+			fmt.Fprintf(o.ErrOut, "error: unable to reallocate %s: %v\n", conflict.Namespace, err)
+			failed = true
+		}
+	}
+	if failed {
+		return errors.New("some projects could not be reallocated")
+	}
+	return nil
+}
+
+// clearAllocation fetches the latest copy of the namespace before removing its allocation, so that
+// updates made since the namespaces were listed are not lost.
+func (o *RepairAllocationsOptions) clearAllocation(name string) error {
+	ns, err := o.Client.Get(name)
+	if err != nil {
+		return err
+	}
+	securitycontroller.ClearAllocation(ns)
+	_, err = o.Client.Update(ns)
+	return err
+}
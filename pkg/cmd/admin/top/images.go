@@ -0,0 +1,127 @@
+package top
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/docker/pkg/units"
+	"github.com/spf13/cobra"
+	kapi "k8s.io/kubernetes/pkg/api"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// TopImagesRecommendedName is the recommended command name
+	TopImagesRecommendedName = "images"
+
+	topImagesLong = `Show the storage used by images in the integrated registry
+
+Lists the images pushed to the integrated registry, with the image stream tags that reference
+them and the storage used by their layers, largest first. Layers are often shared between
+images, so the storage column counts every layer of the image, while the exclusive column only
+counts the layers that no other image uses, which is the storage freed by pruning the image.`
+
+	topImagesExample = `  # Show the storage used by all images in the integrated registry
+  $ %[1]s %[2]s`
+)
+
+// TopImagesOptions holds all the required options for top images
+type TopImagesOptions struct {
+	Images  *imageapi.ImageList
+	Streams *imageapi.ImageStreamList
+	Out     io.Writer
+}
+
+// NewCmdTopImages implements the OpenShift cli top images command
+func NewCmdTopImages(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	opts := &TopImagesOptions{}
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Show usage statistics for images",
+		Long:    topImagesLong,
+		Example: fmt.Sprintf(topImagesExample, parentName, name),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(err)
+			}
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, err.Error()))
+			}
+			if err := opts.Run(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+	return cmd
+}
+
+// Complete the options for top images
+func (o *TopImagesOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return errors.New("no arguments are allowed to this command")
+	}
+	o.Out = out
+
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	if o.Images, err = osClient.Images().List(kapi.ListOptions{}); err != nil {
+		return err
+	}
+	if o.Streams, err = osClient.ImageStreams(kapi.NamespaceAll).List(kapi.ListOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate the options for top images
+func (o *TopImagesOptions) Validate() error {
+	if o.Images == nil || o.Streams == nil {
+		return errors.New("the images and image streams need to be specified")
+	}
+	if o.Out == nil {
+		return errors.New("a writer needs to be specified")
+	}
+	return nil
+}
+
+// Run prints the storage used by each image
+func (o *TopImagesOptions) Run() error {
+	usage := newRegistryUsage(o.Images)
+	tags := imageStreamTags(o.Streams)
+
+	rows := []usageRow{}
+	for name := range usage.images {
+		layers, storage, exclusive := usage.storage(sets.NewString(name))
+		rows = append(rows, usageRow{
+			name:      name,
+			columns:   []string{formatTags(tags[name]), fmt.Sprintf("%d", layers)},
+			storage:   storage,
+			exclusive: exclusive,
+		})
+	}
+	return printUsage(o.Out, []string{"NAME", "IMAGESTREAMTAGS", "LAYERS"}, rows)
+}
+
+// printUsage prints the rows, largest first, followed by their storage and exclusive storage
+func printUsage(out io.Writer, headers []string, rows []usageRow) error {
+	sort.Sort(byStorage(rows))
+
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(append(headers, "STORAGE", "EXCLUSIVE"), "\t"))
+	for _, row := range rows {
+		columns := append([]string{row.name}, row.columns...)
+		columns = append(columns, units.HumanSize(float64(row.storage)), units.HumanSize(float64(row.exclusive)))
+		fmt.Fprintln(w, strings.Join(columns, "\t"))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,131 @@
+package top
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	kapi "k8s.io/kubernetes/pkg/api"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// TopImageStreamsRecommendedName is the recommended command name
+	TopImageStreamsRecommendedName = "imagestreams"
+
+	topImageStreamsLong = `Show the storage used by image streams in the integrated registry
+
+Lists the image streams with images in the integrated registry, with the storage used by the
+images in their tag history, largest first. Layers shared by several images of a stream are
+counted once. The exclusive column only counts the layers that no image outside of the stream
+uses, which is the storage freed by deleting the stream and pruning its images.`
+
+	topImageStreamsExample = `  # Show the storage used by all image streams
+  $ %[1]s %[2]s
+
+  # Show the storage used by the image streams of the project 'web'
+  $ %[1]s %[2]s -n web`
+)
+
+// TopImageStreamsOptions holds all the required options for top imagestreams
+type TopImageStreamsOptions struct {
+	Images  *imageapi.ImageList
+	Streams *imageapi.ImageStreamList
+	Out     io.Writer
+}
+
+// NewCmdTopImageStreams implements the OpenShift cli top imagestreams command
+func NewCmdTopImageStreams(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	opts := &TopImageStreamsOptions{}
+	cmd := &cobra.Command{
+		Use:     name,
+		Aliases: []string{"is"},
+		Short:   "Show usage statistics for image streams",
+		Long:    topImageStreamsLong,
+		Example: fmt.Sprintf(topImageStreamsExample, parentName, name),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(err)
+			}
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, err.Error()))
+			}
+			if err := opts.Run(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+	return cmd
+}
+
+// Complete the options for top imagestreams. Image streams are listed in all namespaces unless
+// a namespace is given explicitly, but the images of all namespaces are needed to find the
+// layers that are shared with other streams.
+func (o *TopImageStreamsOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return errors.New("no arguments are allowed to this command")
+	}
+	o.Out = out
+
+	namespace, explicit, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	if !explicit {
+		namespace = kapi.NamespaceAll
+	}
+
+	osClient, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	if o.Images, err = osClient.Images().List(kapi.ListOptions{}); err != nil {
+		return err
+	}
+	if o.Streams, err = osClient.ImageStreams(namespace).List(kapi.ListOptions{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate the options for top imagestreams
+func (o *TopImageStreamsOptions) Validate() error {
+	if o.Images == nil || o.Streams == nil {
+		return errors.New("the images and image streams need to be specified")
+	}
+	if o.Out == nil {
+		return errors.New("a writer needs to be specified")
+	}
+	return nil
+}
+
+// Run prints the storage used by each image stream
+func (o *TopImageStreamsOptions) Run() error {
+	usage := newRegistryUsage(o.Images)
+
+	rows := []usageRow{}
+	for i := range o.Streams.Items {
+		stream := &o.Streams.Items[i]
+		images := imageStreamImages(stream)
+		for _, name := range images.List() {
+			if _, ok := usage.images[name]; !ok {
+				images.Delete(name)
+			}
+		}
+		if images.Len() == 0 {
+			continue
+		}
+		layers, storage, exclusive := usage.storage(images)
+		rows = append(rows, usageRow{
+			name:      fmt.Sprintf("%s/%s", stream.Namespace, stream.Name),
+			columns:   []string{fmt.Sprintf("%d", images.Len()), fmt.Sprintf("%d", layers)},
+			storage:   storage,
+			exclusive: exclusive,
+		})
+	}
+	return printUsage(o.Out, []string{"NAME", "IMAGES", "LAYERS"}, rows)
+}
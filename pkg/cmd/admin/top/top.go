@@ -0,0 +1,31 @@
+package top
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const TopRecommendedName = "top"
+
+const topLong = `Show usage statistics of resources on the server
+
+The commands here show how much of the resources of the cluster, such as the storage of the
+integrated registry, is consumed by each image or image stream.`
+
+func NewCommandTop(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	// Parent command to which all subcommands are added.
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Show usage statistics of resources on the server",
+		Long:  topLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
+	}
+
+	cmds.AddCommand(NewCmdTopImages(f, fullName, TopImagesRecommendedName, out))
+	cmds.AddCommand(NewCmdTopImageStreams(f, fullName, TopImageStreamsRecommendedName, out))
+	return cmds
+}
@@ -0,0 +1,106 @@
+package top
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func managedImage(name string, layers ...imageapi.ImageLayer) imageapi.Image {
+	return imageapi.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
+		},
+		DockerImageLayers: layers,
+	}
+}
+
+func stream(namespace, name string, tags map[string][]string) imageapi.ImageStream {
+	s := imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name},
+		Status:     imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{}},
+	}
+	for tag, images := range tags {
+		list := imageapi.TagEventList{}
+		for _, image := range images {
+			list.Items = append(list.Items, imageapi.TagEvent{Image: image})
+		}
+		s.Status.Tags[tag] = list
+	}
+	return s
+}
+
+func testImages() *imageapi.ImageList {
+	base := imageapi.ImageLayer{Name: "sha256:base", Size: 1000}
+	external := managedImage("sha256:external", base)
+	delete(external.Annotations, imageapi.ManagedByOpenShiftAnnotation)
+	untracked := managedImage("sha256:untracked")
+	untracked.DockerImageMetadata.Size = 50
+
+	return &imageapi.ImageList{Items: []imageapi.Image{
+		managedImage("sha256:ruby-1", base, imageapi.ImageLayer{Name: "sha256:ruby-1", Size: 200}),
+		managedImage("sha256:ruby-2", base, imageapi.ImageLayer{Name: "sha256:ruby-2", Size: 300}),
+		managedImage("sha256:nodejs", base, imageapi.ImageLayer{Name: "sha256:nodejs", Size: 100}),
+		external,
+		untracked,
+	}}
+}
+
+func testStreams() *imageapi.ImageStreamList {
+	return &imageapi.ImageStreamList{Items: []imageapi.ImageStream{
+		stream("web", "ruby", map[string][]string{"latest": {"sha256:ruby-2", "sha256:ruby-1"}}),
+		stream("web", "nodejs", map[string][]string{"latest": {"sha256:nodejs"}, "v1": {"sha256:nodejs"}}),
+		stream("other", "external", map[string][]string{"latest": {"sha256:external"}}),
+	}}
+}
+
+func TestTopImages(t *testing.T) {
+	out := &bytes.Buffer{}
+	o := &TopImagesOptions{Images: testImages(), Streams: testStreams(), Out: out}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]string{
+		{"NAME", "IMAGESTREAMTAGS", "LAYERS", "STORAGE", "EXCLUSIVE"},
+		{"sha256:ruby-2", "web/ruby:latest", "2", "1.3", "kB", "300", "B"},
+		{"sha256:ruby-1", "web/ruby:latest", "2", "1.2", "kB", "200", "B"},
+		{"sha256:nodejs", "web/nodejs:latest,web/nodejs:v1", "2", "1.1", "kB", "100", "B"},
+		{"sha256:untracked", "<none>", "1", "50", "B", "50", "B"},
+	}
+	checkOutput(t, out.String(), expected)
+}
+
+func TestTopImageStreams(t *testing.T) {
+	out := &bytes.Buffer{}
+	o := &TopImageStreamsOptions{Images: testImages(), Streams: testStreams(), Out: out}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the base layer is shared between both streams, so it is counted once in each stream's
+	// storage but is not exclusive to either of them
+	expected := [][]string{
+		{"NAME", "IMAGES", "LAYERS", "STORAGE", "EXCLUSIVE"},
+		{"web/ruby", "2", "3", "1.5", "kB", "500", "B"},
+		{"web/nodejs", "1", "2", "1.1", "kB", "100", "B"},
+	}
+	checkOutput(t, out.String(), expected)
+}
+
+func checkOutput(t *testing.T, out string, expected [][]string) {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got:\n%s", len(expected), out)
+	}
+	for i, line := range lines {
+		if fields := strings.Fields(line); strings.Join(fields, " ") != strings.Join(expected[i], " ") {
+			t.Errorf("line %d: expected %v, got %v", i, expected[i], fields)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package top
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// maxListedTags is the number of image stream tags listed for an image before the rest are
+// summarized
+const maxListedTags = 3
+
+// layerUsage records the size of a layer and the images that contain it
+type layerUsage struct {
+	size   int64
+	images sets.String
+}
+
+// registryUsage indexes the layers of the images stored in the integrated registry, so that
+// layers shared by several images are only counted once
+type registryUsage struct {
+	images map[string]*imageapi.Image
+	layers map[string]*layerUsage
+}
+
+// newRegistryUsage indexes the layers of the images that are managed by the integrated registry.
+// Images that were only imported from other registries do not use its storage and are ignored.
+func newRegistryUsage(images *imageapi.ImageList) *registryUsage {
+	u := &registryUsage{
+		images: map[string]*imageapi.Image{},
+		layers: map[string]*layerUsage{},
+	}
+	for i := range images.Items {
+		image := &images.Items[i]
+		if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] != "true" {
+			continue
+		}
+		u.images[image.Name] = image
+		for _, layer := range imageLayers(image) {
+			usage, ok := u.layers[layer.Name]
+			if !ok {
+				usage = &layerUsage{size: layer.Size, images: sets.NewString()}
+				u.layers[layer.Name] = usage
+			}
+			usage.images.Insert(image.Name)
+		}
+	}
+	return u
+}
+
+// imageLayers returns the layers of the image. Images without layer information are treated as
+// a single layer of the size of the image.
+func imageLayers(image *imageapi.Image) []imageapi.ImageLayer {
+	if len(image.DockerImageLayers) > 0 {
+		return image.DockerImageLayers
+	}
+	return []imageapi.ImageLayer{{Name: image.Name, Size: image.DockerImageMetadata.Size}}
+}
+
+// storage returns the number of distinct layers of the given images, the size of those layers,
+// and the size of the layers that no other image contains
+func (u *registryUsage) storage(imageNames sets.String) (layers int, total, exclusive int64) {
+	seen := sets.NewString()
+	for _, name := range imageNames.List() {
+		image, ok := u.images[name]
+		if !ok {
+			continue
+		}
+		for _, layer := range imageLayers(image) {
+			if seen.Has(layer.Name) {
+				continue
+			}
+			seen.Insert(layer.Name)
+			usage := u.layers[layer.Name]
+			total += usage.size
+			if imageNames.HasAll(usage.images.List()...) {
+				exclusive += usage.size
+			}
+		}
+	}
+	return seen.Len(), total, exclusive
+}
+
+// imageStreamImages returns the names of the images referenced by the tag history of the stream
+func imageStreamImages(stream *imageapi.ImageStream) sets.String {
+	names := sets.NewString()
+	for _, history := range stream.Status.Tags {
+		for _, event := range history.Items {
+			if len(event.Image) > 0 {
+				names.Insert(event.Image)
+			}
+		}
+	}
+	return names
+}
+
+// imageStreamTags maps the name of each image to the image stream tags whose history references it
+func imageStreamTags(streams *imageapi.ImageStreamList) map[string][]string {
+	tags := map[string]sets.String{}
+	for _, stream := range streams.Items {
+		for tag, history := range stream.Status.Tags {
+			for _, event := range history.Items {
+				if _, ok := tags[event.Image]; !ok {
+					tags[event.Image] = sets.NewString()
+				}
+				tags[event.Image].Insert(fmt.Sprintf("%s/%s", stream.Namespace, imageapi.JoinImageStreamTag(stream.Name, tag)))
+			}
+		}
+	}
+	out := map[string][]string{}
+	for image, set := range tags {
+		out[image] = set.List()
+	}
+	return out
+}
+
+// formatTags lists the first tags and summarizes the rest
+func formatTags(tags []string) string {
+	if len(tags) == 0 {
+		return "<none>"
+	}
+	if len(tags) <= maxListedTags {
+		return strings.Join(tags, ",")
+	}
+	return fmt.Sprintf("%s + %d more...", strings.Join(tags[:maxListedTags], ","), len(tags)-maxListedTags)
+}
+
+// usageRow is a line of the output of the top commands
+type usageRow struct {
+	name      string
+	columns   []string
+	storage   int64
+	exclusive int64
+}
+
+// byStorage sorts rows by decreasing storage, then by name
+type byStorage []usageRow
+
+func (r byStorage) Len() int      { return len(r) }
+func (r byStorage) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r byStorage) Less(i, j int) bool {
+	if r[i].storage != r[j].storage {
+		return r[i].storage > r[j].storage
+	}
+	return r[i].name < r[j].name
+}
@@ -0,0 +1,147 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	kclientcmd "k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	configapi "github.com/openshift/origin/pkg/cmd/server/api"
+	"github.com/openshift/origin/pkg/cmd/server/api/validation"
+)
+
+const (
+	// defaultCertificateExpiryWarning is how long before a certificate expires that the validate commands warn about it
+	defaultCertificateExpiryWarning = 30 * 24 * time.Hour
+	// defaultReachabilityTimeout is how long the validate commands wait for a connection to a remote server
+	defaultReachabilityTimeout = 5 * time.Second
+)
+
+// fileReference is a file named in a config file, with the path of the field that names it
+type fileReference struct {
+	fldPath *field.Path
+	file    string
+}
+
+// urlReference is a URL named in a config file, with the path of the field that names it
+type urlReference struct {
+	fldPath *field.Path
+	url     string
+}
+
+// appendServingInfoCertificates adds the certificate and client CA files of the serving info
+func appendServingInfoCertificates(refs []fileReference, info configapi.ServingInfo, fldPath *field.Path) []fileReference {
+	refs = appendFile(refs, info.ServerCert.CertFile, fldPath.Child("certFile"))
+	refs = appendFile(refs, info.ClientCA, fldPath.Child("clientCA"))
+	for i, namedCertificate := range info.NamedCertificates {
+		refs = appendFile(refs, namedCertificate.CertFile, fldPath.Child("namedCertificates").Index(i).Child("certFile"))
+	}
+	return refs
+}
+
+func appendFile(refs []fileReference, file string, fldPath *field.Path) []fileReference {
+	if len(file) == 0 {
+		return refs
+	}
+	return append(refs, fileReference{fldPath: fldPath, file: file})
+}
+
+// masterCertificates returns the certificate and CA files of a master config
+func masterCertificates(config *configapi.MasterConfig) []fileReference {
+	refs := []fileReference{}
+	refs = appendServingInfoCertificates(refs, config.ServingInfo.ServingInfo, field.NewPath("servingInfo"))
+	if config.AssetConfig != nil {
+		refs = appendServingInfoCertificates(refs, config.AssetConfig.ServingInfo.ServingInfo, field.NewPath("assetConfig", "servingInfo"))
+	}
+	if config.EtcdConfig != nil {
+		refs = appendServingInfoCertificates(refs, config.EtcdConfig.ServingInfo, field.NewPath("etcdConfig", "servingInfo"))
+		refs = appendServingInfoCertificates(refs, config.EtcdConfig.PeerServingInfo, field.NewPath("etcdConfig", "peerServingInfo"))
+	}
+	refs = appendFile(refs, config.EtcdClientInfo.CA, field.NewPath("etcdClientInfo", "ca"))
+	refs = appendFile(refs, config.EtcdClientInfo.ClientCert.CertFile, field.NewPath("etcdClientInfo", "certFile"))
+	refs = appendFile(refs, config.KubeletClientInfo.CA, field.NewPath("kubeletClientInfo", "ca"))
+	refs = appendFile(refs, config.KubeletClientInfo.ClientCert.CertFile, field.NewPath("kubeletClientInfo", "certFile"))
+	refs = appendFile(refs, config.ServiceAccountConfig.MasterCA, field.NewPath("serviceAccountConfig", "masterCA"))
+	if config.OAuthConfig != nil && config.OAuthConfig.MasterCA != nil {
+		refs = appendFile(refs, *config.OAuthConfig.MasterCA, field.NewPath("oauthConfig", "masterCA"))
+	}
+	return refs
+}
+
+// nodeCertificates returns the certificate and CA files of a node config
+func nodeCertificates(config *configapi.NodeConfig) []fileReference {
+	return appendServingInfoCertificates([]fileReference{}, config.ServingInfo, field.NewPath("servingInfo"))
+}
+
+// masterRemoteURLs returns the URLs of the servers that a master must reach when it starts. The URLs of an
+// etcd embedded in the master are not included, since etcd is not running until the master is.
+func masterRemoteURLs(config *configapi.MasterConfig) []urlReference {
+	refs := []urlReference{}
+	if config.EtcdConfig == nil {
+		for i, etcdURL := range config.EtcdClientInfo.URLs {
+			refs = append(refs, urlReference{fldPath: field.NewPath("etcdClientInfo", "urls").Index(i), url: etcdURL})
+		}
+	}
+	return refs
+}
+
+// nodeRemoteURLs returns the URL of the master that the node connects to
+func nodeRemoteURLs(config *configapi.NodeConfig) ([]urlReference, error) {
+	kubeConfig, err := kclientcmd.LoadFromFile(config.MasterKubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	context, ok := kubeConfig.Contexts[kubeConfig.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("the current context %q of %s does not exist", kubeConfig.CurrentContext, config.MasterKubeConfig)
+	}
+	cluster, ok := kubeConfig.Clusters[context.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("the cluster %q of %s does not exist", context.Cluster, config.MasterKubeConfig)
+	}
+	return []urlReference{{fldPath: field.NewPath("masterKubeConfig"), url: cluster.Server}}, nil
+}
+
+// validateCertificates checks that every certificate in the referenced files is valid now and will not expire
+// within expiryWarning. Missing files are already reported by the config validation.
+func validateCertificates(refs []fileReference, now time.Time, expiryWarning time.Duration) validation.ValidationResults {
+	results := validation.ValidationResults{}
+	for _, ref := range refs {
+		if fileErrs := validation.ValidateFile(ref.file, ref.fldPath); len(fileErrs) > 0 {
+			continue
+		}
+		results.Append(validation.ValidateCertificateExpiry(ref.file, now, expiryWarning, ref.fldPath))
+	}
+	return results
+}
+
+// validateReachable checks that a TCP connection can be opened to the host of every referenced URL
+func validateReachable(refs []urlReference, timeout time.Duration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, ref := range refs {
+		u, err := url.Parse(ref.url)
+		if err != nil || len(u.Host) == 0 {
+			// invalid URLs are reported by the config validation
+			continue
+		}
+		host := u.Host
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			switch u.Scheme {
+			case "https":
+				host = net.JoinHostPort(host, "443")
+			default:
+				host = net.JoinHostPort(host, "80")
+			}
+		}
+		conn, err := net.DialTimeout("tcp", host, timeout)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(ref.fldPath, ref.url, fmt.Sprintf("could not connect: %v", err)))
+			continue
+		}
+		conn.Close()
+	}
+	return allErrs
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -23,18 +24,31 @@ const (
 Validate the configuration file for a master server.
 
 This command validates that a configuration file intended to be used for a master server is valid.
+Run it before restarting a master to find problems that would prevent the master from starting.
+
+In addition to the checks the master performs when it starts, the command reports certificates
+that have expired or will expire soon. With --check-urls, it also checks that the master can
+connect to an external etcd.
 `
 
 	validateMasterConfigExample = ` // Validate master server configuration file
-  $ %s openshift.local.config/master/master-config.yaml`
+  $ %[1]s openshift.local.config/master/master-config.yaml
 
-	validateMasterConfigDeprecationMessage = `This command is deprecated and will be removed. Use 'openshift ex diagnostics MasterConfigCheck --master-config=path/to/config.yaml' instead.`
+  // Warn about certificates that expire in the next 90 days, and check that etcd can be reached
+  $ %[1]s openshift.local.config/master/master-config.yaml --cert-expiry-warning=2160h --check-urls`
 )
 
 type ValidateMasterConfigOptions struct {
 	// MasterConfigFile is the location of the config file to be validated
 	MasterConfigFile string
 
+	// CertificateExpiryWarning is how long before a certificate expires that a warning is reported
+	CertificateExpiryWarning time.Duration
+	// CheckURLs enables checking that the remote servers named in the config file can be reached
+	CheckURLs bool
+	// Timeout is how long to wait for a connection to a remote server
+	Timeout time.Duration
+
 	// Out is the writer to write output to
 	Out io.Writer
 }
@@ -42,15 +56,16 @@ type ValidateMasterConfigOptions struct {
 // NewCommandValidateMasterConfig provides a CLI handler for the `validate all-in-one` command
 func NewCommandValidateMasterConfig(name, fullName string, out io.Writer) *cobra.Command {
 	options := &ValidateMasterConfigOptions{
-		Out: out,
+		CertificateExpiryWarning: defaultCertificateExpiryWarning,
+		Timeout:                  defaultReachabilityTimeout,
+		Out:                      out,
 	}
 
 	cmd := &cobra.Command{
-		Use:        fmt.Sprintf("%s SOURCE", name),
-		Short:      "Validate the configuration file for a master server",
-		Long:       validateMasterConfigLong,
-		Example:    fmt.Sprintf(validateMasterConfigExample, fullName),
-		Deprecated: validateMasterConfigDeprecationMessage,
+		Use:     fmt.Sprintf("%s SOURCE", name),
+		Short:   "Validate the configuration file for a master server",
+		Long:    validateMasterConfigLong,
+		Example: fmt.Sprintf(validateMasterConfigExample, fullName),
 		Run: func(c *cobra.Command, args []string) {
 			if err := options.Complete(args); err != nil {
 				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
@@ -67,6 +82,8 @@ func NewCommandValidateMasterConfig(name, fullName string, out io.Writer) *cobra
 		},
 	}
 
+	bindCheckFlags(cmd, &options.CertificateExpiryWarning, &options.CheckURLs, &options.Timeout)
+
 	return cmd
 }
 
@@ -87,6 +104,10 @@ func (o *ValidateMasterConfigOptions) Run() (bool, error) {
 	}
 
 	results := validation.ValidateMasterConfig(masterConfig, nil)
+	results.Append(validateCertificates(masterCertificates(masterConfig), time.Now(), o.CertificateExpiryWarning))
+	if o.CheckURLs {
+		results.AddErrors(validateReachable(masterRemoteURLs(masterConfig), o.Timeout)...)
+	}
 	writer := tabwriter.NewWriter(o.Out, minColumnWidth, tabWidth, padding, padchar, flags)
 	err = prettyPrintValidationResults(results, writer)
 	if err != nil {
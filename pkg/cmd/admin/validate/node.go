@@ -6,10 +6,12 @@ import (
 	"io"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	configapilatest "github.com/openshift/origin/pkg/cmd/server/api/latest"
 	"github.com/openshift/origin/pkg/cmd/server/api/validation"
@@ -22,18 +24,31 @@ const (
 Validate the configuration file for a node.
 
 This command validates that a configuration file intended to be used for a node is valid.
+Run it before restarting a node to find problems that would prevent the node from starting.
+
+In addition to the checks the node performs when it starts, the command reports certificates
+that have expired or will expire soon. With --check-urls, it also checks that the node can
+connect to the master.
 `
 
 	valiateNodeConfigExample = ` // Validate node configuration file
-  $ %s openshift.local.config/master/node-config.yaml`
+  $ %[1]s openshift.local.config/master/node-config.yaml
 
-	validateNodeConfigDeprecationMessage = `This command is deprecated and will be removed. Use 'openshift ex diagnostics NodeConfigCheck --node-config=path/to/config.yaml' instead.`
+  // Validate node configuration file and check that the master can be reached
+  $ %[1]s openshift.local.config/master/node-config.yaml --check-urls`
 )
 
 type ValidateNodeConfigOptions struct {
 	// NodeConfigFile is the location of the config file to be validated
 	NodeConfigFile string
 
+	// CertificateExpiryWarning is how long before a certificate expires that a warning is reported
+	CertificateExpiryWarning time.Duration
+	// CheckURLs enables checking that the master named in the config file can be reached
+	CheckURLs bool
+	// Timeout is how long to wait for a connection to the master
+	Timeout time.Duration
+
 	// Out is the writer to write output to
 	Out io.Writer
 }
@@ -41,15 +56,16 @@ type ValidateNodeConfigOptions struct {
 // NewCommandValidateMasterConfig provides a CLI handler for the `validate all-in-one` command
 func NewCommandValidateNodeConfig(name, fullName string, out io.Writer) *cobra.Command {
 	options := &ValidateNodeConfigOptions{
-		Out: out,
+		CertificateExpiryWarning: defaultCertificateExpiryWarning,
+		Timeout:                  defaultReachabilityTimeout,
+		Out:                      out,
 	}
 
 	cmd := &cobra.Command{
-		Use:        fmt.Sprintf("%s SOURCE", name),
-		Short:      "Validate the configuration file for a node",
-		Long:       validateNodeConfigLong,
-		Example:    fmt.Sprintf(valiateNodeConfigExample, fullName),
-		Deprecated: validateNodeConfigDeprecationMessage,
+		Use:     fmt.Sprintf("%s SOURCE", name),
+		Short:   "Validate the configuration file for a node",
+		Long:    validateNodeConfigLong,
+		Example: fmt.Sprintf(valiateNodeConfigExample, fullName),
 		Run: func(c *cobra.Command, args []string) {
 			if err := options.Complete(args); err != nil {
 				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
@@ -66,6 +82,8 @@ func NewCommandValidateNodeConfig(name, fullName string, out io.Writer) *cobra.C
 		},
 	}
 
+	bindCheckFlags(cmd, &options.CertificateExpiryWarning, &options.CheckURLs, &options.Timeout)
+
 	return cmd
 }
 
@@ -86,6 +104,15 @@ func (o *ValidateNodeConfigOptions) Run() (ok bool, err error) {
 	}
 
 	results := validation.ValidateNodeConfig(nodeConfig, nil)
+	results.Append(validateCertificates(nodeCertificates(nodeConfig), time.Now(), o.CertificateExpiryWarning))
+	if o.CheckURLs {
+		urls, err := nodeRemoteURLs(nodeConfig)
+		if err != nil {
+			results.AddErrors(field.Invalid(field.NewPath("masterKubeConfig"), nodeConfig.MasterKubeConfig, fmt.Sprintf("could not load: %v", err)))
+		} else {
+			results.AddErrors(validateReachable(urls, o.Timeout)...)
+		}
+	}
 	writer := tabwriter.NewWriter(o.Out, minColumnWidth, tabWidth, padding, padchar, flags)
 	err = prettyPrintValidationResults(results, writer)
 	if err != nil {
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"io"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,21 +14,18 @@ const (
 
 	validateLong = `Validate configuration file integrity
 
-The commands here allow administrators to validate the integrity of configuration files.
+The commands here allow administrators to validate the integrity of configuration files before
+restarting a master or node with them.
 `
-
-	validateDeprecationMessage = `The commands here are deprecated and will be removed. Use 'openshift ex diagnostics' to run configuration validations instead.
-See sub-command help text for specific instructions with 'openshift ex diagnostics'.`
 )
 
 func NewCommandValidate(name, fullName string, out io.Writer) *cobra.Command {
 	// Parent command to which all subcommands are added.
 	cmds := &cobra.Command{
-		Use:        name,
-		Short:      "Validate configuration file integrity",
-		Long:       validateLong,
-		Deprecated: validateDeprecationMessage,
-		Run:        cmdutil.DefaultSubCommandRun(out),
+		Use:   name,
+		Short: "Validate configuration file integrity",
+		Long:  validateLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
 	}
 
 	cmds.AddCommand(NewCommandValidateMasterConfig(ValidateMasterConfigRecommendedName,
@@ -36,3 +34,10 @@ func NewCommandValidate(name, fullName string, out io.Writer) *cobra.Command {
 		fullName+" "+ValidateNodeConfigRecommendedName, out))
 	return cmds
 }
+
+// bindCheckFlags binds the flags of the checks that go beyond the validation performed at startup
+func bindCheckFlags(cmd *cobra.Command, certificateExpiryWarning *time.Duration, checkURLs *bool, timeout *time.Duration) {
+	cmd.Flags().DurationVar(certificateExpiryWarning, "cert-expiry-warning", *certificateExpiryWarning, "Warn about certificates that expire within this duration.")
+	cmd.Flags().BoolVar(checkURLs, "check-urls", *checkURLs, "Check that the servers named in the config file can be reached.")
+	cmd.Flags().DurationVar(timeout, "timeout", *timeout, "How long to wait for a connection when checking URLs.")
+}
@@ -88,6 +88,8 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdCancelBuild(fullName, f, out),
 				cmd.NewCmdImportImage(fullName, f, out),
 				cmd.NewCmdScale(fullName, f, out),
+				cmd.NewCmdAutoscale(fullName, f, out),
+				cmd.NewCmdIdle(fullName, f, out),
 				cmd.NewCmdTag(fullName, f, out),
 			},
 		},
@@ -99,6 +101,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdEdit(fullName, f, out),
 				cmd.NewCmdEnv(fullName, f, in, out),
 				cmd.NewCmdVolume(fullName, f, out, errout),
+				cmd.NewCmdSet(fullName, f, in, out, errout),
 				cmd.NewCmdLabel(fullName, f, out),
 				cmd.NewCmdAnnotate(fullName, f, out),
 				cmd.NewCmdExpose(fullName, f, out),
@@ -110,8 +113,10 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 			Message: "Troubleshooting and Debugging Commands:",
 			Commands: []*cobra.Command{
 				cmd.NewCmdExplain(fullName, f, out),
+				cmd.NewCmdAPIVersions(cmd.APIVersionsRecommendedName, fullName, f, out),
 				cmd.NewCmdLogs(cmd.LogsRecommendedName, fullName, f, out),
 				cmd.NewCmdRsh(cmd.RshRecommendedName, fullName, f, in, out, errout),
+				cmd.NewCmdDebug(fullName, f, in, out, errout),
 				rsync.NewCmdRsync(rsync.RsyncRecommendedName, fullName, f, out, errout),
 				cmd.NewCmdExec(fullName, f, in, out, errout),
 				cmd.NewCmdPortForward(fullName, f),
@@ -131,7 +136,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdRun(fullName, f, in, out, errout),
 				cmd.NewCmdAttach(fullName, f, in, out, errout),
 				policy.NewCmdPolicy(policy.PolicyRecommendedName, fullName+" "+policy.PolicyRecommendedName, f, out),
-				secrets.NewCmdSecrets(secrets.SecretsRecommendedName, fullName+" "+secrets.SecretsRecommendedName, f, in, out, fullName+" edit"),
+				secrets.NewCmdSecrets(secrets.SecretsRecommendedName, fullName+" "+secrets.SecretsRecommendedName, f, in, out, fullName+" edit", fullName+" describe"),
 				cmd.NewCmdConvert(fullName, f, out),
 			},
 		},
@@ -147,7 +152,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 	groups.Add(cmds)
 	changeSharedFlagDefaults(cmds)
 	templates.ActsAsRootCommand(cmds, groups...).
-		ExposeFlags(loginCmd, "certificate-authority", "insecure-skip-tls-verify", "token")
+		ExposeFlags(loginCmd, "certificate-authority", "insecure-skip-tls-verify", "token", "client-certificate", "client-key")
 
 	if name == fullName {
 		cmds.AddCommand(version.NewVersionCommand(fullName, false))
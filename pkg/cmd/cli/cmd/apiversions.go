@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const APIVersionsRecommendedName = "api-versions"
+
+const apiVersionsLong = `
+Print the supported API versions on the server, in the form of "group/version"
+
+The versions of the OpenShift API are listed along with the versions of the Kubernetes API.`
+
+const apiVersionsExample = `  # Print the supported API versions
+  $ %[1]s api-versions`
+
+// NewCmdAPIVersions prints the API versions served by the server
+func NewCmdAPIVersions(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Print the supported API versions on the server",
+		Long:    apiVersionsLong,
+		Example: fmt.Sprintf(apiVersionsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(RunAPIVersions(f, out))
+		},
+	}
+	return cmd
+}
+
+// RunAPIVersions prints the API versions served by the server, sorted
+func RunAPIVersions(f *clientcmd.Factory, out io.Writer) error {
+	client, err := f.DiscoveryClient()
+	if err != nil {
+		return err
+	}
+	groups, err := client.ServerGroups()
+	if err != nil {
+		return fmt.Errorf("couldn't get the available API versions from the server: %v", err)
+	}
+	versions := kclient.ExtractGroupVersions(groups)
+	sort.Strings(versions)
+	for _, version := range versions {
+		fmt.Fprintln(out, version)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const CreateImageStreamRecommendedName = "imagestream"
+
+const createImageStreamLong = `
+Create a new empty image stream
+
+Builds and the integrated registry can push images to the new image stream. Use the
+'import-image' or 'tag' commands to import images into it from other registries.`
+
+const createImageStreamExample = `  # Create a new image stream for the output of a build
+  $ %[1]s create imagestream ruby-app`
+
+// NewCmdCreateImageStream creates an empty image stream
+func NewCmdCreateImageStream(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     name + " NAME",
+		Short:   "Create a new empty image stream",
+		Long:    createImageStreamLong,
+		Example: fmt.Sprintf(createImageStreamExample, fullName),
+		Aliases: []string{"is"},
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(RunCreateImageStream(f, out, cmd, args))
+		},
+	}
+	return cmd
+}
+
+// RunCreateImageStream creates the image stream named by args in the current namespace
+func RunCreateImageStream(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return kcmdutil.UsageError(cmd, "NAME is required")
+	}
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	client, _, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	stream := &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: args[0]}}
+	if _, err := client.ImageStreams(namespace).Create(stream); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "imagestream %q created\n", args[0])
+	return nil
+}
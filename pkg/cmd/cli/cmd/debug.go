@@ -0,0 +1,554 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kubecmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+const (
+	DebugRecommendedName = "debug"
+
+	// debugPodAnnotationSourceContainer is set on a debug pod to the name of the container being debugged
+	debugPodAnnotationSourceContainer = "debug.openshift.io/source-container"
+	// debugPodAnnotationSourceResource is set on a debug pod to the resource it was created from
+	debugPodAnnotationSourceResource = "debug.openshift.io/source-resource"
+
+	// defaultDebugNodeImage is the image of the pod started by a node debug session
+	defaultDebugNodeImage = "openshift/origin-base"
+	// debugNodeHostPath is where the root filesystem of the node is mounted in a node debug pod
+	debugNodeHostPath = "/host"
+
+	debugLong = `
+Launch a command shell to debug a running application
+
+When debugging images and setup problems, it's useful to get an exact copy of a running
+pod configuration and troubleshoot with a shell. Since a pod that is failing may not be
+started and not accessible to 'rsh' or 'exec', the 'debug' command makes it easy to
+create a carbon copy of that setup.
+
+The default mode is to start a shell inside of the first container of the referenced pod,
+replication controller, or deployment config. The started pod will be a copy of your
+source pod, with labels stripped, the command changed to '/bin/sh', and readiness and
+liveness checks disabled. If you just want to run a command, add '--' and a command to
+run. Passing a command will not create a TTY or send STDIN by default. Other flags are
+supported for altering the container or pod in common ways.
+
+When a node is referenced, a privileged pod is started on that node in the host network,
+PID and IPC namespaces, with the root filesystem of the node mounted at %[2]s. Use
+'chroot %[2]s' to run the tools of the node.
+
+The debug pod is deleted when the remote command completes or the user interrupts
+the shell.`
+
+	debugExample = `
+  # Debug a currently running deployment
+  $ %[1]s dc/test
+
+  # Test running a deployment as a non-root user
+  $ %[1]s dc/test --as-user=1000000
+
+  # Debug a specific failing container by running the env command in the 'second' container
+  $ %[1]s dc/test -c second -- /bin/env
+
+  # Debug a node by starting a shell in its host namespaces
+  $ %[1]s node/node1.example.com
+
+  # See the pod that would be created to debug
+  $ %[1]s dc/test -o yaml`
+)
+
+// DebugOptions holds the options for debugging a copy of a pod
+type DebugOptions struct {
+	Attach kubecmd.AttachOptions
+
+	Print         func(pod *kapi.Pod, out io.Writer) error
+	LogsForObject func(object, options runtime.Object) (*kclient.Request, error)
+
+	NoStdin    bool
+	ForceTTY   bool
+	DisableTTY bool
+	Timeout    time.Duration
+
+	Command   []string
+	Env       []kapi.EnvVar
+	RemoveEnv []string
+
+	AsRoot bool
+	AsUser int64
+
+	KeepAnnotations bool
+	KeepLiveness    bool
+	KeepReadiness   bool
+	OneContainer    bool
+	NodeName        string
+	Image           string
+
+	// object is the resource that is debugged
+	object runtime.Object
+	// resourceName is the name of the debugged resource, as resource/name
+	resourceName string
+}
+
+// NewCmdDebug creates a command for debugging pods.
+func NewCmdDebug(fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &DebugOptions{
+		Timeout: 15 * time.Minute,
+		Attach: kubecmd.AttachOptions{
+			In:     in,
+			Out:    out,
+			Err:    errout,
+			Attach: &kubecmd.DefaultRemoteAttach{},
+		},
+		AsUser: -1,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "debug RESOURCE/NAME [ENV1=VAL1 ...] [-c CONTAINER] [options] [-- COMMAND]",
+		Short:   "Launch a new instance of a pod for debugging",
+		Long:    fmt.Sprintf(debugLong, fullName, debugNodeHostPath),
+		Example: fmt.Sprintf(debugExample, fmt.Sprintf("%s %s", fullName, DebugRecommendedName)),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(cmd, f, args, in, out, errout))
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Debug())
+		},
+	}
+
+	// the printer flags are added individually, since -t is the shorthand of --tty rather than --template
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml|go-template=...|go-template-file=...|jsonpath=...|jsonpath-file=... Prints the debug pod instead of creating it.")
+	cmd.Flags().String("output-version", "", "Output the formatted object with the given version (default api-version).")
+	cmd.Flags().String("template", "", "Template string or path to template file to use when -o=go-template, -o=go-template-file.")
+
+	cmd.Flags().BoolVarP(&options.NoStdin, "no-stdin", "I", false, "Bypasses passing STDIN to the container, defaults to true if no command specified")
+	cmd.Flags().BoolVarP(&options.ForceTTY, "tty", "t", false, "Force a pseudo-terminal to be allocated")
+	cmd.Flags().BoolVarP(&options.DisableTTY, "no-tty", "T", false, "Disable pseudo-terminal allocation")
+	cmd.Flags().StringVarP(&options.Attach.ContainerName, "container", "c", "", "Container name; defaults to first container")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", options.Timeout, "How long to wait for the debug pod to start")
+
+	cmd.Flags().BoolVar(&options.KeepAnnotations, "keep-annotations", false, "Keep the original pod annotations")
+	cmd.Flags().BoolVar(&options.KeepLiveness, "keep-liveness", false, "Keep the original pod liveness probes")
+	cmd.Flags().BoolVar(&options.KeepReadiness, "keep-readiness", false, "Keep the original pod readiness probes")
+	cmd.Flags().BoolVar(&options.OneContainer, "one-container", false, "Run only the selected container, remove all others")
+	cmd.Flags().StringVar(&options.NodeName, "node-name", "", "Set a specific node to run on - by default the pod will run on any valid node")
+	cmd.Flags().BoolVar(&options.AsRoot, "as-root", false, "Try to run the container as the root user")
+	cmd.Flags().Int64Var(&options.AsUser, "as-user", options.AsUser, "Try to run the container as a specific user UID (note: admins may limit your ability to use this flag)")
+	cmd.Flags().StringVar(&options.Image, "image", "", fmt.Sprintf("Override the image used by the debugged container; defaults to %s when debugging a node", defaultDebugNodeImage))
+
+	return cmd
+}
+
+// Complete resolves the resource to debug and loads the clients from the command environment
+func (o *DebugOptions) Complete(cmd *cobra.Command, f *clientcmd.Factory, args []string, in io.Reader, out, errout io.Writer) error {
+	if i := cmd.ArgsLenAtDash(); i != -1 && i < len(args) {
+		o.Command = args[i:]
+		args = args[:i]
+	}
+	resources, envArgs := []string{}, []string{}
+	for _, s := range args {
+		isEnv := strings.Contains(s, "=") || strings.HasSuffix(s, "-")
+		switch {
+		case isEnv:
+			envArgs = append(envArgs, s)
+		case len(envArgs) > 0:
+			return kcmdutil.UsageError(cmd, "all resources must be specified before environment changes: %s", s)
+		default:
+			resources = append(resources, s)
+		}
+	}
+	if len(resources) != 1 {
+		return kcmdutil.UsageError(cmd, "you must identify a single resource with a pod template to debug")
+	}
+
+	switch {
+	case o.ForceTTY && o.NoStdin:
+		return kcmdutil.UsageError(cmd, "you may not specify -I and -t together")
+	case o.ForceTTY && o.DisableTTY:
+		return kcmdutil.UsageError(cmd, "you may not specify -t and -T together")
+	case o.ForceTTY:
+		o.Attach.TTY = true
+	case o.DisableTTY:
+		o.Attach.TTY = false
+	// don't default TTY to true if a command is passed
+	case len(o.Command) > 0:
+		o.Attach.TTY = false
+	default:
+		o.Attach.TTY = !o.NoStdin && cmdutil.IsTerminal(in)
+		glog.V(4).Infof("Defaulting TTY to %t", o.Attach.TTY)
+	}
+	// STDIN is passed to shells and whenever a TTY is allocated
+	o.Attach.Stdin = !o.NoStdin && (o.Attach.TTY || len(o.Command) == 0)
+	if o.AsRoot {
+		if o.AsUser != -1 {
+			return kcmdutil.UsageError(cmd, "you may not specify --as-root and --as-user together")
+		}
+		o.AsUser = 0
+	}
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		NamespaceParam(namespace).DefaultNamespace().
+		SingleResourceType().
+		ResourceNames("pods", resources[0]).
+		Do().Infos()
+	if err != nil {
+		return err
+	}
+	if len(infos) != 1 {
+		return fmt.Errorf("you must identify a single resource with a pod template to debug")
+	}
+	o.object = infos[0].Object
+	o.resourceName = fmt.Sprintf("%s/%s", infos[0].Mapping.Resource, infos[0].Name)
+	// the pod that debugs a node runs in the current project
+	o.Attach.Namespace = namespace
+
+	o.Env, o.RemoveEnv, err = ParseEnv(envArgs, in)
+	if err != nil {
+		return err
+	}
+
+	o.Attach.Config, err = f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	_, o.Attach.Client, err = f.Clients()
+	if err != nil {
+		return err
+	}
+
+	output := kcmdutil.GetFlagString(cmd, "output")
+	if len(output) != 0 {
+		o.Print = func(pod *kapi.Pod, out io.Writer) error {
+			return f.PrintObject(cmd, pod, out)
+		}
+	}
+	o.LogsForObject = f.LogsForObject
+	return nil
+}
+
+// Validate checks that the debug options are consistent
+func (o *DebugOptions) Validate() error {
+	if o.object == nil {
+		return errors.New("a resource to debug is required")
+	}
+	if o.AsUser < -1 {
+		return errors.New("--as-user must be a valid user ID")
+	}
+	if _, isNode := o.object.(*kapi.Node); isNode {
+		if len(o.NodeName) > 0 {
+			return errors.New("--node-name may not be specified when debugging a node")
+		}
+		if len(o.Attach.ContainerName) > 0 {
+			return errors.New("--container may not be specified when debugging a node")
+		}
+	}
+	if o.Print == nil && (o.Attach.Client == nil || o.Attach.Config == nil || o.Attach.Attach == nil) {
+		return errors.New("client, client config, and attach must be provided")
+	}
+	return nil
+}
+
+// Debug creates a debug pod for the resource, attaches to it, and deletes the pod when the session
+// ends or the command is interrupted.
+func (o *DebugOptions) Debug() error {
+	pod, originalCommand, err := o.debugPod()
+	if err != nil {
+		return err
+	}
+	if o.Print != nil {
+		return o.Print(pod, o.Attach.Out)
+	}
+	containerName := pod.Annotations[debugPodAnnotationSourceContainer]
+
+	if len(originalCommand) > 0 {
+		fmt.Fprintf(o.Attach.Err, "Debugging with pod/%s, original command: %s\n", pod.Name, strings.Join(originalCommand, " "))
+	} else {
+		fmt.Fprintf(o.Attach.Err, "Debugging with pod/%s ...\n", pod.Name)
+	}
+
+	pods := o.Attach.Client.Pods(o.Attach.Namespace)
+	pod, err = pods.Create(pod)
+	if err != nil {
+		return err
+	}
+
+	// the pod is deleted when Debug returns, and when the command is interrupted before the
+	// session starts, since the signal handler of the attached TTY exits the process
+	done := make(chan struct{})
+	defer close(done)
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	go func() {
+		select {
+		case <-sigs:
+			o.deletePod(pod)
+			os.Exit(1)
+		case <-done:
+		}
+	}()
+	defer o.deletePod(pod)
+
+	status, err := o.waitForContainer(pod, containerName)
+	if err != nil {
+		return err
+	}
+	if status.State.Terminated != nil {
+		// the container exited before it could be attached to, so show what it printed
+		if err := o.printLogs(pod, containerName); err != nil {
+			return err
+		}
+		if code := status.State.Terminated.ExitCode; code != 0 {
+			return fmt.Errorf("container %s exited with code %d", containerName, code)
+		}
+		return nil
+	}
+
+	o.Attach.PodName = pod.Name
+	o.Attach.ContainerName = containerName
+	return o.Attach.Run()
+}
+
+// debugPod returns the pod to create for the resource being debugged, and the original command
+// of the debugged container
+func (o *DebugOptions) debugPod() (*kapi.Pod, []string, error) {
+	var name string
+	var template *kapi.PodTemplateSpec
+	switch t := o.object.(type) {
+	case *kapi.Node:
+		return o.nodeDebugPod(t), nil, nil
+	case *kapi.Pod:
+		name = t.Name
+		template = &kapi.PodTemplateSpec{ObjectMeta: t.ObjectMeta, Spec: t.Spec}
+	case *kapi.ReplicationController:
+		name = t.Name
+		template = t.Spec.Template
+	case *deployapi.DeploymentConfig:
+		name = t.Name
+		template = t.Spec.Template
+	default:
+		return nil, nil, fmt.Errorf("%s does not have a pod template that can be debugged", o.resourceName)
+	}
+	if template == nil {
+		return nil, nil, fmt.Errorf("%s does not have a pod template", o.resourceName)
+	}
+
+	copied, err := kapi.Scheme.DeepCopy(template)
+	if err != nil {
+		return nil, nil, err
+	}
+	return o.transformPodForDebug(name, copied.(*kapi.PodTemplateSpec))
+}
+
+// transformPodForDebug turns a pod template into a pod that runs the debug command in place of the
+// selected container. Labels are removed so that the pod does not receive traffic from services
+// and is not managed by any controller.
+func (o *DebugOptions) transformPodForDebug(name string, template *kapi.PodTemplateSpec) (*kapi.Pod, []string, error) {
+	pod := &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        fmt.Sprintf("%s-debug", name),
+			Annotations: map[string]string{},
+		},
+		Spec: template.Spec,
+	}
+	if o.KeepAnnotations {
+		for k, v := range template.Annotations {
+			pod.Annotations[k] = v
+		}
+	}
+
+	index := -1
+	for i, container := range pod.Spec.Containers {
+		if len(o.Attach.ContainerName) == 0 || container.Name == o.Attach.ContainerName {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		if len(o.Attach.ContainerName) == 0 {
+			return nil, nil, fmt.Errorf("%s does not have any containers", o.resourceName)
+		}
+		return nil, nil, fmt.Errorf("container %q not found in %s", o.Attach.ContainerName, o.resourceName)
+	}
+	if o.OneContainer {
+		pod.Spec.Containers = []kapi.Container{pod.Spec.Containers[index]}
+		index = 0
+	}
+
+	for i := range pod.Spec.Containers {
+		if !o.KeepLiveness {
+			pod.Spec.Containers[i].LivenessProbe = nil
+		}
+		if !o.KeepReadiness {
+			pod.Spec.Containers[i].ReadinessProbe = nil
+		}
+	}
+
+	container := &pod.Spec.Containers[index]
+	originalCommand := append(append([]string{}, container.Command...), container.Args...)
+	container.Command = o.debugCommand()
+	container.Args = nil
+	container.Stdin = o.Attach.Stdin
+	container.StdinOnce = o.Attach.Stdin
+	container.TTY = o.Attach.TTY
+	container.Env = updateEnv(container.Env, o.Env, o.RemoveEnv)
+	if len(o.Image) > 0 {
+		container.Image = o.Image
+	}
+	if o.AsUser != -1 {
+		if container.SecurityContext == nil {
+			container.SecurityContext = &kapi.SecurityContext{}
+		}
+		uid := o.AsUser
+		container.SecurityContext.RunAsUser = &uid
+		if uid == 0 {
+			container.SecurityContext.RunAsNonRoot = nil
+		}
+	}
+
+	pod.Spec.NodeName = o.NodeName
+	pod.Spec.RestartPolicy = kapi.RestartPolicyNever
+	pod.Spec.ActiveDeadlineSeconds = nil
+
+	pod.Annotations[debugPodAnnotationSourceContainer] = container.Name
+	pod.Annotations[debugPodAnnotationSourceResource] = o.resourceName
+	return pod, originalCommand, nil
+}
+
+// nodeDebugPod returns a privileged pod that runs the debug command on the node in the host
+// namespaces, with the root filesystem of the node mounted at debugNodeHostPath
+func (o *DebugOptions) nodeDebugPod(node *kapi.Node) *kapi.Pod {
+	image := o.Image
+	if len(image) == 0 {
+		image = defaultDebugNodeImage
+	}
+	privileged := true
+	root := int64(0)
+	return &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: fmt.Sprintf("%s-debug", node.Name),
+			Annotations: map[string]string{
+				debugPodAnnotationSourceContainer: "debug",
+				debugPodAnnotationSourceResource:  o.resourceName,
+			},
+		},
+		Spec: kapi.PodSpec{
+			NodeName:      node.Name,
+			RestartPolicy: kapi.RestartPolicyNever,
+			SecurityContext: &kapi.PodSecurityContext{
+				HostNetwork: true,
+				HostPID:     true,
+				HostIPC:     true,
+			},
+			Volumes: []kapi.Volume{
+				{
+					Name: "host",
+					VolumeSource: kapi.VolumeSource{
+						HostPath: &kapi.HostPathVolumeSource{Path: "/"},
+					},
+				},
+			},
+			Containers: []kapi.Container{
+				{
+					Name:      "debug",
+					Image:     image,
+					Command:   o.debugCommand(),
+					Env:       o.Env,
+					Stdin:     o.Attach.Stdin,
+					StdinOnce: o.Attach.Stdin,
+					TTY:       o.Attach.TTY,
+					SecurityContext: &kapi.SecurityContext{
+						Privileged: &privileged,
+						RunAsUser:  &root,
+					},
+					VolumeMounts: []kapi.VolumeMount{
+						{Name: "host", MountPath: debugNodeHostPath},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (o *DebugOptions) debugCommand() []string {
+	if len(o.Command) > 0 {
+		return o.Command
+	}
+	return []string{"/bin/sh"}
+}
+
+// waitForContainer waits until the named container of the pod is running or has exited
+func (o *DebugOptions) waitForContainer(pod *kapi.Pod, containerName string) (*kapi.ContainerStatus, error) {
+	var status *kapi.ContainerStatus
+	err := wait.PollImmediate(time.Second, o.Timeout, func() (bool, error) {
+		latest, err := o.Attach.Client.Pods(pod.Namespace).Get(pod.Name)
+		if err != nil {
+			return false, err
+		}
+		for i := range latest.Status.ContainerStatuses {
+			s := &latest.Status.ContainerStatuses[i]
+			if s.Name != containerName {
+				continue
+			}
+			if s.State.Running != nil || s.State.Terminated != nil {
+				status = s
+				return true, nil
+			}
+		}
+		if latest.Status.Phase == kapi.PodFailed {
+			return false, fmt.Errorf("the debug pod failed to start: %s", latest.Status.Message)
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("timed out waiting for the debug pod %s to start", pod.Name)
+	}
+	return status, err
+}
+
+func (o *DebugOptions) printLogs(pod *kapi.Pod, containerName string) error {
+	req, err := o.LogsForObject(pod, &kapi.PodLogOptions{Container: containerName})
+	if err != nil {
+		return err
+	}
+	readCloser, err := req.Stream()
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+	_, err = io.Copy(o.Attach.Out, readCloser)
+	return err
+}
+
+// deletePod removes the debug pod immediately
+func (o *DebugOptions) deletePod(pod *kapi.Pod) {
+	fmt.Fprintf(o.Attach.Err, "\nRemoving debug pod ...\n")
+	if err := o.Attach.Client.Pods(pod.Namespace).Delete(pod.Name, kapi.NewDeleteOptions(0)); err != nil && !kerrors.IsNotFound(err) {
+		fmt.Fprintf(o.Attach.Err, "error: unable to delete the debug pod %q: %v\n", pod.Name, err)
+	}
+}
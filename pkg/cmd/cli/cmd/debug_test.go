@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func debugTestConfig() *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: deployapi.DeploymentConfigSpec{
+			Template: &kapi.PodTemplateSpec{
+				ObjectMeta: kapi.ObjectMeta{
+					Labels:      map[string]string{"deploymentconfig": "test"},
+					Annotations: map[string]string{"a": "b"},
+				},
+				Spec: kapi.PodSpec{
+					RestartPolicy: kapi.RestartPolicyAlways,
+					Containers: []kapi.Container{
+						{
+							Name:           "first",
+							Image:          "first-image",
+							Command:        []string{"/bin/run"},
+							Args:           []string{"--serve"},
+							Env:            []kapi.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+							LivenessProbe:  &kapi.Probe{},
+							ReadinessProbe: &kapi.Probe{},
+						},
+						{
+							Name:           "second",
+							Image:          "second-image",
+							ReadinessProbe: &kapi.Probe{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDebugPod(t *testing.T) {
+	o := &DebugOptions{
+		AsUser:       -1,
+		object:       debugTestConfig(),
+		resourceName: "deploymentconfigs/test",
+	}
+	o.Attach.Stdin = true
+	o.Attach.TTY = true
+
+	pod, originalCommand, err := o.debugPod()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Name != "test-debug" {
+		t.Errorf("unexpected pod name: %s", pod.Name)
+	}
+	if len(pod.Labels) != 0 {
+		t.Errorf("expected labels to be removed: %v", pod.Labels)
+	}
+	expectedAnnotations := map[string]string{
+		debugPodAnnotationSourceContainer: "first",
+		debugPodAnnotationSourceResource:  "deploymentconfigs/test",
+	}
+	if !reflect.DeepEqual(pod.Annotations, expectedAnnotations) {
+		t.Errorf("unexpected annotations: %v", pod.Annotations)
+	}
+	if !reflect.DeepEqual(originalCommand, []string{"/bin/run", "--serve"}) {
+		t.Errorf("unexpected original command: %v", originalCommand)
+	}
+	if pod.Spec.RestartPolicy != kapi.RestartPolicyNever {
+		t.Errorf("unexpected restart policy: %s", pod.Spec.RestartPolicy)
+	}
+	if len(pod.Spec.Containers) != 2 {
+		t.Fatalf("expected all containers to be kept: %#v", pod.Spec.Containers)
+	}
+	container := pod.Spec.Containers[0]
+	if !reflect.DeepEqual(container.Command, []string{"/bin/sh"}) || container.Args != nil {
+		t.Errorf("unexpected command: %v %v", container.Command, container.Args)
+	}
+	if !container.Stdin || !container.StdinOnce || !container.TTY {
+		t.Errorf("expected stdin and a TTY: %#v", container)
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.LivenessProbe != nil || c.ReadinessProbe != nil {
+			t.Errorf("expected probes to be removed from %s", c.Name)
+		}
+	}
+
+	// the original object must not be changed
+	template := o.object.(*deployapi.DeploymentConfig).Spec.Template
+	if template.Spec.Containers[0].Command[0] != "/bin/run" || template.Spec.Containers[0].LivenessProbe == nil {
+		t.Errorf("the deployment config was modified: %#v", template)
+	}
+}
+
+func TestDebugPodOptions(t *testing.T) {
+	o := &DebugOptions{
+		AsUser:        0,
+		object:        debugTestConfig(),
+		resourceName:  "deploymentconfigs/test",
+		Command:       []string{"/bin/env"},
+		Env:           []kapi.EnvVar{{Name: "C", Value: "3"}},
+		RemoveEnv:     []string{"A"},
+		Image:         "tools",
+		OneContainer:  true,
+		KeepReadiness: true,
+		NodeName:      "node1",
+	}
+
+	pod, _, err := o.debugPod()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pod.Spec.Containers) != 1 || pod.Spec.Containers[0].Name != "first" {
+		t.Fatalf("expected only the first container: %#v", pod.Spec.Containers)
+	}
+	container := pod.Spec.Containers[0]
+	if !reflect.DeepEqual(container.Command, []string{"/bin/env"}) {
+		t.Errorf("unexpected command: %v", container.Command)
+	}
+	if container.Stdin || container.TTY {
+		t.Errorf("expected no stdin or TTY: %#v", container)
+	}
+	if !reflect.DeepEqual(container.Env, []kapi.EnvVar{{Name: "B", Value: "2"}, {Name: "C", Value: "3"}}) {
+		t.Errorf("unexpected env: %v", container.Env)
+	}
+	if container.Image != "tools" {
+		t.Errorf("unexpected image: %s", container.Image)
+	}
+	if container.ReadinessProbe == nil || container.LivenessProbe != nil {
+		t.Errorf("expected only the readiness probe to be kept: %#v", container)
+	}
+	if container.SecurityContext == nil || container.SecurityContext.RunAsUser == nil || *container.SecurityContext.RunAsUser != 0 {
+		t.Errorf("expected to run as root: %#v", container.SecurityContext)
+	}
+	if pod.Spec.NodeName != "node1" {
+		t.Errorf("unexpected node: %s", pod.Spec.NodeName)
+	}
+
+	o.Attach.ContainerName = "missing"
+	if _, _, err := o.debugPod(); err == nil || !strings.Contains(err.Error(), `container "missing" not found`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDebugPodForNode(t *testing.T) {
+	o := &DebugOptions{
+		AsUser:       -1,
+		object:       &kapi.Node{ObjectMeta: kapi.ObjectMeta{Name: "node1.example.com"}},
+		resourceName: "nodes/node1.example.com",
+	}
+	o.Attach.Stdin = true
+
+	pod, _, err := o.debugPod()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.NodeName != "node1.example.com" {
+		t.Errorf("unexpected node: %s", pod.Spec.NodeName)
+	}
+	if sc := pod.Spec.SecurityContext; sc == nil || !sc.HostNetwork || !sc.HostPID || !sc.HostIPC {
+		t.Errorf("expected the host namespaces: %#v", sc)
+	}
+	container := pod.Spec.Containers[0]
+	if container.Image != defaultDebugNodeImage {
+		t.Errorf("unexpected image: %s", container.Image)
+	}
+	if container.SecurityContext == nil || container.SecurityContext.Privileged == nil || !*container.SecurityContext.Privileged {
+		t.Errorf("expected a privileged container: %#v", container.SecurityContext)
+	}
+	if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].MountPath != debugNodeHostPath {
+		t.Errorf("expected the host filesystem to be mounted: %#v", container.VolumeMounts)
+	}
+	if pod.Spec.Volumes[0].HostPath == nil || pod.Spec.Volumes[0].HostPath.Path != "/" {
+		t.Errorf("unexpected volumes: %#v", pod.Spec.Volumes)
+	}
+}
@@ -16,16 +16,19 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/strategicpatch"
 
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
 
 const (
 	envLong = `
-Update environment variables on a pod template
+Update environment variables on a pod template or a build config
 
-List environment variable definitions in one or more pods or pod templates.
+List environment variable definitions in one or more pods, pod templates, or build configs.
 Add, update, or remove container environment variable definitions in one or
-more pod templates (within replication controllers or deployment configurations).
+more pod templates (within replication controllers or deployment configurations),
+or the environment of the builder container of build configs.
 View or modify the environment variable definitions on all containers in the
 specified pods or pod templates, or just those that match a wildcard.
 
@@ -44,6 +47,9 @@ syntax.`
   # Output modified deployment config in YAML, and does not alter the object on the server
   $ %[1]s env dc/registry STORAGE_DIR=/data -o yaml
 
+  # Set a proxy for the builds of the build config 'ruby-sample-build'
+  $ %[1]s env bc/ruby-sample-build HTTP_PROXY=http://proxy.example.com:3128
+
   # Update all containers in all replication controllers in the project to have ENV=prod
   $ %[1]s env rc --all ENV=prod
 
@@ -64,7 +70,7 @@ func NewCmdEnv(fullName string, f *clientcmd.Factory, in io.Reader, out io.Write
 	var env []string
 	cmd := &cobra.Command{
 		Use:     "env RESOURCE/NAME KEY_1=VAL_1 ... KEY_N=VAL_N",
-		Short:   "Update the environment on a resource with a pod template",
+		Short:   "Update the environment on a resource with a pod template or a build config",
 		Long:    envLong,
 		Example: fmt.Sprintf(envExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -85,6 +91,7 @@ func NewCmdEnv(fullName string, f *clientcmd.Factory, in io.Reader, out io.Write
 	cmd.Flags().String("resource-version", "", "If non-empty, the labels update will only succeed if this is the current resource-version for the object. Only valid when specifying a single resource.")
 	cmd.Flags().StringP("output", "o", "", "Display the changed objects instead of updating them. One of: json|yaml.")
 	cmd.Flags().String("output-version", "", "Output the changed objects with the given version (default api-version).")
+	cmd.Flags().Bool("dry-run", false, "If true, only print the objects that would be updated, without updating them.")
 
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
 
@@ -194,6 +201,7 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Comman
 	//overwrite := cmdutil.GetFlagBool(cmd, "overwrite")
 	resourceVersion := cmdutil.GetFlagString(cmd, "resource-version")
 	outputFormat := cmdutil.GetFlagString(cmd, "output")
+	dryRun := cmdutil.GetFlagBool(cmd, "dry-run")
 
 	if list && len(outputFormat) > 0 {
 		return cmdutil.UsageError(cmd, "--list and --output may not be specified together")
@@ -253,6 +261,19 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Comman
 
 	skipped := 0
 	for _, info := range infos {
+		if bc, isBuildConfig := info.Object.(*buildapi.BuildConfig); isBuildConfig {
+			bcEnv := updateEnv(buildutil.GetStrategyEnv(bc.Spec.Strategy), env, remove)
+			if !buildutil.SetStrategyEnv(&bc.Spec.Strategy, bcEnv) {
+				return fmt.Errorf("%s/%s does not have a build strategy", info.Mapping.Resource, info.Name)
+			}
+			if list {
+				fmt.Fprintf(out, "# %s %s\n", info.Mapping.Resource, info.Name)
+				for _, env := range bcEnv {
+					fmt.Fprintf(out, "%s=%s\n", env.Name, env.Value)
+				}
+			}
+			continue
+		}
 		ok, err := f.UpdatePodSpecForObject(info.Object, func(spec *kapi.PodSpec) error {
 			containers, _ := selectContainers(spec.Containers, containerMatch)
 			if len(containers) == 0 {
@@ -286,7 +307,7 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Comman
 		}
 	}
 	if one && skipped == len(infos) {
-		return fmt.Errorf("%s/%s is not a pod or does not have a pod template", infos[0].Mapping.Resource, infos[0].Name)
+		return fmt.Errorf("%s/%s is not a pod, does not have a pod template, and is not a build config", infos[0].Mapping.Resource, infos[0].Name)
 	}
 
 	if list {
@@ -325,6 +346,14 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Comman
 		return fmt.Errorf("could not convert all objects to API version %q", clientConfig.GroupVersion)
 	}
 
+	shortOutput := outputFormat == "name"
+	if dryRun {
+		for _, info := range infos {
+			cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "updated (dry run)")
+		}
+		return nil
+	}
+
 	failed := false
 	for i, info := range infos {
 		newData, err := json.Marshal(objects[i])
@@ -343,7 +372,6 @@ func RunEnv(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Comman
 		}
 		info.Refresh(obj, true)
 
-		shortOutput := cmdutil.GetFlagString(cmd, "output") == "name"
 		cmdutil.PrintSuccess(mapper, shortOutput, out, info.Mapping.Resource, info.Name, "updated")
 	}
 	if failed {
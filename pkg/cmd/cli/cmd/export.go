@@ -34,7 +34,9 @@ The --exact flag will instruct export to not clear fields that might be useful.
 versions.
 
 Another use case for export is to create reusable templates for applications. Pass --as-template
-to generate the API structure for a template to which you can add parameters and object labels.`
+to generate the API structure for a template to which you can add parameters and object labels.
+The hosts of routes and the secrets of build config webhooks are replaced with parameters, so
+that each application created from the template gets its own webhook secrets.`
 
 	exportExample = `  # export the services and deployment configurations labeled name=test
   %[1]s export svc,dc -l name=test
@@ -140,12 +142,21 @@ func RunExport(f *clientcmd.Factory, exporter Exporter, in io.Reader, out io.Wri
 
 	var result runtime.Object
 	if len(asTemplate) > 0 {
+		var params []templateapi.Parameter
+		if !raw {
+			objects := []runtime.Object{}
+			for _, info := range infos {
+				objects = append(objects, info.Object)
+			}
+			params = exportTemplateParameters(objects)
+		}
 		objects, err := resource.AsVersionedObjects(infos, outputVersion.String())
 		if err != nil {
 			return err
 		}
 		template := &templateapi.Template{
-			Objects: objects,
+			Objects:    objects,
+			Parameters: params,
 		}
 		template.Name = asTemplate
 		result, err = kapi.Scheme.ConvertToVersion(template, outputVersion.String())
@@ -21,6 +21,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/secret"
 	"k8s.io/kubernetes/pkg/registry/serviceaccount"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildrest "github.com/openshift/origin/pkg/build/registry/build"
@@ -28,7 +29,9 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	routeregistry "github.com/openshift/origin/pkg/route/registry/route"
 	osautil "github.com/openshift/origin/pkg/serviceaccounts/util"
+	templateapi "github.com/openshift/origin/pkg/template/api"
 )
 
 var ErrExportOmit = fmt.Errorf("object is omitted")
@@ -172,6 +175,16 @@ func (e *defaultExporter) Export(obj runtime.Object, exact bool) error {
 			t.Status.Config = &kapi.ObjectReference{Name: t.Status.Config.Name}
 		}
 	case *routeapi.Route:
+		t.Status = routeapi.RouteStatus{}
+		if exact {
+			return nil
+		}
+		// a generated host is derived from the name and namespace of the route, and the
+		// destination will generate its own
+		if t.Annotations[routeregistry.HostGeneratedAnnotationKey] == "true" {
+			t.Spec.Host = ""
+			delete(t.Annotations, routeregistry.HostGeneratedAnnotationKey)
+		}
 	case *imageapi.Image:
 	case *imageapi.ImageStream:
 		if exact {
@@ -248,3 +261,69 @@ func (e *defaultExporter) Export(obj runtime.Object, exact bool) error {
 	}
 	return nil
 }
+
+// webhookSecretGenerator is the expression that generates the value of webhook secret parameters
+const webhookSecretGenerator = "[a-zA-Z0-9]{40}"
+
+// exportTemplateParameters replaces the hosts of routes and the secrets of build config webhooks
+// with references to template parameters, and returns those parameters. The parameter of a host
+// defaults to the current host, while a new webhook secret is generated each time the template
+// is processed.
+func exportTemplateParameters(objects []runtime.Object) []templateapi.Parameter {
+	params := []templateapi.Parameter{}
+	names := sets.NewString()
+	addParameter := func(param templateapi.Parameter, nameParts ...string) string {
+		name := templateParameterName(nameParts...)
+		param.Name = name
+		for i := 2; names.Has(param.Name); i++ {
+			param.Name = fmt.Sprintf("%s_%d", name, i)
+		}
+		names.Insert(param.Name)
+		params = append(params, param)
+		return "${" + param.Name + "}"
+	}
+
+	for _, obj := range objects {
+		switch t := obj.(type) {
+		case *routeapi.Route:
+			if len(t.Spec.Host) == 0 {
+				continue
+			}
+			t.Spec.Host = addParameter(templateapi.Parameter{
+				Description: fmt.Sprintf("The external hostname of the route %s", t.Name),
+				Value:       t.Spec.Host,
+			}, t.Name, "hostname")
+		case *buildapi.BuildConfig:
+			for i := range t.Spec.Triggers {
+				trigger := &t.Spec.Triggers[i]
+				switch {
+				case trigger.GitHubWebHook != nil:
+					trigger.GitHubWebHook.Secret = addParameter(templateapi.Parameter{
+						Description: fmt.Sprintf("The secret of the GitHub webhook that starts a build of %s", t.Name),
+						Generate:    "expression",
+						From:        webhookSecretGenerator,
+					}, t.Name, "github", "webhook", "secret")
+				case trigger.GenericWebHook != nil:
+					trigger.GenericWebHook.Secret = addParameter(templateapi.Parameter{
+						Description: fmt.Sprintf("The secret of the generic webhook that starts a build of %s", t.Name),
+						Generate:    "expression",
+						From:        webhookSecretGenerator,
+					}, t.Name, "generic", "webhook", "secret")
+				}
+			}
+		}
+	}
+	return params
+}
+
+// templateParameterName joins the parts into an upper case parameter name made of letters,
+// digits, and underscores
+func templateParameterName(parts ...string) string {
+	name := strings.ToUpper(strings.Join(parts, "_"))
+	return strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -7,9 +7,12 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/runtime"
 
+	buildapi "github.com/openshift/origin/pkg/build/api"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	routeregistry "github.com/openshift/origin/pkg/route/registry/route"
 	osautil "github.com/openshift/origin/pkg/serviceaccounts/util"
 )
 
@@ -145,6 +148,41 @@ func TestExport(t *testing.T) {
 			exact:       true,
 			expectedErr: nil,
 		},
+		{
+			name: "export route with generated host",
+			object: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "web",
+					Namespace:   "test",
+					Annotations: map[string]string{routeregistry.HostGeneratedAnnotationKey: "true"},
+				},
+				Spec: routeapi.RouteSpec{Host: "web-test.router.default.svc.cluster.local"},
+			},
+			expectedObj: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "web",
+					Annotations: map[string]string{},
+				},
+			},
+		},
+		{
+			name: "export route with generated host exactly",
+			object: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "web",
+					Annotations: map[string]string{routeregistry.HostGeneratedAnnotationKey: "true"},
+				},
+				Spec: routeapi.RouteSpec{Host: "web-test.router.default.svc.cluster.local"},
+			},
+			expectedObj: &routeapi.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        "web",
+					Annotations: map[string]string{routeregistry.HostGeneratedAnnotationKey: "true"},
+				},
+				Spec: routeapi.RouteSpec{Host: "web-test.router.default.svc.cluster.local"},
+			},
+			exact: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -157,3 +195,57 @@ func TestExport(t *testing.T) {
 		}
 	}
 }
+
+func TestExportTemplateParameters(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "web"},
+		Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+	}
+	otherRoute := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "WEB"},
+		Spec:       routeapi.RouteSpec{Host: "other.example.com"},
+	}
+	unexposed := &routeapi.Route{ObjectMeta: kapi.ObjectMeta{Name: "internal"}}
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "ruby-sample"},
+		Spec: buildapi.BuildConfigSpec{
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{Type: buildapi.GitHubWebHookBuildTriggerType, GitHubWebHook: &buildapi.WebHookTrigger{Secret: "secret101"}},
+				{Type: buildapi.GenericWebHookBuildTriggerType, GenericWebHook: &buildapi.WebHookTrigger{Secret: "secret202"}},
+				{Type: buildapi.ConfigChangeBuildTriggerType},
+			},
+		},
+	}
+
+	params := exportTemplateParameters([]runtime.Object{route, otherRoute, unexposed, config})
+
+	names := []string{}
+	for _, param := range params {
+		names = append(names, param.Name)
+	}
+	expected := []string{"WEB_HOSTNAME", "WEB_HOSTNAME_2", "RUBY_SAMPLE_GITHUB_WEBHOOK_SECRET", "RUBY_SAMPLE_GENERIC_WEBHOOK_SECRET"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected parameters %v, got %v", expected, names)
+	}
+	if params[0].Value != "www.example.com" || params[1].Value != "other.example.com" {
+		t.Errorf("expected the hostname parameters to default to the current hosts, got %#v", params[:2])
+	}
+	for _, param := range params[2:] {
+		if param.Generate != "expression" || param.From != webhookSecretGenerator || len(param.Value) != 0 {
+			t.Errorf("expected the secret parameter %s to be generated, got %#v", param.Name, param)
+		}
+	}
+
+	if route.Spec.Host != "${WEB_HOSTNAME}" || otherRoute.Spec.Host != "${WEB_HOSTNAME_2}" {
+		t.Errorf("unexpected route hosts %q and %q", route.Spec.Host, otherRoute.Spec.Host)
+	}
+	if len(unexposed.Spec.Host) != 0 {
+		t.Errorf("a route without a host should not be parameterized, got %q", unexposed.Spec.Host)
+	}
+	if secret := config.Spec.Triggers[0].GitHubWebHook.Secret; secret != "${RUBY_SAMPLE_GITHUB_WEBHOOK_SECRET}" {
+		t.Errorf("unexpected GitHub webhook secret %q", secret)
+	}
+	if secret := config.Spec.Triggers[1].GenericWebHook.Secret; secret != "${RUBY_SAMPLE_GENERIC_WEBHOOK_SECRET}" {
+		t.Errorf("unexpected generic webhook secret %q", secret)
+	}
+}
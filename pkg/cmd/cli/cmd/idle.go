@@ -0,0 +1,288 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kcontroller "k8s.io/kubernetes/pkg/controller"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+	unidlingutil "github.com/openshift/origin/pkg/unidling/util"
+)
+
+const (
+	idleLong = `
+Idle scalable resources
+
+Idling discovers the deployment configs and replication controllers behind the given services,
+scales them to zero, and records their previous scale. When an idled service receives
+traffic, the proxy and the router hold the connection while the resources are scaled back up
+to their previous scale.
+
+Services are selected by name, with a label selector, with --all, or by listing their names
+in a file passed with --resource-names-file.`
+
+	idleExample = `  # Idle the scalable resources behind the service 'frontend'
+  $ %[1]s idle frontend
+
+  # Idle all services labeled 'app=web', printing what would be idled
+  $ %[1]s idle -l app=web --dry-run
+
+  # Idle the services listed, one per line, in a file
+  $ %[1]s idle --resource-names-file=services.txt`
+)
+
+// IdleOptions holds the options for idling the scalable resources behind services
+type IdleOptions struct {
+	DryRun            bool
+	Selector          string
+	All               bool
+	ResourceNamesFile string
+	Names             []string
+
+	Namespace string
+	Out       io.Writer
+
+	Builder     *resource.Builder
+	Endpoints   kclient.EndpointsNamespacer
+	Pods        kclient.PodsNamespacer
+	Controllers kclient.ReplicationControllersNamespacer
+	Scalables   *unidlingutil.ScalableClient
+	IdledAtFn   func() time.Time
+}
+
+// NewCmdIdle implements the OpenShift cli idle command
+func NewCmdIdle(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	o := &IdleOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     "idle (SERVICE... | -l label | --all | --resource-names-file FILENAME)",
+		Short:   "Idle scalable resources",
+		Long:    idleLong,
+		Example: fmt.Sprintf(idleExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(o.Complete(f, cmd, args))
+			kcmdutil.CheckErr(o.Run())
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "If true, only print what would be idled without changing anything.")
+	cmd.Flags().StringVarP(&o.Selector, "selector", "l", o.Selector, "Selector (label query) to filter services")
+	cmd.Flags().BoolVar(&o.All, "all", o.All, "Idle all services in the current project")
+	cmd.Flags().StringVar(&o.ResourceNamesFile, "resource-names-file", o.ResourceNamesFile, "File containing the names of the services to idle, one per line")
+	cmd.MarkFlagFilename("resource-names-file")
+	return cmd
+}
+
+// Complete sets the clients and the services to idle from the factory and the arguments
+func (o *IdleOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	o.Names = args
+	if len(o.ResourceNamesFile) > 0 {
+		names, err := readResourceNames(o.ResourceNamesFile)
+		if err != nil {
+			return err
+		}
+		o.Names = append(o.Names, names...)
+	}
+	if len(o.Names) == 0 && len(o.Selector) == 0 && !o.All {
+		return kcmdutil.UsageError(cmd, "You must specify the services to idle, a selector with -l, or --all.")
+	}
+	if len(o.Names) > 0 && (len(o.Selector) > 0 || o.All) {
+		return kcmdutil.UsageError(cmd, "Services may not be named when using a selector or --all.")
+	}
+	if len(o.Selector) > 0 && o.All {
+		return kcmdutil.UsageError(cmd, "--all may not be combined with a selector.")
+	}
+
+	oc, kc, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Endpoints = kc
+	o.Pods = kc
+	o.Controllers = kc
+	o.Scalables = &unidlingutil.ScalableClient{Client: oc, KubeClient: kc}
+	o.IdledAtFn = time.Now
+
+	mapper, typer := f.Object()
+	o.Builder = resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		SelectorParam(o.Selector).
+		SelectAllParam(o.All).
+		Flatten()
+	if len(o.Names) > 0 {
+		o.Builder.ResourceNames("endpoints", o.Names...)
+	} else {
+		o.Builder.ResourceTypes("endpoints")
+	}
+	return nil
+}
+
+// Run idles the scalable resources behind each selected service
+func (o *IdleOptions) Run() error {
+	errs := []error{}
+	err := o.Builder.Do().Visit(func(info *resource.Info, err error) error {
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		endpoints, ok := info.Object.(*kapi.Endpoints)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s/%s is not a set of endpoints", info.Mapping.Resource, info.Name))
+			return nil
+		}
+		if err := o.idleService(endpoints); err != nil {
+			errs = append(errs, err)
+		}
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// idleService marks the endpoints of a service as idled and scales the resources behind it to
+// zero. The endpoints are marked first, so that traffic arriving while the resources are
+// scaled down already wakes them up.
+func (o *IdleOptions) idleService(endpoints *kapi.Endpoints) error {
+	targets, err := o.findScaleTargets(endpoints)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no deployment configs or replication controllers were found for the service %q", endpoints.Name)
+	}
+
+	if o.DryRun {
+		for _, target := range targets {
+			fmt.Fprintf(o.Out, "Would idle %s %q (%d replicas) for the service %q\n", strings.ToLower(target.Kind), target.Name, target.Replicas, endpoints.Name)
+		}
+		return nil
+	}
+
+	idledAt := o.IdledAtFn()
+	if err := unidlingutil.SetIdled(endpoints, idledAt, targets); err != nil {
+		return err
+	}
+	if _, err := o.Endpoints.Endpoints(endpoints.Namespace).Update(endpoints); err != nil {
+		return fmt.Errorf("unable to mark the service %q as idled: %v", endpoints.Name, err)
+	}
+
+	errs := []error{}
+	for _, target := range targets {
+		err := o.Scalables.Update(endpoints.Namespace, target.Kind, target.Name, func(meta *kapi.ObjectMeta, replicas *int) bool {
+			meta.Annotations[unidlingapi.IdledAtAnnotation] = idledAt.UTC().Format(time.RFC3339)
+			meta.Annotations[unidlingapi.PreviousScaleAnnotation] = fmt.Sprintf("%d", target.Replicas)
+			*replicas = 0
+			return true
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to idle %s %q: %v", strings.ToLower(target.Kind), target.Name, err))
+			continue
+		}
+		fmt.Fprintf(o.Out, "Idled %s %q (previously %d replicas) for the service %q\n", strings.ToLower(target.Kind), target.Name, target.Replicas, endpoints.Name)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// findScaleTargets returns the deployment configs and replication controllers that created the
+// pods behind the endpoints, with their current scale
+func (o *IdleOptions) findScaleTargets(endpoints *kapi.Endpoints) ([]unidlingapi.RecordedScaleReference, error) {
+	seen := map[unidlingapi.RecordedScaleReference]bool{}
+	targets := []unidlingapi.RecordedScaleReference{}
+	for _, subset := range endpoints.Subsets {
+		addresses := append(append([]kapi.EndpointAddress{}, subset.Addresses...), subset.NotReadyAddresses...)
+		for _, address := range addresses {
+			if address.TargetRef == nil || address.TargetRef.Kind != "Pod" {
+				continue
+			}
+			pod, err := o.Pods.Pods(endpoints.Namespace).Get(address.TargetRef.Name)
+			if err != nil {
+				return nil, fmt.Errorf("unable to find the pod %q of the service %q: %v", address.TargetRef.Name, endpoints.Name, err)
+			}
+			ref, err := o.scaleTargetForPod(pod)
+			if err != nil {
+				return nil, err
+			}
+			if ref == nil || seen[*ref] {
+				continue
+			}
+			seen[*ref] = true
+
+			_, replicas, err := o.Scalables.Get(endpoints.Namespace, ref.Kind, ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			ref.Replicas = replicas
+			targets = append(targets, *ref)
+		}
+	}
+	return targets, nil
+}
+
+// scaleTargetForPod returns the replication controller that created the pod, or its deployment
+// config if the controller is a deployment. Pods that were not created by a replication
+// controller are ignored.
+func (o *IdleOptions) scaleTargetForPod(pod *kapi.Pod) (*unidlingapi.RecordedScaleReference, error) {
+	value, ok := pod.Annotations[kcontroller.CreatedByAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	createdBy := &kapi.SerializedReference{}
+	if err := json.Unmarshal([]byte(value), createdBy); err != nil {
+		return nil, fmt.Errorf("unable to read the creator of the pod %q: %v", pod.Name, err)
+	}
+	if createdBy.Reference.Kind != "ReplicationController" {
+		return nil, nil
+	}
+
+	rc, err := o.Controllers.ReplicationControllers(pod.Namespace).Get(createdBy.Reference.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the replication controller %q of the pod %q: %v", createdBy.Reference.Name, pod.Name, err)
+	}
+	if name, ok := rc.Annotations[deployapi.DeploymentConfigAnnotation]; ok {
+		return &unidlingapi.RecordedScaleReference{Kind: "DeploymentConfig", Name: name}, nil
+	}
+	return &unidlingapi.RecordedScaleReference{Kind: "ReplicationController", Name: rc.Name}, nil
+}
+
+// readResourceNames reads the names in the file, one per line, ignoring empty lines
+func readResourceNames(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	names := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name := strings.TrimSpace(scanner.Text())
+		if len(name) == 0 {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, scanner.Err()
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	kcontroller "k8s.io/kubernetes/pkg/controller"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+	unidlingutil "github.com/openshift/origin/pkg/unidling/util"
+)
+
+func createdByPod(name, rc string) *kapi.Pod {
+	pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name}}
+	if len(rc) > 0 {
+		pod.Annotations = map[string]string{
+			kcontroller.CreatedByAnnotation: `{"kind":"SerializedReference","apiVersion":"v1","reference":{"kind":"ReplicationController","namespace":"test","name":"` + rc + `"}}`,
+		}
+	}
+	return pod
+}
+
+func podAddress(name string) kapi.EndpointAddress {
+	return kapi.EndpointAddress{IP: "10.0.0.1", TargetRef: &kapi.ObjectReference{Kind: "Pod", Namespace: "test", Name: name}}
+}
+
+// getByName returns the named object on get, since the fake clients otherwise return the
+// objects of a kind in order
+func getByName(objects ...runtime.Object) ktestclient.ReactionFunc {
+	return func(action ktestclient.Action) (bool, runtime.Object, error) {
+		name := action.(ktestclient.GetAction).GetName()
+		for _, obj := range objects {
+			if meta, err := kapi.ObjectMetaFor(obj); err == nil && meta.Name == name {
+				return true, obj, nil
+			}
+		}
+		return true, nil, kerrors.NewNotFound(action.GetResource(), name)
+	}
+}
+
+func TestIdleService(t *testing.T) {
+	idledAt := time.Date(2016, 5, 1, 12, 0, 0, 0, time.UTC)
+	endpoints := &kapi.Endpoints{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"},
+		Subsets: []kapi.EndpointSubset{{
+			Addresses:         []kapi.EndpointAddress{podAddress("frontend-1-a"), podAddress("frontend-1-b"), podAddress("bare")},
+			NotReadyAddresses: []kapi.EndpointAddress{podAddress("standalone-c")},
+		}},
+	}
+	pods := []runtime.Object{
+		createdByPod("frontend-1-a", "frontend-1"),
+		createdByPod("frontend-1-b", "frontend-1"),
+		createdByPod("bare", ""),
+		createdByPod("standalone-c", "standalone"),
+	}
+	rcs := []runtime.Object{
+		&kapi.ReplicationController{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend-1", Annotations: map[string]string{deployapi.DeploymentConfigAnnotation: "frontend"}},
+			Spec:       kapi.ReplicationControllerSpec{Replicas: 3},
+		},
+		&kapi.ReplicationController{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "standalone"},
+			Spec:       kapi.ReplicationControllerSpec{Replicas: 2},
+		},
+	}
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"},
+		Spec:       deployapi.DeploymentConfigSpec{Replicas: 3},
+	}
+	kc := ktestclient.NewSimpleFake(append(append([]runtime.Object{endpoints}, pods...), rcs...)...)
+	kc.PrependReactor("get", "pods", getByName(pods...))
+	kc.PrependReactor("get", "replicationcontrollers", getByName(rcs...))
+	oc := testclient.NewSimpleFake(config)
+	oc.PrependReactor("get", "deploymentconfigs", getByName(config))
+
+	out := &bytes.Buffer{}
+	o := &IdleOptions{
+		Out:         out,
+		Endpoints:   kc,
+		Pods:        kc,
+		Controllers: kc,
+		Scalables:   &unidlingutil.ScalableClient{Client: oc, KubeClient: kc},
+		IdledAtFn:   func() time.Time { return idledAt },
+	}
+
+	targets, err := o.findScaleTargets(endpoints)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []unidlingapi.RecordedScaleReference{
+		{Kind: "DeploymentConfig", Name: "frontend", Replicas: 3},
+		{Kind: "ReplicationController", Name: "standalone", Replicas: 2},
+	}
+	if !reflect.DeepEqual(targets, expected) {
+		t.Fatalf("expected targets %#v, got %#v", expected, targets)
+	}
+
+	o.DryRun = true
+	kc.ClearActions()
+	oc.ClearActions()
+	if err := o.idleService(endpoints); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range append(kc.Actions(), oc.Actions()...) {
+		if _, ok := action.(ktestclient.UpdateAction); ok {
+			t.Errorf("unexpected update during a dry run: %#v", action)
+		}
+	}
+
+	o.DryRun = false
+	kc.ClearActions()
+	oc.ClearActions()
+	if err := o.idleService(endpoints); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated := []interface{}{}
+	for _, action := range append(kc.Actions(), oc.Actions()...) {
+		if update, ok := action.(ktestclient.UpdateAction); ok {
+			updated = append(updated, update.GetObject())
+		}
+	}
+	if len(updated) != 3 {
+		t.Fatalf("expected the endpoints and both targets to be updated, got %#v", updated)
+	}
+
+	idledEndpoints := updated[0].(*kapi.Endpoints)
+	if at, ok := unidlingutil.IdledAt(&idledEndpoints.ObjectMeta); !ok || !at.Equal(idledAt) {
+		t.Errorf("expected the endpoints to be idled at %v, got %v", idledAt, at)
+	}
+	if recorded, err := unidlingutil.UnidleTargets(idledEndpoints); err != nil || !reflect.DeepEqual(recorded, expected) {
+		t.Errorf("expected the endpoints to record %#v, got %#v: %v", expected, recorded, err)
+	}
+
+	rc := updated[1].(*kapi.ReplicationController)
+	if rc.Spec.Replicas != 0 || rc.Annotations[unidlingapi.PreviousScaleAnnotation] != "2" {
+		t.Errorf("expected the replication controller to be idled, got %#v", rc)
+	}
+	config = updated[2].(*deployapi.DeploymentConfig)
+	if config.Spec.Replicas != 0 || config.Annotations[unidlingapi.PreviousScaleAnnotation] != "3" {
+		t.Errorf("expected the deployment config to be idled, got %#v", config)
+	}
+}
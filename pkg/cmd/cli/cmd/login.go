@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/openshift/origin/pkg/cmd/cli/config"
 	"github.com/openshift/origin/pkg/cmd/flagtypes"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	osclientcmd "github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
@@ -30,7 +32,15 @@ default configuration will be saved to your home directory under
 
 The information required to login -- like username and password, a session token, or
 the server details -- can be provided through flags. If not provided, the command will
-prompt for user input as needed.`
+prompt for user input as needed.
+
+A token obtained from the web console, or a client certificate and key issued for your user,
+may be used instead of a username and password. The server certificate is verified with the
+certificate authority given by --certificate-authority, and the login fails if the server is
+not trusted by it.
+
+The cluster and the context saved to the configuration file are named after the server, the
+project and the user. Use --cluster-name and --context-name to choose other names.`
 
 	loginExample = `  # Log in interactively
   $ %[1]s login
@@ -39,7 +49,13 @@ prompt for user input as needed.`
   $ %[1]s login localhost:8443 --certificate-authority=/path/to/cert.crt
 
   # Log in to the given server with the given credentials (will not prompt interactively)
-  $ %[1]s login localhost:8443 --username=myuser --password=mypass`
+  $ %[1]s login localhost:8443 --username=myuser --password=mypass
+
+  # Log in to the given server with a token, naming the context 'dev'
+  $ %[1]s login localhost:8443 --token=mytoken --context-name=dev
+
+  # Log in to the given server with a client certificate and key
+  $ %[1]s login localhost:8443 --client-certificate=/path/to/user.crt --client-key=/path/to/user.key`
 )
 
 // NewCmdLogin implements the OpenShift cli login command
@@ -87,6 +103,8 @@ func NewCmdLogin(fullName string, f *osclientcmd.Factory, reader io.Reader, out
 	// Login is the only command that can negotiate a session token against the auth server using basic auth
 	cmds.Flags().StringVarP(&options.Username, "username", "u", "", "Username, will prompt if not provided")
 	cmds.Flags().StringVarP(&options.Password, "password", "p", "", "Password, will prompt if not provided")
+	cmds.Flags().StringVar(&options.ClusterNickname, "cluster-name", "", "Name of the cluster saved to the config file, defaults to a name derived from the server URL")
+	cmds.Flags().StringVar(&options.ContextNickname, "context-name", "", "Name of the context saved to the config file, defaults to a name derived from the project, server and user")
 
 	return cmds
 }
@@ -171,6 +189,27 @@ func (o LoginOptions) Validate(args []string, serverFlag string) error {
 		return errors.New("--token and --username are mutually exclusive")
 	}
 
+	if (len(o.CertFile) > 0) != (len(o.KeyFile) > 0) {
+		return errors.New("--client-certificate and --client-key must be specified together")
+	}
+
+	if len(o.CertFile) > 0 && len(o.Token) > 0 {
+		return errors.New("--token and --client-certificate are mutually exclusive")
+	}
+
+	if len(o.CAFile) > 0 {
+		if o.InsecureTLS {
+			return errors.New("--certificate-authority and --insecure-skip-tls-verify are mutually exclusive")
+		}
+		data, err := ioutil.ReadFile(o.CAFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the certificate authority file: %v", err)
+		}
+		if _, err := crypto.CertsFromPEM(data); err != nil {
+			return fmt.Errorf("the certificate authority file %s is not valid: %v", o.CAFile, err)
+		}
+	}
+
 	if o.StartingKubeConfig == nil {
 		return errors.New("Must have a config file already created")
 	}
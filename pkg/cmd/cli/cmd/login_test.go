@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	kclientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+func TestLoginValidate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "login-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	validCA := dir + "/ca.crt"
+	if _, err := crypto.MakeCA(validCA, dir+"/ca.key", dir+"/ca.serial.txt", "test-ca"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	invalidCA := dir + "/invalid.crt"
+	if err := ioutil.WriteFile(invalidCA, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := map[string]struct {
+		options       LoginOptions
+		expectedError string
+	}{
+		"token": {
+			options: LoginOptions{Token: "token"},
+		},
+		"token and username": {
+			options:       LoginOptions{Token: "token", Username: "user"},
+			expectedError: "--token and --username are mutually exclusive",
+		},
+		"client certificate and key": {
+			options: LoginOptions{CertFile: "user.crt", KeyFile: "user.key"},
+		},
+		"client certificate without key": {
+			options:       LoginOptions{CertFile: "user.crt"},
+			expectedError: "must be specified together",
+		},
+		"client key without certificate": {
+			options:       LoginOptions{KeyFile: "user.key"},
+			expectedError: "must be specified together",
+		},
+		"client certificate and token": {
+			options:       LoginOptions{CertFile: "user.crt", KeyFile: "user.key", Token: "token"},
+			expectedError: "--token and --client-certificate are mutually exclusive",
+		},
+		"valid certificate authority": {
+			options: LoginOptions{CAFile: validCA},
+		},
+		"invalid certificate authority": {
+			options:       LoginOptions{CAFile: invalidCA},
+			expectedError: "is not valid",
+		},
+		"missing certificate authority": {
+			options:       LoginOptions{CAFile: dir + "/missing.crt"},
+			expectedError: "unable to read the certificate authority file",
+		},
+		"certificate authority and insecure": {
+			options:       LoginOptions{CAFile: validCA, InsecureTLS: true},
+			expectedError: "mutually exclusive",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.options.Server = "https://localhost:8443"
+		tc.options.StartingKubeConfig = kclientcmdapi.NewConfig()
+
+		err := tc.options.Validate(nil, "")
+		switch {
+		case len(tc.expectedError) == 0 && err != nil:
+			t.Errorf("%s: unexpected error: %v", name, err)
+		case len(tc.expectedError) > 0 && (err == nil || !strings.Contains(err.Error(), tc.expectedError)):
+			t.Errorf("%s: expected error containing %q, got %v", name, tc.expectedError, err)
+		}
+	}
+}
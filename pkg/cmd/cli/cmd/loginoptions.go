@@ -58,6 +58,10 @@ type LoginOptions struct {
 
 	Token string
 
+	// nicknames of the saved cluster and context, generated when empty
+	ClusterNickname string
+	ContextNickname string
+
 	PathOptions *kcmdconfig.PathOptions
 }
 
@@ -136,6 +140,10 @@ func (o *LoginOptions) getClientConfig() (*kclient.Config, error) {
 			clientConfig.CAFile = ""
 			clientConfig.CAData = nil
 
+		// the server must be trusted by a certificate authority that was given explicitly
+		case len(o.CAFile) > 0 && clientcmd.IsCertificateAuthorityUnknown(result.Error()):
+			return nil, fmt.Errorf("the server certificate is not signed by the certificate authority in %s", o.CAFile)
+
 		// certificate issue, prompt user for insecure connection
 		case clientcmd.IsCertificateAuthorityUnknown(result.Error()):
 			// check to see if we already have a cluster stanza that tells us to use --insecure for this particular server.  If we don't, then prompt
@@ -221,6 +229,32 @@ func (o *LoginOptions) gatherAuthInfo() error {
 		}
 	}
 
+	// if a client certificate were explicitly provided, try to authenticate with it
+	if o.certProvided() {
+		clientConfig.BearerToken = ""
+		clientConfig.CertFile = o.CertFile
+		clientConfig.KeyFile = o.KeyFile
+		osClient, err := client.New(clientConfig)
+		if err != nil {
+			return err
+		}
+		me, err := whoAmI(osClient)
+		if err == nil {
+			o.Username = me.Name
+			o.Config = clientConfig
+
+			fmt.Fprintf(o.Out, "Logged into %q as %q using the client certificate provided.\n\n", o.Config.Host, o.Username)
+			return nil
+		}
+
+		// a certificate the server does not trust authenticates nobody, which is forbidden
+		if !kerrors.IsUnauthorized(err) && !kerrors.IsForbidden(err) {
+			return err
+		}
+
+		fmt.Fprint(o.Out, "The client certificate provided was not accepted by the server.\n\n")
+	}
+
 	// if a username was provided try to make use of it, but if a password were provided we force a token
 	// request which will return a proper response code for that given password
 	if o.usernameProvided() && !o.passwordProvided() {
@@ -371,7 +405,7 @@ func (o *LoginOptions) SaveConfig() (bool, error) {
 		globalExistedBefore = false
 	}
 
-	newConfig, err := config.CreateConfig(o.Project, o.Config)
+	newConfig, err := config.CreateNamedConfig(o.Project, o.ClusterNickname, o.ContextNickname, o.Config)
 	if err != nil {
 		return false, err
 	}
@@ -392,6 +426,11 @@ func (o *LoginOptions) SaveConfig() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	// an explicitly named context is saved under that name even if an identical one exists
+	if len(o.ContextNickname) > 0 {
+		configToWrite.Contexts[o.ContextNickname] = newConfig.Contexts[o.ContextNickname]
+		configToWrite.CurrentContext = o.ContextNickname
+	}
 
 	if err := kubecmdconfig.ModifyConfig(o.PathOptions, *configToWrite, true); err != nil {
 		return false, err
@@ -438,3 +477,7 @@ func (o *LoginOptions) serverProvided() bool {
 func (o *LoginOptions) tokenProvided() bool {
 	return len(o.Token) > 0
 }
+
+func (o *LoginOptions) certProvided() bool {
+	return len(o.CertFile) > 0 && len(o.KeyFile) > 0
+}
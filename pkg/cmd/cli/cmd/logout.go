@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kclientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
 	kcmdconfig "k8s.io/kubernetes/pkg/kubectl/cmd/config"
@@ -122,7 +123,7 @@ func (o LogoutOptions) RunLogout() error {
 		return err
 	}
 
-	if err := client.OAuthAccessTokens().Delete(token); err != nil {
+	if err := deleteAccessToken(client, token); err != nil {
 		return err
 	}
 
@@ -144,3 +145,14 @@ func (o LogoutOptions) RunLogout() error {
 
 	return nil
 }
+
+// deleteAccessToken revokes the current user's token on the server. Servers that predate the
+// userOAuthAccessTokens resource, or whose policy has not been updated to allow users to manage
+// their own tokens, only allow the token to be deleted through oAuthAccessTokens.
+func deleteAccessToken(c client.Interface, token string) error {
+	err := c.UserOAuthAccessTokens().Delete(token)
+	if kerrors.IsNotFound(err) || kerrors.IsForbidden(err) {
+		return c.OAuthAccessTokens().Delete(token)
+	}
+	return err
+}
@@ -1,18 +1,24 @@
 package cmd
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/meta"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -32,6 +38,10 @@ When a pod is specified and has more than one container, the container name shou
 specified via -c. When a build config or deployment config is specified, you can view
 the logs for a particular version of it via --version.
 
+Instead of a resource, you can select pods with a label selector via -l. The logs of all
+matching pods are printed together, each line prefixed with the name of its pod. The
+first container of each pod is used unless a container is specified via -c.
+
 If your pod is failing to start, you may need to use the --previous option to see the
 logs of the last attempt.`
 
@@ -50,7 +60,10 @@ logs of the last attempt.`
   $ %[1]s backend -c ruby-container
 
   # Start streaming of ruby-container logs from pod backend.
-  $ %[1]s -f pod/backend -c ruby-container`
+  $ %[1]s -f pod/backend -c ruby-container
+
+  # Start streaming the logs of all pods labeled app=frontend.
+  $ %[1]s -f -l app=frontend`
 )
 
 // OpenShiftLogsOptions holds all the necessary options for running oc logs.
@@ -60,6 +73,11 @@ type OpenShiftLogsOptions struct {
 	// KubeLogOptions contains all the necessary options for
 	// running the upstream logs command.
 	KubeLogOptions *kcmd.LogsOptions
+	// Selector selects the pods whose logs are printed instead of a
+	// single resource.
+	Selector string
+	// ListPods lists the pods matching a selector in a namespace.
+	ListPods func(namespace string, selector labels.Selector) (*kapi.PodList, error)
 }
 
 // NewCmdLogs creates a new logs command that supports OpenShift resources.
@@ -68,6 +86,7 @@ func NewCmdLogs(name, parent string, f *clientcmd.Factory, out io.Writer) *cobra
 		KubeLogOptions: &kcmd.LogsOptions{},
 	}
 	cmd := kcmd.NewCmdLogs(f.Factory, out)
+	cmd.Use = "logs [-f] [-p] (POD | TYPE/NAME | -l SELECTOR) [-c CONTAINER]"
 	cmd.Short = "Print the logs for a resource."
 	cmd.Long = logsLong
 	cmd.Example = fmt.Sprintf(logsExample, parent+" "+name)
@@ -80,6 +99,7 @@ func NewCmdLogs(name, parent string, f *clientcmd.Factory, out io.Writer) *cobra
 		cmdutil.CheckErr(o.RunLog())
 	}
 	cmd.Flags().Int64("version", 0, "View the logs of a particular build or deployment by version if greater than zero")
+	cmd.Flags().StringVarP(&o.Selector, "selector", "l", "", "Selector (label query) to filter pods on, instead of specifying a resource")
 
 	return cmd
 }
@@ -88,6 +108,17 @@ func NewCmdLogs(name, parent string, f *clientcmd.Factory, out io.Writer) *cobra
 // resource a user requested to view its logs and creates the appropriate logOptions
 // object for it.
 func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []string) error {
+	if len(o.Selector) > 0 {
+		if len(args) > 0 {
+			return cmdutil.UsageError(cmd, "only one of a resource or --selector may be specified")
+		}
+		if cmdutil.GetFlagInt64(cmd, "version") != 0 {
+			return cmdutil.UsageError(cmd, "--version may not be used with --selector")
+		}
+		// The upstream command requires a resource argument. The pods are
+		// listed by selector instead, so the argument is never used.
+		args = []string{"pods"}
+	}
 	if err := o.KubeLogOptions.Complete(f.Factory, out, cmd, args); err != nil {
 		return err
 	}
@@ -96,6 +127,17 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd
 		return err
 	}
 
+	if len(o.Selector) > 0 {
+		_, kc, err := f.Clients()
+		if err != nil {
+			return err
+		}
+		o.ListPods = func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+			return kc.Pods(namespace).List(kapi.ListOptions{LabelSelector: selector})
+		}
+		return nil
+	}
+
 	podLogOptions := o.KubeLogOptions.Options.(*kapi.PodLogOptions)
 
 	mapper, typer := f.Object()
@@ -178,6 +220,9 @@ func (o OpenShiftLogsOptions) Validate() error {
 // RunLog will run the upstream logs command and may use an OpenShift
 // logOptions object.
 func (o OpenShiftLogsOptions) RunLog() error {
+	if len(o.Selector) > 0 {
+		return o.runSelectorLogs()
+	}
 	if o.Options != nil {
 		// Use our own options object.
 		o.KubeLogOptions.Options = o.Options
@@ -185,3 +230,93 @@ func (o OpenShiftLogsOptions) RunLog() error {
 	_, err := o.KubeLogOptions.RunLogs()
 	return err
 }
+
+// runSelectorLogs prints the logs of all pods matching the selector
+// concurrently, prefixing each line with the name of its pod.
+func (o OpenShiftLogsOptions) runSelectorLogs() error {
+	selector, err := labels.Parse(o.Selector)
+	if err != nil {
+		return err
+	}
+	pods, err := o.ListPods(o.KubeLogOptions.Namespace, selector)
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pods found for selector %q", o.Selector)
+	}
+
+	podLogOptions := o.KubeLogOptions.Options.(*kapi.PodLogOptions)
+	out := &lockedWriter{w: o.KubeLogOptions.Out}
+	errs := make(chan error, len(pods.Items))
+	wg := sync.WaitGroup{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		opts := *podLogOptions
+		if len(opts.Container) == 0 && len(pod.Spec.Containers) > 0 {
+			opts.Container = pod.Spec.Containers[0].Name
+		}
+		req, err := o.KubeLogOptions.LogsForObject(pod, &opts)
+		if err != nil {
+			errs <- fmt.Errorf("%s: %v", pod.Name, err)
+			continue
+		}
+		wg.Add(1)
+		go func(name string, req *kclient.Request) {
+			defer wg.Done()
+			if err := streamWithPrefix(out, req, fmt.Sprintf("[%s] ", name)); err != nil {
+				errs <- fmt.Errorf("%s: %v", name, err)
+			}
+		}(pod.Name, req)
+	}
+	wg.Wait()
+	close(errs)
+
+	aggregate := []error{}
+	for err := range errs {
+		aggregate = append(aggregate, err)
+	}
+	return utilerrors.NewAggregate(aggregate)
+}
+
+// streamWithPrefix copies the stream of req to out line by line, prefixing
+// every line.
+func streamWithPrefix(out io.Writer, req *kclient.Request, prefix string) error {
+	readCloser, err := req.Stream()
+	if err != nil {
+		return err
+	}
+	defer readCloser.Close()
+
+	r := bufio.NewReader(readCloser)
+	for {
+		line, err := r.ReadString('\n')
+		if len(line) > 0 {
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+			if _, err := io.WriteString(out, prefix+line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lockedWriter serializes the writes of concurrent log streams so that their
+// lines do not interleave.
+type lockedWriter struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.w.Write(p)
+}
@@ -1,13 +1,23 @@
 package cmd
 
 import (
+	"bytes"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/spf13/pflag"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
@@ -30,3 +40,68 @@ func TestFlagParity(t *testing.T) {
 		}
 	})
 }
+
+type fakeLogsClient map[string]string
+
+// Do returns the logs of the pod named by the request path.
+func (c fakeLogsClient) Do(req *http.Request) (*http.Response, error) {
+	logs := c[strings.TrimPrefix(req.URL.Path, "/")]
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(logs))}, nil
+}
+
+func TestRunSelectorLogs(t *testing.T) {
+	pod := func(name string, containers ...string) kapi.Pod {
+		p := kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "test", Labels: map[string]string{"app": "frontend"}}}
+		for _, container := range containers {
+			p.Spec.Containers = append(p.Spec.Containers, kapi.Container{Name: container})
+		}
+		return p
+	}
+	client := fakeLogsClient{
+		"frontend-1": "starting\nlistening\n",
+		"frontend-2": "starting\nno trailing newline",
+	}
+
+	out := &bytes.Buffer{}
+	var selected labels.Selector
+	containers := map[string]string{}
+	o := OpenShiftLogsOptions{
+		Selector: "app=frontend",
+		KubeLogOptions: &kcmd.LogsOptions{
+			Namespace: "test",
+			Options:   &kapi.PodLogOptions{},
+			Out:       out,
+			LogsForObject: func(object, options runtime.Object) (*kclient.Request, error) {
+				pod := object.(*kapi.Pod)
+				containers[pod.Name] = options.(*kapi.PodLogOptions).Container
+				return kclient.NewRequest(client, "GET", &url.URL{Path: "/" + pod.Name}, unversioned.GroupVersion{}, kapi.Codec, nil), nil
+			},
+		},
+		ListPods: func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+			selected = selector
+			return &kapi.PodList{Items: []kapi.Pod{pod("frontend-1", "app", "sidecar"), pod("frontend-2", "app")}}, nil
+		},
+	}
+
+	if err := o.RunLog(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if selected == nil || selected.String() != "app=frontend" {
+		t.Errorf("expected pods to be listed with selector app=frontend, got %v", selected)
+	}
+	if e, a := map[string]string{"frontend-1": "app", "frontend-2": "app"}, containers; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected logs of containers %v, got %v", e, a)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	sort.Strings(lines)
+	expected := []string{
+		"[frontend-1] listening",
+		"[frontend-1] starting",
+		"[frontend-2] no trailing newline",
+		"[frontend-2] starting",
+	}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("expected prefixed lines %v, got %v", expected, lines)
+	}
+}
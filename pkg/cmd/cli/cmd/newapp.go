@@ -591,6 +591,8 @@ See '%[2]s -h' for examples.
 		return fmt.Errorf(err.(newapp.ErrMultipleMatches).UsageError(""))
 	case newapp.ErrPartialMatch:
 		return fmt.Errorf(err.(newapp.ErrPartialMatch).UsageError(""))
+	case newapp.ErrContextDirRequired:
+		return fmt.Errorf(t.UsageError(c.CommandPath()))
 	}
 	switch err {
 	case errNoTokenAvailable:
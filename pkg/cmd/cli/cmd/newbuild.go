@@ -47,6 +47,12 @@ You can use '%[1]s status' to check the progress.`
   # Create a build config using a Dockerfile specified as an argument
   $ %[1]s new-build -D $'FROM centos:7\nRUN yum install -y httpd'
 
+  # Create a build config that expects binary input and builds it with a Dockerfile read from STDIN
+  $ cat Dockerfile | %[1]s new-build --name=myapp --binary -D -
+
+  # Create a build config that expects binary input to be built on top of an image
+  $ %[1]s new-build openshift/ruby-22-centos7 --name=myapp --binary
+
   # Create a build config from a remote repository and add custom environment variables
   $ %[1]s new-build https://github.com/openshift/ruby-hello-world RACK_ENV=development
 
@@ -190,7 +196,11 @@ func RunNewBuild(fullName string, f *clientcmd.Factory, out io.Writer, in io.Rea
 	for _, item := range result.List.Items {
 		switch t := item.(type) {
 		case *buildapi.BuildConfig:
-			if len(t.Spec.Triggers) > 0 && t.Spec.Source.Binary == nil {
+			switch {
+			case t.Spec.Source.Binary != nil:
+				fmt.Fprintf(out, "%sBuild configuration %q created and expects binary input.\n", indent, t.Name)
+				fmt.Fprintf(out, "%sRun '%s start-build %s --from-dir=<dir>' to upload the contents of a directory and start a build.\n", indent, fullName, t.Name)
+			case len(t.Spec.Triggers) > 0:
 				fmt.Fprintf(out, "%sBuild configuration %q created and build triggered.\n", indent, t.Name)
 				fmt.Fprintf(out, "%sRun '%s logs -f bc/%s' to stream the build progress.\n", indent, fullName, t.Name)
 			}
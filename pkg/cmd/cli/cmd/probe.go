@@ -0,0 +1,398 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	probeLong = `
+Set or remove a liveness or readiness probe from a pod or pod template
+
+Each container in a pod may define one or both probes. The readiness probe is used to
+determine whether the container is ready to accept traffic - services only send traffic
+to ready pods. The liveness probe is used to determine whether the container is still
+running - containers that fail the liveness probe are restarted.
+
+A probe runs a command inside the container (passed after --), opens a TCP connection
+to a port (--open-tcp), or makes an HTTP GET request (--get-url). The settings of an
+existing probe, like --initial-delay-seconds, can be changed without changing how the
+probe checks the container. Use --remove to remove a probe.
+
+If you alter a probe on a deployment config, a deployment will be triggered. Build
+configs do not have probes.`
+
+	probeExample = `  # Clear both readiness and liveness probes off all containers
+  $ %[1]s probe dc/registry --remove --readiness --liveness
+
+  # Set an exec action as a liveness probe to run 'echo ok'
+  $ %[1]s probe dc/registry --liveness -- echo ok
+
+  # Set a readiness probe to try to open a TCP socket on 3306
+  $ %[1]s probe rc/mysql --readiness --open-tcp=3306
+
+  # Set an HTTP readiness probe for port 8080 and path /healthz over HTTP on the pod IP
+  $ %[1]s probe dc/webapp --readiness --get-url=http://:8080/healthz
+
+  # Set an HTTP readiness probe over HTTPS on 127.0.0.1 for a hostNetwork pod
+  $ %[1]s probe dc/router --readiness --get-url=https://127.0.0.1:1936/stats
+
+  # Set only the initial-delay-seconds field on all deployments
+  $ %[1]s probe dc --all --readiness --initial-delay-seconds=30`
+)
+
+// ProbeOptions holds the options for changing the probes of the containers of a pod template
+type ProbeOptions struct {
+	DefaultNamespace       string
+	ExplicitNamespace      bool
+	Out                    io.Writer
+	Err                    io.Writer
+	Mapper                 meta.RESTMapper
+	Typer                  runtime.ObjectTyper
+	RESTClientFactory      func(mapping *meta.RESTMapping) (resource.RESTClient, error)
+	UpdatePodSpecForObject func(obj runtime.Object, fn func(*kapi.PodSpec) error) (bool, error)
+
+	// Resource selection
+	Selector  string
+	All       bool
+	Filenames []string
+	Resources []string
+
+	Containers    string
+	Output        string
+	OutputVersion unversioned.GroupVersion
+	DryRun        bool
+
+	Readiness bool
+	Liveness  bool
+	Remove    bool
+
+	// Handler options, at most one may be set
+	Command       []string
+	OpenTCPSocket string
+	HTTPGet       string
+
+	// Probe settings, only changed when set
+	InitialDelaySeconds *int
+	TimeoutSeconds      *int
+	PeriodSeconds       *int
+	SuccessThreshold    *int
+	FailureThreshold    *int
+
+	httpGetAction *kapi.HTTPGetAction
+}
+
+// NewCmdProbe implements the set probe command
+func NewCmdProbe(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *cobra.Command {
+	options := &ProbeOptions{
+		Out:        out,
+		Err:        errOut,
+		Containers: "*",
+	}
+	cmd := &cobra.Command{
+		Use:     "probe RESOURCE/NAME --readiness|--liveness [options] (--get-url=URL|--open-tcp=PORT|-- CMD)",
+		Short:   "Update a probe on a pod template",
+		Long:    probeLong,
+		Example: fmt.Sprintf(probeExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			if err := options.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			err := options.Run()
+			if err == errExit {
+				os.Exit(1)
+			}
+			kcmdutil.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Containers, "containers", "c", options.Containers, "The names of containers in the selected pod templates to change - may use wildcards")
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on")
+	cmd.Flags().BoolVar(&options.All, "all", options.All, "Select all resources in the namespace of the specified resource types")
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to use to edit the resource.")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "Display the changed objects instead of updating them. One of: json|yaml")
+	cmd.Flags().String("output-version", "", "Output the changed objects with the given version (default api-version).")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "If true, only print the objects that would be updated, without updating them.")
+
+	cmd.Flags().BoolVar(&options.Remove, "remove", options.Remove, "If true, remove the specified probe(s).")
+	cmd.Flags().BoolVar(&options.Readiness, "readiness", options.Readiness, "Set or remove a readiness probe to indicate when this container should receive traffic")
+	cmd.Flags().BoolVar(&options.Liveness, "liveness", options.Liveness, "Set or remove a liveness probe to verify this container is running")
+
+	cmd.Flags().StringVar(&options.OpenTCPSocket, "open-tcp", options.OpenTCPSocket, "A port number or port name to attempt to open via TCP.")
+	cmd.Flags().StringVar(&options.HTTPGet, "get-url", options.HTTPGet, "A URL to perform an HTTP GET on (you can omit the host, have a string port, or omit the scheme).")
+	cmd.Flags().Int("initial-delay-seconds", 0, "The time in seconds to wait before the probe begins checking")
+	cmd.Flags().Int("timeout-seconds", 0, "The time in seconds to wait before considering the probe to have failed")
+	cmd.Flags().Int("period-seconds", 0, "The time in seconds between attempts")
+	cmd.Flags().Int("success-threshold", 0, "The number of successes required before the probe is considered successful")
+	cmd.Flags().Int("failure-threshold", 0, "The number of failures before the probe is considered to have failed")
+
+	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+
+	return cmd
+}
+
+// Complete loads the resources, the probe command, and the probe settings from the command line
+func (o *ProbeOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	if i := cmd.ArgsLenAtDash(); i != -1 && i < len(args) {
+		o.Command = args[i:]
+		args = args[:i]
+	}
+	o.Resources = args
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	o.OutputVersion, err = kcmdutil.OutputVersion(cmd, clientConfig.GroupVersion)
+	if err != nil {
+		return err
+	}
+	o.DefaultNamespace, o.ExplicitNamespace, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Mapper, o.Typer = f.Object()
+	o.RESTClientFactory = f.Factory.RESTClient
+	o.UpdatePodSpecForObject = f.UpdatePodSpecForObject
+
+	for name, field := range map[string]**int{
+		"initial-delay-seconds": &o.InitialDelaySeconds,
+		"timeout-seconds":       &o.TimeoutSeconds,
+		"period-seconds":        &o.PeriodSeconds,
+		"success-threshold":     &o.SuccessThreshold,
+		"failure-threshold":     &o.FailureThreshold,
+	} {
+		if cmd.Flags().Lookup(name).Changed {
+			value := kcmdutil.GetFlagInt(cmd, name)
+			*field = &value
+		}
+	}
+	return nil
+}
+
+// Validate checks that the probe options are consistent and parses the probe URL
+func (o *ProbeOptions) Validate() error {
+	if len(o.Selector) > 0 {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return errors.New("--selector=<selector> must be a valid label selector")
+		}
+		if o.All {
+			return errors.New("you may specify either --selector or --all but not both")
+		}
+	}
+	if len(o.Filenames) == 0 && len(o.Resources) == 0 {
+		return errors.New("one or more resources must be specified as <resource> <name> or <resource>/<name>")
+	}
+	if !o.Readiness && !o.Liveness {
+		return errors.New("you must specify one of --readiness or --liveness or both")
+	}
+
+	handlers := 0
+	if len(o.Command) > 0 {
+		handlers++
+	}
+	if len(o.OpenTCPSocket) > 0 {
+		handlers++
+	}
+	if len(o.HTTPGet) > 0 {
+		handlers++
+	}
+	settings := o.InitialDelaySeconds != nil || o.TimeoutSeconds != nil || o.PeriodSeconds != nil || o.SuccessThreshold != nil || o.FailureThreshold != nil
+
+	switch {
+	case o.Remove && (handlers > 0 || settings):
+		return errors.New("--remove may not be used with any flag except --readiness or --liveness")
+	case handlers > 1:
+		return errors.New("you may only set one of --get-url, --open-tcp, or a command")
+	case !o.Remove && handlers == 0 && !settings:
+		return errors.New("you must specify one of --get-url, --open-tcp, a command, or a probe setting to change")
+	}
+
+	for name, value := range map[string]*int{
+		"--initial-delay-seconds": o.InitialDelaySeconds,
+		"--timeout-seconds":       o.TimeoutSeconds,
+		"--period-seconds":        o.PeriodSeconds,
+		"--success-threshold":     o.SuccessThreshold,
+		"--failure-threshold":     o.FailureThreshold,
+	} {
+		if value != nil && *value < 0 {
+			return fmt.Errorf("%s may not be negative", name)
+		}
+	}
+
+	if len(o.HTTPGet) > 0 {
+		action, err := parseHTTPGetURL(o.HTTPGet)
+		if err != nil {
+			return err
+		}
+		o.httpGetAction = action
+	}
+	return nil
+}
+
+// parseHTTPGetURL converts a URL into an HTTP GET action. An empty host means the pod IP, and the
+// port may be the name of a container port.
+func parseHTTPGetURL(value string) (*kapi.HTTPGetAction, error) {
+	scheme := "http"
+	if i := strings.Index(value, "://"); i != -1 {
+		scheme, value = value[:i], value[i+3:]
+	}
+	var uriScheme kapi.URIScheme
+	switch strings.ToLower(scheme) {
+	case "http":
+		uriScheme = kapi.URISchemeHTTP
+	case "https":
+		uriScheme = kapi.URISchemeHTTPS
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme %q for --get-url, must be http or https", scheme)
+	}
+	hostPort, path := value, ""
+	if i := strings.Index(value, "/"); i != -1 {
+		hostPort, path = value[:i], value[i:]
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil || len(port) == 0 {
+		return nil, fmt.Errorf("--get-url must include a port, e.g. http://:8080/healthz")
+	}
+	return &kapi.HTTPGetAction{
+		Scheme: uriScheme,
+		Host:   host,
+		Port:   parsePort(port),
+		Path:   path,
+	}, nil
+}
+
+// Run updates the probes of the selected containers
+func (o *ProbeOptions) Run() error {
+	mapper := resource.ClientMapperFunc(o.RESTClientFactory)
+	b := resource.NewBuilder(o.Mapper, o.Typer, mapper).
+		ContinueOnError().
+		NamespaceParam(o.DefaultNamespace).DefaultNamespace().
+		FilenameParam(o.ExplicitNamespace, o.Filenames...).
+		SelectorParam(o.Selector).
+		ResourceTypeOrNameArgs(o.All, o.Resources...).
+		Flatten()
+
+	singular := false
+	infos, err := b.Do().IntoSingular(&singular).Infos()
+	if err != nil {
+		return err
+	}
+
+	updateInfos := []*resource.Info{}
+	skipped := 0
+	for _, info := range infos {
+		ok, err := o.UpdatePodSpecForObject(info.Object, func(spec *kapi.PodSpec) error {
+			containers, _ := selectContainers(spec.Containers, o.Containers)
+			if len(containers) == 0 {
+				fmt.Fprintf(o.Err, "warning: %s/%s does not have any containers matching %q\n", info.Mapping.Resource, info.Name, o.Containers)
+				return nil
+			}
+			for _, container := range containers {
+				if o.Readiness {
+					probe, err := o.updateProbe(container.ReadinessProbe)
+					if err != nil {
+						return fmt.Errorf("container %s: readiness probe: %v", container.Name, err)
+					}
+					container.ReadinessProbe = probe
+				}
+				if o.Liveness {
+					probe, err := o.updateProbe(container.LivenessProbe)
+					if err != nil {
+						return fmt.Errorf("container %s: liveness probe: %v", container.Name, err)
+					}
+					container.LivenessProbe = probe
+				}
+			}
+			return nil
+		})
+		if !ok {
+			skipped++
+			fmt.Fprintf(o.Err, "error: %s/%s does not have a pod template\n", info.Mapping.Resource, info.Name)
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, err)
+			continue
+		}
+		updateInfos = append(updateInfos, info)
+	}
+	if singular && skipped == len(infos) {
+		return errExit
+	}
+
+	if err := updateObjects(updateInfos, o.Output, o.OutputVersion, o.DryRun, o.Out, o.Err, "probes"); err != nil {
+		return err
+	}
+	if len(updateInfos) != len(infos) {
+		return errExit
+	}
+	return nil
+}
+
+// updateProbe returns the probe with the handler and the settings of the options applied. A
+// probe that does not exist yet is created if a handler is set.
+func (o *ProbeOptions) updateProbe(probe *kapi.Probe) (*kapi.Probe, error) {
+	if o.Remove {
+		return nil, nil
+	}
+	if probe == nil {
+		probe = &kapi.Probe{}
+	}
+	switch {
+	case len(o.Command) > 0:
+		probe.Handler = kapi.Handler{Exec: &kapi.ExecAction{Command: o.Command}}
+	case len(o.OpenTCPSocket) > 0:
+		probe.Handler = kapi.Handler{TCPSocket: &kapi.TCPSocketAction{Port: parsePort(o.OpenTCPSocket)}}
+	case o.httpGetAction != nil:
+		action := *o.httpGetAction
+		probe.Handler = kapi.Handler{HTTPGet: &action}
+	}
+	if probe.Exec == nil && probe.TCPSocket == nil && probe.HTTPGet == nil {
+		return nil, errors.New("the probe does not exist, specify --get-url, --open-tcp, or a command to create it")
+	}
+
+	if o.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *o.InitialDelaySeconds
+	}
+	if o.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *o.TimeoutSeconds
+	}
+	if o.PeriodSeconds != nil {
+		probe.PeriodSeconds = *o.PeriodSeconds
+	}
+	if o.SuccessThreshold != nil {
+		probe.SuccessThreshold = *o.SuccessThreshold
+	}
+	if o.FailureThreshold != nil {
+		probe.FailureThreshold = *o.FailureThreshold
+	}
+	return probe, nil
+}
+
+// parsePort returns the port as a number, or as the name of a container port if it is not a number
+func parsePort(port string) intstr.IntOrString {
+	if value, err := strconv.Atoi(port); err == nil {
+		return intstr.FromInt(value)
+	}
+	return intstr.FromString(port)
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestParseHTTPGetURL(t *testing.T) {
+	testCases := map[string]struct {
+		url      string
+		expected *kapi.HTTPGetAction
+		err      bool
+	}{
+		"pod IP": {
+			url:      "http://:8080/healthz",
+			expected: &kapi.HTTPGetAction{Scheme: kapi.URISchemeHTTP, Port: intstr.FromInt(8080), Path: "/healthz"},
+		},
+		"https with host": {
+			url:      "https://127.0.0.1:1936/stats?a=b",
+			expected: &kapi.HTTPGetAction{Scheme: kapi.URISchemeHTTPS, Host: "127.0.0.1", Port: intstr.FromInt(1936), Path: "/stats?a=b"},
+		},
+		"named port without scheme": {
+			url:      ":http",
+			expected: &kapi.HTTPGetAction{Scheme: kapi.URISchemeHTTP, Port: intstr.FromString("http")},
+		},
+		"missing port": {
+			url: "http://example.com/healthz",
+			err: true,
+		},
+		"unsupported scheme": {
+			url: "ftp://:21/",
+			err: true,
+		},
+	}
+	for name, tc := range testCases {
+		action, err := parseHTTPGetURL(tc.url)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(action, tc.expected) {
+			t.Errorf("%s: expected %#v, got %#v", name, tc.expected, action)
+		}
+	}
+}
+
+func TestProbeValidate(t *testing.T) {
+	delay := 10
+	testCases := map[string]struct {
+		options *ProbeOptions
+		err     bool
+	}{
+		"command": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Readiness: true, Command: []string{"true"}},
+		},
+		"setting only": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Liveness: true, InitialDelaySeconds: &delay},
+		},
+		"remove": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Liveness: true, Remove: true},
+		},
+		"no probe type": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Command: []string{"true"}},
+			err:     true,
+		},
+		"nothing to change": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Readiness: true},
+			err:     true,
+		},
+		"two handlers": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Readiness: true, OpenTCPSocket: "80", HTTPGet: ":80"},
+			err:     true,
+		},
+		"remove with handler": {
+			options: &ProbeOptions{Resources: []string{"dc/test"}, Readiness: true, Remove: true, OpenTCPSocket: "80"},
+			err:     true,
+		},
+		"no resources": {
+			options: &ProbeOptions{Readiness: true, OpenTCPSocket: "80"},
+			err:     true,
+		},
+	}
+	for name, tc := range testCases {
+		err := tc.options.Validate()
+		if tc.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestUpdateProbe(t *testing.T) {
+	delay, timeout := 10, 5
+
+	o := &ProbeOptions{OpenTCPSocket: "mysql", InitialDelaySeconds: &delay}
+	probe, err := o.updateProbe(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &kapi.Probe{
+		Handler:             kapi.Handler{TCPSocket: &kapi.TCPSocketAction{Port: intstr.FromString("mysql")}},
+		InitialDelaySeconds: 10,
+	}
+	if !reflect.DeepEqual(probe, expected) {
+		t.Errorf("expected %#v, got %#v", expected, probe)
+	}
+
+	// changing a setting keeps the handler of the probe
+	o = &ProbeOptions{TimeoutSeconds: &timeout}
+	probe, err = o.updateProbe(probe)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if probe.TCPSocket == nil || probe.InitialDelaySeconds != 10 || probe.TimeoutSeconds != 5 {
+		t.Errorf("unexpected probe: %#v", probe)
+	}
+
+	// a setting cannot create a probe without a handler
+	if _, err := o.updateProbe(nil); err == nil {
+		t.Errorf("expected an error for a probe without a handler")
+	}
+
+	o = &ProbeOptions{Remove: true}
+	if probe, err := o.updateProbe(probe); probe != nil || err != nil {
+		t.Errorf("expected the probe to be removed: %#v %v", probe, err)
+	}
+}
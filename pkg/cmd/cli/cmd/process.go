@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -20,6 +21,7 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/template"
 	"github.com/openshift/origin/pkg/template/api"
+	templateregistry "github.com/openshift/origin/pkg/template/registry"
 )
 
 const (
@@ -31,11 +33,18 @@ update. Templates have "parameters", which may either be generated on creation o
 as well as metadata describing the template.
 
 The output of the process command is always a list of one or more resources. You may pipe the
-output to the create command over STDIN (using the '-f -' option) or redirect it to a file.`
+output to the create command over STDIN (using the '-f -' option) or redirect it to a file.
+
+Templates are normally processed by the server. Pass --local to process a template file on the
+client instead, which generates parameter values the same way the server does and does not need
+a connection to the server or credentials.`
 
 	processExample = `  # Convert template.json file into resource list and pass to create
   $ %[1]s process -f template.json | %[1]s create -f -
 
+  # Process template.json on the client, without contacting the server
+  $ %[1]s process -f template.json --local -o yaml
+
   # Process template while passing a user-defined label
   $ %[1]s process -f template.json -l name=mytemplate
 
@@ -68,6 +77,7 @@ func NewCmdProcess(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.
 	cmd.Flags().StringSliceP("value", "v", nil, "Specify a list of key-value pairs (eg. -v FOO=BAR,BAR=FOO) to set/override parameter values")
 	cmd.Flags().BoolP("parameters", "", false, "Do not process but only print available parameters")
 	cmd.Flags().StringP("labels", "l", "", "Label to set in all resources for this template")
+	cmd.Flags().Bool("local", false, "If true process the template file on the client, without contacting the server")
 
 	cmd.Flags().StringP("output", "o", "json", "Output format. One of: describe|json|yaml|name|template|templatefile.")
 	cmd.Flags().Bool("raw", false, "If true output the processed template instead of the template's objects. Implied by -o describe")
@@ -99,6 +109,11 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 		}
 	}
 
+	local := kcmdutil.GetFlagBool(cmd, "local")
+	if local && len(templateName) > 0 {
+		return kcmdutil.UsageError(cmd, "Only template files may be processed with --local, pass the template with -f")
+	}
+
 	namespace, explicit, err := f.DefaultNamespace()
 	if err != nil {
 		return err
@@ -106,9 +121,20 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 
 	mapper, typer := f.Object()
 
-	client, _, err := f.Clients()
-	if err != nil {
-		return err
+	// processTemplate sends the template to the server, unless it is processed locally
+	var processTemplate func(*api.Template) (*api.Template, error)
+	clientMapper := f.ClientMapperForCommand()
+	if local {
+		processTemplate = processTemplateLocally
+		clientMapper = resource.ClientMapperFunc(func(mapping *meta.RESTMapping) (resource.RESTClient, error) {
+			return nil, nil
+		})
+	} else {
+		client, _, err := f.Clients()
+		if err != nil {
+			return err
+		}
+		processTemplate = client.TemplateConfigs(namespace).Create
 	}
 
 	var (
@@ -140,6 +166,10 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 		if len(storedTemplate) == 0 {
 			return fmt.Errorf("invalid value syntax %q", templateName)
 		}
+		client, _, err := f.Clients()
+		if err != nil {
+			return err
+		}
 		templateObj, err := client.Templates(sourceNamespace).Get(storedTemplate)
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -150,7 +180,7 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 		templateObj.CreationTimestamp = unversioned.Now()
 		infos = append(infos, &resource.Info{Object: templateObj})
 	} else {
-		infos, err = resource.NewBuilder(mapper, typer, f.ClientMapperForCommand()).
+		infos, err = resource.NewBuilder(mapper, typer, clientMapper).
 			NamespaceParam(namespace).RequireNamespace().
 			FilenameParam(explicit, filename).
 			Do().
@@ -207,7 +237,7 @@ func RunProcess(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, args []
 			injectUserVars(cmd, obj)
 		}
 
-		resultObj, err := client.TemplateConfigs(namespace).Create(obj)
+		resultObj, err := processTemplate(obj)
 		if err != nil {
 			fmt.Fprintf(cmd.Out(), "error processing the template %q: %v\n", obj.Name, err)
 			continue
@@ -271,9 +301,32 @@ func injectUserVars(cmd *cobra.Command, t *api.Template) {
 		if v := template.GetParameterByName(t, p[0]); v != nil {
 			v.Value = p[1]
 			v.Generate = ""
+			v.ValueFrom = nil
 			template.AddParameter(t, *v)
 		} else {
 			fmt.Fprintf(cmd.Out(), "unknown parameter name %q\n", p[0])
 		}
 	}
 }
+
+// processTemplateLocally processes the template the way the server does, and returns the
+// processed objects in the same form as a template processed by the server
+func processTemplateLocally(t *api.Template) (*api.Template, error) {
+	processed, err := templateregistry.NewREST(nil, nil).Create(kapi.NewContext(), t)
+	if err != nil {
+		return nil, err
+	}
+	result := processed.(*api.Template)
+	for i, item := range result.Objects {
+		unstructured, ok := item.(*runtime.Unstructured)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(unstructured.Object)
+		if err != nil {
+			return nil, err
+		}
+		result.Objects[i] = &runtime.Unknown{TypeMeta: unstructured.TypeMeta, RawJSON: data}
+	}
+	return result, nil
+}
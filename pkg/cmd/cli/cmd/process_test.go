@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+func TestProcessTemplateLocally(t *testing.T) {
+	template := &api.Template{
+		ObjectMeta: kapi.ObjectMeta{Name: "test"},
+		Parameters: []api.Parameter{
+			{Name: "NAME", Value: "frontend"},
+			{Name: "PASSWORD", Generate: "expression", From: "[a-z]{12}"},
+		},
+		Objects: []runtime.Object{
+			&runtime.Unknown{RawJSON: []byte(`{"kind":"Service","apiVersion":"v1","metadata":{"name":"${NAME}","namespace":"other","annotations":{"password":"${PASSWORD}"}}}`)},
+		},
+		ObjectLabels: map[string]string{"app": "test"},
+	}
+
+	result, err := processTemplateLocally(template)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Objects) != 1 {
+		t.Fatalf("expected one object, got %#v", result.Objects)
+	}
+	obj, ok := result.Objects[0].(*runtime.Unknown)
+	if !ok {
+		t.Fatalf("expected the processed object to be returned as JSON, got %#v", result.Objects[0])
+	}
+	if obj.Kind != "Service" || obj.APIVersion != "v1" {
+		t.Errorf("unexpected kind and version %#v", obj.TypeMeta)
+	}
+	data := string(obj.RawJSON)
+	for _, expected := range []string{`"name":"frontend"`, `"app":"test"`} {
+		if !strings.Contains(data, expected) {
+			t.Errorf("expected %s in %s", expected, data)
+		}
+	}
+	if strings.Contains(data, `"namespace":"other"`) {
+		t.Errorf("expected the namespace to be removed from %s", data)
+	}
+	if !regexp.MustCompile(`"password":"[a-z]{12}"`).MatchString(data) {
+		t.Errorf("expected a generated password in %s", data)
+	}
+
+	template.Parameters[1].Value = ""
+	template.Parameters[1].Generate = "unknown"
+	if _, err := processTemplateLocally(template); err == nil {
+		t.Errorf("expected an error for an unknown generator")
+	}
+}
@@ -20,6 +20,7 @@ type NewProjectOptions struct {
 	ProjectName string
 	DisplayName string
 	Description string
+	Contact     string
 
 	Name string
 
@@ -42,7 +43,10 @@ After your project is created it will become the default project in your config.
   $ %[1]s web-team-dev
 
   # Create a new project with a display name and description
-  $ %[1]s web-team-dev --display-name="Web Team Development" --description="Development project for the web team."`
+  $ %[1]s web-team-dev --display-name="Web Team Development" --description="Development project for the web team."
+
+  # Create a new project with a contact for the people responsible for it
+  $ %[1]s web-team-dev --contact="web-team@example.com"`
 )
 
 func NewCmdRequestProject(baseName, name, ocLoginName, ocProjectName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
@@ -73,6 +77,7 @@ func NewCmdRequestProject(baseName, name, ocLoginName, ocProjectName string, f *
 
 	cmd.Flags().StringVar(&options.DisplayName, "display-name", "", "Project display name")
 	cmd.Flags().StringVar(&options.Description, "description", "", "Project description")
+	cmd.Flags().StringVar(&options.Contact, "contact", "", "How to reach the people responsible for the project")
 
 	return cmd
 }
@@ -107,6 +112,9 @@ func (o *NewProjectOptions) Run() error {
 	projectRequest.DisplayName = o.DisplayName
 	projectRequest.Description = o.Description
 	projectRequest.Annotations = make(map[string]string)
+	if len(o.Contact) > 0 {
+		projectRequest.Annotations[projectapi.ProjectContact] = o.Contact
+	}
 
 	project, err := o.Client.ProjectRequests().Create(projectRequest)
 	if err != nil {
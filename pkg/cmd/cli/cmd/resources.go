@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kresource "k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	resourcesLong = `
+Set the compute resource requirements of containers or builds
+
+The requests and limits of compute resources, like CPU and memory, can be set on the
+containers of any object that has a pod template (deployment configs, replication
+controllers, or pods), and on the builds of build configs. The requests are the amount
+of resources that are reserved for a container on a node, and the limits are the most
+that a container may use. Only the resources that are given are changed.
+
+If you alter the resources of a deployment config, a deployment will be triggered. The
+resources of a build config are used by the builds started after the change.`
+
+	resourcesExample = `  # Set a CPU limit of 200 millicores and a memory limit of 512Mi on all containers of deployment config 'registry'
+  $ %[1]s resources dc/registry --limits=cpu=200m,memory=512Mi
+
+  # Set the requests of the container 'nginx' of replication controller 'web'
+  $ %[1]s resources rc/web -c nginx --requests=cpu=100m,memory=256Mi
+
+  # Give the builds of build config 'ruby-sample-build' more memory
+  $ %[1]s resources bc/ruby-sample-build --limits=memory=2Gi
+
+  # Print the result in YAML of setting the limits, without updating the server
+  $ %[1]s resources dc/registry --limits=cpu=200m --dry-run -o yaml`
+)
+
+// ResourcesOptions holds the options for changing the compute resource requirements of containers
+// and builds
+type ResourcesOptions struct {
+	DefaultNamespace       string
+	ExplicitNamespace      bool
+	Out                    io.Writer
+	Err                    io.Writer
+	Mapper                 meta.RESTMapper
+	Typer                  runtime.ObjectTyper
+	RESTClientFactory      func(mapping *meta.RESTMapping) (resource.RESTClient, error)
+	UpdatePodSpecForObject func(obj runtime.Object, fn func(*kapi.PodSpec) error) (bool, error)
+
+	// Resource selection
+	Selector  string
+	All       bool
+	Filenames []string
+	Resources []string
+
+	Containers    string
+	Output        string
+	OutputVersion unversioned.GroupVersion
+	DryRun        bool
+
+	Limits   string
+	Requests string
+
+	limits   kapi.ResourceList
+	requests kapi.ResourceList
+}
+
+// NewCmdResources implements the set resources command
+func NewCmdResources(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *cobra.Command {
+	options := &ResourcesOptions{
+		Out:        out,
+		Err:        errOut,
+		Containers: "*",
+	}
+	cmd := &cobra.Command{
+		Use:     "resources RESOURCE/NAME [--limits=LIMITS] [--requests=REQUESTS]",
+		Short:   "Update resource requests and limits on a pod template or a build config",
+		Long:    resourcesLong,
+		Example: fmt.Sprintf(resourcesExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			if err := options.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			err := options.Run()
+			if err == errExit {
+				os.Exit(1)
+			}
+			kcmdutil.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Containers, "containers", "c", options.Containers, "The names of containers in the selected pod templates to change - may use wildcards")
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on")
+	cmd.Flags().BoolVar(&options.All, "all", options.All, "Select all resources in the namespace of the specified resource types")
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to use to edit the resource.")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "Display the changed objects instead of updating them. One of: json|yaml")
+	cmd.Flags().String("output-version", "", "Output the changed objects with the given version (default api-version).")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "If true, only print the objects that would be updated, without updating them.")
+
+	cmd.Flags().StringVar(&options.Limits, "limits", options.Limits, "The resource limits to set, as a comma separated list of name=quantity pairs, e.g. cpu=200m,memory=512Mi")
+	cmd.Flags().StringVar(&options.Requests, "requests", options.Requests, "The resource requests to set, as a comma separated list of name=quantity pairs, e.g. cpu=100m,memory=256Mi")
+
+	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+
+	return cmd
+}
+
+// Complete loads the clients from the command environment
+func (o *ResourcesOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	o.Resources = args
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	o.OutputVersion, err = kcmdutil.OutputVersion(cmd, clientConfig.GroupVersion)
+	if err != nil {
+		return err
+	}
+	o.DefaultNamespace, o.ExplicitNamespace, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Mapper, o.Typer = f.Object()
+	o.RESTClientFactory = f.Factory.RESTClient
+	o.UpdatePodSpecForObject = f.UpdatePodSpecForObject
+	return nil
+}
+
+// Validate checks the resource selection and parses the limits and requests
+func (o *ResourcesOptions) Validate() error {
+	if len(o.Selector) > 0 {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return errors.New("--selector=<selector> must be a valid label selector")
+		}
+		if o.All {
+			return errors.New("you may specify either --selector or --all but not both")
+		}
+	}
+	if len(o.Filenames) == 0 && len(o.Resources) == 0 {
+		return errors.New("one or more resources must be specified as <resource> <name> or <resource>/<name>")
+	}
+	if len(o.Limits) == 0 && len(o.Requests) == 0 {
+		return errors.New("you must specify --limits or --requests")
+	}
+
+	var err error
+	if o.limits, err = parseResourceList(o.Limits); err != nil {
+		return fmt.Errorf("--limits is not valid: %v", err)
+	}
+	if o.requests, err = parseResourceList(o.Requests); err != nil {
+		return fmt.Errorf("--requests is not valid: %v", err)
+	}
+	return nil
+}
+
+// parseResourceList parses a comma separated list of name=quantity pairs
+func parseResourceList(value string) (kapi.ResourceList, error) {
+	list := kapi.ResourceList{}
+	if len(value) == 0 {
+		return list, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("%q must be of the form name=quantity", pair)
+		}
+		quantity, err := kresource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid quantity: %v", parts[1], err)
+		}
+		list[kapi.ResourceName(parts[0])] = *quantity
+	}
+	return list, nil
+}
+
+// Run updates the resource requirements of the selected containers and build configs
+func (o *ResourcesOptions) Run() error {
+	mapper := resource.ClientMapperFunc(o.RESTClientFactory)
+	b := resource.NewBuilder(o.Mapper, o.Typer, mapper).
+		ContinueOnError().
+		NamespaceParam(o.DefaultNamespace).DefaultNamespace().
+		FilenameParam(o.ExplicitNamespace, o.Filenames...).
+		SelectorParam(o.Selector).
+		ResourceTypeOrNameArgs(o.All, o.Resources...).
+		Flatten()
+
+	singular := false
+	infos, err := b.Do().IntoSingular(&singular).Infos()
+	if err != nil {
+		return err
+	}
+
+	updateInfos := []*resource.Info{}
+	skipped := 0
+	for _, info := range infos {
+		if bc, isBuildConfig := info.Object.(*buildapi.BuildConfig); isBuildConfig {
+			o.updateRequirements(&bc.Spec.Resources)
+			updateInfos = append(updateInfos, info)
+			continue
+		}
+		ok, err := o.UpdatePodSpecForObject(info.Object, func(spec *kapi.PodSpec) error {
+			containers, _ := selectContainers(spec.Containers, o.Containers)
+			if len(containers) == 0 {
+				fmt.Fprintf(o.Err, "warning: %s/%s does not have any containers matching %q\n", info.Mapping.Resource, info.Name, o.Containers)
+				return nil
+			}
+			for _, container := range containers {
+				o.updateRequirements(&container.Resources)
+			}
+			return nil
+		})
+		if !ok {
+			skipped++
+			fmt.Fprintf(o.Err, "error: %s/%s does not have a pod template and is not a build config\n", info.Mapping.Resource, info.Name)
+			continue
+		}
+		if err != nil {
+			fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, err)
+			continue
+		}
+		updateInfos = append(updateInfos, info)
+	}
+	if singular && skipped == len(infos) {
+		return errExit
+	}
+
+	if err := updateObjects(updateInfos, o.Output, o.OutputVersion, o.DryRun, o.Out, o.Err, "resources"); err != nil {
+		return err
+	}
+	if len(updateInfos) != len(infos) {
+		return errExit
+	}
+	return nil
+}
+
+// updateRequirements sets the limits and requests of the options, keeping the quantities of the
+// other resources
+func (o *ResourcesOptions) updateRequirements(requirements *kapi.ResourceRequirements) {
+	if len(o.limits) > 0 && requirements.Limits == nil {
+		requirements.Limits = kapi.ResourceList{}
+	}
+	for name, quantity := range o.limits {
+		requirements.Limits[name] = quantity
+	}
+	if len(o.requests) > 0 && requirements.Requests == nil {
+		requirements.Requests = kapi.ResourceList{}
+	}
+	for name, quantity := range o.requests {
+		requirements.Requests[name] = quantity
+	}
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+func TestParseResourceList(t *testing.T) {
+	list, err := parseResourceList("cpu=200m,memory=512Mi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cpu := list[kapi.ResourceCPU]; cpu.String() != "200m" {
+		t.Errorf("unexpected cpu: %s", cpu.String())
+	}
+	if memory := list[kapi.ResourceMemory]; memory.String() != "512Mi" {
+		t.Errorf("unexpected memory: %s", memory.String())
+	}
+
+	for _, invalid := range []string{"cpu", "=1", "cpu=abc", "cpu=1,memory"} {
+		if _, err := parseResourceList(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestUpdateRequirements(t *testing.T) {
+	o := &ResourcesOptions{
+		limits: kapi.ResourceList{kapi.ResourceMemory: resource.MustParse("1Gi")},
+	}
+	requirements := kapi.ResourceRequirements{
+		Limits:   kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("100m"), kapi.ResourceMemory: resource.MustParse("256Mi")},
+		Requests: kapi.ResourceList{kapi.ResourceCPU: resource.MustParse("50m")},
+	}
+	o.updateRequirements(&requirements)
+
+	if cpu := requirements.Limits[kapi.ResourceCPU]; cpu.String() != "100m" {
+		t.Errorf("expected the cpu limit to be kept: %s", cpu.String())
+	}
+	if memory := requirements.Limits[kapi.ResourceMemory]; memory.String() != "1Gi" {
+		t.Errorf("expected the memory limit to be changed: %s", memory.String())
+	}
+	if len(requirements.Requests) != 1 {
+		t.Errorf("expected the requests to be kept: %v", requirements.Requests)
+	}
+
+	empty := kapi.ResourceRequirements{}
+	o.updateRequirements(&empty)
+	if memory := empty.Limits[kapi.ResourceMemory]; memory.String() != "1Gi" || empty.Requests != nil {
+		t.Errorf("unexpected requirements: %#v", empty)
+	}
+}
@@ -28,6 +28,7 @@ import (
 type tarStrategy struct {
 	Quiet          bool
 	Delete         bool
+	Exclude        string
 	Tar            tar.Tar
 	RemoteExecutor executor
 	IgnoredFlags   []string
@@ -36,7 +37,7 @@ type tarStrategy struct {
 func newTarStrategy(f *clientcmd.Factory, c *cobra.Command, o *RsyncOptions) (copyStrategy, error) {
 
 	tarHelper := tar.New()
-	tarHelper.SetExclusionPattern(nil)
+	tarHelper.SetExclusionPattern(excludePattern(o.RsyncExclude))
 
 	ignoredFlags := rsyncSpecificFlags(o)
 
@@ -48,6 +49,7 @@ func newTarStrategy(f *clientcmd.Factory, c *cobra.Command, o *RsyncOptions) (co
 	return &tarStrategy{
 		Quiet:          o.Quiet,
 		Delete:         o.Delete,
+		Exclude:        o.RsyncExclude,
 		Tar:            tarHelper,
 		RemoteExecutor: remoteExec,
 		IgnoredFlags:   ignoredFlags,
@@ -142,7 +144,7 @@ func (r *tarStrategy) Copy(source, destination *pathSpec, out, errOut io.Writer)
 	} else {
 		glog.V(4).Infof("Creating local tar file %s from remote path %s", tmp.Name(), source.Path)
 		errBuf := &bytes.Buffer{}
-		err = tarRemote(r.RemoteExecutor, source.Path, tmp, errBuf)
+		err = tarRemote(r.RemoteExecutor, source.Path, r.Exclude, tmp, errBuf)
 		if err != nil {
 			if checkTar(r.RemoteExecutor) != nil {
 				return strategySetupError("tar not available in container")
@@ -201,13 +203,16 @@ func (r *tarStrategy) String() string {
 	return "tar"
 }
 
-func tarRemote(exec executor, sourceDir string, out, errOut io.Writer) error {
+func tarRemote(exec executor, sourceDir, exclude string, out, errOut io.Writer) error {
 	glog.V(4).Infof("Tarring %s remotely", sourceDir)
-	var cmd []string
+	cmd := []string{"tar"}
+	if len(exclude) > 0 {
+		cmd = append(cmd, fmt.Sprintf("--exclude=%s", exclude))
+	}
 	if strings.HasSuffix(sourceDir, "/") {
-		cmd = []string{"tar", "-C", sourceDir, "-c", "."}
+		cmd = append(cmd, "-C", sourceDir, "-c", ".")
 	} else {
-		cmd = []string{"tar", "-C", path.Dir(sourceDir), "-c", path.Base(sourceDir)}
+		cmd = append(cmd, "-C", path.Dir(sourceDir), "-c", path.Base(sourceDir))
 	}
 	glog.V(4).Infof("Remote tar command: %s", strings.Join(cmd, " "))
 	return exec.Execute(cmd, nil, out, errOut)
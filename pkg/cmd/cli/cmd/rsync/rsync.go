@@ -25,14 +25,21 @@ use your package manager. In Windows, install cwRsync from
 https://www.itefix.net/cwrsync.
 
 If no container is specified, the first container of the pod is used
-for the copy.`
+for the copy.
+
+With --watch, the command keeps running after the first copy and copies
+the local directory to the pod again every time one of its files changes.
+Files matching the --exclude pattern are neither copied nor watched.`
 
 	rsyncExample = `
   # Synchronize a local directory with a pod directory
   $ %[1]s ./local/dir/ POD:/remote/dir
 
   # Synchronize a pod directory with a local directory
-  $ %[1]s POD:/remote/dir/ ./local/dir`
+  $ %[1]s POD:/remote/dir/ ./local/dir
+
+  # Synchronize a local directory with a pod directory every time it changes, without its logs
+  $ %[1]s ./local/dir/ POD:/remote/dir --watch --exclude=*.log`
 
 	noRsyncUnixWarning    = "WARNING: rsync command not found in path. Please use your package manager to install it.\n"
 	noRsyncWindowsWarning = "WARNING: rsync command not found in path. Download cwRsync for Windows and add it to your PATH.\n"
@@ -65,6 +72,7 @@ type RsyncOptions struct {
 	StrategyName  string
 	Quiet         bool
 	Delete        bool
+	Watch         bool
 
 	RsyncInclude  string
 	RsyncExclude  string
@@ -98,11 +106,12 @@ func NewCmdRsync(name, parent string, f *clientcmd.Factory, out, errOut io.Write
 
 	cmd.Flags().StringVarP(&o.ContainerName, "container", "c", "", "Container within the pod")
 	cmd.Flags().StringVar(&o.StrategyName, "strategy", "", "Specify which strategy to use for copy: rsync, rsync-daemon, or tar")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "Watch the local directory for changes and copy them to the pod")
 
 	// Flags for rsync options, Must match rsync flag names
 	cmd.Flags().BoolVarP(&o.Quiet, "quiet", "q", false, "Suppress non-error messages")
 	cmd.Flags().BoolVar(&o.Delete, "delete", false, "Delete files not present in source")
-	cmd.Flags().StringVar(&o.RsyncExclude, "exclude", "", "rsync - exclude files matching specified pattern")
+	cmd.Flags().StringVar(&o.RsyncExclude, "exclude", "", "Exclude files matching specified pattern")
 	cmd.Flags().StringVar(&o.RsyncInclude, "include", "", "rsync - include files matching specified pattern")
 	cmd.Flags().BoolVar(&o.RsyncProgress, "progress", false, "rsync - show progress during transfer")
 	cmd.Flags().BoolVar(&o.RsyncNoPerms, "no-perms", false, "rsync - do not transfer permissions")
@@ -215,6 +224,9 @@ func (o *RsyncOptions) Validate() error {
 		return errors.New("rsync is only valid between a local directory and a pod directory; " +
 			"specify a pod directory as [PODNAME]:[DIR]")
 	}
+	if o.Watch && !o.Source.Local() {
+		return errors.New("--watch is only valid when the source is a local directory")
+	}
 	if err := o.Strategy.Validate(); err != nil {
 		return err
 	}
@@ -222,9 +234,16 @@ func (o *RsyncOptions) Validate() error {
 	return nil
 }
 
-// RunRsync copies files from source to destination
+// RunRsync copies files from source to destination, and keeps copying the changes of the
+// source when watching it
 func (o *RsyncOptions) RunRsync() error {
-	return o.Strategy.Copy(o.Source, o.Destination, o.Out, o.ErrOut)
+	if err := o.Strategy.Copy(o.Source, o.Destination, o.Out, o.ErrOut); err != nil {
+		return err
+	}
+	if !o.Watch {
+		return nil
+	}
+	return o.watch(watchInterval, make(chan struct{}))
 }
 
 // PodName returns the name of the pod as specified in either the
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -107,9 +108,6 @@ func rsyncSpecificFlags(o *RsyncOptions) []string {
 	if len(o.RsyncInclude) > 0 {
 		flags = append(flags, "--include")
 	}
-	if len(o.RsyncExclude) > 0 {
-		flags = append(flags, "--exclude")
-	}
 	if o.RsyncProgress {
 		flags = append(flags, "--progress")
 	}
@@ -118,3 +116,24 @@ func rsyncSpecificFlags(o *RsyncOptions) []string {
 	}
 	return flags
 }
+
+// excludePattern converts an rsync exclude pattern into a regular expression that matches the
+// paths of the excluded files and directories. Like rsync, "*" and "?" do not match a path
+// separator, and the pattern matches any whole file name or path suffix.
+func excludePattern(pattern string) *regexp.Regexp {
+	if len(pattern) == 0 {
+		return nil
+	}
+	expr := ""
+	for _, c := range strings.TrimSuffix(pattern, "/") {
+		switch c {
+		case '*':
+			expr += "[^/]*"
+		case '?':
+			expr += "[^/]"
+		default:
+			expr += regexp.QuoteMeta(string(c))
+		}
+	}
+	return regexp.MustCompile("(^|/)" + expr + "(/|$)")
+}
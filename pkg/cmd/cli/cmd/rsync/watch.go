@@ -0,0 +1,89 @@
+package rsync
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// watchInterval is how often the source directory is checked for changes in watch mode
+const watchInterval = time.Second
+
+// fileState is the part of the state of a file that changes when the file is modified
+type fileState struct {
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+// snapshotDir returns the state of all files and directories under root, except the ones
+// matching the exclude pattern
+func snapshotDir(root string, exclude *regexp.Regexp) (map[string]fileState, error) {
+	snapshot := map[string]fileState{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files may be removed while the directory is walked
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if exclude != nil && exclude.MatchString(path) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			// the modification time of a directory changes when excluded files are added to
+			// it, and its other changes are found through the files it contains
+			snapshot[path] = fileState{mode: info.Mode()}
+			return nil
+		}
+		snapshot[path] = fileState{size: info.Size(), mode: info.Mode(), modTime: info.ModTime()}
+		return nil
+	})
+	return snapshot, err
+}
+
+// watch copies the local source to the destination every time a file of the source changes,
+// until stop is closed. Failed copies are reported and retried until they succeed.
+func (o *RsyncOptions) watch(interval time.Duration, stop <-chan struct{}) error {
+	exclude := excludePattern(o.RsyncExclude)
+	last, err := snapshotDir(o.Source.Path, exclude)
+	if err != nil {
+		return err
+	}
+	if !o.Quiet {
+		fmt.Fprintf(o.Out, "Watching for changes in %s\n", o.Source.Path)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := snapshotDir(o.Source.Path, exclude)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(last, current) {
+			continue
+		}
+		glog.V(4).Infof("Changes found in %s, synchronizing", o.Source.Path)
+		if err := o.Strategy.Copy(o.Source, o.Destination, o.Out, o.ErrOut); err != nil {
+			fmt.Fprintf(o.ErrOut, "error: unable to synchronize the changes: %v\n", err)
+			continue
+		}
+		last = current
+	}
+}
@@ -0,0 +1,115 @@
+package rsync
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type countingStrategy struct {
+	copies chan struct{}
+}
+
+func (s *countingStrategy) Copy(source, destination *pathSpec, out, errOut io.Writer) error {
+	s.copies <- struct{}{}
+	return nil
+}
+
+func (s *countingStrategy) Validate() error { return nil }
+func (s *countingStrategy) String() string  { return "counting" }
+
+func TestExcludePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		excluded bool
+	}{
+		{pattern: "*.log", path: "/src/app.log", excluded: true},
+		{pattern: "*.log", path: "/src/logs/app.log", excluded: true},
+		{pattern: "*.log", path: "/src/app.log.txt", excluded: false},
+		{pattern: "*.log", path: "/src/app.go", excluded: false},
+		{pattern: ".git", path: "/src/.git/HEAD", excluded: true},
+		{pattern: ".git/", path: "/src/.git", excluded: true},
+		{pattern: ".git", path: "/src/.gitignore", excluded: false},
+		{pattern: "tmp/cache", path: "/src/tmp/cache/a", excluded: true},
+		{pattern: "file?.txt", path: "/src/file1.txt", excluded: true},
+		{pattern: "file?.txt", path: "/src/dir/file.txt", excluded: false},
+	}
+	for _, test := range tests {
+		if excluded := excludePattern(test.pattern).MatchString(test.path); excluded != test.excluded {
+			t.Errorf("pattern %q, path %q: expected excluded to be %t", test.pattern, test.path, test.excluded)
+		}
+	}
+	if excludePattern("") != nil {
+		t.Errorf("expected no pattern for an empty exclude")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rsync-watch")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	strategy := &countingStrategy{copies: make(chan struct{}, 10)}
+	o := &RsyncOptions{
+		Source:       &pathSpec{Path: dir + "/"},
+		Destination:  &pathSpec{PodName: "pod", Path: "/src"},
+		Strategy:     strategy,
+		RsyncExclude: "*.log",
+		Out:          &bytes.Buffer{},
+		ErrOut:       &bytes.Buffer{},
+	}
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() { done <- o.watch(10*time.Millisecond, stop) }()
+
+	expectCopies := func(expected int) {
+		timeout := time.After(200 * time.Millisecond)
+		copies := 0
+		for {
+			select {
+			case <-strategy.copies:
+				copies++
+				continue
+			case <-timeout:
+			}
+			break
+		}
+		if copies != expected {
+			t.Errorf("expected %d copies, got %d", expected, copies)
+		}
+	}
+
+	// wait for the first snapshot before changing anything
+	expectCopies(0)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "app.log"), []byte("ignored"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectCopies(0)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "util.go"), []byte("package main"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectCopies(1)
+
+	if err := os.Remove(filepath.Join(dir, "main.go")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectCopies(1)
+
+	close(stop)
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
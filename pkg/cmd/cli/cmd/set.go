@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+// SetRecommendedName is the recommended command name
+const SetRecommendedName = "set"
+
+const setLong = `
+Configure application resources
+
+These commands help you make changes to existing application resources. The
+environment, volumes, probes, and resource requirements of deployment configs,
+replication controllers, and pods can be changed, as well as the environment and
+resource requirements of the builds of build configs. The triggers of deployment
+configs and build configs can be listed and changed.
+
+Every command supports --dry-run and --output, to see the changes without
+updating the objects on the server.`
+
+// NewCmdSet exposes commands for modifying objects.
+func NewCmdSet(fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	name := fullName + " " + SetRecommendedName
+	cmd := &cobra.Command{
+		Use:   SetRecommendedName + " COMMAND",
+		Short: "Commands that help set specific features on objects",
+		Long:  setLong,
+		Run:   cmdutil.DefaultSubCommandRun(errout),
+	}
+
+	cmd.AddCommand(NewCmdEnv(name, f, in, out))
+	cmd.AddCommand(NewCmdVolume(name, f, out, errout))
+	cmd.AddCommand(NewCmdProbe(name, f, out, errout))
+	cmd.AddCommand(NewCmdResources(name, f, out, errout))
+	cmd.AddCommand(NewCmdTriggers(name, f, out, errout))
+	return cmd
+}
+
+// updateObjects prints the changed objects when an output format is set, lists them when dryRun
+// is set, and replaces them on the server otherwise. The failure of one object does not prevent
+// the others from being updated.
+func updateObjects(infos []*resource.Info, output string, outputVersion unversioned.GroupVersion, dryRun bool, out, errOut io.Writer, description string) error {
+	if len(output) != 0 {
+		objects, err := resource.AsVersionedObject(infos, false, outputVersion.String())
+		if err != nil {
+			return err
+		}
+		p, _, err := kubectl.GetPrinter(output, "")
+		if err != nil {
+			return err
+		}
+		return p.PrintObj(objects, out)
+	}
+
+	if dryRun {
+		for _, info := range infos {
+			fmt.Fprintf(out, "%s/%s (dry run)\n", info.Mapping.Resource, info.Name)
+		}
+		return nil
+	}
+
+	failed := false
+	for _, info := range infos {
+		obj, err := resource.NewHelper(info.Client, info.Mapping).Replace(info.Namespace, info.Name, true, info.Object)
+		if err != nil {
+			handlePodUpdateError(errOut, err, description)
+			failed = true
+			continue
+		}
+		info.Refresh(obj, true)
+		fmt.Fprintf(out, "%s/%s\n", info.Mapping.Resource, info.Name)
+	}
+	if failed {
+		return errExit
+	}
+	return nil
+}
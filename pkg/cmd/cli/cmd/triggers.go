@@ -0,0 +1,533 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/generate/app"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	triggersLong = `
+Set or remove the triggers of deployment configs and build configs
+
+Triggers start a new deployment or build when something changes. A config change trigger
+fires when the deployment config or build config itself changes, and an image change
+trigger fires when a new image is tagged into an image stream tag. Build configs may
+also be triggered by GitHub and generic webhooks, which are called with a secret that is
+generated when the trigger is added.
+
+Without any trigger flags the current triggers are listed. Add a trigger with one of
+--from-config, --from-image, --from-github, or --from-webhook, and remove it by adding
+--remove. Using --from-github or --from-webhook on a build config that already has such a
+trigger replaces its secret. The image change triggers of deployment configs may be
+paused with --manual and resumed with --auto, which applies to all image change triggers
+unless --from-image is given.`
+
+	triggersExample = `  # List the triggers of the deployment config 'registry'
+  $ %[1]s triggers dc/registry
+
+  # Stop deploying automatically when the images of 'registry' change
+  $ %[1]s triggers dc/registry --manual
+
+  # Deploy 'registry' when the image stream tag 'registry:latest' changes, for the container 'web'
+  $ %[1]s triggers dc/registry --from-image=registry:latest -c web
+
+  # Stop triggering a deployment when the configuration of 'registry' changes
+  $ %[1]s triggers dc/registry --from-config --remove
+
+  # Add a GitHub webhook to a build config, or replace the secret of the existing one
+  $ %[1]s triggers bc/webapp --from-github
+
+  # Start a build of 'webapp' when an image stream tag in another project changes
+  $ %[1]s triggers bc/webapp --from-image=openshift/ruby:2.2
+
+  # Remove all triggers of a build config, printing the result without updating the server
+  $ %[1]s triggers bc/webapp --remove-all -o yaml`
+)
+
+// TriggersOptions holds the options for listing and changing the triggers of deployment configs
+// and build configs
+type TriggersOptions struct {
+	DefaultNamespace  string
+	ExplicitNamespace bool
+	Out               io.Writer
+	Err               io.Writer
+	Mapper            meta.RESTMapper
+	Typer             runtime.ObjectTyper
+	RESTClientFactory func(mapping *meta.RESTMapping) (resource.RESTClient, error)
+
+	// Resource selection
+	Selector  string
+	All       bool
+	Filenames []string
+	Resources []string
+
+	Output        string
+	OutputVersion unversioned.GroupVersion
+	DryRun        bool
+
+	Remove    bool
+	RemoveAll bool
+	Auto      bool
+	Manual    bool
+
+	// Triggers to add or remove
+	FromConfig  bool
+	FromGitHub  bool
+	FromWebHook bool
+	FromImage   string
+	Containers  string
+
+	fromImage *kapi.ObjectReference
+}
+
+// NewCmdTriggers implements the set triggers command
+func NewCmdTriggers(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *cobra.Command {
+	options := &TriggersOptions{
+		Out: out,
+		Err: errOut,
+	}
+	cmd := &cobra.Command{
+		Use:     "triggers RESOURCE/NAME [--from-config|--from-image=IMAGESTREAMTAG|--from-github|--from-webhook] [--remove|--auto|--manual]",
+		Short:   "Update the triggers on a deployment config or a build config",
+		Long:    triggersLong,
+		Example: fmt.Sprintf(triggersExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			if err := options.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			err := options.Run()
+			if err == errExit {
+				os.Exit(1)
+			}
+			kcmdutil.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on")
+	cmd.Flags().BoolVar(&options.All, "all", options.All, "Select all resources in the namespace of the specified resource types")
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to use to edit the resource.")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "Display the changed objects instead of updating them. One of: json|yaml")
+	cmd.Flags().String("output-version", "", "Output the changed objects with the given version (default api-version).")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "If true, only print the objects that would be updated, without updating them.")
+
+	cmd.Flags().BoolVar(&options.Remove, "remove", options.Remove, "If true, remove the specified trigger(s).")
+	cmd.Flags().BoolVar(&options.RemoveAll, "remove-all", options.RemoveAll, "If true, remove all triggers.")
+	cmd.Flags().BoolVar(&options.Auto, "auto", options.Auto, "If true, deploy automatically when the images of the image change triggers change.")
+	cmd.Flags().BoolVar(&options.Manual, "manual", options.Manual, "If true, do not deploy automatically when the images of the image change triggers change.")
+
+	cmd.Flags().BoolVar(&options.FromConfig, "from-config", options.FromConfig, "If true, set a config change trigger.")
+	cmd.Flags().BoolVar(&options.FromGitHub, "from-github", options.FromGitHub, "If true, set a GitHub webhook trigger with a generated secret. Build configs only.")
+	cmd.Flags().BoolVar(&options.FromWebHook, "from-webhook", options.FromWebHook, "If true, set a generic webhook trigger with a generated secret. Build configs only.")
+	cmd.Flags().StringVar(&options.FromImage, "from-image", options.FromImage, "An image stream tag, as [NAMESPACE/]NAME[:TAG], to set an image change trigger for.")
+	cmd.Flags().StringVarP(&options.Containers, "containers", "c", options.Containers, "The names of the containers of a deployment config to update when --from-image changes - may use wildcards. Defaults to all containers.")
+
+	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+
+	return cmd
+}
+
+// Complete loads the clients from the command environment
+func (o *TriggersOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	o.Resources = args
+
+	clientConfig, err := f.ClientConfig()
+	if err != nil {
+		return err
+	}
+	o.OutputVersion, err = kcmdutil.OutputVersion(cmd, clientConfig.GroupVersion)
+	if err != nil {
+		return err
+	}
+	o.DefaultNamespace, o.ExplicitNamespace, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Mapper, o.Typer = f.Object()
+	o.RESTClientFactory = f.Factory.RESTClient
+	return nil
+}
+
+// Validate checks that the trigger flags are consistent and parses the image stream tag
+func (o *TriggersOptions) Validate() error {
+	if len(o.Selector) > 0 {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			return errors.New("--selector=<selector> must be a valid label selector")
+		}
+		if o.All {
+			return errors.New("you may specify either --selector or --all but not both")
+		}
+	}
+	if len(o.Filenames) == 0 && len(o.Resources) == 0 {
+		return errors.New("one or more resources must be specified as <resource> <name> or <resource>/<name>")
+	}
+
+	triggers := o.FromConfig || o.FromGitHub || o.FromWebHook || len(o.FromImage) > 0
+	switch {
+	case o.Auto && o.Manual:
+		return errors.New("you may specify only one of --auto or --manual")
+	case o.RemoveAll && (triggers || o.Remove || o.Auto || o.Manual):
+		return errors.New("--remove-all may not be used with any other trigger flag")
+	case o.Remove && !triggers:
+		return errors.New("--remove requires one of --from-config, --from-image, --from-github, or --from-webhook")
+	case o.Remove && (o.Auto || o.Manual):
+		return errors.New("--remove may not be used with --auto or --manual")
+	case len(o.Containers) > 0 && (len(o.FromImage) == 0 || o.Remove):
+		return errors.New("--containers may only be used when adding a trigger with --from-image")
+	}
+
+	if len(o.FromImage) > 0 {
+		ref, err := parseImageStreamTagRef(o.FromImage)
+		if err != nil {
+			return err
+		}
+		o.fromImage = ref
+	}
+	return nil
+}
+
+// parseImageStreamTagRef parses [NAMESPACE/]NAME[:TAG] into a reference to an image stream tag
+func parseImageStreamTagRef(value string) (*kapi.ObjectReference, error) {
+	ref := &kapi.ObjectReference{Kind: "ImageStreamTag"}
+	name := value
+	if parts := strings.SplitN(value, "/", 2); len(parts) == 2 {
+		ref.Namespace, name = parts[0], parts[1]
+	}
+	stream, tag, _ := imageapi.SplitImageStreamTag(name)
+	if len(stream) == 0 || strings.ContainsAny(stream, "/@") || strings.ContainsAny(tag, ":/") ||
+		strings.ContainsAny(ref.Namespace, ":@") || (len(ref.Namespace) == 0 && strings.Contains(value, "/")) {
+		return nil, fmt.Errorf("--from-image must be an image stream tag of the form [NAMESPACE/]NAME[:TAG], got %q", value)
+	}
+	ref.Name = imageapi.JoinImageStreamTag(stream, tag)
+	return ref, nil
+}
+
+// changesTriggers returns true if the options alter triggers, instead of listing them
+func (o *TriggersOptions) changesTriggers() bool {
+	return o.RemoveAll || o.Auto || o.Manual || o.FromConfig || o.FromGitHub || o.FromWebHook || len(o.FromImage) > 0
+}
+
+// Run lists or updates the triggers of the selected objects
+func (o *TriggersOptions) Run() error {
+	mapper := resource.ClientMapperFunc(o.RESTClientFactory)
+	b := resource.NewBuilder(o.Mapper, o.Typer, mapper).
+		ContinueOnError().
+		NamespaceParam(o.DefaultNamespace).DefaultNamespace().
+		FilenameParam(o.ExplicitNamespace, o.Filenames...).
+		SelectorParam(o.Selector).
+		ResourceTypeOrNameArgs(o.All, o.Resources...).
+		Flatten()
+
+	singular := false
+	infos, err := b.Do().IntoSingular(&singular).Infos()
+	if err != nil {
+		return err
+	}
+
+	if !o.changesTriggers() {
+		return o.printTriggers(infos)
+	}
+
+	updateInfos := []*resource.Info{}
+	for _, info := range infos {
+		var err error
+		switch t := info.Object.(type) {
+		case *deployapi.DeploymentConfig:
+			err = o.updateDeploymentConfig(t)
+		case *buildapi.BuildConfig:
+			err = o.updateBuildConfig(t)
+		default:
+			err = errors.New("does not have triggers")
+		}
+		if err != nil {
+			fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, err)
+			continue
+		}
+		updateInfos = append(updateInfos, info)
+	}
+	if singular && len(updateInfos) == 0 {
+		return errExit
+	}
+
+	if err := updateObjects(updateInfos, o.Output, o.OutputVersion, o.DryRun, o.Out, o.Err, "triggers"); err != nil {
+		return err
+	}
+	if len(updateInfos) != len(infos) {
+		return errExit
+	}
+	return nil
+}
+
+// printTriggers writes a table of the triggers of the given objects
+func (o *TriggersOptions) printTriggers(infos []*resource.Info) error {
+	w := kubectl.GetNewTabWriter(o.Out)
+	fmt.Fprintln(w, "NAME\tTYPE\tVALUE\tAUTO")
+	failed := false
+	for _, info := range infos {
+		name := info.Mapping.Resource + "/" + info.Name
+		switch t := info.Object.(type) {
+		case *deployapi.DeploymentConfig:
+			for _, trigger := range t.Spec.Triggers {
+				switch trigger.Type {
+				case deployapi.DeploymentTriggerOnConfigChange:
+					fmt.Fprintf(w, "%s\tconfig\t\ttrue\n", name)
+				case deployapi.DeploymentTriggerOnImageChange:
+					if params := trigger.ImageChangeParams; params != nil {
+						fmt.Fprintf(w, "%s\timage\t%s (%s)\t%t\n", name, formatImageStreamTagRef(&params.From), strings.Join(params.ContainerNames, ", "), params.Automatic)
+					}
+				}
+			}
+		case *buildapi.BuildConfig:
+			for _, trigger := range t.Spec.Triggers {
+				switch trigger.Type {
+				case buildapi.ConfigChangeBuildTriggerType:
+					fmt.Fprintf(w, "%s\tconfig\t\ttrue\n", name)
+				case buildapi.ImageChangeBuildTriggerType:
+					if from := buildImageChangeFrom(t, &trigger); from != nil {
+						fmt.Fprintf(w, "%s\timage\t%s\ttrue\n", name, formatImageStreamTagRef(from))
+					}
+				case buildapi.GitHubWebHookBuildTriggerType:
+					if trigger.GitHubWebHook != nil {
+						fmt.Fprintf(w, "%s\tgithub\t%s\t\n", name, trigger.GitHubWebHook.Secret)
+					}
+				case buildapi.GenericWebHookBuildTriggerType:
+					if trigger.GenericWebHook != nil {
+						fmt.Fprintf(w, "%s\twebhook\t%s\t\n", name, trigger.GenericWebHook.Secret)
+					}
+				}
+			}
+		default:
+			fmt.Fprintf(o.Err, "error: %s does not have triggers\n", name)
+			failed = true
+		}
+	}
+	w.Flush()
+	if failed {
+		return errExit
+	}
+	return nil
+}
+
+// updateDeploymentConfig applies the trigger options to a deployment config
+func (o *TriggersOptions) updateDeploymentConfig(config *deployapi.DeploymentConfig) error {
+	if o.FromGitHub || o.FromWebHook {
+		return errors.New("does not support webhook triggers, only build configs do")
+	}
+	if o.RemoveAll {
+		// an empty list keeps the server from adding a default config change trigger
+		config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{}
+		return nil
+	}
+
+	if o.FromConfig {
+		triggers := []deployapi.DeploymentTriggerPolicy{}
+		found := false
+		for _, trigger := range config.Spec.Triggers {
+			if trigger.Type == deployapi.DeploymentTriggerOnConfigChange {
+				found = true
+				if o.Remove {
+					continue
+				}
+			}
+			triggers = append(triggers, trigger)
+		}
+		if !found && !o.Remove {
+			triggers = append(triggers, deployapi.DeploymentTriggerPolicy{Type: deployapi.DeploymentTriggerOnConfigChange})
+		}
+		config.Spec.Triggers = triggers
+	}
+
+	if o.fromImage != nil {
+		matches := func(trigger *deployapi.DeploymentTriggerPolicy) bool {
+			return trigger.Type == deployapi.DeploymentTriggerOnImageChange && trigger.ImageChangeParams != nil &&
+				sameImageStreamTag(&trigger.ImageChangeParams.From, o.fromImage, config.Namespace)
+		}
+		triggers := []deployapi.DeploymentTriggerPolicy{}
+		var existing *deployapi.DeploymentTriggerImageChangeParams
+		for i := range config.Spec.Triggers {
+			trigger := config.Spec.Triggers[i]
+			if matches(&trigger) {
+				if o.Remove {
+					continue
+				}
+				existing = trigger.ImageChangeParams
+			}
+			triggers = append(triggers, trigger)
+		}
+
+		if !o.Remove && (existing == nil || len(o.Containers) > 0) {
+			if config.Spec.Template == nil {
+				return errors.New("does not have a pod template")
+			}
+			spec := o.Containers
+			if len(spec) == 0 {
+				spec = "*"
+			}
+			containers, _ := selectContainers(config.Spec.Template.Spec.Containers, spec)
+			if len(containers) == 0 {
+				return fmt.Errorf("does not have any containers matching %q", spec)
+			}
+			names := []string{}
+			for _, container := range containers {
+				names = append(names, container.Name)
+			}
+			if existing != nil {
+				existing.ContainerNames = names
+			} else {
+				triggers = append(triggers, deployapi.DeploymentTriggerPolicy{
+					Type: deployapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+						Automatic:      !o.Manual,
+						ContainerNames: names,
+						From:           *o.fromImage,
+					},
+				})
+			}
+		}
+		config.Spec.Triggers = triggers
+	}
+
+	if o.Auto || o.Manual {
+		for i := range config.Spec.Triggers {
+			trigger := &config.Spec.Triggers[i]
+			if trigger.Type != deployapi.DeploymentTriggerOnImageChange || trigger.ImageChangeParams == nil {
+				continue
+			}
+			if o.fromImage != nil && !sameImageStreamTag(&trigger.ImageChangeParams.From, o.fromImage, config.Namespace) {
+				continue
+			}
+			trigger.ImageChangeParams.Automatic = o.Auto
+		}
+	}
+	return nil
+}
+
+// updateBuildConfig applies the trigger options to a build config
+func (o *TriggersOptions) updateBuildConfig(config *buildapi.BuildConfig) error {
+	if o.Auto || o.Manual {
+		return errors.New("does not support --auto or --manual, use --remove to stop triggering builds")
+	}
+	if o.RemoveAll {
+		config.Spec.Triggers = []buildapi.BuildTriggerPolicy{}
+		return nil
+	}
+
+	if o.FromConfig {
+		config.Spec.Triggers = o.setBuildTrigger(config.Spec.Triggers, buildapi.ConfigChangeBuildTriggerType, func(trigger *buildapi.BuildTriggerPolicy) bool {
+			return trigger.Type == buildapi.ConfigChangeBuildTriggerType
+		})
+	}
+	if o.FromGitHub {
+		config.Spec.Triggers = o.setBuildTrigger(config.Spec.Triggers, buildapi.GitHubWebHookBuildTriggerType, func(trigger *buildapi.BuildTriggerPolicy) bool {
+			if trigger.Type != buildapi.GitHubWebHookBuildTriggerType {
+				return false
+			}
+			trigger.GitHubWebHook = &buildapi.WebHookTrigger{Secret: app.GenerateSecret(20)}
+			return true
+		})
+	}
+	if o.FromWebHook {
+		config.Spec.Triggers = o.setBuildTrigger(config.Spec.Triggers, buildapi.GenericWebHookBuildTriggerType, func(trigger *buildapi.BuildTriggerPolicy) bool {
+			if trigger.Type != buildapi.GenericWebHookBuildTriggerType {
+				return false
+			}
+			trigger.GenericWebHook = &buildapi.WebHookTrigger{Secret: app.GenerateSecret(20)}
+			return true
+		})
+	}
+	if o.fromImage != nil {
+		config.Spec.Triggers = o.setBuildTrigger(config.Spec.Triggers, buildapi.ImageChangeBuildTriggerType, func(trigger *buildapi.BuildTriggerPolicy) bool {
+			from := buildImageChangeFrom(config, trigger)
+			return trigger.Type == buildapi.ImageChangeBuildTriggerType && from != nil && sameImageStreamTag(from, o.fromImage, config.Namespace)
+		})
+	}
+	return nil
+}
+
+// setBuildTrigger removes the triggers that match when --remove is set, and otherwise adds a
+// trigger of the given type if none match. match may update a matching trigger in place.
+func (o *TriggersOptions) setBuildTrigger(triggers []buildapi.BuildTriggerPolicy, triggerType buildapi.BuildTriggerType, match func(*buildapi.BuildTriggerPolicy) bool) []buildapi.BuildTriggerPolicy {
+	updated := []buildapi.BuildTriggerPolicy{}
+	found := false
+	for _, trigger := range triggers {
+		if match(&trigger) {
+			found = true
+			if o.Remove {
+				continue
+			}
+		}
+		updated = append(updated, trigger)
+	}
+	if found || o.Remove {
+		return updated
+	}
+
+	trigger := buildapi.BuildTriggerPolicy{Type: triggerType}
+	switch triggerType {
+	case buildapi.GitHubWebHookBuildTriggerType:
+		trigger.GitHubWebHook = &buildapi.WebHookTrigger{Secret: app.GenerateSecret(20)}
+	case buildapi.GenericWebHookBuildTriggerType:
+		trigger.GenericWebHook = &buildapi.WebHookTrigger{Secret: app.GenerateSecret(20)}
+	case buildapi.ImageChangeBuildTriggerType:
+		from := *o.fromImage
+		trigger.ImageChange = &buildapi.ImageChangeTrigger{From: &from}
+	}
+	return append(updated, trigger)
+}
+
+// buildImageChangeFrom returns the image stream tag an image change trigger of a build config
+// watches, which is the image of the build strategy when the trigger does not name one.
+func buildImageChangeFrom(config *buildapi.BuildConfig, trigger *buildapi.BuildTriggerPolicy) *kapi.ObjectReference {
+	if trigger.ImageChange == nil {
+		return nil
+	}
+	if trigger.ImageChange.From != nil {
+		return trigger.ImageChange.From
+	}
+	return buildutil.GetImageStreamForBuildSpec(config.Spec.BuildSpec)
+}
+
+// sameImageStreamTag returns true if both references point to the same image stream tag, using
+// namespace for references without one.
+func sameImageStreamTag(a, b *kapi.ObjectReference, namespace string) bool {
+	if a.Kind != b.Kind || a.Name != b.Name {
+		return false
+	}
+	aNamespace, bNamespace := a.Namespace, b.Namespace
+	if len(aNamespace) == 0 {
+		aNamespace = namespace
+	}
+	if len(bNamespace) == 0 {
+		bNamespace = namespace
+	}
+	return aNamespace == bNamespace
+}
+
+// formatImageStreamTagRef returns the reference as [NAMESPACE/]NAME:TAG
+func formatImageStreamTagRef(ref *kapi.ObjectReference) string {
+	if len(ref.Namespace) == 0 {
+		return ref.Name
+	}
+	return ref.Namespace + "/" + ref.Name
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func TestParseImageStreamTagRef(t *testing.T) {
+	testCases := map[string]*kapi.ObjectReference{
+		"ruby":                {Kind: "ImageStreamTag", Name: "ruby:latest"},
+		"ruby:2.2":            {Kind: "ImageStreamTag", Name: "ruby:2.2"},
+		"openshift/ruby:2.2":  {Kind: "ImageStreamTag", Name: "ruby:2.2", Namespace: "openshift"},
+		"":                    nil,
+		"/ruby":               nil,
+		"a/b/ruby":            nil,
+		"ruby@sha256:abc":     nil,
+		"openshift/ruby:2:2":  nil,
+		"openshift/:2.2":      nil,
+		"registry:5000/ruby":  nil,
+		"openshift/ruby:2.2/": nil,
+	}
+	for value, expected := range testCases {
+		ref, err := parseImageStreamTagRef(value)
+		if expected == nil {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %#v", value, ref)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", value, err)
+			continue
+		}
+		if !reflect.DeepEqual(ref, expected) {
+			t.Errorf("%q: expected %#v, got %#v", value, expected, ref)
+		}
+	}
+}
+
+func TestTriggersValidate(t *testing.T) {
+	testCases := map[string]struct {
+		options *TriggersOptions
+		err     bool
+	}{
+		"list": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}},
+		},
+		"add image": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, FromImage: "ruby:2.2", Containers: "web", Manual: true},
+		},
+		"remove config": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, FromConfig: true, Remove: true},
+		},
+		"auto and manual": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, Auto: true, Manual: true},
+			err:     true,
+		},
+		"remove without trigger": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, Remove: true},
+			err:     true,
+		},
+		"remove all with trigger": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, RemoveAll: true, FromConfig: true},
+			err:     true,
+		},
+		"containers without image": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, FromConfig: true, Containers: "web"},
+			err:     true,
+		},
+		"invalid image": {
+			options: &TriggersOptions{Resources: []string{"dc/test"}, FromImage: "a/b/c"},
+			err:     true,
+		},
+	}
+	for name, tc := range testCases {
+		err := tc.options.Validate()
+		if tc.err != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func triggersTestDeploymentConfig() *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "ns"},
+		Spec: deployapi.DeploymentConfigSpec{
+			Triggers: []deployapi.DeploymentTriggerPolicy{
+				{Type: deployapi.DeploymentTriggerOnConfigChange},
+				{
+					Type: deployapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+						Automatic:      true,
+						ContainerNames: []string{"web"},
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"},
+					},
+				},
+			},
+			Template: &kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{{Name: "web"}, {Name: "sidecar"}},
+				},
+			},
+		},
+	}
+}
+
+func TestUpdateDeploymentConfigTriggers(t *testing.T) {
+	// pausing all image change triggers
+	config := triggersTestDeploymentConfig()
+	if err := (&TriggersOptions{Manual: true}).updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 2 || config.Spec.Triggers[1].ImageChangeParams.Automatic {
+		t.Errorf("expected the image change trigger to be manual: %#v", config.Spec.Triggers)
+	}
+
+	// removing the config change trigger
+	config = triggersTestDeploymentConfig()
+	if err := (&TriggersOptions{FromConfig: true, Remove: true}).updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 1 || config.Spec.Triggers[0].Type != deployapi.DeploymentTriggerOnImageChange {
+		t.Errorf("expected only the image change trigger: %#v", config.Spec.Triggers)
+	}
+
+	// adding the config change trigger twice keeps a single trigger
+	o := &TriggersOptions{FromConfig: true}
+	if err := o.updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 2 {
+		t.Errorf("expected two triggers: %#v", config.Spec.Triggers)
+	}
+
+	// adding an image change trigger for all containers
+	config = triggersTestDeploymentConfig()
+	o = &TriggersOptions{FromImage: "other/sidecar:v1", Manual: true}
+	if err := o.Validate(); err == nil {
+		t.Fatalf("expected an error without resources")
+	}
+	o.Resources = []string{"dc/test"}
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 3 {
+		t.Fatalf("expected a new trigger: %#v", config.Spec.Triggers)
+	}
+	expected := &deployapi.DeploymentTriggerImageChangeParams{
+		ContainerNames: []string{"web", "sidecar"},
+		From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "sidecar:v1", Namespace: "other"},
+	}
+	if params := config.Spec.Triggers[2].ImageChangeParams; !reflect.DeepEqual(params, expected) {
+		t.Errorf("expected %#v, got %#v", expected, params)
+	}
+	if !config.Spec.Triggers[1].ImageChangeParams.Automatic {
+		t.Errorf("expected other image change triggers to be unchanged")
+	}
+
+	// changing the containers of an existing trigger, referenced by namespace
+	o = &TriggersOptions{Resources: []string{"dc/test"}, FromImage: "ns/web", Containers: "side*"}
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := config.Spec.Triggers[1].ImageChangeParams.ContainerNames; !reflect.DeepEqual(names, []string{"sidecar"}) {
+		t.Errorf("unexpected container names: %v", names)
+	}
+
+	o = &TriggersOptions{Resources: []string{"dc/test"}, FromImage: "web", Containers: "missing"}
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.updateDeploymentConfig(config); err == nil {
+		t.Errorf("expected an error for missing containers")
+	}
+
+	if err := (&TriggersOptions{FromGitHub: true}).updateDeploymentConfig(config); err == nil {
+		t.Errorf("expected an error for webhooks")
+	}
+
+	if err := (&TriggersOptions{RemoveAll: true}).updateDeploymentConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Spec.Triggers == nil || len(config.Spec.Triggers) != 0 {
+		t.Errorf("expected an empty list of triggers: %#v", config.Spec.Triggers)
+	}
+}
+
+func TestUpdateBuildConfigTriggers(t *testing.T) {
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "ns"},
+		Spec: buildapi.BuildConfigSpec{
+			BuildSpec: buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:2.2", Namespace: "openshift"},
+					},
+				},
+			},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{Type: buildapi.GitHubWebHookBuildTriggerType, GitHubWebHook: &buildapi.WebHookTrigger{Secret: "secret"}},
+				{Type: buildapi.ImageChangeBuildTriggerType, ImageChange: &buildapi.ImageChangeTrigger{}},
+			},
+		},
+	}
+
+	// a new secret replaces the existing one
+	if err := (&TriggersOptions{FromGitHub: true}).updateBuildConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 2 {
+		t.Fatalf("expected the trigger to be replaced: %#v", config.Spec.Triggers)
+	}
+	if secret := config.Spec.Triggers[0].GitHubWebHook.Secret; secret == "secret" || len(secret) == 0 {
+		t.Errorf("expected a new secret: %q", secret)
+	}
+
+	if err := (&TriggersOptions{FromWebHook: true, FromConfig: true}).updateBuildConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 4 || config.Spec.Triggers[2].Type != buildapi.ConfigChangeBuildTriggerType || config.Spec.Triggers[3].GenericWebHook == nil {
+		t.Errorf("expected a config change and a generic webhook trigger: %#v", config.Spec.Triggers)
+	}
+
+	// the image change trigger without a reference watches the strategy image
+	o := &TriggersOptions{Resources: []string{"bc/test"}, FromImage: "openshift/ruby:2.2"}
+	if err := o.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.updateBuildConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(config.Spec.Triggers) != 4 {
+		t.Errorf("expected no new image change trigger: %#v", config.Spec.Triggers)
+	}
+	o.Remove = true
+	if err := o.updateBuildConfig(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type == buildapi.ImageChangeBuildTriggerType {
+			t.Errorf("expected the image change trigger to be removed: %#v", config.Spec.Triggers)
+		}
+	}
+
+	if err := (&TriggersOptions{Manual: true}).updateBuildConfig(config); err == nil {
+		t.Errorf("expected an error for --manual")
+	}
+}
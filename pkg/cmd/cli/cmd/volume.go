@@ -72,6 +72,10 @@ For descriptions on other volume types, see https://docs.openshift.com`
   # Create a new persistent volume claim that overwrites an existing volume 'v1'
   $ %[1]s volume dc/registry --add --name=v1 -t pvc --claim-size=1G --overwrite
 
+  # Create a new persistent volume claim provisioned with the storage class 'fast' and
+  # mount it under /data
+  $ %[1]s volume dc/registry --add -m /data --claim-size=10Gi --claim-class=fast
+
   # Change the mount point for volume 'v1' to /data
   $ %[1]s volume dc/registry --add --name=v1 -m /data --overwrite
 
@@ -84,6 +88,10 @@ For descriptions on other volume types, see https://docs.openshift.com`
   $ %[1]s volume dc/registry --add -m /repo --source=<json-string>`
 
 	volumePrefix = "volume-"
+
+	// storageClassAnnotation requests the dynamic provisioning of a volume for a claim
+	// with the named storage class
+	storageClassAnnotation = "volume.alpha.kubernetes.io/storage-class"
 )
 
 type VolumeOptions struct {
@@ -113,6 +121,7 @@ type VolumeOptions struct {
 	Confirm       bool
 	Output        string
 	OutputVersion unversioned.GroupVersion
+	DryRun        bool
 
 	// Add op params
 	AddOpts *AddVolumeOptions
@@ -130,6 +139,7 @@ type AddVolumeOptions struct {
 	ClaimName   string
 	ClaimSize   string
 	ClaimMode   string
+	ClaimClass  string
 
 	TypeChanged bool
 }
@@ -172,6 +182,7 @@ func NewCmdVolume(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().BoolVar(&opts.Confirm, "confirm", false, "Confirm that you really want to remove multiple volumes")
 	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Display the changed objects instead of updating them. One of: json|yaml")
 	cmd.Flags().String("output-version", "", "Output the changed objects with the given version (default api-version).")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "If true, only print the objects that would be updated, without updating them.")
 
 	cmd.Flags().StringVarP(&addOpts.Type, "type", "t", "", "Type of the volume source for add operation. Supported options: emptyDir, hostPath, secret, persistentVolumeClaim")
 	cmd.Flags().StringVarP(&addOpts.MountPath, "mount-path", "m", "", "Mount path inside the container. Optional param for --add or --remove op")
@@ -181,6 +192,7 @@ func NewCmdVolume(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().StringVar(&addOpts.ClaimName, "claim-name", "", "Persistent volume claim name. Must be provided for persistentVolumeClaim volume type")
 	cmd.Flags().StringVar(&addOpts.ClaimSize, "claim-size", "", "If specified along with a persistent volume type, create a new claim with the given size in bytes. Accepts SI notation: 10, 10G, 10Gi")
 	cmd.Flags().StringVar(&addOpts.ClaimMode, "claim-mode", "ReadWriteOnce", "Set the access mode of the claim to be created. Valid values are ReadWriteOnce (rwo), ReadWriteMany (rwm), or ReadOnlyMany (rom)")
+	cmd.Flags().StringVar(&addOpts.ClaimClass, "claim-class", "", "If specified along with --claim-size, request that a volume of the given storage class is provisioned for the new claim")
 	cmd.Flags().StringVar(&addOpts.Source, "source", "", "Details of volume source as json string. This can be used if the required volume type is not supported by --type option. (e.g.: '{\"gitRepo\": {\"repository\": <git-url>, \"revision\": <commit-hash>}}')")
 
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
@@ -273,6 +285,10 @@ func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 			return errors.New("--path|--secret-name|--claim-name are only valid for --type option")
 		}
 
+		if len(a.ClaimClass) > 0 && len(a.ClaimSize) == 0 {
+			return errors.New("--claim-class is only valid together with --claim-size (to create a new claim)")
+		}
+
 		if len(a.Source) > 0 {
 			var source map[string]interface{}
 			err := json.Unmarshal([]byte(a.Source), &source)
@@ -289,8 +305,8 @@ func (a *AddVolumeOptions) Validate(isAddOp bool) error {
 				return err
 			}
 		}
-	} else if len(a.Source) > 0 || len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ClaimName) > 0 || a.Overwrite {
-		return errors.New("--type|--path|--secret-name|--claim-name|--source|--overwrite are only valid for --add operation")
+	} else if len(a.Source) > 0 || len(a.Path) > 0 || len(a.SecretName) > 0 || len(a.ClaimName) > 0 || len(a.ClaimClass) > 0 || a.Overwrite {
+		return errors.New("--type|--path|--secret-name|--claim-name|--claim-class|--source|--overwrite are only valid for --add operation")
 	}
 	return nil
 }
@@ -441,6 +457,16 @@ func (v *VolumeOptions) RunVolume(args []string) error {
 		return p.PrintObj(objects, v.Out)
 	}
 
+	if v.DryRun {
+		for _, info := range updateInfos {
+			fmt.Fprintf(v.Out, "%s/%s (dry run)\n", info.Mapping.Resource, info.Name)
+		}
+		if updatePodSpecFailed {
+			return errExit
+		}
+		return nil
+	}
+
 	failed := false
 	for _, info := range updateInfos {
 		var obj runtime.Object
@@ -486,7 +512,7 @@ func setVolumeSourceByType(kv *kapi.Volume, opts *AddVolumeOptions) error {
 }
 
 func (v *AddVolumeOptions) createClaim() *kapi.PersistentVolumeClaim {
-	return &kapi.PersistentVolumeClaim{
+	claim := &kapi.PersistentVolumeClaim{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: v.ClaimName,
 		},
@@ -499,6 +525,10 @@ func (v *AddVolumeOptions) createClaim() *kapi.PersistentVolumeClaim {
 			},
 		},
 	}
+	if len(v.ClaimClass) > 0 {
+		claim.Annotations = map[string]string{storageClassAnnotation: v.ClaimClass}
+	}
+	return claim
 }
 
 func (v *VolumeOptions) setVolumeSource(kv *kapi.Volume) error {
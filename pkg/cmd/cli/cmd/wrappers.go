@@ -141,7 +141,10 @@ JSON and YAML formats are accepted.`
   $ %[1]s create -f pod.json
 
   # Create a pod based on the JSON passed into stdin.
-  $ cat pod.json | %[1]s create -f -`
+  $ cat pod.json | %[1]s create -f -
+
+  # Create a new empty image stream.
+  $ %[1]s create imagestream ruby-app`
 )
 
 // NewCmdCreate is a wrapper for the Kubernetes cli create command
@@ -149,6 +152,7 @@ func NewCmdCreate(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	cmd := kcmd.NewCmdCreate(f.Factory, out)
 	cmd.Long = createLong
 	cmd.Example = fmt.Sprintf(createExample, fullName)
+	cmd.AddCommand(NewCmdCreateImageStream(CreateImageStreamRecommendedName, fullName, f, out))
 	return cmd
 }
 
@@ -268,6 +272,33 @@ func NewCmdScale(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Co
 	return cmd
 }
 
+const (
+	autoscaleLong = `Autoscale a deployment config or replication controller
+
+Looks up a deployment config or replication controller by name and creates an autoscaler that uses
+this deployment config or replication controller as a reference. An autoscaler can automatically
+increase or decrease number of pods deployed within the system as needed.
+
+An autoscaler of a deployment config scales the config itself. While a deployment is running the
+new replica count is applied once the deployment has completed.`
+
+	autoscaleExample = `  # Auto scale a deployment config "foo", with the number of pods between 2 to 10, target CPU utilization at a default value that server applies:
+  $ %[1]s autoscale dc/foo --min=2 --max=10
+
+  # Auto scale a replication controller "foo", with the number of pods between 1 to 5, target CPU utilization at 80%%
+  $ %[1]s autoscale rc/foo --max=5 --cpu-percent=80`
+)
+
+// NewCmdAutoscale is a wrapper for the Kubernetes cli autoscale command
+func NewCmdAutoscale(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	cmd := kcmd.NewCmdAutoscale(f.Factory, out)
+	cmd.Short = "Autoscale a deployment config or replication controller"
+	cmd.Long = autoscaleLong
+	cmd.Example = fmt.Sprintf(autoscaleExample, fullName)
+	cmd.ValidArgs = []string{"deploymentconfig", "replicationcontroller"}
+	return cmd
+}
+
 const (
 	stopLong = `Gracefully shut down a resource by id or filename
 
@@ -88,19 +88,29 @@ func GetContextNickname(namespace, clusterNick, userNick string) string {
 
 // CreateConfig takes a clientCfg and builds a config (kubeconfig style) from it.
 func CreateConfig(namespace string, clientCfg *client.Config) (*clientcmdapi.Config, error) {
-	clusterNick, err := GetClusterNicknameFromConfig(clientCfg)
-	if err != nil {
-		return nil, err
+	return CreateNamedConfig(namespace, "", "", clientCfg)
+}
+
+// CreateNamedConfig is like CreateConfig, but names the cluster and the context with the given
+// nicknames instead of generating them from the server and the user, when they are not empty.
+// The user stanza is named after the cluster nickname.
+func CreateNamedConfig(namespace, clusterNick, contextNick string, clientCfg *client.Config) (*clientcmdapi.Config, error) {
+	if len(clusterNick) == 0 {
+		nick, err := GetClusterNicknameFromConfig(clientCfg)
+		if err != nil {
+			return nil, err
+		}
+		clusterNick = nick
 	}
 
 	userNick, err := GetUserNicknameFromConfig(clientCfg)
 	if err != nil {
 		return nil, err
 	}
+	userNick = strings.SplitN(userNick, "/", 2)[0] + "/" + clusterNick
 
-	contextNick, err := GetContextNicknameFromConfig(namespace, clientCfg)
-	if err != nil {
-		return nil, err
+	if len(contextNick) == 0 {
+		contextNick = GetContextNickname(namespace, clusterNick, userNick)
 	}
 
 	config := clientcmdapi.NewConfig()
@@ -20,9 +20,12 @@ import (
 	"github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployedges "github.com/openshift/origin/pkg/deploy/graph"
+	deployanalysis "github.com/openshift/origin/pkg/deploy/graph/analysis"
 	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	imageedges "github.com/openshift/origin/pkg/image/graph"
+	imagegraph "github.com/openshift/origin/pkg/image/graph/nodes"
 )
 
 // DeploymentConfigDescriber generates information about a DeploymentConfig
@@ -36,6 +39,7 @@ type deploymentDescriberClient interface {
 	listDeployments(namespace string, selector labels.Selector) (*kapi.ReplicationControllerList, error)
 	listPods(namespace string, selector labels.Selector) (*kapi.PodList, error)
 	listEvents(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error)
+	getImageStream(namespace, name string) (*imageapi.ImageStream, error)
 }
 
 type genericDeploymentDescriberClient struct {
@@ -44,6 +48,7 @@ type genericDeploymentDescriberClient struct {
 	listDeploymentsFunc     func(namespace string, selector labels.Selector) (*kapi.ReplicationControllerList, error)
 	listPodsFunc            func(namespace string, selector labels.Selector) (*kapi.PodList, error)
 	listEventsFunc          func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error)
+	getImageStreamFunc      func(namespace, name string) (*imageapi.ImageStream, error)
 }
 
 func (c *genericDeploymentDescriberClient) getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error) {
@@ -66,6 +71,10 @@ func (c *genericDeploymentDescriberClient) listEvents(deploymentConfig *deployap
 	return c.listEventsFunc(deploymentConfig)
 }
 
+func (c *genericDeploymentDescriberClient) getImageStream(namespace, name string) (*imageapi.ImageStream, error) {
+	return c.getImageStreamFunc(namespace, name)
+}
+
 // NewDeploymentConfigDescriberForConfig returns a new DeploymentConfigDescriber
 // for a DeploymentConfig
 func NewDeploymentConfigDescriberForConfig(client client.Interface, kclient kclient.Interface, config *deployapi.DeploymentConfig) *DeploymentConfigDescriber {
@@ -86,6 +95,9 @@ func NewDeploymentConfigDescriberForConfig(client client.Interface, kclient kcli
 			listEventsFunc: func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error) {
 				return kclient.Events(config.Namespace).Search(config)
 			},
+			getImageStreamFunc: func(namespace, name string) (*imageapi.ImageStream, error) {
+				return client.ImageStreams(namespace).Get(name)
+			},
 		},
 	}
 }
@@ -109,6 +121,9 @@ func NewDeploymentConfigDescriber(client client.Interface, kclient kclient.Inter
 			listEventsFunc: func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error) {
 				return kclient.Events(deploymentConfig.Namespace).Search(deploymentConfig)
 			},
+			getImageStreamFunc: func(namespace, name string) (*imageapi.ImageStream, error) {
+				return client.ImageStreams(namespace).Get(name)
+			},
 		},
 	}
 }
@@ -142,6 +157,7 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 			fmt.Fprintf(out, "Warning:\t%s\n", deploymentConfig.Status.Details.Message)
 		}
 		deploymentName := deployutil.LatestDeploymentNameForConfig(deploymentConfig)
+		deployments := []kapi.ReplicationController{}
 		deployment, err := d.client.getDeployment(namespace, deploymentName)
 		if err != nil {
 			if kerrors.IsNotFound(err) {
@@ -150,11 +166,13 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 				formatString(out, "Latest Deployment", fmt.Sprintf("error: %v", err))
 			}
 		} else {
+			deployments = append(deployments, *deployment)
 			header := fmt.Sprintf("Deployment #%d (latest)", deployutil.DeploymentVersionFor(deployment))
 			printDeploymentRc(deployment, d.client, out, header, true)
 		}
 		deploymentsHistory, err := d.client.listDeployments(namespace, labels.Everything())
 		if err == nil {
+			deployments = append(deployments, deploymentsHistory.Items...)
 			sorted := rcSorter{}
 			sorted = append(sorted, deploymentsHistory.Items...)
 			sort.Sort(sorted)
@@ -166,6 +184,8 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 			}
 		}
 
+		printDeploymentConfigMarkers(d.findMarkers(deploymentConfig, deployments), out)
+
 		if events != nil {
 			kctl.DescribeEvents(events, out)
 		}
@@ -173,15 +193,76 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 	})
 }
 
+// findMarkers runs the deployment config analyzers of 'oc status' against the config and its deployments to
+// explain why it is not deployed. Image triggers are only checked when all their image streams could be
+// retrieved, to avoid reporting image streams the user cannot see as missing.
+func (d *DeploymentConfigDescriber) findMarkers(config *deployapi.DeploymentConfig, deployments []kapi.ReplicationController) []graph.Marker {
+	g := graph.New()
+	dcNode := deploygraph.EnsureDeploymentConfigNode(g, config)
+	for i := range deployments {
+		kubegraph.EnsureReplicationControllerNode(g, &deployments[i])
+	}
+
+	triggersKnown := true
+	for _, trigger := range config.Spec.Triggers {
+		params := trigger.ImageChangeParams
+		if params == nil || params.From.Kind != "ImageStreamTag" {
+			continue
+		}
+		namespace := params.From.Namespace
+		if len(namespace) == 0 {
+			namespace = config.Namespace
+		}
+		name, _, _ := imageapi.SplitImageStreamTag(params.From.Name)
+		stream, err := d.client.getImageStream(namespace, name)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				triggersKnown = false
+			}
+			continue
+		}
+		imagegraph.EnsureImageStreamNode(g, stream)
+		imagegraph.EnsureAllImageStreamTagNodes(g, stream)
+	}
+
+	deployedges.AddTriggerEdges(g, dcNode)
+	deployedges.AddDeploymentEdges(g, dcNode)
+	imageedges.AddAllImageStreamRefEdges(g)
+
+	f := namespacedFormatter{currentNamespace: config.Namespace}
+	markers := []graph.Marker{}
+	if triggersKnown {
+		markers = append(markers, deployanalysis.FindDeploymentConfigTriggerErrors(g, f)...)
+	}
+	return append(markers, deployanalysis.FindStalledDeploymentConfigs(g, f)...)
+}
+
+func printDeploymentConfigMarkers(markers []graph.Marker, w io.Writer) {
+	if len(markers) == 0 {
+		return
+	}
+	fmt.Fprint(w, "Problems:\n")
+	for _, marker := range markers {
+		fmt.Fprintf(w, "  * %s\n", marker.Message)
+		if len(marker.Suggestion) > 0 {
+			fmt.Fprintf(w, "    try: %s\n", marker.Suggestion)
+		}
+	}
+}
+
 func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	switch strategy.Type {
 	case deployapi.DeploymentStrategyTypeRecreate:
 		if strategy.RecreateParams != nil {
 			pre := strategy.RecreateParams.Pre
+			mid := strategy.RecreateParams.Mid
 			post := strategy.RecreateParams.Post
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
+			if mid != nil {
+				printHook("Mid-deployment", mid, w)
+			}
 			if post != nil {
 				printHook("Post-deployment", post, w)
 			}
@@ -196,6 +277,9 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 			if post != nil {
 				printHook("Post-deployment", post, w)
 			}
+			if canary := strategy.RollingParams.Canary; canary != nil {
+				printCanary(canary, w)
+			}
 		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		fmt.Fprintf(w, "\t  Image:\t%s\n", strategy.CustomParams.Image)
@@ -219,6 +303,19 @@ func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 	}
 }
 
+func printCanary(canary *deployapi.CanaryCheck, w io.Writer) {
+	switch {
+	case canary.ExecNewPod != nil:
+		fmt.Fprintf(w, "\t  Canary check (pod type):\n")
+		fmt.Fprintf(w, "\t    Container:\t%s\n", canary.ExecNewPod.ContainerName)
+		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(canary.ExecNewPod.Command, " "))
+		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(canary.ExecNewPod.Env)))
+	case canary.HTTPGet != nil:
+		fmt.Fprintf(w, "\t  Canary check (HTTP type):\n")
+		fmt.Fprintf(w, "\t    URL:\t%s\n", canary.HTTPGet.URL)
+	}
+}
+
 func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Writer) {
 	if len(triggers) == 0 {
 		formatString(w, "Triggers", "<none>")
@@ -331,6 +428,9 @@ func NewLatestDeploymentsDescriber(client client.Interface, kclient kclient.Inte
 			listEventsFunc: func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error) {
 				return kclient.Events(deploymentConfig.Namespace).Search(deploymentConfig)
 			},
+			getImageStreamFunc: func(namespace, name string) (*imageapi.ImageStream, error) {
+				return client.ImageStreams(namespace).Get(name)
+			},
 		},
 	}
 }
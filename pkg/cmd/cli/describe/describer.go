@@ -28,7 +28,9 @@ import (
 	"github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 	userapi "github.com/openshift/origin/pkg/user/api"
@@ -36,28 +38,31 @@ import (
 
 func describerMap(c *client.Client, kclient kclient.Interface, host string) map[unversioned.GroupKind]kctl.Describer {
 	m := map[unversioned.GroupKind]kctl.Describer{
-		buildapi.Kind("Build"):                        &BuildDescriber{c, kclient},
-		buildapi.Kind("BuildConfig"):                  &BuildConfigDescriber{c, host},
-		deployapi.Kind("DeploymentConfig"):            NewDeploymentConfigDescriber(c, kclient),
-		authorizationapi.Kind("Identity"):             &IdentityDescriber{c},
-		imageapi.Kind("Image"):                        &ImageDescriber{c},
-		imageapi.Kind("ImageStream"):                  &ImageStreamDescriber{c},
-		imageapi.Kind("ImageStreamTag"):               &ImageStreamTagDescriber{c},
-		imageapi.Kind("ImageStreamImage"):             &ImageStreamImageDescriber{c},
-		routeapi.Kind("Route"):                        &RouteDescriber{c, kclient},
-		projectapi.Kind("Project"):                    &ProjectDescriber{c, kclient},
-		templateapi.Kind("Template"):                  &TemplateDescriber{c, meta.NewAccessor(), kapi.Scheme, nil},
-		authorizationapi.Kind("Policy"):               &PolicyDescriber{c},
-		authorizationapi.Kind("PolicyBinding"):        &PolicyBindingDescriber{c},
-		authorizationapi.Kind("RoleBinding"):          &RoleBindingDescriber{c},
-		authorizationapi.Kind("Role"):                 &RoleDescriber{c},
-		authorizationapi.Kind("ClusterPolicy"):        &ClusterPolicyDescriber{c},
-		authorizationapi.Kind("ClusterPolicyBinding"): &ClusterPolicyBindingDescriber{c},
-		authorizationapi.Kind("ClusterRoleBinding"):   &ClusterRoleBindingDescriber{c},
-		authorizationapi.Kind("ClusterRole"):          &ClusterRoleDescriber{c},
-		userapi.Kind("User"):                          &UserDescriber{c},
-		userapi.Kind("Group"):                         &GroupDescriber{c.Groups()},
-		userapi.Kind("UserIdentityMapping"):           &UserIdentityMappingDescriber{c},
+		buildapi.Kind("Build"):                          &BuildDescriber{c, kclient},
+		buildapi.Kind("BuildConfig"):                    &BuildConfigDescriber{c, host},
+		deployapi.Kind("DeploymentConfig"):              NewDeploymentConfigDescriber(c, kclient),
+		authorizationapi.Kind("Identity"):               &IdentityDescriber{c},
+		imageapi.Kind("Image"):                          &ImageDescriber{c},
+		imageapi.Kind("ImageStream"):                    &ImageStreamDescriber{c},
+		imageapi.Kind("ImageStreamTag"):                 &ImageStreamTagDescriber{c},
+		imageapi.Kind("ImageStreamImage"):               &ImageStreamImageDescriber{c},
+		routeapi.Kind("Route"):                          &RouteDescriber{c, kclient},
+		projectapi.Kind("Project"):                      &ProjectDescriber{c, kclient},
+		quotaapi.Kind("ClusterResourceQuota"):           &ClusterResourceQuotaDescriber{c},
+		podpresetapi.Kind("PodPreset"):                  &PodPresetDescriber{c},
+		authorizationapi.Kind("RoleBindingRestriction"): &RoleBindingRestrictionDescriber{c},
+		templateapi.Kind("Template"):                    &TemplateDescriber{c, meta.NewAccessor(), kapi.Scheme, nil},
+		authorizationapi.Kind("Policy"):                 &PolicyDescriber{c},
+		authorizationapi.Kind("PolicyBinding"):          &PolicyBindingDescriber{c},
+		authorizationapi.Kind("RoleBinding"):            &RoleBindingDescriber{c},
+		authorizationapi.Kind("Role"):                   &RoleDescriber{c},
+		authorizationapi.Kind("ClusterPolicy"):          &ClusterPolicyDescriber{c},
+		authorizationapi.Kind("ClusterPolicyBinding"):   &ClusterPolicyBindingDescriber{c},
+		authorizationapi.Kind("ClusterRoleBinding"):     &ClusterRoleBindingDescriber{c},
+		authorizationapi.Kind("ClusterRole"):            &ClusterRoleDescriber{c},
+		userapi.Kind("User"):                            &UserDescriber{c},
+		userapi.Kind("Group"):                           &GroupDescriber{c.Groups()},
+		userapi.Kind("UserIdentityMapping"):             &UserIdentityMappingDescriber{c},
 	}
 	return m
 }
@@ -634,6 +639,9 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 		}
 		formatString(out, "TLS Termination", tlsTerm)
 		formatString(out, "Insecure Policy", insecurePolicy)
+		if route.Spec.TLS != nil && len(route.Spec.TLS.SecretName) > 0 {
+			formatString(out, "TLS Secret", route.Spec.TLS.SecretName)
+		}
 		return nil
 	})
 }
@@ -673,8 +681,15 @@ func (d *ProjectDescriber) Describe(namespace, name string) (string, error) {
 		formatMeta(out, project.ObjectMeta)
 		formatString(out, "Display Name", project.Annotations[projectapi.ProjectDisplayName])
 		formatString(out, "Description", project.Annotations[projectapi.ProjectDescription])
+		formatString(out, "Contact", project.Annotations[projectapi.ProjectContact])
 		formatString(out, "Status", project.Status.Phase)
 		formatString(out, "Node Selector", nodeSelector)
+		if allocation := project.Status.SecurityAllocation; allocation != nil {
+			fmt.Fprintf(out, "Security Allocation:\n")
+			fmt.Fprintf(out, "\tUID Range:\t%s\n", allocation.UIDRange)
+			fmt.Fprintf(out, "\tSupplemental Groups:\t%s\n", allocation.SupplementalGroups)
+			fmt.Fprintf(out, "\tMCS Label:\t%s\n", allocation.MCSLabel)
+		}
 		if len(resourceQuotaList.Items) == 0 {
 			formatString(out, "Quota", "")
 		} else {
@@ -1001,8 +1016,136 @@ func (d *GroupDescriber) Describe(namespace, name string) (string, error) {
 	})
 }
 
+// PodPresetDescriber generates information about a PodPreset
+type PodPresetDescriber struct {
+	osClient client.Interface
+}
+
+// Describe returns the description of a pod preset
+func (d *PodPresetDescriber) Describe(namespace, name string) (string, error) {
+	preset, err := d.osClient.PodPresets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, preset.ObjectMeta)
+		formatString(out, "Selector", formatLabels(preset.Spec.Selector))
+		for i, env := range preset.Spec.Env {
+			if i == 0 {
+				formatString(out, "Environment", formatEnv(env))
+			} else {
+				formatString(out, "", formatEnv(env))
+			}
+		}
+		for _, volume := range preset.Spec.Volumes {
+			source := "<unknown>"
+			switch {
+			case volume.Secret != nil:
+				source = fmt.Sprintf("secret %s", volume.Secret.SecretName)
+			case volume.PersistentVolumeClaim != nil:
+				source = fmt.Sprintf("persistent volume claim %s", volume.PersistentVolumeClaim.ClaimName)
+			case volume.EmptyDir != nil:
+				source = "empty directory"
+			}
+			formatString(out, "Volume "+volume.Name, source)
+		}
+		for _, mount := range preset.Spec.VolumeMounts {
+			mode := "rw"
+			if mount.ReadOnly {
+				mode = "ro"
+			}
+			formatString(out, "Mount "+mount.MountPath, fmt.Sprintf("%s (%s)", mount.Name, mode))
+		}
+		return nil
+	})
+}
+
+// RoleBindingRestrictionDescriber generates information about a RoleBindingRestriction
+type RoleBindingRestrictionDescriber struct {
+	osClient client.Interface
+}
+
+// Describe returns the description of a role binding restriction
+func (d *RoleBindingRestrictionDescriber) Describe(namespace, name string) (string, error) {
+	restriction, err := d.osClient.RoleBindingRestrictions(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, restriction.ObjectMeta)
+		switch spec := restriction.Spec; {
+		case spec.UserRestriction != nil:
+			formatString(out, "Subject Type", "User")
+			formatString(out, "Users", strings.Join(spec.UserRestriction.Users, ", "))
+			formatString(out, "Users in Groups", strings.Join(spec.UserRestriction.Groups, ", "))
+			formatString(out, "Users with Labels", formatLabels(spec.UserRestriction.Selector))
+		case spec.GroupRestriction != nil:
+			formatString(out, "Subject Type", "Group")
+			formatString(out, "Groups", strings.Join(spec.GroupRestriction.Groups, ", "))
+			formatString(out, "Groups with Labels", formatLabels(spec.GroupRestriction.Selector))
+		case spec.ServiceAccountRestriction != nil:
+			formatString(out, "Subject Type", "ServiceAccount")
+			serviceAccounts := []string{}
+			for _, serviceAccount := range spec.ServiceAccountRestriction.ServiceAccounts {
+				namespace := serviceAccount.Namespace
+				if len(namespace) == 0 {
+					namespace = restriction.Namespace
+				}
+				serviceAccounts = append(serviceAccounts, namespace+"/"+serviceAccount.Name)
+			}
+			formatString(out, "Service Accounts", strings.Join(serviceAccounts, ", "))
+			formatString(out, "Namespaces", strings.Join(spec.ServiceAccountRestriction.Namespaces, ", "))
+		}
+		return nil
+	})
+}
+
 // policy describers
 
+// ClusterResourceQuotaDescriber generates information about a ClusterResourceQuota
+type ClusterResourceQuotaDescriber struct {
+	osClient client.Interface
+}
+
+// Describe returns the description of a cluster resource quota
+func (d *ClusterResourceQuotaDescriber) Describe(namespace, name string) (string, error) {
+	quota, err := d.osClient.ClusterResourceQuotas().Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	return tabbedString(func(out *tabwriter.Writer) error {
+		formatMeta(out, quota.ObjectMeta)
+		formatString(out, "Label Selector", formatLabels(quota.Spec.Selector.LabelSelector))
+		formatString(out, "Annotation Selector", formatLabels(quota.Spec.Selector.AnnotationSelector))
+
+		namespaces := []string{}
+		for _, status := range quota.Status.Namespaces {
+			namespaces = append(namespaces, status.Namespace)
+		}
+		sort.Strings(namespaces)
+		formatString(out, "Namespaces", strings.Join(namespaces, ", "))
+
+		fmt.Fprintf(out, "Resource\tUsed\tHard\n")
+		fmt.Fprintf(out, "--------\t----\t----\n")
+
+		resources := []kapi.ResourceName{}
+		for resource := range quota.Spec.Quota.Hard {
+			resources = append(resources, resource)
+		}
+		sort.Sort(kctl.SortableResourceNames(resources))
+
+		for _, resource := range resources {
+			hardQuantity := quota.Spec.Quota.Hard[resource]
+			usedQuantity := quota.Status.Total.Used[resource]
+			fmt.Fprintf(out, "%v\t%v\t%v\n", resource, usedQuantity.String(), hardQuantity.String())
+		}
+		return nil
+	})
+}
+
 // PolicyDescriber generates information about a Project
 type PolicyDescriber struct {
 	client.Interface
@@ -1062,6 +1205,31 @@ func describePolicyRule(out *tabwriter.Writer, rule authorizationapi.PolicyRule,
 	)
 }
 
+// DescribeSelfSubjectRulesReview returns the rules and deny rules of the review, preceded by the evaluation error if there
+// was one
+func DescribeSelfSubjectRulesReview(review *authorizationapi.SelfSubjectRulesReview) (string, error) {
+	return tabbedString(func(out *tabwriter.Writer) error {
+		if len(review.Status.EvaluationError) > 0 {
+			formatString(out, "Evaluation Error", review.Status.EvaluationError)
+		}
+
+		fmt.Fprint(out, policyRuleHeadings+"\n")
+		for _, rule := range review.Status.Rules {
+			describePolicyRule(out, rule, "")
+		}
+
+		if len(review.Status.DenyRules) > 0 {
+			fmt.Fprint(out, "Deny Rules:\n")
+			fmt.Fprint(out, policyRuleHeadings+"\n")
+			for _, rule := range review.Status.DenyRules {
+				describePolicyRule(out, rule, "")
+			}
+		}
+
+		return nil
+	})
+}
+
 // RoleDescriber generates information about a Project
 type RoleDescriber struct {
 	client.Interface
@@ -1082,12 +1250,28 @@ func DescribeRole(role *authorizationapi.Role) (string, error) {
 	return tabbedString(func(out *tabwriter.Writer) error {
 		formatMeta(out, role.ObjectMeta)
 
+		if len(role.IncludedRoles) > 0 {
+			includedRoles := []string{}
+			for _, includedRole := range role.IncludedRoles {
+				includedRoles = append(includedRoles, includedRole.Namespace+"/"+includedRole.Name)
+			}
+			formatString(out, "Included Roles", strings.Join(includedRoles, ", "))
+		}
+
 		fmt.Fprint(out, policyRuleHeadings+"\n")
 		for _, rule := range role.Rules {
 			describePolicyRule(out, rule, "")
 
 		}
 
+		if len(role.DenyRules) > 0 {
+			fmt.Fprint(out, "Deny Rules:\n")
+			fmt.Fprint(out, policyRuleHeadings+"\n")
+			for _, rule := range role.DenyRules {
+				describePolicyRule(out, rule, "")
+			}
+		}
+
 		return nil
 	})
 }
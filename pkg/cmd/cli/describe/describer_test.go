@@ -7,6 +7,7 @@ import (
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/kubectl"
@@ -24,6 +25,7 @@ import (
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+	securityapi "github.com/openshift/origin/pkg/security/api"
 )
 
 type describeClient struct {
@@ -41,6 +43,7 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BuildLogOptions{}),                       // normal users don't ever look at these
 	reflect.TypeOf(&buildapi.BinaryBuildRequestOptions{}),             // normal users don't ever look at these
 	reflect.TypeOf(&buildapi.BuildRequest{}),                          // normal users don't ever look at these
+	reflect.TypeOf(&buildapi.BuildHistory{}),                          // not a top level resource
 	reflect.TypeOf(&deployapi.DeploymentConfigRollback{}),             // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLog{}),                        // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),                 // normal users don't ever look at these
@@ -59,6 +62,10 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&authorizationapi.ResourceAccessReview{}),
 	reflect.TypeOf(&authorizationapi.LocalSubjectAccessReview{}),
 	reflect.TypeOf(&authorizationapi.LocalResourceAccessReview{}),
+	reflect.TypeOf(&authorizationapi.SelfSubjectRulesReview{}),
+	reflect.TypeOf(&securityapi.PodSecurityPolicySubjectReview{}),
+	reflect.TypeOf(&securityapi.PodSecurityPolicyReview{}),
+	reflect.TypeOf(&oauthapi.ServiceAccountTokenRequest{}),
 }
 
 // MissingDescriberCoverageExceptions is the list of types that were missing describer methods when I started
@@ -158,6 +165,9 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 			listEventsFunc: func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error) {
 				return eventList, nil
 			},
+			getImageStreamFunc: func(namespace, name string) (*imageapi.ImageStream, error) {
+				return nil, kerrors.NewNotFound("ImageStream", name)
+			},
 		},
 	}
 
@@ -213,6 +223,46 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 	describe()
 }
 
+func TestDeploymentConfigDescriberProblems(t *testing.T) {
+	config := deployapitest.OkDeploymentConfig(1)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusFailed)
+	deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentFailedDeployerPodNoLongerExists
+
+	d := &DeploymentConfigDescriber{
+		client: &genericDeploymentDescriberClient{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return config, nil
+			},
+			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
+			listDeploymentsFunc: func(namespace string, selector labels.Selector) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{}, nil
+			},
+			listPodsFunc: func(namespace string, selector labels.Selector) (*kapi.PodList, error) {
+				return &kapi.PodList{}, nil
+			},
+			listEventsFunc: func(deploymentConfig *deployapi.DeploymentConfig) (*kapi.EventList, error) {
+				return &kapi.EventList{}, nil
+			},
+			getImageStreamFunc: func(namespace, name string) (*imageapi.ImageStream, error) {
+				return nil, kerrors.NewNotFound("ImageStream", name)
+			},
+		},
+	}
+
+	out, err := d.Describe("test", "config")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{"has failed: " + strings.TrimSuffix(deployapi.DeploymentFailedDeployerPodNoLongerExists, "."), "oc deploy config --retry"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in output:\n%s", expected, out)
+		}
+	}
+}
+
 func TestDescribeBuildDuration(t *testing.T) {
 	type testBuild struct {
 		build  *buildapi.Build
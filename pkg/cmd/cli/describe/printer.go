@@ -19,7 +19,9 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 	templateapi "github.com/openshift/origin/pkg/template/api"
@@ -59,6 +61,12 @@ var (
 	hostSubnetColumns     = []string{"NAME", "HOST", "HOST IP", "SUBNET"}
 	netNamespaceColumns   = []string{"NAME", "NETID"}
 	clusterNetworkColumns = []string{"NAME", "NETWORK", "HOST SUBNET LENGTH", "SERVICE NETWORK"}
+
+	clusterResourceQuotaColumns = []string{"NAME", "LABEL SELECTOR", "ANNOTATION SELECTOR"}
+
+	podPresetColumns = []string{"NAME", "SELECTOR", "ENV", "VOLUMES"}
+
+	roleBindingRestrictionColumns = []string{"NAME", "SUBJECT TYPE"}
 )
 
 // NewHumanReadablePrinter returns a new HumanReadablePrinter
@@ -129,6 +137,15 @@ func NewHumanReadablePrinter(noHeaders, withNamespace, wide bool, showAll bool,
 	p.Handler(clusterNetworkColumns, printClusterNetwork)
 	p.Handler(clusterNetworkColumns, printClusterNetworkList)
 
+	p.Handler(clusterResourceQuotaColumns, printClusterResourceQuota)
+	p.Handler(clusterResourceQuotaColumns, printClusterResourceQuotaList)
+
+	p.Handler(podPresetColumns, printPodPreset)
+	p.Handler(podPresetColumns, printPodPresetList)
+
+	p.Handler(roleBindingRestrictionColumns, printRoleBindingRestriction)
+	p.Handler(roleBindingRestrictionColumns, printRoleBindingRestrictionList)
+
 	return p
 }
 
@@ -639,7 +656,10 @@ func printOAuthClientAuthorizationList(list *oauthapi.OAuthClientAuthorizationLi
 
 func printOAuthAccessToken(token *oauthapi.OAuthAccessToken, w io.Writer, opts kctl.PrintOptions) error {
 	created := token.CreationTimestamp
-	expires := created.Add(time.Duration(token.ExpiresIn) * time.Second)
+	expires := "never"
+	if token.ExpiresIn > 0 {
+		expires = created.Add(time.Duration(token.ExpiresIn) * time.Second).String()
+	}
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.Name, token.UserName, token.ClientName, created, expires, token.RedirectURI, strings.Join(token.Scopes, ","))
 	return err
 }
@@ -757,3 +777,73 @@ func printClusterNetworkList(list *sdnapi.ClusterNetworkList, w io.Writer, opts
 	}
 	return nil
 }
+
+func printClusterResourceQuota(quota *quotaapi.ClusterResourceQuota, w io.Writer, opts kctl.PrintOptions) error {
+	selector := quota.Spec.Selector
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", quota.Name, formatLabels(selector.LabelSelector), formatLabels(selector.AnnotationSelector))
+	return err
+}
+
+func printClusterResourceQuotaList(list *quotaapi.ClusterResourceQuotaList, w io.Writer, opts kctl.PrintOptions) error {
+	for i := range list.Items {
+		if err := printClusterResourceQuota(&list.Items[i], w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPodPreset(preset *podpresetapi.PodPreset, w io.Writer, opts kctl.PrintOptions) error {
+	if opts.WithNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", preset.Namespace); err != nil {
+			return err
+		}
+	}
+	env := []string{}
+	for _, e := range preset.Spec.Env {
+		env = append(env, e.Name)
+	}
+	volumes := []string{}
+	for _, v := range preset.Spec.Volumes {
+		volumes = append(volumes, v.Name)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", preset.Name, formatLabels(preset.Spec.Selector), strings.Join(env, ","), strings.Join(volumes, ","))
+	return err
+}
+
+func printPodPresetList(list *podpresetapi.PodPresetList, w io.Writer, opts kctl.PrintOptions) error {
+	for i := range list.Items {
+		if err := printPodPreset(&list.Items[i], w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printRoleBindingRestriction(restriction *authorizationapi.RoleBindingRestriction, w io.Writer, opts kctl.PrintOptions) error {
+	if opts.WithNamespace {
+		if _, err := fmt.Fprintf(w, "%s\t", restriction.Namespace); err != nil {
+			return err
+		}
+	}
+	subjectType := "<none>"
+	switch {
+	case restriction.Spec.UserRestriction != nil:
+		subjectType = "User"
+	case restriction.Spec.GroupRestriction != nil:
+		subjectType = "Group"
+	case restriction.Spec.ServiceAccountRestriction != nil:
+		subjectType = "ServiceAccount"
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\n", restriction.Name, subjectType)
+	return err
+}
+
+func printRoleBindingRestrictionList(list *authorizationapi.RoleBindingRestrictionList, w io.Writer, opts kctl.PrintOptions) error {
+	for i := range list.Items {
+		if err := printRoleBindingRestriction(&list.Items[i], w, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
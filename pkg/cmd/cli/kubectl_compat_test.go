@@ -16,9 +16,8 @@ import (
 // MissingCommands is the list of commands we're already missing.
 // NEVER ADD TO THIS LIST
 // TODO kill this list
-var MissingCommands = sets.NewString("namespace", "rolling-update", "cluster-info", "api-versions",
-	"apply",     // we don't want to support this implementation
-	"autoscale", // TODO
+var MissingCommands = sets.NewString("namespace", "rolling-update", "cluster-info",
+	"apply", // we don't want to support this implementation
 )
 
 // WhitelistedCommands is the list of commands we're never going to have in oc
@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"fmt"
+	"io"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	LinkSecretRecommendedName = "link"
+
+	linkSecretLong = `
+Link secrets to a service account
+
+Linking a secret enables a service account to automatically use that secret for some forms of authentication.
+Mount secrets can be used inside of the pods of the service account, or as push, pull, or source secrets of
+its builds. Pull secrets are used by the nodes to pull images on behalf of the service account.`
+
+	linkSecretExample = `  # Add an image pull secret to a service account to automatically use it for pulling pod images:
+  $ %[1]s serviceaccount-name pull-secret --for=pull
+
+  # Add an image pull secret to a service account to automatically use it for both pulling and pushing build images:
+  $ %[1]s builder builder-image-secret --for=pull,mount
+
+  # If the cluster's serviceAccountConfig is operating with limitSecretReferences: True, secrets must be added to the pod's service account whitelist in order to be available to the pod:
+  $ %[1]s pod-sa pod-secret`
+)
+
+// NewCmdLinkSecret creates a command object for linking secrets to a service account
+func NewCmdLinkSecret(name, fullName string, f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	o := &AddSecretOptions{Out: out}
+	var typeFlags []string
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s serviceaccount-name secret-name [another-secret-name]...", name),
+		Short:   "Link secrets to a ServiceAccount",
+		Long:    linkSecretLong,
+		Example: fmt.Sprintf(linkSecretExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := o.Complete(f, args, typeFlags); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.AddSecrets(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&typeFlags, "for", []string{"mount"}, "type of secret to link: mount or pull")
+
+	return cmd
+}
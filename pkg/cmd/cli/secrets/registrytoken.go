@@ -0,0 +1,165 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/spf13/cobra"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer/scope"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
+)
+
+const (
+	CreateRegistryTokenSecretRecommendedName = "new-registry-token"
+
+	createRegistryTokenLong = `
+Create a new registry token secret
+
+Registry token secrets hold a long-lived token of a service account that only allows pulling images of
+this project from the integrated registry, or pushing them too with --push. Systems outside of the cluster
+can log in to the registry with the token instead of a user token that expires:
+  'docker login DOCKER_REGISTRY_SERVER --username=SERVICE_ACCOUNT --password=TOKEN --email=any'.
+
+The token is added to the secret once it is created; display it with 'describe'. The token is valid until
+the secret or its service account is deleted, and it cannot do more than the service account is allowed to.`
+
+	createRegistryTokenExample = `  # Create a token of the service account 'robot' allowed to pull images:
+  $ %[1]s SECRET --service-account=robot
+
+  # Create a token of the service account 'robot' allowed to pull and push images:
+  $ %[1]s SECRET --service-account=robot --push
+
+  # Display the token:
+  $ %[2]s SECRET`
+)
+
+type CreateRegistryTokenOptions struct {
+	SecretName     string
+	ServiceAccount string
+	Push           bool
+	Namespace      string
+
+	SecretsInterface         client.SecretsInterface
+	ServiceAccountsInterface client.ServiceAccountsInterface
+
+	Out io.Writer
+}
+
+// NewCmdCreateRegistryTokenSecret creates a command object for making a scoped service account token secret
+func NewCmdCreateRegistryTokenSecret(name, fullName string, f *cmdutil.Factory, out io.Writer, ocDescribeFullName string) *cobra.Command {
+	o := &CreateRegistryTokenOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s SECRET --service-account=SERVICE_ACCOUNT [--push]", name),
+		Short:   "Create a new secret holding a service account token for the registry",
+		Long:    createRegistryTokenLong,
+		Example: fmt.Sprintf(createRegistryTokenExample, fullName, ocDescribeFullName+" secret"),
+		Run: func(c *cobra.Command, args []string) {
+			if err := o.Complete(f, args); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.CreateRegistryTokenSecret(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&o.ServiceAccount, "service-account", "", "Service account the token authenticates as")
+	cmd.Flags().BoolVar(&o.Push, "push", false, "Allow the token to push images as well as pull them")
+
+	return cmd
+}
+
+func (o CreateRegistryTokenOptions) CreateRegistryTokenSecret() error {
+	serviceAccount, err := o.ServiceAccountsInterface.Get(o.ServiceAccount)
+	if err != nil {
+		return err
+	}
+
+	secret := o.NewRegistryTokenSecret(serviceAccount)
+	if _, err := o.SecretsInterface.Create(secret); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.GetOut(), "secret/%s\n", secret.Name)
+
+	return nil
+}
+
+// NewRegistryTokenSecret returns a token secret of serviceAccount scoped to the registry access of the options
+func (o CreateRegistryTokenOptions) NewRegistryTokenSecret(serviceAccount *api.ServiceAccount) *api.Secret {
+	role := bootstrappolicy.ImagePullerRoleName
+	if o.Push {
+		role = bootstrappolicy.ImageBuilderRoleName
+	}
+	// the registry looks up the user and checks its access to image streams
+	scopes := []string{scope.UserInfo, scope.UserAccessCheck, scope.ClusterRoleIndicator + role + ":" + o.Namespace}
+
+	secret := &api.Secret{}
+	secret.Name = o.SecretName
+	secret.Type = api.SecretTypeServiceAccountToken
+	secret.Annotations = map[string]string{
+		api.ServiceAccountNameKey:                   serviceAccount.Name,
+		api.ServiceAccountUIDKey:                    string(serviceAccount.UID),
+		authapi.ServiceAccountTokenScopesAnnotation: strings.Join(scopes, ","),
+	}
+
+	return secret
+}
+
+func (o *CreateRegistryTokenOptions) Complete(f *cmdutil.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("must have exactly one argument: secret name")
+	}
+	o.SecretName = args[0]
+
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	o.SecretsInterface = client.Secrets(o.Namespace)
+	o.ServiceAccountsInterface = client.ServiceAccounts(o.Namespace)
+
+	return nil
+}
+
+func (o CreateRegistryTokenOptions) Validate() error {
+	if len(o.SecretName) == 0 {
+		return errors.New("secret name must be present")
+	}
+	if len(o.ServiceAccount) == 0 {
+		return errors.New("service-account must be present")
+	}
+	if o.SecretsInterface == nil || o.ServiceAccountsInterface == nil {
+		return errors.New("secrets and service accounts interfaces must be present")
+	}
+
+	return nil
+}
+
+func (o CreateRegistryTokenOptions) GetOut() io.Writer {
+	if o.Out == nil {
+		return ioutil.Discard
+	}
+
+	return o.Out
+}
@@ -0,0 +1,173 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	UnlinkSecretRecommendedName = "unlink"
+
+	unlinkSecretLong = `
+Unlink secrets from a service account
+
+Unlinking a secret removes it from both the mount secrets and the image pull secrets of a service account.
+The secrets themselves are not deleted, and secrets that were already deleted can be unlinked.`
+
+	unlinkSecretExample = `  # Unlink a secret from a service account:
+  $ %[1]s serviceaccount-name secret-name another-secret-name ...`
+)
+
+type UnlinkSecretOptions struct {
+	TargetName  string
+	SecretNames []string
+
+	Namespace string
+
+	ClientInterface client.Interface
+
+	Out io.Writer
+}
+
+// NewCmdUnlinkSecret creates a command object for removing secret references from a service account
+func NewCmdUnlinkSecret(name, fullName string, f *cmdutil.Factory, out io.Writer) *cobra.Command {
+	o := &UnlinkSecretOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s serviceaccount-name secret-name [another-secret-name]...", name),
+		Short:   "Unlink secrets from a ServiceAccount",
+		Long:    unlinkSecretLong,
+		Example: fmt.Sprintf(unlinkSecretExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			if err := o.Complete(f, args); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(c, err.Error()))
+			}
+
+			if err := o.UnlinkSecrets(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	return cmd
+}
+
+func (o *UnlinkSecretOptions) Complete(f *cmdutil.Factory, args []string) error {
+	if len(args) < 2 {
+		return errors.New("must have service account name and at least one secret name")
+	}
+	var err error
+	if o.TargetName, err = nameOf(args[0], "sa", "serviceaccount", "serviceaccounts"); err != nil {
+		return err
+	}
+	for _, arg := range args[1:] {
+		secretName, err := nameOf(arg, "secret", "secrets")
+		if err != nil {
+			return err
+		}
+		o.SecretNames = append(o.SecretNames, secretName)
+	}
+
+	o.ClientInterface, err = f.Client()
+	if err != nil {
+		return err
+	}
+
+	o.Namespace, _, err = f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o UnlinkSecretOptions) Validate() error {
+	if len(o.TargetName) == 0 {
+		return errors.New("service account name must be present")
+	}
+	if len(o.SecretNames) == 0 {
+		return errors.New("secret name must be present")
+	}
+	if o.ClientInterface == nil {
+		return errors.New("ClientInterface must be present")
+	}
+
+	return nil
+}
+
+// UnlinkSecrets removes the secrets from the mount and pull secrets of the service account. Secrets that are not
+// linked are reported after the others have been removed.
+func (o UnlinkSecretOptions) UnlinkSecrets() error {
+	serviceaccount, err := o.ClientInterface.ServiceAccounts(o.Namespace).Get(o.TargetName)
+	if err != nil {
+		return err
+	}
+
+	toRemove := sets.NewString(o.SecretNames...)
+	removed := sets.String{}
+
+	mountSecrets := []kapi.ObjectReference{}
+	for _, secret := range serviceaccount.Secrets {
+		if toRemove.Has(secret.Name) {
+			removed.Insert(secret.Name)
+			continue
+		}
+		mountSecrets = append(mountSecrets, secret)
+	}
+	pullSecrets := []kapi.LocalObjectReference{}
+	for _, secret := range serviceaccount.ImagePullSecrets {
+		if toRemove.Has(secret.Name) {
+			removed.Insert(secret.Name)
+			continue
+		}
+		pullSecrets = append(pullSecrets, secret)
+	}
+
+	if removed.Len() > 0 {
+		serviceaccount.Secrets = mountSecrets
+		serviceaccount.ImagePullSecrets = pullSecrets
+		if _, err := o.ClientInterface.ServiceAccounts(o.Namespace).Update(serviceaccount); err != nil {
+			return err
+		}
+	}
+
+	if notLinked := toRemove.Difference(removed); notLinked.Len() > 0 {
+		return fmt.Errorf("secrets %s are not linked to service account %s", strings.Join(notLinked.List(), ", "), o.TargetName)
+	}
+	return nil
+}
+
+// nameOf returns the name of arg, which is either a name or resource/name for one of the given resources.
+func nameOf(arg string, resources ...string) (string, error) {
+	parts := strings.Split(arg, "/")
+	switch {
+	case len(parts) == 1:
+		return arg, nil
+	case len(parts) == 2 && sets.NewString(resources...).Has(strings.ToLower(parts[0])):
+		return parts[1], nil
+	default:
+		return "", fmt.Errorf("%q must be a name or %s/name", arg, resources[len(resources)-1])
+	}
+}
+
+func (o UnlinkSecretOptions) GetOut() io.Writer {
+	if o.Out == nil {
+		return ioutil.Discard
+	}
+
+	return o.Out
+}
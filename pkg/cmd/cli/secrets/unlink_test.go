@@ -0,0 +1,101 @@
+package secrets
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestUnlinkSecrets(t *testing.T) {
+	tests := []struct {
+		name          string
+		secretNames   []string
+		expectedMount []kapi.ObjectReference
+		expectedPull  []kapi.LocalObjectReference
+		expectUpdate  bool
+		expectErr     bool
+	}{
+		{
+			name:          "mount and pull secret",
+			secretNames:   []string{"both"},
+			expectedMount: []kapi.ObjectReference{{Name: "mount"}},
+			expectedPull:  []kapi.LocalObjectReference{{Name: "pull"}},
+			expectUpdate:  true,
+		},
+		{
+			name:          "several secrets",
+			secretNames:   []string{"mount", "pull"},
+			expectedMount: []kapi.ObjectReference{{Name: "both"}},
+			expectedPull:  []kapi.LocalObjectReference{{Name: "both"}},
+			expectUpdate:  true,
+		},
+		{
+			name:          "secret that is not linked",
+			secretNames:   []string{"mount", "other"},
+			expectedMount: []kapi.ObjectReference{{Name: "both"}},
+			expectedPull:  []kapi.LocalObjectReference{{Name: "both"}, {Name: "pull"}},
+			expectUpdate:  true,
+			expectErr:     true,
+		},
+		{
+			name:        "no linked secret",
+			secretNames: []string{"other"},
+			expectErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		serviceaccount := &kapi.ServiceAccount{
+			ObjectMeta:       kapi.ObjectMeta{Name: "sa", Namespace: "test"},
+			Secrets:          []kapi.ObjectReference{{Name: "both"}, {Name: "mount"}},
+			ImagePullSecrets: []kapi.LocalObjectReference{{Name: "both"}, {Name: "pull"}},
+		}
+		client := testclient.NewSimpleFake(serviceaccount)
+		o := UnlinkSecretOptions{
+			TargetName:      "sa",
+			SecretNames:     test.secretNames,
+			Namespace:       "test",
+			ClientInterface: client,
+		}
+
+		err := o.UnlinkSecrets()
+		if test.expectErr != (err != nil) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.expectErr, err)
+		}
+
+		var updated *kapi.ServiceAccount
+		for _, action := range client.Actions() {
+			if update, ok := action.(testclient.UpdateAction); ok {
+				updated = update.GetObject().(*kapi.ServiceAccount)
+			}
+		}
+		switch {
+		case !test.expectUpdate && updated != nil:
+			t.Errorf("%s: unexpected update: %#v", test.name, updated)
+		case test.expectUpdate && updated == nil:
+			t.Errorf("%s: expected an update", test.name)
+		case updated != nil:
+			if !reflect.DeepEqual(updated.Secrets, test.expectedMount) {
+				t.Errorf("%s: expected mount secrets %v, got %v", test.name, test.expectedMount, updated.Secrets)
+			}
+			if !reflect.DeepEqual(updated.ImagePullSecrets, test.expectedPull) {
+				t.Errorf("%s: expected pull secrets %v, got %v", test.name, test.expectedPull, updated.ImagePullSecrets)
+			}
+		}
+	}
+}
+
+func TestNameOf(t *testing.T) {
+	for arg, expected := range map[string]string{"sa": "sa", "serviceaccounts/sa": "sa", "ServiceAccount/sa": "sa"} {
+		if name, err := nameOf(arg, "sa", "serviceaccount", "serviceaccounts"); err != nil || name != expected {
+			t.Errorf("%s: expected %s, got %s: %v", arg, expected, name, err)
+		}
+	}
+	for _, arg := range []string{"secrets/sa", "serviceaccounts/sa/other"} {
+		if _, err := nameOf(arg, "sa", "serviceaccount", "serviceaccounts"); err == nil {
+			t.Errorf("%s: expected an error", arg)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package dockerregistry
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/docker/distribution/configuration"
+	"github.com/docker/distribution/context"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"github.com/docker/distribution/registry/storage/driver/factory"
+
+	"github.com/openshift/origin/pkg/dockerregistry/storageadmin"
+)
+
+// ReportStorage checks that the storage backend of the registry configured by configFile is
+// reachable, and writes a report of the blobs it keeps to out.
+func ReportStorage(configFile io.Reader, out io.Writer) error {
+	driver, err := storageDriver(configFile)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if _, err := driver.List(ctx, "/"); err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+			return fmt.Errorf("the %s storage is unhealthy: %v", driver.Name(), err)
+		}
+	}
+	report, err := storageadmin.Inspect(ctx, driver)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "storage:\t%s\n", driver.Name())
+	fmt.Fprintf(out, "blobs:\t%d (%d bytes)\n", report.Blobs, report.Bytes)
+	fmt.Fprintf(out, "orphaned blobs:\t%d (%d bytes)\n", report.OrphanedBlobs, report.OrphanedBytes)
+	return nil
+}
+
+// MigrateStorage copies the storage of the registry configured by fromConfigFile to the storage
+// configured by toConfigFile. It can be run again to resume a migration that was interrupted.
+func MigrateStorage(fromConfigFile, toConfigFile io.Reader, out io.Writer) error {
+	from, err := storageDriver(fromConfigFile)
+	if err != nil {
+		return err
+	}
+	to, err := storageDriver(toConfigFile)
+	if err != nil {
+		return err
+	}
+	migration, err := storageadmin.Migrate(context.Background(), from, to, out)
+	if err != nil {
+		return fmt.Errorf("migration from %s to %s failed, run it again to resume: %v", from.Name(), to.Name(), err)
+	}
+	fmt.Fprintf(out, "migrated %s to %s: %d files copied, %d resumed, %d already copied, %d bytes\n",
+		from.Name(), to.Name(), migration.Copied, migration.Resumed, migration.Skipped, migration.Bytes)
+	return nil
+}
+
+// storageDriver creates the storage driver of the registry configured by configFile.
+func storageDriver(configFile io.Reader) (storagedriver.StorageDriver, error) {
+	config, err := configuration.Parse(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing configuration file: %v", err)
+	}
+	driver, err := factory.Create(config.Storage.Type(), config.Storage.Parameters())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the %s storage driver: %v", config.Storage.Type(), err)
+	}
+	return driver, nil
+}
@@ -50,6 +50,18 @@ type DiagnosticsOptions struct {
 	Logger *log.Logger
 }
 
+// DiagnosticsRecommendedName is the recommended command name
+const DiagnosticsRecommendedName = "diagnostics"
+
+// Exit codes of the diagnostics command, so that automation can tell a clean run from a run with
+// problems without parsing the output. Errors that prevent diagnostics from running at all, such
+// as invalid flags, exit with 1.
+const (
+	ExitCodeSuccess  = 0
+	ExitCodeWarnings = 2
+	ExitCodeErrors   = 255
+)
+
 const (
 	// Standard locations for the host config files OpenShift uses.
 	StandardMasterConfigPath string = "/etc/origin/master/master-config.yaml"
@@ -81,10 +93,20 @@ Diagnostics may be individually run by passing diagnostic name as arguments.
 The available diagnostic names are:
 %[2]s
 
+Messages can be written in a machine readable format with --output=json
+or --output=yaml. The command exits with 0 if no problems were found, 2 if
+only warnings were found, and 255 if errors were found.
+
 NOTE: This is a beta version of diagnostics and may still evolve in a
 different direction.
 `
 
+const diagnosticsExample = `  # Run all available diagnostics
+  $ %[1]s
+
+  # Check the nodes and the pod network of the cluster, writing the results as JSON
+  $ %[1]s NodeDefinitions ClusterNetwork --output=json`
+
 // NewCommandDiagnostics is the base command for running any diagnostics.
 func NewCommandDiagnostics(name string, fullName string, out io.Writer) *cobra.Command {
 	o := &DiagnosticsOptions{
@@ -94,9 +116,10 @@ func NewCommandDiagnostics(name string, fullName string, out io.Writer) *cobra.C
 	}
 
 	cmd := &cobra.Command{
-		Use:   name,
-		Short: "This utility helps you troubleshoot and diagnose.",
-		Long:  fmt.Sprintf(longDescription, fullName, strings.Join(availableDiagnostics().List(), " ")),
+		Use:     name,
+		Short:   "This utility helps you troubleshoot and diagnose.",
+		Long:    fmt.Sprintf(longDescription, fullName, strings.Join(availableDiagnostics().List(), " ")),
+		Example: fmt.Sprintf(diagnosticsExample, fullName),
 		Run: func(c *cobra.Command, args []string) {
 			kcmdutil.CheckErr(o.Complete(args))
 
@@ -106,8 +129,8 @@ func NewCommandDiagnostics(name string, fullName string, out io.Writer) *cobra.C
 			o.Logger.Summary(warnCount, errorCount)
 
 			kcmdutil.CheckErr(err)
-			if failed {
-				os.Exit(255)
+			if code := exitCode(failed, warnCount); code != ExitCodeSuccess {
+				os.Exit(code)
 			}
 
 		},
@@ -262,6 +285,17 @@ func (o DiagnosticsOptions) RunDiagnostics() (bool, error, int, int) {
 	return failed, err, numWarnings, numErrors
 }
 
+// exitCode returns the exit code for the outcome of a diagnostics run
+func exitCode(failed bool, warnCount int) int {
+	switch {
+	case failed:
+		return ExitCodeErrors
+	case warnCount > 0:
+		return ExitCodeWarnings
+	}
+	return ExitCodeSuccess
+}
+
 // Run performs the actual execution of diagnostics once they're built.
 func (o DiagnosticsOptions) Run(diagnostics []types.Diagnostic) (bool, error, int, int) {
 	warnCount := 0
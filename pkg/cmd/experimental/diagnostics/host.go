@@ -13,7 +13,7 @@ import (
 var (
 	// availableHostDiagnostics contains the names of host diagnostics that can be executed
 	// during a single run of diagnostics. Add more diagnostics to the list as they are defined.
-	availableHostDiagnostics = sets.NewString(systemddiags.AnalyzeLogsName, systemddiags.UnitStatusName, hostdiags.MasterConfigCheckName, hostdiags.NodeConfigCheckName)
+	availableHostDiagnostics = sets.NewString(systemddiags.AnalyzeLogsName, systemddiags.UnitStatusName, hostdiags.MasterConfigCheckName, hostdiags.NodeConfigCheckName, hostdiags.NodeSDNFlowsName)
 )
 
 // buildHostDiagnostics builds host Diagnostic objects based on the host environment.
@@ -53,6 +53,11 @@ func (o DiagnosticsOptions) buildHostDiagnostics() ([]types.Diagnostic, bool, er
 				diagnostics = append(diagnostics, hostdiags.NodeConfigCheck{NodeConfigFile: o.NodeConfigLocation})
 			}
 
+		case hostdiags.NodeSDNFlowsName:
+			if len(o.NodeConfigLocation) > 0 {
+				diagnostics = append(diagnostics, hostdiags.NodeSDNFlows{NodeConfigFile: o.NodeConfigLocation})
+			}
+
 		default:
 			return diagnostics, false, fmt.Errorf("unknown diagnostic: %v", diagnosticName)
 		}
@@ -8,17 +8,20 @@ import (
 
 // LoggerOptionFlags enable the user to specify how they want output.
 type LoggerOptionFlags struct {
-	Level FlagInfo
+	Level  FlagInfo
+	Format FlagInfo
 }
 
 // RecommendedLoggerOptionFlags provides default overrideable Logger flag specifications to be bound to options.
 func RecommendedLoggerOptionFlags() LoggerOptionFlags {
 	return LoggerOptionFlags{
-		Level: FlagInfo{FlagLevelName, "l", "1", "Level of diagnostic output: 4: Error, 3: Warn, 2: Notice, 1: Info, 0: Debug"},
+		Level:  FlagInfo{FlagLevelName, "l", "1", "Level of diagnostic output: 4: Error, 3: Warn, 2: Notice, 1: Info, 0: Debug"},
+		Format: FlagInfo{FlagFormatName, "o", log.TextFormat, "Output format of diagnostic messages: text, json, or yaml"},
 	}
 }
 
 // BindLoggerOptionFlags binds flags to LoggerOptionFlags.
 func BindLoggerOptionFlags(cmdFlags *pflag.FlagSet, loggerOptions *log.LoggerOptions, flags LoggerOptionFlags) {
 	flags.Level.BindIntFlag(cmdFlags, &loggerOptions.Level)
+	flags.Format.BindStringFlag(cmdFlags, &loggerOptions.Format)
 }
@@ -49,6 +49,7 @@ const (
 	FlagNodeConfigName          = "node-config"
 	FlagClusterContextName      = "cluster-context"
 	FlagLevelName               = "diaglevel"
+	FlagFormatName              = "output"
 	FlagIsHostName              = "host"
 	FlagImageTemplateName       = "images"
 	FlagLatestImageName         = "latest-images"
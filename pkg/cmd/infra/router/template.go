@@ -164,18 +164,19 @@ func (o *TemplateRouterOptions) Run() error {
 		IncludeUDP:         o.RouterSelection.IncludeUDP,
 	}
 
-	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg)
+	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
-	plugin := controller.NewUniqueHost(templatePlugin, o.RouteSelectionFunc())
-
-	oc, kc, err := o.Config.Clients()
+	svcFetcher := templateplugin.NewListWatchServiceLookup(kc, 10*time.Minute)
+	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg, svcFetcher)
 	if err != nil {
 		return err
 	}
 
+	plugin := controller.NewUniqueHost(templatePlugin, o.RouteSelectionFunc())
+
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
 	controller.Run()
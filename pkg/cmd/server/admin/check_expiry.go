@@ -0,0 +1,256 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const CheckExpiryCommandName = "check-expiry"
+
+const (
+	// CertificateValid is the status of a certificate that is valid and does not expire soon
+	CertificateValid = "Valid"
+	// CertificateExpiresSoon is the status of a certificate that expires within the expiry warning
+	CertificateExpiresSoon = "ExpiresSoon"
+	// CertificateExpired is the status of a certificate whose expiration date has passed
+	CertificateExpired = "Expired"
+	// CertificateNotYetValid is the status of a certificate that is not valid before a future date
+	CertificateNotYetValid = "NotYetValid"
+)
+
+type CheckExpiryOptions struct {
+	CertDir       string
+	ExpiryWarning time.Duration
+
+	Output io.Writer
+}
+
+// CertificateExpiry describes a certificate found in a certificate directory
+type CertificateExpiry struct {
+	// Source is the file that contains the certificate. For certificates embedded in a
+	// kubeconfig, it also names the user or cluster entry.
+	Source    string
+	Subject   string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Status returns whether the certificate is valid at now, and whether it expires within expiryWarning
+func (c CertificateExpiry) Status(now time.Time, expiryWarning time.Duration) string {
+	switch {
+	case now.After(c.NotAfter):
+		return CertificateExpired
+	case now.Before(c.NotBefore):
+		return CertificateNotYetValid
+	case now.Add(expiryWarning).After(c.NotAfter):
+		return CertificateExpiresSoon
+	default:
+		return CertificateValid
+	}
+}
+
+const checkExpiryLong = `
+Check the expiration of certificates
+
+This command lists every certificate in a certificate directory, such as the
+directories written by create-master-certs and create-node-config, with its
+expiration date. Certificates in *.crt files and client and CA certificates
+embedded in *.kubeconfig files are checked. The command fails if any
+certificate has expired or is not valid yet.
+
+Certificates that expire soon can be re-issued with reissue-cert without
+regenerating the CA.
+`
+
+const checkExpiryExample = `  # Check the certificates of a master
+  %[1]s --cert-dir=openshift.local.config/master
+
+  # Report certificates that expire within 90 days
+  %[1]s --cert-dir=openshift.local.config/master --expiry-warning=2160h`
+
+func NewCommandCheckExpiry(commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &CheckExpiryOptions{Output: out}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Check the expiration of certificates",
+		Long:    checkExpiryLong,
+		Example: fmt.Sprintf(checkExpiryExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Validate(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			if err := options.CheckExpiry(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.CertDir, "cert-dir", "openshift.local.config/master", "The certificate data directory.")
+	flags.DurationVar(&options.ExpiryWarning, "expiry-warning", 30*24*time.Hour, "Report certificates that expire within this duration.")
+
+	// autocompletion hints
+	cmd.MarkFlagFilename("cert-dir")
+
+	return cmd
+}
+
+func (o CheckExpiryOptions) Validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are supported")
+	}
+	if len(o.CertDir) == 0 {
+		return errors.New("cert-dir must be provided")
+	}
+	if o.ExpiryWarning < 0 {
+		return errors.New("expiry-warning must not be negative")
+	}
+
+	return nil
+}
+
+// CheckExpiry prints the status of every certificate in the certificate directory. It returns an
+// error if any certificate is expired or not valid yet.
+func (o CheckExpiryOptions) CheckExpiry() error {
+	glog.V(4).Infof("Checking certificate expiration with: %#v", o)
+
+	certs, err := FindCertificates(o.CertDir)
+	if err != nil {
+		return err
+	}
+	if len(certs) == 0 {
+		return fmt.Errorf("no certificates found in %s", o.CertDir)
+	}
+
+	now := time.Now()
+	invalid := 0
+	w := tabwriter.NewWriter(o.Output, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "FILE\tSUBJECT\tEXPIRES\tSTATUS")
+	for _, cert := range certs {
+		status := cert.Status(now, o.ExpiryWarning)
+		if status == CertificateExpired || status == CertificateNotYetValid {
+			invalid++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", cert.Source, cert.Subject, cert.NotAfter.UTC().Format(time.RFC3339), status)
+	}
+	w.Flush()
+
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d certificates are expired or not valid yet", invalid, len(certs))
+	}
+	return nil
+}
+
+// FindCertificates returns the certificates of all *.crt files and the certificates embedded in all
+// *.kubeconfig files under dir, sorted by expiration date.
+func FindCertificates(dir string) ([]CertificateExpiry, error) {
+	certs := []CertificateExpiry{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".crt":
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			found, err := certificatesFromPEM(path, data)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, found...)
+		case ".kubeconfig":
+			found, err := kubeConfigCertificates(path)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, found...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(byExpiration(certs))
+	return certs, nil
+}
+
+func kubeConfigCertificates(path string) ([]CertificateExpiry, error) {
+	config, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	certs := []CertificateExpiry{}
+	for name, authInfo := range config.AuthInfos {
+		if len(authInfo.ClientCertificateData) == 0 {
+			continue
+		}
+		found, err := certificatesFromPEM(fmt.Sprintf("%s (user %s)", path, name), authInfo.ClientCertificateData)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, found...)
+	}
+	for name, cluster := range config.Clusters {
+		if len(cluster.CertificateAuthorityData) == 0 {
+			continue
+		}
+		found, err := certificatesFromPEM(fmt.Sprintf("%s (cluster %s)", path, name), cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, found...)
+	}
+	return certs, nil
+}
+
+func certificatesFromPEM(source string, data []byte) ([]CertificateExpiry, error) {
+	parsed, err := crypto.CertsFromPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read certificates from %s: %v", source, err)
+	}
+	certs := []CertificateExpiry{}
+	for _, cert := range parsed {
+		certs = append(certs, CertificateExpiry{
+			Source:    source,
+			Subject:   cert.Subject.CommonName,
+			NotBefore: cert.NotBefore,
+			NotAfter:  cert.NotAfter,
+		})
+	}
+	return certs, nil
+}
+
+type byExpiration []CertificateExpiry
+
+func (c byExpiration) Len() int      { return len(c) }
+func (c byExpiration) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c byExpiration) Less(i, j int) bool {
+	if c[i].NotAfter.Equal(c[j].NotAfter) {
+		return c[i].Source < c[j].Source
+	}
+	return c[i].NotAfter.Before(c[j].NotAfter)
+}
@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func makeMasterCerts(t *testing.T) string {
+	certDir, err := ioutil.TempDir("", "master-certs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	options := CreateMasterCertsOptions{
+		CertDir:      certDir,
+		SignerName:   "unit-test-signer",
+		APIServerURL: "https://localhost:8443",
+		Hostnames:    []string{"localhost", "127.0.0.1"},
+		Output:       ioutil.Discard,
+	}
+	if err := options.CreateMasterCerts(); err != nil {
+		os.RemoveAll(certDir)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return certDir
+}
+
+func TestCertificateExpiryStatus(t *testing.T) {
+	now := time.Now()
+	cert := CertificateExpiry{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(10 * 24 * time.Hour)}
+
+	testCases := map[string]struct {
+		now           time.Time
+		expiryWarning time.Duration
+		expected      string
+	}{
+		"valid":           {now: now, expiryWarning: 24 * time.Hour, expected: CertificateValid},
+		"expires soon":    {now: now, expiryWarning: 30 * 24 * time.Hour, expected: CertificateExpiresSoon},
+		"expired":         {now: now.Add(11 * 24 * time.Hour), expected: CertificateExpired},
+		"not valid yet":   {now: now.Add(-2 * time.Hour), expected: CertificateNotYetValid},
+		"without warning": {now: now.Add(9 * 24 * time.Hour), expected: CertificateValid},
+	}
+	for k, tc := range testCases {
+		if actual := cert.Status(tc.now, tc.expiryWarning); actual != tc.expected {
+			t.Errorf("%s: expected %s, got %s", k, tc.expected, actual)
+		}
+	}
+}
+
+func TestFindCertificates(t *testing.T) {
+	certDir := makeMasterCerts(t)
+	defer os.RemoveAll(certDir)
+
+	certs, err := FindCertificates(certDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sources := map[string]bool{}
+	for i, cert := range certs {
+		sources[cert.Source] = true
+		if i > 0 && cert.NotAfter.Before(certs[i-1].NotAfter) {
+			t.Errorf("expected certificates sorted by expiration, got %v before %v", certs[i-1], cert)
+		}
+		if status := cert.Status(time.Now(), 0); status != CertificateValid {
+			t.Errorf("expected %s to be valid, got %s", cert.Source, status)
+		}
+	}
+	for _, expected := range []string{
+		filepath.Join(certDir, "ca.crt"),
+		filepath.Join(certDir, "master.server.crt"),
+		filepath.Join(certDir, "master.etcd-client.crt"),
+		filepath.Join(certDir, "openshift-router.crt"),
+		filepath.Join(certDir, "openshift-router.kubeconfig") + " (user system:openshift-router/localhost:8443)",
+	} {
+		if !sources[expected] {
+			t.Errorf("expected a certificate from %s, got %v", expected, sources)
+		}
+	}
+}
+
+func TestCheckExpiry(t *testing.T) {
+	certDir := makeMasterCerts(t)
+	defer os.RemoveAll(certDir)
+
+	out := &bytes.Buffer{}
+	options := CheckExpiryOptions{CertDir: certDir, ExpiryWarning: 30 * 24 * time.Hour, Output: out}
+	if err := options.CheckExpiry(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte("openshift-registry.crt")) {
+		t.Errorf("expected the registry certificate to be listed, got\n%s", out.String())
+	}
+
+	emptyDir, err := ioutil.TempDir("", "no-certs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(emptyDir)
+	options.CertDir = emptyDir
+	if err := options.CheckExpiry(); err == nil {
+		t.Errorf("expected an error for a directory without certificates")
+	}
+}
@@ -0,0 +1,270 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	configapi "github.com/openshift/origin/pkg/cmd/server/api"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const ReissueCertCommandName = "reissue-cert"
+
+type ReissueCertOptions struct {
+	CertDir string
+	Name    string
+
+	// Hostnames replaces the hostnames of a server certificate. When empty, the hostnames of the
+	// existing certificate are kept.
+	Hostnames []string
+
+	Output io.Writer
+}
+
+// ReissuableClientCerts returns the client certificates written by create-master-certs that can be
+// re-issued individually, by name
+func ReissuableClientCerts(certDir string) map[string]ClientCertInfo {
+	return map[string]ClientCertInfo{
+		"admin":            DefaultClusterAdminClientCertInfo(certDir),
+		"openshift-master": DefaultOpenshiftLoopbackClientCertInfo(certDir),
+		"router":           DefaultRouterClientCertInfo(certDir),
+		"registry":         DefaultRegistryClientCertInfo(certDir),
+		"etcd-client":      DefaultMasterEtcdClientCertInfo(certDir),
+		"kubelet-client":   DefaultMasterKubeletClientCertInfo(certDir),
+		"proxy-client":     DefaultProxyClientCertInfo(certDir),
+	}
+}
+
+// ReissuableServerCerts returns the serving certificates written by create-master-certs that can be
+// re-issued individually, by name
+func ReissuableServerCerts(certDir string) map[string]configapi.CertInfo {
+	return map[string]configapi.CertInfo{
+		"master-server": DefaultMasterServingCertInfo(certDir),
+		"etcd-server":   DefaultEtcdServingCertInfo(certDir),
+	}
+}
+
+func reissuableCertNames() []string {
+	names := sets.StringKeySet(ReissuableClientCerts("")).Union(sets.StringKeySet(ReissuableServerCerts("")))
+	return names.List()
+}
+
+const reissueCertLong = `
+Re-issue a single certificate
+
+This command replaces one of the certificates written by create-master-certs
+with a new certificate and key signed by the existing CA. Other certificates,
+and the CA itself, are left untouched, so components that trust the CA keep
+working. Certificates that are embedded in a kubeconfig file (admin,
+openshift-master, router and registry) are also replaced in that file.
+
+Serving certificates keep the hostnames of the existing certificate unless
+--hostnames is given.
+
+The certificates that can be re-issued are: %[2]s
+
+Use check-expiry to find the certificates that expire soon.
+`
+
+const reissueCertExample = `  # Re-issue the router client certificate and its kubeconfig
+  %[1]s router --cert-dir=openshift.local.config/master
+
+  # Re-issue the etcd serving certificate for new hostnames
+  %[1]s etcd-server --cert-dir=openshift.local.config/master --hostnames=etcd.example.com,10.0.0.1`
+
+func NewCommandReissueCert(commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &ReissueCertOptions{Output: out}
+
+	cmd := &cobra.Command{
+		Use:     commandName + " NAME",
+		Short:   "Re-issue a single certificate signed by the existing CA",
+		Long:    fmt.Sprintf(reissueCertLong, fullName, strings.Join(reissuableCertNames(), ", ")),
+		Example: fmt.Sprintf(reissueCertExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			if err := options.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+
+			if err := options.ReissueCert(); err != nil {
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	flags := cmd.Flags()
+
+	flags.StringVar(&options.CertDir, "cert-dir", "openshift.local.config/master", "The certificate data directory.")
+	flags.StringSliceVar(&options.Hostnames, "hostnames", options.Hostnames, "Every hostname or IP that a serving cert should be valid for (comma-delimited list). Defaults to the hostnames of the existing certificate.")
+
+	// autocompletion hints
+	cmd.MarkFlagFilename("cert-dir")
+
+	return cmd
+}
+
+func (o *ReissueCertOptions) Complete(args []string) error {
+	if len(args) != 1 {
+		return errors.New("exactly one certificate name is required")
+	}
+	o.Name = args[0]
+	return nil
+}
+
+func (o ReissueCertOptions) Validate() error {
+	if len(o.CertDir) == 0 {
+		return errors.New("cert-dir must be provided")
+	}
+	_, isClient := ReissuableClientCerts(o.CertDir)[o.Name]
+	_, isServer := ReissuableServerCerts(o.CertDir)[o.Name]
+	if !isClient && !isServer {
+		return fmt.Errorf("unknown certificate %q, must be one of: %s", o.Name, strings.Join(reissuableCertNames(), ", "))
+	}
+	if isClient && len(o.Hostnames) > 0 {
+		return fmt.Errorf("hostnames cannot be set for the client certificate %q", o.Name)
+	}
+	if err := o.signerCertOptions().Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o ReissueCertOptions) signerCertOptions() *SignerCertOptions {
+	return &SignerCertOptions{
+		CertFile:   DefaultCertFilename(o.CertDir, CAFilePrefix),
+		KeyFile:    DefaultKeyFilename(o.CertDir, CAFilePrefix),
+		SerialFile: DefaultSerialFilename(o.CertDir, CAFilePrefix),
+	}
+}
+
+// ReissueCert replaces the named certificate and key with new ones signed by the CA in the
+// certificate directory
+func (o ReissueCertOptions) ReissueCert() error {
+	glog.V(4).Infof("Re-issuing a cert with: %#v", o)
+
+	if clientCertInfo, ok := ReissuableClientCerts(o.CertDir)[o.Name]; ok {
+		return o.reissueClientCert(clientCertInfo)
+	}
+	if serverCertInfo, ok := ReissuableServerCerts(o.CertDir)[o.Name]; ok {
+		return o.reissueServerCert(serverCertInfo)
+	}
+	return fmt.Errorf("unknown certificate %q", o.Name)
+}
+
+func (o ReissueCertOptions) reissueClientCert(clientCertInfo ClientCertInfo) error {
+	clientCertOptions := CreateClientCertOptions{
+		SignerCertOptions: o.signerCertOptions(),
+
+		CertFile: clientCertInfo.CertLocation.CertFile,
+		KeyFile:  clientCertInfo.CertLocation.KeyFile,
+
+		User:      clientCertInfo.User,
+		Groups:    clientCertInfo.Groups.List(),
+		Overwrite: true,
+		Output:    o.Output,
+	}
+	if err := clientCertOptions.Validate(nil); err != nil {
+		return err
+	}
+	if _, err := clientCertOptions.CreateClientCert(); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Output, "Re-issued certificate %s and key %s\n", clientCertInfo.CertLocation.CertFile, clientCertInfo.CertLocation.KeyFile)
+
+	if len(clientCertInfo.UnqualifiedUser) == 0 {
+		return nil
+	}
+	kubeConfigFile := DefaultKubeConfigFilename(o.CertDir, clientCertInfo.UnqualifiedUser)
+	if _, err := os.Stat(kubeConfigFile); os.IsNotExist(err) {
+		glog.V(3).Infof("No kubeconfig found at %s, not updating it", kubeConfigFile)
+		return nil
+	}
+	if err := updateKubeConfigClientCert(kubeConfigFile, clientCertInfo.CertLocation); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Output, "Updated %s\n", kubeConfigFile)
+	return nil
+}
+
+func (o ReissueCertOptions) reissueServerCert(serverCertInfo configapi.CertInfo) error {
+	hostnames := o.Hostnames
+	if len(hostnames) == 0 {
+		existing, err := certificateHostnames(serverCertInfo.CertFile)
+		if err != nil {
+			return err
+		}
+		hostnames = existing
+	}
+
+	serverCertOptions := CreateServerCertOptions{
+		SignerCertOptions: o.signerCertOptions(),
+
+		CertFile: serverCertInfo.CertFile,
+		KeyFile:  serverCertInfo.KeyFile,
+
+		Hostnames: hostnames,
+		Overwrite: true,
+		Output:    o.Output,
+	}
+	if err := serverCertOptions.Validate(nil); err != nil {
+		return err
+	}
+	if _, err := serverCertOptions.CreateServerCert(); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Output, "Re-issued certificate %s and key %s for %s\n", serverCertInfo.CertFile, serverCertInfo.KeyFile, strings.Join(hostnames, ", "))
+	return nil
+}
+
+// certificateHostnames returns the DNS names and IP addresses of the first certificate in certFile
+func certificateHostnames(certFile string) ([]string, error) {
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the existing certificate, --hostnames must be provided: %v", err)
+	}
+	certs, err := crypto.CertsFromPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the existing certificate, --hostnames must be provided: %v", err)
+	}
+	hostnames := sets.NewString(certs[0].DNSNames...)
+	for _, ip := range certs[0].IPAddresses {
+		hostnames.Insert(ip.String())
+	}
+	return hostnames.List(), nil
+}
+
+// updateKubeConfigClientCert replaces the client certificate and key of every user in the kubeconfig
+// file with the contents of the given files
+func updateKubeConfigClientCert(kubeConfigFile string, certInfo configapi.CertInfo) error {
+	config, err := clientcmd.LoadFromFile(kubeConfigFile)
+	if err != nil {
+		return err
+	}
+	certData, err := ioutil.ReadFile(certInfo.CertFile)
+	if err != nil {
+		return err
+	}
+	keyData, err := ioutil.ReadFile(certInfo.KeyFile)
+	if err != nil {
+		return err
+	}
+	for _, authInfo := range config.AuthInfos {
+		authInfo.ClientCertificateData = certData
+		authInfo.ClientKeyData = keyData
+	}
+	return clientcmd.WriteToFile(*config, kubeConfigFile)
+}
@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/client/unversioned/clientcmd"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+func readCertificate(t *testing.T, certFile string) []byte {
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return data
+}
+
+func TestReissueClientCert(t *testing.T) {
+	certDir := makeMasterCerts(t)
+	defer os.RemoveAll(certDir)
+
+	caBefore := readCertificate(t, filepath.Join(certDir, "ca.crt"))
+	registryBefore := readCertificate(t, filepath.Join(certDir, "openshift-registry.crt"))
+	routerBefore := readCertificate(t, filepath.Join(certDir, "openshift-router.crt"))
+
+	options := ReissueCertOptions{CertDir: certDir, Name: "router", Output: ioutil.Discard}
+	if err := options.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := options.ReissueCert(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	routerAfter := readCertificate(t, filepath.Join(certDir, "openshift-router.crt"))
+	if bytes.Equal(routerBefore, routerAfter) {
+		t.Errorf("expected the router certificate to be re-issued")
+	}
+	if !bytes.Equal(caBefore, readCertificate(t, filepath.Join(certDir, "ca.crt"))) {
+		t.Errorf("expected the CA to be unchanged")
+	}
+	if !bytes.Equal(registryBefore, readCertificate(t, filepath.Join(certDir, "openshift-registry.crt"))) {
+		t.Errorf("expected the registry certificate to be unchanged")
+	}
+
+	kubeConfig, err := clientcmd.LoadFromFile(filepath.Join(certDir, "openshift-router.kubeconfig"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, authInfo := range kubeConfig.AuthInfos {
+		if !bytes.Equal(authInfo.ClientCertificateData, routerAfter) {
+			t.Errorf("expected user %s of the kubeconfig to use the re-issued certificate", name)
+		}
+	}
+}
+
+func TestReissueServerCertKeepsHostnames(t *testing.T) {
+	certDir := makeMasterCerts(t)
+	defer os.RemoveAll(certDir)
+
+	certFile := filepath.Join(certDir, "etcd.server.crt")
+	before, err := certificateHostnames(certFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	options := ReissueCertOptions{CertDir: certDir, Name: "etcd-server", Output: ioutil.Discard}
+	if err := options.ReissueCert(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after, err := certificateHostnames(certFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Errorf("expected hostnames %v, got %v", before, after)
+	}
+
+	options.Hostnames = []string{"etcd.example.com"}
+	if err := options.ReissueCert(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certs, err := crypto.CertsFromPEM(readCertificate(t, certFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(certs[0].DNSNames, []string{"etcd.example.com"}) {
+		t.Errorf("expected the new hostnames, got %v", certs[0].DNSNames)
+	}
+}
+
+func TestReissueCertValidate(t *testing.T) {
+	certDir := makeMasterCerts(t)
+	defer os.RemoveAll(certDir)
+
+	testCases := map[string]struct {
+		options ReissueCertOptions
+		valid   bool
+	}{
+		"client":                {options: ReissueCertOptions{CertDir: certDir, Name: "etcd-client"}, valid: true},
+		"server with hostnames": {options: ReissueCertOptions{CertDir: certDir, Name: "master-server", Hostnames: []string{"a"}}, valid: true},
+		"unknown name":          {options: ReissueCertOptions{CertDir: certDir, Name: "ca"}},
+		"client with hostnames": {options: ReissueCertOptions{CertDir: certDir, Name: "router", Hostnames: []string{"a"}}},
+		"missing CA":            {options: ReissueCertOptions{CertDir: filepath.Join(certDir, "missing"), Name: "router"}},
+	}
+	for k, tc := range testCases {
+		err := tc.options.Validate()
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: expected an error", k)
+		}
+	}
+}
@@ -187,6 +187,9 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 				refs = append(refs, &provider.RemoteConnectionInfo.ClientCert.CertFile)
 				refs = append(refs, &provider.RemoteConnectionInfo.ClientCert.KeyFile)
 
+			case (*GitLabIdentityProvider):
+				refs = append(refs, &provider.CA)
+
 			case (*OpenIDIdentityProvider):
 				refs = append(refs, &provider.CA)
 
@@ -196,6 +199,7 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 		if config.OAuthConfig.Templates != nil {
 			refs = append(refs, &config.OAuthConfig.Templates.Login)
 			refs = append(refs, &config.OAuthConfig.Templates.ProviderSelection)
+			refs = append(refs, &config.OAuthConfig.Templates.Error)
 		}
 	}
 
@@ -475,6 +479,7 @@ func IsIdentityProviderType(provider runtime.EmbeddedObject) bool {
 		(*KeystonePasswordIdentityProvider),
 		(*OpenIDIdentityProvider),
 		(*GitHubIdentityProvider),
+		(*GitLabIdentityProvider),
 		(*GoogleIdentityProvider):
 
 		return true
@@ -488,6 +493,7 @@ func IsOAuthIdentityProvider(provider IdentityProvider) bool {
 	case
 		(*OpenIDIdentityProvider),
 		(*GitHubIdentityProvider),
+		(*GitLabIdentityProvider),
 		(*GoogleIdentityProvider):
 
 		return true
@@ -531,3 +537,11 @@ func HasKubernetesAPIVersion(config KubernetesMasterConfig, groupVersion unversi
 	enabledVersions := GetEnabledAPIVersionsForGroup(config, groupVersion.Group)
 	return sets.NewString(enabledVersions...).Has(groupVersion.Version)
 }
+
+// GetClusterNetworks returns the CIDRs that node subnets are allocated from, in order
+func GetClusterNetworks(config MasterNetworkConfig) []ClusterNetworkEntry {
+	if len(config.ClusterNetworks) > 0 {
+		return config.ClusterNetworks
+	}
+	return []ClusterNetworkEntry{{CIDR: config.ClusterNetworkCIDR, HostSubnetLength: config.HostSubnetLength}}
+}
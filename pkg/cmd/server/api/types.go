@@ -235,6 +235,12 @@ type MasterConfig struct {
 	// PolicyConfig holds information about where to locate critical pieces of bootstrapping policy
 	PolicyConfig PolicyConfig
 
+	// AuditConfig holds information about auditing policy changes and authorization denials
+	AuditConfig AuditConfig
+
+	// BuildWebHookConfig controls the rate limiting and replay protection of build webhooks
+	BuildWebHookConfig BuildWebHookConfig
+
 	// ProjectConfig holds information about project creation and defaults
 	ProjectConfig ProjectConfig
 
@@ -260,10 +266,49 @@ type ImagePolicyConfig struct {
 	MaxScheduledImageImportsPerMinute int `json:"maxScheduledImageImportsPerMinute"`
 }
 
+// AuditConfig holds configuration for the audit capabilities
+type AuditConfig struct {
+	// Enabled turns on auditing.  Every change to policies, roles, and role bindings, and every exec, attach,
+	// and port-forward session with a pod is recorded.
+	Enabled bool
+	// AuditFilePath is the file audit records are appended to, one JSON object per line
+	AuditFilePath string
+	// WebhookURL, if set, receives each audit record as a JSON POST request
+	WebhookURL string
+	// DenialSampleInterval controls the auditing of authorization denials.  One in every DenialSampleInterval
+	// denials is recorded.  Zero, the default, disables the auditing of denials.
+	DenialSampleInterval int
+}
+
+// BuildWebHookConfig holds configuration for the webhooks that trigger builds.  The limits and the received
+// delivery IDs are kept in memory by each master, so with several masters every master enforces its own limits,
+// and a delivery replayed to another master, or after a restart, is accepted.
+type BuildWebHookConfig struct {
+	// RateLimitBurst is the number of webhook deliveries each build config accepts at once.  Zero, the default,
+	// disables rate limiting.  Deliveries over the limit are rejected with 429 Too Many Requests.
+	RateLimitBurst int
+	// RateLimitIntervalSeconds is the number of seconds after which a build config accepts one more delivery
+	// when its burst is used up.  It is required when RateLimitBurst is set.
+	RateLimitIntervalSeconds int
+	// RejectReplayedDeliveries rejects, with 409 Conflict, a delivery whose ID (the X-GitHub-Delivery header)
+	// was already received for the build config.  Deliveries without an ID are always accepted, and a delivery
+	// that was rejected or failed to start a build may be retried.
+	RejectReplayedDeliveries bool
+}
+
 type ProjectConfig struct {
 	// DefaultNodeSelector holds default project node label selector
 	DefaultNodeSelector string
 
+	// InfraNodeSelector is the node label selector for infrastructure pods: build pods, deployer pods, and every pod
+	// in InfraProjects, such as routers and registries. It takes the place of DefaultNodeSelector for those pods, while a
+	// project node selector still takes precedence. If empty, infrastructure pods use DefaultNodeSelector.
+	InfraNodeSelector string
+
+	// InfraProjects are the projects whose pods are all infrastructure pods. Defaults to the default project, where
+	// oadm router and oadm registry create routers and registries.
+	InfraProjects []string
+
 	// ProjectRequestMessage is the string presented to a user if they are unable to request a project via the projectrequest api endpoint
 	ProjectRequestMessage string
 
@@ -272,6 +317,12 @@ type ProjectConfig struct {
 	// If it is not specified, a default template is used.
 	ProjectRequestTemplate string
 
+	// ApplyTemplateDefaultsToExistingProjects controls whether the resource quotas and limit ranges of the project request
+	// template are also created in existing projects that have none, such as projects created before the template was set.
+	// Only projects that were requested, which carry the openshift.io/requester annotation, are changed, so system
+	// namespaces are left alone, and so are projects annotated with openshift.io/skip-template-defaults=true.
+	ApplyTemplateDefaultsToExistingProjects bool
+
 	// SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.
 	SecurityAllocator *SecurityAllocator
 }
@@ -279,6 +330,11 @@ type ProjectConfig struct {
 type RoutingConfig struct {
 	// Subdomain is the suffix appended to $service.$namespace. to form the default route hostname
 	Subdomain string
+	// HostnameTemplate is the template of default route hostnames. ${name}, ${namespace} and ${subdomain} are
+	// replaced with the name and namespace of the route and the subdomain. Defaults to ${name}-${namespace}.${subdomain}
+	// A route whose generated hostname is not a valid DNS subdomain, for instance because one of its labels is
+	// longer than 63 characters, gets no hostname and must set one itself. Service DNS names are not affected.
+	HostnameTemplate string
 }
 
 type SecurityAllocator struct {
@@ -319,9 +375,20 @@ type MasterNetworkConfig struct {
 	NetworkPluginName  string
 	ClusterNetworkCIDR string
 	HostSubnetLength   uint
+	// ClusterNetworks is the list of CIDRs that node subnets are allocated from, in order. If set,
+	// ClusterNetworkCIDR and HostSubnetLength must be empty or match the first entry.
+	ClusterNetworks    []ClusterNetworkEntry
 	ServiceNetworkCIDR string
 }
 
+// ClusterNetworkEntry is a CIDR that node subnets are allocated from
+type ClusterNetworkEntry struct {
+	// CIDR is the network that node subnets are allocated from
+	CIDR string
+	// HostSubnetLength is the number of bits of each node subnet that are allocated to pods
+	HostSubnetLength uint
+}
+
 type ImageConfig struct {
 	// Format describes how to determine image names for system components
 	Format string
@@ -420,6 +487,9 @@ type DNSConfig struct {
 	// BindNetwork is the type of network to bind to - defaults to "tcp4", accepts "tcp",
 	// "tcp4", and "tcp6"
 	BindNetwork string
+	// Nameservers is the list of upstream nameservers, as ip or ip:port, that queries for names outside
+	// the cluster domain are forwarded to. If empty, those queries are refused.
+	Nameservers []string
 }
 
 type AssetConfig struct {
@@ -498,6 +568,10 @@ type OAuthTemplates struct {
 	// ProviderSelection is a path to a file containing a go template used to render the provider selection page.
 	// If unspecified, the default provider selection page is used.
 	ProviderSelection string
+
+	// Error is a path to a file containing a go template used to render error pages during the authentication or grant flow.
+	// If unspecified, the default error page is used.
+	Error string
 }
 
 type ServiceAccountConfig struct {
@@ -526,9 +600,9 @@ type ServiceAccountConfig struct {
 }
 
 type TokenConfig struct {
-	// AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens
+	// AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens. 0 uses the server default
 	AuthorizeTokenMaxAgeSeconds int32
-	// AccessTokenMaxAgeSeconds defines the maximum age of access tokens
+	// AccessTokenMaxAgeSeconds defines the maximum age of access tokens. 0 uses the server default
 	AccessTokenMaxAgeSeconds int32
 }
 
@@ -567,7 +641,11 @@ type IdentityProvider struct {
 	UseAsChallenger bool
 	// UseAsLogin indicates whether to use this identity provider for unauthenticated browsers to login against
 	UseAsLogin bool
-	// MappingMethod determines how identities from this provider are mapped to users
+	// MappingMethod determines how identities from this provider are mapped to users. One of:
+	//   claim    - associate new identities with the user named after the preferred username, failing if that user already has another identity (default)
+	//   lookup   - only allow identities that were already mapped to a user, new identities are rejected
+	//   add      - associate new identities with the user named after the preferred username, creating or adding to that user
+	//   generate - associate new identities with a new user, generating a unique name if the preferred username is taken
 	MappingMethod string
 	// Provider contains the information about how to set up a specific identity provider
 	Provider runtime.EmbeddedObject
@@ -578,6 +656,26 @@ type BasicAuthPasswordIdentityProvider struct {
 
 	// RemoteConnectionInfo contains information about how to connect to the external basic auth server
 	RemoteConnectionInfo RemoteConnectionInfo
+
+	// Attributes maps keys of the JSON response from the external basic auth server to identity fields
+	Attributes BasicAuthAttributeMapping
+}
+
+// BasicAuthAttributeMapping lists the keys of the JSON response from an external basic auth server to read identity fields from.
+// For each field, the first key with a non-empty value is used.
+type BasicAuthAttributeMapping struct {
+	// ID is the list of keys whose values should be used as the user ID.
+	// If unspecified, the "sub" key is used
+	ID []string
+	// PreferredUsername is the list of keys whose values should be used as the preferred username.
+	// If unspecified, the "preferred_username" key is used
+	PreferredUsername []string
+	// Name is the list of keys whose values should be used as the display name.
+	// If unspecified, the "name" key is used
+	Name []string
+	// Email is the list of keys whose values should be used as the email address.
+	// If unspecified, the "email" key is used
+	Email []string
 }
 
 type AllowAllPasswordIdentityProvider struct {
@@ -658,10 +756,19 @@ type RequestHeaderIdentityProvider struct {
 	//   https://www.example.com/auth-proxy/oauth/authorize?${query}
 	ChallengeURL string
 
-	// ClientCA is a file with the trusted signer certs.  If empty, no request verification is done, and any direct request to the OAuth server can impersonate any identity from this provider, merely by setting a request header.
+	// ClientCA is a file with the trusted signer certs. It is required: requests are only trusted from proxies presenting a client certificate signed by one of them, since any direct request to the OAuth server could otherwise impersonate any identity from this provider, merely by setting a request header.
 	ClientCA string
+	// ClientCommonNames is an optional list of common names to require a match from. If empty, any client certificate validated against the clientCA bundle is considered authoritative.
+	ClientCommonNames []string
+
 	// Headers is the set of headers to check for identity information
 	Headers []string
+	// PreferredUsernameHeaders is the set of headers to check for the preferred username
+	PreferredUsernameHeaders []string
+	// NameHeaders is the set of headers to check for the display name
+	NameHeaders []string
+	// EmailHeaders is the set of headers to check for the email address
+	EmailHeaders []string
 }
 
 type GitHubIdentityProvider struct {
@@ -671,6 +778,29 @@ type GitHubIdentityProvider struct {
 	ClientID string
 	// ClientSecret is the oauth client secret
 	ClientSecret string
+	// Organizations optionally restricts which organizations are allowed to log in. Names are matched case-insensitively.
+	Organizations []string
+	// Teams optionally restricts which teams are allowed to log in. Format is <org>/<team>, matched case-insensitively.
+	Teams []string
+}
+
+type GitLabIdentityProvider struct {
+	unversioned.TypeMeta
+
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server
+	// If empty, the default system roots are used
+	CA string
+
+	// URL is the oauth server base URL
+	URL string
+
+	// ClientID is the oauth client ID
+	ClientID string
+	// ClientSecret is the oauth client secret
+	ClientSecret string
+	// Groups optionally restricts which groups are allowed to log in. Groups are identified by their path,
+	// matched case-insensitively.
+	Groups []string
 }
 
 type GoogleIdentityProvider struct {
@@ -703,6 +833,11 @@ type OpenIDIdentityProvider struct {
 	// ExtraAuthorizeParameters are any custom parameters to add to the authorize request.
 	ExtraAuthorizeParameters map[string]string
 
+	// Issuer is the optional issuer identifier of the provider. If set, the URLs that are not specified are discovered
+	// from the provider configuration of the issuer, and id_tokens must have been issued by it. Discovered URLs must
+	// use https. If the issuer cannot be reached when the master starts, discovery is retried when the provider is used.
+	Issuer string
+
 	// URLs to use to authenticate
 	URLs OpenIDURLs
 
@@ -711,9 +846,9 @@ type OpenIDIdentityProvider struct {
 }
 
 type OpenIDURLs struct {
-	// Authorize is the oauth authorization URL
+	// Authorize is the oauth authorization URL. Required unless an issuer is specified.
 	Authorize string
-	// Token is the oauth token granting URL
+	// Token is the oauth token granting URL. Required unless an issuer is specified.
 	Token string
 	// UserInfo is the optional userinfo URL.
 	// If present, a granted access_token is used to request claims
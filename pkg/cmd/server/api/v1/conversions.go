@@ -26,6 +26,9 @@ func init() {
 			if len(obj.PolicyConfig.OpenShiftInfrastructureNamespace) == 0 {
 				obj.PolicyConfig.OpenShiftInfrastructureNamespace = bootstrappolicy.DefaultOpenShiftInfraNamespace
 			}
+			if obj.ProjectConfig.InfraProjects == nil {
+				obj.ProjectConfig.InfraProjects = []string{"default"}
+			}
 			if len(obj.RoutingConfig.Subdomain) == 0 {
 				obj.RoutingConfig.Subdomain = "router.default.svc.cluster.local"
 			}
@@ -187,6 +187,12 @@ type MasterConfig struct {
 	// PolicyConfig holds information about where to locate critical pieces of bootstrapping policy
 	PolicyConfig PolicyConfig `json:"policyConfig"`
 
+	// AuditConfig holds information about auditing policy changes and authorization denials
+	AuditConfig AuditConfig `json:"auditConfig"`
+
+	// BuildWebHookConfig controls the rate limiting and replay protection of build webhooks
+	BuildWebHookConfig BuildWebHookConfig `json:"buildWebHookConfig"`
+
 	// ProjectConfig holds information about project creation and defaults
 	ProjectConfig ProjectConfig `json:"projectConfig"`
 
@@ -212,10 +218,49 @@ type ImagePolicyConfig struct {
 	MaxScheduledImageImportsPerMinute int `json:"maxScheduledImageImportsPerMinute"`
 }
 
+// AuditConfig holds configuration for the audit capabilities
+type AuditConfig struct {
+	// Enabled turns on auditing.  Every change to policies, roles, and role bindings, and every exec, attach,
+	// and port-forward session with a pod is recorded.
+	Enabled bool `json:"enabled"`
+	// AuditFilePath is the file audit records are appended to, one JSON object per line
+	AuditFilePath string `json:"auditFilePath"`
+	// WebhookURL, if set, receives each audit record as a JSON POST request
+	WebhookURL string `json:"webhookURL"`
+	// DenialSampleInterval controls the auditing of authorization denials.  One in every DenialSampleInterval
+	// denials is recorded.  Zero, the default, disables the auditing of denials.
+	DenialSampleInterval int `json:"denialSampleInterval"`
+}
+
+// BuildWebHookConfig holds configuration for the webhooks that trigger builds.  The limits and the received
+// delivery IDs are kept in memory by each master, so with several masters every master enforces its own limits,
+// and a delivery replayed to another master, or after a restart, is accepted.
+type BuildWebHookConfig struct {
+	// RateLimitBurst is the number of webhook deliveries each build config accepts at once.  Zero, the default,
+	// disables rate limiting.  Deliveries over the limit are rejected with 429 Too Many Requests.
+	RateLimitBurst int `json:"rateLimitBurst"`
+	// RateLimitIntervalSeconds is the number of seconds after which a build config accepts one more delivery
+	// when its burst is used up.  It is required when RateLimitBurst is set.
+	RateLimitIntervalSeconds int `json:"rateLimitIntervalSeconds"`
+	// RejectReplayedDeliveries rejects, with 409 Conflict, a delivery whose ID (the X-GitHub-Delivery header)
+	// was already received for the build config.  Deliveries without an ID are always accepted, and a delivery
+	// that was rejected or failed to start a build may be retried.
+	RejectReplayedDeliveries bool `json:"rejectReplayedDeliveries"`
+}
+
 type ProjectConfig struct {
 	// DefaultNodeSelector holds default project node label selector
 	DefaultNodeSelector string `json:"defaultNodeSelector"`
 
+	// InfraNodeSelector is the node label selector for infrastructure pods: build pods, deployer pods, and every pod
+	// in InfraProjects, such as routers and registries. It takes the place of DefaultNodeSelector for those pods, while a
+	// project node selector still takes precedence. If empty, infrastructure pods use DefaultNodeSelector.
+	InfraNodeSelector string `json:"infraNodeSelector"`
+
+	// InfraProjects are the projects whose pods are all infrastructure pods. Defaults to the default project, where
+	// oadm router and oadm registry create routers and registries.
+	InfraProjects []string `json:"infraProjects"`
+
 	// ProjectRequestMessage is the string presented to a user if they are unable to request a project via the projectrequest api endpoint
 	ProjectRequestMessage string `json:"projectRequestMessage"`
 
@@ -224,6 +269,12 @@ type ProjectConfig struct {
 	// If it is not specified, a default template is used.
 	ProjectRequestTemplate string `json:"projectRequestTemplate"`
 
+	// ApplyTemplateDefaultsToExistingProjects controls whether the resource quotas and limit ranges of the project request
+	// template are also created in existing projects that have none, such as projects created before the template was set.
+	// Only projects that were requested, which carry the openshift.io/requester annotation, are changed, so system
+	// namespaces are left alone, and so are projects annotated with openshift.io/skip-template-defaults=true.
+	ApplyTemplateDefaultsToExistingProjects bool `json:"applyTemplateDefaultsToExistingProjects"`
+
 	// SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.
 	SecurityAllocator *SecurityAllocator `json:"securityAllocator"`
 }
@@ -264,6 +315,11 @@ type PolicyConfig struct {
 type RoutingConfig struct {
 	// Subdomain is the suffix appended to $service.$namespace. to form the default route hostname
 	Subdomain string `json:"subdomain"`
+	// HostnameTemplate is the template of default route hostnames. ${name}, ${namespace} and ${subdomain} are
+	// replaced with the name and namespace of the route and the subdomain. Defaults to ${name}-${namespace}.${subdomain}
+	// A route whose generated hostname is not a valid DNS subdomain, for instance because one of its labels is
+	// longer than 63 characters, gets no hostname and must set one itself. Service DNS names are not affected.
+	HostnameTemplate string `json:"hostnameTemplate"`
 }
 
 // MasterNetworkConfig to be passed to the compiled in network plugin
@@ -271,7 +327,18 @@ type MasterNetworkConfig struct {
 	NetworkPluginName  string `json:"networkPluginName"`
 	ClusterNetworkCIDR string `json:"clusterNetworkCIDR"`
 	HostSubnetLength   uint   `json:"hostSubnetLength"`
-	ServiceNetworkCIDR string `json:"serviceNetworkCIDR"`
+	// ClusterNetworks is the list of CIDRs that node subnets are allocated from, in order. If set,
+	// clusterNetworkCIDR and hostSubnetLength must be empty or match the first entry.
+	ClusterNetworks    []ClusterNetworkEntry `json:"clusterNetworks"`
+	ServiceNetworkCIDR string                `json:"serviceNetworkCIDR"`
+}
+
+// ClusterNetworkEntry is a CIDR that node subnets are allocated from
+type ClusterNetworkEntry struct {
+	// CIDR is the network that node subnets are allocated from
+	CIDR string `json:"cidr"`
+	// HostSubnetLength is the number of bits of each node subnet that are allocated to pods
+	HostSubnetLength uint `json:"hostSubnetLength"`
 }
 
 type ImageConfig struct {
@@ -374,6 +441,9 @@ type DNSConfig struct {
 	// BindNetwork is the type of network to bind to - defaults to "tcp4", accepts "tcp",
 	// "tcp4", and "tcp6"
 	BindNetwork string `json:"bindNetwork"`
+	// Nameservers is the list of upstream nameservers, as ip or ip:port, that queries for names outside
+	// the cluster domain are forwarded to. If empty, those queries are refused.
+	Nameservers []string `json:"nameservers"`
 }
 
 type AssetConfig struct {
@@ -451,6 +521,10 @@ type OAuthTemplates struct {
 	// ProviderSelection is a path to a file containing a go template used to render the provider selection page.
 	// If unspecified, the default provider selection page is used.
 	ProviderSelection string `json:"providerSelection"`
+
+	// Error is a path to a file containing a go template used to render error pages during the authentication or grant flow.
+	// If unspecified, the default error page is used.
+	Error string `json:"error"`
 }
 
 type ServiceAccountConfig struct {
@@ -479,9 +553,9 @@ type ServiceAccountConfig struct {
 }
 
 type TokenConfig struct {
-	// AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens
+	// AuthorizeTokenMaxAgeSeconds defines the maximum age of authorize tokens. 0 uses the server default
 	AuthorizeTokenMaxAgeSeconds int32 `json:"authorizeTokenMaxAgeSeconds"`
-	// AccessTokenMaxAgeSeconds defines the maximum age of access tokens
+	// AccessTokenMaxAgeSeconds defines the maximum age of access tokens. 0 uses the server default
 	AccessTokenMaxAgeSeconds int32 `json:"accessTokenMaxAgeSeconds"`
 }
 
@@ -520,7 +594,11 @@ type IdentityProvider struct {
 	UseAsChallenger bool `json:"challenge"`
 	// UseAsLogin indicates whether to use this identity provider for unauthenticated browsers to login against
 	UseAsLogin bool `json:"login"`
-	// MappingMethod determines how identities from this provider are mapped to users
+	// MappingMethod determines how identities from this provider are mapped to users. One of:
+	//   claim    - associate new identities with the user named after the preferred username, failing if that user already has another identity (default)
+	//   lookup   - only allow identities that were already mapped to a user, new identities are rejected
+	//   add      - associate new identities with the user named after the preferred username, creating or adding to that user
+	//   generate - associate new identities with a new user, generating a unique name if the preferred username is taken
 	MappingMethod string `json:"mappingMethod"`
 	// Provider contains the information about how to set up a specific identity provider
 	Provider runtime.RawExtension `json:"provider"`
@@ -531,6 +609,26 @@ type BasicAuthPasswordIdentityProvider struct {
 
 	// RemoteConnectionInfo contains information about how to connect to the external basic auth server
 	RemoteConnectionInfo `json:",inline"`
+
+	// Attributes maps keys of the JSON response from the external basic auth server to identity fields
+	Attributes BasicAuthAttributeMapping `json:"attributes"`
+}
+
+// BasicAuthAttributeMapping lists the keys of the JSON response from an external basic auth server to read identity fields from.
+// For each field, the first key with a non-empty value is used.
+type BasicAuthAttributeMapping struct {
+	// ID is the list of keys whose values should be used as the user ID.
+	// If unspecified, the "sub" key is used
+	ID []string `json:"id"`
+	// PreferredUsername is the list of keys whose values should be used as the preferred username.
+	// If unspecified, the "preferred_username" key is used
+	PreferredUsername []string `json:"preferredUsername"`
+	// Name is the list of keys whose values should be used as the display name.
+	// If unspecified, the "name" key is used
+	Name []string `json:"name"`
+	// Email is the list of keys whose values should be used as the email address.
+	// If unspecified, the "email" key is used
+	Email []string `json:"email"`
 }
 
 type AllowAllPasswordIdentityProvider struct {
@@ -611,10 +709,19 @@ type RequestHeaderIdentityProvider struct {
 	//   https://www.example.com/auth-proxy/oauth/authorize?${query}
 	ChallengeURL string `json:"challengeURL"`
 
-	// ClientCA is a file with the trusted signer certs.  If empty, no request verification is done, and any direct request to the OAuth server can impersonate any identity from this provider, merely by setting a request header.
+	// ClientCA is a file with the trusted signer certs. It is required: requests are only trusted from proxies presenting a client certificate signed by one of them, since any direct request to the OAuth server could otherwise impersonate any identity from this provider, merely by setting a request header.
 	ClientCA string `json:"clientCA"`
+	// ClientCommonNames is an optional list of common names to require a match from. If empty, any client certificate validated against the clientCA bundle is considered authoritative.
+	ClientCommonNames []string `json:"clientCommonNames"`
+
 	// Headers is the set of headers to check for identity information
 	Headers []string `json:"headers"`
+	// PreferredUsernameHeaders is the set of headers to check for the preferred username
+	PreferredUsernameHeaders []string `json:"preferredUsernameHeaders"`
+	// NameHeaders is the set of headers to check for the display name
+	NameHeaders []string `json:"nameHeaders"`
+	// EmailHeaders is the set of headers to check for the email address
+	EmailHeaders []string `json:"emailHeaders"`
 }
 
 type GitHubIdentityProvider struct {
@@ -624,6 +731,29 @@ type GitHubIdentityProvider struct {
 	ClientID string `json:"clientID"`
 	// ClientSecret is the oauth client secret
 	ClientSecret string `json:"clientSecret"`
+	// Organizations optionally restricts which organizations are allowed to log in. Names are matched case-insensitively.
+	Organizations []string `json:"organizations"`
+	// Teams optionally restricts which teams are allowed to log in. Format is <org>/<team>, matched case-insensitively.
+	Teams []string `json:"teams"`
+}
+
+type GitLabIdentityProvider struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// CA is the optional trusted certificate authority bundle to use when making requests to the server
+	// If empty, the default system roots are used
+	CA string `json:"ca"`
+
+	// URL is the oauth server base URL
+	URL string `json:"url"`
+
+	// ClientID is the oauth client ID
+	ClientID string `json:"clientID"`
+	// ClientSecret is the oauth client secret
+	ClientSecret string `json:"clientSecret"`
+	// Groups optionally restricts which groups are allowed to log in. Groups are identified by their path,
+	// matched case-insensitively.
+	Groups []string `json:"groups"`
 }
 
 type GoogleIdentityProvider struct {
@@ -656,6 +786,11 @@ type OpenIDIdentityProvider struct {
 	// ExtraAuthorizeParameters are any custom parameters to add to the authorize request.
 	ExtraAuthorizeParameters map[string]string `json:"extraAuthorizeParameters"`
 
+	// Issuer is the optional issuer identifier of the provider. If set, the URLs that are not specified are discovered
+	// from the provider configuration of the issuer, and id_tokens must have been issued by it. Discovered URLs must
+	// use https. If the issuer cannot be reached when the master starts, discovery is retried when the provider is used.
+	Issuer string `json:"issuer"`
+
 	// URLs to use to authenticate
 	URLs OpenIDURLs `json:"urls"`
 
@@ -664,9 +799,9 @@ type OpenIDIdentityProvider struct {
 }
 
 type OpenIDURLs struct {
-	// Authorize is the oauth authorization URL
+	// Authorize is the oauth authorization URL. Required unless an issuer is specified.
 	Authorize string `json:"authorize"`
-	// Token is the oauth token granting URL
+	// Token is the oauth token granting URL. Required unless an issuer is specified.
 	Token string `json:"token"`
 	// UserInfo is the optional userinfo URL.
 	// If present, a granted access_token is used to request claims
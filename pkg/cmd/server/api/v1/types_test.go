@@ -90,6 +90,15 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+auditConfig:
+  auditFilePath: ""
+  denialSampleInterval: 0
+  enabled: false
+  webhookURL: ""
+buildWebHookConfig:
+  rateLimitBurst: 0
+  rateLimitIntervalSeconds: 0
+  rejectReplayedDeliveries: false
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -97,6 +106,7 @@ disabledFeatures: null
 dnsConfig:
   bindAddress: ""
   bindNetwork: ""
+  nameservers: null
 etcdClientInfo:
   ca: ""
   certFile: ""
@@ -170,6 +180,7 @@ masterClients:
 masterPublicURL: ""
 networkConfig:
   clusterNetworkCIDR: ""
+  clusterNetworks: null
   hostSubnetLength: 0
   networkPluginName: ""
   serviceNetworkCIDR: ""
@@ -185,6 +196,11 @@ oauthConfig:
     name: ""
     provider:
       apiVersion: v1
+      attributes:
+        email: null
+        id: null
+        name: null
+        preferredUsername: null
       ca: ""
       certFile: ""
       keyFile: ""
@@ -237,9 +253,13 @@ oauthConfig:
       apiVersion: v1
       challengeURL: ""
       clientCA: ""
+      clientCommonNames: null
+      emailHeaders: null
       headers: null
       kind: RequestHeaderIdentityProvider
       loginURL: ""
+      nameHeaders: null
+      preferredUsernameHeaders: null
   - challenge: false
     login: false
     mappingMethod: ""
@@ -261,6 +281,20 @@ oauthConfig:
       clientID: ""
       clientSecret: ""
       kind: GitHubIdentityProvider
+      organizations: null
+      teams: null
+  - challenge: false
+    login: false
+    mappingMethod: ""
+    name: ""
+    provider:
+      apiVersion: v1
+      ca: ""
+      clientID: ""
+      clientSecret: ""
+      groups: null
+      kind: GitLabIdentityProvider
+      url: ""
   - challenge: false
     login: false
     mappingMethod: ""
@@ -287,6 +321,7 @@ oauthConfig:
       clientSecret: ""
       extraAuthorizeParameters: null
       extraScopes: null
+      issuer: ""
       kind: OpenIDIdentityProvider
       urls:
         authorize: ""
@@ -300,6 +335,7 @@ oauthConfig:
     sessionName: ""
     sessionSecretsFile: ""
   templates:
+    error: ""
     login: ""
     providerSelection: ""
   tokenConfig:
@@ -311,11 +347,15 @@ policyConfig:
   openshiftInfrastructureNamespace: ""
   openshiftSharedResourcesNamespace: ""
 projectConfig:
+  applyTemplateDefaultsToExistingProjects: false
   defaultNodeSelector: ""
+  infraNodeSelector: ""
+  infraProjects: null
   projectRequestMessage: ""
   projectRequestTemplate: ""
   securityAllocator: null
 routingConfig:
+  hostnameTemplate: ""
   subdomain: ""
 serviceAccountConfig:
   limitSecretReferences: false
@@ -388,6 +428,7 @@ func TestMasterConfig(t *testing.T) {
 				{Provider: runtime.EmbeddedObject{Object: &internal.RequestHeaderIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.KeystonePasswordIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.GitHubIdentityProvider{}}},
+				{Provider: runtime.EmbeddedObject{Object: &internal.GitLabIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.GoogleIdentityProvider{}}},
 				{Provider: runtime.EmbeddedObject{Object: &internal.OpenIDIdentityProvider{}}},
 			},
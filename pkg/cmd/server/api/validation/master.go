@@ -23,6 +23,7 @@ import (
 	"github.com/openshift/origin/pkg/security/mcs"
 	"github.com/openshift/origin/pkg/security/uid"
 	"github.com/openshift/origin/pkg/util/labelselector"
+	routeplugin "github.com/openshift/origin/plugins/route/allocation/simple"
 )
 
 // TODO: this should just be two return arrays, no need to be clever
@@ -92,10 +93,18 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 			}
 		}
 
+		if len(config.AssetConfig.MasterPublicURL) > 0 && config.AssetConfig.MasterPublicURL != config.MasterPublicURL {
+			validationResults.AddWarnings(field.Invalid(assetConfigPath.Child("masterPublicURL"), config.AssetConfig.MasterPublicURL, "should match masterPublicURL, or the web console will send API requests to a different server than clients use"))
+		}
+
 		// TODO warn when the CORS list does not include the assetConfig.publicURL host:port
 		// only warn cause they could handle CORS headers themselves in a proxy
 	}
 
+	if config.OAuthConfig != nil && len(config.OAuthConfig.MasterPublicURL) > 0 && config.OAuthConfig.MasterPublicURL != config.MasterPublicURL {
+		validationResults.AddWarnings(field.Invalid(fldPath.Child("oauthConfig", "masterPublicURL"), config.OAuthConfig.MasterPublicURL, "should match masterPublicURL, or OAuth redirects will send clients to a different server"))
+	}
+
 	if config.DNSConfig != nil {
 		dnsConfigPath := fldPath.Child("dnsConfig")
 		validationResults.AddErrors(ValidateHostPort(config.DNSConfig.BindAddress, dnsConfigPath.Child("bindAddress"))...)
@@ -104,6 +113,9 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		default:
 			validationResults.AddErrors(field.Invalid(dnsConfigPath.Child("bindNetwork"), config.DNSConfig.BindNetwork, "must be 'tcp', 'tcp4', or 'tcp6'"))
 		}
+		for i, nameserver := range config.DNSConfig.Nameservers {
+			validationResults.AddErrors(ValidateNameserver(nameserver, dnsConfigPath.Child("nameservers").Index(i))...)
+		}
 	}
 
 	if config.EtcdConfig != nil {
@@ -127,6 +139,10 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 
 	validationResults.AddErrors(ValidateImagePolicyConfig(config.ImagePolicyConfig, fldPath.Child("imagePolicyConfig"))...)
 
+	validationResults.Append(ValidateAuditConfig(config.AuditConfig, fldPath.Child("auditConfig")))
+
+	validationResults.AddErrors(ValidateBuildWebHookConfig(config.BuildWebHookConfig, fldPath.Child("buildWebHookConfig"))...)
+
 	validationResults.AddErrors(ValidateKubeletConnectionInfo(config.KubeletClientInfo, fldPath.Child("kubeletClientInfo"))...)
 
 	builtInKubernetes := config.KubernetesMasterConfig != nil
@@ -148,6 +164,8 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		}
 	}
 
+	validationResults.AddErrors(ValidateMasterNetworkConfig(config.NetworkConfig, fldPath.Child("networkConfig"))...)
+
 	validationResults.AddErrors(ValidateKubeConfig(config.MasterClients.OpenShiftLoopbackKubeConfig, fldPath.Child("masterClients", "openShiftLoopbackKubeConfig"))...)
 
 	if len(config.MasterClients.ExternalKubernetesKubeConfig) > 0 {
@@ -400,6 +418,80 @@ func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path
 	return errs
 }
 
+func ValidateAuditConfig(config api.AuditConfig, fldPath *field.Path) ValidationResults {
+	validationResults := ValidationResults{}
+
+	if len(config.WebhookURL) > 0 {
+		if _, urlErrs := ValidateURL(config.WebhookURL, fldPath.Child("webhookURL")); len(urlErrs) > 0 {
+			validationResults.AddErrors(urlErrs...)
+		}
+	}
+	if config.DenialSampleInterval < 0 {
+		validationResults.AddErrors(field.Invalid(fldPath.Child("denialSampleInterval"), config.DenialSampleInterval, "must be a positive integer or 0"))
+	}
+	if config.Enabled && len(config.AuditFilePath) == 0 && len(config.WebhookURL) == 0 {
+		validationResults.AddWarnings(field.Invalid(fldPath.Child("auditFilePath"), config.AuditFilePath, "no audit file or webhook is set, audit records will be written to the master log"))
+	}
+
+	return validationResults
+}
+
+// ValidateMasterNetworkConfig ensures that the cluster networks are valid, non overlapping CIDRs and that
+// clusterNetworkCIDR and hostSubnetLength agree with the first cluster network.
+func ValidateMasterNetworkConfig(config api.MasterNetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.ClusterNetworks) == 0 {
+		return allErrs
+	}
+
+	first := config.ClusterNetworks[0]
+	if len(config.ClusterNetworkCIDR) > 0 && config.ClusterNetworkCIDR != first.CIDR {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("clusterNetworkCIDR"), config.ClusterNetworkCIDR, "must be empty or match the cidr of the first entry of clusterNetworks"))
+	}
+	if config.HostSubnetLength != 0 && config.HostSubnetLength != first.HostSubnetLength {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hostSubnetLength"), config.HostSubnetLength, "must be 0 or match the hostSubnetLength of the first entry of clusterNetworks"))
+	}
+
+	clusterIPNets := []*net.IPNet{}
+	for i, entry := range config.ClusterNetworks {
+		entryPath := fldPath.Child("clusterNetworks").Index(i)
+		clusterIP, clusterIPNet, err := net.ParseCIDR(strings.TrimSpace(entry.CIDR))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(entryPath.Child("cidr"), entry.CIDR, "must be a valid CIDR notation IP range (e.g. 10.128.0.0/14)"))
+			continue
+		}
+		ones, bits := clusterIPNet.Mask.Size()
+		if entry.HostSubnetLength >= uint(bits-ones) {
+			allErrs = append(allErrs, field.Invalid(entryPath.Child("hostSubnetLength"), entry.HostSubnetLength, fmt.Sprintf("must be less than %d, the number of host bits of %s", bits-ones, entry.CIDR)))
+		}
+		for _, other := range clusterIPNets {
+			if other.Contains(clusterIP) || clusterIPNet.Contains(other.IP) {
+				allErrs = append(allErrs, field.Invalid(entryPath.Child("cidr"), entry.CIDR, fmt.Sprintf("overlaps with %s", other.String())))
+			}
+		}
+		clusterIPNets = append(clusterIPNets, clusterIPNet)
+	}
+
+	return allErrs
+}
+
+func ValidateBuildWebHookConfig(config api.BuildWebHookConfig, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if config.RateLimitBurst < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("rateLimitBurst"), config.RateLimitBurst, "must be a positive integer or 0"))
+	}
+	if config.RateLimitIntervalSeconds < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("rateLimitIntervalSeconds"), config.RateLimitIntervalSeconds, "must be a positive integer or 0"))
+	}
+	if config.RateLimitBurst > 0 && config.RateLimitIntervalSeconds == 0 {
+		errs = append(errs, field.Required(fldPath.Child("rateLimitIntervalSeconds")))
+	}
+
+	return errs
+}
+
 func ValidateKubeletConnectionInfo(config api.KubeletConnectionInfo, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -514,6 +606,17 @@ func ValidateProjectConfig(config api.ProjectConfig, fldPath *field.Path) Valida
 		}
 	}
 
+	if len(config.InfraNodeSelector) > 0 {
+		if _, err := labelselector.Parse(config.InfraNodeSelector); err != nil {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("infraNodeSelector"), config.InfraNodeSelector, "must be a valid label selector"))
+		}
+	}
+	for i, project := range config.InfraProjects {
+		if ok, msg := kvalidation.ValidateNamespaceName(project, false); !ok {
+			validationResults.AddErrors(field.Invalid(fldPath.Child("infraProjects").Index(i), project, msg))
+		}
+	}
+
 	if alloc := config.SecurityAllocator; alloc != nil {
 		securityAllocatorPath := fldPath.Child("securityAllocator")
 		if _, err := uid.ParseRange(alloc.UIDAllocatorRange); err != nil {
@@ -543,6 +646,12 @@ func ValidateRoutingConfig(config api.RoutingConfig, fldPath *field.Path) field.
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("subdomain"), config.Subdomain, "must be a valid subdomain"))
 	}
 
+	if len(config.HostnameTemplate) > 0 && len(config.Subdomain) > 0 {
+		if err := routeplugin.ValidateHostnameTemplate(config.HostnameTemplate, config.Subdomain); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hostnameTemplate"), config.HostnameTemplate, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
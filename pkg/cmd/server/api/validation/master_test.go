@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -253,3 +254,218 @@ func TestValidateAdmissionPluginConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAuditConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config           api.AuditConfig
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		"disabled": {
+			config: api.AuditConfig{},
+		},
+		"file": {
+			config: api.AuditConfig{Enabled: true, AuditFilePath: "/var/log/audit.log", DenialSampleInterval: 10},
+		},
+		"webhook": {
+			config: api.AuditConfig{Enabled: true, WebhookURL: "https://audit.example.com/records"},
+		},
+		"no backend": {
+			config:           api.AuditConfig{Enabled: true},
+			expectedWarnings: 1,
+		},
+		"invalid webhook": {
+			config:         api.AuditConfig{Enabled: true, WebhookURL: "audit.example.com"},
+			expectedErrors: 2, // missing scheme and host
+		},
+		"negative sample interval": {
+			config:         api.AuditConfig{Enabled: true, AuditFilePath: "/var/log/audit.log", DenialSampleInterval: -1},
+			expectedErrors: 1,
+		},
+	}
+
+	for k, tc := range testCases {
+		results := ValidateAuditConfig(tc.config, field.NewPath("auditConfig"))
+		if len(results.Errors) != tc.expectedErrors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrors, results.Errors)
+		}
+		if len(results.Warnings) != tc.expectedWarnings {
+			t.Errorf("%s: expected %d warnings, got %v", k, tc.expectedWarnings, results.Warnings)
+		}
+	}
+}
+
+func TestValidateBuildWebHookConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config         api.BuildWebHookConfig
+		expectedErrors int
+	}{
+		"disabled": {
+			config: api.BuildWebHookConfig{},
+		},
+		"rate limit": {
+			config: api.BuildWebHookConfig{RateLimitBurst: 5, RateLimitIntervalSeconds: 60},
+		},
+		"replay protection": {
+			config: api.BuildWebHookConfig{RejectReplayedDeliveries: true},
+		},
+		"missing interval": {
+			config:         api.BuildWebHookConfig{RateLimitBurst: 5},
+			expectedErrors: 1,
+		},
+		"negative values": {
+			config:         api.BuildWebHookConfig{RateLimitBurst: -1, RateLimitIntervalSeconds: -1},
+			expectedErrors: 2,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateBuildWebHookConfig(tc.config, field.NewPath("buildWebHookConfig"))
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrors, errs)
+		}
+	}
+}
+
+func TestValidateRoutingConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config         api.RoutingConfig
+		expectedErrors int
+	}{
+		"default template": {
+			config: api.RoutingConfig{Subdomain: "apps.example.com"},
+		},
+		"custom template": {
+			config: api.RoutingConfig{Subdomain: "apps.example.com", HostnameTemplate: "${name}.${namespace}.${subdomain}"},
+		},
+		"unknown variable": {
+			config:         api.RoutingConfig{Subdomain: "apps.example.com", HostnameTemplate: "${name}.${service}.${subdomain}"},
+			expectedErrors: 1,
+		},
+		"invalid hostname": {
+			config:         api.RoutingConfig{Subdomain: "apps.example.com", HostnameTemplate: "${name}_${namespace}.${subdomain}"},
+			expectedErrors: 1,
+		},
+		"missing subdomain": {
+			config:         api.RoutingConfig{HostnameTemplate: "${name}.${namespace}.${subdomain}"},
+			expectedErrors: 1,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateRoutingConfig(tc.config, field.NewPath("routingConfig"))
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrors, errs)
+		}
+	}
+}
+
+func TestValidateMasterNetworkConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config         api.MasterNetworkConfig
+		expectedErrors int
+	}{
+		"single network": {
+			config: api.MasterNetworkConfig{ClusterNetworkCIDR: "10.1.0.0/16", HostSubnetLength: 8},
+		},
+		"multiple networks": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworks: []api.ClusterNetworkEntry{{CIDR: "10.1.0.0/16", HostSubnetLength: 8}, {CIDR: "10.128.0.0/14", HostSubnetLength: 9}},
+			},
+		},
+		"multiple networks matching the single network": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworkCIDR: "10.1.0.0/16",
+				HostSubnetLength:   8,
+				ClusterNetworks:    []api.ClusterNetworkEntry{{CIDR: "10.1.0.0/16", HostSubnetLength: 8}, {CIDR: "10.128.0.0/14", HostSubnetLength: 9}},
+			},
+		},
+		"multiple networks not matching the single network": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworkCIDR: "10.2.0.0/16",
+				HostSubnetLength:   9,
+				ClusterNetworks:    []api.ClusterNetworkEntry{{CIDR: "10.1.0.0/16", HostSubnetLength: 8}},
+			},
+			expectedErrors: 2,
+		},
+		"invalid network": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworks: []api.ClusterNetworkEntry{{CIDR: "10.1.0.0", HostSubnetLength: 8}},
+			},
+			expectedErrors: 1,
+		},
+		"host subnet too large": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworks: []api.ClusterNetworkEntry{{CIDR: "10.1.0.0/24", HostSubnetLength: 8}},
+			},
+			expectedErrors: 1,
+		},
+		"overlapping networks": {
+			config: api.MasterNetworkConfig{
+				ClusterNetworks: []api.ClusterNetworkEntry{{CIDR: "10.1.0.0/16", HostSubnetLength: 8}, {CIDR: "10.0.0.0/8", HostSubnetLength: 8}},
+			},
+			expectedErrors: 1,
+		},
+	}
+
+	for k, tc := range testCases {
+		errs := ValidateMasterNetworkConfig(tc.config, field.NewPath("networkConfig"))
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrors, errs)
+		}
+	}
+}
+
+func TestValidateMasterConfigPublicURLs(t *testing.T) {
+	testCases := map[string]struct {
+		assetMasterPublicURL string
+		oauthMasterPublicURL string
+		expectedWarnings     []string
+	}{
+		"matching": {
+			assetMasterPublicURL: "https://master.example.com:8443",
+			oauthMasterPublicURL: "https://master.example.com:8443",
+		},
+		"asset mismatch": {
+			assetMasterPublicURL: "https://other.example.com:8443",
+			oauthMasterPublicURL: "https://master.example.com:8443",
+			expectedWarnings:     []string{"assetConfig.masterPublicURL"},
+		},
+		"oauth mismatch": {
+			assetMasterPublicURL: "https://master.example.com:8443",
+			oauthMasterPublicURL: "https://master.example.com",
+			expectedWarnings:     []string{"oauthConfig.masterPublicURL"},
+		},
+	}
+
+	for k, tc := range testCases {
+		config := &configapi.MasterConfig{
+			MasterPublicURL: "https://master.example.com:8443",
+			AssetConfig: &configapi.AssetConfig{
+				MasterPublicURL: tc.assetMasterPublicURL,
+				PublicURL:       "https://master.example.com:8443/console/",
+			},
+			OAuthConfig: &configapi.OAuthConfig{
+				MasterPublicURL: tc.oauthMasterPublicURL,
+				AssetPublicURL:  "https://master.example.com:8443/console/",
+			},
+		}
+		results := ValidateMasterConfig(config, nil)
+
+		actual := []string{}
+		for _, warning := range results.Warnings {
+			if strings.HasSuffix(warning.Field, "masterPublicURL") {
+				actual = append(actual, warning.Field)
+			}
+		}
+		if len(actual) != len(tc.expectedWarnings) {
+			t.Errorf("%s: expected warnings for %v, got %v", k, tc.expectedWarnings, actual)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != tc.expectedWarnings[i] {
+				t.Errorf("%s: expected warnings for %v, got %v", k, tc.expectedWarnings, actual)
+			}
+		}
+	}
+}
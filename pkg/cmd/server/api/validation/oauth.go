@@ -10,6 +10,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/auth/authenticator/redirector"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
@@ -47,6 +48,8 @@ func ValidateOAuthConfig(config *api.OAuthConfig, fldPath *field.Path) Validatio
 
 	validationResults.AddErrors(validateGrantConfig(config.GrantConfig, fldPath.Child("grantConfig"))...)
 
+	validationResults.AddErrors(validateTokenConfig(config.TokenConfig, fldPath.Child("tokenConfig"))...)
+
 	providerNames := sets.NewString()
 	redirectingIdentityProviders := []string{}
 
@@ -122,6 +125,17 @@ func ValidateOAuthConfig(config *api.OAuthConfig, fldPath *field.Path) Validatio
 				}
 			}
 		}
+
+		if len(config.Templates.Error) > 0 {
+			content, err := ioutil.ReadFile(config.Templates.Error)
+			if err != nil {
+				validationResults.AddErrors(field.Invalid(fldPath.Child("templates", "error"), config.Templates.Error, "could not read file"))
+			} else {
+				for _, err = range errorpage.ValidateErrorPageTemplate(content) {
+					validationResults.AddErrors(field.Invalid(fldPath.Child("templates", "error"), config.Templates.Error, err.Error()))
+				}
+			}
+		}
 	}
 
 	return validationResults
@@ -160,6 +174,7 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 
 		case (*api.BasicAuthPasswordIdentityProvider):
 			validationResults.AddErrors(ValidateRemoteConnectionInfo(provider.RemoteConnectionInfo, providerPath)...)
+			validationResults.AddErrors(ValidateBasicAuthAttributeMapping(provider.Attributes, providerPath.Child("attributes"))...)
 
 		case (*api.HTPasswdPasswordIdentityProvider):
 			validationResults.AddErrors(ValidateFile(provider.File, providerPath.Child("file"))...)
@@ -171,7 +186,10 @@ func ValidateIdentityProvider(identityProvider api.IdentityProvider, fldPath *fi
 			validationResults.Append(ValidateKeystoneIdentityProvider(provider, identityProvider, providerPath))
 
 		case (*api.GitHubIdentityProvider):
-			validationResults.AddErrors(ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+			validationResults.AddErrors(ValidateGitHubIdentityProvider(provider, identityProvider)...)
+
+		case (*api.GitLabIdentityProvider):
+			validationResults.AddErrors(ValidateGitLabIdentityProvider(provider, identityProvider)...)
 
 		case (*api.GoogleIdentityProvider):
 			validationResults.AddErrors(ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
@@ -215,11 +233,42 @@ func ValidateKeystoneIdentityProvider(provider *api.KeystonePasswordIdentityProv
 	return validationResults
 }
 
+func ValidateBasicAuthAttributeMapping(attributes api.BasicAuthAttributeMapping, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateAttributeKeys(attributes.ID, fldPath.Child("id"))...)
+	allErrs = append(allErrs, validateAttributeKeys(attributes.PreferredUsername, fldPath.Child("preferredUsername"))...)
+	allErrs = append(allErrs, validateAttributeKeys(attributes.Name, fldPath.Child("name"))...)
+	allErrs = append(allErrs, validateAttributeKeys(attributes.Email, fldPath.Child("email"))...)
+
+	return allErrs
+}
+
+// validateAttributeKeys requires each key in the list to be non-empty
+func validateAttributeKeys(keys []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, key := range keys {
+		if len(key) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i)))
+		}
+	}
+	return allErrs
+}
+
 func ValidateRequestHeaderIdentityProvider(provider *api.RequestHeaderIdentityProvider, identityProvider api.IdentityProvider) ValidationResults {
 	validationResults := ValidationResults{}
 
 	if len(provider.ClientCA) > 0 {
 		validationResults.AddErrors(ValidateFile(provider.ClientCA, field.NewPath("provider", "clientCA"))...)
+	} else {
+		err := field.Required(field.NewPath("provider", "clientCA"))
+		err.Detail = "without a clientCA, any request directly against the OAuth server can impersonate any identity from this provider"
+		validationResults.AddErrors(err)
+	}
+	for i, name := range provider.ClientCommonNames {
+		if len(name) == 0 {
+			validationResults.AddErrors(field.Required(field.NewPath("provider", "clientCommonNames").Index(i)))
+		}
 	}
 	if len(provider.Headers) == 0 {
 		validationResults.AddErrors(field.Required(field.NewPath("provider", "headers")))
@@ -261,12 +310,6 @@ func ValidateRequestHeaderIdentityProvider(provider *api.RequestHeaderIdentityPr
 			)
 		}
 	}
-
-	// Warn if it looks like they expect direct requests to the OAuth endpoints, and have not secured the header checking with a client certificate check
-	if len(provider.ClientCA) == 0 && (len(provider.ChallengeURL) > 0 || len(provider.LoginURL) > 0) {
-		validationResults.AddWarnings(field.Invalid(field.NewPath("provider", "clientCA"), "", "if no clientCA is set, no request verification is done, and any request directly against the OAuth server can impersonate any identity from this provider"))
-	}
-
 	return validationResults
 }
 
@@ -286,6 +329,51 @@ func ValidateOAuthIdentityProvider(clientID, clientSecret string, challenge bool
 	return allErrs
 }
 
+func ValidateGitHubIdentityProvider(provider *api.GitHubIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+
+	providerPath := field.NewPath("provider")
+	if len(provider.Organizations) > 0 && len(provider.Teams) > 0 {
+		allErrs = append(allErrs, field.Invalid(providerPath.Child("organizations"), provider.Organizations, "specify organizations or teams, not both"))
+	}
+	for i, organization := range provider.Organizations {
+		if len(organization) == 0 {
+			allErrs = append(allErrs, field.Required(providerPath.Child("organizations").Index(i)))
+		}
+	}
+	for i, team := range provider.Teams {
+		if parts := strings.Split(team, "/"); len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(providerPath.Child("teams").Index(i), team, "must be in the format <org>/<team>"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateGitLabIdentityProvider(provider *api.GitLabIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, ValidateOAuthIdentityProvider(provider.ClientID, provider.ClientSecret, identityProvider.UseAsChallenger)...)
+
+	providerPath := field.NewPath("provider")
+	_, urlErrs := ValidateSecureURL(provider.URL, providerPath.Child("url"))
+	allErrs = append(allErrs, urlErrs...)
+
+	for i, group := range provider.Groups {
+		if len(group) == 0 {
+			allErrs = append(allErrs, field.Required(providerPath.Child("groups").Index(i)))
+		}
+	}
+
+	if len(provider.CA) != 0 {
+		allErrs = append(allErrs, ValidateFile(provider.CA, providerPath.Child("ca"))...)
+	}
+
+	return allErrs
+}
+
 func ValidateOpenIDIdentityProvider(provider *api.OpenIDIdentityProvider, identityProvider api.IdentityProvider) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -295,18 +383,34 @@ func ValidateOpenIDIdentityProvider(provider *api.OpenIDIdentityProvider, identi
 	// http://openid.net/specs/openid-connect-core-1_0.html#AuthorizationEndpoint
 	providerPath := field.NewPath("provider")
 	urlsPath := providerPath.Child("urls")
-	_, urlErrs := ValidateSecureURL(provider.URLs.Authorize, urlsPath.Child("authorize"))
-	allErrs = append(allErrs, urlErrs...)
+	discover := len(provider.Issuer) != 0
+	if !discover || len(provider.URLs.Authorize) != 0 {
+		_, urlErrs := ValidateSecureURL(provider.URLs.Authorize, urlsPath.Child("authorize"))
+		allErrs = append(allErrs, urlErrs...)
+	}
 
 	// Communication with the Token Endpoint MUST utilize TLS
 	// http://openid.net/specs/openid-connect-core-1_0.html#TokenEndpoint
-	_, urlErrs = ValidateSecureURL(provider.URLs.Token, urlsPath.Child("token"))
-	allErrs = append(allErrs, urlErrs...)
+	if !discover || len(provider.URLs.Token) != 0 {
+		_, urlErrs := ValidateSecureURL(provider.URLs.Token, urlsPath.Child("token"))
+		allErrs = append(allErrs, urlErrs...)
+	}
+
+	if discover {
+		// The issuer is a case sensitive URL using the https scheme with no query or fragment components
+		// http://openid.net/specs/openid-connect-discovery-1_0.html#IssuerDiscovery
+		issuerPath := providerPath.Child("issuer")
+		issuerURL, urlErrs := ValidateSecureURL(provider.Issuer, issuerPath)
+		allErrs = append(allErrs, urlErrs...)
+		if len(urlErrs) == 0 && (len(issuerURL.RawQuery) != 0 || len(issuerURL.Fragment) != 0) {
+			allErrs = append(allErrs, field.Invalid(issuerPath, provider.Issuer, "must not contain a query or fragment"))
+		}
+	}
 
 	if len(provider.URLs.UserInfo) != 0 {
 		// Communication with the UserInfo Endpoint MUST utilize TLS
 		// http://openid.net/specs/openid-connect-core-1_0.html#UserInfo
-		_, urlErrs = ValidateSecureURL(provider.URLs.UserInfo, urlsPath.Child("userInfo"))
+		_, urlErrs := ValidateSecureURL(provider.URLs.UserInfo, urlsPath.Child("userInfo"))
 		allErrs = append(allErrs, urlErrs...)
 	}
 
@@ -332,6 +436,20 @@ func validateGrantConfig(config api.GrantConfig, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func validateTokenConfig(config api.TokenConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// A zero max age falls back to the server default
+	if config.AuthorizeTokenMaxAgeSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("authorizeTokenMaxAgeSeconds"), config.AuthorizeTokenMaxAgeSeconds, "must be greater than or equal to 0"))
+	}
+	if config.AccessTokenMaxAgeSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("accessTokenMaxAgeSeconds"), config.AccessTokenMaxAgeSeconds, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
 func validateSessionConfig(config *api.SessionConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -0,0 +1,252 @@
+package validation
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/cmd/server/api"
+)
+
+func TestValidateOpenIDIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider api.OpenIDIdentityProvider
+		fields   []string
+	}{
+		"explicit urls": {
+			provider: api.OpenIDIdentityProvider{
+				URLs: api.OpenIDURLs{Authorize: "https://example.com/auth", Token: "https://example.com/token"},
+			},
+		},
+		"missing urls": {
+			provider: api.OpenIDIdentityProvider{},
+			fields:   []string{"provider.urls.authorize", "provider.urls.token"},
+		},
+		"discovered urls": {
+			provider: api.OpenIDIdentityProvider{
+				Issuer: "https://example.com",
+			},
+		},
+		"discovered and explicit urls": {
+			provider: api.OpenIDIdentityProvider{
+				Issuer: "https://example.com",
+				URLs:   api.OpenIDURLs{Token: "http://example.com/token"},
+			},
+			fields: []string{"provider.urls.token"},
+		},
+		"insecure issuer": {
+			provider: api.OpenIDIdentityProvider{
+				Issuer: "http://example.com",
+			},
+			fields: []string{"provider.issuer"},
+		},
+		"issuer with query": {
+			provider: api.OpenIDIdentityProvider{
+				Issuer: "https://example.com?tenant=foo",
+			},
+			fields: []string{"provider.issuer"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.provider.ClientID = "client"
+		tc.provider.ClientSecret = "secret"
+		tc.provider.Claims.ID = []string{"sub"}
+
+		errs := ValidateOpenIDIdentityProvider(&tc.provider, api.IdentityProvider{})
+		fields := sets.NewString()
+		for i := range errs {
+			fields.Insert(errs[i].Field)
+		}
+		if !fields.Equal(sets.NewString(tc.fields...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+		}
+	}
+}
+
+func TestValidateGitHubIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider api.GitHubIdentityProvider
+		fields   []string
+	}{
+		"unrestricted": {},
+		"organizations": {
+			provider: api.GitHubIdentityProvider{Organizations: []string{"openshift"}},
+		},
+		"teams": {
+			provider: api.GitHubIdentityProvider{Teams: []string{"openshift/developers"}},
+		},
+		"organizations and teams": {
+			provider: api.GitHubIdentityProvider{Organizations: []string{"openshift"}, Teams: []string{"openshift/developers"}},
+			fields:   []string{"provider.organizations"},
+		},
+		"invalid team": {
+			provider: api.GitHubIdentityProvider{Teams: []string{"developers"}},
+			fields:   []string{"provider.teams[0]"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.provider.ClientID = "client"
+		tc.provider.ClientSecret = "secret"
+
+		errs := ValidateGitHubIdentityProvider(&tc.provider, api.IdentityProvider{})
+		fields := sets.NewString()
+		for i := range errs {
+			fields.Insert(errs[i].Field)
+		}
+		if !fields.Equal(sets.NewString(tc.fields...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+		}
+	}
+}
+
+func TestValidateGitLabIdentityProvider(t *testing.T) {
+	testCases := map[string]struct {
+		provider api.GitLabIdentityProvider
+		fields   []string
+	}{
+		"valid": {
+			provider: api.GitLabIdentityProvider{URL: "https://gitlab.com", Groups: []string{"developers"}},
+		},
+		"missing url": {
+			provider: api.GitLabIdentityProvider{},
+			fields:   []string{"provider.url"},
+		},
+		"insecure url": {
+			provider: api.GitLabIdentityProvider{URL: "http://gitlab.com"},
+			fields:   []string{"provider.url"},
+		},
+		"empty group": {
+			provider: api.GitLabIdentityProvider{URL: "https://gitlab.com", Groups: []string{""}},
+			fields:   []string{"provider.groups[0]"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.provider.ClientID = "client"
+		tc.provider.ClientSecret = "secret"
+
+		errs := ValidateGitLabIdentityProvider(&tc.provider, api.IdentityProvider{})
+		fields := sets.NewString()
+		for i := range errs {
+			fields.Insert(errs[i].Field)
+		}
+		if !fields.Equal(sets.NewString(tc.fields...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+		}
+	}
+}
+
+func TestValidateTokenConfig(t *testing.T) {
+	testCases := map[string]struct {
+		config api.TokenConfig
+		fields []string
+	}{
+		"defaults": {},
+		"explicit max ages": {
+			config: api.TokenConfig{AuthorizeTokenMaxAgeSeconds: 300, AccessTokenMaxAgeSeconds: 86400},
+		},
+		"negative max ages": {
+			config: api.TokenConfig{AuthorizeTokenMaxAgeSeconds: -1, AccessTokenMaxAgeSeconds: -1},
+			fields: []string{"tokenConfig.authorizeTokenMaxAgeSeconds", "tokenConfig.accessTokenMaxAgeSeconds"},
+		},
+	}
+
+	for name, tc := range testCases {
+		errs := validateTokenConfig(tc.config, field.NewPath("tokenConfig"))
+		fields := sets.NewString()
+		for i := range errs {
+			fields.Insert(errs[i].Field)
+		}
+		if !fields.Equal(sets.NewString(tc.fields...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+		}
+	}
+}
+
+func TestValidateRequestHeaderIdentityProvider(t *testing.T) {
+	ca, err := ioutil.TempFile("", "ca.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca.Close()
+	defer os.Remove(ca.Name())
+	caFile := ca.Name()
+
+	testCases := map[string]struct {
+		provider api.RequestHeaderIdentityProvider
+		errors   []string
+		warnings []string
+	}{
+		"without client ca": {
+			errors: []string{"provider.clientCA"},
+		},
+		"client common names without client ca": {
+			provider: api.RequestHeaderIdentityProvider{ClientCommonNames: []string{"proxy"}},
+			errors:   []string{"provider.clientCA"},
+		},
+		"empty client common name": {
+			provider: api.RequestHeaderIdentityProvider{ClientCA: caFile, ClientCommonNames: []string{""}},
+			errors:   []string{"provider.clientCommonNames[0]"},
+		},
+		"identity headers": {
+			provider: api.RequestHeaderIdentityProvider{
+				ClientCA:                 caFile,
+				PreferredUsernameHeaders: []string{"X-Remote-Login"},
+				NameHeaders:              []string{"X-Remote-Name"},
+				EmailHeaders:             []string{"X-Remote-Email"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc.provider.Headers = []string{"X-Remote-User"}
+
+		results := ValidateRequestHeaderIdentityProvider(&tc.provider, api.IdentityProvider{})
+		errors := sets.NewString()
+		for i := range results.Errors {
+			errors.Insert(results.Errors[i].Field)
+		}
+		if !errors.Equal(sets.NewString(tc.errors...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.errors, results.Errors)
+		}
+		warnings := sets.NewString()
+		for i := range results.Warnings {
+			warnings.Insert(results.Warnings[i].Field)
+		}
+		if !warnings.Equal(sets.NewString(tc.warnings...)) {
+			t.Errorf("%s: expected warnings on %v, got %v", name, tc.warnings, results.Warnings)
+		}
+	}
+}
+
+func TestValidateBasicAuthAttributeMapping(t *testing.T) {
+	testCases := map[string]struct {
+		attributes api.BasicAuthAttributeMapping
+		fields     []string
+	}{
+		"defaults": {},
+		"custom keys": {
+			attributes: api.BasicAuthAttributeMapping{ID: []string{"uid", "sub"}, Email: []string{"mail"}},
+		},
+		"empty keys": {
+			attributes: api.BasicAuthAttributeMapping{ID: []string{"uid", ""}, Name: []string{""}},
+			fields:     []string{"attributes.id[1]", "attributes.name[0]"},
+		},
+	}
+
+	for name, tc := range testCases {
+		errs := ValidateBasicAuthAttributeMapping(tc.attributes, field.NewPath("attributes"))
+		fields := sets.NewString()
+		for i := range errs {
+			fields.Insert(errs[i].Field)
+		}
+		if !fields.Equal(sets.NewString(tc.fields...)) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+		}
+	}
+}
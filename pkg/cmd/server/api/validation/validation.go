@@ -4,10 +4,13 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -17,6 +20,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/cmd/server/api"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	cmdflags "github.com/openshift/origin/pkg/cmd/util/flags"
 )
@@ -33,6 +37,23 @@ func ValidateHostPort(value string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// ValidateNameserver ensures that nameserver is an IP address, optionally followed by a port
+func ValidateNameserver(nameserver string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if net.ParseIP(nameserver) != nil {
+		return allErrs
+	}
+	host, port, err := net.SplitHostPort(nameserver)
+	if err != nil || net.ParseIP(host) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, nameserver, "must be an IP address, optionally followed by a port (e.g. 8.8.8.8 or 8.8.8.8:53)"))
+	} else if portNum, err := strconv.Atoi(port); err != nil || !utilvalidation.IsValidPortNum(portNum) {
+		allErrs = append(allErrs, field.Invalid(fldPath, nameserver, "must have a valid port number"))
+	}
+
+	return allErrs
+}
+
 func ValidateCertInfo(certInfo api.CertInfo, required bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -58,6 +79,36 @@ func ValidateCertInfo(certInfo api.CertInfo, required bool, fldPath *field.Path)
 	return allErrs
 }
 
+// ValidateCertificateExpiry loads the certificates in certFile and returns an error for each certificate that is
+// not valid at now, and a warning for each certificate that expires within expiryWarning of now.
+func ValidateCertificateExpiry(certFile string, now time.Time, expiryWarning time.Duration, fldPath *field.Path) ValidationResults {
+	validationResults := ValidationResults{}
+
+	data, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		validationResults.AddErrors(field.Invalid(fldPath, certFile, "could not read file"))
+		return validationResults
+	}
+	certs, err := crypto.CertsFromPEM(data)
+	if err != nil {
+		validationResults.AddErrors(field.Invalid(fldPath, certFile, fmt.Sprintf("could not load certificates: %v", err)))
+		return validationResults
+	}
+
+	for _, cert := range certs {
+		switch {
+		case now.After(cert.NotAfter):
+			validationResults.AddErrors(field.Invalid(fldPath, certFile, fmt.Sprintf("certificate %q expired at %s", cert.Subject.CommonName, cert.NotAfter.UTC().Format(time.RFC3339))))
+		case now.Before(cert.NotBefore):
+			validationResults.AddErrors(field.Invalid(fldPath, certFile, fmt.Sprintf("certificate %q is not valid until %s", cert.Subject.CommonName, cert.NotBefore.UTC().Format(time.RFC3339))))
+		case now.Add(expiryWarning).After(cert.NotAfter):
+			validationResults.AddWarnings(field.Invalid(fldPath, certFile, fmt.Sprintf("certificate %q expires at %s", cert.Subject.CommonName, cert.NotAfter.UTC().Format(time.RFC3339))))
+		}
+	}
+
+	return validationResults
+}
+
 func ValidateServingInfo(info api.ServingInfo, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
@@ -5,10 +5,96 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/cmd/server/api"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
 )
 
+func TestValidateNameserver(t *testing.T) {
+	testCases := map[string]bool{
+		"8.8.8.8":          true,
+		"8.8.8.8:5353":     true,
+		"[2001:db8::1]:53": true,
+		"2001:db8::1":      true,
+		"dns.example.com":  false,
+		"8.8.8.8:":         false,
+		"8.8.8.8:99999":    false,
+		"":                 false,
+	}
+
+	for nameserver, valid := range testCases {
+		errs := ValidateNameserver(nameserver, field.NewPath("nameservers").Index(0))
+		if valid && len(errs) > 0 {
+			t.Errorf("%q: unexpected errors: %v", nameserver, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("%q: expected an error", nameserver)
+		}
+	}
+}
+
+func TestValidateCertificateExpiry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert-expiry")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	certFileName := dir + "/ca.crt"
+	ca, err := crypto.MakeCA(certFileName, dir+"/ca.key", dir+"/ca.serial.txt", "test-ca")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	notBefore, notAfter := ca.Config.Certs[0].NotBefore, ca.Config.Certs[0].NotAfter
+
+	testCases := map[string]struct {
+		certFile         string
+		now              time.Time
+		expiryWarning    time.Duration
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		"valid": {
+			certFile: certFileName,
+			now:      notBefore.Add(time.Hour),
+		},
+		"expires soon": {
+			certFile:         certFileName,
+			now:              notAfter.Add(-time.Hour),
+			expiryWarning:    24 * time.Hour,
+			expectedWarnings: 1,
+		},
+		"expired": {
+			certFile:       certFileName,
+			now:            notAfter.Add(time.Hour),
+			expiryWarning:  24 * time.Hour,
+			expectedErrors: 1,
+		},
+		"not yet valid": {
+			certFile:       certFileName,
+			now:            notBefore.Add(-time.Hour),
+			expectedErrors: 1,
+		},
+		"missing file": {
+			certFile:       certFileName + ".missing",
+			now:            notBefore.Add(time.Hour),
+			expectedErrors: 1,
+		},
+	}
+
+	for k, tc := range testCases {
+		results := ValidateCertificateExpiry(tc.certFile, tc.now, tc.expiryWarning, field.NewPath("certFile"))
+		if len(results.Errors) != tc.expectedErrors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.expectedErrors, results.Errors)
+		}
+		if len(results.Warnings) != tc.expectedWarnings {
+			t.Errorf("%s: expected %d warnings, got %v", k, tc.expectedWarnings, results.Warnings)
+		}
+	}
+}
+
 func TestValidateServingInfo(t *testing.T) {
 	certFile, err := ioutil.TempFile("", "cert.crt")
 	if err != nil {
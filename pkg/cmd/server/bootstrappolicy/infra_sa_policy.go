@@ -91,7 +91,6 @@ func init() {
 			},
 			Rules: []authorizationapi.PolicyRule{
 				// BuildControllerFactory.buildLW
-				// BuildControllerFactory.buildDeleteLW
 				{
 					Verbs:     sets.NewString("get", "list", "watch"),
 					Resources: sets.NewString("builds"),
@@ -113,7 +112,7 @@ func init() {
 				},
 				// BuildController.PodManager (ControllerClient)
 				// BuildDeleteController.PodManager (ControllerClient)
-				// BuildControllerFactory.buildDeleteLW
+				// BuildControllerFactory.CreateDeleteController (deletedBuildsOfPods)
 				{
 					Verbs:     sets.NewString("get", "list", "create", "delete"),
 					Resources: sets.NewString("pods"),
@@ -0,0 +1,171 @@
+package bootstrappolicy
+
+import (
+	"fmt"
+	"strconv"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+)
+
+// PolicyState holds the cluster roles and cluster role bindings of a cluster, by name
+type PolicyState struct {
+	ClusterRoles        map[string]*authorizationapi.ClusterRole
+	ClusterRoleBindings map[string]*authorizationapi.ClusterRoleBinding
+}
+
+// PolicyMigration changes the cluster roles and cluster role bindings of a cluster from the previous version of the
+// bootstrap policy to Version
+type PolicyMigration struct {
+	// Version is the version of the bootstrap policy after the migration
+	Version int
+	// Description says what the migration changes
+	Description string
+	// Migrate changes the state in place. It must leave roles and bindings that were customized by the cluster admin in
+	// a way that conflicts with the migration alone, and must not change a state it was already applied to.
+	Migrate func(state *PolicyState) error
+}
+
+// PolicyMigrations lists the migrations of the bootstrap policy by increasing version. When the default cluster roles or
+// role bindings change in a way existing clusters need, make the change in the bootstrap policy and append a migration
+// making the same change here.
+var PolicyMigrations = []PolicyMigration{
+	{
+		Version:     1,
+		Description: "Allow admins and editors to request tokens for service accounts",
+		Migrate: func(state *PolicyState) error {
+			rule := authorizationapi.PolicyRule{Verbs: sets.NewString("create"), Resources: sets.NewString("serviceaccounttokenrequests")}
+			addRules(state, AdminRoleName, rule)
+			addRules(state, EditRoleName, rule)
+			return nil
+		},
+	},
+	{
+		Version:     2,
+		Description: "Allow admins and cluster readers to read role binding restrictions",
+		Migrate: func(state *PolicyState) error {
+			rule := authorizationapi.PolicyRule{Verbs: sets.NewString("get", "list", "watch"), Resources: sets.NewString("rolebindingrestrictions")}
+			addRules(state, AdminRoleName, rule)
+			addRules(state, ClusterReaderRoleName, rule)
+			return nil
+		},
+	},
+	{
+		Version:     3,
+		Description: "Allow users to list the rules they have in a project",
+		Migrate: func(state *PolicyState) error {
+			addRules(state, BasicUserRoleName, authorizationapi.PolicyRule{Verbs: sets.NewString("create"), Resources: sets.NewString("selfsubjectrulesreviews")})
+			return nil
+		},
+	},
+	{
+		Version:     4,
+		Description: "Allow users to list and revoke their own access tokens",
+		Migrate: func(state *PolicyState) error {
+			addRules(state, BasicUserRoleName, authorizationapi.PolicyRule{Verbs: sets.NewString("get", "list", "delete"), Resources: sets.NewString("useroauthaccesstokens")})
+			return nil
+		},
+	},
+	{
+		Version:     5,
+		Description: "Allow admins and editors to review pod templates against security context constraints",
+		Migrate: func(state *PolicyState) error {
+			rule := authorizationapi.PolicyRule{Verbs: sets.NewString("create"), Resources: sets.NewString("podsecuritypolicyreviews", "podsecuritypolicysubjectreviews")}
+			addRules(state, AdminRoleName, rule)
+			addRules(state, EditRoleName, rule)
+			return nil
+		},
+	},
+	{
+		Version:     6,
+		Description: "Allow cluster readers to read cluster resource quotas, and project members to read their status",
+		Migrate: func(state *PolicyState) error {
+			addRules(state, ClusterReaderRoleName, authorizationapi.PolicyRule{Verbs: sets.NewString("get", "list", "watch"), Resources: sets.NewString("clusterresourcequotas", "clusterresourcequotas/status")})
+			rule := authorizationapi.PolicyRule{Verbs: sets.NewString("get", "list", "watch"), Resources: sets.NewString("clusterresourcequotas/status")}
+			addRules(state, AdminRoleName, rule)
+			addRules(state, EditRoleName, rule)
+			addRules(state, ViewRoleName, rule)
+			return nil
+		},
+	},
+}
+
+// BootstrapPolicyVersion returns the version of the current bootstrap policy
+func BootstrapPolicyVersion() int {
+	if len(PolicyMigrations) == 0 {
+		return 0
+	}
+	return PolicyMigrations[len(PolicyMigrations)-1].Version
+}
+
+// GetPolicyVersion returns the version of the bootstrap policy recorded on the cluster-admin role. Clusters created
+// before the bootstrap policy was versioned are at version 0.
+func GetPolicyVersion(clusterAdmin *authorizationapi.ClusterRole) (int, error) {
+	value, ok := clusterAdmin.Annotations[authorizationapi.BootstrapPolicyVersionAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("the %s annotation of clusterrole/%s must be a version number, not %q", authorizationapi.BootstrapPolicyVersionAnnotation, clusterAdmin.Name, value)
+	}
+	return version, nil
+}
+
+// SetPolicyVersion records the version of the bootstrap policy on the cluster-admin role
+func SetPolicyVersion(clusterAdmin *authorizationapi.ClusterRole, version int) {
+	if clusterAdmin.Annotations == nil {
+		clusterAdmin.Annotations = map[string]string{}
+	}
+	clusterAdmin.Annotations[authorizationapi.BootstrapPolicyVersionAnnotation] = strconv.Itoa(version)
+}
+
+// PendingPolicyMigrations returns the migrations to apply to a cluster at the given version of the bootstrap policy
+func PendingPolicyMigrations(version int) []PolicyMigration {
+	for i := range PolicyMigrations {
+		if PolicyMigrations[i].Version > version {
+			return PolicyMigrations[i:]
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the state
+func (s *PolicyState) Copy() (*PolicyState, error) {
+	copied := &PolicyState{
+		ClusterRoles:        map[string]*authorizationapi.ClusterRole{},
+		ClusterRoleBindings: map[string]*authorizationapi.ClusterRoleBinding{},
+	}
+	for name, role := range s.ClusterRoles {
+		obj, err := kapi.Scheme.DeepCopy(role)
+		if err != nil {
+			return nil, err
+		}
+		copied.ClusterRoles[name] = obj.(*authorizationapi.ClusterRole)
+	}
+	for name, binding := range s.ClusterRoleBindings {
+		obj, err := kapi.Scheme.DeepCopy(binding)
+		if err != nil {
+			return nil, err
+		}
+		copied.ClusterRoleBindings[name] = obj.(*authorizationapi.ClusterRoleBinding)
+	}
+	return copied, nil
+}
+
+// addRules adds the rules the named role does not cover yet. Missing and protected roles are left alone.
+func addRules(state *PolicyState, roleName string, rules ...authorizationapi.PolicyRule) {
+	role, ok := state.ClusterRoles[roleName]
+	if !ok || role.Annotations[authorizationapi.ReconcileProtectAnnotation] == "true" {
+		return
+	}
+	for _, rule := range rules {
+		rule.Resources = authorizationapi.NormalizeResources(rule.Resources)
+		if covered, _ := rulevalidation.Covers(role.Rules, []authorizationapi.PolicyRule{rule}); !covered {
+			role.Rules = append(role.Rules, rule)
+		}
+	}
+}
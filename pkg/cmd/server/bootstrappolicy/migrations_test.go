@@ -0,0 +1,114 @@
+package bootstrappolicy
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+)
+
+func bootstrapState() *PolicyState {
+	state := &PolicyState{
+		ClusterRoles:        map[string]*authorizationapi.ClusterRole{},
+		ClusterRoleBindings: map[string]*authorizationapi.ClusterRoleBinding{},
+	}
+	roles := GetBootstrapClusterRoles()
+	for i := range roles {
+		state.ClusterRoles[roles[i].Name] = &roles[i]
+	}
+	bindings := GetBootstrapClusterRoleBindings()
+	for i := range bindings {
+		state.ClusterRoleBindings[bindings[i].Name] = &bindings[i]
+	}
+	return state
+}
+
+func TestPolicyMigrationVersions(t *testing.T) {
+	version := 0
+	for _, migration := range PolicyMigrations {
+		if migration.Version <= version {
+			t.Errorf("migration to version %d must come after version %d", migration.Version, version)
+		}
+		if len(migration.Description) == 0 || migration.Migrate == nil {
+			t.Errorf("migration to version %d must have a description and a migrate func", migration.Version)
+		}
+		version = migration.Version
+	}
+	if BootstrapPolicyVersion() != version {
+		t.Errorf("expected bootstrap policy version %d, got %d", version, BootstrapPolicyVersion())
+	}
+}
+
+// TestPolicyMigrationsOfBootstrapPolicy ensures every migration is also made in the bootstrap policy, so new and
+// migrated clusters end up with the same roles
+func TestPolicyMigrationsOfBootstrapPolicy(t *testing.T) {
+	state := bootstrapState()
+	for _, migration := range PolicyMigrations {
+		migrated, err := state.Copy()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := migration.Migrate(migrated); err != nil {
+			t.Errorf("version %d: unexpected error: %v", migration.Version, err)
+			continue
+		}
+		if !kapi.Semantic.DeepEqual(state, migrated) {
+			t.Errorf("version %d: the bootstrap policy is missing the changes of %q", migration.Version, migration.Description)
+		}
+	}
+}
+
+func TestServiceAccountTokenRequestsMigration(t *testing.T) {
+	old := bootstrapState()
+	for _, name := range []string{AdminRoleName, EditRoleName} {
+		role := old.ClusterRoles[name]
+		rules := []authorizationapi.PolicyRule{}
+		for _, rule := range role.Rules {
+			rule.Resources = sets.NewString(rule.Resources.List()...)
+			rule.Resources.Delete("serviceaccounttokenrequests")
+			rules = append(rules, rule)
+		}
+		role.Rules = rules
+	}
+	old.ClusterRoles[EditRoleName].Annotations = map[string]string{authorizationapi.ReconcileProtectAnnotation: "true"}
+
+	migrated, err := old.Copy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := PolicyMigrations[0].Migrate(migrated); err != nil {
+		t.Fatal(err)
+	}
+
+	rule := authorizationapi.PolicyRule{Verbs: sets.NewString("create"), Resources: sets.NewString("serviceaccounttokenrequests")}
+	expected := append(old.ClusterRoles[AdminRoleName].Rules, rule)
+	if !kapi.Semantic.DeepEqual(migrated.ClusterRoles[AdminRoleName].Rules, expected) {
+		t.Errorf("expected the admin role to be allowed to create serviceaccounttokenrequests, got %v", migrated.ClusterRoles[AdminRoleName].Rules)
+	}
+	if !kapi.Semantic.DeepEqual(migrated.ClusterRoles[EditRoleName], old.ClusterRoles[EditRoleName]) {
+		t.Errorf("expected the protected edit role to be left alone, got %v", migrated.ClusterRoles[EditRoleName].Rules)
+	}
+}
+
+func TestGetPolicyVersion(t *testing.T) {
+	role := &authorizationapi.ClusterRole{ObjectMeta: kapi.ObjectMeta{Name: ClusterAdminRoleName}}
+	if version, err := GetPolicyVersion(role); err != nil || version != 0 {
+		t.Errorf("expected version 0 without annotation, got %d: %v", version, err)
+	}
+	SetPolicyVersion(role, 3)
+	if version, err := GetPolicyVersion(role); err != nil || version != 3 {
+		t.Errorf("expected version 3, got %d: %v", version, err)
+	}
+	role.Annotations[authorizationapi.BootstrapPolicyVersionAnnotation] = "v3"
+	if _, err := GetPolicyVersion(role); err == nil {
+		t.Errorf("expected an error for an invalid version")
+	}
+	if migrations := PendingPolicyMigrations(BootstrapPolicyVersion()); len(migrations) != 0 {
+		t.Errorf("expected no pending migrations for the current version, got %d", len(migrations))
+	}
+	if migrations := PendingPolicyMigrations(0); len(migrations) != len(PolicyMigrations) {
+		t.Errorf("expected all migrations to be pending for version 0, got %d", len(migrations))
+	}
+}
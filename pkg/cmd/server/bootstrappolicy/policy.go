@@ -2,6 +2,7 @@ package bootstrappolicy
 
 import (
 	"fmt"
+	"strconv"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -47,6 +48,8 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 		{
 			ObjectMeta: kapi.ObjectMeta{
 				Name: ClusterAdminRoleName,
+				// new clusters start at the current version, migrations only apply to existing clusters
+				Annotations: map[string]string{authorizationapi.BootstrapPolicyVersionAnnotation: strconv.Itoa(BootstrapPolicyVersion())},
 			},
 			Rules: []authorizationapi.PolicyRule{
 				{
@@ -111,7 +114,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				},
 				{
 					Verbs:     sets.NewString("get", "list", "watch"),
-					Resources: sets.NewString(authorizationapi.PolicyOwnerGroupName, authorizationapi.KubeAllGroupName, authorizationapi.OpenshiftStatusGroupName, authorizationapi.KubeStatusGroupName),
+					Resources: sets.NewString(authorizationapi.PolicyOwnerGroupName, authorizationapi.KubeAllGroupName, authorizationapi.OpenshiftStatusGroupName, authorizationapi.KubeStatusGroupName, "rolebindingrestrictions"),
+				},
+				{
+					Verbs:     sets.NewString("create"),
+					Resources: sets.NewString("podsecuritypolicysubjectreviews", "podsecuritypolicyreviews", "serviceaccounttokenrequests"),
 				},
 				{
 					Verbs: sets.NewString("get", "update"),
@@ -138,6 +145,10 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("get", "list", "watch"),
 					Resources: sets.NewString(authorizationapi.KubeAllGroupName, authorizationapi.OpenshiftStatusGroupName, authorizationapi.KubeStatusGroupName, "projects"),
 				},
+				{
+					Verbs:     sets.NewString("create"),
+					Resources: sets.NewString("podsecuritypolicysubjectreviews", "podsecuritypolicyreviews", "serviceaccounttokenrequests"),
+				},
 				{
 					Verbs: sets.NewString("get", "update"),
 					// this is used by verifyImageStreamAccess in pkg/dockerregistry/server/auth.go
@@ -171,6 +182,8 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				{Verbs: sets.NewString("list", "get"), Resources: sets.NewString("clusterroles")},
 				{Verbs: sets.NewString("list"), Resources: sets.NewString("projects")},
 				{Verbs: sets.NewString("create"), Resources: sets.NewString("subjectaccessreviews", "localsubjectaccessreviews"), AttributeRestrictions: runtime.EmbeddedObject{Object: &authorizationapi.IsPersonalSubjectAccessReview{}}},
+				{Verbs: sets.NewString("create"), Resources: sets.NewString("selfsubjectrulesreviews")},
+				{Verbs: sets.NewString("get", "list", "delete"), Resources: sets.NewString("useroauthaccesstokens")},
 			},
 		},
 		{
@@ -322,7 +335,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("list", "watch"),
-					Resources: sets.NewString("routes", "endpoints"),
+					Resources: sets.NewString("routes", "endpoints", "services"),
 				},
 			},
 		},
@@ -347,6 +360,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("create"),
 					Resources: sets.NewString("imagestreammappings"),
 				},
+				{
+					// Used to enforce the image storage quota of projects
+					Verbs:     sets.NewString("list"),
+					Resources: sets.NewString("imagestreams", "resourcequotas"),
+				},
 			},
 		},
 		{
@@ -31,17 +31,19 @@ import (
 	"k8s.io/kubernetes/pkg/util/intstr"
 	saadmit "k8s.io/kubernetes/plugin/pkg/admission/serviceaccount"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/flagtypes"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/etcd"
 	cmdflags "github.com/openshift/origin/pkg/cmd/util/flags"
 	"github.com/openshift/origin/pkg/cmd/util/pluginconfig"
+	imageadmission "github.com/openshift/origin/pkg/image/admission"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
 )
 
 // AdmissionPlugins is the full list of admission control plugins to enable in the order they must run
-var AdmissionPlugins = []string{"NamespaceLifecycle", "OriginPodNodeEnvironment", "LimitRanger", "ServiceAccount", "SecurityContextConstraint", "ResourceQuota", "SCCExecRestrictions"}
+var AdmissionPlugins = []string{"NamespaceLifecycle", "OriginPodNodeEnvironment", "PodPreset", "LimitRanger", imageadmission.PluginName, "ServiceAccount", "SecurityContextConstraint", "ResourceQuota", "ClusterResourceQuota", "SCCExecRestrictions"}
 
 // MasterConfig defines the required values to start a Kubernetes master
 type MasterConfig struct {
@@ -53,7 +55,7 @@ type MasterConfig struct {
 	CloudProvider     cloudprovider.Interface
 }
 
-func BuildKubernetesMasterConfig(options configapi.MasterConfig, requestContextMapper kapi.RequestContextMapper, kubeClient *kclient.Client, projectCache *projectcache.ProjectCache) (*MasterConfig, error) {
+func BuildKubernetesMasterConfig(options configapi.MasterConfig, requestContextMapper kapi.RequestContextMapper, kubeClient *kclient.Client, openshiftClient *osclient.Client, projectCache *projectcache.ProjectCache) (*MasterConfig, error) {
 	if options.KubernetesMasterConfig == nil {
 		return nil, errors.New("insufficient information to build KubernetesMasterConfig")
 	}
@@ -131,7 +133,8 @@ func BuildKubernetesMasterConfig(options configapi.MasterConfig, requestContextM
 	// This is a placeholder to provide additional initialization
 	// objects to plugins
 	pluginInitializer := oadmission.PluginInitializer{
-		ProjectCache: projectCache,
+		OpenshiftClient: openshiftClient,
+		ProjectCache:    projectCache,
 	}
 
 	plugins := []admission.Interface{}
@@ -15,14 +15,19 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/cadvisor"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/dockertools"
+	kproxy "k8s.io/kubernetes/pkg/proxy"
 	pconfig "k8s.io/kubernetes/pkg/proxy/config"
 	proxy "k8s.io/kubernetes/pkg/proxy/iptables"
+	"k8s.io/kubernetes/pkg/proxy/userspace"
+	kutil "k8s.io/kubernetes/pkg/util"
 	utildbus "k8s.io/kubernetes/pkg/util/dbus"
 	kexec "k8s.io/kubernetes/pkg/util/exec"
 	"k8s.io/kubernetes/pkg/util/iptables"
 
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	dockerutil "github.com/openshift/origin/pkg/cmd/util/docker"
+	"github.com/openshift/origin/pkg/proxy/hybrid"
+	"github.com/openshift/origin/pkg/proxy/unidler"
 )
 
 type commandExecutor interface {
@@ -187,6 +192,13 @@ func (c *NodeConfig) RunSDN() {
 	}
 }
 
+const (
+	// unidlingTimeout is how long a connection to an idled service waits for its pods
+	unidlingTimeout = 120 * time.Second
+	// unidlingUDPIdleTimeout is how long the unidling proxy keeps idle UDP connections open
+	unidlingUDPIdleTimeout = 250 * time.Millisecond
+)
+
 // RunProxy starts the proxy
 func (c *NodeConfig) RunProxy() {
 	// initialize kube proxy
@@ -223,12 +235,27 @@ func (c *NodeConfig) RunProxy() {
 	exec := kexec.New()
 	dbus := utildbus.New()
 	iptables := iptables.New(exec, dbus, protocol)
-	proxier, err := proxy.NewProxier(iptables, exec, syncPeriod, false)
+	mainProxier, err := proxy.NewProxier(iptables, exec, syncPeriod, false)
 	if err != nil {
 		// This should be fatal, but that would break the integration tests
 		glog.Warningf("WARNING: Could not initialize Kubernetes Proxy. You must run this process as root to use the service proxy: %v", err)
 		return
 	}
+
+	// idled services are sent through a userspace proxy, which holds their connections while
+	// they are scaled back up
+	unidlingLoadBalancer := unidler.NewLoadBalancer(unidler.NewEventSignaler(recorder), unidlingTimeout)
+	unidlingProxier, err := userspace.NewProxier(unidlingLoadBalancer, ip, iptables, kutil.PortRange{}, syncPeriod, unidlingUDPIdleTimeout)
+	if err != nil {
+		glog.Warningf("WARNING: Could not initialize the unidling proxy, idled services will not be woken up by traffic: %v", err)
+	}
+	var proxier interface {
+		kproxy.ProxyProvider
+		pconfig.EndpointsConfigHandler
+	} = mainProxier
+	if unidlingProxier != nil {
+		proxier = hybrid.NewProxier(mainProxier, mainProxier, unidlingProxier, unidlingLoadBalancer)
+	}
 	iptables.AddReloadFunc(proxier.Sync)
 
 	pconfig.NewSourceAPI(
@@ -38,11 +38,13 @@ import (
 	"github.com/openshift/origin/pkg/auth/ldaputil"
 	"github.com/openshift/origin/pkg/auth/oauth/external"
 	"github.com/openshift/origin/pkg/auth/oauth/external/github"
+	"github.com/openshift/origin/pkg/auth/oauth/external/gitlab"
 	"github.com/openshift/origin/pkg/auth/oauth/external/google"
 	"github.com/openshift/origin/pkg/auth/oauth/external/openid"
 	"github.com/openshift/origin/pkg/auth/oauth/handlers"
 	"github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/server/csrf"
+	"github.com/openshift/origin/pkg/auth/server/errorpage"
 	"github.com/openshift/origin/pkg/auth/server/grant"
 	"github.com/openshift/origin/pkg/auth/server/login"
 	"github.com/openshift/origin/pkg/auth/server/selectprovider"
@@ -61,6 +63,7 @@ import (
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver"
 	"github.com/openshift/origin/pkg/oauth/server/osinserver/registrystorage"
+	saoauth "github.com/openshift/origin/pkg/serviceaccounts/oauthclient"
 )
 
 const (
@@ -110,12 +113,20 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 	clientAuthStorage := clientauthetcd.NewREST(c.EtcdHelper)
 	clientAuthRegistry := clientauthregistry.NewRegistry(clientAuthStorage)
 
-	authRequestHandler, authHandler, authFinalizer, err := c.getAuthorizeAuthenticationHandlers(mux)
+	errorPageHandler, err := c.getErrorHandler()
 	if err != nil {
 		glog.Fatal(err)
 	}
 
-	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, clientRegistry, registry.NewUserConversion())
+	authRequestHandler, authHandler, authFinalizer, err := c.getAuthorizeAuthenticationHandlers(mux, errorPageHandler)
+	if err != nil {
+		glog.Fatal(err)
+	}
+
+	// Service accounts can act as OAuth clients in addition to the registered clients
+	combinedOAuthClientGetter := saoauth.NewServiceAccountOAuthClientGetter(c.KubeClient, c.KubeClient, clientRegistry)
+
+	storage := registrystorage.New(accessTokenRegistry, authorizeTokenRegistry, combinedOAuthClientGetter, registry.NewUserConversion())
 	config := osinserver.NewDefaultServerConfig()
 	if c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds > 0 {
 		config.AuthorizationExpiration = c.Options.TokenConfig.AuthorizeTokenMaxAgeSeconds
@@ -125,7 +136,7 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 	}
 
 	grantChecker := registry.NewClientAuthorizationGrantChecker(clientAuthRegistry)
-	grantHandler := c.getGrantHandler(mux, authRequestHandler, clientRegistry, clientAuthRegistry)
+	grantHandler := c.getGrantHandler(mux, authRequestHandler, combinedOAuthClientGetter, clientAuthRegistry)
 
 	server := osinserver.New(
 		config,
@@ -134,12 +145,12 @@ func (c *AuthConfig) InstallAPI(container *restful.Container) []string {
 			handlers.NewAuthorizeAuthenticator(
 				authRequestHandler,
 				authHandler,
-				handlers.EmptyError{},
+				errorPageHandler,
 			),
 			handlers.NewGrantCheck(
 				grantChecker,
 				grantHandler,
-				handlers.EmptyError{},
+				errorPageHandler,
 			),
 			authFinalizer,
 		},
@@ -273,12 +284,26 @@ func (c *AuthConfig) getCSRF() csrf.CSRF {
 	return csrf.NewCookieCSRF("csrf", "/", "", secure, true)
 }
 
-func (c *AuthConfig) getAuthorizeAuthenticationHandlers(mux cmdutil.Mux) (authenticator.Request, handlers.AuthenticationHandler, osinserver.AuthorizeHandler, error) {
+// getErrorHandler returns the error page shown when authentication or granting fails
+func (c *AuthConfig) getErrorHandler() (*errorpage.ErrorPage, error) {
+	var errorTemplateFile string
+	if c.Options.Templates != nil {
+		errorTemplateFile = c.Options.Templates.Error
+	}
+	errorPageRenderer, err := errorpage.NewErrorPageTemplateRenderer(errorTemplateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return errorpage.NewErrorPageHandler(errorPageRenderer), nil
+}
+
+func (c *AuthConfig) getAuthorizeAuthenticationHandlers(mux cmdutil.Mux, errorHandler handlers.AuthenticationErrorHandler) (authenticator.Request, handlers.AuthenticationHandler, osinserver.AuthorizeHandler, error) {
 	authRequestHandler, err := c.getAuthenticationRequestHandler()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	authHandler, err := c.getAuthenticationHandler(mux, handlers.EmptyError{})
+	authHandler, err := c.getAuthenticationHandler(mux, errorHandler)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -288,18 +313,21 @@ func (c *AuthConfig) getAuthorizeAuthenticationHandlers(mux cmdutil.Mux) (authen
 }
 
 // getGrantHandler returns the object that handles approving or rejecting grant requests
-func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request, clientregistry clientregistry.Registry, authregistry clientauthregistry.Registry) handlers.GrantHandler {
+func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request, clientregistry clientregistry.Getter, authregistry clientauthregistry.Registry) handlers.GrantHandler {
 	switch c.Options.GrantConfig.Method {
 	case configapi.GrantHandlerDeny:
 		return handlers.NewEmptyGrant()
 
 	case configapi.GrantHandlerAuto:
-		return handlers.NewAutoGrant()
+		// Service account clients are set up by project members, not cluster admins, so users must approve them explicitly
+		return handlers.NewClientSelectorGrant(
+			func(client osin.Client) bool { return saoauth.IsServiceAccountClient(client.GetId()) },
+			c.getPromptGrantHandler(mux, auth, clientregistry, authregistry),
+			handlers.NewAutoGrant(),
+		)
 
 	case configapi.GrantHandlerPrompt:
-		grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, clientregistry, authregistry)
-		grantServer.Install(mux, OpenShiftApprovePrefix)
-		return handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+		return c.getPromptGrantHandler(mux, auth, clientregistry, authregistry)
 
 	default:
 		glog.Fatalf("No grant handler found that matches %v.  The oauth server cannot start!", c.Options.GrantConfig.Method)
@@ -308,6 +336,13 @@ func (c *AuthConfig) getGrantHandler(mux cmdutil.Mux, auth authenticator.Request
 	return nil
 }
 
+// getPromptGrantHandler installs the grant approval page and returns a grant handler that redirects to it
+func (c *AuthConfig) getPromptGrantHandler(mux cmdutil.Mux, auth authenticator.Request, clientregistry clientregistry.Getter, authregistry clientauthregistry.Registry) handlers.GrantHandler {
+	grantServer := grant.NewGrant(c.getCSRF(), auth, grant.DefaultFormRenderer, clientregistry, authregistry)
+	grantServer.Install(mux, OpenShiftApprovePrefix)
+	return handlers.NewRedirectGrant(OpenShiftApprovePrefix)
+}
+
 // getAuthenticationFinalizer returns an authentication finalizer which is called just prior to writing a response to an authorization request
 func (c *AuthConfig) getAuthenticationFinalizer() osinserver.AuthorizeHandler {
 	if c.SessionAuth != nil {
@@ -387,8 +422,8 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 			}
 			oauthSuccessHandler := handlers.AuthenticationSuccessHandlers{c.SessionAuth, state}
 
-			// If the specified errorHandler doesn't handle the login error, let the state error handler attempt to propagate specific errors back to the token requester
-			oauthErrorHandler := handlers.AuthenticationErrorHandlers{errorHandler, state}
+			// Let the state error handler attempt to propagate specific errors back to the token requester before falling back to the specified errorHandler
+			oauthErrorHandler := handlers.AuthenticationErrorHandlers{state, errorHandler}
 
 			callbackPath := path.Join(OpenShiftOAuthCallbackPrefix, identityProvider.Name)
 			oauthHandler, err := external.NewExternalOAuthRedirector(oauthProvider, state, c.Options.MasterPublicURL+callbackPath, oauthSuccessHandler, oauthErrorHandler, identityMapper)
@@ -441,7 +476,14 @@ func (c *AuthConfig) getAuthenticationHandler(mux cmdutil.Mux, errorHandler hand
 func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvider) (external.Provider, error) {
 	switch provider := identityProvider.Provider.Object.(type) {
 	case (*configapi.GitHubIdentityProvider):
-		return github.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret), nil
+		return github.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret, provider.Organizations, provider.Teams), nil
+
+	case (*configapi.GitLabIdentityProvider):
+		transport, err := cmdutil.TransportFor(provider.CA, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return gitlab.NewProvider(identityProvider.Name, transport, provider.URL, provider.ClientID, provider.ClientSecret, provider.Groups)
 
 	case (*configapi.GoogleIdentityProvider):
 		return google.NewProvider(identityProvider.Name, provider.ClientID, provider.ClientSecret, provider.HostedDomain)
@@ -474,6 +516,9 @@ func (c *AuthConfig) getOAuthProvider(identityProvider configapi.IdentityProvide
 			EmailClaims:             provider.Claims.Email,
 			NameClaims:              provider.Claims.Name,
 		}
+		if len(provider.Issuer) != 0 {
+			return openid.NewDiscoveringProvider(identityProvider.Name, transport, provider.Issuer, config), nil
+		}
 
 		return openid.NewProvider(identityProvider.Name, transport, config)
 
@@ -538,7 +583,13 @@ func (c *AuthConfig) getPasswordAuthenticator(identityProvider configapi.Identit
 		if err != nil {
 			return nil, fmt.Errorf("Error building BasicAuthPasswordIdentityProvider client: %v", err)
 		}
-		return basicauthpassword.New(identityProvider.Name, connectionInfo.URL, transport, identityMapper), nil
+		attributes := basicauthpassword.AttributeMapping{
+			ID:                provider.Attributes.ID,
+			PreferredUsername: provider.Attributes.PreferredUsername,
+			Name:              provider.Attributes.Name,
+			Email:             provider.Attributes.Email,
+		}
+		return basicauthpassword.New(identityProvider.Name, connectionInfo.URL, transport, attributes, identityMapper), nil
 
 	case (*configapi.KeystonePasswordIdentityProvider):
 		connectionInfo := provider.RemoteConnectionInfo
@@ -584,7 +635,10 @@ func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, e
 				var authRequestHandler authenticator.Request
 
 				authRequestConfig := &headerrequest.Config{
-					UserNameHeaders: provider.Headers,
+					UserNameHeaders:          provider.Headers,
+					PreferredUserNameHeaders: provider.PreferredUsernameHeaders,
+					NameHeaders:              provider.NameHeaders,
+					EmailHeaders:             provider.EmailHeaders,
 				}
 				authRequestHandler = headerrequest.NewAuthenticator(identityProvider.Name, authRequestConfig, identityMapper)
 
@@ -600,7 +654,7 @@ func (c *AuthConfig) getAuthenticationRequestHandler() (authenticator.Request, e
 						return nil, fmt.Errorf("Error loading certs from %s: %v", provider.ClientCA, err)
 					}
 
-					authRequestHandler = x509request.NewVerifier(opts, authRequestHandler)
+					authRequestHandler = x509request.NewVerifier(opts, authRequestHandler, sets.NewString(provider.ClientCommonNames...))
 				}
 				authRequestHandlers = append(authRequestHandlers, authRequestHandler)
 
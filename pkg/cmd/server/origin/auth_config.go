@@ -8,6 +8,7 @@ import (
 	"github.com/pborman/uuid"
 
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/storage"
 
 	"github.com/openshift/origin/pkg/auth/server/session"
@@ -37,6 +38,9 @@ type AuthConfig struct {
 	UserRegistry     userregistry.Registry
 	IdentityRegistry identityregistry.Registry
 
+	// KubeClient is used to look up service accounts and their tokens when they are used as OAuth clients
+	KubeClient kclient.Interface
+
 	SessionAuth *session.Authenticator
 }
 
@@ -85,6 +89,11 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 		assetPublicURLs = []string{options.OAuthConfig.AssetPublicURL, "http://localhost:9000", "https://localhost:9000"}
 	}
 
+	kubeClient, _, err := configapi.GetKubeClient(options.MasterClients.OpenShiftLoopbackKubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	userStorage := useretcd.NewREST(etcdHelper)
 	userRegistry := userregistry.NewRegistry(userStorage)
 	identityStorage := identityetcd.NewREST(etcdHelper)
@@ -100,6 +109,8 @@ func BuildAuthConfig(options configapi.MasterConfig) (*AuthConfig, error) {
 		IdentityRegistry: identityRegistry,
 		UserRegistry:     userRegistry,
 
+		KubeClient: kubeClient,
+
 		SessionAuth: sessionAuth,
 	}
 
@@ -67,6 +67,7 @@ func (c *MasterConfig) ensureOpenShiftInfraNamespace() {
 			Confirmed:        true,
 			Union:            true,
 			Out:              ioutil.Discard,
+			ErrOut:           ioutil.Discard,
 			RoleClient:       c.PrivilegedLoopbackOpenShiftClient.ClusterRoles(),
 		}
 		if err := reconcileRole.RunReconcileClusterRoles(nil, nil); err != nil {
@@ -173,20 +174,33 @@ func (c *MasterConfig) ensureComponentAuthorizationRules() {
 	clusterPolicyRegistry := clusterpolicyregistry.NewRegistry(clusterpolicystorage.NewStorage(c.EtcdHelper))
 	ctx := kapi.WithNamespace(kapi.NewContext(), "")
 
-	if _, err := clusterPolicyRegistry.GetClusterPolicy(ctx, authorizationapi.PolicyName); kapierror.IsNotFound(err) {
+	clusterPolicy, err := clusterPolicyRegistry.GetClusterPolicy(ctx, authorizationapi.PolicyName)
+	switch {
+	case kapierror.IsNotFound(err):
 		glog.Infof("No cluster policy found.  Creating bootstrap policy based on: %v", c.Options.PolicyConfig.BootstrapPolicyFile)
 
 		if err := admin.OverwriteBootstrapPolicy(c.EtcdHelper, c.Options.PolicyConfig.BootstrapPolicyFile, admin.CreateBootstrapPolicyFileFullCommand, true, ioutil.Discard); err != nil {
 			glog.Errorf("Error creating bootstrap policy: %v", err)
 		}
 
-	} else {
+	case err != nil:
+		glog.Errorf("Unable to get the cluster policy, not checking its version: %v", err)
+
+	default:
 		glog.V(2).Infof("Ignoring bootstrap policy file because cluster policy found")
+
+		if clusterAdmin, ok := clusterPolicy.Roles[bootstrappolicy.ClusterAdminRoleName]; ok {
+			if version, err := bootstrappolicy.GetPolicyVersion(clusterAdmin); err != nil {
+				glog.Warningf("Unable to determine the version of the cluster policy: %v", err)
+			} else if version < bootstrappolicy.BootstrapPolicyVersion() {
+				glog.Warningf("The cluster policy is at version %d of the bootstrap policy, the current version is %d. Run 'oadm policy %s' to see the changes needed.", version, bootstrappolicy.BootstrapPolicyVersion(), policy.MigrateBootstrapPolicyRecommendedName)
+			}
+		}
 	}
 
 	// Wait until the policy cache has caught up before continuing
 	review := &authorizationapi.SubjectAccessReview{Action: authorizationapi.AuthorizationAttributes{Verb: "get", Resource: "clusterpolicies"}}
-	err := wait.PollImmediate(100*time.Millisecond, 30*time.Second, func() (done bool, err error) {
+	err = wait.PollImmediate(100*time.Millisecond, 30*time.Second, func() (done bool, err error) {
 		result, err := c.PolicyClient().SubjectAccessReviews().Create(review)
 		if err == nil && result.Allowed {
 			return true, nil
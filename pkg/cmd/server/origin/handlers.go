@@ -11,15 +11,19 @@ import (
 	"bitbucket.org/ww/goautoneg"
 
 	restful "github.com/emicklei/go-restful"
+	"github.com/golang/glog"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kapierrors "k8s.io/kubernetes/pkg/api/errors"
 	klatest "k8s.io/kubernetes/pkg/api/latest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
+	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 )
 
 // TODO We would like to use the IndexHandler from k8s but we do not yet have a
@@ -79,18 +83,126 @@ func (c *MasterConfig) authorizationFilter(handler http.Handler) http.Handler {
 
 		allowed, reason, err := c.Authorizer.Authorize(ctx, attributes)
 		if err != nil {
+			if c.Auditor != nil {
+				c.Auditor.Denied(ctx, attributes, err.Error())
+			}
 			forbidden(err.Error(), attributes, w, req)
 			return
 		}
 		if !allowed {
+			if c.Auditor != nil {
+				c.Auditor.Denied(ctx, attributes, reason)
+			}
 			forbidden(reason, attributes, w, req)
 			return
 		}
 
+		if c.Auditor != nil {
+			c.Auditor.Handle(ctx, attributes, reason, handler, w, req)
+			return
+		}
 		handler.ServeHTTP(w, req)
 	})
 }
 
+// impersonationFilter lets a request act as the user named in the Impersonate-User header, and optionally as a member
+// of the groups named in the Impersonate-Group headers.  The authenticated user must be allowed to "impersonate" the
+// requested user (or service account) and every requested group.
+func (c *MasterConfig) impersonationFilter(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requestedUser := req.Header.Get(authenticationapi.ImpersonateUserHeader)
+		requestedGroups := req.Header[http.CanonicalHeaderKey(authenticationapi.ImpersonateGroupHeader)]
+		if len(requestedUser) == 0 {
+			if len(requestedGroups) > 0 {
+				http.Error(w, fmt.Sprintf("%s requires %s", authenticationapi.ImpersonateGroupHeader, authenticationapi.ImpersonateUserHeader), http.StatusBadRequest)
+				return
+			}
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		ctx, exists := c.RequestContextMapper.Get(req)
+		if !exists {
+			forbidden("context not found", nil, w, req)
+			return
+		}
+		oldUser, exists := kapi.UserFrom(ctx)
+		if !exists {
+			forbidden("user not found", nil, w, req)
+			return
+		}
+
+		// service accounts are impersonated through the serviceaccounts resource in their namespace
+		namespace := ""
+		userAttributes := &authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "users", ResourceName: requestedUser}
+		saNamespace, saName, saErr := serviceaccount.SplitUsername(requestedUser)
+		if saErr == nil {
+			namespace = saNamespace
+			userAttributes = &authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "serviceaccounts", ResourceName: saName}
+		}
+		if allowed, reason := c.authorizeImpersonation(kapi.WithNamespace(ctx, namespace), userAttributes); !allowed {
+			forbidden(reason, userAttributes, w, req)
+			return
+		}
+
+		groups := []string{}
+		switch {
+		case len(requestedGroups) > 0:
+			for _, group := range requestedGroups {
+				groupAttributes := &authorizer.DefaultAuthorizationAttributes{Verb: "impersonate", Resource: "groups", ResourceName: group}
+				if allowed, reason := c.authorizeImpersonation(kapi.WithNamespace(ctx, ""), groupAttributes); !allowed {
+					forbidden(reason, groupAttributes, w, req)
+					return
+				}
+				groups = append(groups, group)
+			}
+
+		case saErr == nil:
+			groups = append(groups, serviceaccount.MakeGroupNames(saNamespace, saName)...)
+
+		default:
+			cachedGroups, err := c.GroupCache.GroupsFor(requestedUser)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("unable to determine groups for %s: %v", requestedUser, err), http.StatusInternalServerError)
+				return
+			}
+			for _, group := range cachedGroups {
+				groups = append(groups, group.Name)
+			}
+			// like the token authenticator, add the groups recorded on the user. A user that does not exist yet has none
+			user, err := c.UserRegistry.GetUser(kapi.WithNamespace(ctx, ""), requestedUser)
+			switch {
+			case kapierrors.IsNotFound(err):
+			case err != nil:
+				http.Error(w, fmt.Sprintf("unable to determine groups for %s: %v", requestedUser, err), http.StatusInternalServerError)
+				return
+			default:
+				groups = append(groups, user.Groups...)
+			}
+		}
+		// every authenticated request is a member of the authenticated group, impersonated or not
+		groups = append(groups, bootstrappolicy.AuthenticatedGroup)
+
+		glog.V(4).Infof("%s is impersonating user %s with groups %v", oldUser.GetName(), requestedUser, groups)
+		newUser := authenticationapi.WithScopes(oldUser, requestedUser, "", groups)
+		if err := c.RequestContextMapper.Update(req, kapi.WithUser(ctx, newUser)); err != nil {
+			http.Error(w, "Unable to set impersonated request context", http.StatusInternalServerError)
+			return
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// authorizeImpersonation checks whether the user in the context may perform the impersonate check in attributes
+func (c *MasterConfig) authorizeImpersonation(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string) {
+	allowed, reason, err := c.Authorizer.Authorize(ctx, attributes)
+	if err != nil {
+		return false, err.Error()
+	}
+	return allowed, reason
+}
+
 // forbidden renders a simple forbidden error
 func forbidden(reason string, attributes authorizer.AuthorizationAttributes, w http.ResponseWriter, req *http.Request) {
 	kind := ""
@@ -0,0 +1,155 @@
+package origin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	authenticationapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	userapi "github.com/openshift/origin/pkg/user/api"
+	usercache "github.com/openshift/origin/pkg/user/cache"
+	usertest "github.com/openshift/origin/pkg/user/registry/test"
+)
+
+type impersonateAuthorizer struct {
+	// allowed holds the namespace/resource/name tuples that may be impersonated
+	allowed map[string]bool
+}
+
+func (a *impersonateAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	if attributes.GetVerb() != "impersonate" {
+		return false, "unexpected verb", nil
+	}
+	key := kapi.NamespaceValue(ctx) + "/" + attributes.GetResource() + "/" + attributes.GetResourceName()
+	if a.allowed[key] {
+		return true, "", nil
+	}
+	return false, "not allowed to impersonate " + key, nil
+}
+
+func (a *impersonateAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return nil, nil, nil
+}
+
+func TestImpersonationFilter(t *testing.T) {
+	testCases := map[string]struct {
+		User           string
+		Groups         []string
+		ExpectedCode   int
+		ExpectedUser   string
+		ExpectedGroups []string
+	}{
+		"no impersonation": {
+			ExpectedCode:   http.StatusOK,
+			ExpectedUser:   "admin",
+			ExpectedGroups: []string{"original"},
+		},
+		"allowed user": {
+			User:           "bob",
+			ExpectedCode:   http.StatusOK,
+			ExpectedUser:   "bob",
+			ExpectedGroups: []string{"system:authenticated"},
+		},
+		"allowed user with groups": {
+			User:           "carol",
+			ExpectedCode:   http.StatusOK,
+			ExpectedUser:   "carol",
+			ExpectedGroups: []string{"legacy", "system:authenticated"},
+		},
+		"disallowed user": {
+			User:         "alice",
+			ExpectedCode: http.StatusForbidden,
+		},
+		"allowed user and group": {
+			User:           "bob",
+			Groups:         []string{"developers"},
+			ExpectedCode:   http.StatusOK,
+			ExpectedUser:   "bob",
+			ExpectedGroups: []string{"developers", "system:authenticated"},
+		},
+		"disallowed group": {
+			User:         "bob",
+			Groups:       []string{"developers", "admins"},
+			ExpectedCode: http.StatusForbidden,
+		},
+		"group without user": {
+			Groups:       []string{"developers"},
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"allowed service account": {
+			User:           "system:serviceaccount:ns1:builder",
+			ExpectedCode:   http.StatusOK,
+			ExpectedUser:   "system:serviceaccount:ns1:builder",
+			ExpectedGroups: []string{"system:serviceaccounts", "system:serviceaccounts:ns1", "system:authenticated"},
+		},
+		"disallowed service account": {
+			User:         "system:serviceaccount:ns2:builder",
+			ExpectedCode: http.StatusForbidden,
+		},
+	}
+
+	for k, testCase := range testCases {
+		contextMapper := kapi.NewRequestContextMapper()
+		users := usertest.NewUserRegistry()
+		users.Get["carol"] = &userapi.User{ObjectMeta: kapi.ObjectMeta{Name: "carol"}, Groups: []string{"legacy"}}
+		config := &MasterConfig{
+			Authorizer: &impersonateAuthorizer{allowed: map[string]bool{
+				"/users/bob":                  true,
+				"/users/carol":                true,
+				"/groups/developers":          true,
+				"ns1/serviceaccounts/builder": true,
+			}},
+			GroupCache:           usercache.NewGroupCache(nil),
+			UserRegistry:         users,
+			RequestContextMapper: contextMapper,
+		}
+
+		var actualUser user.Info
+		handler := config.impersonationFilter(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx, _ := contextMapper.Get(req)
+			actualUser, _ = kapi.UserFrom(ctx)
+		}))
+		authenticated := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx, _ := contextMapper.Get(req)
+			contextMapper.Update(req, kapi.WithUser(ctx, &user.DefaultInfo{Name: "admin", Groups: []string{"original"}}))
+			handler.ServeHTTP(w, req)
+		})
+		server, err := kapi.NewRequestContextFilter(contextMapper, authenticated)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", k, err)
+		}
+
+		req, _ := http.NewRequest("GET", "/api/v1/namespaces", nil)
+		if len(testCase.User) > 0 {
+			req.Header.Set(authenticationapi.ImpersonateUserHeader, testCase.User)
+		}
+		for _, group := range testCase.Groups {
+			req.Header.Add(authenticationapi.ImpersonateGroupHeader, group)
+		}
+		resp := httptest.NewRecorder()
+		server.ServeHTTP(resp, req)
+
+		if resp.Code != testCase.ExpectedCode {
+			t.Errorf("%s: expected code %d, got %d: %s", k, testCase.ExpectedCode, resp.Code, resp.Body.String())
+			continue
+		}
+		if testCase.ExpectedCode != http.StatusOK {
+			if actualUser != nil {
+				t.Errorf("%s: expected the request to be rejected, but it was handled as %#v", k, actualUser)
+			}
+			continue
+		}
+		if actualUser.GetName() != testCase.ExpectedUser {
+			t.Errorf("%s: expected user %s, got %s", k, testCase.ExpectedUser, actualUser.GetName())
+		}
+		if !reflect.DeepEqual(actualUser.GetGroups(), testCase.ExpectedGroups) {
+			t.Errorf("%s: expected groups %v, got %v", k, testCase.ExpectedGroups, actualUser.GetGroups())
+		}
+	}
+}
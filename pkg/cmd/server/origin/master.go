@@ -20,6 +20,7 @@ import (
 	v1beta1extensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 	"k8s.io/kubernetes/pkg/apiserver"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
 	kmaster "k8s.io/kubernetes/pkg/master"
 	"k8s.io/kubernetes/pkg/util"
@@ -57,20 +58,29 @@ import (
 	"github.com/openshift/origin/pkg/image/registry/imagestreamimport"
 	"github.com/openshift/origin/pkg/image/registry/imagestreammapping"
 	"github.com/openshift/origin/pkg/image/registry/imagestreamtag"
+	accesstokenregistry "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
 	authorizetokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthauthorizetoken/etcd"
 	clientetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclient/etcd"
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
+	"github.com/openshift/origin/pkg/oauth/registry/serviceaccounttokenrequest"
+	"github.com/openshift/origin/pkg/oauth/registry/useroauthaccesstoken"
+	podpresetetcd "github.com/openshift/origin/pkg/podpreset/registry/podpreset/etcd"
 	projectproxy "github.com/openshift/origin/pkg/project/registry/project/proxy"
 	projectrequeststorage "github.com/openshift/origin/pkg/project/registry/projectrequest/delegated"
+	clusterresourcequotaetcd "github.com/openshift/origin/pkg/quota/registry/clusterresourcequota/etcd"
 	routeallocationcontroller "github.com/openshift/origin/pkg/route/controller/allocation"
 	routeetcd "github.com/openshift/origin/pkg/route/registry/route/etcd"
+	"github.com/openshift/origin/pkg/sdn/registry/clusternetwork"
 	clusternetworketcd "github.com/openshift/origin/pkg/sdn/registry/clusternetwork/etcd"
 	hostsubnetetcd "github.com/openshift/origin/pkg/sdn/registry/hostsubnet/etcd"
 	netnamespaceetcd "github.com/openshift/origin/pkg/sdn/registry/netnamespace/etcd"
+	podsecuritypolicyreviewregistry "github.com/openshift/origin/pkg/security/registry/podsecuritypolicyreview"
+	podsecuritypolicysubjectreviewregistry "github.com/openshift/origin/pkg/security/registry/podsecuritypolicysubjectreview"
 	"github.com/openshift/origin/pkg/service"
 	templateregistry "github.com/openshift/origin/pkg/template/registry"
 	templateetcd "github.com/openshift/origin/pkg/template/registry/etcd"
+	"github.com/openshift/origin/pkg/template/registry/sharedtemplate"
 	groupetcd "github.com/openshift/origin/pkg/user/registry/group/etcd"
 	identityregistry "github.com/openshift/origin/pkg/user/registry/identity"
 	identityetcd "github.com/openshift/origin/pkg/user/registry/identity/etcd"
@@ -79,6 +89,7 @@ import (
 	"github.com/openshift/origin/pkg/user/registry/useridentitymapping"
 
 	"github.com/openshift/origin/pkg/build/registry/buildclone"
+	"github.com/openshift/origin/pkg/build/registry/buildconfighistory"
 	"github.com/openshift/origin/pkg/build/registry/buildconfiginstantiate"
 
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
@@ -96,6 +107,8 @@ import (
 	"github.com/openshift/origin/pkg/authorization/registry/resourceaccessreview"
 	rolestorage "github.com/openshift/origin/pkg/authorization/registry/role/policybased"
 	rolebindingstorage "github.com/openshift/origin/pkg/authorization/registry/rolebinding/policybased"
+	rolebindingrestrictionetcd "github.com/openshift/origin/pkg/authorization/registry/rolebindingrestriction/etcd"
+	"github.com/openshift/origin/pkg/authorization/registry/selfsubjectrulesreview"
 	"github.com/openshift/origin/pkg/authorization/registry/subjectaccessreview"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	routeplugin "github.com/openshift/origin/plugins/route/allocation/simple"
@@ -151,6 +164,7 @@ func (c *MasterConfig) Run(protected []APIInstaller, unprotected []APIInstaller)
 		extra = append(extra, i.InstallAPI(safe)...)
 	}
 	handler := c.authorizationFilter(safe)
+	handler = c.impersonationFilter(handler)
 	handler = authenticationHandlerFilter(handler, c.Authenticator, c.getRequestContextMapper())
 	handler = namespacingFilter(handler, c.getRequestContextMapper())
 	handler = cacheControlFilter(handler, "no-store") // protected endpoints should not be cached
@@ -343,7 +357,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	buildStorage, buildDetailsStorage := buildetcd.NewREST(c.EtcdHelper)
 	buildRegistry := buildregistry.NewRegistry(buildStorage)
 
-	buildConfigStorage := buildconfigetcd.NewREST(c.EtcdHelper)
+	buildConfigStorage := buildconfigetcd.NewREST(c.EtcdHelper, c.Options.MasterPublicURL+OpenShiftAPIPrefixV1)
 	buildConfigRegistry := buildconfigregistry.NewRegistry(buildConfigStorage)
 
 	deployConfigStorage, deployConfigScaleStorage := deployconfigetcd.NewREST(c.EtcdHelper, c.DeploymentConfigScaleClient())
@@ -352,9 +366,15 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	routeAllocator := c.RouteAllocator()
 
 	routeStorage, routeStatusStorage := routeetcd.NewREST(c.EtcdHelper, routeAllocator)
-	hostSubnetStorage := hostsubnetetcd.NewREST(c.EtcdHelper)
-	netNamespaceStorage := netnamespaceetcd.NewREST(c.EtcdHelper)
 	clusterNetworkStorage := clusternetworketcd.NewREST(c.EtcdHelper)
+	hostSubnetStorage := hostsubnetetcd.NewREST(c.EtcdHelper, clusternetwork.NewRegistry(clusterNetworkStorage))
+	netNamespaceStorage := netnamespaceetcd.NewREST(c.EtcdHelper)
+
+	clusterResourceQuotaStorage, clusterResourceQuotaStatusStorage := clusterresourcequotaetcd.NewREST(c.EtcdHelper)
+
+	sccMatcher := c.SCCCache.Matcher()
+	podSecurityPolicySubjectReviewStorage := podsecuritypolicysubjectreviewregistry.NewREST(sccMatcher, c.KubeClient())
+	podSecurityPolicyReviewStorage := podsecuritypolicyreviewregistry.NewREST(sccMatcher, c.KubeClient())
 
 	userStorage := useretcd.NewREST(c.EtcdHelper)
 	userRegistry := userregistry.NewRegistry(userStorage)
@@ -362,6 +382,9 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	identityRegistry := identityregistry.NewRegistry(identityStorage)
 	userIdentityMappingStorage := useridentitymapping.NewREST(userRegistry, identityRegistry)
 
+	accessTokenStorage := accesstokenetcd.NewREST(c.EtcdHelper)
+	userAccessTokenStorage := useroauthaccesstoken.NewREST(accesstokenregistry.NewRegistry(accessTokenStorage))
+
 	policyStorage := policyetcd.NewStorage(c.EtcdHelper)
 	policyRegistry := policyregistry.NewRegistry(policyStorage)
 	policyBindingStorage := policybindingetcd.NewStorage(c.EtcdHelper)
@@ -383,6 +406,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	resourceAccessReviewStorage := resourceaccessreview.NewREST(c.Authorizer)
 	resourceAccessReviewRegistry := resourceaccessreview.NewRegistry(resourceAccessReviewStorage)
 	localResourceAccessReviewStorage := localresourceaccessreview.NewREST(resourceAccessReviewRegistry)
+	selfSubjectRulesReviewStorage := selfsubjectrulesreview.NewREST(c.RuleResolver)
 
 	imageStorage := imageetcd.NewREST(c.EtcdHelper)
 	imageRegistry := image.NewRegistry(imageStorage)
@@ -414,6 +438,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		},
 		ServiceAccounts: c.KubeClient(),
 		Secrets:         c.KubeClient(),
+		Admission:       c.BuildGeneratorAdmissionControl,
 	}
 
 	// TODO: with sharding, this needs to be changed
@@ -449,8 +474,15 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 			"generic": generic.New(),
 			"github":  github.New(),
 		},
+		webhook.NewLimiter(
+			c.Options.BuildWebHookConfig.RateLimitBurst,
+			time.Duration(c.Options.BuildWebHookConfig.RateLimitIntervalSeconds)*time.Second,
+			c.Options.BuildWebHookConfig.RejectReplayedDeliveries,
+		),
 	)
 
+	templateStorage := templateetcd.NewREST(c.EtcdHelper)
+
 	storage := map[string]rest.Storage{
 		"images":               imageStorage,
 		"imageStreams/secrets": imageStreamSecretsStorage,
@@ -467,8 +499,9 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"deploymentConfigRollbacks": deployrollback.NewREST(deployRollbackClient, c.EtcdHelper.Codec()),
 		"deploymentConfigs/log":     deploylogregistry.NewREST(configClient, kclient, c.DeploymentLogClient(), kubeletClient),
 
-		"processedTemplates": templateregistry.NewREST(),
-		"templates":          templateetcd.NewREST(c.EtcdHelper),
+		"processedTemplates": templateregistry.NewREST(c.PrivilegedLoopbackKubernetesClient, c.Authorizer),
+		"templates":          templateStorage,
+		"sharedTemplates":    sharedtemplate.NewREST(templateStorage),
 
 		"routes":        routeStorage,
 		"routes/status": routeStatusStorage,
@@ -476,6 +509,16 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"projects":        projectStorage,
 		"projectRequests": projectRequestStorage,
 
+		"podPresets": podpresetetcd.NewREST(c.EtcdHelper),
+
+		"roleBindingRestrictions": rolebindingrestrictionetcd.NewREST(c.EtcdHelper),
+
+		"clusterResourceQuotas":        clusterResourceQuotaStorage,
+		"clusterResourceQuotas/status": clusterResourceQuotaStatusStorage,
+
+		"podSecurityPolicySubjectReviews": podSecurityPolicySubjectReviewStorage,
+		"podSecurityPolicyReviews":        podSecurityPolicyReviewStorage,
+
 		"hostSubnets":     hostSubnetStorage,
 		"netNamespaces":   netNamespaceStorage,
 		"clusterNetworks": clusterNetworkStorage,
@@ -486,7 +529,8 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"userIdentityMappings": userIdentityMappingStorage,
 
 		"oAuthAuthorizeTokens":      authorizetokenetcd.NewREST(c.EtcdHelper),
-		"oAuthAccessTokens":         accesstokenetcd.NewREST(c.EtcdHelper),
+		"oAuthAccessTokens":         accessTokenStorage,
+		"userOAuthAccessTokens":     userAccessTokenStorage,
 		"oAuthClients":              clientetcd.NewREST(c.EtcdHelper),
 		"oAuthClientAuthorizations": clientauthetcd.NewREST(c.EtcdHelper),
 
@@ -494,6 +538,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"subjectAccessReviews":       subjectAccessReviewStorage,
 		"localSubjectAccessReviews":  localSubjectAccessReviewStorage,
 		"localResourceAccessReviews": localResourceAccessReviewStorage,
+		"selfSubjectRulesReviews":    selfSubjectRulesReviewStorage,
 
 		"policies":       policyStorage,
 		"policyBindings": policyBindingStorage,
@@ -506,12 +551,19 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"clusterRoles":          clusterRoleStorage,
 	}
 
+	if c.Options.OAuthConfig != nil {
+		// minted tokens are only authenticated by the integrated OAuth server
+		maxExpiresIn := int64(c.Options.OAuthConfig.TokenConfig.AccessTokenMaxAgeSeconds)
+		storage["serviceAccountTokenRequests"] = serviceaccounttokenrequest.NewREST(accesstokenregistry.NewRegistry(accessTokenStorage), serviceaccount.NewGetterFromClient(c.KubeClient()), maxExpiresIn)
+	}
+
 	if configapi.IsBuildEnabled(&c.Options) {
 		storage["builds"] = buildStorage
 		storage["buildConfigs"] = buildConfigStorage
 		storage["buildConfigs/webhooks"] = buildConfigWebHooks
 		storage["builds/clone"] = buildclone.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiate"] = buildconfiginstantiate.NewStorage(buildGenerator)
+		storage["buildConfigs/history"] = buildconfighistory.NewREST(buildConfigRegistry, buildRegistry)
 		storage["buildConfigs/instantiatebinary"] = buildconfiginstantiate.NewBinaryStorage(buildGenerator, buildStorage, c.BuildLogClient(), kubeletClient)
 		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient(), kubeletClient)
 		storage["builds/details"] = buildDetailsStorage
@@ -639,7 +691,7 @@ func (c *MasterConfig) RouteAllocator() *routeallocationcontroller.RouteAllocati
 		KubeClient: kclient,
 	}
 
-	plugin, err := routeplugin.NewSimpleAllocationPlugin(c.Options.RoutingConfig.Subdomain)
+	plugin, err := routeplugin.NewSimpleAllocationPlugin(c.Options.RoutingConfig.Subdomain, c.Options.RoutingConfig.HostnameTemplate)
 	if err != nil {
 		glog.Fatalf("Route plugin initialization failed: %v", err)
 	}
@@ -30,10 +30,14 @@ import (
 	"github.com/openshift/origin/pkg/auth/authenticator/request/paramtoken"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/unionrequest"
 	"github.com/openshift/origin/pkg/auth/authenticator/request/x509request"
+	"github.com/openshift/origin/pkg/auth/authenticator/token/scopedserviceaccount"
 	"github.com/openshift/origin/pkg/auth/group"
 	authnregistry "github.com/openshift/origin/pkg/auth/oauth/registry"
 	"github.com/openshift/origin/pkg/auth/userregistry/identitymapper"
+	"github.com/openshift/origin/pkg/authorization/admission/restrictusers"
+	"github.com/openshift/origin/pkg/authorization/audit"
 	"github.com/openshift/origin/pkg/authorization/authorizer"
+	scopeauthorizer "github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	policycache "github.com/openshift/origin/pkg/authorization/cache"
 	policyclient "github.com/openshift/origin/pkg/authorization/client"
 	clusterpolicyregistry "github.com/openshift/origin/pkg/authorization/registry/clusterpolicy"
@@ -45,6 +49,7 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	buildadmissiondeadline "github.com/openshift/origin/pkg/build/admission/deadline"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -53,10 +58,13 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util/plug"
 	"github.com/openshift/origin/pkg/cmd/util/pluginconfig"
 	"github.com/openshift/origin/pkg/cmd/util/variable"
+	imageadmission "github.com/openshift/origin/pkg/image/admission"
 	accesstokenregistry "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
 	projectauth "github.com/openshift/origin/pkg/project/auth"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
+	originquotaadmission "github.com/openshift/origin/pkg/quota/admission/resourcequota"
+	oscc "github.com/openshift/origin/pkg/security/admission"
 	"github.com/openshift/origin/pkg/serviceaccounts"
 	usercache "github.com/openshift/origin/pkg/user/cache"
 	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
@@ -77,16 +85,27 @@ type MasterConfig struct {
 	Authenticator                 authenticator.Request
 	Authorizer                    authorizer.Authorizer
 	AuthorizationAttributeBuilder authorizer.AuthorizationAttributeBuilder
+	// Auditor, if set, records policy changes and authorization denials
+	Auditor *audit.Auditor
+	// RuleResolver resolves the rules of users from the cached policy the Authorizer uses
+	RuleResolver rulevalidation.AuthorizationRuleResolver
 
 	PolicyCache               policycache.ReadOnlyCache
 	GroupCache                *usercache.GroupCache
 	ProjectAuthorizationCache *projectauth.AuthorizationCache
 	ProjectCache              *projectcache.ProjectCache
+	// SCCCache holds the security context constraints the pod security policy reviews match against
+	SCCCache *oscc.SCCCache
+
+	// UserRegistry looks up the users that requests impersonate
+	UserRegistry userregistry.Registry
 
 	// RequestContextMapper maps requests to contexts
 	RequestContextMapper kapi.RequestContextMapper
 
 	AdmissionControl admission.Interface
+	// BuildGeneratorAdmissionControl admits the builds the build generator creates from build configs and other builds
+	BuildGeneratorAdmissionControl admission.Interface
 
 	TLS bool
 
@@ -165,11 +184,18 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 
 	groupCache := usercache.NewGroupCache(groupregistry.NewRegistry(groupstorage.NewREST(etcdHelper)))
 	projectCache := projectcache.NewProjectCache(privilegedLoopbackKubeClient.Namespaces(), options.ProjectConfig.DefaultNodeSelector)
+	projectCache.InfraNodeSelector = options.ProjectConfig.InfraNodeSelector
+	projectCache.InfraProjects = sets.NewString(options.ProjectConfig.InfraProjects...)
+	// build and deployer pods are created by these infrastructure controllers
+	projectCache.InfraPodCreators = sets.NewString(
+		serviceaccount.MakeUsername(options.PolicyConfig.OpenShiftInfrastructureNamespace, bootstrappolicy.InfraBuildControllerServiceAccountName),
+		serviceaccount.MakeUsername(options.PolicyConfig.OpenShiftInfrastructureNamespace, bootstrappolicy.InfraDeploymentControllerServiceAccountName),
+	)
 
 	kubeletClientConfig := configapi.GetKubeletClientConfig(options)
 
 	// in-order list of plug-ins that should intercept admission decisions (origin only intercepts)
-	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy"}
+	admissionControlPluginNames := []string{"OriginNamespaceLifecycle", "BuildByStrategy", buildadmissiondeadline.PluginName, imageadmission.PluginName, restrictusers.PluginName, originquotaadmission.PluginName}
 	if len(options.AdmissionConfig.PluginOrderOverride) > 0 {
 		admissionControlPluginNames = options.AdmissionConfig.PluginOrderOverride
 	}
@@ -178,7 +204,11 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		OpenshiftClient: privilegedLoopbackOpenShiftClient,
 		ProjectCache:    projectCache,
 	}
+	// builds instantiated from build configs or cloned from builds are stored directly by the build generator, so the
+	// plug-ins that set or limit what a build may use have to admit them as well
+	buildGeneratorPluginNames := sets.NewString(buildadmissiondeadline.PluginName, originquotaadmission.PluginName)
 	plugins := []admission.Interface{}
+	buildGeneratorPlugins := []admission.Interface{}
 	for _, pluginName := range admissionControlPluginNames {
 		configFile, err := pluginconfig.GetPluginConfig(options.AdmissionConfig.PluginConfig[pluginName])
 		if err != nil {
@@ -187,6 +217,9 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		plugin := admission.InitPlugin(pluginName, privilegedLoopbackKubeClient, configFile)
 		if plugin != nil {
 			plugins = append(plugins, plugin)
+			if buildGeneratorPluginNames.Has(pluginName) {
+				buildGeneratorPlugins = append(buildGeneratorPlugins, plugin)
+			}
 		}
 	}
 	pluginInitializer.Initialize(plugins)
@@ -203,7 +236,13 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 
 	plug, plugStart := newControllerPlug(options, client)
 
-	authorizer := newAuthorizer(policyClient, options.ProjectConfig.ProjectRequestMessage)
+	ruleResolver := newRuleResolver(policyClient)
+	authorizer := newAuthorizer(ruleResolver, options.ProjectConfig.ProjectRequestMessage)
+
+	auditor, err := newAuditor(options.AuditConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	config := &MasterConfig{
 		Options: options,
@@ -211,15 +250,20 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		Authenticator:                 newAuthenticator(options, etcdHelper, serviceAccountTokenGetter, apiClientCAs, groupCache),
 		Authorizer:                    authorizer,
 		AuthorizationAttributeBuilder: newAuthorizationAttributeBuilder(requestContextMapper),
+		Auditor:                       auditor,
+		RuleResolver:                  ruleResolver,
 
 		PolicyCache:               policyCache,
 		GroupCache:                groupCache,
+		UserRegistry:              userregistry.NewRegistry(useretcd.NewREST(etcdHelper)),
 		ProjectAuthorizationCache: newProjectAuthorizationCache(authorizer, privilegedLoopbackKubeClient, policyClient),
 		ProjectCache:              projectCache,
+		SCCCache:                  oscc.NewSCCCache(privilegedLoopbackKubeClient),
 
 		RequestContextMapper: requestContextMapper,
 
-		AdmissionControl: admissionController,
+		AdmissionControl:               admissionController,
+		BuildGeneratorAdmissionControl: admission.NewChainHandler(buildGeneratorPlugins...),
 
 		TLS: configapi.UseTLS(options.ServingInfo.ServingInfo),
 
@@ -302,7 +346,7 @@ func newAuthenticator(config configapi.MasterConfig, etcdHelper storage.Interfac
 			}
 			publicKeys = append(publicKeys, publicKey)
 		}
-		tokenAuthenticator := serviceaccount.JWTTokenAuthenticator(publicKeys, true, tokenGetter)
+		tokenAuthenticator := scopedserviceaccount.NewTokenAuthenticator(publicKeys, tokenGetter)
 		authenticators = append(authenticators, bearertoken.New(tokenAuthenticator, true))
 	}
 
@@ -318,6 +362,12 @@ func newAuthenticator(config configapi.MasterConfig, etcdHelper storage.Interfac
 		authenticators = append(authenticators,
 			// if you have a bearer token, you're a human (usually)
 			group.NewGroupAdder(unionrequest.NewUnionAuthentication(tokenRequestAuthenticators...), []string{bootstrappolicy.HumanGroup}))
+
+		// access tokens minted for service accounts do not belong to humans
+		serviceAccountTokenAuthenticator := getEtcdServiceAccountTokenAuthenticator(etcdHelper, tokenGetter)
+		authenticators = append(authenticators,
+			bearertoken.New(serviceAccountTokenAuthenticator, true),
+			paramtoken.New("access_token", serviceAccountTokenAuthenticator, true))
 	}
 
 	if configapi.UseTLS(config.ServingInfo.ServingInfo) {
@@ -361,14 +411,43 @@ func newReadOnlyCacheAndClient(etcdHelper storage.Interface) (cache policycache.
 	return
 }
 
-func newAuthorizer(policyClient policyclient.ReadOnlyPolicyClient, projectRequestDenyMessage string) authorizer.Authorizer {
-	authorizer := authorizer.NewAuthorizer(rulevalidation.NewDefaultRuleResolver(
+func newRuleResolver(policyClient policyclient.ReadOnlyPolicyClient) rulevalidation.AuthorizationRuleResolver {
+	return rulevalidation.NewDefaultRuleResolver(
 		rulevalidation.PolicyGetter(policyClient),
 		rulevalidation.BindingLister(policyClient),
 		rulevalidation.ClusterPolicyGetter(policyClient),
 		rulevalidation.ClusterBindingLister(policyClient),
-	), authorizer.NewForbiddenMessageResolver(projectRequestDenyMessage))
-	return authorizer
+	)
+}
+
+func newAuthorizer(ruleResolver rulevalidation.AuthorizationRuleResolver, projectRequestDenyMessage string) authorizer.Authorizer {
+	authorizer := authorizer.NewAuthorizer(ruleResolver, authorizer.NewForbiddenMessageResolver(projectRequestDenyMessage))
+	// Requests made with scoped tokens are limited to what their scopes allow
+	return scopeauthorizer.NewAuthorizer(authorizer, ruleResolver)
+}
+
+// newAuditor returns nil when auditing is disabled
+func newAuditor(config configapi.AuditConfig) (*audit.Auditor, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	backends := []audit.Backend{}
+	if len(config.AuditFilePath) > 0 {
+		fileBackend, err := audit.NewFileBackend(config.AuditFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open audit file: %v", err)
+		}
+		backends = append(backends, fileBackend)
+	}
+	if len(config.WebhookURL) > 0 {
+		backends = append(backends, audit.NewWebhookBackend(config.WebhookURL))
+	}
+	if len(backends) == 0 {
+		backends = append(backends, audit.NewLogBackend())
+	}
+
+	return audit.NewAuditor(audit.NewMultiBackend(backends...), config.DenialSampleInterval), nil
 }
 
 func newAuthorizationAttributeBuilder(requestContextMapper kapi.RequestContextMapper) authorizer.AuthorizationAttributeBuilder {
@@ -386,6 +465,13 @@ func getEtcdTokenAuthenticator(etcdHelper storage.Interface, groupMapper identit
 	return authnregistry.NewTokenAuthenticator(accessTokenRegistry, userRegistry, groupMapper)
 }
 
+func getEtcdServiceAccountTokenAuthenticator(etcdHelper storage.Interface, tokenGetter serviceaccount.ServiceAccountTokenGetter) authenticator.Token {
+	accessTokenStorage := accesstokenetcd.NewREST(etcdHelper)
+	accessTokenRegistry := accesstokenregistry.NewRegistry(accessTokenStorage)
+
+	return authnregistry.NewServiceAccountTokenAuthenticator(accessTokenRegistry, tokenGetter)
+}
+
 // KubeClient returns the kubernetes client object
 func (c *MasterConfig) KubeClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
@@ -465,9 +551,14 @@ func (c *MasterConfig) ImageChangeControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
-// ImageImportControllerClient returns the deployment client object
-func (c *MasterConfig) ImageImportControllerClient() *osclient.Client {
-	return c.PrivilegedLoopbackOpenShiftClient
+// ImageImportControllerClients returns the image import controller client objects
+func (c *MasterConfig) ImageImportControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
+// ImageTriggerControllerClients returns the image trigger controller client objects
+func (c *MasterConfig) ImageTriggerControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
 }
 
 // DeploymentConfigScaleClient returns the client used by the Scale subresource registry
@@ -551,6 +642,26 @@ func (c *MasterConfig) OriginNamespaceControllerClients() (*osclient.Client, *kc
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
 }
 
+// ProjectTemplateDefaultsControllerClients returns the project template defaults controller client objects
+func (c *MasterConfig) ProjectTemplateDefaultsControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
+// ClusterQuotaReconciliationControllerClients returns the cluster quota reconciliation controller client objects
+func (c *MasterConfig) ClusterQuotaReconciliationControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
+// ResourceQuotaControllerClients returns the resource quota controller client objects
+func (c *MasterConfig) ResourceQuotaControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
+// UnidlingControllerClients returns the unidling controller client objects
+func (c *MasterConfig) UnidlingControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
 // NewEtcdStorage returns a storage interface for the provided storage version.
 func NewEtcdStorage(client *etcdclient.Client, version unversioned.GroupVersion, prefix string) (oshelper storage.Interface, err error) {
 	interfaces, err := latest.InterfacesFor(version)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/openshift/origin/pkg/api/validation"
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	oscc "github.com/openshift/origin/pkg/security/admission"
 )
 
 // KnownUpdateValidationExceptions is the list of types that are known to not have an update validation function registered
@@ -83,5 +84,6 @@ func fakeMasterConfig() *MasterConfig {
 	return &MasterConfig{
 		KubeletClientConfig: &kubeletclient.KubeletClientConfig{},
 		EtcdHelper:          etcdstorage.NewEtcdStorage(nil, nil, ""),
+		SCCCache:            oscc.NewSCCCache(nil),
 	}
 }
@@ -30,12 +30,16 @@ import (
 	imagechangecontroller "github.com/openshift/origin/pkg/deploy/controller/imagechange"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imagetriggercontroller "github.com/openshift/origin/pkg/image/controller/trigger"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
+	quotacontroller "github.com/openshift/origin/pkg/quota/controller"
 	securitycontroller "github.com/openshift/origin/pkg/security/controller"
 	"github.com/openshift/origin/pkg/security/mcs"
 	"github.com/openshift/origin/pkg/security/uid"
 	"github.com/openshift/origin/pkg/security/uidallocator"
+	unidlingcontroller "github.com/openshift/origin/pkg/unidling/controller"
 
+	osdnapi "github.com/openshift/openshift-sdn/plugins/osdn/api"
 	"github.com/openshift/openshift-sdn/plugins/osdn/factory"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
@@ -60,6 +64,64 @@ func (c *MasterConfig) RunOriginNamespaceController() {
 	controller.Run()
 }
 
+// RunProjectTemplateDefaultsController starts the controller that creates the resource quotas and limit ranges
+// of the project request template in existing projects that have none
+func (c *MasterConfig) RunProjectTemplateDefaultsController() {
+	namespace, templateName, err := configapi.ParseNamespaceAndName(c.Options.ProjectConfig.ProjectRequestTemplate)
+	if err != nil {
+		glog.Errorf("Error parsing project request template value, project template defaults will not be applied: %v", err)
+		return
+	}
+	osclient, kclient := c.ProjectTemplateDefaultsControllerClients()
+	factory := projectcontroller.TemplateDefaultsControllerFactory{
+		Client:            osclient,
+		KubeClient:        kclient,
+		TemplateNamespace: namespace,
+		TemplateName:      templateName,
+		ResyncPeriod:      10 * time.Minute,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunClusterQuotaReconciliationController starts the controller that records the usage of
+// cluster resource quotas across the namespaces they select
+func (c *MasterConfig) RunClusterQuotaReconciliationController() {
+	osclient, kclient := c.ClusterQuotaReconciliationControllerClients()
+	factory := quotacontroller.ClusterQuotaReconciliationControllerFactory{
+		Client:       osclient,
+		KubeClient:   kclient,
+		ResyncPeriod: 5 * time.Minute,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunResourceQuotaController starts the controller that records the usage of OpenShift resources
+// limited by resource quotas
+func (c *MasterConfig) RunResourceQuotaController() {
+	osclient, kclient := c.ResourceQuotaControllerClients()
+	factory := quotacontroller.ResourceQuotaControllerFactory{
+		Client:       osclient,
+		KubeClient:   kclient,
+		ResyncPeriod: 5 * time.Minute,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
+// RunUnidlingController starts the controller that scales idled services back up when they
+// receive traffic
+func (c *MasterConfig) RunUnidlingController() {
+	osclient, kclient := c.UnidlingControllerClients()
+	factory := unidlingcontroller.UnidlingControllerFactory{
+		Client:     osclient,
+		KubeClient: kclient,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
 // RunServiceAccountsController starts the service account controller
 func (c *MasterConfig) RunServiceAccountsController() {
 	if len(c.Options.ServiceAccountConfig.ManagedNames) == 0 {
@@ -152,6 +214,17 @@ func (c *MasterConfig) RunDNSServer() {
 	}
 	config.DnsAddr = c.Options.DNSConfig.BindAddress
 	config.NoRec = true // do not want to deploy an open resolver
+	if len(c.Options.DNSConfig.Nameservers) > 0 {
+		// recursion is only enabled towards the nameservers the administrator chose
+		config.NoRec = false
+		config.Nameservers = []string{}
+		for _, nameserver := range c.Options.DNSConfig.Nameservers {
+			if net.ParseIP(nameserver) != nil {
+				nameserver = net.JoinHostPort(nameserver, "53")
+			}
+			config.Nameservers = append(config.Nameservers, nameserver)
+		}
+	}
 
 	_, port, err := net.SplitHostPort(c.Options.DNSConfig.BindAddress)
 	if err != nil {
@@ -336,7 +409,11 @@ func (c *MasterConfig) RunSDNController() {
 	}
 
 	if controller != nil {
-		err = controller.StartMaster(c.Options.NetworkConfig.ClusterNetworkCIDR, c.Options.NetworkConfig.HostSubnetLength, c.Options.NetworkConfig.ServiceNetworkCIDR)
+		clusterNetworks := []osdnapi.ClusterNetwork{}
+		for _, clusterNetwork := range configapi.GetClusterNetworks(c.Options.NetworkConfig) {
+			clusterNetworks = append(clusterNetworks, osdnapi.ClusterNetwork{CIDR: clusterNetwork.CIDR, HostSubnetLength: clusterNetwork.HostSubnetLength})
+		}
+		err = controller.StartMaster(clusterNetworks, c.Options.NetworkConfig.ServiceNetworkCIDR)
 		if err != nil {
 			glog.Fatalf("SDN initialization failed: %v", err)
 		}
@@ -345,11 +422,12 @@ func (c *MasterConfig) RunSDNController() {
 
 // RunImageImportController starts the image import trigger controller process.
 func (c *MasterConfig) RunImageImportController() {
-	osclient := c.ImageImportControllerClient()
+	osclient, kclient := c.ImageImportControllerClients()
 	importRate := float32(c.Options.ImagePolicyConfig.MaxScheduledImageImportsPerMinute) / float32(time.Minute/time.Second)
 	importBurst := c.Options.ImagePolicyConfig.MaxScheduledImageImportsPerMinute * 2
 	factory := imagecontroller.ImportControllerFactory{
 		Client:               osclient,
+		KubeClient:           kclient,
 		ResyncInterval:       10 * time.Minute,
 		MinimumCheckInterval: time.Duration(c.Options.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds) * time.Second,
 		ImportRateLimiter:    util.NewTokenBucketRateLimiter(importRate, importBurst),
@@ -364,6 +442,15 @@ func (c *MasterConfig) RunImageImportController() {
 	}
 }
 
+// RunImageTriggerController starts the controller that applies the image triggers annotated on
+// deployment configs and replication controllers.
+func (c *MasterConfig) RunImageTriggerController() {
+	osclient, kclient := c.ImageTriggerControllerClients()
+	factory := imagetriggercontroller.TriggerControllerFactory{Client: osclient, KubeClient: kclient}
+	controller := factory.Create()
+	controller.Run()
+}
+
 // RunSecurityAllocationController starts the security allocation controller process.
 func (c *MasterConfig) RunSecurityAllocationController() {
 	alloc := c.Options.ProjectConfig.SecurityAllocator
@@ -419,3 +506,8 @@ func (c *MasterConfig) RunSecurityAllocationController() {
 func (c *MasterConfig) RunGroupCache() {
 	c.GroupCache.Run()
 }
+
+// RunSCCCache starts the security context constraints cache, used by the pod security policy reviews
+func (c *MasterConfig) RunSCCCache() {
+	c.SCCCache.Run()
+}
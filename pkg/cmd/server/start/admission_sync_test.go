@@ -22,8 +22,11 @@ var admissionPluginsNotUsedByKube = sets.NewString(
 	"DenyEscalatingExec",     // from kube, it denies exec to pods that have certain privileges.  This is superceded in origin by SCCExecRestrictions that checks against SCC rules.
 
 	"BuildByStrategy",          // from origin, only needed for managing builds, not kubernetes resources
+	"BuildCompletionDeadline",  // from origin, only needed for managing builds, not kubernetes resources
 	"OriginNamespaceLifecycle", // from origin, only needed for rejecting openshift resources, so not needed by kube
+	"OriginResourceQuota",      // from origin, only needed for enforcing quota on openshift resources, so not needed by kube
 	"ProjectRequestLimit",      // from origin, used for limiting project requests by user (online use case)
+	"RestrictSubjectBindings",  // from origin, only needed for restricting role bindings, not kubernetes resources
 
 	"NamespaceExists",  // superceded by NamespaceLifecycle
 	"InitialResources", // do we want this? https://github.com/kubernetes/kubernetes/blob/master/docs/proposals/initial-resources.md
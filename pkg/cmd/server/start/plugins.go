@@ -3,10 +3,16 @@ package start
 import (
 
 	// Admission control plug-ins used by OpenShift
+	_ "github.com/openshift/origin/pkg/authorization/admission/restrictusers"
 	_ "github.com/openshift/origin/pkg/build/admission"
+	_ "github.com/openshift/origin/pkg/build/admission/deadline"
+	_ "github.com/openshift/origin/pkg/image/admission"
+	_ "github.com/openshift/origin/pkg/podpreset/admission"
 	_ "github.com/openshift/origin/pkg/project/admission/lifecycle"
 	_ "github.com/openshift/origin/pkg/project/admission/nodeenv"
 	_ "github.com/openshift/origin/pkg/project/admission/requestlimit"
+	_ "github.com/openshift/origin/pkg/quota/admission/clusterresourcequota"
+	_ "github.com/openshift/origin/pkg/quota/admission/resourcequota"
 	_ "github.com/openshift/origin/pkg/security/admission"
 	_ "k8s.io/kubernetes/plugin/pkg/admission/admit"
 	_ "k8s.io/kubernetes/plugin/pkg/admission/exec"
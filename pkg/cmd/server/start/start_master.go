@@ -332,7 +332,7 @@ func BuildKubernetesMasterConfig(openshiftConfig *origin.MasterConfig) (*kuberne
 	if openshiftConfig.Options.KubernetesMasterConfig == nil {
 		return nil, nil
 	}
-	kubeConfig, err := kubernetes.BuildKubernetesMasterConfig(openshiftConfig.Options, openshiftConfig.RequestContextMapper, openshiftConfig.KubeClient(), openshiftConfig.ProjectCache)
+	kubeConfig, err := kubernetes.BuildKubernetesMasterConfig(openshiftConfig.Options, openshiftConfig.RequestContextMapper, openshiftConfig.KubeClient(), openshiftConfig.PrivilegedLoopbackOpenShiftClient, openshiftConfig.ProjectCache)
 	return kubeConfig, err
 }
 
@@ -437,6 +437,7 @@ func StartAPI(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) error {
 	oc.RunGroupCache()
 	oc.RunPolicyCache()
 	oc.RunProjectCache()
+	oc.RunSCCCache()
 
 	unprotectedInstallers := []origin.APIInstaller{}
 
@@ -582,7 +583,14 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	oc.RunDeploymentConfigChangeController()
 	oc.RunDeploymentImageChangeTriggerController()
 	oc.RunImageImportController()
+	oc.RunImageTriggerController()
 	oc.RunOriginNamespaceController()
+	if oc.Options.ProjectConfig.ApplyTemplateDefaultsToExistingProjects {
+		oc.RunProjectTemplateDefaultsController()
+	}
+	oc.RunClusterQuotaReconciliationController()
+	oc.RunResourceQuotaController()
+	oc.RunUnidlingController()
 	oc.RunSDNController()
 
 	glog.Infof("Started Origin Controllers")
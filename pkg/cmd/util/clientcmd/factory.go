@@ -227,6 +227,9 @@ func NewFactory(clientConfig kclientcmd.ClientConfig) *Factory {
 				client.ClusterRoleBindingsInterface(oc),
 				client.RoleBindingsNamespacer(oc),
 				kclient.SecurityContextConstraintsInterface(kc),
+				client.IdentitiesInterface(oc),
+				client.OAuthAccessTokensInterface(oc),
+				client.OAuthAuthorizeTokensInterface(oc),
 			), nil
 		case userapi.Kind("Group"):
 			return authenticationreaper.NewGroupReaper(
@@ -322,6 +325,13 @@ func NewFactory(clientConfig kclientcmd.ClientConfig) *Factory {
 		}
 		return kCanBeExposed(kind)
 	}
+	kCanBeAutoscaled := w.Factory.CanBeAutoscaled
+	w.CanBeAutoscaled = func(kind unversioned.GroupKind) error {
+		if kind == deployapi.Kind("DeploymentConfig") {
+			return nil
+		}
+		return kCanBeAutoscaled(kind)
+	}
 	kAttachablePodForObjectFunc := w.Factory.AttachablePodForObject
 	w.AttachablePodForObject = func(object runtime.Object) (*api.Pod, error) {
 		oc, kc, err := w.Clients()
@@ -421,6 +431,16 @@ func (f *Factory) Clients() (*client.Client, *kclient.Client, error) {
 	return osClient, kClient, nil
 }
 
+// DiscoveryClient returns a client that discovers the API versions and resources served by both
+// Kubernetes and OpenShift.
+func (f *Factory) DiscoveryClient() (*client.DiscoveryClient, error) {
+	cfg, err := f.clients.ClientConfigForVersion(nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewDiscoveryClient(cfg)
+}
+
 // ShortcutExpander is a RESTMapper that can be used for OpenShift resources.
 type ShortcutExpander struct {
 	meta.RESTMapper
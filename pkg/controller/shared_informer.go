@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	kcache "k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// SharedInformer lists and watches a resource once, and shares the cached objects and the changes
+// to them with several controllers.
+type SharedInformer struct {
+	store      kcache.Store
+	controller *framework.Controller
+	run        sync.Once
+
+	lock     sync.RWMutex
+	handlers []framework.ResourceEventHandler
+}
+
+// NewSharedInformer returns a SharedInformer of the objects of lw, which are relisted every resync.
+func NewSharedInformer(lw kcache.ListerWatcher, objType runtime.Object, resync time.Duration) *SharedInformer {
+	i := &SharedInformer{}
+	i.store, i.controller = framework.NewInformer(lw, objType, resync, framework.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			for _, handler := range i.eventHandlers() {
+				handler.OnAdd(obj)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			for _, handler := range i.eventHandlers() {
+				handler.OnUpdate(oldObj, newObj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			for _, handler := range i.eventHandlers() {
+				handler.OnDelete(obj)
+			}
+		},
+	})
+	return i
+}
+
+// AddEventHandler notifies handler of the changes to the objects. A handler added after the
+// informer was started is not notified of the objects that were already listed.
+func (i *SharedInformer) AddEventHandler(handler framework.ResourceEventHandler) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.handlers = append(i.handlers, handler)
+}
+
+func (i *SharedInformer) eventHandlers() []framework.ResourceEventHandler {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	return i.handlers
+}
+
+// Store returns the cached objects. The objects must not be changed.
+func (i *SharedInformer) Store() kcache.Store {
+	return i.store
+}
+
+// HasSynced returns true once the objects were listed for the first time.
+func (i *SharedInformer) HasSynced() bool {
+	return i.controller.HasSynced()
+}
+
+// RunUntil starts listing and watching the objects until stopCh is closed. Only the first call
+// starts the informer, so every controller sharing it may call RunUntil.
+func (i *SharedInformer) RunUntil(stopCh <-chan struct{}) {
+	i.run.Do(func() {
+		go i.controller.Run(stopCh)
+	})
+}
@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kcache "k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/workqueue"
+)
+
+const (
+	// workQueueInitialBackoff is the delay before a key is retried for the first time
+	workQueueInitialBackoff = time.Second
+	// workQueueMaxBackoff is the longest delay before a key is retried
+	workQueueMaxBackoff = 2 * time.Minute
+)
+
+// WorkQueueController is a RunnableController that handles the objects of a store whose keys were
+// added to a work queue. A key is queued once however often its object changes before it is
+// handled, it is handled by one worker at a time, and always with the latest state of its object.
+// When handling fails, the key is retried after a delay that doubles with every failure until
+// the RetryFunc gives up.
+type WorkQueueController struct {
+	// Handle is called with a copy of an object of the store whose key was queued. Optional.
+	Handle func(obj interface{}) error
+	// HandleDeleted is called with the last known state of an object that was deleted from the
+	// store. Optional.
+	HandleDeleted func(obj interface{}) error
+
+	store     kcache.Store
+	queue     *workqueue.Type
+	retryFunc RetryFunc
+	workers   int
+	backoff   *kutil.Backoff
+
+	lock    sync.Mutex
+	retries map[string]Retry
+	// deleted holds the objects deleted from the store until they are handled
+	deleted map[string]interface{}
+}
+
+// NewWorkQueueController returns a WorkQueueController of the objects of store, which are handled
+// by the given number of workers.
+func NewWorkQueueController(store kcache.Store, retryFn RetryFunc, workers int) *WorkQueueController {
+	return &WorkQueueController{
+		store:     store,
+		queue:     workqueue.New(),
+		retryFunc: retryFn,
+		workers:   workers,
+		backoff:   kutil.NewBackOff(workQueueInitialBackoff, workQueueMaxBackoff),
+		retries:   map[string]Retry{},
+		deleted:   map[string]interface{}{},
+	}
+}
+
+// EventHandler returns the handler that queues the keys of the objects that changed in the store.
+func (c *WorkQueueController) EventHandler() framework.ResourceEventHandler {
+	return framework.ResourceEventHandlerFuncs{
+		AddFunc: c.Enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.Enqueue(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			c.EnqueueDeleted(obj)
+		},
+	}
+}
+
+// Enqueue queues the key of an object of the store.
+func (c *WorkQueueController) Enqueue(obj interface{}) {
+	if c.Handle == nil {
+		return
+	}
+	key, err := kcache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		kutil.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// EnqueueDeleted queues the key of an object that is no longer in the store.
+func (c *WorkQueueController) EnqueueDeleted(obj interface{}) {
+	if c.HandleDeleted == nil || obj == nil {
+		return
+	}
+	key, err := kcache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		kutil.HandleError(err)
+		return
+	}
+	c.lock.Lock()
+	c.deleted[key] = obj
+	c.lock.Unlock()
+	c.queue.Add(key)
+}
+
+// Run starts the workers.
+func (c *WorkQueueController) Run() {
+	for i := 0; i < c.workers; i++ {
+		go kutil.Forever(c.work, 0)
+	}
+}
+
+// RunUntil starts the workers, which stop once stopCh is closed.
+func (c *WorkQueueController) RunUntil(stopCh <-chan struct{}) {
+	for i := 0; i < c.workers; i++ {
+		go kutil.Until(c.work, 0, stopCh)
+	}
+	go func() {
+		<-stopCh
+		c.queue.ShutDown()
+	}()
+}
+
+// work handles queued keys until the queue is shut down.
+func (c *WorkQueueController) work() {
+	for {
+		key, quit := c.queue.Get()
+		if quit {
+			return
+		}
+		c.handleKey(key.(string))
+		c.queue.Done(key)
+	}
+}
+
+// handleKey handles the latest state of the object of key, and schedules a retry if it fails.
+func (c *WorkQueueController) handleKey(key string) {
+	obj, exists, err := c.store.GetByKey(key)
+	if err != nil {
+		kutil.HandleError(err)
+		return
+	}
+
+	handle, deleted := c.Handle, false
+	if !exists {
+		c.lock.Lock()
+		obj, deleted = c.deleted[key]
+		delete(c.deleted, key)
+		c.lock.Unlock()
+		if !deleted {
+			c.forget(key)
+			return
+		}
+		handle = c.HandleDeleted
+	}
+	if handle == nil {
+		c.forget(key)
+		return
+	}
+
+	// the objects of the store are shared, handlers get a copy they may change
+	if object, ok := obj.(runtime.Object); ok {
+		copied, err := kapi.Scheme.Copy(object)
+		if err != nil {
+			kutil.HandleError(err)
+			return
+		}
+		obj = copied
+	}
+
+	if err := handle(obj); err != nil {
+		c.retry(key, obj, deleted, err)
+		return
+	}
+	c.forget(key)
+}
+
+// retry queues key again after its backoff if the retry func allows it.
+func (c *WorkQueueController) retry(key string, obj interface{}, deleted bool, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	retries, ok := c.retries[key]
+	if !ok {
+		retries = Retry{StartTimestamp: unversioned.Now()}
+	}
+	if !c.retryFunc(obj, err, retries) {
+		delete(c.retries, key)
+		c.backoff.Reset(key)
+		return
+	}
+	retries.Count++
+	c.retries[key] = retries
+	if deleted {
+		if _, ok := c.deleted[key]; !ok {
+			c.deleted[key] = obj
+		}
+	}
+
+	c.backoff.Next(key, time.Now())
+	delay := c.backoff.Get(key)
+	glog.V(5).Infof("Retrying %s in %v", key, delay)
+	time.AfterFunc(delay, func() { c.queue.Add(key) })
+}
+
+// forget clears the retries of key.
+func (c *WorkQueueController) forget(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.retries, key)
+	c.backoff.Reset(key)
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kcache "k8s.io/kubernetes/pkg/client/cache"
+	kutil "k8s.io/kubernetes/pkg/util"
+)
+
+func testPod(name, resourceVersion string) *kapi.Pod {
+	return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name, ResourceVersion: resourceVersion}}
+}
+
+func TestWorkQueueControllerHandlesLatestState(t *testing.T) {
+	store := kcache.NewStore(kcache.MetaNamespaceKeyFunc)
+	c := NewWorkQueueController(store, RetryNever, 1)
+	handled := []string{}
+	c.Handle = func(obj interface{}) error {
+		pod := obj.(*kapi.Pod)
+		handled = append(handled, pod.ResourceVersion)
+		pod.ResourceVersion = "changed"
+		return nil
+	}
+
+	for _, version := range []string{"1", "2", "3"} {
+		pod := testPod("pod", version)
+		store.Add(pod)
+		c.Enqueue(pod)
+	}
+	if c.queue.Len() != 1 {
+		t.Fatalf("expected the key to be queued once, got %d", c.queue.Len())
+	}
+	key, _ := c.queue.Get()
+	c.handleKey(key.(string))
+	c.queue.Done(key)
+
+	if len(handled) != 1 || handled[0] != "3" {
+		t.Errorf("expected the latest state to be handled once, got %v", handled)
+	}
+	if obj, _, _ := store.GetByKey("test/pod"); obj.(*kapi.Pod).ResourceVersion != "3" {
+		t.Errorf("expected the object in the store not to be changed by the handler")
+	}
+}
+
+func TestWorkQueueControllerHandlesDeleted(t *testing.T) {
+	store := kcache.NewStore(kcache.MetaNamespaceKeyFunc)
+	c := NewWorkQueueController(store, RetryNever, 1)
+	deleted := []string{}
+	c.HandleDeleted = func(obj interface{}) error {
+		deleted = append(deleted, obj.(*kapi.Pod).Name)
+		return nil
+	}
+	handler := c.EventHandler()
+
+	handler.OnAdd(testPod("kept", "1"))
+	handler.OnDelete(testPod("removed", "1"))
+	handler.OnDelete(kcache.DeletedFinalStateUnknown{Key: "test/unknown", Obj: testPod("unknown", "1")})
+	// an object that was deleted and created again is not handled as deleted
+	store.Add(testPod("recreated", "2"))
+	handler.OnDelete(testPod("recreated", "1"))
+
+	for c.queue.Len() > 0 {
+		key, _ := c.queue.Get()
+		c.handleKey(key.(string))
+		c.queue.Done(key)
+	}
+	if len(deleted) != 2 || deleted[0] != "removed" || deleted[1] != "unknown" {
+		t.Errorf("unexpected deleted objects handled: %v", deleted)
+	}
+}
+
+func TestWorkQueueControllerRetries(t *testing.T) {
+	store := kcache.NewStore(kcache.MetaNamespaceKeyFunc)
+	retries := []int{}
+	c := NewWorkQueueController(store, func(obj interface{}, err error, retry Retry) bool {
+		retries = append(retries, retry.Count)
+		return retry.Count < 2
+	}, 1)
+	c.backoff = kutil.NewBackOff(time.Millisecond, 10*time.Millisecond)
+	attempts := make(chan struct{}, 10)
+	c.Handle = func(obj interface{}) error {
+		attempts <- struct{}{}
+		return fmt.Errorf("failed")
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	c.RunUntil(stop)
+
+	pod := testPod("pod", "1")
+	store.Add(pod)
+	c.Enqueue(pod)
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-attempts:
+		case <-time.After(time.Second):
+			t.Fatalf("expected attempt %d", i+1)
+		}
+	}
+	select {
+	case <-attempts:
+		t.Fatalf("unexpected attempt after the retry func gave up")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(retries) != 3 || retries[0] != 0 || retries[2] != 2 {
+		t.Errorf("unexpected retries: %v", retries)
+	}
+	if len(c.retries) != 0 {
+		t.Errorf("expected the retries to be forgotten, got %v", c.retries)
+	}
+}
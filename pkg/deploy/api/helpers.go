@@ -9,13 +9,19 @@ import (
 // Currently DeploymentLogOptions.Container and DeploymentLogOptions.Previous aren't used
 // so they won't be copied to PodLogOptions.
 func DeploymentToPodLogOptions(opts *DeploymentLogOptions) *kapi.PodLogOptions {
+	limitBytes := opts.LimitBytes
+	// the skipped bytes are read from the pod log too
+	if opts.LimitBytes != nil && opts.OffsetBytes != nil {
+		limit := *opts.LimitBytes + *opts.OffsetBytes
+		limitBytes = &limit
+	}
 	return &kapi.PodLogOptions{
 		Follow:       opts.Follow,
 		SinceSeconds: opts.SinceSeconds,
 		SinceTime:    opts.SinceTime,
 		Timestamps:   opts.Timestamps,
 		TailLines:    opts.TailLines,
-		LimitBytes:   opts.LimitBytes,
+		LimitBytes:   limitBytes,
 	}
 }
 
@@ -67,9 +67,15 @@ type CustomDeploymentStrategyParams struct {
 // RecreateDeploymentStrategyParams are the input to the Recreate deployment
 // strategy.
 type RecreateDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
+	// Mid is a lifecycle hook which is executed while the deployment is scaled down in between the
+	// old and new replication controllers. All LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -126,7 +132,7 @@ type RollingDeploymentStrategyParams struct {
 	TimeoutSeconds *int64
 	// The maximum number of pods that can be unavailable during the update.
 	// Value can be an absolute number (ex: 5) or a percentage of total pods at the start of update (ex: 10%).
-	// Absolute number is calculated from percentage by rounding up.
+	// Absolute number is calculated from percentage by rounding down.
 	// This can not be 0 if MaxSurge is 0.
 	// By default, a fixed value of 1 is used.
 	// Example: when this is set to 30%, the old RC can be scaled down by 30%
@@ -158,9 +164,33 @@ type RollingDeploymentStrategyParams struct {
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
 	Post *LifecycleHook
+	// Canary is a check which is run each time the new deployment is about to
+	// be scaled up beyond the pods it already runs, and once more when all of
+	// its pods are running. If the check fails, the rollout is aborted and the
+	// previous deployment is scaled back up.
+	Canary *CanaryCheck
+}
+
+// CanaryCheck verifies the pods of a new deployment during a rolling
+// deployment. Exactly one of ExecNewPod and HTTPGet must be set.
+type CanaryCheck struct {
+	// ExecNewPod runs a command in a new pod based on a container of the
+	// deployment template. The check fails if the command fails.
+	ExecNewPod *ExecNewPodHook
+	// HTTPGet requests a URL. The check fails if the response status is not
+	// between 200 and 399.
+	HTTPGet *CanaryHTTPGetAction
+}
+
+// CanaryHTTPGetAction requests a URL to check a deployment.
+type CanaryHTTPGetAction struct {
+	// URL is the absolute http or https URL to request.
+	URL string
 }
 
 const (
+	// DefaultRecreateTimeoutSeconds is the default TimeoutSeconds for RecreateDeploymentStrategyParams.
+	DefaultRecreateTimeoutSeconds int64 = 10 * 60
 	// DefaultRollingTimeoutSeconds is the default TimeoutSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingTimeoutSeconds int64 = 10 * 60
 	// DefaultRollingIntervalSeconds is the default IntervalSeconds for RollingDeploymentStrategyParams.
@@ -409,6 +439,11 @@ type DeploymentLogOptions struct {
 	// log output. This may not display a complete final line of logging, and may return
 	// slightly more or slightly less than the specified limit.
 	LimitBytes *int64
+	// If set, the number of bytes at the beginning of the log output to skip. A client that was
+	// disconnected may resume the deployment log by passing the number of bytes it already received
+	// together with the options of the interrupted request. LimitBytes counts the bytes after the
+	// offset. May not be combined with sinceSeconds or tailLines.
+	OffsetBytes *int64
 
 	// NoWait if true causes the call to return immediately even if the deployment
 	// is not available yet. Otherwise the server will wait until the deployment has started.
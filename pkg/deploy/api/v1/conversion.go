@@ -64,6 +64,11 @@ func convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategy
 			return err
 		}
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	}
 
 	if in.UpdatePercent != nil {
 		pct := intstr.FromString(fmt.Sprintf("%d%%", int(math.Abs(float64(*in.UpdatePercent)))))
@@ -99,6 +104,11 @@ func convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategy
 			return err
 		}
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	}
 
 	if out.MaxUnavailable == nil {
 		out.MaxUnavailable = &intstr.IntOrString{}
@@ -63,6 +63,11 @@ func convert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStr
 			return err
 		}
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	}
 
 	if in.UpdatePercent != nil {
 		pct := intstr.FromString(fmt.Sprintf("%d%%", int(math.Abs(float64(*in.UpdatePercent)))))
@@ -98,6 +103,11 @@ func convert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStr
 			return err
 		}
 	}
+	if in.Canary != nil {
+		if err := s.Convert(&in.Canary, &out.Canary, 0); err != nil {
+			return err
+		}
+	}
 
 	if out.MaxUnavailable == nil {
 		out.MaxUnavailable = &intstr.IntOrString{}
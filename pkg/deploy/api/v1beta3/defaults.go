@@ -35,6 +35,16 @@ func init() {
 					TimeoutSeconds:      mkintp(deployapi.DefaultRollingTimeoutSeconds),
 				}
 			}
+			if obj.Type == DeploymentStrategyTypeRecreate && obj.RecreateParams == nil {
+				obj.RecreateParams = &RecreateDeploymentStrategyParams{
+					TimeoutSeconds: mkintp(deployapi.DefaultRecreateTimeoutSeconds),
+				}
+			}
+		},
+		func(obj *RecreateDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRecreateTimeoutSeconds)
+			}
 		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
@@ -67,9 +67,15 @@ type CustomDeploymentStrategyParams struct {
 // RecreateDeploymentStrategyParams are the input to the Recreate deployment
 // strategy.
 type RecreateDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for updates before giving up. If the
+	// value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for updates before giving up"`
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Mid is a lifecycle hook which is executed while the deployment is scaled down in between the
+	// old and new replication controllers. All LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook `json:"mid,omitempty" description:"a hook executed after the old deployment is scaled down and before the new one is scaled up"`
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -128,7 +134,7 @@ type RollingDeploymentStrategyParams struct {
 	// MaxUnavailable is the maximum number of pods that can be unavailable
 	// during the update. Value can be an absolute number (ex: 5) or a
 	// percentage of total pods at the start of update (ex: 10%). Absolute
-	// number is calculated from percentage by rounding up.
+	// number is calculated from percentage by rounding down.
 	//
 	// This cannot be 0 if MaxSurge is 0. By default, 25% is used.
 	//
@@ -163,6 +169,28 @@ type RollingDeploymentStrategyParams struct {
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
 	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+	// Canary is a check which is run each time the new deployment is about to
+	// be scaled up beyond the pods it already runs, and once more when all of
+	// its pods are running. If the check fails, the rollout is aborted and the
+	// previous deployment is scaled back up.
+	Canary *CanaryCheck `json:"canary,omitempty" description:"a check run between the scaling steps of the rollout; a failure aborts the rollout and scales the previous deployment back up"`
+}
+
+// CanaryCheck verifies the pods of a new deployment during a rolling
+// deployment. Exactly one of ExecNewPod and HTTPGet must be set.
+type CanaryCheck struct {
+	// ExecNewPod runs a command in a new pod based on a container of the
+	// deployment template. The check fails if the command fails.
+	ExecNewPod *ExecNewPodHook `json:"execNewPod,omitempty" description:"options for a check that runs a command in a new pod based on a container of the deployment template"`
+	// HTTPGet requests a URL. The check fails if the response status is not
+	// between 200 and 399.
+	HTTPGet *CanaryHTTPGetAction `json:"httpGet,omitempty" description:"options for a check that requests a URL"`
+}
+
+// CanaryHTTPGetAction requests a URL to check a deployment.
+type CanaryHTTPGetAction struct {
+	// URL is the absolute http or https URL to request.
+	URL string `json:"url" description:"the absolute http or https URL to request; a response status outside of 200-399 fails the check"`
 }
 
 // These constants represent keys used for correlating objects related to deployments.
@@ -392,6 +420,11 @@ type DeploymentLogOptions struct {
 	// log output. This may not display a complete final line of logging, and may return
 	// slightly more or slightly less than the specified limit.
 	LimitBytes *int64 `json:"limitBytes,omitempty" description:"the number of bytes to read from the server before terminating the log output"`
+	// If set, the number of bytes at the beginning of the log output to skip. A client that was
+	// disconnected may resume the deployment log by passing the number of bytes it already received
+	// together with the options of the interrupted request. LimitBytes counts the bytes after the
+	// offset. May not be combined with sinceSeconds or tailLines.
+	OffsetBytes *int64 `json:"offsetBytes,omitempty" description:"the number of bytes at the beginning of the log output to skip; used to resume a log stream"`
 
 	// NoWait if true causes the call to return immediately even if the deployment
 	// is not available yet. Otherwise the server will wait until the deployment has started.
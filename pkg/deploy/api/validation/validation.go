@@ -3,12 +3,15 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -18,19 +21,44 @@ import (
 )
 
 func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorList {
+	return validateDeploymentConfig(config, nil)
+}
+
+// validateDeploymentConfig validates the deployment config.  oldConfig is the deployment config being updated, or nil
+// on create.  The checks of the trigger list, the selector and the container names of the triggers were added after
+// deployment configs could be stored, so they only apply to new deployment configs and to changes of the fields they
+// check, which keeps existing deployment configs updatable.
+func validateDeploymentConfig(config, oldConfig *deployapi.DeploymentConfig) field.ErrorList {
 	allErrs := validation.ValidateObjectMeta(&config.ObjectMeta, true, validation.NameIsDNSSubdomain, field.NewPath("metadata"))
 
+	triggersChanged := oldConfig == nil || !kapi.Semantic.DeepEqual(config.Spec.Triggers, oldConfig.Spec.Triggers)
+
 	// TODO: Refactor to validate spec and status separately
 	for i := range config.Spec.Triggers {
 		allErrs = append(allErrs, validateTrigger(&config.Spec.Triggers[i], field.NewPath("spec", "triggers").Index(i))...)
 	}
+	if triggersChanged {
+		allErrs = append(allErrs, validateTriggerList(config.Namespace, config.Spec.Triggers, field.NewPath("spec", "triggers"))...)
+	}
 
 	specPath := field.NewPath("spec")
 	allErrs = append(allErrs, validateDeploymentStrategy(&config.Spec.Strategy, field.NewPath("spec", "strategy"))...)
 	if config.Spec.Template == nil {
 		allErrs = append(allErrs, field.Required(specPath.Child("template")))
 	} else {
-		allErrs = append(allErrs, validation.ValidatePodTemplateSpec(config.Spec.Template, specPath.Child("template"))...)
+		templatePath := specPath.Child("template")
+		allErrs = append(allErrs, validation.ValidatePodTemplateSpec(config.Spec.Template, templatePath)...)
+		selectorChanged := oldConfig == nil || oldConfig.Spec.Template == nil ||
+			!kapi.Semantic.DeepEqual(config.Spec.Selector, oldConfig.Spec.Selector) ||
+			!kapi.Semantic.DeepEqual(config.Spec.Template.Labels, oldConfig.Spec.Template.Labels)
+		if selector := labels.Set(config.Spec.Selector).AsSelector(); selectorChanged && !selector.Empty() && !selector.Matches(labels.Set(config.Spec.Template.Labels)) {
+			allErrs = append(allErrs, field.Invalid(templatePath.Child("metadata", "labels"), config.Spec.Template.Labels, "selector does not match the labels of the template"))
+		}
+		containersChanged := oldConfig == nil || oldConfig.Spec.Template == nil ||
+			!kapi.Semantic.DeepEqual(containerNames(config.Spec.Template), containerNames(oldConfig.Spec.Template))
+		if triggersChanged || containersChanged {
+			allErrs = append(allErrs, validateTriggerContainerNames(config.Spec.Triggers, config.Spec.Template, specPath.Child("triggers"))...)
+		}
 	}
 	if config.Status.LatestVersion < 0 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("status", "latestVersion"), config.Status.LatestVersion, "latestVersion cannot be negative"))
@@ -46,7 +74,7 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorLis
 
 func ValidateDeploymentConfigUpdate(newConfig *deployapi.DeploymentConfig, oldConfig *deployapi.DeploymentConfig) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&newConfig.ObjectMeta, &oldConfig.ObjectMeta, field.NewPath("metadata"))
-	allErrs = append(allErrs, ValidateDeploymentConfig(newConfig)...)
+	allErrs = append(allErrs, validateDeploymentConfig(newConfig, oldConfig)...)
 	statusPath := field.NewPath("status")
 	if newConfig.Status.LatestVersion < oldConfig.Status.LatestVersion {
 		allErrs = append(allErrs, field.Invalid(statusPath.Child("latestVersion"), newConfig.Status.LatestVersion, "latestVersion cannot be decremented"))
@@ -126,9 +154,16 @@ func validateCustomParams(params *deployapi.CustomDeploymentStrategyParams, fldP
 func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
 	if params.Pre != nil {
 		errs = append(errs, validateLifecycleHook(params.Pre, fldPath.Child("pre"))...)
 	}
+	if params.Mid != nil {
+		errs = append(errs, validateLifecycleHook(params.Mid, fldPath.Child("mid"))...)
+	}
 	if params.Post != nil {
 		errs = append(errs, validateLifecycleHook(params.Post, fldPath.Child("post"))...)
 	}
@@ -225,10 +260,13 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, fl
 	// Most of this is lifted from the upstream experimental deployments API. We
 	// can't reuse it directly yet, but no use reinventing the logic, so copy-
 	// pasted and adapted here.
-	errs = append(errs, ValidatePositiveIntOrPercent(params.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
-	errs = append(errs, ValidatePositiveIntOrPercent(params.MaxSurge, fldPath.Child("maxSurge"))...)
-	if getIntOrPercentValue(params.MaxUnavailable) == 0 && getIntOrPercentValue(params.MaxSurge) == 0 {
-		// Both MaxSurge and MaxUnavailable cannot be zero.
+	unavailableErrs := ValidatePositiveIntOrPercent(params.MaxUnavailable, fldPath.Child("maxUnavailable"))
+	surgeErrs := ValidatePositiveIntOrPercent(params.MaxSurge, fldPath.Child("maxSurge"))
+	errs = append(errs, unavailableErrs...)
+	errs = append(errs, surgeErrs...)
+	// Both MaxSurge and MaxUnavailable cannot be zero, whether they are given
+	// as absolute numbers or as percentages.
+	if len(unavailableErrs) == 0 && len(surgeErrs) == 0 && getIntOrPercentValue(params.MaxUnavailable) == 0 && getIntOrPercentValue(params.MaxSurge) == 0 {
 		errs = append(errs, field.Invalid(fldPath.Child("maxUnavailable"), params.MaxUnavailable, "cannot be 0 when maxSurge is 0 as well"))
 	}
 	// Validate that MaxUnavailable is not more than 100%.
@@ -240,6 +278,36 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, fl
 	if params.Post != nil {
 		errs = append(errs, validateLifecycleHook(params.Post, fldPath.Child("post"))...)
 	}
+	if params.Canary != nil {
+		errs = append(errs, validateCanaryCheck(params.Canary, fldPath.Child("canary"))...)
+	}
+
+	return errs
+}
+
+func validateCanaryCheck(check *deployapi.CanaryCheck, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	switch {
+	case check.ExecNewPod != nil && check.HTTPGet != nil:
+		errs = append(errs, field.Invalid(fldPath.Child("httpGet"), check.HTTPGet.URL, "may not be specified together with execNewPod"))
+	case check.ExecNewPod != nil:
+		errs = append(errs, validateExecNewPod(check.ExecNewPod, fldPath.Child("execNewPod"))...)
+	case check.HTTPGet != nil:
+		urlPath := fldPath.Child("httpGet", "url")
+		if len(check.HTTPGet.URL) == 0 {
+			errs = append(errs, field.Required(urlPath))
+			break
+		}
+		u, err := url.Parse(check.HTTPGet.URL)
+		if err != nil {
+			errs = append(errs, field.Invalid(urlPath, check.HTTPGet.URL, err.Error()))
+		} else if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			errs = append(errs, field.Invalid(urlPath, check.HTTPGet.URL, "must be an absolute http or https URL"))
+		}
+	default:
+		errs = append(errs, field.Required(fldPath.Child("execNewPod")))
+	}
 
 	return errs
 }
@@ -262,6 +330,77 @@ func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.
 	return errs
 }
 
+// validateTriggerList checks that the triggers of a deployment config have a supported type, that there is
+// at most one config change trigger, and that no two image change triggers update the same container from
+// the same image stream tag.
+func validateTriggerList(namespace string, triggers []deployapi.DeploymentTriggerPolicy, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	configChange := false
+	fromContainers := map[string]sets.String{}
+	for i, trigger := range triggers {
+		switch trigger.Type {
+		case "", deployapi.DeploymentTriggerManual:
+		case deployapi.DeploymentTriggerOnConfigChange:
+			if configChange {
+				errs = append(errs, field.Duplicate(fldPath.Index(i).Child("type"), trigger.Type))
+			}
+			configChange = true
+		case deployapi.DeploymentTriggerOnImageChange:
+			if trigger.ImageChangeParams == nil || len(trigger.ImageChangeParams.From.Name) == 0 {
+				continue
+			}
+			ref := trigger.ImageChangeParams.From
+			if len(ref.Namespace) == 0 {
+				ref.Namespace = namespace
+			}
+			key := ref.Namespace + "/" + ref.Name
+			containers, ok := fromContainers[key]
+			if !ok {
+				containers = sets.NewString()
+				fromContainers[key] = containers
+			}
+			if containers.HasAny(trigger.ImageChangeParams.ContainerNames...) {
+				errs = append(errs, field.Duplicate(fldPath.Index(i).Child("imageChangeParams", "from"), trigger.ImageChangeParams.From.Name))
+			}
+			containers.Insert(trigger.ImageChangeParams.ContainerNames...)
+		default:
+			errs = append(errs, field.NotSupported(fldPath.Index(i).Child("type"), trigger.Type, []string{string(deployapi.DeploymentTriggerOnConfigChange), string(deployapi.DeploymentTriggerOnImageChange)}))
+		}
+	}
+
+	return errs
+}
+
+// containerNames returns the names of the containers of the pod template.
+func containerNames(template *kapi.PodTemplateSpec) sets.String {
+	names := sets.NewString()
+	for _, container := range template.Spec.Containers {
+		names.Insert(container.Name)
+	}
+	return names
+}
+
+// validateTriggerContainerNames checks that the containers updated by image change triggers exist
+// in the pod template.
+func validateTriggerContainerNames(triggers []deployapi.DeploymentTriggerPolicy, template *kapi.PodTemplateSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	containers := containerNames(template)
+	for i, trigger := range triggers {
+		if trigger.Type != deployapi.DeploymentTriggerOnImageChange || trigger.ImageChangeParams == nil {
+			continue
+		}
+		for j, name := range trigger.ImageChangeParams.ContainerNames {
+			if !containers.Has(name) {
+				errs = append(errs, field.Invalid(fldPath.Index(i).Child("imageChangeParams", "containerNames").Index(j), name, "does not match any container in the pod template"))
+			}
+		}
+	}
+
+	return errs
+}
+
 func validateImageChangeParams(params *deployapi.DeploymentTriggerImageChangeParams, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -364,6 +503,19 @@ func ValidateDeploymentLogOptions(opts *deployapi.DeploymentLogOptions) field.Er
 	if errs := validation.ValidatePodLogOptions(popts); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if opts.OffsetBytes != nil {
+		offsetPath := field.NewPath("offsetBytes")
+		if *opts.OffsetBytes < 0 {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, isNegativeErrorMsg))
+		}
+		// the log output these select changes between the interrupted and the resumed request
+		if opts.SinceSeconds != nil {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, "may not be combined with sinceSeconds"))
+		}
+		if opts.TailLines != nil {
+			allErrs = append(allErrs, field.Invalid(offsetPath, *opts.OffsetBytes, "may not be combined with tailLines"))
+		}
+	}
 
 	if opts.Version != nil && *opts.Version <= 0 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("version"), *opts.Version, "deployment version must be greater than 0"))
@@ -5,6 +5,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"github.com/openshift/origin/pkg/deploy/api"
@@ -41,6 +42,12 @@ func rollingConfig(interval, updatePeriod, timeout int) api.DeploymentConfig {
 	}
 }
 
+func rollingConfigCanary(check *api.CanaryCheck) api.DeploymentConfig {
+	config := rollingConfig(1, 1, 1)
+	config.Spec.Strategy.RollingParams.Canary = check
+	return config
+}
+
 func rollingConfigMax(maxSurge, maxUnavailable intstr.IntOrString) api.DeploymentConfig {
 	return api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -79,6 +86,38 @@ func TestValidateDeploymentConfigOK(t *testing.T) {
 	}
 }
 
+func TestValidateDeploymentConfigSameImageForDifferentContainers(t *testing.T) {
+	errs := ValidateDeploymentConfig(&api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Replicas: 1,
+			Triggers: []api.DeploymentTriggerPolicy{
+				{
+					Type: api.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:v1"},
+						ContainerNames: []string{"container1"},
+					},
+				},
+				{
+					Type: api.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:v1"},
+						ContainerNames: []string{"container2"},
+					},
+				},
+			},
+			Selector: test.OkSelector(),
+			Strategy: test.OkStrategy(),
+			Template: test.OkPodTemplate(),
+		},
+	})
+
+	if len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
 func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 	errorCases := map[string]struct {
 		DeploymentConfig api.DeploymentConfig
@@ -126,7 +165,7 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 					Triggers: []api.DeploymentTriggerPolicy{
 						{
 							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
-								ContainerNames: []string{"foo"},
+								ContainerNames: []string{"container1"},
 							},
 						},
 					},
@@ -147,7 +186,7 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 						{
 							Type: api.DeploymentTriggerOnImageChange,
 							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
-								ContainerNames: []string{"foo"},
+								ContainerNames: []string{"container1"},
 							},
 						},
 					},
@@ -172,7 +211,7 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 									Kind: "Invalid",
 									Name: "name:tag",
 								},
-								ContainerNames: []string{"foo"},
+								ContainerNames: []string{"container1"},
 							},
 						},
 					},
@@ -184,6 +223,63 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.triggers[0].imageChangeParams.from.kind",
 		},
+		"unknown Trigger imageChangeParams.containerNames": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+								From: kapi.ObjectReference{
+									Kind: "ImageStreamTag",
+									Name: "foo:v1",
+								},
+								ContainerNames: []string{"container1", "foo"},
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.triggers[0].imageChangeParams.containerNames[1]",
+		},
+		"selector not matching template labels": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: manualTrigger(),
+					Selector: map[string]string{"a": "c"},
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.template.metadata.labels",
+		},
+		"duplicate container names": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: manualTrigger(),
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: func() *kapi.PodTemplateSpec {
+						template := test.OkPodTemplate()
+						template.Spec.Containers[1].Name = template.Spec.Containers[0].Name
+						return template
+					}(),
+				},
+			},
+			field.ErrorTypeDuplicate,
+			"spec.template.spec.containers[1].name",
+		},
 		"missing Trigger imageChangeParams.containerNames": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -208,6 +304,68 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"spec.triggers[0].imageChangeParams.containerNames",
 		},
+		"unsupported trigger.type": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{Type: "Unknown"},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeNotSupported,
+			"spec.triggers[0].type",
+		},
+		"duplicate config change trigger": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{Type: api.DeploymentTriggerOnConfigChange},
+						{Type: api.DeploymentTriggerOnConfigChange},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeDuplicate,
+			"spec.triggers[1].type",
+		},
+		"duplicate image change trigger": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+								From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:v1"},
+								ContainerNames: []string{"container1"},
+							},
+						},
+						{
+							Type: api.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+								From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:v1", Namespace: "bar"},
+								ContainerNames: []string{"container2", "container1"},
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeDuplicate,
+			"spec.triggers[1].imageChangeParams.from",
+		},
 		"missing strategy.type": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -280,6 +438,47 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"spec.strategy.recreateParams.pre.failurePolicy",
 		},
+		"missing spec.strategy.recreateParams.mid.failurePolicy": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Mid: &api.LifecycleHook{
+								ExecNewPod: &api.ExecNewPodHook{
+									Command:       []string{"cmd"},
+									ContainerName: "container",
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.recreateParams.mid.failurePolicy",
+		},
+		"invalid spec.strategy.recreateParams.timeoutSeconds": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							TimeoutSeconds: mkint64p(0),
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.timeoutSeconds",
+		},
 		"missing spec.strategy.recreateParams.pre.execNewPod": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -433,6 +632,17 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxUnavailable",
 		},
+		"both maxSurge and maxUnavailable 0 mixed spec.strategy.rollingParams.maxUnavailable": {
+			rollingConfigMax(intstr.FromString("0%"), intstr.FromInt(0)),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.maxUnavailable",
+		},
+		"valid maxSurge percent with maxUnavailable 0": {
+			DeploymentConfig: rollingConfigMax(intstr.FromString("150%"), intstr.FromInt(0)),
+		},
+		"valid maxSurge 0 with maxUnavailable percent": {
+			DeploymentConfig: rollingConfigMax(intstr.FromInt(0), intstr.FromString("100%")),
+		},
 		"invalid lower bound percent spec.strategy.rollingParams.maxUnavailable": {
 			rollingConfigMax(intstr.FromInt(0), intstr.FromString("-1%")),
 			field.ErrorTypeInvalid,
@@ -453,6 +663,42 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxSurge",
 		},
+		"valid spec.strategy.rollingParams.canary.httpGet": {
+			DeploymentConfig: rollingConfigCanary(&api.CanaryCheck{HTTPGet: &api.CanaryHTTPGetAction{URL: "https://metrics.example.com/check"}}),
+		},
+		"missing spec.strategy.rollingParams.canary.execNewPod": {
+			rollingConfigCanary(&api.CanaryCheck{}),
+			field.ErrorTypeRequired,
+			"spec.strategy.rollingParams.canary.execNewPod",
+		},
+		"both spec.strategy.rollingParams.canary.httpGet and execNewPod": {
+			rollingConfigCanary(&api.CanaryCheck{
+				ExecNewPod: &api.ExecNewPodHook{Command: []string{"/check"}, ContainerName: "container"},
+				HTTPGet:    &api.CanaryHTTPGetAction{URL: "https://metrics.example.com/check"},
+			}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.canary.httpGet",
+		},
+		"missing spec.strategy.rollingParams.canary.execNewPod.command": {
+			rollingConfigCanary(&api.CanaryCheck{ExecNewPod: &api.ExecNewPodHook{ContainerName: "container"}}),
+			field.ErrorTypeRequired,
+			"spec.strategy.rollingParams.canary.execNewPod.command",
+		},
+		"missing spec.strategy.rollingParams.canary.httpGet.url": {
+			rollingConfigCanary(&api.CanaryCheck{HTTPGet: &api.CanaryHTTPGetAction{}}),
+			field.ErrorTypeRequired,
+			"spec.strategy.rollingParams.canary.httpGet.url",
+		},
+		"relative spec.strategy.rollingParams.canary.httpGet.url": {
+			rollingConfigCanary(&api.CanaryCheck{HTTPGet: &api.CanaryHTTPGetAction{URL: "/check"}}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.canary.httpGet.url",
+		},
+		"unsupported scheme spec.strategy.rollingParams.canary.httpGet.url": {
+			rollingConfigCanary(&api.CanaryCheck{HTTPGet: &api.CanaryHTTPGetAction{URL: "ftp://example.com/check"}}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.canary.httpGet.url",
+		},
 	}
 
 	for testName, v := range errorCases {
@@ -542,6 +788,48 @@ func TestValidateDeploymentConfigUpdate(t *testing.T) {
 	}
 }
 
+// TestValidateDeploymentConfigUpdateExisting checks that deployment configs stored before the trigger, selector and
+// container name checks were added can still be updated, unless the update changes the fields those checks cover.
+func TestValidateDeploymentConfigUpdateExisting(t *testing.T) {
+	oldConfig := &api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar", ResourceVersion: "1"},
+		Spec: api.DeploymentConfigSpec{
+			Replicas: 1,
+			Triggers: []api.DeploymentTriggerPolicy{
+				{Type: api.DeploymentTriggerOnConfigChange},
+				{Type: api.DeploymentTriggerOnConfigChange},
+				{
+					Type: api.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &api.DeploymentTriggerImageChangeParams{
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:v1"},
+						ContainerNames: []string{"missing"},
+					},
+				},
+			},
+			Selector: map[string]string{"name": "other"},
+			Strategy: test.OkStrategy(),
+			Template: test.OkPodTemplate(),
+		},
+	}
+
+	newConfig := *oldConfig
+	newConfig.Spec.Replicas = 2
+	if errs := ValidateDeploymentConfigUpdate(&newConfig, oldConfig); len(errs) > 0 {
+		t.Errorf("Unexpected update failure: %v", errs)
+	}
+
+	newConfig.Spec.Triggers = newConfig.Spec.Triggers[1:]
+	newConfig.Spec.Selector = map[string]string{"name": "another"}
+	errs := ValidateDeploymentConfigUpdate(&newConfig, oldConfig)
+	fields := sets.NewString()
+	for _, err := range errs {
+		fields.Insert(err.Field)
+	}
+	if expected := sets.NewString("spec.template.metadata.labels", "spec.triggers[1].imageChangeParams.containerNames[0]"); !fields.Equal(expected) {
+		t.Errorf("expected errors for %v, got %v", expected.List(), errs)
+	}
+}
+
 func TestValidateDeploymentConfigRollbackOK(t *testing.T) {
 	rollback := &api.DeploymentConfigRollback{
 		Spec: api.DeploymentConfigRollbackSpec{
@@ -619,7 +907,7 @@ func TestValidateDeploymentConfigDefaultImageStreamKind(t *testing.T) {
 							Kind: "ImageStreamTag",
 							Name: "name:v1",
 						},
-						ContainerNames: []string{"foo"},
+						ContainerNames: []string{"container1"},
 					},
 				},
 			},
@@ -2,12 +2,16 @@ package analysis
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/gonum/graph"
 
 	osgraph "github.com/openshift/origin/pkg/api/graph"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployedges "github.com/openshift/origin/pkg/deploy/graph"
 	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	imageedges "github.com/openshift/origin/pkg/image/graph"
 	imagegraph "github.com/openshift/origin/pkg/image/graph/nodes"
 )
@@ -15,6 +19,11 @@ import (
 const (
 	MissingImageStreamErr        = "MissingImageStream"
 	MissingImageStreamTagWarning = "MissingImageStreamTag"
+
+	LatestDeploymentFailedErr     = "LatestDeploymentFailed"
+	NoTriggersWarning             = "DeploymentConfigWithoutTriggers"
+	ManualImageTriggerWarning     = "ManualImageChangeTrigger"
+	UnresolvedImageTriggerWarning = "UnresolvedImageChangeTrigger"
 )
 
 // FindDeploymentConfigTriggerErrors checks for possible failures in deployment config
@@ -65,6 +74,128 @@ dc:
 	return markers
 }
 
+// FindStalledDeploymentConfigs explains why a deployment config has not rolled out.
+//
+// Checks, in order:
+// 1. The latest deployment has failed.
+// 2. The deployment config has never been deployed and has no triggers.
+// 3. The deployment config has never been deployed and only a manual image trigger could deploy it.
+// 4. The deployment config has never been deployed and an image trigger for an existing tag has not fired.
+//
+// Image triggers for missing image streams or tags are reported by FindDeploymentConfigTriggerErrors.
+func FindStalledDeploymentConfigs(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastDcNode := range g.NodesByKind(deploygraph.DeploymentConfigNodeKind) {
+		dcNode := uncastDcNode.(*deploygraph.DeploymentConfigNode)
+		config := dcNode.DeploymentConfig
+
+		if latestDeployment, _ := deployedges.RelevantDeployments(g, dcNode); latestDeployment != nil {
+			// The latest deployment has failed. Cancelled deployments are marked as failed too but were
+			// stopped on purpose.
+			rc := latestDeployment.ReplicationController
+			if deployutil.DeploymentStatusFor(rc) == deployapi.DeploymentStatusFailed && !deployutil.IsDeploymentCancelled(rc) {
+				message := fmt.Sprintf("The latest deployment of %s (#%d) has failed.", f.ResourceName(dcNode), config.Status.LatestVersion)
+				if reason := deployutil.DeploymentStatusReasonFor(rc); len(reason) > 0 {
+					message = fmt.Sprintf("The latest deployment of %s (#%d) has failed: %s.", f.ResourceName(dcNode), config.Status.LatestVersion, strings.TrimSuffix(reason, "."))
+				}
+				markers = append(markers, osgraph.Marker{
+					Node:         uncastDcNode,
+					RelatedNodes: []graph.Node{latestDeployment},
+
+					Severity:   osgraph.ErrorSeverity,
+					Key:        LatestDeploymentFailedErr,
+					Message:    message,
+					Suggestion: osgraph.Suggestion(fmt.Sprintf("Inspect the deployer logs with 'oc logs %s' and retry with 'oc deploy %s --retry'", f.ResourceName(dcNode), config.Name)),
+				})
+			}
+			continue
+		}
+
+		if config.Status.LatestVersion != 0 {
+			continue
+		}
+
+		// The deployment config has never been deployed and nothing will deploy it.
+		if len(config.Spec.Triggers) == 0 {
+			markers = append(markers, osgraph.Marker{
+				Node: uncastDcNode,
+
+				Severity:   osgraph.WarningSeverity,
+				Key:        NoTriggersWarning,
+				Message:    fmt.Sprintf("%s has not been deployed and has no triggers to start a deployment.", f.ResourceName(dcNode)),
+				Suggestion: osgraph.Suggestion(fmt.Sprintf("oc deploy %s --latest", config.Name)),
+			})
+			continue
+		}
+
+		for _, uncastIstNode := range g.PredecessorNodesByEdgeKind(uncastDcNode, deployedges.TriggersDeploymentEdgeKind) {
+			istNode := uncastIstNode.(*imagegraph.ImageStreamTagNode)
+			params := imageTriggerFor(config, istNode)
+			if !istNode.Found() || params == nil || len(params.LastTriggeredImage) > 0 {
+				continue
+			}
+
+			// The tag exists but the trigger has to be started by hand. A config change trigger would
+			// resolve the tag for the initial deployment.
+			if !params.Automatic && !hasConfigChangeTrigger(config) {
+				markers = append(markers, osgraph.Marker{
+					Node:         uncastDcNode,
+					RelatedNodes: []graph.Node{uncastIstNode},
+
+					Severity:   osgraph.WarningSeverity,
+					Key:        ManualImageTriggerWarning,
+					Message:    fmt.Sprintf("%s has not been deployed because the image trigger for %s is not automatic.", f.ResourceName(dcNode), f.ResourceName(istNode)),
+					Suggestion: osgraph.Suggestion(fmt.Sprintf("oc deploy %s --latest", config.Name)),
+				})
+				break
+			}
+
+			// The tag exists but the trigger has not resolved it to an image yet.
+			markers = append(markers, osgraph.Marker{
+				Node:         uncastDcNode,
+				RelatedNodes: []graph.Node{uncastIstNode},
+
+				Severity:   osgraph.WarningSeverity,
+				Key:        UnresolvedImageTriggerWarning,
+				Message:    fmt.Sprintf("%s has not been deployed because the image trigger for %s has not resolved an image yet.", f.ResourceName(dcNode), f.ResourceName(istNode)),
+				Suggestion: osgraph.Suggestion(fmt.Sprintf("Check that the tag points to an image with 'oc describe %s'", f.ResourceName(istNode))),
+			})
+			break
+		}
+	}
+
+	return markers
+}
+
+func hasConfigChangeTrigger(config *deployapi.DeploymentConfig) bool {
+	for _, trigger := range config.Spec.Triggers {
+		if trigger.Type == deployapi.DeploymentTriggerOnConfigChange {
+			return true
+		}
+	}
+	return false
+}
+
+// imageTriggerFor returns the image change trigger of the config for the image stream tag, if any.
+func imageTriggerFor(config *deployapi.DeploymentConfig, istNode *imagegraph.ImageStreamTagNode) *deployapi.DeploymentTriggerImageChangeParams {
+	for _, trigger := range config.Spec.Triggers {
+		params := trigger.ImageChangeParams
+		if params == nil || params.From.Kind != "ImageStreamTag" {
+			continue
+		}
+		namespace := params.From.Namespace
+		if len(namespace) == 0 {
+			namespace = config.Namespace
+		}
+		name, tag, _ := imageapi.SplitImageStreamTag(params.From.Name)
+		if namespace == istNode.Namespace && imageapi.JoinImageStreamTag(name, tag) == istNode.ImageStreamTag.Name {
+			return params
+		}
+	}
+	return nil
+}
+
 func doesImageStreamExist(g osgraph.Graph, istag graph.Node) (graph.Node, bool) {
 	for _, imagestream := range g.SuccessorNodesByEdgeKind(istag, imageedges.ReferencedImageStreamGraphEdgeKind) {
 		return imagestream, imagestream.(*imagegraph.ImageStreamNode).Found()
@@ -7,7 +7,10 @@ import (
 	osgraphtest "github.com/openshift/origin/pkg/api/graph/test"
 	buildedges "github.com/openshift/origin/pkg/build/graph"
 	deployedges "github.com/openshift/origin/pkg/deploy/graph"
+	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	imageedges "github.com/openshift/origin/pkg/image/graph"
+	imagegraph "github.com/openshift/origin/pkg/image/graph/nodes"
 )
 
 func TestMissingImageStreamTag(t *testing.T) {
@@ -47,3 +50,35 @@ func TestMissingImageStream(t *testing.T) {
 		t.Fatalf("expected marker key %q, got %q", expected, got)
 	}
 }
+
+func TestStalledDeploymentConfigs(t *testing.T) {
+	g, objs, err := osgraphtest.BuildGraph("../../../api/graph/test/stalled-dcs.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, obj := range objs {
+		if is, ok := obj.(*imageapi.ImageStream); ok {
+			imagegraph.EnsureAllImageStreamTagNodes(g, is)
+		}
+	}
+	deployedges.AddAllTriggerEdges(g)
+	deployedges.AddAllDeploymentEdges(g)
+	imageedges.AddAllImageStreamRefEdges(g)
+
+	expected := map[string]string{
+		"failed":      LatestDeploymentFailedErr,
+		"no-triggers": NoTriggersWarning,
+		"manual":      ManualImageTriggerWarning,
+		"unresolved":  UnresolvedImageTriggerWarning,
+	}
+	markers := FindStalledDeploymentConfigs(g, osgraph.DefaultNamer)
+	if e, a := len(expected), len(markers); e != a {
+		t.Fatalf("expected %v markers, got %v: %#v", e, a, markers)
+	}
+	for _, marker := range markers {
+		name := marker.Node.(*deploygraph.DeploymentConfigNode).Name
+		if got, expected := marker.Key, expected[name]; got != expected {
+			t.Errorf("%s: expected marker key %q, got %q", name, expected, got)
+		}
+	}
+}
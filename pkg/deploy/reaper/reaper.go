@@ -27,7 +27,8 @@ type DeploymentConfigReaper struct {
 
 // Stop scales a replication controller via its deployment configuration down to
 // zero replicas, waits for all of them to get deleted and then deletes both the
-// replication controller and its deployment configuration.
+// replication controller and its deployment configuration. The deployer and hook
+// pods of every deleted replication controller are deleted as well.
 func (reaper *DeploymentConfigReaper) Stop(namespace, name string, timeout time.Duration, gracePeriod *kapi.DeleteOptions) error {
 	// If the config is already deleted, it may still have associated
 	// deployments which didn't get cleaned up during prior calls to Stop. If
@@ -64,8 +65,25 @@ func (reaper *DeploymentConfigReaper) Stop(namespace, name string, timeout time.
 		if err = rcReaper.Stop(rc.Namespace, rc.Name, timeout, gracePeriod); err != nil {
 			// Better not error out here...
 			glog.Infof("Cannot delete ReplicationController %s/%s: %v", rc.Namespace, rc.Name, err)
+			continue
 		}
+		reaper.deleteDeployerPods(rc.Namespace, rc.Name, gracePeriod)
 	}
 
 	return nil
 }
+
+// deleteDeployerPods deletes the deployer and hook pods of a deployment, which
+// would otherwise be left behind once the deployment is gone.
+func (reaper *DeploymentConfigReaper) deleteDeployerPods(namespace, deploymentName string, gracePeriod *kapi.DeleteOptions) {
+	pods, err := reaper.kc.Pods(namespace).List(kapi.ListOptions{LabelSelector: util.DeployerPodSelector(deploymentName)})
+	if err != nil {
+		glog.Infof("Cannot list deployer pods for ReplicationController %s/%s: %v", namespace, deploymentName, err)
+		return
+	}
+	for _, pod := range pods.Items {
+		if err := reaper.kc.Pods(pod.Namespace).Delete(pod.Name, gracePeriod); err != nil && !kerrors.IsNotFound(err) {
+			glog.Infof("Cannot delete deployer pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+}
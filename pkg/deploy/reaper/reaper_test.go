@@ -8,9 +8,11 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
@@ -61,6 +63,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-1"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-1"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-1"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 			},
 			err: false,
 		},
@@ -82,6 +85,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-1"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-4"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-1"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-2"),
 				ktestclient.NewListAction("replicationcontrollers", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-2"),
@@ -89,6 +93,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-2"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-2"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-3"),
 				ktestclient.NewListAction("replicationcontrollers", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-3"),
@@ -96,6 +101,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-3"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-3"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-4"),
 				ktestclient.NewListAction("replicationcontrollers", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-4"),
@@ -103,6 +109,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-4"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-4"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewListAction("replicationcontrollers", "", kapi.ListOptions{}),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
@@ -110,6 +117,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-5"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-5"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 			},
 			err: false,
 		},
@@ -131,6 +139,7 @@ func TestStop(t *testing.T) {
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-1"),
 				ktestclient.NewGetAction("replicationcontrollers", "", "config-1"),
 				ktestclient.NewDeleteAction("replicationcontrollers", "", "config-1"),
+				ktestclient.NewListAction("pods", "", kapi.ListOptions{}),
 			},
 			err: false,
 		},
@@ -203,3 +212,39 @@ func TestStop(t *testing.T) {
 		}
 	}
 }
+
+func TestStopDeletesDeployerPods(t *testing.T) {
+	deployerPod := func(name, deployment string) *kapi.Pod {
+		return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{deployapi.DeployerPodForDeploymentLabel: deployment},
+		}}
+	}
+	kc := ktestclient.NewSimpleFake(mkdeploymentlist(1))
+	kc.PrependReactor("list", "pods", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		selector := action.(ktestclient.ListAction).GetListRestrictions().Labels
+		list := &kapi.PodList{}
+		for _, pod := range []*kapi.Pod{deployerPod("config-1-deploy", "config-1"), deployerPod("config-1-hook-pre", "config-1"), deployerPod("other-1-deploy", "other-1")} {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				list.Items = append(list.Items, *pod)
+			}
+		}
+		return true, list, nil
+	})
+
+	reaper := &DeploymentConfigReaper{oc: testclient.NewSimpleFake(deploytest.OkDeploymentConfig(1)), kc: kc, pollInterval: time.Millisecond, timeout: time.Millisecond}
+	if err := reaper.Stop("default", "config", 1*time.Second, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deleted := []string{}
+	for _, action := range kc.Actions() {
+		if action.Matches("delete", "pods") {
+			deleted = append(deleted, action.(ktestclient.DeleteAction).GetName())
+		}
+	}
+	if expected := []string{"config-1-deploy", "config-1-hook-pre"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected deployer pods %v to be deleted, got %v", expected, deleted)
+	}
+}
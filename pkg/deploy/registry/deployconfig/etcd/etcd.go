@@ -147,16 +147,20 @@ func (r *ScaleREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object
 		return nil, false, err
 	}
 
-	oldReplicas := deploymentConfig.Spec.Replicas
 	deploymentConfig.Spec.Replicas = scale.Spec.Replicas
 	if err := r.registry.UpdateDeploymentConfig(ctx, deploymentConfig); err != nil {
 		return nil, false, err
 	}
-	scaleRet.Status.Replicas = totalReplicas + (scale.Spec.Replicas - oldReplicas)
+	// The deployments are scaled asynchronously, and not at all while one is running, so the
+	// status reports the replicas that exist right now.
+	scaleRet.Status.Replicas = totalReplicas
 
 	return scaleRet, false, nil
 }
 
+// replicasForDeploymentConfig returns the number of pods observed across all deployments of the config. During
+// a rollout both the old and the new deployment have pods that match the selector of the config, so their
+// observed replicas are summed rather than their desired replicas, which include pods that do not exist yet.
 func (r *ScaleREST) replicasForDeploymentConfig(namespace, configName string) (int, error) {
 	options := kapi.ListOptions{LabelSelector: util.ConfigSelector(configName)}
 	rcList, err := r.rcNamespacer.ReplicationControllers(namespace).List(options)
@@ -166,7 +170,7 @@ func (r *ScaleREST) replicasForDeploymentConfig(namespace, configName string) (i
 
 	replicas := 0
 	for _, rc := range rcList.Items {
-		replicas += rc.Spec.Replicas
+		replicas += rc.Status.Replicas
 	}
 
 	return replicas, nil
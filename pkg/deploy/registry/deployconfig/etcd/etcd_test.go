@@ -3,6 +3,8 @@ package etcd
 import (
 	"testing"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -96,3 +98,48 @@ func TestWatch(t *testing.T) {
 		},
 	)
 }
+
+func TestScaleReportsObservedReplicas(t *testing.T) {
+	etcdStorage, server := registrytest.NewEtcdStorage(t, "")
+	defer server.Terminate(t)
+	// A rollout in progress: the new deployment wants 3 replicas but has 1, the old one is scaled down to 2.
+	deployments := &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{
+		{Spec: kapi.ReplicationControllerSpec{Replicas: 3}, Status: kapi.ReplicationControllerStatus{Replicas: 1}},
+		{Spec: kapi.ReplicationControllerSpec{Replicas: 2}, Status: kapi.ReplicationControllerStatus{Replicas: 2}},
+	}}
+	storage, scaleStorage := NewREST(etcdStorage, testclient.NewSimpleFake(deployments))
+
+	ctx := kapi.WithNamespace(kapi.NewContext(), kapi.NamespaceDefault)
+	config := validDeploymentConfig()
+	config.Name = "foo"
+	config.Namespace = kapi.NamespaceDefault
+	config.Spec.Replicas = 3
+	if _, err := storage.Create(ctx, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, err := scaleStorage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scale := obj.(*extensions.Scale)
+	if scale.Spec.Replicas != 3 || scale.Status.Replicas != 3 {
+		t.Errorf("expected 3 desired and 3 observed replicas, got %#v", scale)
+	}
+
+	scale.Spec.Replicas = 5
+	obj, _, err = scaleStorage.Update(ctx, scale)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scale := obj.(*extensions.Scale); scale.Spec.Replicas != 5 || scale.Status.Replicas != 3 {
+		t.Errorf("expected 5 desired and 3 observed replicas, got %#v", scale)
+	}
+	obj, err = storage.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if replicas := obj.(*api.DeploymentConfig).Spec.Replicas; replicas != 5 {
+		t.Errorf("expected the config to be scaled to 5, got %d", replicas)
+	}
+}
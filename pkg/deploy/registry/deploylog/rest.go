@@ -19,6 +19,7 @@ import (
 	"github.com/openshift/origin/pkg/deploy/api/validation"
 	"github.com/openshift/origin/pkg/deploy/registry"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	utilrest "github.com/openshift/origin/pkg/util/rest"
 )
 
 // defaultTimeout is the default time to wait for the logs of a deployment
@@ -150,13 +151,17 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 		return nil, errors.NewBadRequest(err.Error())
 	}
 
-	return &genericrest.LocationStreamer{
+	streamer := &genericrest.LocationStreamer{
 		Location:        location,
 		Transport:       transport,
 		ContentType:     "text/plain",
 		Flush:           deployLogOpts.Follow,
 		ResponseChecker: genericrest.NewGenericHttpResponseChecker("Pod", deployPodName),
-	}, nil
+	}
+	if deployLogOpts.OffsetBytes != nil {
+		return &utilrest.OffsetStreamer{ResourceStreamer: streamer, Offset: *deployLogOpts.OffsetBytes}, nil
+	}
+	return streamer, nil
 }
 
 // podGetter implements the ResourceGetter interface. Used by LogLocation to
@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	utilrest "github.com/openshift/origin/pkg/util/rest"
 )
 
 func makeDeployment(version int) kapi.ReplicationController {
@@ -172,6 +173,28 @@ func TestRESTGet(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			testName: "resumed deployment log",
+			rest:     mockREST(1, 1, api.DeploymentStatusRunning),
+			name:     "config",
+			opts:     &api.DeploymentLogOptions{Follow: true, Version: intp(1), OffsetBytes: intp(100), LimitBytes: intp(50)},
+			expected: &utilrest.OffsetStreamer{
+				ResourceStreamer: &genericrest.LocationStreamer{
+					Location: &url.URL{
+						Scheme:   "https",
+						Host:     "config-1-deploy-host:12345",
+						Path:     "/containerLogs/default/config-1-deploy/config-1-deploy-container",
+						RawQuery: "follow=true&limitBytes=150",
+					},
+					Transport:       nil,
+					ContentType:     "text/plain",
+					Flush:           true,
+					ResponseChecker: genericrest.NewGenericHttpResponseChecker("Pod", "config-1-deploy"),
+				},
+				Offset: 100,
+			},
+			expectedErr: nil,
+		},
 		{
 			testName:    "non-existent previous deployment",
 			rest:        mockREST(1 /* won't be used */, 101, ""),
@@ -199,8 +222,8 @@ func TestRESTGet(t *testing.T) {
 		if !reflect.DeepEqual(got, test.expected) {
 			t.Errorf("%s: location streamer mismatch: expected\n%#v\ngot\n%#v\n", test.testName, test.expected, got)
 			if testing.Verbose() {
-				e := test.expected.(*genericrest.LocationStreamer)
-				a := got.(*genericrest.LocationStreamer)
+				e, _ := test.expected.(*genericrest.LocationStreamer)
+				a, _ := got.(*genericrest.LocationStreamer)
 				t.Errorf("%s: expected url:\n%v\ngot:\n%v\n", test.testName, e.Location, a.Location)
 			}
 		}
@@ -35,7 +35,7 @@ type RecreateDeploymentStrategy struct {
 	// hookExecutor can execute a lifecycle hook.
 	hookExecutor hookExecutor
 	// retryTimeout is how long to wait for the replica count update to succeed
-	// before giving up.
+	// before giving up, unless the strategy params set a timeout.
 	retryTimeout time.Duration
 	// retryPeriod is how often to try updating the replica count.
 	retryPeriod time.Duration
@@ -52,7 +52,7 @@ func NewRecreateDeploymentStrategy(client kclient.Interface, codec runtime.Codec
 		scaler:       scaler,
 		codec:        codec,
 		hookExecutor: stratsupport.NewHookExecutor(client, os.Stdout, codec),
-		retryTimeout: 120 * time.Second,
+		retryTimeout: time.Duration(deployapi.DefaultRecreateTimeoutSeconds) * time.Second,
 		retryPeriod:  1 * time.Second,
 	}
 }
@@ -76,8 +76,12 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 	}
 
 	params := config.Spec.Strategy.RecreateParams
-	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
-	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	retryTimeout := s.retryTimeout
+	if params != nil && params.TimeoutSeconds != nil {
+		retryTimeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, retryTimeout)
 
 	// Execute any pre-hook.
 	if params != nil && params.Pre != nil {
@@ -97,6 +101,15 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 		}
 	}
 
+	// Execute any mid-hook, while no pods of either deployment are running.
+	if params != nil && params.Mid != nil {
+		if err := s.hookExecutor.Execute(params.Mid, to, "midhook"); err != nil {
+			return fmt.Errorf("Mid hook failed: %s", err)
+		} else {
+			glog.Infof("Mid hook finished")
+		}
+	}
+
 	// Scale up the to deployment.
 	if desiredReplicas > 0 {
 		// If an UpdateAcceptor is provided, scale up to 1 and validate the replica,
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/kubectl"
 
 	api "github.com/openshift/origin/pkg/api/latest"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -168,6 +169,93 @@ func TestRecreate_deploymentPostHookFail(t *testing.T) {
 	}
 }
 
+func TestRecreate_deploymentMidHook(t *testing.T) {
+	for _, hookErr := range []error{nil, fmt.Errorf("hook execution failure")} {
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy.RecreateParams = &deployapi.RecreateDeploymentStrategyParams{
+			Mid: &deployapi.LifecycleHook{
+				FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+				ExecNewPod:    &deployapi.ExecNewPodHook{},
+			},
+		}
+		from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+		deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+		scaler := &scalertest.FakeScaler{}
+
+		scaleEventsAtHook := -1
+		strategy := &RecreateDeploymentStrategy{
+			codec:        api.Codec,
+			retryTimeout: 1 * time.Second,
+			retryPeriod:  1 * time.Millisecond,
+			getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
+			hookExecutor: &hookExecutorImpl{
+				executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+					scaleEventsAtHook = len(scaler.Events)
+					return hookErr
+				},
+			},
+			scaler: scaler,
+		}
+
+		err := strategy.Deploy(from, deployment, 2)
+		if hookErr == nil && err != nil {
+			t.Fatalf("unexpected deploy error: %#v", err)
+		}
+		if hookErr != nil && err == nil {
+			t.Fatalf("expected a deploy error")
+		}
+		// The hook runs once the old deployment is scaled down.
+		if scaleEventsAtHook != 1 || scaler.Events[0].Size != 0 {
+			t.Fatalf("expected the hook to run after scaling down, got %d events before it: %v", scaleEventsAtHook, scaler.Events)
+		}
+		expectedEvents := 2
+		if hookErr != nil {
+			expectedEvents = 1
+		}
+		if e, a := expectedEvents, len(scaler.Events); e != a {
+			t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+		}
+	}
+}
+
+func TestRecreate_timeoutSeconds(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	timeout := int64(5)
+	config.Spec.Strategy.RecreateParams = &deployapi.RecreateDeploymentStrategyParams{TimeoutSeconds: &timeout}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	scaler := &timeoutRecordingScaler{}
+
+	strategy := &RecreateDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		scaler: scaler,
+	}
+
+	if err := strategy.Deploy(nil, deployment, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+	if e, a := []time.Duration{5 * time.Second}, scaler.timeouts; len(a) != 1 || a[0] != e[0] {
+		t.Errorf("expected scaling to time out after %v, got %v", e, a)
+	}
+}
+
+// timeoutRecordingScaler records how long each scale waits for the replicas.
+type timeoutRecordingScaler struct {
+	scalertest.FakeScaler
+	timeouts []time.Duration
+}
+
+func (s *timeoutRecordingScaler) Scale(namespace, name string, newSize uint, preconditions *kubectl.ScalePrecondition, retry, wait *kubectl.RetryParams) error {
+	s.timeouts = append(s.timeouts, wait.Timeout)
+	return s.FakeScaler.Scale(namespace, name, newSize, preconditions, retry, wait)
+}
+
 func TestRecreate_acceptorSuccess(t *testing.T) {
 	var deployment *kapi.ReplicationController
 	scaler := &scalertest.FakeScaler{}
@@ -0,0 +1,83 @@
+package rolling
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// canaryChecker knows how to run the canary check of a rolling deployment.
+type canaryChecker interface {
+	Check(check *deployapi.CanaryCheck, deployment *kapi.ReplicationController, label string) error
+}
+
+// canary tracks the canary checks of a single rollout.
+type canary struct {
+	// check is the canary check of the deployment.
+	check *deployapi.CanaryCheck
+	// deployment is the new deployment being rolled out.
+	deployment *kapi.ReplicationController
+	// checker runs the check.
+	checker canaryChecker
+	// replicas is the replica count of the deployment after its last scale up.
+	replicas int
+	// err is the error of the check which aborted the rollout, if any.
+	err error
+}
+
+// run runs the check against the pods the deployment currently has.
+func (c *canary) run() error {
+	return c.checker.Check(c.check, c.deployment, fmt.Sprintf("canary-%d", c.replicas))
+}
+
+// canaryClient is the client of the rolling updater when the deployment has a
+// canary check. The rolling updater has no extension point between its
+// scaling steps, so the check is run whenever the updater is about to scale up
+// the new deployment beyond the pods it already runs.
+type canaryClient struct {
+	kclient.Interface
+	canary *canary
+}
+
+func (c *canaryClient) ReplicationControllers(namespace string) kclient.ReplicationControllerInterface {
+	return &canaryReplicationControllers{
+		ReplicationControllerInterface: c.Interface.ReplicationControllers(namespace),
+		canary:                         c.canary,
+	}
+}
+
+type canaryReplicationControllers struct {
+	kclient.ReplicationControllerInterface
+	canary *canary
+}
+
+// Update runs the canary check before the new deployment is scaled up from a
+// non-zero replica count. A failed check is reported as an invalid update so
+// that the scaler gives up instead of retrying.
+func (c *canaryReplicationControllers) Update(rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+	deployment := c.canary.deployment
+	if rc.Namespace != deployment.Namespace || rc.Name != deployment.Name {
+		return c.ReplicationControllerInterface.Update(rc)
+	}
+
+	if rc.Spec.Replicas > c.canary.replicas && c.canary.replicas > 0 {
+		if err := c.canary.run(); err != nil {
+			c.canary.err = err
+			return nil, kerrors.NewInvalid("ReplicationController", rc.Name, field.ErrorList{
+				field.Invalid(field.NewPath("spec", "replicas"), rc.Spec.Replicas, fmt.Sprintf("canary check for %s failed: %v", deployutil.LabelForDeployment(deployment), err)),
+			})
+		}
+	}
+
+	updated, err := c.ReplicationControllerInterface.Update(rc)
+	if err == nil {
+		c.canary.replicas = rc.Spec.Replicas
+	}
+	return updated, err
+}
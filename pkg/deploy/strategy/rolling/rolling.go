@@ -2,7 +2,10 @@ package rolling
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -13,6 +16,8 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/util/wait"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -47,12 +52,17 @@ type RollingDeploymentStrategy struct {
 	initialStrategy acceptingDeploymentStrategy
 	// client is used to deal with ReplicationControllers.
 	client kclient.Interface
-	// rollingUpdate knows how to perform a rolling update.
-	rollingUpdate func(config *kubectl.RollingUpdaterConfig) error
+	// rollingUpdate knows how to perform a rolling update with the given
+	// client.
+	rollingUpdate func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error
+	// scaler is used to scale the deployments back when a rollout is aborted.
+	scaler kubectl.Scaler
 	// codec is used to access the encoded config on a deployment.
 	codec runtime.Codec
 	// hookExecutor can execute a lifecycle hook.
 	hookExecutor hookExecutor
+	// canaryChecker can run the canary check of a deployment.
+	canaryChecker canaryChecker
 	// getUpdateAcceptor returns an UpdateAcceptor to verify the first replica
 	// of the deployment.
 	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
@@ -77,17 +87,20 @@ const AcceptorInterval = 1 * time.Second
 
 // NewRollingDeploymentStrategy makes a new RollingDeploymentStrategy.
 func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
 	return &RollingDeploymentStrategy{
 		codec:           codec,
 		initialStrategy: initialStrategy,
 		client:          client,
 		apiRetryPeriod:  DefaultApiRetryPeriod,
 		apiRetryTimeout: DefaultApiRetryTimeout,
-		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
 			updater := kubectl.NewRollingUpdater(namespace, client)
 			return updater.Update(config)
 		},
-		hookExecutor: stratsupport.NewHookExecutor(client, os.Stdout, codec),
+		scaler:        scaler,
+		hookExecutor:  stratsupport.NewHookExecutor(client, os.Stdout, codec),
+		canaryChecker: stratsupport.NewCanaryChecker(client, os.Stdout, codec),
 		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
 			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
 		},
@@ -186,6 +199,21 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		return err
 	}
 
+	// Run any canary check between the scaling steps of the new deployment.
+	// Remember the replica counts to return to if the check fails.
+	client := s.client
+	fromReplicas, toReplicas := from.Spec.Replicas, to.Spec.Replicas
+	var rolloutCanary *canary
+	if params.Canary != nil {
+		rolloutCanary = &canary{
+			check:      params.Canary,
+			deployment: to,
+			checker:    s.canaryChecker,
+			replicas:   toReplicas,
+		}
+		client = &canaryClient{Interface: s.client, canary: rolloutCanary}
+	}
+
 	// HACK: There's a validation in the rolling updater which assumes that when
 	// an existing RC is supplied, it will have >0 replicas- a validation which
 	// is then disregarded as the desired count is obtained from the annotation
@@ -195,6 +223,12 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 	// https://github.com/kubernetes/kubernetes/pull/7183
 	to.Spec.Replicas = 1
 
+	maxSurge, maxUnavailable, err := resolveFenceposts(params.MaxSurge, params.MaxUnavailable, desiredReplicas)
+	if err != nil {
+		return err
+	}
+	glog.Infof("Rolling out %d replicas with a surge of %d and at most %d unavailable", desiredReplicas, maxSurge, maxUnavailable)
+
 	// Perform a rolling update.
 	rollingConfig := &kubectl.RollingUpdaterConfig{
 		Out:            &rollingUpdaterWriter{},
@@ -204,10 +238,19 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		Interval:       time.Duration(*params.IntervalSeconds) * time.Second,
 		Timeout:        time.Duration(*params.TimeoutSeconds) * time.Second,
 		CleanupPolicy:  kubectl.PreserveRollingUpdateCleanupPolicy,
-		MaxSurge:       params.MaxSurge,
-		MaxUnavailable: params.MaxUnavailable,
+		MaxSurge:       intstr.FromInt(maxSurge),
+		MaxUnavailable: intstr.FromInt(maxUnavailable),
+	}
+	err = s.rollingUpdate(rollingConfig, client)
+	if rolloutCanary != nil {
+		if err == nil {
+			// Check the deployment once more now that all of its pods run.
+			rolloutCanary.err = rolloutCanary.run()
+		}
+		if rolloutCanary.err != nil {
+			return s.rollback(from, fromReplicas, to, toReplicas, rollingConfig.Timeout, rolloutCanary.err)
+		}
 	}
-	err = s.rollingUpdate(rollingConfig)
 	if err != nil {
 		return err
 	}
@@ -225,6 +268,68 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 	return nil
 }
 
+// rollback scales from back up and to back down to the given replica counts
+// after the canary check of to failed with cause.
+func (s *RollingDeploymentStrategy) rollback(from *kapi.ReplicationController, fromReplicas int, to *kapi.ReplicationController, toReplicas int, timeout time.Duration, cause error) error {
+	glog.Infof("Canary check failed for %s, scaling %s back up to %d", deployutil.LabelForDeployment(to), deployutil.LabelForDeployment(from), fromReplicas)
+	retryParams := kubectl.NewRetryParams(s.apiRetryPeriod, s.apiRetryTimeout)
+	waitParams := kubectl.NewRetryParams(s.apiRetryPeriod, timeout)
+
+	errs := []error{fmt.Errorf("canary check failed, rolled back: %v", cause)}
+	if err := s.scaler.Scale(from.Namespace, from.Name, uint(fromReplicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retryParams, waitParams); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't scale %s back up to %d: %v", deployutil.LabelForDeployment(from), fromReplicas, err))
+	}
+	if err := s.scaler.Scale(to.Namespace, to.Name, uint(toReplicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retryParams, waitParams); err != nil {
+		errs = append(errs, fmt.Errorf("couldn't scale %s back down to %d: %v", deployutil.LabelForDeployment(to), toReplicas, err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// resolveFenceposts converts maxSurge and maxUnavailable into absolute numbers
+// of pods for the desired replica count, following the semantics of upstream
+// deployments: a percentage of maxSurge is rounded up and a percentage of
+// maxUnavailable is rounded down. If both resolve to 0, one pod is allowed to be
+// unavailable so the rollout can make progress.
+func resolveFenceposts(maxSurge, maxUnavailable intstr.IntOrString, desired int) (int, int, error) {
+	surge, err := resolveIntOrPercent(maxSurge, "maxSurge", desired, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	unavailable, err := resolveIntOrPercent(maxUnavailable, "maxUnavailable", desired, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	if surge == 0 && unavailable == 0 {
+		unavailable = 1
+	}
+	return surge, unavailable, nil
+}
+
+// resolveIntOrPercent returns value as an absolute number, or as the given
+// percentage of total rounded up or down.
+func resolveIntOrPercent(value intstr.IntOrString, name string, total int, roundUp bool) (int, error) {
+	switch value.Type {
+	case intstr.Int:
+		if value.IntVal < 0 {
+			return 0, fmt.Errorf("%s must be >= 0", name)
+		}
+		return value.IntValue(), nil
+	case intstr.String:
+		percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(value.StrVal, "%") {
+			return 0, fmt.Errorf("invalid %s value %q: must be an integer or a percentage", name, value.StrVal)
+		}
+		if percent < 0 {
+			return 0, fmt.Errorf("%s must be >= 0", name)
+		}
+		if roundUp {
+			return int(math.Ceil(float64(total) * float64(percent) / 100)), nil
+		}
+		return int(math.Floor(float64(total) * float64(percent) / 100)), nil
+	}
+	return 0, fmt.Errorf("invalid kind %v for %s", value.Type, name)
+}
+
 // rollingUpdaterWriter is an io.Writer that delegates to glog.
 type rollingUpdaterWriter struct{}
 
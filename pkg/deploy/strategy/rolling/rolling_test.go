@@ -2,17 +2,21 @@ package rolling
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	api "github.com/openshift/origin/pkg/api/latest"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
 	strat "github.com/openshift/origin/pkg/deploy/strategy"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
@@ -29,7 +33,7 @@ func TestRolling_deployInitial(t *testing.T) {
 				return nil
 			},
 		},
-		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
 			t.Fatalf("unexpected call to rollingUpdate")
 			return nil
 		},
@@ -85,7 +89,7 @@ func TestRolling_deployRolling(t *testing.T) {
 				return nil
 			},
 		},
-		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
 			rollingConfig = config
 			return nil
 		},
@@ -122,6 +126,14 @@ func TestRolling_deployRolling(t *testing.T) {
 		t.Errorf("expected Timeout %d, got %d", e, a)
 	}
 
+	if e, a := intstr.FromInt(0), rollingConfig.MaxSurge; e != a {
+		t.Errorf("expected MaxSurge %v, got %v", e, a)
+	}
+
+	if e, a := intstr.FromInt(1), rollingConfig.MaxUnavailable; e != a {
+		t.Errorf("expected MaxUnavailable %v, got %v", e, a)
+	}
+
 	// verify hack
 	if e, a := 1, rollingConfig.NewRc.Spec.Replicas; e != a {
 		t.Errorf("expected rollingConfig.NewRc.Spec.Replicas %d, got %d", e, a)
@@ -165,7 +177,7 @@ func TestRolling_deployRollingHooks(t *testing.T) {
 				return nil
 			},
 		},
-		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
 			return nil
 		},
 		hookExecutor: &hookExecutorImpl{
@@ -211,6 +223,101 @@ func TestRolling_deployRollingHooks(t *testing.T) {
 	}
 }
 
+func TestRolling_deployRollingCanary(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	latest, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	latest.Spec.Replicas = 3
+
+	cases := []struct {
+		failLabel      string
+		expectedLabels []string
+	}{
+		{"", []string{"canary-1", "canary-2", "canary-3"}},
+		{"canary-2", []string{"canary-1", "canary-2"}},
+		{"canary-3", []string{"canary-1", "canary-2", "canary-3"}},
+	}
+
+	for _, tc := range cases {
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deploytest.OkRollingStrategy()
+		config.Spec.Strategy.RollingParams.Canary = &deployapi.CanaryCheck{
+			HTTPGet: &deployapi.CanaryHTTPGetAction{URL: "http://example.com/check"},
+		}
+		deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+		deployments := map[string]*kapi.ReplicationController{
+			latest.Name:     latest,
+			deployment.Name: deployment,
+		}
+
+		fake := &ktestclient.Fake{}
+		fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			name := action.(ktestclient.GetAction).GetName()
+			return true, deployments[name], nil
+		})
+		fake.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			updated := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			return true, updated, nil
+		})
+
+		labels := []string{}
+		scaler := &scalertest.FakeScaler{}
+		strategy := &RollingDeploymentStrategy{
+			codec:  api.Codec,
+			client: fake,
+			scaler: scaler,
+			// Scale the new deployment up one replica at a time, the way the
+			// rolling updater does with a surge of 1.
+			rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
+				for replicas := 1; replicas <= 3; replicas++ {
+					rc, err := client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+					if err != nil {
+						return err
+					}
+					rc.Spec.Replicas = replicas
+					if _, err := client.ReplicationControllers(rc.Namespace).Update(rc); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			canaryChecker: &testCanaryChecker{
+				checkFn: func(check *deployapi.CanaryCheck, deployment *kapi.ReplicationController, label string) error {
+					labels = append(labels, label)
+					if label == tc.failLabel {
+						return fmt.Errorf("canary failure")
+					}
+					return nil
+				},
+			},
+			getUpdateAcceptor: getUpdateAcceptor,
+			apiRetryPeriod:    1 * time.Millisecond,
+			apiRetryTimeout:   10 * time.Millisecond,
+		}
+
+		err := strategy.Deploy(latest, deployment, 3)
+		if !reflect.DeepEqual(labels, tc.expectedLabels) {
+			t.Errorf("%q: expected canary checks %v, got %v", tc.failLabel, tc.expectedLabels, labels)
+		}
+		if len(tc.failLabel) == 0 {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if len(scaler.Events) != 0 {
+				t.Errorf("unexpected scaling: %v", scaler.Events)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%q: expected an error", tc.failLabel)
+		}
+		expected := []scalertest.ScaleEvent{{Name: latest.Name, Size: 3}, {Name: deployment.Name, Size: 0}}
+		if !reflect.DeepEqual(scaler.Events, expected) {
+			t.Errorf("%q: expected the rollout to be rolled back with %v, got %v", tc.failLabel, expected, scaler.Events)
+		}
+	}
+}
+
 // TestRolling_deployInitialHooks can go away once the rolling strategy
 // supports initial deployments.
 func TestRolling_deployInitialHooks(t *testing.T) {
@@ -224,7 +331,7 @@ func TestRolling_deployInitialHooks(t *testing.T) {
 				return nil
 			},
 		},
-		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig, client kclient.Interface) error {
 			return nil
 		},
 		hookExecutor: &hookExecutorImpl{
@@ -282,6 +389,43 @@ func mkintp(i int) *int64 {
 	return &v
 }
 
+func TestRolling_resolveFenceposts(t *testing.T) {
+	tests := []struct {
+		maxSurge       intstr.IntOrString
+		maxUnavailable intstr.IntOrString
+		desired        int
+		surge          int
+		unavailable    int
+		expectErr      bool
+	}{
+		{maxSurge: intstr.FromInt(2), maxUnavailable: intstr.FromInt(1), desired: 10, surge: 2, unavailable: 1},
+		{maxSurge: intstr.FromString("25%"), maxUnavailable: intstr.FromString("25%"), desired: 10, surge: 3, unavailable: 2},
+		{maxSurge: intstr.FromString("25%"), maxUnavailable: intstr.FromString("25%"), desired: 1, surge: 1, unavailable: 0},
+		{maxSurge: intstr.FromString("0%"), maxUnavailable: intstr.FromString("10%"), desired: 5, surge: 0, unavailable: 1},
+		{maxSurge: intstr.FromInt(0), maxUnavailable: intstr.FromInt(0), desired: 5, surge: 0, unavailable: 1},
+		{maxSurge: intstr.FromString("50%"), maxUnavailable: intstr.FromInt(0), desired: 3, surge: 2, unavailable: 0},
+		{maxSurge: intstr.FromString("foo"), maxUnavailable: intstr.FromInt(0), desired: 3, expectErr: true},
+		{maxSurge: intstr.FromInt(0), maxUnavailable: intstr.FromInt(-1), desired: 3, expectErr: true},
+	}
+
+	for i, test := range tests {
+		surge, unavailable, err := resolveFenceposts(test.maxSurge, test.maxUnavailable, test.desired)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("%d: expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+			continue
+		}
+		if surge != test.surge || unavailable != test.unavailable {
+			t.Errorf("%d: expected surge %d and unavailable %d, got %d and %d", i, test.surge, test.unavailable, surge, unavailable)
+		}
+	}
+}
+
 func rollingParams(preFailurePolicy, postFailurePolicy deployapi.LifecycleHookFailurePolicy) *deployapi.RollingDeploymentStrategyParams {
 	var pre *deployapi.LifecycleHook
 	var post *deployapi.LifecycleHook
@@ -322,3 +466,11 @@ type testAcceptor struct {
 func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
 	return t.acceptFn(deployment)
 }
+
+type testCanaryChecker struct {
+	checkFn func(check *deployapi.CanaryCheck, deployment *kapi.ReplicationController, label string) error
+}
+
+func (c *testCanaryChecker) Check(check *deployapi.CanaryCheck, deployment *kapi.ReplicationController, label string) error {
+	return c.checkFn(check, deployment, label)
+}
@@ -0,0 +1,75 @@
+package support
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// CanaryHTTPTimeout is how long an HTTP canary check waits for a response.
+const CanaryHTTPTimeout = 30 * time.Second
+
+// CanaryChecker runs the canary checks of a rolling deployment.
+type CanaryChecker struct {
+	// hookExecutor runs checks which execute a command in a new pod.
+	hookExecutor *HookExecutor
+	// httpGet requests the URL of HTTP checks.
+	httpGet func(url string) (*http.Response, error)
+}
+
+// NewCanaryChecker makes a CanaryChecker from a client.
+func NewCanaryChecker(client kclient.Interface, podLogDestination io.Writer, codec runtime.Codec) *CanaryChecker {
+	httpClient := &http.Client{Timeout: CanaryHTTPTimeout}
+	return &CanaryChecker{
+		hookExecutor: NewHookExecutor(client, podLogDestination, codec),
+		httpGet:      httpClient.Get,
+	}
+}
+
+// Check runs check against deployment and returns an error if the check
+// failed. The label distinguishes the pods of successive checks of the same
+// deployment.
+func (c *CanaryChecker) Check(check *deployapi.CanaryCheck, deployment *kapi.ReplicationController, label string) error {
+	switch {
+	case check.ExecNewPod != nil:
+		hook := &deployapi.LifecycleHook{
+			FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+			ExecNewPod:    check.ExecNewPod,
+		}
+		if err := c.hookExecutor.executeExecNewPod(hook, deployment, label); err != nil {
+			return fmt.Errorf("canary pod failed: %v", err)
+		}
+	case check.HTTPGet != nil:
+		if err := c.checkHTTPGet(check.HTTPGet.URL); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("no canary check is configured")
+	}
+	glog.Infof("Canary check passed for %s", deployutil.LabelForDeployment(deployment))
+	return nil
+}
+
+// checkHTTPGet requests url and returns an error unless the response status
+// is between 200 and 399.
+func (c *CanaryChecker) checkHTTPGet(url string) error {
+	resp, err := c.httpGet(url)
+	if err != nil {
+		return fmt.Errorf("canary request to %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("canary request to %s returned %s", url, resp.Status)
+	}
+	return nil
+}
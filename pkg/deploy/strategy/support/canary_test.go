@@ -0,0 +1,82 @@
+package support
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func TestCanaryChecker_httpGet(t *testing.T) {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+
+	tests := map[int]bool{
+		http.StatusOK:                  true,
+		http.StatusNoContent:           true,
+		http.StatusNotFound:            false,
+		http.StatusInternalServerError: false,
+	}
+	for status, pass := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+		checker := &CanaryChecker{httpGet: http.Get}
+		check := &deployapi.CanaryCheck{HTTPGet: &deployapi.CanaryHTTPGetAction{URL: server.URL + "/check"}}
+
+		err := checker.Check(check, deployment, "canary")
+		if pass && err != nil {
+			t.Errorf("%d: unexpected error: %v", status, err)
+		}
+		if !pass && err == nil {
+			t.Errorf("%d: expected the check to fail", status)
+		}
+		server.Close()
+	}
+}
+
+func TestCanaryChecker_execNewPodFailed(t *testing.T) {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+
+	var created *kapi.Pod
+	checker := &CanaryChecker{
+		hookExecutor: &HookExecutor{
+			podClient: &HookExecutorPodClientImpl{
+				CreatePodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+					created = pod
+					return pod, nil
+				},
+				PodWatchFunc: func(namespace, name, resourceVersion string, stopChannel chan struct{}) func() *kapi.Pod {
+					return func() *kapi.Pod {
+						return &kapi.Pod{Status: kapi.PodStatus{Phase: kapi.PodFailed, Message: "check failed"}}
+					}
+				},
+			},
+			podLogStream: func(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader("")), nil
+			},
+			podLogDestination: ioutil.Discard,
+			codec:             kapi.Codec,
+		},
+	}
+	check := &deployapi.CanaryCheck{
+		ExecNewPod: &deployapi.ExecNewPodHook{ContainerName: "container1", Command: []string{"/check"}},
+	}
+
+	if err := checker.Check(check, deployment, "canary-2"); err == nil {
+		t.Fatalf("expected the check to fail")
+	}
+	if created == nil {
+		t.Fatalf("expected a canary pod to be created")
+	}
+	if e, a := "config-1-canary-2", created.Name; e != a {
+		t.Errorf("expected canary pod %s, got %s", e, a)
+	}
+}
@@ -0,0 +1,194 @@
+package cluster
+
+// The purpose of this diagnostic is to detect nodes that cannot take part in the
+// openshift-sdn pod network because their HostSubnet is missing or inconsistent.
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	osclient "github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/diagnostics/log"
+	"github.com/openshift/origin/pkg/diagnostics/types"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+)
+
+const (
+	nodeWithoutSubnet = `Node {{.node}} has no HostSubnet.
+The SDN master allocates a subnet to every node when the node is registered.
+Until it has one, pods on the node cannot reach pods on other nodes. Check
+the master logs for errors allocating a subnet to the node.`
+
+	subnetWithoutNode = `HostSubnet {{.subnet}} ({{.cidr}}) does not belong to any node.
+The subnet stays allocated until the HostSubnet is deleted. If the node was
+removed permanently, the HostSubnet can be deleted with:
+    oc delete hostsubnet {{.subnet}}`
+
+	subnetOutsideNetwork = `HostSubnet {{.subnet}} ({{.cidr}}) is not inside any cluster network:
+    {{.networks}}
+Pods on node {{.subnet}} are not routed by the other nodes. This happens
+when a cluster network is removed from the master config while nodes
+still use it.`
+
+	subnetIPMismatch = `HostSubnet {{.subnet}} uses host IP {{.hostIP}}, which is not an address
+of node {{.subnet}}. Other nodes send pod traffic for {{.cidr}} to that IP.
+If the node changed its IP address, restart the node so that it updates
+its HostSubnet.`
+)
+
+// ClusterNetwork is a Diagnostic to check that every node has a valid HostSubnet
+// in the pod network of the cluster.
+type ClusterNetwork struct {
+	NodesClient          kclient.NodesInterface
+	ClusterNetworkClient osclient.ClusterNetworkingInterface
+	HostSubnetsClient    osclient.HostSubnetsInterface
+	SARClient            osclient.SubjectAccessReviews
+}
+
+const ClusterNetworkName = "ClusterNetwork"
+
+func (d *ClusterNetwork) Name() string {
+	return ClusterNetworkName
+}
+
+func (d *ClusterNetwork) Description() string {
+	return "Check that every node has a HostSubnet inside the cluster network"
+}
+
+func (d *ClusterNetwork) CanRun() (bool, error) {
+	if d.NodesClient == nil || d.ClusterNetworkClient == nil || d.HostSubnetsClient == nil {
+		return false, errors.New("must have kube and os client")
+	}
+	if d.SARClient == nil {
+		return false, errors.New("must have client.SubjectAccessReviews")
+	}
+	can, err := userCan(d.SARClient, authorizationapi.AuthorizationAttributes{
+		Verb:     "list",
+		Resource: "hostsubnets",
+	})
+	if err != nil {
+		return false, types.DiagnosticError{ID: "DClu4000", LogMessage: fmt.Sprintf("Error checking access to HostSubnets: %v", err), Cause: err}
+	} else if !can {
+		return false, types.DiagnosticError{ID: "DClu4001", LogMessage: "Client does not have access to see HostSubnets", Cause: err}
+	}
+	return true, nil
+}
+
+func (d *ClusterNetwork) Check() types.DiagnosticResult {
+	r := types.NewDiagnosticResult(ClusterNetworkName)
+
+	clusterNetwork, err := d.ClusterNetworkClient.ClusterNetwork().Get("default")
+	if kerrs.IsNotFound(err) {
+		r.Info("DClu4002", "No ClusterNetwork is defined, so the cluster is not using an openshift-sdn network plugin.")
+		return r
+	} else if err != nil {
+		r.Error("DClu4003", err, fmt.Sprintf("Error retrieving the ClusterNetwork: %v", err))
+		return r
+	}
+	subnets, err := d.HostSubnetsClient.HostSubnets().List(kapi.ListOptions{})
+	if err != nil {
+		r.Error("DClu4004", err, fmt.Sprintf("Error retrieving HostSubnets: %v", err))
+		return r
+	}
+	nodes, err := d.NodesClient.Nodes().List(kapi.ListOptions{})
+	if err != nil {
+		r.Error("DClu4005", err, fmt.Sprintf(clientErrorGettingNodes, err))
+		return r
+	}
+
+	checkHostSubnets(r, sdnapi.GetClusterNetworkEntries(clusterNetwork), subnets.Items, nodes.Items)
+	return r
+}
+
+// checkHostSubnets reports the nodes without a HostSubnet, the HostSubnets without a node, and the
+// HostSubnets that are invalid, overlap, or do not match the addresses of their node.
+func checkHostSubnets(r types.DiagnosticResult, networks []sdnapi.ClusterNetworkEntry, subnets []sdnapi.HostSubnet, nodes []kapi.Node) {
+	clusterCIDRs := []*net.IPNet{}
+	networkNames := []string{}
+	for _, network := range networks {
+		_, cidr, err := net.ParseCIDR(network.CIDR)
+		if err != nil {
+			r.Error("DClu4006", err, fmt.Sprintf("The ClusterNetwork contains an invalid network %q: %v", network.CIDR, err))
+			continue
+		}
+		clusterCIDRs = append(clusterCIDRs, cidr)
+		networkNames = append(networkNames, network.CIDR)
+	}
+
+	nodesByName := map[string]kapi.Node{}
+	for _, node := range nodes {
+		nodesByName[node.Name] = node
+	}
+
+	subnetsByName := map[string]bool{}
+	validCIDRs := map[string]*net.IPNet{}
+	for _, subnet := range subnets {
+		subnetsByName[subnet.Name] = true
+
+		_, cidr, err := net.ParseCIDR(subnet.Subnet)
+		if err != nil {
+			r.Error("DClu4007", err, fmt.Sprintf("HostSubnet %s has an invalid subnet %q: %v", subnet.Name, subnet.Subnet, err))
+			continue
+		}
+		inNetwork := false
+		for _, clusterCIDR := range clusterCIDRs {
+			if clusterCIDR.Contains(cidr.IP) && maskSize(cidr) >= maskSize(clusterCIDR) {
+				inNetwork = true
+				break
+			}
+		}
+		if !inNetwork {
+			r.Error("DClu4008", nil, log.EvalTemplate("DClu4008", subnetOutsideNetwork, log.Hash{"subnet": subnet.Name, "cidr": subnet.Subnet, "networks": networkNames}))
+		}
+		for name, other := range validCIDRs {
+			if other.Contains(cidr.IP) || cidr.Contains(other.IP) {
+				r.Error("DClu4009", nil, fmt.Sprintf("HostSubnets %s and %s overlap: %s and %s", name, subnet.Name, other.String(), subnet.Subnet))
+			}
+		}
+		validCIDRs[subnet.Name] = cidr
+
+		node, ok := nodesByName[subnet.Name]
+		if !ok {
+			r.Warn("DClu4010", nil, log.EvalTemplate("DClu4010", subnetWithoutNode, log.Hash{"subnet": subnet.Name, "cidr": subnet.Subnet}))
+			continue
+		}
+		if !nodeHasAddress(node, subnet.HostIP) {
+			r.Warn("DClu4011", nil, log.EvalTemplate("DClu4011", subnetIPMismatch, log.Hash{"subnet": subnet.Name, "cidr": subnet.Subnet, "hostIP": subnet.HostIP}))
+		}
+	}
+
+	for _, node := range nodes {
+		if !subnetsByName[node.Name] {
+			r.Error("DClu4012", nil, log.EvalTemplate("DClu4012", nodeWithoutSubnet, log.Hash{"node": node.Name}))
+		}
+	}
+
+	if len(r.Errors()) == 0 && len(r.Warnings()) == 0 {
+		r.Info("DClu4013", fmt.Sprintf("All %d nodes have a HostSubnet in the cluster network", len(nodes)))
+	}
+}
+
+func maskSize(cidr *net.IPNet) int {
+	ones, _ := cidr.Mask.Size()
+	return ones
+}
+
+// nodeHasAddress returns true if the node reports the IP as one of its addresses. Nodes that do not
+// report any addresses yet are not flagged.
+func nodeHasAddress(node kapi.Node, ip string) bool {
+	if len(node.Status.Addresses) == 0 {
+		return true
+	}
+	for _, address := range node.Status.Addresses {
+		if address.Address == ip {
+			return true
+		}
+	}
+	return false
+}
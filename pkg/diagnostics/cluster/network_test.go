@@ -0,0 +1,108 @@
+package cluster
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/diagnostics/types"
+	sdnapi "github.com/openshift/origin/pkg/sdn/api"
+)
+
+func hostSubnet(name, hostIP, subnet string) sdnapi.HostSubnet {
+	return sdnapi.HostSubnet{ObjectMeta: kapi.ObjectMeta{Name: name}, Host: name, HostIP: hostIP, Subnet: subnet}
+}
+
+func TestCheckHostSubnets(t *testing.T) {
+	networks := []sdnapi.ClusterNetworkEntry{
+		{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+		{CIDR: "10.132.0.0/16", HostSubnetLength: 8},
+	}
+	testCases := map[string]struct {
+		subnets  []sdnapi.HostSubnet
+		nodes    []kapi.Node
+		errors   []string
+		warnings []string
+	}{
+		"valid": {
+			subnets: []sdnapi.HostSubnet{
+				hostSubnet("node1", "192.168.1.1", "10.128.0.0/23"),
+				hostSubnet("node2", "192.168.1.2", "10.132.0.0/24"),
+			},
+			nodes: []kapi.Node{
+				createNode("node1", []string{"192.168.1.1"}),
+				createNode("node2", []string{"192.168.1.2"}),
+			},
+		},
+		"node without subnet": {
+			subnets: []sdnapi.HostSubnet{hostSubnet("node1", "192.168.1.1", "10.128.0.0/23")},
+			nodes: []kapi.Node{
+				createNode("node1", []string{"192.168.1.1"}),
+				createNode("node2", []string{"192.168.1.2"}),
+			},
+			errors: []string{"DClu4012"},
+		},
+		"subnet without node": {
+			subnets: []sdnapi.HostSubnet{
+				hostSubnet("node1", "192.168.1.1", "10.128.0.0/23"),
+				hostSubnet("gone", "192.168.1.2", "10.128.2.0/23"),
+			},
+			nodes:    []kapi.Node{createNode("node1", []string{"192.168.1.1"})},
+			warnings: []string{"DClu4010"},
+		},
+		"subnet outside the cluster networks": {
+			subnets: []sdnapi.HostSubnet{hostSubnet("node1", "192.168.1.1", "10.200.0.0/23")},
+			nodes:   []kapi.Node{createNode("node1", []string{"192.168.1.1"})},
+			errors:  []string{"DClu4008"},
+		},
+		"overlapping subnets": {
+			subnets: []sdnapi.HostSubnet{
+				hostSubnet("node1", "192.168.1.1", "10.128.0.0/23"),
+				hostSubnet("node2", "192.168.1.2", "10.128.1.0/24"),
+			},
+			nodes: []kapi.Node{
+				createNode("node1", []string{"192.168.1.1"}),
+				createNode("node2", []string{"192.168.1.2"}),
+			},
+			errors: []string{"DClu4009"},
+		},
+		"invalid subnet": {
+			subnets: []sdnapi.HostSubnet{hostSubnet("node1", "192.168.1.1", "10.128.0.0")},
+			nodes:   []kapi.Node{createNode("node1", []string{"192.168.1.1"})},
+			errors:  []string{"DClu4007"},
+		},
+		"host IP not an address of the node": {
+			subnets:  []sdnapi.HostSubnet{hostSubnet("node1", "192.168.1.9", "10.128.0.0/23")},
+			nodes:    []kapi.Node{createNode("node1", []string{"192.168.1.1"})},
+			warnings: []string{"DClu4011"},
+		},
+	}
+
+	for k, tc := range testCases {
+		r := types.NewDiagnosticResult(ClusterNetworkName)
+		checkHostSubnets(r, networks, tc.subnets, tc.nodes)
+		if actual := diagnosticIDs(r.Errors()); !reflect.DeepEqual(actual, sortedIDs(tc.errors)) {
+			t.Errorf("%s: expected errors %v, got %v", k, tc.errors, actual)
+		}
+		if actual := diagnosticIDs(r.Warnings()); !reflect.DeepEqual(actual, sortedIDs(tc.warnings)) {
+			t.Errorf("%s: expected warnings %v, got %v", k, tc.warnings, actual)
+		}
+	}
+}
+
+func diagnosticIDs(errs []types.DiagnosticError) []string {
+	ids := []string{}
+	for _, err := range errs {
+		ids = append(ids, err.ID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func sortedIDs(ids []string) []string {
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+	return sorted
+}
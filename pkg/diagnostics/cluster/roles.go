@@ -55,12 +55,16 @@ func (d *ClusterRoles) Check() types.DiagnosticResult {
 		RoleClient: d.ClusterRolesClient.ClusterRoles(),
 	}
 
-	changedClusterRoles, err := reconcileOptions.ChangedClusterRoles()
+	changedClusterRoles, skippedClusterRoles, err := reconcileOptions.ChangedClusterRoles()
 	if err != nil {
 		r.Error("CRD1000", err, fmt.Sprintf("Error inspecting ClusterRoles: %v", err))
 		return r
 	}
 
+	for _, skippedClusterRole := range skippedClusterRoles {
+		r.Info("CRD1009", fmt.Sprintf("clusterrole/%s differs from the recommended policy, but is protected from reconciliation by the %s annotation.", skippedClusterRole, authorizationapi.ReconcileProtectAnnotation))
+	}
+
 	// success
 	if len(changedClusterRoles) == 0 {
 		return r
@@ -0,0 +1,150 @@
+package host
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	configapilatest "github.com/openshift/origin/pkg/cmd/server/api/latest"
+	"github.com/openshift/origin/pkg/diagnostics/log"
+	"github.com/openshift/origin/pkg/diagnostics/types"
+)
+
+const (
+	ovsSubnetPluginName      = "redhat/openshift-ovs-subnet"
+	ovsMultiTenantPluginName = "redhat/openshift-ovs-multitenant"
+
+	sdnBridgeNotSetUp = `The OpenFlow rules of bridge br0 are missing or incomplete:
+{{.missing}}
+The node sets up the bridge when it starts. Check the node logs for errors
+from the SDN setup, and restart the node to set the bridge up again.`
+
+	sdnNoRemoteNodes = `Bridge br0 has no VXLAN rules for other nodes. Pods on this node
+cannot reach pods on other nodes. This is expected if this is the only
+node of the cluster; otherwise check that the node can watch HostSubnets
+on the master.`
+)
+
+// flowDumper returns the OpenFlow rules of the SDN bridge, one per line
+type flowDumper func() (string, error)
+
+func dumpBridgeFlows() (string, error) {
+	out, err := exec.Command("ovs-ofctl", "-O", "OpenFlow13", "dump-flows", "br0").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%v: %s", err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
+
+// NodeSDNFlows is a Diagnostic to check that the Open vSwitch bridge of an openshift-sdn node is set up
+type NodeSDNFlows struct {
+	NodeConfigFile string
+
+	// DumpFlows may be set to replace the call to ovs-ofctl
+	DumpFlows flowDumper
+}
+
+const NodeSDNFlowsName = "NodeSDNFlows"
+
+func (d NodeSDNFlows) Name() string {
+	return NodeSDNFlowsName
+}
+
+func (d NodeSDNFlows) Description() string {
+	return "Check the Open vSwitch flows of the node SDN bridge"
+}
+
+func (d NodeSDNFlows) CanRun() (bool, error) {
+	if len(d.NodeConfigFile) == 0 {
+		return false, errors.New("must have node config file")
+	}
+	if d.DumpFlows == nil {
+		if _, err := exec.LookPath("ovs-ofctl"); err != nil {
+			return false, errors.New("ovs-ofctl is not installed on this host")
+		}
+	}
+	return true, nil
+}
+
+func (d NodeSDNFlows) Check() types.DiagnosticResult {
+	r := types.NewDiagnosticResult(NodeSDNFlowsName)
+	nodeConfig, err := configapilatest.ReadAndResolveNodeConfig(d.NodeConfigFile)
+	if err != nil {
+		r.Error("DH1101", err, fmt.Sprintf("Could not read node config file '%s':\n(%T) %[2]v", d.NodeConfigFile, err))
+		return r
+	}
+	pluginName := nodeConfig.NetworkConfig.NetworkPluginName
+	if pluginName != ovsSubnetPluginName && pluginName != ovsMultiTenantPluginName {
+		r.Info("DH1102", fmt.Sprintf("The node does not use an openshift-sdn network plugin: %q", pluginName))
+		return r
+	}
+
+	dumpFlows := d.DumpFlows
+	if dumpFlows == nil {
+		dumpFlows = dumpBridgeFlows
+	}
+	flows, err := dumpFlows()
+	if err != nil {
+		r.Error("DH1103", err, fmt.Sprintf("Could not read the OpenFlow rules of bridge br0. Open vSwitch may not be running, or this command may need to run as root:\n%v", err))
+		return r
+	}
+
+	checkSDNFlows(r, pluginName == ovsMultiTenantPluginName, strings.Split(flows, "\n"))
+	return r
+}
+
+// checkSDNFlows looks for the rules that the node adds when it sets up the bridge, and for the
+// rules it adds for each remote node.
+func checkSDNFlows(r types.DiagnosticResult, multitenant bool, flows []string) {
+	required := []struct {
+		description string
+		matches     func(flow string) bool
+	}{
+		{"VXLAN input (table 0)", func(flow string) bool {
+			return strings.Contains(flow, "table=0") && strings.Contains(flow, "goto_table:1")
+		}},
+		{"local subnet delivery (table 3)", func(flow string) bool {
+			if !strings.Contains(flow, "table=3") {
+				return false
+			}
+			if multitenant {
+				return strings.Contains(flow, "NXM_NX_TUN_ID")
+			}
+			return strings.Contains(flow, "goto_table:9")
+		}},
+		{"cluster network routing (table 6)", func(flow string) bool {
+			return strings.Contains(flow, "table=6") && strings.Contains(flow, "goto_table:8")
+		}},
+	}
+
+	missing := []string{}
+	for _, rule := range required {
+		found := false
+		for _, flow := range flows {
+			if rule.matches(flow) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, "    "+rule.description)
+		}
+	}
+	if len(missing) > 0 {
+		r.Error("DH1104", nil, log.EvalTemplate("DH1104", sdnBridgeNotSetUp, log.Hash{"missing": strings.Join(missing, "\n")}))
+		return
+	}
+
+	remoteNodes := 0
+	for _, flow := range flows {
+		if strings.Contains(flow, "table=8") && strings.Contains(flow, "tun_dst") {
+			remoteNodes++
+		}
+	}
+	if remoteNodes == 0 {
+		r.Warn("DH1105", nil, sdnNoRemoteNodes)
+		return
+	}
+	r.Info("DH1106", fmt.Sprintf("Bridge br0 is set up and routes pod traffic to %d other nodes", remoteNodes))
+}
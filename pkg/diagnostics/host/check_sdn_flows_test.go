@@ -0,0 +1,59 @@
+package host
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/diagnostics/types"
+)
+
+const (
+	baseFlows = ` cookie=0x0, duration=10.1s, table=0, n_packets=0, n_bytes=0, priority=100,tun_src=0.0.0.0 actions=goto_table:1
+ cookie=0x0, duration=10.1s, table=3, n_packets=0, n_bytes=0, priority=100,ip,nw_dst=10.128.0.0/23 actions=goto_table:9
+ cookie=0x0, duration=10.1s, table=6, n_packets=0, n_bytes=0, priority=100,ip,nw_dst=10.128.0.0/14 actions=goto_table:8`
+	multitenantFlows = ` cookie=0x0, duration=10.1s, table=0, n_packets=0, n_bytes=0, priority=100,tun_src=0.0.0.0 actions=goto_table:1
+ cookie=0x0, duration=10.1s, table=3, n_packets=0, n_bytes=0, priority=100,ip,nw_dst=10.128.0.0/23 actions=move:NXM_NX_TUN_ID[0..31]->NXM_NX_REG0[],goto_table:6
+ cookie=0x0, duration=10.1s, table=6, n_packets=0, n_bytes=0, priority=100,ip,nw_dst=10.128.0.0/14 actions=goto_table:8`
+	remoteNodeFlow = ` cookie=0xc0a80102, duration=9.2s, table=8, n_packets=0, n_bytes=0, priority=100,ip,nw_dst=10.128.2.0/23 actions=move:NXM_NX_REG0[]->NXM_NX_TUN_ID[0..31],set_field:192.168.1.2->tun_dst,output:1`
+)
+
+func TestCheckSDNFlows(t *testing.T) {
+	testCases := map[string]struct {
+		multitenant bool
+		flows       string
+		errors      int
+		warnings    int
+	}{
+		"set up": {
+			flows: baseFlows + "\n" + remoteNodeFlow,
+		},
+		"multitenant set up": {
+			multitenant: true,
+			flows:       multitenantFlows + "\n" + remoteNodeFlow,
+		},
+		"single tenant flows with multitenant plugin": {
+			multitenant: true,
+			flows:       baseFlows + "\n" + remoteNodeFlow,
+			errors:      1,
+		},
+		"empty bridge": {
+			flows:  "",
+			errors: 1,
+		},
+		"no remote nodes": {
+			flows:    baseFlows,
+			warnings: 1,
+		},
+	}
+
+	for k, tc := range testCases {
+		r := types.NewDiagnosticResult(NodeSDNFlowsName)
+		checkSDNFlows(r, tc.multitenant, strings.Split(tc.flows, "\n"))
+		if len(r.Errors()) != tc.errors {
+			t.Errorf("%s: expected %d errors, got %v", k, tc.errors, r.Errors())
+		}
+		if len(r.Warnings()) != tc.warnings {
+			t.Errorf("%s: expected %d warnings, got %v", k, tc.warnings, r.Warnings())
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// structuredEntry is the serialized form of an Entry for the machine readable output formats.
+type structuredEntry struct {
+	ID      string `json:"id"`
+	Origin  string `json:"origin"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+func newStructuredEntry(entry Entry) structuredEntry {
+	return structuredEntry{
+		ID:      entry.ID,
+		Origin:  entry.Origin,
+		Level:   entry.Level.Name,
+		Message: strings.TrimSpace(entry.Message),
+	}
+}
+
+// jsonLogger writes one JSON object per line so that output can be processed as it is produced.
+type jsonLogger struct {
+	out io.Writer
+}
+
+func newJSONLogger(out io.Writer) *jsonLogger {
+	return &jsonLogger{out: out}
+}
+
+func (j *jsonLogger) Write(entry Entry) {
+	b, err := json.Marshal(newStructuredEntry(entry))
+	if err != nil {
+		fmt.Fprintf(j.out, "{\"id\":%q,\"level\":\"error\",\"message\":%q}\n", entry.ID, err.Error())
+		return
+	}
+	fmt.Fprintln(j.out, string(b))
+}
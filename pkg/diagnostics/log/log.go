@@ -50,10 +50,28 @@ type loggerInterface interface {
 	Write(Entry)
 }
 
-func NewLogger(setLevel int, setFormat string, out io.Writer) (*Logger, error) {
-	logger := newTextLogger(out)
+// Output formats supported by the Logger
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+	YAMLFormat = "yaml"
+)
 
+func NewLogger(setLevel int, setFormat string, out io.Writer) (*Logger, error) {
+	var logger loggerInterface
 	var err error = nil
+	switch setFormat {
+	case JSONFormat:
+		logger = newJSONLogger(out)
+	case YAMLFormat:
+		logger = newYAMLLogger(out)
+	case TextFormat, "":
+		logger = newTextLogger(out)
+	default:
+		logger = newTextLogger(out)
+		err = fmt.Errorf("Invalid diagnostic output format %q; must be one of %s, %s, %s", setFormat, TextFormat, JSONFormat, YAMLFormat)
+	}
+
 	level := DebugLevel
 	switch setLevel {
 	case ErrorLevel.Level:
@@ -67,7 +85,9 @@ func NewLogger(setLevel int, setFormat string, out io.Writer) (*Logger, error) {
 	case DebugLevel.Level:
 		// Debug, also default for invalid numbers below
 	default:
-		err = errors.New("Invalid diagnostic level; must be 0-4")
+		if err == nil {
+			err = errors.New("Invalid diagnostic level; must be 0-4")
+		}
 	}
 
 	return &Logger{
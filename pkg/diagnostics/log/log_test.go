@@ -0,0 +1,62 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewLoggerFormats(t *testing.T) {
+	testCases := map[string]struct {
+		format      string
+		expected    string
+		expectedErr bool
+	}{
+		"default": {
+			format:   "",
+			expected: "WARN:  [DL9001 from here]\n       something happened\n       \n",
+		},
+		"json": {
+			format:   JSONFormat,
+			expected: `{"id":"DL9001","origin":"here","level":"warn","message":"something happened"}` + "\n",
+		},
+		"yaml": {
+			format:   YAMLFormat,
+			expected: "---\nid: DL9001\nlevel: warn\nmessage: something happened\norigin: here\n",
+		},
+		"invalid": {
+			format:      "xml",
+			expectedErr: true,
+		},
+	}
+
+	for k, tc := range testCases {
+		out := &bytes.Buffer{}
+		logger, err := NewLogger(InfoLevel.Level, tc.format, out)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", k)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		logger.LogEntry(Entry{ID: "DL9001", Origin: "here", Level: WarnLevel, Message: "something happened\n"})
+		if out.String() != tc.expected {
+			t.Errorf("%s: expected %q, got %q", k, tc.expected, out.String())
+		}
+	}
+}
+
+func TestLoggerLevel(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger, err := NewLogger(WarnLevel.Level, JSONFormat, out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	logger.Info("DL9002", "not shown")
+	if out.Len() != 0 {
+		t.Errorf("expected entries below the level to be skipped, got %q", out.String())
+	}
+}
@@ -0,0 +1,26 @@
+package log
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ghodss/yaml"
+)
+
+// yamlLogger writes each entry as a separate YAML document.
+type yamlLogger struct {
+	out io.Writer
+}
+
+func newYAMLLogger(out io.Writer) *yamlLogger {
+	return &yamlLogger{out: out}
+}
+
+func (y *yamlLogger) Write(entry Entry) {
+	b, err := yaml.Marshal(newStructuredEntry(entry))
+	if err != nil {
+		fmt.Fprintf(y.out, "---\nid: %q\nlevel: error\nmessage: %q\n", entry.ID, err.Error())
+		return
+	}
+	fmt.Fprint(y.out, "---\n"+string(b))
+}
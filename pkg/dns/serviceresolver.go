@@ -11,6 +11,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/util/validation"
 
 	"github.com/skynetservices/skydns/msg"
 	"github.com/skynetservices/skydns/server"
@@ -62,10 +63,11 @@ func NewServiceResolver(config *server.Config, accessor ServiceAccessor, endpoin
 //   * reverse lookup of IP is only possible for portalIP
 //   * SRV records are returned for each host+port combination as:
 //     _<port_name>._<port_protocol>.<dns>
-//     _<port_name>.<endpoint_id>.<dns>
+//   * _<port_name>._<port_protocol>.<dns> returns only the SRV record of that port
 // * endpoints always returns each individual endpoint as A records
-//   * SRV records for endpoints are similar to SVC, but are prefixed with a single label
-//     that is a hash of the endpoint IP
+//   * SRV records for endpoints and headless services point to <endpoint_id>.<dns>, where
+//     endpoint_id is the name of the pod backing the endpoint or a hash of the endpoint IP
+//   * <endpoint_id>.<dns> resolves to that endpoint only
 // * pods is of the form <IP_with_dashes>.<namespace>.pod.<base> and resolves to <IP>
 //
 func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, error) {
@@ -126,7 +128,26 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 
 		subdomain := buildDNSName(b.base, base, namespace, name)
 		endpointPrefix := base == "endpoints"
-		retrieveEndpoints := endpointPrefix || (len(segments) > 3 && segments[3] == "_endpoints")
+		retrieveEndpoints := endpointPrefix
+
+		// the labels in front of the service name select the endpoints or ports to return
+		rest := segments[3:]
+		if len(rest) > 0 && rest[0] == "_endpoints" {
+			retrieveEndpoints = true
+			rest = rest[1:]
+		}
+		var portFilter *portSelector
+		if len(rest) >= 2 && strings.HasPrefix(rest[0], "_") && strings.HasPrefix(rest[1], "_") {
+			portFilter = &portSelector{
+				protocol: kapi.Protocol(strings.ToUpper(rest[0][1:])),
+				name:     rest[1][1:],
+			}
+			rest = rest[2:]
+		}
+		endpointLabel := ""
+		if len(rest) == 1 {
+			endpointLabel = rest[0]
+		}
 
 		// if has a portal IP and looking at svc
 		if svc.Spec.ClusterIP != kapi.ClusterIPNone && !retrieveEndpoints {
@@ -147,7 +168,7 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 			}
 
 			services := []msg.Service{}
-			if len(segments) == 3 {
+			if len(segments) == 3 || portFilter != nil {
 				for _, p := range svc.Spec.Ports {
 					port := p.Port
 					if port == 0 {
@@ -163,6 +184,9 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 					if len(portName) == 0 {
 						portName = fmt.Sprintf("unknown-port-%d", port)
 					}
+					if !portFilter.matches(portName, p.Protocol) {
+						continue
+					}
 					keyName := buildDNSName(subdomain, "_"+strings.ToLower(string(p.Protocol)), "_"+portName)
 					services = append(services,
 						msg.Service{
@@ -179,6 +203,9 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 				}
 			}
 			if len(services) == 0 {
+				if portFilter != nil {
+					return nil, nil
+				}
 				services = append(services, defaultService)
 			}
 			glog.V(4).Infof("Answered %s:%t with %#v", dnsName, exact, services)
@@ -191,9 +218,26 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 			return nil, err
 		}
 
+		// a single label in front of the service name selects one endpoint, so that each pod of a
+		// headless service can be resolved by its peers. Labels that match no endpoint are wildcards.
+		selectEndpoint := false
+		if len(endpointLabel) > 0 {
+			for _, s := range endpoints.Subsets {
+				for _, a := range s.Addresses {
+					if endpointMatches(a, endpointLabel) {
+						selectEndpoint = true
+					}
+				}
+			}
+		}
+
 		services := make([]msg.Service, 0, len(endpoints.Subsets)*4)
 		for _, s := range endpoints.Subsets {
 			for _, a := range s.Addresses {
+				if selectEndpoint && !endpointMatches(a, endpointLabel) {
+					continue
+				}
+				endpointName := buildDNSName(subdomain, getEndpointLabel(a))
 				defaultService := msg.Service{
 					Host: a.IP,
 					Port: 0,
@@ -201,10 +245,9 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 					Priority: 10,
 					Weight:   10,
 					Ttl:      30,
+
+					Key: msg.Path(endpointName),
 				}
-				defaultHash := getHash(defaultService.Host)
-				defaultName := buildDNSName(subdomain, defaultHash)
-				defaultService.Key = msg.Path(defaultName)
 
 				for _, p := range s.Ports {
 					port := p.Port
@@ -218,8 +261,10 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 					if len(portName) == 0 {
 						portName = fmt.Sprintf("unknown-port-%d", port)
 					}
+					if !portFilter.matches(portName, p.Protocol) {
+						continue
+					}
 
-					keyName := buildDNSName(subdomain, "_"+strings.ToLower(string(p.Protocol)), "_"+portName, defaultHash)
 					services = append(services, msg.Service{
 						Host: a.IP,
 						Port: port,
@@ -228,10 +273,10 @@ func (b *ServiceResolver) Records(dnsName string, exact bool) ([]msg.Service, er
 						Weight:   10,
 						Ttl:      30,
 
-						Key: msg.Path(keyName),
+						Key: msg.Path(endpointName),
 					})
 				}
-				if len(services) == 0 {
+				if len(services) == 0 && portFilter == nil {
 					services = append(services, defaultService)
 				}
 			}
@@ -311,6 +356,36 @@ func getHash(text string) string {
 	return fmt.Sprintf("%x", h.Sum32())
 }
 
+// portSelector restricts the records of a service to a single named port. A nil selector
+// matches every port.
+type portSelector struct {
+	protocol kapi.Protocol
+	name     string
+}
+
+// matches returns true if the port with the given name and protocol is selected
+func (p *portSelector) matches(name string, protocol kapi.Protocol) bool {
+	if p == nil {
+		return true
+	}
+	return p.name == name && p.protocol == protocol
+}
+
+// getEndpointLabel returns the DNS label of an endpoint address: the name of the pod backing the
+// endpoint if it is a valid DNS label, otherwise a hash of the endpoint IP.
+func getEndpointLabel(address kapi.EndpointAddress) string {
+	if ref := address.TargetRef; ref != nil && ref.Kind == "Pod" && validation.IsDNS1123Label(ref.Name) {
+		return ref.Name
+	}
+	return getHash(address.IP)
+}
+
+// endpointMatches returns true if label names the endpoint address, either by its pod name or by the
+// hash of its IP.
+func endpointMatches(address kapi.EndpointAddress, label string) bool {
+	return label == getEndpointLabel(address) || label == getHash(address.IP)
+}
+
 // convertDashIPToIP takes an encoded IP (with dashes) and replaces them with
 // dots.
 func convertDashIPToIP(ip string) string {
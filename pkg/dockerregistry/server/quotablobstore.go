@@ -0,0 +1,230 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/hashicorp/golang-lru"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/quota/evaluator"
+)
+
+// imageSizes is a shared cache of the storage used by images, which never changes once an image
+// was created. Images that were not pushed to this registry use no storage.
+var imageSizes *lru.Cache
+
+// uploadedBlobs are the blobs uploaded to each namespace that are not part of an image yet.
+var uploadedBlobs = newPendingBlobs(pendingBlobTTL)
+
+// pendingBlobTTL is how long an uploaded blob counts against the quota of its namespace if no
+// image that contains it is pushed.
+const pendingBlobTTL = time.Hour
+
+func init() {
+	cache, err := lru.New(4096)
+	if err != nil {
+		panic(err)
+	}
+	imageSizes = cache
+}
+
+var (
+	quotaClientOnce sync.Once
+	quotaClient     kclient.ResourceQuotasNamespacer
+	quotaClientErr  error
+)
+
+// registryQuotaClient returns the client that reads the resource quotas of projects. It is
+// created once and shared by every repository.
+func registryQuotaClient() (kclient.ResourceQuotasNamespacer, error) {
+	quotaClientOnce.Do(func() {
+		quotaClient, quotaClientErr = NewRegistryKubeClient()
+	})
+	return quotaClient, quotaClientErr
+}
+
+// quotaExceededError is returned when a blob would exceed the image storage quota of a project.
+// The registry reports it as the detail of an unknown error, which is serialized as a string.
+type quotaExceededError string
+
+func (e quotaExceededError) Error() string {
+	return string(e)
+}
+
+// quotaBlobStore wraps a distribution.BlobStore and rejects uploads of blobs that would take the
+// images of a project beyond the storage allowed by its resource quotas.
+type quotaBlobStore struct {
+	distribution.BlobStore
+
+	repo *repository
+}
+
+var _ distribution.BlobStore = &quotaBlobStore{}
+
+// Create begins an upload whose size is checked against the quota when it is committed.
+func (bs *quotaBlobStore) Create(ctx context.Context) (distribution.BlobWriter, error) {
+	bw, err := bs.BlobStore.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &quotaBlobWriter{BlobWriter: bw, repo: bs.repo}, nil
+}
+
+// Resume continues an upload whose size is checked against the quota when it is committed.
+func (bs *quotaBlobStore) Resume(ctx context.Context, id string) (distribution.BlobWriter, error) {
+	bw, err := bs.BlobStore.Resume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &quotaBlobWriter{BlobWriter: bw, repo: bs.repo}, nil
+}
+
+// quotaBlobWriter wraps a distribution.BlobWriter and checks the quota before committing.
+type quotaBlobWriter struct {
+	distribution.BlobWriter
+
+	repo *repository
+}
+
+// Commit stores the blob if its size fits in the image storage quota of the project.
+func (bw *quotaBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (distribution.Descriptor, error) {
+	size, err := bw.Seek(0, os.SEEK_END)
+	if err != nil {
+		return distribution.Descriptor{}, err
+	}
+	if err := bw.repo.checkImageStorage(size); err != nil {
+		context.GetLogger(ctx).Errorf("Rejected blob upload to %s/%s: %v", bw.repo.namespace, bw.repo.name, err)
+		return distribution.Descriptor{}, err
+	}
+	desc, err := bw.BlobWriter.Commit(ctx, provisional)
+	if err != nil {
+		return desc, err
+	}
+	uploadedBlobs.add(bw.repo.namespace, desc.Digest, size)
+	return desc, nil
+}
+
+// checkImageStorage returns an error if adding size bytes to the images of the namespace of the
+// repository would exceed the image storage allowed by any of its resource quotas.
+func (r *repository) checkImageStorage(size int64) error {
+	quotas, err := r.quotaClient.ResourceQuotas(r.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var limit *resource.Quantity
+	for i := range quotas.Items {
+		hard, ok := quotas.Items[i].Spec.Hard[imageapi.ResourceImageStorage]
+		if ok && (limit == nil || hard.Cmp(*limit) < 0) {
+			limit = &hard
+		}
+	}
+	if limit == nil {
+		return nil
+	}
+
+	streams, err := r.registryClient.ImageStreams(r.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	used, err := evaluator.ImageStorageUsage(streams.Items, r.imageSize)
+	if err != nil {
+		return err
+	}
+	// the layers of a push are uploaded before its image exists
+	used += uploadedBlobs.size(r.namespace)
+	if used+size > limit.Value() {
+		return quotaExceededError(fmt.Sprintf("a blob of %s exceeds the image storage quota of project %s: %s of %s are used",
+			resource.NewQuantity(size, resource.BinarySI), r.namespace, resource.NewQuantity(used, resource.BinarySI), limit))
+	}
+	return nil
+}
+
+// imageSize returns the storage used by the image with name in this registry.
+func (r *repository) imageSize(name string) (int64, error) {
+	if size, ok := imageSizes.Get(name); ok {
+		return size.(int64), nil
+	}
+	image, err := r.registryClient.Images().Get(name)
+	if kerrors.IsNotFound(err) {
+		// the image was pruned and its layers will be deleted
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	size := evaluator.ImageStorage(image)
+	imageSizes.Add(name, size)
+	return size, nil
+}
+
+// pendingBlobs remembers the size of the blobs uploaded to each namespace until an image that
+// contains them is pushed, so that every layer of a push counts against the quota of the namespace
+// and not only the layer being uploaded. The blobs of an abandoned push are forgotten after ttl.
+// The blobs are only known to the registry instance they were uploaded to.
+type pendingBlobs struct {
+	lock  sync.Mutex
+	ttl   time.Duration
+	blobs map[string]map[digest.Digest]pendingBlob
+}
+
+type pendingBlob struct {
+	size     int64
+	uploaded time.Time
+}
+
+func newPendingBlobs(ttl time.Duration) *pendingBlobs {
+	return &pendingBlobs{ttl: ttl, blobs: make(map[string]map[digest.Digest]pendingBlob)}
+}
+
+// add records a blob uploaded to the namespace.
+func (p *pendingBlobs) add(namespace string, dgst digest.Digest, size int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.blobs[namespace] == nil {
+		p.blobs[namespace] = make(map[digest.Digest]pendingBlob)
+	}
+	p.blobs[namespace][dgst] = pendingBlob{size: size, uploaded: time.Now()}
+}
+
+// remove forgets the blobs of an image pushed to the namespace, which are now counted as part of
+// the image.
+func (p *pendingBlobs) remove(namespace string, dgsts ...digest.Digest) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, dgst := range dgsts {
+		delete(p.blobs[namespace], dgst)
+	}
+	if len(p.blobs[namespace]) == 0 {
+		delete(p.blobs, namespace)
+	}
+}
+
+// size returns the total size of the blobs uploaded to the namespace that are not part of an image,
+// and forgets the blobs that expired.
+func (p *pendingBlobs) size(namespace string) int64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var size int64
+	for dgst, blob := range p.blobs[namespace] {
+		if time.Since(blob.uploaded) > p.ttl {
+			delete(p.blobs[namespace], dgst)
+			continue
+		}
+		size += blob.size
+	}
+	if len(p.blobs[namespace]) == 0 {
+		delete(p.blobs, namespace)
+	}
+	return size
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution/digest"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func testImage(name string, size int64, managed bool) *imageapi.Image {
+	image := &imageapi.Image{
+		ObjectMeta:          kapi.ObjectMeta{Name: name},
+		DockerImageMetadata: imageapi.DockerImage{Size: size},
+	}
+	if managed {
+		image.Annotations = map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"}
+	}
+	return image
+}
+
+func testStreamWithImages(name string, images ...string) imageapi.ImageStream {
+	events := []imageapi.TagEvent{}
+	for _, image := range images {
+		events = append(events, imageapi.TagEvent{Image: image})
+	}
+	return imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "test"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{"latest": {Items: events}},
+		},
+	}
+}
+
+func TestCheckImageStorage(t *testing.T) {
+	images := map[string]*imageapi.Image{
+		"sha256:pushed-1": testImage("sha256:pushed-1", 400, true),
+		"sha256:pushed-2": testImage("sha256:pushed-2", 300, true),
+		"sha256:imported": testImage("sha256:imported", 10000, false),
+	}
+	client := testclient.NewSimpleFake(&imageapi.ImageStreamList{Items: []imageapi.ImageStream{
+		testStreamWithImages("app", "sha256:pushed-1", "sha256:imported"),
+		testStreamWithImages("other", "sha256:pushed-1", "sha256:pushed-2"),
+	}})
+	client.PrependReactor("get", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, images[action.(ktestclient.GetAction).GetName()], nil
+	})
+
+	tests := []struct {
+		name   string
+		quotas []kapi.ResourceQuota
+		size   int64
+		fits   bool
+	}{
+		{
+			name: "no quota",
+			size: 1000000,
+			fits: true,
+		},
+		{
+			name:   "quota without image storage",
+			quotas: []kapi.ResourceQuota{{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{kapi.ResourcePods: resource.MustParse("1")}}}},
+			size:   1000000,
+			fits:   true,
+		},
+		{
+			name:   "blob fits",
+			quotas: []kapi.ResourceQuota{{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("1000")}}}},
+			size:   300,
+			fits:   true,
+		},
+		{
+			name:   "blob exceeds the quota",
+			quotas: []kapi.ResourceQuota{{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("1000")}}}},
+			size:   301,
+		},
+		{
+			name: "blob exceeds the smallest quota",
+			quotas: []kapi.ResourceQuota{
+				{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("1000")}}},
+				{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("800")}}},
+			},
+			size: 200,
+		},
+	}
+
+	for _, test := range tests {
+		r := &repository{
+			registryClient: client,
+			quotaClient:    ktestclient.NewSimpleFake(&kapi.ResourceQuotaList{Items: test.quotas}),
+			namespace:      "test",
+			name:           "app",
+		}
+		err := r.checkImageStorage(test.size)
+		switch {
+		case test.fits && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case !test.fits && err == nil:
+			t.Errorf("%s: expected the blob to be rejected", test.name)
+		case !test.fits && !strings.Contains(err.Error(), "image storage quota of project test"):
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestCheckImageStorageCountsUploadedBlobs(t *testing.T) {
+	client := testclient.NewSimpleFake(&imageapi.ImageStreamList{Items: []imageapi.ImageStream{
+		testStreamWithImages("app", "sha256:pushed-1"),
+	}})
+	client.PrependReactor("get", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, testImage("sha256:pushed-1", 400, true), nil
+	})
+	quota := kapi.ResourceQuota{Spec: kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("1000")}}}
+	r := &repository{
+		registryClient: client,
+		quotaClient:    ktestclient.NewSimpleFake(&kapi.ResourceQuotaList{Items: []kapi.ResourceQuota{quota}}),
+		namespace:      "uploads",
+		name:           "app",
+	}
+
+	uploadedBlobs.add("uploads", digest.Digest("sha256:layer-1"), 300)
+	uploadedBlobs.add("uploads", digest.Digest("sha256:layer-2"), 200)
+	defer uploadedBlobs.remove("uploads", "sha256:layer-1", "sha256:layer-2")
+	if err := r.checkImageStorage(200); err == nil {
+		t.Errorf("expected the blobs uploaded before the image was pushed to count against the quota")
+	}
+
+	uploadedBlobs.remove("uploads", "sha256:layer-1")
+	if err := r.checkImageStorage(200); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPendingBlobsExpire(t *testing.T) {
+	blobs := newPendingBlobs(time.Hour)
+	blobs.add("test", "sha256:layer-1", 100)
+	blobs.add("test", "sha256:layer-2", 200)
+	blobs.add("other", "sha256:layer-1", 400)
+	if size := blobs.size("test"); size != 300 {
+		t.Errorf("expected 300 bytes, got %d", size)
+	}
+	blobs.remove("test", "sha256:layer-2")
+	if size := blobs.size("test"); size != 100 {
+		t.Errorf("expected 100 bytes, got %d", size)
+	}
+
+	expired := newPendingBlobs(0)
+	expired.add("test", "sha256:layer-1", 100)
+	if size := expired.size("test"); size != 0 {
+		t.Errorf("expected expired blobs not to count, got %d", size)
+	}
+	if len(expired.blobs) != 0 {
+		t.Errorf("expected expired blobs to be forgotten, got %#v", expired.blobs)
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
 	"github.com/openshift/origin/pkg/client"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -50,6 +51,9 @@ type repository struct {
 	// if true, the repository will check remote references in the image stream to support pulling "through"
 	// from a remote repository
 	pullthrough bool
+	// if true, blobs are only accepted if they fit in the image storage quota of the project
+	enforceQuota bool
+	quotaClient  kclient.ResourceQuotasNamespacer
 	// cachedLayers remembers a mapping of layer digest to repositories recently seen with that image to avoid
 	// having to check every potential upstream repository when a blob request is made. The cache is useful only
 	// when session affinity is on for the registry, but in practice the first pull will fill the cache.
@@ -77,6 +81,21 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 		return nil, err
 	}
 
+	enforceQuota := false
+	if value, ok := options["enforcequota"]; ok {
+		if b, ok := value.(bool); ok {
+			enforceQuota = b
+		}
+	}
+
+	var quotaClient kclient.ResourceQuotasNamespacer
+	if enforceQuota {
+		quotaClient, err = registryQuotaClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	nameParts := strings.SplitN(repo.Name(), "/", 2)
 	if len(nameParts) != 2 {
 		return nil, fmt.Errorf("invalid repository name %q: it must be of the format <project>/<name>", repo.Name())
@@ -91,6 +110,8 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 		namespace:      nameParts[0],
 		name:           nameParts[1],
 		pullthrough:    pullthrough,
+		enforceQuota:   enforceQuota,
+		quotaClient:    quotaClient,
 		cachedLayers:   cachedLayers,
 	}, nil
 }
@@ -105,16 +126,25 @@ func (r *repository) Manifests(ctx context.Context, options ...distribution.Mani
 	return &repo, nil
 }
 
-// Blobs returns a blob store which can delegate to remote repositories.
+// Blobs returns a blob store which can delegate to remote repositories, and which enforces the
+// image storage quota of the project.
 func (r *repository) Blobs(ctx context.Context) distribution.BlobStore {
+	repo := repository(*r)
+	repo.ctx = ctx
+
+	bs := r.Repository.Blobs(ctx)
+	if r.enforceQuota {
+		bs = &quotaBlobStore{
+			BlobStore: bs,
+			repo:      &repo,
+		}
+	}
 	if !r.pullthrough {
-		return r.Repository.Blobs(ctx)
+		return bs
 	}
 
-	repo := repository(*r)
-	repo.ctx = ctx
 	return &pullthroughBlobStore{
-		BlobStore: r.Repository.Blobs(ctx),
+		BlobStore: bs,
 
 		repo:          &repo,
 		digestToStore: make(map[string]distribution.BlobStore),
@@ -357,6 +387,14 @@ func (r *repository) Put(manifest *schema1.SignedManifest) error {
 		}
 	}
 
+	if r.enforceQuota {
+		layers := []digest.Digest{}
+		for _, layer := range manifest.FSLayers {
+			layers = append(layers, layer.BlobSum)
+		}
+		uploadedBlobs.remove(r.namespace, layers...)
+	}
+
 	// Grab each json signature and store them.
 	signatures, err := manifest.Signatures()
 	if err != nil {
@@ -0,0 +1,120 @@
+package storageadmin
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+)
+
+// Migration counts the files a migration between storage backends copied.
+type Migration struct {
+	// Copied is the number of files copied entirely.
+	Copied int
+	// Resumed is the number of files whose copy was interrupted and has been completed.
+	Resumed int
+	// Skipped is the number of files that were already copied.
+	Skipped int
+	// Bytes is the number of bytes copied.
+	Bytes int64
+}
+
+// Migrate copies the contents of the storage of from to the storage of to, and writes the path of
+// every file it copies to out. A migration can be run again to complete a copy that was
+// interrupted: files are resumed from the size of their partial copy, and files that were
+// already copied are skipped. Links are compared by content, since moving a tag does not change
+// their size. Uploads in progress are not copied, so the registry should not accept pushes
+// during the final run.
+func Migrate(ctx context.Context, from, to storagedriver.StorageDriver, out io.Writer) (*Migration, error) {
+	migration := &Migration{}
+	err := walk(ctx, from, "/", func(fileInfo storagedriver.FileInfo) error {
+		p := fileInfo.Path()
+		if fileInfo.IsDir() {
+			if path.Base(p) == "_uploads" {
+				return storage.ErrSkipDir
+			}
+			return nil
+		}
+		if path.Base(p) == "link" {
+			return migrateContent(ctx, from, to, p, migration, out)
+		}
+		return migrateStream(ctx, from, to, fileInfo, migration, out)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return migration, nil
+}
+
+// migrateContent copies the small file at p if its copy differs.
+func migrateContent(ctx context.Context, from, to storagedriver.StorageDriver, p string, migration *Migration, out io.Writer) error {
+	content, err := from.GetContent(ctx, p)
+	if err != nil {
+		return err
+	}
+	existing, err := to.GetContent(ctx, p)
+	if err == nil && bytes.Equal(content, existing) {
+		migration.Skipped++
+		return nil
+	}
+	if _, ok := err.(storagedriver.PathNotFoundError); err != nil && !ok {
+		return err
+	}
+	if err := to.PutContent(ctx, p, content); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "copied %s\n", p)
+	migration.Copied++
+	migration.Bytes += int64(len(content))
+	return nil
+}
+
+// migrateStream copies the file described by fileInfo, starting from the end of a partial copy.
+func migrateStream(ctx context.Context, from, to storagedriver.StorageDriver, fileInfo storagedriver.FileInfo, migration *Migration, out io.Writer) error {
+	p := fileInfo.Path()
+	var offset int64
+	existing, err := to.Stat(ctx, p)
+	switch err.(type) {
+	case nil:
+		offset = existing.Size()
+	case storagedriver.PathNotFoundError:
+	default:
+		return err
+	}
+
+	switch {
+	case offset == fileInfo.Size():
+		migration.Skipped++
+		return nil
+	case offset > fileInfo.Size():
+		// the copy is not a prefix of the file, start over
+		if err := to.Delete(ctx, p); err != nil {
+			return err
+		}
+		offset = 0
+	}
+
+	reader, err := from.ReadStream(ctx, p, offset)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	n, err := to.WriteStream(ctx, p, offset, reader)
+	migration.Bytes += n
+	if err != nil {
+		return fmt.Errorf("copying %s stopped after %d bytes: %v", p, offset+n, err)
+	}
+
+	if offset > 0 {
+		fmt.Fprintf(out, "resumed %s at %d bytes\n", p, offset)
+		migration.Resumed++
+	} else {
+		fmt.Fprintf(out, "copied %s\n", p)
+		migration.Copied++
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package storageadmin
+
+import (
+	"path"
+	"strings"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+)
+
+const (
+	// blobsRoot holds the data of every blob, as <algorithm>/<first two hex bytes>/<hex>/data.
+	blobsRoot = "/docker/registry/v2/blobs"
+	// repositoriesRoot holds the links of repositories to the blobs of their layers, manifests
+	// and signatures.
+	repositoriesRoot = "/docker/registry/v2/repositories"
+)
+
+// Report describes the blobs kept by a storage backend.
+type Report struct {
+	// Blobs is the number of blobs in the storage.
+	Blobs int
+	// Bytes is the size of all the blobs.
+	Bytes int64
+	// OrphanedBlobs is the number of blobs no repository links to. They can be removed by the
+	// garbage collection of the registry.
+	OrphanedBlobs int
+	// OrphanedBytes is the size of the orphaned blobs.
+	OrphanedBytes int64
+}
+
+// Inspect walks the storage of driver and reports its blobs, including those that are no longer
+// referenced by any repository.
+func Inspect(ctx context.Context, driver storagedriver.StorageDriver) (*Report, error) {
+	referenced := map[string]bool{}
+	err := walk(ctx, driver, repositoriesRoot, func(fileInfo storagedriver.FileInfo) error {
+		if fileInfo.IsDir() || path.Base(fileInfo.Path()) != "link" {
+			return nil
+		}
+		content, err := driver.GetContent(ctx, fileInfo.Path())
+		if err != nil {
+			return err
+		}
+		referenced[strings.TrimSpace(string(content))] = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	err = walk(ctx, driver, blobsRoot, func(fileInfo storagedriver.FileInfo) error {
+		dgst, ok := blobDigest(fileInfo.Path())
+		if fileInfo.IsDir() || !ok {
+			return nil
+		}
+		report.Blobs++
+		report.Bytes += fileInfo.Size()
+		if !referenced[dgst] {
+			report.OrphanedBlobs++
+			report.OrphanedBytes += fileInfo.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// blobDigest returns the digest of the blob stored at the data file p.
+func blobDigest(p string) (string, bool) {
+	parts := strings.Split(strings.TrimPrefix(p, blobsRoot+"/"), "/")
+	if len(parts) != 4 || parts[3] != "data" {
+		return "", false
+	}
+	return parts[0] + ":" + parts[2], true
+}
+
+// walk calls f for every file below from, in lexicographical order. A missing from is empty.
+func walk(ctx context.Context, driver storagedriver.StorageDriver, from string, f storage.WalkFn) error {
+	err := storage.WalkSortedChildren(ctx, driver, from, f)
+	if _, ok := err.(storagedriver.PathNotFoundError); ok {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+package storageadmin
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/docker/distribution/context"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+)
+
+const (
+	layerPath  = blobsRoot + "/sha256/aa/aaaa/data"
+	orphanPath = blobsRoot + "/sha256/bb/bbbb/data"
+	linkPath   = repositoriesRoot + "/test/app/_layers/sha256/aaaa/link"
+	tagPath    = repositoriesRoot + "/test/app/_manifests/tags/latest/current/link"
+	uploadPath = repositoriesRoot + "/test/app/_uploads/1234/data"
+)
+
+func testStorage(t *testing.T) storagedriver.StorageDriver {
+	ctx := context.Background()
+	driver := inmemory.New()
+	for p, content := range map[string]string{
+		layerPath:  "layer",
+		orphanPath: "orphaned layer",
+		linkPath:   "sha256:aaaa",
+		tagPath:    "sha256:cccc",
+		uploadPath: "partial",
+	} {
+		if err := driver.PutContent(ctx, p, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return driver
+}
+
+func TestInspect(t *testing.T) {
+	report, err := Inspect(context.Background(), testStorage(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Report{Blobs: 2, Bytes: 19, OrphanedBlobs: 1, OrphanedBytes: 14}
+	if *report != expected {
+		t.Errorf("expected %#v, got %#v", expected, *report)
+	}
+
+	report, err = Inspect(context.Background(), inmemory.New())
+	if err != nil {
+		t.Fatalf("unexpected error for an empty storage: %v", err)
+	}
+	if *report != (Report{}) {
+		t.Errorf("unexpected report of an empty storage: %#v", *report)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	from := testStorage(t)
+	to := inmemory.New()
+	// an interrupted migration copied part of the layer and a tag that has moved since
+	if err := to.PutContent(ctx, layerPath, []byte("la")); err != nil {
+		t.Fatal(err)
+	}
+	if err := to.PutContent(ctx, tagPath, []byte("sha256:dddd")); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &bytes.Buffer{}
+	migration, err := Migrate(ctx, from, to, out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Migration{Copied: 3, Resumed: 1, Bytes: 3 + 14 + 11 + 11}
+	if *migration != expected {
+		t.Errorf("expected %#v, got %#v\n%s", expected, *migration, out)
+	}
+	for p, content := range map[string]string{
+		layerPath:  "layer",
+		orphanPath: "orphaned layer",
+		linkPath:   "sha256:aaaa",
+		tagPath:    "sha256:cccc",
+	} {
+		if actual, err := to.GetContent(ctx, p); err != nil || string(actual) != content {
+			t.Errorf("expected %s to contain %q, got %q: %v", p, content, actual, err)
+		}
+	}
+	if _, err := to.Stat(ctx, uploadPath); err == nil {
+		t.Errorf("uploads in progress must not be migrated")
+	}
+
+	migration, err = Migrate(ctx, from, to, ioutil.Discard)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := (Migration{Skipped: 4}); *migration != expected {
+		t.Errorf("expected a completed migration to be skipped, got %#v", *migration)
+	}
+}
@@ -92,6 +92,38 @@ func TestBuildConfigWithSecrets(t *testing.T) {
 	}
 }
 
+func TestBuildConfigBinaryWithDockerfile(t *testing.T) {
+	source := &SourceRef{Name: "binary", Binary: true, DockerfileContents: "FROM centos"}
+	build := &BuildRef{Source: source, Strategy: &BuildStrategyRef{IsDockerBuild: true}}
+	config, err := build.BuildConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Spec.Source.Binary == nil {
+		t.Errorf("expected binary build source: %#v", config.Spec.Source)
+	}
+	if config.Spec.Source.Dockerfile == nil || *config.Spec.Source.Dockerfile != "FROM centos" {
+		t.Errorf("unexpected dockerfile: %#v", config.Spec.Source.Dockerfile)
+	}
+	if !hasWebHookTriggers(config.Spec.Triggers) {
+		t.Errorf("expected webhook triggers for a binary build, got %#v", config.Spec.Triggers)
+	}
+}
+
+// hasWebHookTriggers returns true if the triggers include the GitHub and generic webhooks.
+func hasWebHookTriggers(triggers []buildapi.BuildTriggerPolicy) bool {
+	github, generic := false, false
+	for _, trigger := range triggers {
+		switch trigger.Type {
+		case buildapi.GitHubWebHookBuildTriggerType:
+			github = true
+		case buildapi.GenericWebHookBuildTriggerType:
+			generic = true
+		}
+	}
+	return github && generic
+}
+
 func TestSourceRefBuildSourceURI(t *testing.T) {
 	tests := []struct {
 		name     string
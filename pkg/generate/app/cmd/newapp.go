@@ -218,6 +218,7 @@ func (c *AppConfig) SetOpenShiftClient(osclient client.Interface, originNamespac
 		Client: osclient,
 		TemplateConfigsNamespacer: osclient,
 		Namespaces:                namespaces,
+		SharedTemplates:           osclient,
 	}
 	c.templateFileSearcher = &app.TemplateFileSearcher{
 		Typer:        c.typer,
@@ -289,6 +290,12 @@ func (c *AppConfig) addDockerfile() error {
 	case 0:
 		// Create a new SourceRepository with the Dockerfile.
 		repo, err := app.NewSourceRepositoryForDockerfile(c.Dockerfile)
+		if c.BinaryBuild {
+			// The Dockerfile is combined with the binary contents uploaded
+			// by start-build, so the repository expects binary input.
+			repo = app.NewBinarySourceRepository()
+			err = repo.AddDockerfile(c.Dockerfile)
+		}
 		if err != nil {
 			return fmt.Errorf("provided Dockerfile is not valid: %v", err)
 		}
@@ -389,7 +396,7 @@ func (c *AppConfig) validate() (app.ComponentReferences, app.SourceRepositories,
 		errs = append(errs, fmt.Errorf("when --strategy is specified you must provide at least one source code location"))
 	}
 
-	if c.BinaryBuild && (len(repos) > 0 || refs.HasSource()) {
+	if c.BinaryBuild && (hasNonBinaryRepository(repos) || refs.HasSource()) {
 		errs = append(errs, fmt.Errorf("specifying binary builds and source repositories at the same time is not allowed"))
 	}
 
@@ -408,6 +415,17 @@ func (c *AppConfig) validate() (app.ComponentReferences, app.SourceRepositories,
 	return refs, repos, env, parms, errors.NewAggregate(errs)
 }
 
+// hasNonBinaryRepository returns true if any of the provided repositories
+// points to source code rather than expecting binary input.
+func hasNonBinaryRepository(repos app.SourceRepositories) bool {
+	for _, repo := range repos {
+		if !repo.IsBinary() {
+			return true
+		}
+	}
+	return false
+}
+
 // componentsForRepos creates components for repositories that have not been previously associated by a builder
 // these components have already gone through source code detection and have a SourceRepositoryInfo attached to them
 func (c *AppConfig) componentsForRepos(repositories app.SourceRepositories) (app.ComponentReferences, error) {
@@ -547,7 +565,9 @@ func (c *AppConfig) inferBuildTypes(components app.ComponentReferences) (app.Com
 			}
 		}
 	}
-	if len(components) == 0 && c.BinaryBuild {
+	// a Dockerfile passed with a binary build provides the base image through its FROM, which is
+	// resolved with the other source repositories
+	if len(components) == 0 && c.BinaryBuild && len(c.Dockerfile) == 0 {
 		if len(c.Name) == 0 {
 			return nil, fmt.Errorf("you must provide a --name when you don't specify a source repository or base image")
 		}
@@ -1164,6 +1164,45 @@ func TestRunBuilds(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "successful binary build from dockerfile",
+			config: &AppConfig{
+				Dockerfile:  "FROM openshift/origin-base\nUSER foo",
+				Name:        "foobar",
+				BinaryBuild: true,
+			},
+			expected: map[string][]string{
+				"buildConfig": {"foobar"},
+				"imageStream": {"origin-base", "foobar"},
+			},
+			checkResult: func(res *AppResult) error {
+				for _, item := range res.List.Items {
+					switch t := item.(type) {
+					case *buildapi.BuildConfig:
+						if t.Spec.Source.Binary == nil {
+							return fmt.Errorf("bc.Spec.Source.Binary is nil; want binary source")
+						}
+						if t.Spec.Source.Dockerfile == nil {
+							return fmt.Errorf("bc.Spec.Source.Dockerfile is nil; want inline Dockerfile")
+						}
+						if t.Spec.Strategy.DockerStrategy == nil || t.Spec.Strategy.DockerStrategy.From == nil || t.Spec.Strategy.DockerStrategy.From.Name != "origin-base:latest" {
+							return fmt.Errorf("bc.Spec.Strategy.DockerStrategy = %#v; want From origin-base:latest", t.Spec.Strategy.DockerStrategy)
+						}
+						webhooks := 0
+						for _, trigger := range t.Spec.Triggers {
+							if trigger.Type == buildapi.GitHubWebHookBuildTriggerType || trigger.Type == buildapi.GenericWebHookBuildTriggerType {
+								webhooks++
+							}
+						}
+						if webhooks != 2 {
+							return fmt.Errorf("bc.Spec.Triggers = %v; want webhook triggers", t.Spec.Triggers)
+						}
+						return nil
+					}
+				}
+				return fmt.Errorf("BuildConfig not found; got %v", res.List.Items)
+			},
+		},
 		{
 			name: "unsuccessful build from dockerfile due to strategy conflict",
 			config: &AppConfig{
@@ -3,6 +3,8 @@ package app
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strings"
 
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -90,3 +92,36 @@ type CircularOutputReferenceError struct {
 func (e CircularOutputReferenceError) Error() string {
 	return fmt.Sprintf("the input and output image stream tags are identical (%q)", e.Reference.DockerClientDefaults())
 }
+
+// ErrContextDirRequired is the error returned by new-app when nothing can be
+// built at the root of a source repository, but some of its subdirectories
+// can be built by setting a context directory.
+type ErrContextDirRequired struct {
+	// Subdirectories maps each subdirectory that can be built to the terms
+	// detected in it
+	Subdirectories map[string][]string
+}
+
+func (e ErrContextDirRequired) Error() string {
+	return fmt.Sprintf("no language matched the root of the source repository, but %d of its directories can be built", len(e.Subdirectories))
+}
+
+// UsageError is the usage error message returned when a context directory
+// needs to be set.
+func (e ErrContextDirRequired) UsageError(commandName string) string {
+	dirs := []string{}
+	for dir := range e.Subdirectories {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	buf := &bytes.Buffer{}
+	for _, dir := range dirs {
+		fmt.Fprintf(buf, "* %s (%s)\n", dir, strings.Join(e.Subdirectories[dir], ", "))
+		fmt.Fprintf(buf, "  Use --context-dir=%s to build this directory\n\n", dir)
+	}
+	return fmt.Sprintf(`
+No language matched the root of the source repository, but the following directories can be built:
+
+%[1]s`, buf.String())
+}
@@ -170,6 +170,11 @@ func (r *SourceRepository) IsDockerBuild() bool {
 	return r.buildWithDocker
 }
 
+// IsBinary checks if the source repository expects binary input
+func (r *SourceRepository) IsBinary() bool {
+	return r.binary
+}
+
 func (r *SourceRepository) String() string {
 	return r.location
 }
@@ -404,8 +409,42 @@ type SourceRepositoryEnumerator struct {
 // source code detectors.
 var ErrNoLanguageDetected = fmt.Errorf("No language matched the source repository")
 
-// Detect extracts source code information about the provided source repository
+// Detect extracts source code information about the provided source repository.
+// When nothing can be built at its root, the subdirectories that can be built
+// are returned in an ErrContextDirRequired error.
 func (e SourceRepositoryEnumerator) Detect(dir string, dockerStrategy bool) (*SourceRepositoryInfo, error) {
+	info, err := e.detect(dir, dockerStrategy)
+	if err != ErrNoLanguageDetected {
+		return info, err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, ErrNoLanguageDetected
+	}
+	subdirs := map[string][]string{}
+	for _, file := range files {
+		if !file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+		subinfo, err := e.detect(filepath.Join(dir, file.Name()), dockerStrategy)
+		if err != nil {
+			continue
+		}
+		terms := subinfo.Terms()
+		if subinfo.Dockerfile != nil {
+			terms = append(terms, "Dockerfile")
+		}
+		subdirs[file.Name()] = terms
+	}
+	if len(subdirs) == 0 {
+		return nil, ErrNoLanguageDetected
+	}
+	return nil, ErrContextDirRequired{Subdirectories: subdirs}
+}
+
+// detect extracts source code information about the source in dir
+func (e SourceRepositoryEnumerator) detect(dir string, dockerStrategy bool) (*SourceRepositoryInfo, error) {
 	info := &SourceRepositoryInfo{
 		Path: dir,
 	}
@@ -1,6 +1,15 @@
 package app
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/openshift/origin/pkg/generate/dockerfile"
+	"github.com/openshift/origin/pkg/generate/source"
+)
 
 func TestAddBuildSecrets(t *testing.T) {
 	type result struct{ name, dest string }
@@ -56,3 +65,65 @@ func TestAddBuildSecrets(t *testing.T) {
 		}
 	}
 }
+
+func TestDetectContextDir(t *testing.T) {
+	tests := map[string]struct {
+		files          []string
+		dockerStrategy bool
+		expectedTerms  []string
+		expectedErr    error
+	}{
+		"root": {
+			files:         []string{"main.go", "web/package.json"},
+			expectedTerms: []string{"golang"},
+		},
+		"subdirectories": {
+			files: []string{"README.md", "api/main.go", "web/package.json", "docs/index.md", ".git/config/Gemfile"},
+			expectedErr: ErrContextDirRequired{Subdirectories: map[string][]string{
+				"api": {"golang"},
+				"web": {"nodejs"},
+			}},
+		},
+		"dockerfile in subdirectory": {
+			files:          []string{"api/main.go", "db/Dockerfile"},
+			dockerStrategy: true,
+			expectedErr: ErrContextDirRequired{Subdirectories: map[string][]string{
+				"db": {"Dockerfile"},
+			}},
+		},
+		"nothing": {
+			files:       []string{"README.md", "docs/index.md"},
+			expectedErr: ErrNoLanguageDetected,
+		},
+	}
+
+	enumerator := SourceRepositoryEnumerator{
+		Detectors: source.DefaultDetectors,
+		Tester:    dockerfile.NewTester(),
+	}
+	for name, test := range tests {
+		dir, err := ioutil.TempDir("", "sourcelookup")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		for _, file := range test.files {
+			path := filepath.Join(dir, file)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := ioutil.WriteFile(path, []byte("FROM centos\n"), 0644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		info, err := enumerator.Detect(dir, test.dockerStrategy)
+		if !reflect.DeepEqual(err, test.expectedErr) {
+			t.Errorf("%s: expected error %v, got %v", name, test.expectedErr, err)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(info.Terms(), test.expectedTerms) {
+			t.Errorf("%s: expected terms %v, got %v", name, test.expectedTerms, info.Terms())
+		}
+	}
+}
@@ -20,6 +20,8 @@ type TemplateSearcher struct {
 	Client                    client.TemplatesNamespacer
 	TemplateConfigsNamespacer client.TemplateConfigsNamespacer
 	Namespaces                []string
+	// SharedTemplates, if set, is searched for the templates other projects share with the first namespace
+	SharedTemplates client.SharedTemplatesNamespacer
 }
 
 // Search searches for a template and returns matches with the object representation
@@ -45,25 +47,48 @@ func (r TemplateSearcher) Search(terms ...string) (ComponentMatches, error) {
 				return nil, err
 			}
 
-			for i := range templates.Items {
-				template := &templates.Items[i]
-				if score, scored := templateScorer(*template, term); scored {
-					matches = append(matches, &ComponentMatch{
-						Value:       term,
-						Argument:    fmt.Sprintf("--template=%q", template.Name),
-						Name:        template.Name,
-						Description: fmt.Sprintf("Template %q in project %q", template.Name, template.Namespace),
-						Score:       score,
-						Template:    template,
-					})
+			matches = append(matches, matchTemplates(templates, term, nil)...)
+		}
+
+		if r.SharedTemplates != nil && len(r.Namespaces) > 0 {
+			glog.V(4).Infof("checking shared template %s", term)
+			templates, err := r.SharedTemplates.SharedTemplates(r.Namespaces[0]).List(kapi.ListOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) || errors.IsForbidden(err) {
+					continue
 				}
+				return nil, err
 			}
+			// the templates of the namespaces that were searched were already found
+			matches = append(matches, matchTemplates(templates, term, checkedNamespaces)...)
 		}
 	}
 
 	return matches, nil
 }
 
+// matchTemplates returns a match for each template outside of the excluded namespaces that matches term
+func matchTemplates(templates *templateapi.TemplateList, term string, excludedNamespaces sets.String) ComponentMatches {
+	matches := ComponentMatches{}
+	for i := range templates.Items {
+		template := &templates.Items[i]
+		if excludedNamespaces.Has(template.Namespace) {
+			continue
+		}
+		if score, scored := templateScorer(*template, term); scored {
+			matches = append(matches, &ComponentMatch{
+				Value:       term,
+				Argument:    fmt.Sprintf("--template=%q", template.Name),
+				Name:        template.Name,
+				Description: fmt.Sprintf("Template %q in project %q", template.Name, template.Namespace),
+				Score:       score,
+				Template:    template,
+			})
+		}
+	}
+	return matches
+}
+
 // IsPossibleTemplateFile returns true if the argument can be a template file
 func IsPossibleTemplateFile(value string) bool {
 	return isFile(value)
@@ -28,6 +28,8 @@ var DefaultDetectors = Detectors{
 	DetectPython,
 	DetectPerl,
 	DetectScala,
+	DetectGolang,
+	DetectRust,
 }
 
 type sourceDetector struct {
@@ -80,6 +82,24 @@ func DetectScala(dir string) (*Info, bool) {
 	return detect("scala", dir, "build.sbt")
 }
 
+// DetectGolang detects Go source
+func DetectGolang(dir string) (*Info, bool) {
+	if info, found := detect("golang", dir, "Godeps", "Gopkg.toml", "glide.yaml"); found {
+		return info, true
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, "*.go")); len(matches) > 0 {
+		return &Info{
+			Platform: "golang",
+		}, true
+	}
+	return nil, false
+}
+
+// DetectRust detects Rust source
+func DetectRust(dir string) (*Info, bool) {
+	return detect("rust", dir, "Cargo.toml")
+}
+
 // detect returns an Info object with the given platform if the source at dir contains any of the argument files
 func detect(platform string, dir string, files ...string) (*Info, bool) {
 	if filesPresent(dir, files) {
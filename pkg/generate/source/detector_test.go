@@ -1,6 +1,9 @@
 package source
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -44,3 +47,45 @@ func fake2(dir string) (*Info, bool) {
 	return nil, false
 
 }
+
+func TestDefaultDetectors(t *testing.T) {
+	tests := []struct {
+		files            []string
+		expectedPlatform string
+	}{
+		{files: []string{"main.go"}, expectedPlatform: "golang"},
+		{files: []string{"Godeps/Godeps.json"}, expectedPlatform: "golang"},
+		{files: []string{"Gopkg.toml", "cmd/server/main.go"}, expectedPlatform: "golang"},
+		{files: []string{"glide.yaml"}, expectedPlatform: "golang"},
+		{files: []string{"Cargo.toml", "src/main.rs"}, expectedPlatform: "rust"},
+		{files: []string{"Gemfile"}, expectedPlatform: "ruby"},
+		{files: []string{"cmd/server/main.go"}},
+		{files: []string{"README.md"}},
+	}
+	for _, test := range tests {
+		dir, err := ioutil.TempDir("", "detector")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(dir)
+		for _, file := range test.files {
+			path := filepath.Join(dir, file)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := ioutil.WriteFile(path, []byte{}, 0644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		info, ok := DefaultDetectors.DetectSource(dir)
+		switch {
+		case len(test.expectedPlatform) == 0 && ok:
+			t.Errorf("%v: expected no platform, got %s", test.files, info.Platform)
+		case len(test.expectedPlatform) > 0 && !ok:
+			t.Errorf("%v: expected platform %s, got none", test.files, test.expectedPlatform)
+		case ok && info.Platform != test.expectedPlatform:
+			t.Errorf("%v: expected platform %s, got %s", test.files, test.expectedPlatform, info.Platform)
+		}
+	}
+}
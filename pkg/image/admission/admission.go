@@ -0,0 +1,198 @@
+package admission
+
+import (
+	"fmt"
+	"io"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/plugin/pkg/admission/limitranger"
+
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// PluginName is the name of the admission plugin that enforces the image limit types of LimitRanges
+const PluginName = "openshift.io/ImageLimitRange"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(kubeClient kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewImageLimitRangerPlugin(kubeClient), nil
+	})
+}
+
+// imageLimitRangerPlugin enforces the openshift.io/Image and openshift.io/ImageStream limit types of the
+// LimitRanges in a namespace, and rejects LimitRanges that use those types incorrectly.
+type imageLimitRangerPlugin struct {
+	*admission.Handler
+	limitRanger admission.Interface
+	client      client.Interface
+}
+
+// ensure that the required Openshift admission interfaces are implemented
+var _ = oadmission.WantsOpenshiftClient(&imageLimitRangerPlugin{})
+var _ = oadmission.Validator(&imageLimitRangerPlugin{})
+
+// NewImageLimitRangerPlugin returns an admission controller that enforces image limit ranges.
+func NewImageLimitRangerPlugin(kubeClient kclient.Interface) admission.Interface {
+	plugin := &imageLimitRangerPlugin{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+	plugin.limitRanger = limitranger.NewLimitRanger(kubeClient, plugin.limit)
+	return plugin
+}
+
+// Admit validates the image limit types of LimitRanges, and checks images and image streams against the
+// LimitRanges of their namespace.
+func (a *imageLimitRangerPlugin) Admit(attr admission.Attributes) error {
+	switch {
+	case attr.GetResource() == imageapi.Resource("imagestreams") && attr.GetSubresource() == "status":
+		// the limit ranger ignores subresources, but a status update sets the tags and images of the stream
+		return a.limitRanger.Admit(admission.NewAttributesRecord(attr.GetObject(), attr.GetKind(), attr.GetNamespace(), attr.GetName(), attr.GetResource(), "", attr.GetOperation(), attr.GetUserInfo()))
+	case attr.GetSubresource() != "":
+		return nil
+	}
+	switch attr.GetResource() {
+	case kapi.Resource("limitranges"):
+		limitRange, ok := attr.GetObject().(*kapi.LimitRange)
+		if !ok {
+			return nil
+		}
+		if errs := ValidateLimitRange(limitRange); len(errs) > 0 {
+			return kapierrors.NewInvalid("LimitRange", limitRange.Name, errs)
+		}
+		return nil
+	case imageapi.Resource("imagestreams"), imageapi.Resource("imagestreammappings"), imageapi.Resource("imagestreamtags"), imageapi.Resource("imagestreamimports"):
+		return a.limitRanger.Admit(attr)
+	}
+	return nil
+}
+
+// limit is the limitranger.LimitFunc of the plugin.
+func (a *imageLimitRangerPlugin) limit(limitRange *kapi.LimitRange, resourceName string, obj runtime.Object) error {
+	switch resourceName {
+	case "imagestreams":
+		stream, ok := obj.(*imageapi.ImageStream)
+		if !ok {
+			return nil
+		}
+		return LimitImageStream(limitRange, stream)
+
+	case "imagestreammappings":
+		mapping, ok := obj.(*imageapi.ImageStreamMapping)
+		if !ok {
+			return nil
+		}
+		if err := LimitImage(limitRange, &mapping.Image); err != nil {
+			return err
+		}
+		if !hasLimitType(limitRange, imageapi.LimitTypeImageStream) {
+			return nil
+		}
+		stream, err := a.getImageStream(mapping.Namespace, mapping.Name)
+		if err != nil {
+			return err
+		}
+		return LimitImageStream(limitRange, withMappedImage(stream, mapping))
+
+	case "imagestreamtags":
+		istag, ok := obj.(*imageapi.ImageStreamTag)
+		if !ok || !hasLimitType(limitRange, imageapi.LimitTypeImageStream) {
+			return nil
+		}
+		name, tag, ok := imageapi.SplitImageStreamTag(istag.Name)
+		if !ok {
+			return nil
+		}
+		stream, err := a.getImageStream(istag.Namespace, name)
+		if err != nil {
+			return err
+		}
+		return LimitImageStream(limitRange, withSpecTags(stream, tag))
+
+	case "imagestreamimports":
+		// the images and the tags of a repository are only known once they have been imported, so only
+		// the tags that the import sets explicitly can be checked
+		isi, ok := obj.(*imageapi.ImageStreamImport)
+		if !ok || !isi.Spec.Import || !hasLimitType(limitRange, imageapi.LimitTypeImageStream) {
+			return nil
+		}
+		tags := []string{}
+		for _, spec := range isi.Spec.Images {
+			if spec.To != nil {
+				tags = append(tags, spec.To.Name)
+			}
+		}
+		stream, err := a.getImageStream(isi.Namespace, isi.Name)
+		if err != nil {
+			return err
+		}
+		return LimitImageStream(limitRange, withSpecTags(stream, tags...))
+	}
+	return nil
+}
+
+// getImageStream returns the image stream, or an empty image stream if it is created by the request.
+func (a *imageLimitRangerPlugin) getImageStream(namespace, name string) (*imageapi.ImageStream, error) {
+	stream, err := a.client.ImageStreams(namespace).Get(name)
+	if kapierrors.IsNotFound(err) {
+		return &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name}}, nil
+	}
+	return stream, err
+}
+
+// withSpecTags returns a copy of the image stream with the given tags added to its spec.
+func withSpecTags(stream *imageapi.ImageStream, tags ...string) *imageapi.ImageStream {
+	updated := *stream
+	updated.Spec.Tags = map[string]imageapi.TagReference{}
+	for k, v := range stream.Spec.Tags {
+		updated.Spec.Tags[k] = v
+	}
+	for _, tag := range tags {
+		if _, ok := updated.Spec.Tags[tag]; !ok {
+			updated.Spec.Tags[tag] = imageapi.TagReference{}
+		}
+	}
+	return &updated
+}
+
+// withMappedImage returns a copy of the image stream as it will be once the mapping has been applied.
+func withMappedImage(stream *imageapi.ImageStream, mapping *imageapi.ImageStreamMapping) *imageapi.ImageStream {
+	tag := mapping.Tag
+	if len(tag) == 0 {
+		tag = imageapi.DefaultImageTag
+	}
+
+	updated := *stream
+	updated.Status.Tags = map[string]imageapi.TagEventList{}
+	for k, v := range stream.Status.Tags {
+		updated.Status.Tags[k] = v
+	}
+	events := []imageapi.TagEvent{{Image: mapping.Image.Name}}
+	updated.Status.Tags[tag] = imageapi.TagEventList{Items: append(events, stream.Status.Tags[tag].Items...)}
+	return &updated
+}
+
+func hasLimitType(limitRange *kapi.LimitRange, limitType kapi.LimitType) bool {
+	for _, limit := range limitRange.Spec.Limits {
+		if limit.Type == limitType {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *imageLimitRangerPlugin) SetOpenshiftClient(client client.Interface) {
+	a.client = client
+}
+
+func (a *imageLimitRangerPlugin) Validate() error {
+	if a.client == nil {
+		return fmt.Errorf("%s plugin requires an Openshift client", PluginName)
+	}
+	return nil
+}
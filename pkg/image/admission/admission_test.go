@@ -0,0 +1,239 @@
+package admission
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func imageLimitRange(maxSize string, maxTags, maxImages int64) *kapi.LimitRange {
+	return &kapi.LimitRange{
+		ObjectMeta: kapi.ObjectMeta{Name: "limits", Namespace: "test"},
+		Spec: kapi.LimitRangeSpec{
+			Limits: []kapi.LimitRangeItem{
+				{
+					Type: imageapi.LimitTypeImage,
+					Max:  kapi.ResourceList{kapi.ResourceStorage: resource.MustParse(maxSize)},
+				},
+				{
+					Type: imageapi.LimitTypeImageStream,
+					Max: kapi.ResourceList{
+						imageapi.ResourceImageStreamTags:   *resource.NewQuantity(maxTags, resource.DecimalSI),
+						imageapi.ResourceImageStreamImages: *resource.NewQuantity(maxImages, resource.DecimalSI),
+					},
+				},
+			},
+		},
+	}
+}
+
+func testStream(tags map[string][]string) *imageapi.ImageStream {
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "stream", Namespace: "test"},
+		Status:     imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{}},
+	}
+	for tag, images := range tags {
+		list := imageapi.TagEventList{}
+		for _, image := range images {
+			list.Items = append(list.Items, imageapi.TagEvent{Image: image})
+		}
+		stream.Status.Tags[tag] = list
+	}
+	return stream
+}
+
+func testMapping(tag, image string, size int64) *imageapi.ImageStreamMapping {
+	mapping := &imageapi.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{Name: "stream", Namespace: "test"},
+		Tag:        tag,
+	}
+	mapping.Image.Name = image
+	mapping.Image.DockerImageMetadata.Size = size
+	return mapping
+}
+
+func TestLimitImageStreamMapping(t *testing.T) {
+	existing := testStream(map[string][]string{"latest": {"sha256:1"}, "v1": {"sha256:2", "sha256:1"}})
+
+	testCases := map[string]struct {
+		stream  *imageapi.ImageStream
+		mapping *imageapi.ImageStreamMapping
+		allowed bool
+	}{
+		"image within limits": {
+			stream:  existing,
+			mapping: testMapping("latest", "sha256:3", 1024),
+			allowed: true,
+		},
+		"image too large": {
+			stream:  existing,
+			mapping: testMapping("latest", "sha256:3", 1024*1024*1024),
+			allowed: false,
+		},
+		"too many tags": {
+			stream:  existing,
+			mapping: testMapping("v2", "sha256:1", 1024),
+			allowed: false,
+		},
+		"too many images": {
+			stream:  testStream(map[string][]string{"latest": {"sha256:1", "sha256:2", "sha256:3"}}),
+			mapping: testMapping("latest", "sha256:4", 1024),
+			allowed: false,
+		},
+		"new image stream": {
+			mapping: testMapping("", "sha256:1", 1024),
+			allowed: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		client := &testclient.Fake{}
+		stream := tc.stream
+		client.AddReactor("get", "imagestreams", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if stream == nil {
+				return true, nil, kapierrors.NewNotFound("ImageStream", "stream")
+			}
+			return true, stream, nil
+		})
+		plugin := &imageLimitRangerPlugin{client: client}
+
+		err := plugin.limit(imageLimitRange("1Mi", 2, 3), "imagestreammappings", tc.mapping)
+		if tc.allowed && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tc.allowed && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestLimitImageStream(t *testing.T) {
+	plugin := &imageLimitRangerPlugin{client: &testclient.Fake{}}
+	limitRange := imageLimitRange("1Mi", 2, 3)
+
+	stream := testStream(map[string][]string{"latest": {"sha256:1"}})
+	stream.Spec.Tags = map[string]imageapi.TagReference{"latest": {}, "v1": {}}
+	if err := plugin.limit(limitRange, "imagestreams", stream); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	stream.Spec.Tags["v2"] = imageapi.TagReference{}
+	if err := plugin.limit(limitRange, "imagestreams", stream); err == nil {
+		t.Errorf("expected an error for too many tags")
+	}
+}
+
+func TestLimitImageStreamTagsAndImports(t *testing.T) {
+	client := &testclient.Fake{}
+	client.AddReactor("get", "imagestreams", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, testStream(map[string][]string{"latest": {"sha256:1"}, "v1": {"sha256:2"}}), nil
+	})
+	plugin := &imageLimitRangerPlugin{client: client}
+	limitRange := imageLimitRange("1Mi", 2, 3)
+
+	istag := &imageapi.ImageStreamTag{ObjectMeta: kapi.ObjectMeta{Name: "stream:latest", Namespace: "test"}}
+	if err := plugin.limit(limitRange, "imagestreamtags", istag); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	istag.Name = "stream:v2"
+	if err := plugin.limit(limitRange, "imagestreamtags", istag); err == nil {
+		t.Errorf("expected an error for too many tags")
+	}
+
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "stream", Namespace: "test"},
+		Spec: imageapi.ImageStreamImportSpec{
+			Images: []imageapi.ImageImportSpec{{To: &kapi.LocalObjectReference{Name: "v2"}}},
+		},
+	}
+	if err := plugin.limit(limitRange, "imagestreamimports", isi); err != nil {
+		t.Errorf("unexpected error for an import that does not change the stream: %v", err)
+	}
+	isi.Spec.Import = true
+	if err := plugin.limit(limitRange, "imagestreamimports", isi); err == nil {
+		t.Errorf("expected an error for too many tags")
+	}
+}
+
+// fakeLimitRanger records the attributes it admits.
+type fakeLimitRanger struct {
+	attributes []admission.Attributes
+}
+
+func (f *fakeLimitRanger) Admit(attr admission.Attributes) error {
+	f.attributes = append(f.attributes, attr)
+	return nil
+}
+
+func (f *fakeLimitRanger) Handles(operation admission.Operation) bool {
+	return true
+}
+
+func TestAdmitImageStreamStatus(t *testing.T) {
+	limitRanger := &fakeLimitRanger{}
+	plugin := &imageLimitRangerPlugin{
+		Handler:     admission.NewHandler(admission.Create, admission.Update),
+		limitRanger: limitRanger,
+		client:      &testclient.Fake{},
+	}
+
+	stream := testStream(map[string][]string{"latest": {"sha256:1"}})
+	for _, subresource := range []string{"status", "secrets"} {
+		attributes := admission.NewAttributesRecord(stream, imageapi.Kind("ImageStream"), "test", stream.Name, imageapi.Resource("imagestreams"), subresource, admission.Update, nil)
+		if err := plugin.Admit(attributes); err != nil {
+			t.Errorf("%s: unexpected error: %v", subresource, err)
+		}
+	}
+	if len(limitRanger.attributes) != 1 {
+		t.Fatalf("expected only the status update to be limited, got %#v", limitRanger.attributes)
+	}
+	if subresource := limitRanger.attributes[0].GetSubresource(); subresource != "" {
+		t.Errorf("expected the status update to be limited as the image stream, got subresource %q", subresource)
+	}
+}
+
+func TestAdmitValidatesLimitRange(t *testing.T) {
+	plugin := &imageLimitRangerPlugin{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+		client:  &testclient.Fake{},
+	}
+
+	valid := imageLimitRange("1Gi", 10, 10)
+	attributes := admission.NewAttributesRecord(valid, kapi.Kind("LimitRange"), "test", valid.Name, kapi.Resource("limitranges"), "", admission.Create, nil)
+	if err := plugin.Admit(attributes); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	invalid := imageLimitRange("1Gi", 10, 10)
+	invalid.Spec.Limits[0].Max[kapi.ResourceCPU] = resource.MustParse("1")
+	invalid.Spec.Limits[1].Default = kapi.ResourceList{imageapi.ResourceImageStreamTags: resource.MustParse("1")}
+	attributes = admission.NewAttributesRecord(invalid, kapi.Kind("LimitRange"), "test", invalid.Name, kapi.Resource("limitranges"), "", admission.Create, nil)
+	err := plugin.Admit(attributes)
+	if !kapierrors.IsInvalid(err) {
+		t.Fatalf("expected an invalid error, got %v", err)
+	}
+	if details := err.(*kapierrors.StatusError).ErrStatus.Details; details == nil || len(details.Causes) != 2 {
+		t.Errorf("expected 2 causes, got %#v", details)
+	}
+}
+
+func TestImageStreamUsage(t *testing.T) {
+	stream := testStream(map[string][]string{"latest": {"sha256:1", "sha256:2"}, "v1": {"sha256:1"}})
+	stream.Spec.Tags = map[string]imageapi.TagReference{"latest": {}, "v2": {}}
+
+	usage := ImageStreamUsage(stream)
+	if tags := usage[imageapi.ResourceImageStreamTags]; tags.Value() != 3 {
+		t.Errorf("expected 3 tags, got %s", tags.String())
+	}
+	if images := usage[imageapi.ResourceImageStreamImages]; images.Value() != 2 {
+		t.Errorf("expected 2 images, got %s", images.String())
+	}
+}
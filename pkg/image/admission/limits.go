@@ -0,0 +1,111 @@
+package admission
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// supportedResources are the resources that may be limited for each of the image limit types.
+var supportedResources = map[kapi.LimitType]sets.String{
+	imageapi.LimitTypeImage:       sets.NewString(string(kapi.ResourceStorage)),
+	imageapi.LimitTypeImageStream: sets.NewString(string(imageapi.ResourceImageStreamTags), string(imageapi.ResourceImageStreamImages)),
+}
+
+// ValidateLimitRange ensures that the image limit types of a LimitRange only set maximums for the
+// resources they support. Limits of any other type are left to the Kubernetes validation.
+func ValidateLimitRange(limitRange *kapi.LimitRange) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec", "limits")
+	for i, limit := range limitRange.Spec.Limits {
+		supported, ok := supportedResources[limit.Type]
+		if !ok {
+			continue
+		}
+		idxPath := fldPath.Index(i)
+		for k := range limit.Max {
+			if !supported.Has(string(k)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("max").Key(string(k)), string(k), supported.List()))
+			}
+		}
+		for _, unsupported := range []struct {
+			name      string
+			resources kapi.ResourceList
+		}{
+			{"min", limit.Min},
+			{"default", limit.Default},
+			{"defaultRequest", limit.DefaultRequest},
+			{"maxLimitRequestRatio", limit.MaxLimitRequestRatio},
+		} {
+			for k, v := range unsupported.resources {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child(unsupported.name).Key(string(k)), v.String(), fmt.Sprintf("not supported when limit type is %s", limit.Type)))
+			}
+		}
+	}
+	return allErrs
+}
+
+// LimitImage returns an error if the size of the image exceeds the maximum image size of the LimitRange.
+func LimitImage(limitRange *kapi.LimitRange, image *imageapi.Image) error {
+	for _, limit := range limitRange.Spec.Limits {
+		if limit.Type != imageapi.LimitTypeImage {
+			continue
+		}
+		max, ok := limit.Max[kapi.ResourceStorage]
+		if !ok {
+			continue
+		}
+		size := resource.NewQuantity(image.DockerImageMetadata.Size, resource.BinarySI)
+		if size.Cmp(max) > 0 {
+			return fmt.Errorf("image %s is %s, which exceeds the maximum image size of %s", image.Name, size.String(), max.String())
+		}
+	}
+	return nil
+}
+
+// LimitImageStream returns an error if the image stream has more tags or images than the LimitRange allows.
+func LimitImageStream(limitRange *kapi.LimitRange, stream *imageapi.ImageStream) error {
+	usage := ImageStreamUsage(stream)
+	for _, limit := range limitRange.Spec.Limits {
+		if limit.Type != imageapi.LimitTypeImageStream {
+			continue
+		}
+		for _, resourceName := range []kapi.ResourceName{imageapi.ResourceImageStreamTags, imageapi.ResourceImageStreamImages} {
+			max, ok := limit.Max[resourceName]
+			if !ok {
+				continue
+			}
+			used := usage[resourceName]
+			if used.Cmp(max) > 0 {
+				return fmt.Errorf("image stream %s has %s %s, which exceeds the maximum of %s", stream.Name, used.String(), resourceName, max.String())
+			}
+		}
+	}
+	return nil
+}
+
+// ImageStreamUsage returns the number of distinct tags and images of the image stream.
+func ImageStreamUsage(stream *imageapi.ImageStream) kapi.ResourceList {
+	tags := sets.NewString()
+	images := sets.NewString()
+	for tag := range stream.Spec.Tags {
+		tags.Insert(tag)
+	}
+	for tag, events := range stream.Status.Tags {
+		tags.Insert(tag)
+		for _, event := range events.Items {
+			if len(event.Image) > 0 {
+				images.Insert(event.Image)
+			}
+		}
+	}
+	return kapi.ResourceList{
+		imageapi.ResourceImageStreamTags:   *resource.NewQuantity(int64(tags.Len()), resource.DecimalSI),
+		imageapi.ResourceImageStreamImages: *resource.NewQuantity(int64(images.Len()), resource.DecimalSI),
+	}
+}
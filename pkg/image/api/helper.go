@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api/errors"
@@ -810,3 +811,46 @@ func PrioritizeTags(tags []string) {
 	}
 	copy(tags, finalTags)
 }
+
+// reTrackVersion matches the version patterns a tag may track, like "2.x" or "v2.1.x"
+var reTrackVersion = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?\.x$`)
+
+// IsValidTrackVersion returns true if pattern is a version pattern a tag may track.
+func IsValidTrackVersion(pattern string) bool {
+	return reTrackVersion.MatchString(pattern)
+}
+
+// LatestTrackedTag returns the tag in the status of stream with the highest semantic version matching
+// the version pattern, or false if no tag matches. Only tags with a full released version ("2.1.3" or
+// "v2.1.3") and at least one image are considered.
+func LatestTrackedTag(stream *ImageStream, pattern string) (string, bool) {
+	match := reTrackVersion.FindStringSubmatch(pattern)
+	if match == nil {
+		return "", false
+	}
+	major, _ := strconv.ParseUint(match[1], 10, 64)
+	minor, anyMinor := uint64(0), len(match[2]) == 0
+	if !anyMinor {
+		minor, _ = strconv.ParseUint(match[2], 10, 64)
+	}
+
+	var latest *semver.Version
+	latestTag := ""
+	for tag, history := range stream.Status.Tags {
+		if len(history.Items) == 0 {
+			continue
+		}
+		v, err := semver.Parse(strings.TrimPrefix(tag, "v"))
+		if err != nil || len(v.Pre) > 0 {
+			continue
+		}
+		if v.Major != major || (!anyMinor && v.Minor != minor) {
+			continue
+		}
+		// prefer the tag without a prefix when both "2.1.3" and "v2.1.3" exist
+		if latest == nil || v.GT(*latest) || (v.EQ(*latest) && tag < latestTag) {
+			latest, latestTag = &v, tag
+		}
+	}
+	return latestTag, latest != nil
+}
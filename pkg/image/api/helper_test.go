@@ -1236,3 +1236,42 @@ func TestPrioritizeTags(t *testing.T) {
 		t.Errorf("unexpected order: %v", tags)
 	}
 }
+
+func TestLatestTrackedTag(t *testing.T) {
+	stream := &ImageStream{
+		Status: ImageStreamStatus{
+			Tags: map[string]TagEventList{
+				"latest":      {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"1.9.0":       {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"2.1.0":       {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"2.1.10":      {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"v2.1.10":     {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"2.1.2":       {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"2.2.0-beta1": {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"v2.0.5":      {Items: []TagEvent{{DockerImageReference: "a"}}},
+				"2.3.0":       {},
+				"3":           {Items: []TagEvent{{DockerImageReference: "a"}}},
+			},
+		},
+	}
+	testCases := []struct {
+		pattern string
+		tag     string
+		ok      bool
+	}{
+		{pattern: "2.x", tag: "2.1.10", ok: true},
+		{pattern: "v2.x", tag: "2.1.10", ok: true},
+		{pattern: "2.0.x", tag: "v2.0.5", ok: true},
+		{pattern: "1.x", tag: "1.9.0", ok: true},
+		{pattern: "3.x"},
+		{pattern: "2.2.x"},
+		{pattern: "2"},
+		{pattern: "latest"},
+	}
+	for _, test := range testCases {
+		tag, ok := LatestTrackedTag(stream, test.pattern)
+		if tag != test.tag || ok != test.ok {
+			t.Errorf("%s: expected %q %t, got %q %t", test.pattern, test.tag, test.ok, tag, ok)
+		}
+	}
+}
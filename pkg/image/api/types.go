@@ -32,6 +32,22 @@ const (
 	DefaultImageTag = "latest"
 )
 
+const (
+	// LimitTypeImage is a limit type that applies to every image pushed to an image stream in the namespace.
+	// The maximum image size is set with the storage resource.
+	LimitTypeImage kapi.LimitType = "openshift.io/Image"
+	// LimitTypeImageStream is a limit type that applies to every image stream in the namespace.
+	LimitTypeImageStream kapi.LimitType = "openshift.io/ImageStream"
+
+	// ResourceImageStreamTags is the number of distinct tags in the spec and status of an image stream.
+	ResourceImageStreamTags kapi.ResourceName = "openshift.io/image-tags"
+	// ResourceImageStreamImages is the number of distinct images referenced by the status of an image stream.
+	ResourceImageStreamImages kapi.ResourceName = "openshift.io/images"
+	// ResourceImageStorage is the total size of the distinct images pushed to the integrated
+	// registry that are referenced by the image streams of a namespace.
+	ResourceImageStorage kapi.ResourceName = "openshift.io/image-storage"
+)
+
 // Image is an immutable representation of a Docker image and metadata at a point in time.
 type Image struct {
 	unversioned.TypeMeta
@@ -107,6 +123,10 @@ type TagReference struct {
 	Generation *int64
 	// ImportPolicy is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy
+	// TrackVersion, if specified, is a version pattern like "2.x" or "2.1.x". The import controller
+	// points this tag at the tag of this stream with the highest released semantic version matching
+	// the pattern each time new tags are imported.
+	TrackVersion string
 }
 
 type TagImportPolicy struct {
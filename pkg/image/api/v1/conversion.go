@@ -198,6 +198,7 @@ func init() {
 						Insecure:  curr.ImportPolicy.Insecure,
 						Scheduled: curr.ImportPolicy.Scheduled,
 					},
+					TrackVersion: curr.TrackVersion,
 				}
 				if curr.Generation != nil {
 					gen := *curr.Generation
@@ -227,6 +228,7 @@ func init() {
 						Insecure:  newTagReference.ImportPolicy.Insecure,
 						Scheduled: newTagReference.ImportPolicy.Scheduled,
 					},
+					TrackVersion: newTagReference.TrackVersion,
 				}
 				if newTagReference.Generation != nil {
 					gen := *newTagReference.Generation
@@ -85,6 +85,8 @@ type NamedTagReference struct {
 	Generation *int64 `json:"generation" description:"the generation of the image stream this was updated to"`
 	// Import is information that controls how images may be imported by the server.
 	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty" description:"attributes controlling how this reference is imported"`
+	// TrackVersion is a version pattern like "2.x" or "2.1.x"; the tag follows the tag of this stream with the highest matching version.
+	TrackVersion string `json:"trackVersion,omitempty" description:"a version pattern like 2.x or 2.1.x; if set, the tag follows the tag of this stream with the highest matching semantic version"`
 }
 
 type TagImportPolicy struct {
@@ -246,6 +246,7 @@ func init() {
 						Insecure:  curr.ImportPolicy.Insecure,
 						Scheduled: curr.ImportPolicy.Scheduled,
 					},
+					TrackVersion: curr.TrackVersion,
 				}
 				if curr.Generation != nil {
 					gen := *curr.Generation
@@ -275,6 +276,7 @@ func init() {
 						Insecure:  newTagReference.ImportPolicy.Insecure,
 						Scheduled: newTagReference.ImportPolicy.Scheduled,
 					},
+					TrackVersion: newTagReference.TrackVersion,
 				}
 				if newTagReference.Generation != nil {
 					gen := *newTagReference.Generation
@@ -107,6 +107,15 @@ func ValidateImageStream(stream *api.ImageStream) field.ErrorList {
 				result = append(result, field.Invalid(field.NewPath("spec", "tags").Key(tag).Child("from", "kind"), tagRef.From.Kind, "valid values are 'DockerImage', 'ImageStreamImage', 'ImageStreamTag'"))
 			}
 		}
+		if len(tagRef.TrackVersion) > 0 {
+			trackPath := field.NewPath("spec", "tags").Key(tag).Child("trackVersion")
+			if !api.IsValidTrackVersion(tagRef.TrackVersion) {
+				result = append(result, field.Invalid(trackPath, tagRef.TrackVersion, "must be a version pattern like '2.x' or '2.1.x'"))
+			}
+			if tagRef.From != nil && tagRef.From.Kind != "ImageStreamTag" {
+				result = append(result, field.Invalid(trackPath, tagRef.TrackVersion, "only tags pointing to image stream tags may track a version"))
+			}
+		}
 	}
 	for tag, history := range stream.Status.Tags {
 		for i, tagEvent := range history.Items {
@@ -331,10 +331,45 @@ func TestValidateImageStream(t *testing.T) {
 				field.Invalid(field.NewPath("spec", "tags").Key("otherimage").Child("importPolicy", "scheduled"), true, "only tags pointing to Docker repositories may be scheduled for background import"),
 			},
 		},
+		"invalid version pattern": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"tag": {
+					TrackVersion: "2.*",
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tags").Key("tag").Child("trackVersion"), "2.*", "must be a version pattern like '2.x' or '2.1.x'"),
+			},
+		},
+		"DockerImages can't track a version": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"tag": {
+					From: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "abc",
+					},
+					TrackVersion: "2.x",
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tags").Key("tag").Child("trackVersion"), "2.x", "only tags pointing to image stream tags may track a version"),
+			},
+		},
 		"valid": {
 			namespace: "namespace",
 			name:      "foo",
 			specTags: map[string]api.TagReference{
+				"tracking": {
+					From: &kapi.ObjectReference{
+						Kind: "ImageStreamTag",
+						Name: "foo:2.1.0",
+					},
+					TrackVersion: "2.x",
+				},
 				"tag": {
 					From: &kapi.ObjectReference{
 						Kind: "DockerImage",
@@ -7,6 +7,8 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	apierrs "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
 
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/image/api"
@@ -16,6 +18,10 @@ var ErrNotImportable = errors.New("the specified stream cannot be imported")
 
 type ImportController struct {
 	streams client.ImageStreamsNamespacer
+	// recorder records the failed imports of a stream as events. The recorder must correlate the
+	// events, so that a stream failing to import over and over results in one event with a count.
+	// Optional.
+	recorder record.EventRecorder
 }
 
 // Notifier provides information about when the controller makes a decision
@@ -124,10 +130,12 @@ const retryCount = 2
 // 3. spec.DockerImageRepository not defined - import tags per each definition.
 //
 // Notifier, if passed, will be invoked if the stream is going to be imported.
+//
+// When the stream has nothing left to import, the tags that track a version are updated.
 func (c *ImportController) Next(stream *api.ImageStream, notifier Notifier) error {
 	ok, partial := needsImport(stream)
 	if !ok {
-		return nil
+		return c.updateTrackingTags(stream)
 	}
 	glog.V(3).Infof("Importing stream %s/%s partial=%t...", stream.Namespace, stream.Name, partial)
 
@@ -167,9 +175,70 @@ func (c *ImportController) Next(stream *api.ImageStream, notifier Notifier) erro
 			return ErrNotImportable
 		}
 		glog.V(4).Infof("Import stream %s/%s partial=%t error: %v", stream.Namespace, stream.Name, partial, err)
+		// a conflict means the stream changed since it was read, and the newer stream is imported
+		if !apierrs.IsConflict(err) {
+			c.recordEvent(stream, "Unable to import the image stream: %v", err)
+		}
 	} else {
 		glog.V(5).Infof("Import stream %s/%s partial=%t import: %#v", stream.Namespace, stream.Name, partial, result.Status.Import)
+		c.recordFailedImports(stream, result)
+	}
+	return err
+}
+
+// recordFailedImports records an event for each image or repository of the import that failed.
+func (c *ImportController) recordFailedImports(stream *api.ImageStream, isi *api.ImageStreamImport) {
+	if repository := isi.Status.Repository; repository != nil && repository.Status.Status == unversioned.StatusFailure {
+		c.recordEvent(stream, "Importing %s failed: %s", isi.Spec.Repository.From.Name, repository.Status.Message)
+	}
+	for i, image := range isi.Status.Images {
+		if image.Status.Status != unversioned.StatusFailure || i >= len(isi.Spec.Images) {
+			continue
+		}
+		c.recordEvent(stream, "Importing %s failed: %s", isi.Spec.Images[i].From.Name, image.Status.Message)
+	}
+}
+
+// recordEvent records a warning that an import of stream failed. The message should only depend on
+// the cause of the failure, so that repeated failures are correlated into one event.
+func (c *ImportController) recordEvent(stream *api.ImageStream, messageFmt string, args ...interface{}) {
+	if c.recorder == nil {
+		return
+	}
+	c.recorder.Eventf(stream, kapi.EventTypeWarning, "ImportFailed", messageFmt, args...)
+}
+
+// updateTrackingTags points the tags of stream that track a version at the tag with the highest
+// matching version, once the stream has no tags left to import.
+func (c *ImportController) updateTrackingTags(stream *api.ImageStream) error {
+	var updated *api.ImageStream
+	for tag, tagRef := range stream.Spec.Tags {
+		if len(tagRef.TrackVersion) == 0 {
+			continue
+		}
+		latest, ok := api.LatestTrackedTag(stream, tagRef.TrackVersion)
+		if !ok || latest == tag {
+			continue
+		}
+		from := &kapi.ObjectReference{Kind: "ImageStreamTag", Name: api.JoinImageStreamTag(stream.Name, latest)}
+		if tagRef.From != nil && kapi.Semantic.DeepEqual(*tagRef.From, *from) {
+			continue
+		}
+		if updated == nil {
+			obj, err := kapi.Scheme.DeepCopy(stream)
+			if err != nil {
+				return err
+			}
+			updated = obj.(*api.ImageStream)
+		}
+		glog.V(4).Infof("Tag %s of stream %s/%s tracks version %s, pointing it at %s", tag, stream.Namespace, stream.Name, tagRef.TrackVersion, latest)
+		tagRef.From = from
+		updated.Spec.Tags[tag] = tagRef
+	}
+	if updated == nil {
+		return nil
 	}
+	_, err := c.streams.ImageStreams(stream.Namespace).Update(updated)
 	return err
 }
 
@@ -8,6 +8,9 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	apierrs "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 
 	client "github.com/openshift/origin/pkg/client/testclient"
@@ -326,6 +329,118 @@ func TestControllerExternalRepo(t *testing.T) {
 	}
 }
 
+func TestControllerRecordsFailedImports(t *testing.T) {
+	fake := &client.Fake{}
+	fake.AddReactor("create", "imagestreamimports", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		isi := action.(ktestclient.CreateAction).GetObject().(*api.ImageStreamImport)
+		isi.Status.Images = []api.ImageImportStatus{
+			{Tag: "1.1", Status: unversioned.Status{Status: unversioned.StatusFailure, Message: "pull access denied"}},
+			{Tag: "1.2", Status: unversioned.Status{Status: unversioned.StatusSuccess}},
+		}
+		return true, isi, nil
+	})
+	recorder := &record.FakeRecorder{}
+	c := ImportController{streams: fake, recorder: recorder}
+
+	stream := api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "other"},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"1.1": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "some/repo:denied"}},
+			},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if err := c.Next(&stream, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// the events of repeated failures are identical, so that the recorder can correlate them
+	expected := "Warning ImportFailed Importing some/repo:denied failed: pull access denied"
+	if len(recorder.Events) != 2 || recorder.Events[0] != expected || recorder.Events[1] != expected {
+		t.Errorf("unexpected events: %v", recorder.Events)
+	}
+
+	recorder.Events = nil
+	fake.PrependReactor("create", "imagestreamimports", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("server unavailable")
+	})
+	if err := c.Next(&stream, nil); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(recorder.Events) != 1 || recorder.Events[0] != "Warning ImportFailed Unable to import the image stream: server unavailable" {
+		t.Errorf("unexpected events: %v", recorder.Events)
+	}
+
+	// a conflict is retried with the newer stream and is not a failure
+	recorder.Events = nil
+	fake.PrependReactor("create", "imagestreamimports", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrs.NewConflict("imageStream", "test", fmt.Errorf("the stream was modified"))
+	})
+	if err := c.Next(&stream, nil); !apierrs.IsConflict(err) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("unexpected events: %v", recorder.Events)
+	}
+}
+
+func TestControllerTrackVersion(t *testing.T) {
+	stream := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "test",
+			Namespace:   "other",
+			Annotations: map[string]string{api.DockerImageRepositoryCheckAnnotation: "done"},
+		},
+		Spec: api.ImageStreamSpec{
+			DockerImageRepository: "some/repo",
+			Tags: map[string]api.TagReference{
+				"2": {
+					From:         &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "test:2.0.1"},
+					TrackVersion: "2.x",
+				},
+				"3": {TrackVersion: "3.x"},
+			},
+		},
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"2.0.1": {Items: []api.TagEvent{{DockerImageReference: "some/repo@sha256:1"}}},
+				"2.1.0": {Items: []api.TagEvent{{DockerImageReference: "some/repo@sha256:2"}}},
+			},
+		},
+	}
+
+	fake := &client.Fake{}
+	c := ImportController{streams: fake}
+	if err := c.Next(stream, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actions := fake.Actions()
+	if len(actions) != 1 || !actions[0].Matches("update", "imagestreams") {
+		t.Fatalf("expected an update action: %#v", actions)
+	}
+	updated := actions[0].(ktestclient.UpdateAction).GetObject().(*api.ImageStream)
+	if from := updated.Spec.Tags["2"].From; from == nil || from.Kind != "ImageStreamTag" || from.Name != "test:2.1.0" {
+		t.Errorf("expected tag 2 to point at test:2.1.0: %#v", from)
+	}
+	if from := updated.Spec.Tags["3"].From; from != nil {
+		t.Errorf("expected tag 3 to be unchanged: %#v", from)
+	}
+	if from := stream.Spec.Tags["2"].From; from.Name != "test:2.0.1" {
+		t.Errorf("the original stream should not be modified: %#v", from)
+	}
+
+	// once the tags point at the latest version, nothing is updated
+	fake = &client.Fake{}
+	c = ImportController{streams: fake}
+	if err := c.Next(updated, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fake.Actions()) != 0 {
+		t.Errorf("did not expect remote calls: %#v", fake.Actions())
+	}
+}
+
 func TestScheduledImport(t *testing.T) {
 	fake := &client.Fake{}
 	b := newScheduled(true, fake, 1, nil, nil)
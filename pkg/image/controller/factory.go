@@ -7,6 +7,8 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/record"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/watch"
@@ -23,6 +25,8 @@ type ImportControllerFactory struct {
 	MinimumCheckInterval time.Duration
 	ImportRateLimiter    util.RateLimiter
 	ScheduleEnabled      bool
+	// KubeClient is used to record the failed imports as events. Optional.
+	KubeClient kclient.EventNamespacer
 }
 
 // Create creates an ImportController.
@@ -51,6 +55,13 @@ func (f *ImportControllerFactory) Create() (controller.RunnableController, contr
 
 	limiter := util.NewTokenBucketRateLimiter(bucketQPS, 1)
 	b := newScheduled(f.ScheduleEnabled, f.Client, buckets, limiter, f.ImportRateLimiter)
+	if f.KubeClient != nil {
+		// the broadcaster correlates the events, repeated failures of a stream increase the count of
+		// its existing event instead of creating new ones
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(f.KubeClient.Events(""))
+		b.controller.recorder = eventBroadcaster.NewRecorder(kapi.EventSource{Component: "image-import-controller"})
+	}
 
 	// instantiate an importer for changes that happen to the image stream
 	changed := &controller.RetryController{
@@ -0,0 +1,88 @@
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// TriggerAnnotationKey is the annotation that holds the image triggers of an object, as a JSON
+// list of ObjectFieldTriggers.
+const TriggerAnnotationKey = "image.openshift.io/triggers"
+
+// ObjectFieldTrigger sets a field of an object to the latest image of an image stream tag.
+type ObjectFieldTrigger struct {
+	// From is the ImageStreamTag to follow. Its namespace defaults to the namespace of the object.
+	From kapi.ObjectReference `json:"from"`
+	// FieldPath is the image field of a container in the pod template of the object, either
+	// spec.template.spec.containers[?(@.name=="NAME")].image or
+	// spec.template.spec.containers[INDEX].image.
+	FieldPath string `json:"fieldPath"`
+	// Paused suspends the trigger without removing it.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// containerFieldPath matches the container image field paths ObjectFieldTriggers may set.
+var containerFieldPath = regexp.MustCompile(`^spec\.template\.spec\.containers\[(?:\?\(@\.name=="([^"]+)"\)|(\d+))\]\.image$`)
+
+// triggersFor returns the image triggers declared by the annotation of obj, if any.
+func triggersFor(obj runtime.Object) ([]ObjectFieldTrigger, error) {
+	meta, err := kapi.ObjectMetaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := meta.Annotations[TriggerAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	triggers := []ObjectFieldTrigger{}
+	if err := json.Unmarshal([]byte(value), &triggers); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", TriggerAnnotationKey, err)
+	}
+	return triggers, nil
+}
+
+// podSpecFor returns the spec of the pod template of obj, or nil if obj has no pod template.
+func podSpecFor(obj runtime.Object) *kapi.PodSpec {
+	switch t := obj.(type) {
+	case *kapi.ReplicationController:
+		if t.Spec.Template == nil {
+			return nil
+		}
+		return &t.Spec.Template.Spec
+	case *deployapi.DeploymentConfig:
+		if t.Spec.Template == nil {
+			return nil
+		}
+		return &t.Spec.Template.Spec
+	default:
+		return nil
+	}
+}
+
+// containerForFieldPath returns the container of spec whose image is set by fieldPath.
+func containerForFieldPath(spec *kapi.PodSpec, fieldPath string) (*kapi.Container, error) {
+	match := containerFieldPath.FindStringSubmatch(fieldPath)
+	if match == nil {
+		return nil, fmt.Errorf("unsupported field path %q", fieldPath)
+	}
+	if name := match[1]; len(name) > 0 {
+		for i := range spec.Containers {
+			if spec.Containers[i].Name == name {
+				return &spec.Containers[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no container named %q", name)
+	}
+	index, err := strconv.Atoi(match[2])
+	if err != nil || index >= len(spec.Containers) {
+		return nil, fmt.Errorf("no container at index %s", match[2])
+	}
+	return &spec.Containers[index], nil
+}
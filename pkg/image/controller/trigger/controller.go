@@ -0,0 +1,118 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// TriggerController updates the container images of objects that declare image triggers in
+// their TriggerAnnotationKey annotation when a tag of a triggered ImageStream changes. Unlike
+// the image change triggers of deployment configs, the triggers are not tied to the spec of a
+// resource, so any resource with a pod template can use them.
+//
+// Use the TriggerControllerFactory to create this controller.
+type TriggerController struct {
+	sources []triggerSource
+}
+
+// triggerSource lists and updates the objects of one resource that may declare image triggers.
+type triggerSource struct {
+	resource string
+	list     func() []runtime.Object
+	update   func(obj runtime.Object) error
+}
+
+// Handle sets the container images of all objects with a trigger on a tag of stream to the
+// latest image of that tag.
+func (c *TriggerController) Handle(stream *imageapi.ImageStream) error {
+	anyFailed := false
+	for _, source := range c.sources {
+		for _, obj := range source.list() {
+			updated, err := applyTriggers(obj, stream)
+			if err != nil {
+				glog.V(2).Infof("Couldn't apply the image triggers of %s: %v", labelFor(source.resource, obj), err)
+				continue
+			}
+			if updated == nil {
+				continue
+			}
+			if err := source.update(updated); err != nil {
+				anyFailed = true
+				glog.V(2).Infof("Couldn't update the images of %s: %v", labelFor(source.resource, obj), err)
+				continue
+			}
+			glog.V(4).Infof("Updated the images of %s for ImageStream %s/%s", labelFor(source.resource, obj), stream.Namespace, stream.Name)
+		}
+	}
+	if anyFailed {
+		return fmt.Errorf("couldn't update some objects for triggers on ImageStream %s/%s", stream.Namespace, stream.Name)
+	}
+	return nil
+}
+
+// applyTriggers returns a copy of obj with the images set by its triggers on stream, or nil if
+// no image changed.
+func applyTriggers(obj runtime.Object, stream *imageapi.ImageStream) (runtime.Object, error) {
+	triggers, err := triggersFor(obj)
+	if err != nil || len(triggers) == 0 {
+		return nil, err
+	}
+	meta, err := kapi.ObjectMetaFor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	copied, err := kapi.Scheme.Copy(obj)
+	if err != nil {
+		return nil, err
+	}
+	spec := podSpecFor(copied)
+	if spec == nil {
+		return nil, fmt.Errorf("objects of type %T have no pod template", obj)
+	}
+
+	changed := false
+	for _, trigger := range triggers {
+		if trigger.Paused || trigger.From.Kind != "ImageStreamTag" {
+			continue
+		}
+		namespace := trigger.From.Namespace
+		if len(namespace) == 0 {
+			namespace = meta.Namespace
+		}
+		name, tag, ok := imageapi.SplitImageStreamTag(trigger.From.Name)
+		if !ok || namespace != stream.Namespace || name != stream.Name {
+			continue
+		}
+		latest := imageapi.LatestTaggedImage(stream, tag)
+		if latest == nil || len(latest.DockerImageReference) == 0 {
+			continue
+		}
+		container, err := containerForFieldPath(spec, trigger.FieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if container.Image != latest.DockerImageReference {
+			container.Image = latest.DockerImageReference
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+	return copied, nil
+}
+
+func labelFor(resource string, obj runtime.Object) string {
+	meta, err := kapi.ObjectMetaFor(obj)
+	if err != nil {
+		return resource
+	}
+	return fmt.Sprintf("%s %s/%s", resource, meta.Namespace, meta.Name)
+}
@@ -0,0 +1,179 @@
+package trigger
+
+import (
+	"fmt"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const latestImage = "registry:5000/test/app@sha256:0000000000000000000000000000000000000000000000000000000000000001"
+
+func testStream() *imageapi.ImageStream {
+	return &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "app", Namespace: "test"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{DockerImageReference: latestImage}}},
+			},
+		},
+	}
+}
+
+func testRC(name, annotation string) *kapi.ReplicationController {
+	return &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Namespace:   "test",
+			Annotations: map[string]string{TriggerAnnotationKey: annotation},
+		},
+		Spec: kapi.ReplicationControllerSpec{
+			Template: &kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{
+						{Name: "sidecar", Image: "sidecar:1"},
+						{Name: "web", Image: "app:old"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name          string
+		annotation    string
+		expectedImage string
+	}{
+		{
+			name:          "container by name",
+			annotation:    `[{"from":{"kind":"ImageStreamTag","name":"app:latest"},"fieldPath":"spec.template.spec.containers[?(@.name==\"web\")].image"}]`,
+			expectedImage: latestImage,
+		},
+		{
+			name:          "container by index",
+			annotation:    `[{"from":{"kind":"ImageStreamTag","name":"app:latest","namespace":"test"},"fieldPath":"spec.template.spec.containers[1].image"}]`,
+			expectedImage: latestImage,
+		},
+		{
+			name:       "paused trigger",
+			annotation: `[{"from":{"kind":"ImageStreamTag","name":"app:latest"},"fieldPath":"spec.template.spec.containers[1].image","paused":true}]`,
+		},
+		{
+			name:       "other stream",
+			annotation: `[{"from":{"kind":"ImageStreamTag","name":"other:latest"},"fieldPath":"spec.template.spec.containers[1].image"}]`,
+		},
+		{
+			name:       "other namespace",
+			annotation: `[{"from":{"kind":"ImageStreamTag","name":"app:latest","namespace":"other"},"fieldPath":"spec.template.spec.containers[1].image"}]`,
+		},
+		{
+			name:       "tag without images",
+			annotation: `[{"from":{"kind":"ImageStreamTag","name":"app:stable"},"fieldPath":"spec.template.spec.containers[1].image"}]`,
+		},
+		{
+			name:       "missing container",
+			annotation: `[{"from":{"kind":"ImageStreamTag","name":"app:latest"},"fieldPath":"spec.template.spec.containers[?(@.name==\"db\")].image"}]`,
+		},
+		{
+			name:       "invalid annotation",
+			annotation: `{`,
+		},
+	}
+
+	for _, test := range tests {
+		rc := testRC("rc", test.annotation)
+		var updated *kapi.ReplicationController
+		controller := &TriggerController{
+			sources: []triggerSource{{
+				resource: "ReplicationController",
+				list:     func() []runtime.Object { return []runtime.Object{rc} },
+				update: func(obj runtime.Object) error {
+					updated = obj.(*kapi.ReplicationController)
+					return nil
+				},
+			}},
+		}
+		if err := controller.Handle(testStream()); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if rc.Spec.Template.Spec.Containers[1].Image != "app:old" {
+			t.Errorf("%s: the listed object must not be modified", test.name)
+		}
+		switch {
+		case len(test.expectedImage) == 0 && updated != nil:
+			t.Errorf("%s: unexpected update: %#v", test.name, updated)
+		case len(test.expectedImage) > 0 && updated == nil:
+			t.Errorf("%s: expected an update", test.name)
+		case updated != nil:
+			if a := updated.Spec.Template.Spec.Containers[1].Image; a != test.expectedImage {
+				t.Errorf("%s: expected image %s, got %s", test.name, test.expectedImage, a)
+			}
+			if a := updated.Spec.Template.Spec.Containers[0].Image; a != "sidecar:1" {
+				t.Errorf("%s: unexpected change of the other container to %s", test.name, a)
+			}
+		}
+	}
+}
+
+func TestHandleDeploymentConfig(t *testing.T) {
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "dc",
+			Namespace: "test",
+			Annotations: map[string]string{
+				TriggerAnnotationKey: `[{"from":{"kind":"ImageStreamTag","name":"app:latest"},"fieldPath":"spec.template.spec.containers[0].image"}]`,
+			},
+		},
+		Spec: deployapi.DeploymentConfigSpec{
+			Template: &kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{Containers: []kapi.Container{{Name: "web", Image: latestImage}}},
+			},
+		},
+	}
+	controller := &TriggerController{
+		sources: []triggerSource{{
+			resource: "DeploymentConfig",
+			list:     func() []runtime.Object { return []runtime.Object{config} },
+			update: func(obj runtime.Object) error {
+				t.Errorf("unexpected update of an up to date config")
+				return nil
+			},
+		}},
+	}
+	if err := controller.Handle(testStream()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestHandleUpdateError(t *testing.T) {
+	annotation := `[{"from":{"kind":"ImageStreamTag","name":"app:latest"},"fieldPath":"spec.template.spec.containers[1].image"}]`
+	updates := 0
+	controller := &TriggerController{
+		sources: []triggerSource{{
+			resource: "ReplicationController",
+			list: func() []runtime.Object {
+				return []runtime.Object{testRC("a", annotation), testRC("b", annotation)}
+			},
+			update: func(obj runtime.Object) error {
+				updates++
+				if obj.(*kapi.ReplicationController).Name == "a" {
+					return fmt.Errorf("conflict")
+				}
+				return nil
+			},
+		}},
+	}
+	if err := controller.Handle(testStream()); err == nil {
+		t.Errorf("expected an error when an update failed")
+	}
+	if updates != 2 {
+		t.Errorf("expected the remaining objects to be updated, got %d updates", updates)
+	}
+}
@@ -0,0 +1,113 @@
+package trigger
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	controller "github.com/openshift/origin/pkg/controller"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// TriggerControllerFactory can create a TriggerController which watches all ImageStream
+// changes.
+type TriggerControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Create creates a TriggerController.
+func (factory *TriggerControllerFactory) Create() controller.RunnableController {
+	imageStreamLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.ImageStreams(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.ImageStreams(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(imageStreamLW, &imageapi.ImageStream{}, queue, 2*time.Minute).Run()
+
+	deploymentConfigLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	deploymentConfigStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, deploymentConfigStore, 2*time.Minute).Run()
+
+	replicationControllerLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.ReplicationControllers(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.ReplicationControllers(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	replicationControllerStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(replicationControllerLW, &kapi.ReplicationController{}, replicationControllerStore, 2*time.Minute).Run()
+
+	triggerController := &TriggerController{
+		sources: []triggerSource{
+			{
+				resource: "DeploymentConfig",
+				list:     storeLister(deploymentConfigStore),
+				update: func(obj runtime.Object) error {
+					config := obj.(*deployapi.DeploymentConfig)
+					_, err := factory.Client.DeploymentConfigs(config.Namespace).Update(config)
+					return err
+				},
+			},
+			{
+				resource: "ReplicationController",
+				list:     storeLister(replicationControllerStore),
+				update: func(obj runtime.Object) error {
+					rc := obj.(*kapi.ReplicationController)
+					_, err := factory.KubeClient.ReplicationControllers(rc.Namespace).Update(rc)
+					return err
+				},
+			},
+		},
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				return retries.Count < 1
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			stream := obj.(*imageapi.ImageStream)
+			return triggerController.Handle(stream)
+		},
+	}
+}
+
+// storeLister returns a function listing the objects of store.
+func storeLister(store cache.Store) func() []runtime.Object {
+	return func() []runtime.Object {
+		objs := []runtime.Object{}
+		for _, obj := range store.List() {
+			objs = append(objs, obj.(runtime.Object))
+		}
+		return objs
+	}
+}
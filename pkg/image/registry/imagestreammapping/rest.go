@@ -56,7 +56,16 @@ func (s imageStreamMappingStrategy) NamespaceScoped() bool {
 }
 
 // PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+// An image without a name is named after the digest of its reference, so that clients that
+// pushed the image to an external registry only have to report the pull spec by digest.
 func (s imageStreamMappingStrategy) PrepareForCreate(obj runtime.Object) {
+	mapping := obj.(*api.ImageStreamMapping)
+	if len(mapping.Image.Name) != 0 {
+		return
+	}
+	if ref, err := api.ParseDockerImageReference(mapping.Image.DockerImageReference); err == nil {
+		mapping.Image.Name = ref.ID
+	}
 }
 
 // Canonicalize normalizes the object after validation.
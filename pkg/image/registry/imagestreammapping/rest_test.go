@@ -173,6 +173,40 @@ func TestCreateSuccessWithName(t *testing.T) {
 	}
 }
 
+func TestCreateSuccessWithDigestReference(t *testing.T) {
+	client, server, storage := setup(t)
+	defer server.Terminate(t)
+
+	initialRepo := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "somerepo"},
+	}
+	_, err := client.Create(etcdtest.AddPrefix("/imagestreams/default/somerepo"), runtime.EncodeOrDie(latest.Codec, initialRepo), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	digest := "sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238"
+	mapping := &api.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "default", Name: "somerepo"},
+		Image:      api.Image{DockerImageReference: "registry.example.com/ci/somerepo@" + digest},
+		Tag:        "latest",
+	}
+	if _, err := storage.Create(kapi.NewDefaultContext(), mapping); err != nil {
+		t.Fatalf("Unexpected error creating mapping: %#v", err)
+	}
+
+	if _, err := storage.imageRegistry.GetImage(kapi.NewDefaultContext(), digest); err != nil {
+		t.Errorf("Unexpected error retrieving image: %#v", err)
+	}
+	repo, err := storage.imageStreamRegistry.GetImageStream(kapi.NewDefaultContext(), "somerepo")
+	if err != nil {
+		t.Fatalf("Unexpected error: %#v", err)
+	}
+	if e, a := digest, repo.Status.Tags["latest"].Items[0].Image; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
 func TestAddExistingImageWithNewTag(t *testing.T) {
 	imageID := "8d812da98d6dd61620343f1a5bf6585b34ad6ed16e5c5f7c7216a525d6aeb772"
 	existingRepo := &api.ImageStream{
@@ -28,6 +28,7 @@ func init() {
 		&OAuthClientList{},
 		&OAuthClientAuthorization{},
 		&OAuthClientAuthorizationList{},
+		&ServiceAccountTokenRequest{},
 	)
 }
 
@@ -39,3 +40,4 @@ func (*OAuthAuthorizeTokenList) IsAnAPIObject()      {}
 func (*OAuthClientList) IsAnAPIObject()              {}
 func (*OAuthClientAuthorization) IsAnAPIObject()     {}
 func (*OAuthClientAuthorizationList) IsAnAPIObject() {}
+func (*ServiceAccountTokenRequest) IsAnAPIObject()   {}
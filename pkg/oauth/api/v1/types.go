@@ -12,8 +12,8 @@ type OAuthAccessToken struct {
 	// ClientName references the client that created this token.
 	ClientName string `json:"clientName,omitempty" description:"references the client that created this token"`
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
-	ExpiresIn int64 `json:"expiresIn,omitempty" description:"is the seconds from creation time before this token expires"`
+	// ExpiresIn is the seconds from CreationTime before this token expires. 0 means the token does not expire.
+	ExpiresIn int64 `json:"expiresIn,omitempty" description:"is the seconds from creation time before this token expires, 0 means the token does not expire"`
 
 	// Scopes is an array of the requested scopes.
 	Scopes []string `json:"scopes,omitempty" description:"list of requested scopes"`
@@ -41,8 +41,8 @@ type OAuthAuthorizeToken struct {
 	// ClientName references the client that created this token.
 	ClientName string `json:"clientName,omitempty" description:"references the client that created this token"`
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
-	ExpiresIn int64 `json:"expiresIn,omitempty" description:"seconds from creation time before this token expires"`
+	// ExpiresIn is the seconds from CreationTime before this token expires. It must be greater than 0.
+	ExpiresIn int64 `json:"expiresIn,omitempty" description:"seconds from creation time before this token expires, must be greater than 0"`
 
 	// Scopes is an array of the requested scopes.
 	Scopes []string `json:"scopes,omitempty" description:"list of requested scopes"`
@@ -68,11 +68,23 @@ type OAuthClient struct {
 	// Secret is the unique secret associated with a client
 	Secret string `json:"secret,omitempty" description:"unique secret associated with a client"`
 
+	// AdditionalSecrets holds other secrets that may be used to identify the client. This is useful for rotation
+	// and for service account token validation
+	AdditionalSecrets []string `json:"additionalSecrets,omitempty" description:"other secrets that may be used to identify the client; useful for rotation and service account token validation"`
+
 	// RespondWithChallenges indicates whether the client wants authentication needed responses made in the form of challenges instead of redirects
 	RespondWithChallenges bool `json:"respondWithChallenges,omitempty" description:"indicates whether the client wants authentication needed responses made in the form of challenges instead of redirects"`
 
 	// RedirectURIs is the valid redirection URIs associated with a client
 	RedirectURIs []string `json:"redirectURIs,omitempty" description:"valid redirection URIs associated with a client"`
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// 0 means no expiration.
+	AccessTokenMaxAgeSeconds *int32 `json:"accessTokenMaxAgeSeconds,omitempty" description:"overrides the default access token max age for tokens granted to this client; 0 means no expiration"`
+
+	// AuthorizeTokenMaxAgeSeconds overrides the default authorize token max age for tokens granted to this client.
+	// It must be greater than 0.
+	AuthorizeTokenMaxAgeSeconds *int32 `json:"authorizeTokenMaxAgeSeconds,omitempty" description:"overrides the default authorize token max age for tokens granted to this client, must be greater than 0"`
 }
 
 type OAuthClientAuthorization struct {
@@ -93,6 +105,22 @@ type OAuthClientAuthorization struct {
 	Scopes []string `json:"scopes,omitempty" description:"list of granted scopes"`
 }
 
+// ServiceAccountTokenRequest mints a short-lived OAuth access token for the service account with the name of the
+// request, in the namespace of the request.
+type ServiceAccountTokenRequest struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// ExpiresIn is the seconds from creation before the token expires. 0 requests the default lifetime.
+	ExpiresIn int64 `json:"expiresIn,omitempty" description:"seconds from creation before the token expires, 0 requests the default lifetime"`
+
+	// Scopes restrict what the token allows. No scopes allow everything the service account may do.
+	Scopes []string `json:"scopes,omitempty" description:"list of scopes restricting what the token allows"`
+
+	// Token is the minted access token, set by the server.
+	Token string `json:"token,omitempty" description:"minted access token, set by the server"`
+}
+
 type OAuthAccessTokenList struct {
 	unversioned.TypeMeta `json:",inline"`
 	unversioned.ListMeta `json:"metadata,omitempty"`
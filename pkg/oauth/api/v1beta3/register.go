@@ -22,6 +22,7 @@ func init() {
 		&OAuthClientList{},
 		&OAuthClientAuthorization{},
 		&OAuthClientAuthorizationList{},
+		&ServiceAccountTokenRequest{},
 	)
 }
 
@@ -33,3 +34,4 @@ func (*OAuthAuthorizeTokenList) IsAnAPIObject()      {}
 func (*OAuthClientList) IsAnAPIObject()              {}
 func (*OAuthClientAuthorization) IsAnAPIObject()     {}
 func (*OAuthClientAuthorizationList) IsAnAPIObject() {}
+func (*ServiceAccountTokenRequest) IsAnAPIObject()   {}
@@ -12,7 +12,7 @@ type OAuthAccessToken struct {
 	// ClientName references the client that created this token.
 	ClientName string `json:"clientName,omitempty"`
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
+	// ExpiresIn is the seconds from CreationTime before this token expires. 0 means the token does not expire.
 	ExpiresIn int64 `json:"expiresIn,omitempty"`
 
 	// Scopes is an array of the requested scopes.
@@ -41,7 +41,7 @@ type OAuthAuthorizeToken struct {
 	// ClientName references the client that created this token.
 	ClientName string `json:"clientName,omitempty"`
 
-	// ExpiresIn is the seconds from CreationTime before this token expires.
+	// ExpiresIn is the seconds from CreationTime before this token expires. It must be greater than 0.
 	ExpiresIn int64 `json:"expiresIn,omitempty"`
 
 	// Scopes is an array of the requested scopes.
@@ -68,11 +68,23 @@ type OAuthClient struct {
 	// Secret is the unique secret associated with a client
 	Secret string `json:"secret,omitempty"`
 
+	// AdditionalSecrets holds other secrets that may be used to identify the client. This is useful for rotation
+	// and for service account token validation
+	AdditionalSecrets []string `json:"additionalSecrets,omitempty"`
+
 	// RespondWithChallenges indicates whether the client wants authentication needed responses made in the form of challenges instead of redirects
 	RespondWithChallenges bool `json:"respondWithChallenges,omitempty"`
 
 	// RedirectURIs is the valid redirection URIs associated with a client
 	RedirectURIs []string `json:"redirectURIs,omitempty"`
+
+	// AccessTokenMaxAgeSeconds overrides the default access token max age for tokens granted to this client.
+	// 0 means no expiration.
+	AccessTokenMaxAgeSeconds *int32 `json:"accessTokenMaxAgeSeconds,omitempty"`
+
+	// AuthorizeTokenMaxAgeSeconds overrides the default authorize token max age for tokens granted to this client.
+	// It must be greater than 0.
+	AuthorizeTokenMaxAgeSeconds *int32 `json:"authorizeTokenMaxAgeSeconds,omitempty"`
 }
 
 type OAuthClientAuthorization struct {
@@ -93,6 +105,22 @@ type OAuthClientAuthorization struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
+// ServiceAccountTokenRequest mints a short-lived OAuth access token for the service account with the name of the
+// request, in the namespace of the request.
+type ServiceAccountTokenRequest struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// ExpiresIn is the seconds from creation before the token expires. 0 requests the default lifetime.
+	ExpiresIn int64 `json:"expiresIn,omitempty"`
+
+	// Scopes restrict what the token allows. No scopes allow everything the service account may do.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Token is the minted access token, set by the server.
+	Token string `json:"token,omitempty"`
+}
+
 type OAuthAccessTokenList struct {
 	unversioned.TypeMeta `json:",inline"`
 	unversioned.ListMeta `json:"metadata,omitempty"`
@@ -6,9 +6,11 @@ import (
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
+	scopeauthorizer "github.com/openshift/origin/pkg/authorization/authorizer/scope"
 	"github.com/openshift/origin/pkg/oauth/api"
 	uservalidation "github.com/openshift/origin/pkg/user/api/validation"
 )
@@ -60,6 +62,44 @@ func ValidateAccessToken(accessToken *api.OAuthAccessToken) field.ErrorList {
 	if ok, msg := ValidateRedirectURI(accessToken.RedirectURI); !ok {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURI"), accessToken.RedirectURI, msg))
 	}
+	allErrs = append(allErrs, ValidateScopes(accessToken.Scopes, accessToken.ClientName, field.NewPath("scopes"))...)
+	// An access token without an expiration does not expire
+	if accessToken.ExpiresIn < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("expiresIn"), accessToken.ExpiresIn, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateScopes checks that every scope is understood. Service account clients are set up by project members and
+// their secrets are the tokens of the service account, so they must request scopes, and may only request user:info,
+// user:check-access and roles in the namespace of the service account.
+func ValidateScopes(scopes []string, clientName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	saNamespace, _, saErr := serviceaccount.SplitUsername(clientName)
+	isServiceAccountClient := saErr == nil
+	if isServiceAccountClient && len(scopes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath))
+	}
+
+	for i, scope := range scopes {
+		for _, err := range scopeauthorizer.ValidateScopes([]string{scope}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), scope, err.Error()))
+		}
+		if !isServiceAccountClient {
+			continue
+		}
+		switch {
+		case scope == scopeauthorizer.UserInfo, scope == scopeauthorizer.UserAccessCheck:
+		case strings.HasPrefix(scope, scopeauthorizer.ClusterRoleIndicator):
+			if !strings.HasSuffix(scope, ":"+saNamespace) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), scope, fmt.Sprintf("service account clients may only request roles in namespace %s", saNamespace)))
+			}
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), scope, fmt.Sprintf("service account clients may only request %s, %s and %s<name>:%s", scopeauthorizer.UserInfo, scopeauthorizer.UserAccessCheck, scopeauthorizer.ClusterRoleIndicator, saNamespace)))
+		}
+	}
 
 	return allErrs
 }
@@ -75,6 +115,20 @@ func ValidateAuthorizeToken(authorizeToken *api.OAuthAuthorizeToken) field.Error
 	if ok, msg := ValidateRedirectURI(authorizeToken.RedirectURI); !ok {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURI"), authorizeToken.RedirectURI, msg))
 	}
+	allErrs = append(allErrs, ValidateScopes(authorizeToken.Scopes, authorizeToken.ClientName, field.NewPath("scopes"))...)
+	if authorizeToken.ExpiresIn <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("expiresIn"), authorizeToken.ExpiresIn, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func ValidateServiceAccountTokenRequest(request *api.ServiceAccountTokenRequest) field.ErrorList {
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, validation.ValidateServiceAccountName, field.NewPath("metadata"))
+	if request.ExpiresIn < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("expiresIn"), request.ExpiresIn, "must be greater than or equal to 0"))
+	}
+	allErrs = append(allErrs, ValidateScopes(request.Scopes, "", field.NewPath("scopes"))...)
 
 	return allErrs
 }
@@ -86,6 +140,12 @@ func ValidateClient(client *api.OAuthClient) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("redirectURIs").Index(i), redirect, msg))
 		}
 	}
+	if client.AccessTokenMaxAgeSeconds != nil && *client.AccessTokenMaxAgeSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("accessTokenMaxAgeSeconds"), *client.AccessTokenMaxAgeSeconds, "must be greater than or equal to 0"))
+	}
+	if client.AuthorizeTokenMaxAgeSeconds != nil && *client.AuthorizeTokenMaxAgeSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("authorizeTokenMaxAgeSeconds"), *client.AuthorizeTokenMaxAgeSeconds, "must be greater than 0"))
+	}
 
 	return allErrs
 }
@@ -104,13 +164,15 @@ func ValidateClientAuthorizationName(name string, prefix bool) (bool, string) {
 		return ok, reason
 	}
 
-	parts := strings.Split(name, ":")
-	if len(parts) != 2 {
+	// Service account client names contain ":", so split on the last one, which must be followed
+	// by the name of the service account. The name must still match the user and client names.
+	i := strings.LastIndex(name, ":")
+	if i == -1 {
 		return false, "must be in the format <userName>:<clientName>"
 	}
 
-	userName := parts[0]
-	clientName := parts[1]
+	userName := name[:i]
+	clientName := name[i+1:]
 	if len(userName) == 0 || len(clientName) == 0 {
 		return false, "must be in the format <userName>:<clientName>"
 	}
@@ -161,6 +223,9 @@ func ValidateClientAuthorizationUpdate(newAuth *api.OAuthClientAuthorization, ol
 func ValidateClientNameField(value string, fldPath *field.Path) field.ErrorList {
 	if len(value) == 0 {
 		return field.ErrorList{field.Required(fldPath)}
+	} else if _, _, err := serviceaccount.SplitUsername(value); err == nil {
+		// Service accounts can be used as OAuth clients
+		return field.ErrorList{}
 	} else if ok, msg := validation.NameIsDNSSubdomain(value, false); !ok {
 		return field.ErrorList{field.Invalid(fldPath, value, msg)}
 	}
@@ -170,6 +235,9 @@ func ValidateClientNameField(value string, fldPath *field.Path) field.ErrorList
 func ValidateUserNameField(value string, fldPath *field.Path) field.ErrorList {
 	if len(value) == 0 {
 		return field.ErrorList{field.Required(fldPath)}
+	} else if _, _, err := serviceaccount.SplitUsername(value); err == nil {
+		// Access tokens can be requested for service accounts
+		return field.ErrorList{}
 	} else if ok, msg := uservalidation.ValidateUserName(value, false); !ok {
 		return field.ErrorList{field.Invalid(fldPath, value, msg)}
 	}
@@ -97,6 +97,16 @@ func TestValidateClientAuthorization(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateClientAuthorization(&oapi.OAuthClientAuthorization{
+		ObjectMeta: api.ObjectMeta{Name: "myusername:system:serviceaccount:myproject:jenkins"},
+		ClientName: "system:serviceaccount:myproject:jenkins",
+		UserName:   "myusername",
+		UserUID:    "myuseruid",
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success for a service account client: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		A oapi.OAuthClientAuthorization
 		T field.ErrorType
@@ -149,6 +159,25 @@ func TestValidateClientAuthorization(t *testing.T) {
 	}
 }
 
+func TestValidateClientAuthorizationName(t *testing.T) {
+	tests := map[string]bool{
+		"myusername:myclientname":                            true,
+		"myusername:system:serviceaccount:myproject:jenkins": true,
+		"myusername":    false,
+		":myclientname": false,
+		"myusername:system:serviceaccount:myproject:": false,
+	}
+	for name, valid := range tests {
+		if ok, reason := ValidateClientAuthorizationName(name, false); ok != valid {
+			t.Errorf("%s: expected valid=%t, got %t: %s", name, valid, ok, reason)
+		}
+	}
+}
+
+func newInt32(i int32) *int32 {
+	return &i
+}
+
 func TestValidateClient(t *testing.T) {
 	errs := ValidateClient(&oapi.OAuthClient{
 		ObjectMeta: api.ObjectMeta{Name: "client-name"},
@@ -157,6 +186,15 @@ func TestValidateClient(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateClient(&oapi.OAuthClient{
+		ObjectMeta:                  api.ObjectMeta{Name: "client-name"},
+		AccessTokenMaxAgeSeconds:    newInt32(0),
+		AuthorizeTokenMaxAgeSeconds: newInt32(60),
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		Client oapi.OAuthClient
 		T      field.ErrorType
@@ -172,6 +210,16 @@ func TestValidateClient(t *testing.T) {
 			T:      field.ErrorTypeInvalid,
 			F:      "metadata.namespace",
 		},
+		"negative access token max age": {
+			Client: oapi.OAuthClient{ObjectMeta: api.ObjectMeta{Name: "name"}, AccessTokenMaxAgeSeconds: newInt32(-1)},
+			T:      field.ErrorTypeInvalid,
+			F:      "accessTokenMaxAgeSeconds",
+		},
+		"zero authorize token max age": {
+			Client: oapi.OAuthClient{ObjectMeta: api.ObjectMeta{Name: "name"}, AuthorizeTokenMaxAgeSeconds: newInt32(0)},
+			T:      field.ErrorTypeInvalid,
+			F:      "authorizeTokenMaxAgeSeconds",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateClient(&v.Client)
@@ -190,6 +238,59 @@ func TestValidateClient(t *testing.T) {
 	}
 }
 
+func TestValidateServiceAccountTokenRequest(t *testing.T) {
+	errs := ValidateServiceAccountTokenRequest(&oapi.ServiceAccountTokenRequest{
+		ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "foo"},
+		ExpiresIn:  600,
+		Scopes:     []string{"user:info", "role:edit:foo"},
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		Request oapi.ServiceAccountTokenRequest
+		T       field.ErrorType
+		F       string
+	}{
+		"zero-length name": {
+			Request: oapi.ServiceAccountTokenRequest{ObjectMeta: api.ObjectMeta{Namespace: "foo"}},
+			T:       field.ErrorTypeRequired,
+			F:       "metadata.name",
+		},
+		"missing namespace": {
+			Request: oapi.ServiceAccountTokenRequest{ObjectMeta: api.ObjectMeta{Name: "builder"}},
+			T:       field.ErrorTypeRequired,
+			F:       "metadata.namespace",
+		},
+		"negative expiration": {
+			Request: oapi.ServiceAccountTokenRequest{ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "foo"}, ExpiresIn: -1},
+			T:       field.ErrorTypeInvalid,
+			F:       "expiresIn",
+		},
+		"unknown scope": {
+			Request: oapi.ServiceAccountTokenRequest{ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "foo"}, Scopes: []string{"unknown"}},
+			T:       field.ErrorTypeInvalid,
+			F:       "scopes[0]",
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateServiceAccountTokenRequest(&v.Request)
+		if len(errs) == 0 {
+			t.Errorf("expected failure %s for %v", k, v.Request)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Type != v.T {
+				t.Errorf("%s: expected errors to have type %s: %v", k, v.T, errs[i])
+			}
+			if errs[i].Field != v.F {
+				t.Errorf("%s: expected errors to have field %s: %v", k, v.F, errs[i])
+			}
+		}
+	}
+}
+
 func TestValidateAccessTokens(t *testing.T) {
 	errs := ValidateAccessToken(&oapi.OAuthAccessToken{
 		ObjectMeta: api.ObjectMeta{Name: "accessTokenNameWithMinimumLength"},
@@ -201,6 +302,16 @@ func TestValidateAccessTokens(t *testing.T) {
 		t.Errorf("expected success: %v", errs)
 	}
 
+	errs = ValidateAccessToken(&oapi.OAuthAccessToken{
+		ObjectMeta: api.ObjectMeta{Name: "accessTokenNameWithMinimumLength"},
+		ClientName: "myclient",
+		UserName:   "system:serviceaccount:myproject:builder",
+		UserUID:    "myuseruid",
+	})
+	if len(errs) != 0 {
+		t.Errorf("expected success for a service account: %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		Token oapi.OAuthAccessToken
 		T     field.ErrorType
@@ -225,6 +336,17 @@ func TestValidateAccessTokens(t *testing.T) {
 			T: field.ErrorTypeInvalid,
 			F: "metadata.namespace",
 		},
+		"negative expiration": {
+			Token: oapi.OAuthAccessToken{
+				ObjectMeta: api.ObjectMeta{Name: "accessTokenNameWithMinimumLength"},
+				ClientName: "myclient",
+				UserName:   "myusername",
+				UserUID:    "myuseruid",
+				ExpiresIn:  -1,
+			},
+			T: field.ErrorTypeInvalid,
+			F: "expiresIn",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateAccessToken(&v.Token)
@@ -249,6 +371,7 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 		ClientName: "myclient",
 		UserName:   "myusername",
 		UserUID:    "myuseruid",
+		ExpiresIn:  300,
 	})
 	if len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
@@ -264,6 +387,7 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 				ClientName: "myclient",
 				UserName:   "myusername",
 				UserUID:    "myuseruid",
+				ExpiresIn:  300,
 			},
 			T: field.ErrorTypeRequired,
 			F: "metadata.name",
@@ -273,6 +397,7 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
 				UserName:   "myusername",
 				UserUID:    "myuseruid",
+				ExpiresIn:  300,
 			},
 			T: field.ErrorTypeRequired,
 			F: "clientName",
@@ -282,6 +407,7 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
 				ClientName: "myclient",
 				UserUID:    "myuseruid",
+				ExpiresIn:  300,
 			},
 			T: field.ErrorTypeRequired,
 			F: "userName",
@@ -291,6 +417,7 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 				ObjectMeta: api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
 				ClientName: "myclient",
 				UserName:   "myusername",
+				ExpiresIn:  300,
 			},
 			T: field.ErrorTypeRequired,
 			F: "userUID",
@@ -301,10 +428,21 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 				ClientName: "myclient",
 				UserName:   "myusername",
 				UserUID:    "myuseruid",
+				ExpiresIn:  300,
 			},
 			T: field.ErrorTypeInvalid,
 			F: "metadata.namespace",
 		},
+		"zero expiration": {
+			Token: oapi.OAuthAuthorizeToken{
+				ObjectMeta: api.ObjectMeta{Name: "authorizeTokenNameWithMinimumLength"},
+				ClientName: "myclient",
+				UserName:   "myusername",
+				UserUID:    "myuseruid",
+			},
+			T: field.ErrorTypeInvalid,
+			F: "expiresIn",
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateAuthorizeToken(&v.Token)
@@ -322,3 +460,64 @@ func TestValidateAuthorizeTokens(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateScopes(t *testing.T) {
+	testCases := map[string]struct {
+		scopes     []string
+		clientName string
+		fields     []string
+	}{
+		"no scopes": {
+			clientName: "openshift-web-console",
+		},
+		"valid scopes": {
+			scopes:     []string{"user:info", "role:view:foo"},
+			clientName: "openshift-web-console",
+		},
+		"unknown scope": {
+			scopes:     []string{"user:info", "unknown"},
+			clientName: "openshift-web-console",
+			fields:     []string{"scopes[1]"},
+		},
+		"service account client without scopes": {
+			clientName: "system:serviceaccount:foo:jenkins",
+			fields:     []string{"scopes"},
+		},
+		"service account client with scopes in its namespace": {
+			scopes:     []string{"user:info", "role:edit:foo"},
+			clientName: "system:serviceaccount:foo:jenkins",
+		},
+		"service account client with full access": {
+			scopes:     []string{"user:full"},
+			clientName: "system:serviceaccount:foo:jenkins",
+			fields:     []string{"scopes[0]"},
+		},
+		"service account client listing projects": {
+			scopes:     []string{"user:check-access", "user:list-projects"},
+			clientName: "system:serviceaccount:foo:jenkins",
+			fields:     []string{"scopes[1]"},
+		},
+		"service account client with scopes in another namespace": {
+			scopes:     []string{"role:edit:bar", "role:edit:*"},
+			clientName: "system:serviceaccount:foo:jenkins",
+			fields:     []string{"scopes[0]", "scopes[1]"},
+		},
+	}
+
+	for name, tc := range testCases {
+		errs := ValidateScopes(tc.scopes, tc.clientName, field.NewPath("scopes"))
+		fields := []string{}
+		for i := range errs {
+			fields = append(fields, errs[i].Field)
+		}
+		if len(fields) != len(tc.fields) {
+			t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+			continue
+		}
+		for i := range fields {
+			if fields[i] != tc.fields[i] {
+				t.Errorf("%s: expected errors on %v, got %v", name, tc.fields, errs)
+			}
+		}
+	}
+}
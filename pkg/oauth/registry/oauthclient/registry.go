@@ -22,6 +22,12 @@ type Registry interface {
 	DeleteClient(ctx kapi.Context, name string) error
 }
 
+// Getter exposes a way to get a specific client. The OAuth server only needs to look up clients,
+// which allows other sources of clients, like service accounts, to be used in front of the registry.
+type Getter interface {
+	GetClient(ctx kapi.Context, name string) (*api.OAuthClient, error)
+}
+
 // storage puts strong typing around storage calls
 type storage struct {
 	rest.StandardStorage
@@ -0,0 +1,97 @@
+package serviceaccounttokenrequest
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/api/validation"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+	"github.com/openshift/origin/pkg/oauth/server/osinserver"
+)
+
+const (
+	// ClientName is recorded as the client of the access tokens minted for service accounts
+	ClientName = "openshift-service-account-token-request"
+
+	// DefaultExpiresIn is the lifetime of tokens whose request does not set one, in seconds
+	DefaultExpiresIn = 60 * 60
+)
+
+// REST mints access tokens for service accounts
+type REST struct {
+	tokens          oauthaccesstoken.Registry
+	serviceAccounts serviceaccount.ServiceAccountTokenGetter
+	// maxExpiresIn is the longest lifetime a token may be requested for, in seconds. 0 means no limit.
+	maxExpiresIn int64
+}
+
+var _ rest.Creater = &REST{}
+
+// NewREST returns a RESTStorage object that mints access tokens for service accounts, valid for at most maxExpiresIn
+// seconds
+func NewREST(tokens oauthaccesstoken.Registry, serviceAccounts serviceaccount.ServiceAccountTokenGetter, maxExpiresIn int64) *REST {
+	return &REST{tokens: tokens, serviceAccounts: serviceAccounts, maxExpiresIn: maxExpiresIn}
+}
+
+func (r *REST) New() runtime.Object {
+	return &api.ServiceAccountTokenRequest{}
+}
+
+// Create mints an access token for the service account named by the request, and returns the request with the token
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	request, ok := obj.(*api.ServiceAccountTokenRequest)
+	if !ok {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("not a serviceAccountTokenRequest: %#v", obj))
+	}
+	if !kapi.ValidNamespace(ctx, &request.ObjectMeta) {
+		return nil, kerrors.NewConflict("serviceAccountTokenRequest", request.Name, fmt.Errorf("the namespace of the request does not match the namespace of the service account"))
+	}
+
+	if request.ExpiresIn == 0 {
+		request.ExpiresIn = DefaultExpiresIn
+		if r.maxExpiresIn > 0 && r.maxExpiresIn < request.ExpiresIn {
+			request.ExpiresIn = r.maxExpiresIn
+		}
+	}
+	errs := validation.ValidateServiceAccountTokenRequest(request)
+	if r.maxExpiresIn > 0 && request.ExpiresIn > r.maxExpiresIn {
+		errs = append(errs, field.Invalid(field.NewPath("expiresIn"), request.ExpiresIn, fmt.Sprintf("must be less than or equal to %d", r.maxExpiresIn)))
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewInvalid("ServiceAccountTokenRequest", request.Name, errs)
+	}
+
+	serviceAccount, err := r.serviceAccounts.GetServiceAccount(request.Namespace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := osinserver.TokenGen{}.GenerateAccessToken(nil, false)
+	if err != nil {
+		return nil, err
+	}
+	token := &api.OAuthAccessToken{
+		ObjectMeta: kapi.ObjectMeta{Name: value},
+		ClientName: ClientName,
+		ExpiresIn:  request.ExpiresIn,
+		Scopes:     request.Scopes,
+		UserName:   serviceaccount.MakeUsername(serviceAccount.Namespace, serviceAccount.Name),
+		UserUID:    string(serviceAccount.UID),
+	}
+	// access tokens are not namespaced
+	token, err = r.tokens.CreateAccessToken(kapi.NewContext(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Token = token.Name
+	request.CreationTimestamp = token.CreationTimestamp
+	return request, nil
+}
@@ -0,0 +1,126 @@
+package serviceaccounttokenrequest
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
+)
+
+type createdAccessTokenRegistry struct {
+	test.AccessTokenRegistry
+	created *api.OAuthAccessToken
+}
+
+func (r *createdAccessTokenRegistry) CreateAccessToken(ctx kapi.Context, token *api.OAuthAccessToken) (*api.OAuthAccessToken, error) {
+	if namespace, ok := kapi.NamespaceFrom(ctx); ok && len(namespace) > 0 {
+		return nil, kerrors.NewBadRequest("access tokens are not namespaced")
+	}
+	r.created = token
+	return token, nil
+}
+
+type fakeServiceAccountGetter struct {
+	serviceAccount *kapi.ServiceAccount
+}
+
+func (g *fakeServiceAccountGetter) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	if namespace != g.serviceAccount.Namespace || name != g.serviceAccount.Name {
+		return nil, kerrors.NewNotFound("ServiceAccount", name)
+	}
+	return g.serviceAccount, nil
+}
+
+func (g *fakeServiceAccountGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	return nil, kerrors.NewNotFound("Secret", name)
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name              string
+		request           *api.ServiceAccountTokenRequest
+		maxExpiresIn      int64
+		expectedExpiresIn int64
+		expectNotFound    bool
+		expectInvalid     bool
+	}{
+		{
+			name:              "default lifetime",
+			request:           &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test"}},
+			expectedExpiresIn: DefaultExpiresIn,
+		},
+		{
+			name:              "default lifetime limited by the maximum",
+			request:           &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test"}},
+			maxExpiresIn:      600,
+			expectedExpiresIn: 600,
+		},
+		{
+			name:              "requested lifetime and scopes",
+			request:           &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test"}, ExpiresIn: 300, Scopes: []string{"user:info"}},
+			maxExpiresIn:      600,
+			expectedExpiresIn: 300,
+		},
+		{
+			name:          "requested lifetime over the maximum",
+			request:       &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test"}, ExpiresIn: 6000},
+			maxExpiresIn:  600,
+			expectInvalid: true,
+		},
+		{
+			name:          "invalid scope",
+			request:       &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test"}, Scopes: []string{"unknown"}},
+			expectInvalid: true,
+		},
+		{
+			name:           "missing service account",
+			request:        &api.ServiceAccountTokenRequest{ObjectMeta: kapi.ObjectMeta{Name: "other", Namespace: "test"}},
+			expectNotFound: true,
+		},
+	}
+
+	for _, test := range tests {
+		tokens := &createdAccessTokenRegistry{}
+		serviceAccounts := &fakeServiceAccountGetter{serviceAccount: &kapi.ServiceAccount{ObjectMeta: kapi.ObjectMeta{Name: "robot", Namespace: "test", UID: "1"}}}
+		storage := NewREST(tokens, serviceAccounts, test.maxExpiresIn)
+
+		obj, err := storage.Create(kapi.WithNamespace(kapi.NewContext(), "test"), test.request)
+		switch {
+		case test.expectInvalid:
+			if !kerrors.IsInvalid(err) {
+				t.Errorf("%s: expected an invalid error, got %v", test.name, err)
+			}
+			continue
+		case test.expectNotFound:
+			if !kerrors.IsNotFound(err) {
+				t.Errorf("%s: expected a not found error, got %v", test.name, err)
+			}
+			continue
+		case err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if tokens.created == nil {
+			t.Errorf("%s: expected an access token to be created", test.name)
+			continue
+		}
+
+		request := obj.(*api.ServiceAccountTokenRequest)
+		if len(request.Token) == 0 || request.Token != tokens.created.Name {
+			t.Errorf("%s: expected token %q, got %q", test.name, tokens.created.Name, request.Token)
+		}
+		if request.ExpiresIn != test.expectedExpiresIn || tokens.created.ExpiresIn != test.expectedExpiresIn {
+			t.Errorf("%s: expected lifetime %d, got %d and %d", test.name, test.expectedExpiresIn, request.ExpiresIn, tokens.created.ExpiresIn)
+		}
+		if tokens.created.UserName != "system:serviceaccount:test:robot" || tokens.created.UserUID != "1" || tokens.created.ClientName != ClientName {
+			t.Errorf("%s: unexpected access token %#v", test.name, tokens.created)
+		}
+		if !reflect.DeepEqual(tokens.created.Scopes, test.request.Scopes) {
+			t.Errorf("%s: expected scopes %v, got %v", test.name, test.request.Scopes, tokens.created.Scopes)
+		}
+	}
+}
@@ -12,9 +12,11 @@ type AccessTokenRegistry struct {
 	AccessTokens           *api.OAuthAccessTokenList
 	AccessToken            *api.OAuthAccessToken
 	DeletedAccessTokenName string
+	ListOptions            *unversioned.ListOptions
 }
 
 func (r *AccessTokenRegistry) ListAccessTokens(ctx kapi.Context, options *unversioned.ListOptions) (*api.OAuthAccessTokenList, error) {
+	r.ListOptions = options
 	return r.AccessTokens, r.Err
 }
 
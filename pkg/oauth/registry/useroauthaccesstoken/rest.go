@@ -0,0 +1,107 @@
+package useroauthaccesstoken
+
+import (
+	"errors"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken"
+)
+
+// REST exposes the access tokens of the current user. Tokens belonging to other users are never returned or deleted.
+type REST struct {
+	registry oauthaccesstoken.Registry
+}
+
+var _ rest.Getter = &REST{}
+var _ rest.Lister = &REST{}
+var _ rest.GracefulDeleter = &REST{}
+
+// NewREST returns a RESTStorage object that lets users manage their own access tokens
+func NewREST(registry oauthaccesstoken.Registry) *REST {
+	return &REST{registry: registry}
+}
+
+func (r *REST) New() runtime.Object {
+	return &api.OAuthAccessToken{}
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &api.OAuthAccessTokenList{}
+}
+
+// List returns the access tokens of the current user that match the options
+func (r *REST) List(ctx kapi.Context, options *unversioned.ListOptions) (runtime.Object, error) {
+	userName, err := userNameFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userOptions := unversioned.ListOptions{}
+	if options != nil {
+		userOptions = *options
+	}
+	userOptions.FieldSelector.Selector, err = userNameSelector(userOptions.FieldSelector.Selector, userName)
+	if err != nil {
+		return nil, kerrors.NewBadRequest(err.Error())
+	}
+
+	return r.registry.ListAccessTokens(ctx, &userOptions)
+}
+
+// Get returns the named access token if it belongs to the current user
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return r.getUserToken(ctx, name)
+}
+
+// Delete removes the named access token if it belongs to the current user
+func (r *REST) Delete(ctx kapi.Context, name string, options *kapi.DeleteOptions) (runtime.Object, error) {
+	if _, err := r.getUserToken(ctx, name); err != nil {
+		return nil, err
+	}
+	if err := r.registry.DeleteAccessToken(ctx, name); err != nil {
+		return nil, err
+	}
+	return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
+}
+
+// getUserToken returns the named access token, or a not found error if it belongs to another user
+func (r *REST) getUserToken(ctx kapi.Context, name string) (*api.OAuthAccessToken, error) {
+	userName, err := userNameFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := r.registry.GetAccessToken(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// don't reveal the existence of tokens owned by other users
+	if token.UserName != userName {
+		return nil, kerrors.NewNotFound("OAuthAccessToken", name)
+	}
+	return token, nil
+}
+
+// userNameSelector restricts selector to the tokens of userName
+func userNameSelector(selector fields.Selector, userName string) (fields.Selector, error) {
+	userSelector := fields.OneTermEqualSelector("userName", userName)
+	if selector == nil || selector.Empty() {
+		return userSelector, nil
+	}
+	return fields.ParseSelector(selector.String() + "," + userSelector.String())
+}
+
+func userNameFrom(ctx kapi.Context) (string, error) {
+	user, ok := kapi.UserFrom(ctx)
+	if !ok || len(user.GetName()) == 0 {
+		return "", kerrors.NewForbidden("OAuthAccessToken", "", errors.New("requests for user access tokens must be authenticated"))
+	}
+	return user.GetName(), nil
+}
@@ -0,0 +1,79 @@
+package useroauthaccesstoken
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/fields"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
+)
+
+func TestList(t *testing.T) {
+	testCases := map[string]struct {
+		options  *unversioned.ListOptions
+		selector string
+	}{
+		"no options": {
+			selector: "userName=bob",
+		},
+		"client selector": {
+			options:  &unversioned.ListOptions{FieldSelector: unversioned.FieldSelector{Selector: fields.OneTermEqualSelector("clientName", "cli")}},
+			selector: "clientName=cli,userName=bob",
+		},
+		"other user selector": {
+			options:  &unversioned.ListOptions{FieldSelector: unversioned.FieldSelector{Selector: fields.OneTermEqualSelector("userName", "alice")}},
+			selector: "userName=alice,userName=bob",
+		},
+	}
+
+	for k, tc := range testCases {
+		registry := &test.AccessTokenRegistry{AccessTokens: &api.OAuthAccessTokenList{}}
+		storage := NewREST(registry)
+
+		if _, err := storage.List(userContext("bob"), tc.options); err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		if selector := registry.ListOptions.FieldSelector.Selector.String(); selector != tc.selector {
+			t.Errorf("%s: expected selector %q, got %q", k, tc.selector, selector)
+		}
+	}
+
+	registry := &test.AccessTokenRegistry{}
+	if _, err := NewREST(registry).List(kapi.NewContext(), nil); !kerrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error without a user, got %v", err)
+	}
+	if registry.ListOptions != nil {
+		t.Errorf("expected tokens not to be listed without a user")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	registry := &test.AccessTokenRegistry{
+		AccessToken: &api.OAuthAccessToken{ObjectMeta: kapi.ObjectMeta{Name: "bob-token"}, UserName: "bob"},
+	}
+	storage := NewREST(registry)
+
+	if _, err := storage.Delete(userContext("alice"), "bob-token", nil); !kerrors.IsNotFound(err) {
+		t.Errorf("expected a not found error deleting another user's token, got %v", err)
+	}
+	if len(registry.DeletedAccessTokenName) != 0 {
+		t.Errorf("expected another user's token not to be deleted")
+	}
+
+	if _, err := storage.Delete(userContext("bob"), "bob-token", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registry.DeletedAccessTokenName != "bob-token" {
+		t.Errorf("expected bob-token to be deleted, got %q", registry.DeletedAccessTokenName)
+	}
+}
+
+func userContext(name string) kapi.Context {
+	return kapi.WithUser(kapi.NewContext(), &user.DefaultInfo{Name: name})
+}
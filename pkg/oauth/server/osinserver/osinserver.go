@@ -111,6 +111,10 @@ func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		s.server.FinishAccessRequest(resp, r, ar)
+		// A token that does not expire is returned without an expires_in
+		if expiresIn, ok := resp.Output["expires_in"].(int32); ok && expiresIn == 0 {
+			delete(resp.Output, "expires_in")
+		}
 	}
 	if resp.IsError && resp.InternalError != nil {
 		util.HandleError(fmt.Errorf("internal error: %s", resp.InternalError))
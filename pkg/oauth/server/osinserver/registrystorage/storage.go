@@ -1,7 +1,9 @@
 package registrystorage
 
 import (
+	"crypto/subtle"
 	"errors"
+	"math"
 	"strings"
 
 	"github.com/RangelReale/osin"
@@ -26,11 +28,11 @@ type UserConversion interface {
 type storage struct {
 	accesstoken    oauthaccesstoken.Registry
 	authorizetoken oauthauthorizetoken.Registry
-	client         oauthclient.Registry
+	client         oauthclient.Getter
 	user           UserConversion
 }
 
-func New(access oauthaccesstoken.Registry, authorize oauthauthorizetoken.Registry, client oauthclient.Registry, user UserConversion) osin.Storage {
+func New(access oauthaccesstoken.Registry, authorize oauthauthorizetoken.Registry, client oauthclient.Getter, user UserConversion) osin.Storage {
 	return &storage{
 		accesstoken:    access,
 		authorizetoken: authorize,
@@ -52,6 +54,19 @@ func (w *clientWrapper) GetSecret() string {
 	return w.client.Secret
 }
 
+// ClientSecretMatches implements osin.ClientSecretMatcher to allow any of the client's secrets to be used
+func (w *clientWrapper) ClientSecretMatches(in string) bool {
+	if subtle.ConstantTimeCompare([]byte(w.client.Secret), []byte(in)) == 1 {
+		return true
+	}
+	for _, secret := range w.client.AdditionalSecrets {
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(in)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *clientWrapper) GetRedirectUri() string {
 	if len(w.client.RedirectURIs) == 0 {
 		return ""
@@ -89,6 +104,9 @@ func (s *storage) GetClient(id string) (osin.Client, error) {
 
 // SaveAuthorize saves authorize data.
 func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
+	if client, ok := data.Client.GetUserData().(*api.OAuthClient); ok && client.AuthorizeTokenMaxAgeSeconds != nil {
+		data.ExpiresIn = *client.AuthorizeTokenMaxAgeSeconds
+	}
 	token, err := s.convertToAuthorizeToken(data)
 	if err != nil {
 		return err
@@ -117,6 +135,10 @@ func (s *storage) RemoveAuthorize(code string) error {
 // SaveAccess writes AccessData.
 // If RefreshToken is not blank, it must save in a way that can be loaded using LoadRefresh.
 func (s *storage) SaveAccess(data *osin.AccessData) error {
+	// Apply the client's token lifetime before saving, so the expires_in returned to the client matches the stored token
+	if client, ok := data.Client.GetUserData().(*api.OAuthClient); ok && client.AccessTokenMaxAgeSeconds != nil {
+		data.ExpiresIn = *client.AccessTokenMaxAgeSeconds
+	}
 	token, err := s.convertToAccessToken(data)
 	if err != nil {
 		return err
@@ -225,11 +247,17 @@ func (s *storage) convertFromAccessToken(access *api.OAuthAccessToken) (*osin.Ac
 		return nil, err
 	}
 
+	// osin treats an ExpiresIn of 0 as already expired, while a stored token without one does not expire
+	expiresIn := int32(access.ExpiresIn)
+	if expiresIn == 0 {
+		expiresIn = math.MaxInt32
+	}
+
 	return &osin.AccessData{
 		AccessToken:  access.Name,
 		RefreshToken: access.RefreshToken,
 		Client:       &clientWrapper{access.ClientName, client},
-		ExpiresIn:    int32(access.ExpiresIn),
+		ExpiresIn:    expiresIn,
 		Scope:        scope.Join(access.Scopes),
 		RedirectUri:  access.RedirectURI,
 		CreatedAt:    access.CreationTimestamp.Time,
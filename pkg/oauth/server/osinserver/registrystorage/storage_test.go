@@ -2,8 +2,103 @@ package registrystorage
 
 import (
 	"testing"
+	"time"
+
+	"github.com/RangelReale/osin"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
 )
 
+type testUserConversion struct{}
+
+func (testUserConversion) ConvertToAuthorizeToken(interface{}, *api.OAuthAuthorizeToken) error {
+	return nil
+}
+func (testUserConversion) ConvertToAccessToken(interface{}, *api.OAuthAccessToken) error {
+	return nil
+}
+func (testUserConversion) ConvertFromAuthorizeToken(*api.OAuthAuthorizeToken) (interface{}, error) {
+	return nil, nil
+}
+func (testUserConversion) ConvertFromAccessToken(*api.OAuthAccessToken) (interface{}, error) {
+	return nil, nil
+}
+
 func TestRegistry(t *testing.T) {
 	_ = storage{}
 }
+
+func TestSaveTokensUseClientMaxAge(t *testing.T) {
+	noExpiration := int32(0)
+	authorizeMaxAge := int32(60)
+	client := &api.OAuthClient{AccessTokenMaxAgeSeconds: &noExpiration, AuthorizeTokenMaxAgeSeconds: &authorizeMaxAge}
+	defaultClient := &api.OAuthClient{}
+
+	s := New(&test.AccessTokenRegistry{}, &test.AuthorizeTokenRegistry{}, &test.ClientRegistry{}, testUserConversion{})
+
+	authorize := &osin.AuthorizeData{Client: &clientWrapper{"client", client}, ExpiresIn: 300}
+	if err := s.SaveAuthorize(authorize); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorize.ExpiresIn != 60 {
+		t.Errorf("expected the client's authorize token max age, got %d", authorize.ExpiresIn)
+	}
+
+	access := &osin.AccessData{Client: &clientWrapper{"client", client}, ExpiresIn: 86400}
+	if err := s.SaveAccess(access); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if access.ExpiresIn != 0 {
+		t.Errorf("expected the client's access token max age, got %d", access.ExpiresIn)
+	}
+
+	access = &osin.AccessData{Client: &clientWrapper{"client", defaultClient}, ExpiresIn: 86400}
+	if err := s.SaveAccess(access); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if access.ExpiresIn != 86400 {
+		t.Errorf("expected the default access token max age, got %d", access.ExpiresIn)
+	}
+}
+
+func TestLoadAccessWithoutExpiration(t *testing.T) {
+	created := unversioned.Time{Time: time.Now().Add(-48 * time.Hour)}
+	tokens := &test.AccessTokenRegistry{
+		AccessToken: &api.OAuthAccessToken{ObjectMeta: kapi.ObjectMeta{Name: "token", CreationTimestamp: created}, ClientName: "client"},
+	}
+	s := New(tokens, &test.AuthorizeTokenRegistry{}, &test.ClientRegistry{Client: &api.OAuthClient{}}, testUserConversion{})
+
+	access, err := s.LoadAccess("token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if access.IsExpired() {
+		t.Errorf("expected a token without an expiration not to expire")
+	}
+
+	tokens.AccessToken.ExpiresIn = 60
+	access, err = s.LoadAccess("token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !access.IsExpired() {
+		t.Errorf("expected the token to have expired")
+	}
+}
+
+func TestClientSecretMatches(t *testing.T) {
+	client := &clientWrapper{"client", &api.OAuthClient{Secret: "secret", AdditionalSecrets: []string{"other-secret"}}}
+	for _, secret := range []string{"secret", "other-secret"} {
+		if !osin.CheckClientSecret(client, secret) {
+			t.Errorf("expected %q to match", secret)
+		}
+	}
+	for _, secret := range []string{"", "unknown"} {
+		if osin.CheckClientSecret(client, secret) {
+			t.Errorf("expected %q not to match", secret)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package admission
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+func init() {
+	admission.RegisterPlugin("PodPreset", func(kubeClient kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewPodPreset(), nil
+	})
+}
+
+type podPreset struct {
+	*admission.Handler
+	client client.Interface
+}
+
+// ensure that the required Openshift admission interfaces are implemented
+var _ = oadmission.WantsOpenshiftClient(&podPreset{})
+var _ = oadmission.Validator(&podPreset{})
+
+// NewPodPreset returns an admission controller that injects the environment variables and volumes
+// of the pod presets of a namespace into the new pods they select.
+func NewPodPreset() admission.Interface {
+	return &podPreset{
+		Handler: admission.NewHandler(admission.Create),
+	}
+}
+
+// Admit applies every pod preset that selects a new pod, in order of name, and records the applied
+// presets in annotations on the pod.
+func (p *podPreset) Admit(a admission.Attributes) error {
+	if a.GetResource() != kapi.Resource("pods") || a.GetSubresource() != "" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*kapi.Pod)
+	if !ok {
+		return nil
+	}
+	if pod.Annotations[podpresetapi.PodPresetExcludeAnnotation] == "true" {
+		return nil
+	}
+
+	presets, err := p.client.PodPresets(a.GetNamespace()).List(kapi.ListOptions{})
+	if err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("unable to %s %s at this time because there was an error retrieving pod presets: %v", a.GetOperation(), a.GetResource(), err))
+	}
+	matching := []podpresetapi.PodPreset{}
+	for _, preset := range presets.Items {
+		if labels.SelectorFromSet(preset.Spec.Selector).Matches(labels.Set(pod.Labels)) {
+			matching = append(matching, preset)
+		}
+	}
+	sort.Sort(byName(matching))
+
+	for i := range matching {
+		ApplyPodPreset(&matching[i], pod)
+		glog.V(4).Infof("Applied pod preset %s/%s to pod %s", matching[i].Namespace, matching[i].Name, pod.Name)
+	}
+	return nil
+}
+
+// ApplyPodPreset injects the environment variables, volumes and volume mounts of the preset into
+// the pod. Settings the pod already has, whether from its template or from an earlier preset, take
+// precedence. A volume of the preset that has the name of an existing volume is not added, and
+// neither are its mounts, so the preset never mounts a volume it does not define.
+func ApplyPodPreset(preset *podpresetapi.PodPreset, pod *kapi.Pod) {
+	existingVolumes := map[string]bool{}
+	for _, volume := range pod.Spec.Volumes {
+		existingVolumes[volume.Name] = true
+	}
+	skippedVolumes := map[string]bool{}
+	for _, volume := range preset.Spec.Volumes {
+		if existingVolumes[volume.Name] {
+			skippedVolumes[volume.Name] = true
+			continue
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	}
+
+	for i := range pod.Spec.Containers {
+		applyToContainer(preset, skippedVolumes, &pod.Spec.Containers[i])
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[podpresetapi.PodPresetAnnotationPrefix+preset.Name] = preset.ResourceVersion
+}
+
+func applyToContainer(preset *podpresetapi.PodPreset, skippedVolumes map[string]bool, container *kapi.Container) {
+	envNames := map[string]bool{}
+	for _, env := range container.Env {
+		envNames[env.Name] = true
+	}
+	for _, env := range preset.Spec.Env {
+		if !envNames[env.Name] {
+			container.Env = append(container.Env, env)
+		}
+	}
+
+	mountPaths := map[string]bool{}
+	for _, mount := range container.VolumeMounts {
+		mountPaths[mount.MountPath] = true
+	}
+	for _, mount := range preset.Spec.VolumeMounts {
+		if !mountPaths[mount.MountPath] && !skippedVolumes[mount.Name] {
+			container.VolumeMounts = append(container.VolumeMounts, mount)
+		}
+	}
+}
+
+func (p *podPreset) SetOpenshiftClient(client client.Interface) {
+	p.client = client
+}
+
+func (p *podPreset) Validate() error {
+	if p.client == nil {
+		return fmt.Errorf("PodPreset plugin requires an Openshift client")
+	}
+	return nil
+}
+
+type byName []podpresetapi.PodPreset
+
+func (p byName) Len() int           { return len(p) }
+func (p byName) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byName) Less(i, j int) bool { return p[i].Name < p[j].Name }
@@ -0,0 +1,172 @@
+package admission
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+func databasePreset() podpresetapi.PodPreset {
+	return podpresetapi.PodPreset{
+		ObjectMeta: kapi.ObjectMeta{Name: "database", Namespace: "app", ResourceVersion: "7"},
+		Spec: podpresetapi.PodPresetSpec{
+			Selector: map[string]string{"role": "frontend"},
+			Env:      []kapi.EnvVar{{Name: "DB_URL", Value: "postgresql://db:5432"}},
+			Volumes: []kapi.Volume{{
+				Name:         "db-credentials",
+				VolumeSource: kapi.VolumeSource{Secret: &kapi.SecretVolumeSource{SecretName: "db-credentials"}},
+			}},
+			VolumeMounts: []kapi.VolumeMount{{Name: "db-credentials", MountPath: "/etc/db", ReadOnly: true}},
+		},
+	}
+}
+
+func newTestAdmission(t *testing.T, presets ...podpresetapi.PodPreset) (admission.Interface, *testclient.Fake) {
+	originClient := &testclient.Fake{}
+	originClient.AddReactor("list", "podpresets", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &podpresetapi.PodPresetList{Items: presets}, nil
+	})
+
+	plugin := NewPodPreset()
+	plugin.(*podPreset).SetOpenshiftClient(originClient)
+	if err := plugin.(*podPreset).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	return plugin, originClient
+}
+
+func testPod(labels map[string]string) *kapi.Pod {
+	return &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Name: "pod", Namespace: "app", Labels: labels},
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{{Name: "web", Image: "web"}, {Name: "sidecar", Image: "sidecar"}},
+		},
+	}
+}
+
+func podAttributes(pod *kapi.Pod) admission.Attributes {
+	return admission.NewAttributesRecord(pod, kapi.Kind("Pod"), pod.Namespace, pod.Name, kapi.Resource("pods"), "", admission.Create, nil)
+}
+
+func TestAdmitInjectsMatchingPreset(t *testing.T) {
+	plugin, _ := newTestAdmission(t, databasePreset())
+	pod := testPod(map[string]string{"role": "frontend", "app": "shop"})
+
+	if err := plugin.Admit(podAttributes(pod)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	preset := databasePreset()
+	if !reflect.DeepEqual(pod.Spec.Volumes, preset.Spec.Volumes) {
+		t.Errorf("expected the preset volumes, got %#v", pod.Spec.Volumes)
+	}
+	for _, container := range pod.Spec.Containers {
+		if !reflect.DeepEqual(container.Env, preset.Spec.Env) {
+			t.Errorf("%s: expected the preset env, got %#v", container.Name, container.Env)
+		}
+		if !reflect.DeepEqual(container.VolumeMounts, preset.Spec.VolumeMounts) {
+			t.Errorf("%s: expected the preset volume mounts, got %#v", container.Name, container.VolumeMounts)
+		}
+	}
+	if version := pod.Annotations[podpresetapi.PodPresetAnnotationPrefix+"database"]; version != "7" {
+		t.Errorf("expected the applied preset to be recorded, got %v", pod.Annotations)
+	}
+}
+
+func TestAdmitIgnoresUnselectedPods(t *testing.T) {
+	testCases := map[string]*kapi.Pod{
+		"other labels": testPod(map[string]string{"role": "backend"}),
+		"excluded": func() *kapi.Pod {
+			pod := testPod(map[string]string{"role": "frontend"})
+			pod.Annotations = map[string]string{podpresetapi.PodPresetExcludeAnnotation: "true"}
+			return pod
+		}(),
+	}
+	for name, pod := range testCases {
+		plugin, _ := newTestAdmission(t, databasePreset())
+		if err := plugin.Admit(podAttributes(pod)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if len(pod.Spec.Volumes) != 0 || len(pod.Spec.Containers[0].Env) != 0 {
+			t.Errorf("%s: expected the pod to be unchanged, got %#v", name, pod.Spec)
+		}
+	}
+}
+
+func TestAdmitIgnoresOtherResources(t *testing.T) {
+	plugin, client := newTestAdmission(t, databasePreset())
+
+	obj := &kapi.Service{ObjectMeta: kapi.ObjectMeta{Name: "db", Namespace: "app"}}
+	attributes := admission.NewAttributesRecord(obj, kapi.Kind("Service"), "app", obj.Name, kapi.Resource("services"), "", admission.Create, nil)
+	if err := plugin.Admit(attributes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
+
+func TestAdmitRejectsWhenPresetsUnavailable(t *testing.T) {
+	plugin, client := newTestAdmission(t)
+	client.PrependReactor("list", "podpresets", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, nil, kapierrors.NewServiceUnavailable("etcd is down")
+	})
+
+	err := plugin.Admit(podAttributes(testPod(map[string]string{"role": "frontend"})))
+	if !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestApplyPodPresetKeepsExistingSettings(t *testing.T) {
+	pod := testPod(map[string]string{"role": "frontend"})
+	pod.Spec.Volumes = []kapi.Volume{{Name: "db-credentials", VolumeSource: kapi.VolumeSource{EmptyDir: &kapi.EmptyDirVolumeSource{}}}}
+	pod.Spec.Containers[0].Env = []kapi.EnvVar{{Name: "DB_URL", Value: "postgresql://local:5432"}}
+
+	preset := databasePreset()
+	ApplyPodPreset(&preset, pod)
+
+	if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].EmptyDir == nil {
+		t.Errorf("expected the existing volume to be kept, got %#v", pod.Spec.Volumes)
+	}
+	for _, container := range pod.Spec.Containers {
+		if len(container.VolumeMounts) != 0 {
+			t.Errorf("%s: expected no mount of a volume the preset did not add, got %#v", container.Name, container.VolumeMounts)
+		}
+	}
+	if env := pod.Spec.Containers[0].Env; len(env) != 1 || env[0].Value != "postgresql://local:5432" {
+		t.Errorf("expected the container env to take precedence, got %#v", env)
+	}
+	if env := pod.Spec.Containers[1].Env; len(env) != 1 || env[0].Value != "postgresql://db:5432" {
+		t.Errorf("expected the preset env in the second container, got %#v", env)
+	}
+}
+
+func TestAdmitAppliesPresetsInNameOrder(t *testing.T) {
+	first := databasePreset()
+	first.Name = "a-database"
+	first.Spec.Env[0].Value = "first"
+	second := databasePreset()
+	second.Name = "b-database"
+	second.Spec.Env[0].Value = "second"
+
+	plugin, _ := newTestAdmission(t, second, first)
+	pod := testPod(map[string]string{"role": "frontend"})
+	if err := plugin.Admit(podAttributes(pod)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env := pod.Spec.Containers[0].Env; len(env) != 1 || env[0].Value != "first" {
+		t.Errorf("expected the first preset by name to win, got %#v", env)
+	}
+	if len(pod.Annotations) != 2 {
+		t.Errorf("expected both presets to be recorded, got %v", pod.Annotations)
+	}
+}
@@ -0,0 +1,11 @@
+package api
+
+import "k8s.io/kubernetes/pkg/fields"
+
+// PodPresetToSelectableFields returns a label set that represents the object
+func PodPresetToSelectableFields(preset *PodPreset) fields.Set {
+	return fields.Set{
+		"metadata.name":      preset.Name,
+		"metadata.namespace": preset.Namespace,
+	}
+}
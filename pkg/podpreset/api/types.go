@@ -0,0 +1,53 @@
+package api
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+const (
+	// PodPresetAnnotationPrefix prefixes the annotations that record which presets were applied to a
+	// pod. The value of each annotation is the resource version of the preset.
+	PodPresetAnnotationPrefix = "podpreset.openshift.io/"
+
+	// PodPresetExcludeAnnotation on a pod with the value "true" prevents any preset from being applied
+	// to it.
+	PodPresetExcludeAnnotation = "podpreset.openshift.io/exclude"
+)
+
+// PodPreset injects environment variables and volumes into the pods of its namespace that match its
+// selector when they are created. It allows shared settings, such as the location and credentials of
+// a database, to be attached to applications without editing their pod templates.
+type PodPreset struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec defines the pods the preset applies to and what is injected into them
+	Spec PodPresetSpec
+}
+
+// PodPresetSpec defines the pods a preset applies to and what is injected into them
+type PodPresetSpec struct {
+	// Selector selects the pods the preset applies to by label
+	Selector map[string]string
+
+	// Env is added to every container of a selected pod. Variables already defined by a container
+	// are not overridden.
+	Env []kapi.EnvVar
+
+	// Volumes are added to a selected pod, unless the pod already has a volume with the same name
+	Volumes []kapi.Volume
+
+	// VolumeMounts are added to every container of a selected pod, unless the container already
+	// mounts a volume at the same path
+	VolumeMounts []kapi.VolumeMount
+}
+
+// PodPresetList is a collection of PodPresets
+type PodPresetList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	// Items is a list of PodPresets
+	Items []PodPreset
+}
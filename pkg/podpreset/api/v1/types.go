@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kapi "k8s.io/kubernetes/pkg/api/v1"
+)
+
+// PodPreset injects environment variables and volumes into the pods of its namespace that match its
+// selector when they are created. It allows shared settings, such as the location and credentials of
+// a database, to be attached to applications without editing their pod templates.
+type PodPreset struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec defines the pods the preset applies to and what is injected into them
+	Spec PodPresetSpec `json:"spec" description:"the pods the preset applies to and what is injected into them"`
+}
+
+// PodPresetSpec defines the pods a preset applies to and what is injected into them
+type PodPresetSpec struct {
+	// Selector selects the pods the preset applies to by label
+	Selector map[string]string `json:"selector" description:"labels of the pods the preset applies to"`
+
+	// Env is added to every container of a selected pod. Variables already defined by a container
+	// are not overridden.
+	Env []kapi.EnvVar `json:"env,omitempty" description:"environment variables added to every container of a selected pod; variables defined by the container take precedence"`
+
+	// Volumes are added to a selected pod, unless the pod already has a volume with the same name
+	Volumes []kapi.Volume `json:"volumes,omitempty" description:"volumes added to a selected pod unless it has a volume with the same name"`
+
+	// VolumeMounts are added to every container of a selected pod, unless the container already
+	// mounts a volume at the same path
+	VolumeMounts []kapi.VolumeMount `json:"volumeMounts,omitempty" description:"volume mounts added to every container of a selected pod unless it mounts a volume at the same path"`
+}
+
+// PodPresetList is a collection of PodPresets
+type PodPresetList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of PodPresets
+	Items []PodPreset `json:"items" description:"list of pod presets"`
+}
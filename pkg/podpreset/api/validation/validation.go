@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/sets"
+	kvalidation "k8s.io/kubernetes/pkg/util/validation"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+// ValidatePodPreset tests required fields for a PodPreset. The volumes and environment variables
+// are checked again as part of every pod they are injected into.
+func ValidatePodPreset(preset *podpresetapi.PodPreset) field.ErrorList {
+	// the name is a DNS label so that it can be used in the annotation that records the preset on a pod
+	allErrs := validation.ValidateObjectMeta(&preset.ObjectMeta, true, oapi.GetNameValidationFunc(validation.NameIsDNSLabel), field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(preset.Spec.Selector) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("selector")))
+	} else {
+		allErrs = append(allErrs, validation.ValidateLabels(preset.Spec.Selector, specPath.Child("selector"))...)
+	}
+
+	if len(preset.Spec.Env) == 0 && len(preset.Spec.Volumes) == 0 && len(preset.Spec.VolumeMounts) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("env")))
+	}
+
+	envNames := sets.NewString()
+	for i, env := range preset.Spec.Env {
+		idxPath := specPath.Child("env").Index(i).Child("name")
+		switch {
+		case len(env.Name) == 0:
+			allErrs = append(allErrs, field.Required(idxPath))
+		case !kvalidation.IsCIdentifier(env.Name):
+			allErrs = append(allErrs, field.Invalid(idxPath, env.Name, "must match regex "+kvalidation.CIdentifierFmt))
+		case envNames.Has(env.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath, env.Name))
+		}
+		envNames.Insert(env.Name)
+	}
+
+	volumeNames := sets.NewString()
+	for i, volume := range preset.Spec.Volumes {
+		idxPath := specPath.Child("volumes").Index(i).Child("name")
+		switch {
+		case len(volume.Name) == 0:
+			allErrs = append(allErrs, field.Required(idxPath))
+		case !kvalidation.IsDNS1123Label(volume.Name):
+			allErrs = append(allErrs, field.Invalid(idxPath, volume.Name, validation.DNS1123LabelErrorMsg))
+		case volumeNames.Has(volume.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath, volume.Name))
+		}
+		volumeNames.Insert(volume.Name)
+	}
+
+	mountPaths := sets.NewString()
+	for i, mount := range preset.Spec.VolumeMounts {
+		idxPath := specPath.Child("volumeMounts").Index(i)
+		if len(mount.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name")))
+		} else if !volumeNames.Has(mount.Name) {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), mount.Name))
+		}
+		if len(mount.MountPath) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("mountPath")))
+		} else if mountPaths.Has(mount.MountPath) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("mountPath"), mount.MountPath))
+		}
+		mountPaths.Insert(mount.MountPath)
+	}
+
+	return allErrs
+}
+
+// ValidatePodPresetUpdate tests if an update to a PodPreset is valid
+func ValidatePodPresetUpdate(preset, oldPreset *podpresetapi.PodPreset) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&preset.ObjectMeta, &oldPreset.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidatePodPreset(preset)...)
+	return allErrs
+}
@@ -0,0 +1,103 @@
+package validation
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	podpresetapi "github.com/openshift/origin/pkg/podpreset/api"
+)
+
+func validPreset() *podpresetapi.PodPreset {
+	return &podpresetapi.PodPreset{
+		ObjectMeta: kapi.ObjectMeta{Name: "database", Namespace: "app"},
+		Spec: podpresetapi.PodPresetSpec{
+			Selector: map[string]string{"role": "frontend"},
+			Env:      []kapi.EnvVar{{Name: "DB_URL", Value: "postgresql://db:5432"}},
+			Volumes: []kapi.Volume{{
+				Name:         "db-credentials",
+				VolumeSource: kapi.VolumeSource{Secret: &kapi.SecretVolumeSource{SecretName: "db-credentials"}},
+			}},
+			VolumeMounts: []kapi.VolumeMount{{Name: "db-credentials", MountPath: "/etc/db", ReadOnly: true}},
+		},
+	}
+}
+
+func TestValidatePodPreset(t *testing.T) {
+	testCases := map[string]struct {
+		modify  func(*podpresetapi.PodPreset)
+		numErrs int
+	}{
+		"valid": {
+			modify:  func(*podpresetapi.PodPreset) {},
+			numErrs: 0,
+		},
+		"missing namespace": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Namespace = "" },
+			numErrs: 1,
+		},
+		"name not a DNS label": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Name = "db.preset" },
+			numErrs: 1,
+		},
+		"missing selector": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.Selector = nil },
+			numErrs: 1,
+		},
+		"invalid selector": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.Selector = map[string]string{"bad key": "value"} },
+			numErrs: 1,
+		},
+		"nothing to inject": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec = podpresetapi.PodPresetSpec{Selector: p.Spec.Selector} },
+			numErrs: 1,
+		},
+		"invalid env name": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.Env[0].Name = "DB-URL" },
+			numErrs: 1,
+		},
+		"duplicate env name": {
+			modify: func(p *podpresetapi.PodPreset) {
+				p.Spec.Env = append(p.Spec.Env, kapi.EnvVar{Name: "DB_URL", Value: "other"})
+			},
+			numErrs: 1,
+		},
+		"duplicate volume": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.Volumes = append(p.Spec.Volumes, p.Spec.Volumes[0]) },
+			numErrs: 1,
+		},
+		"mount of an unknown volume": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.VolumeMounts[0].Name = "other" },
+			numErrs: 1,
+		},
+		"mount without path": {
+			modify:  func(p *podpresetapi.PodPreset) { p.Spec.VolumeMounts[0].MountPath = "" },
+			numErrs: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		preset := validPreset()
+		tc.modify(preset)
+		if errs := ValidatePodPreset(preset); len(errs) != tc.numErrs {
+			t.Errorf("%s: expected %d errors, got %d: %v", name, tc.numErrs, len(errs), errs)
+		}
+	}
+}
+
+func TestValidatePodPresetUpdate(t *testing.T) {
+	oldPreset := validPreset()
+	oldPreset.ResourceVersion = "1"
+
+	preset := validPreset()
+	preset.ResourceVersion = "1"
+	preset.Spec.Env[0].Value = "postgresql://other:5432"
+	if errs := ValidatePodPresetUpdate(preset, oldPreset); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	preset.Namespace = "other"
+	if errs := ValidatePodPresetUpdate(preset, oldPreset); len(errs) == 0 {
+		t.Errorf("expected an error when changing the namespace")
+	}
+}
@@ -0,0 +1,3 @@
+// Package podpreset contains the OpenShift PodPreset API and the admission plugin that injects
+// the environment variables and volumes of pod presets into matching pods.
+package podpreset
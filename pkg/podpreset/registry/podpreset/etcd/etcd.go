@@ -0,0 +1,49 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/podpreset/api"
+	"github.com/openshift/origin/pkg/podpreset/registry/podpreset"
+)
+
+// REST implements a RESTStorage for pod presets against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+const etcdPrefix = "/podpresets"
+
+// NewREST returns a RESTStorage object that will work against pod presets.
+func NewREST(s storage.Interface) *REST {
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.PodPreset{} },
+		NewListFunc: func() runtime.Object { return &api.PodPresetList{} },
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, etcdPrefix)
+		},
+		KeyFunc: func(ctx kapi.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, etcdPrefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.PodPreset).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return podpreset.Matcher(label, field)
+		},
+		EndpointName: "podpresets",
+
+		CreateStrategy: podpreset.Strategy,
+		UpdateStrategy: podpreset.Strategy,
+
+		Storage: s,
+	}
+
+	return &REST{store}
+}
@@ -0,0 +1,75 @@
+package podpreset
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/podpreset/api"
+	"github.com/openshift/origin/pkg/podpreset/api/validation"
+)
+
+// strategy implements behavior for PodPresets
+type strategy struct {
+	runtime.ObjectTyper
+}
+
+// Strategy is the default logic that applies when creating and updating PodPreset
+// objects via the REST API.
+var Strategy = strategy{kapi.Scheme}
+
+// NamespaceScoped is true for pod presets
+func (strategy) NamespaceScoped() bool {
+	return true
+}
+
+func (strategy) GenerateName(base string) string {
+	return base
+}
+
+// AllowCreateOnUpdate is false for pod presets
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (strategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+// PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+func (strategy) PrepareForCreate(obj runtime.Object) {
+}
+
+// PrepareForUpdate clears fields that are not allowed to be set by end users on update.
+func (strategy) PrepareForUpdate(obj, old runtime.Object) {
+}
+
+// Canonicalize normalizes the object after validation.
+func (strategy) Canonicalize(obj runtime.Object) {
+}
+
+// Validate validates a new pod preset
+func (strategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidatePodPreset(obj.(*api.PodPreset))
+}
+
+// ValidateUpdate is the default update validation for a pod preset
+func (strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidatePodPresetUpdate(obj.(*api.PodPreset), old.(*api.PodPreset))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		preset, ok := obj.(*api.PodPreset)
+		if !ok {
+			return false, fmt.Errorf("not a PodPreset")
+		}
+		return label.Matches(labels.Set(preset.Labels)) && field.Matches(api.PodPresetToSelectableFields(preset)), nil
+	})
+}
@@ -17,6 +17,7 @@ import (
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/cmd/server/origin"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
+	oscc "github.com/openshift/origin/pkg/security/admission"
 )
 
 type UnknownObject struct{}
@@ -147,6 +148,7 @@ func TestCreatesAllowedDuringNamespaceDeletion(t *testing.T) {
 	config := &origin.MasterConfig{
 		KubeletClientConfig: &kubeletclient.KubeletClientConfig{},
 		EtcdHelper:          etcdstorage.NewEtcdStorage(nil, nil, ""),
+		SCCCache:            oscc.NewSCCCache(nil),
 	}
 	storageMap := config.GetRestStorage()
 	resources := sets.String{}
@@ -3,6 +3,8 @@ package nodeenv
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -31,6 +33,7 @@ var _ = oadmission.WantsProjectCache(&podNodeEnvironment{})
 var _ = oadmission.Validator(&podNodeEnvironment{})
 
 // Admit enforces that pod and its project node label selectors matches at least a node in the cluster.
+// Infrastructure pods use the infrastructure node selector in place of the cluster default node selector.
 func (p *podNodeEnvironment) Admit(a admission.Attributes) (err error) {
 	resource := a.GetResource()
 	if resource != kapi.Resource("pods") {
@@ -56,13 +59,21 @@ func (p *podNodeEnvironment) Admit(a admission.Attributes) (err error) {
 	if err != nil {
 		return apierrors.NewForbidden(resource.Resource, name, err)
 	}
-	projectNodeSelector, err := p.cache.GetNodeSelectorMap(namespace)
+	creator := ""
+	if userInfo := a.GetUserInfo(); userInfo != nil {
+		creator = userInfo.GetName()
+	}
+	getNodeSelectorMap := p.cache.GetNodeSelectorMap
+	if p.cache.IsInfraPod(namespace.Name, creator) {
+		getNodeSelectorMap = p.cache.GetInfraNodeSelectorMap
+	}
+	projectNodeSelector, err := getNodeSelectorMap(namespace)
 	if err != nil {
 		return err
 	}
 
-	if labelselector.Conflicts(projectNodeSelector, pod.Spec.NodeSelector) {
-		return apierrors.NewForbidden(resource.Resource, name, fmt.Errorf("pod node label selector conflicts with its project node label selector"))
+	if conflicts := conflictingLabels(projectNodeSelector, pod.Spec.NodeSelector); len(conflicts) > 0 {
+		return apierrors.NewForbidden(resource.Resource, name, fmt.Errorf("pod node label selector conflicts with its project node label selector: %s", strings.Join(conflicts, ", ")))
 	}
 
 	// modify pod node selector = project node selector + current pod node selector
@@ -71,6 +82,19 @@ func (p *podNodeEnvironment) Admit(a admission.Attributes) (err error) {
 	return nil
 }
 
+// conflictingLabels describes every label the pod node selector sets to a different value than the
+// project node selector, in a stable order.
+func conflictingLabels(projectNodeSelector, podNodeSelector map[string]string) []string {
+	conflicts := []string{}
+	for k, projectValue := range projectNodeSelector {
+		if podValue, ok := podNodeSelector[k]; ok && podValue != projectValue {
+			conflicts = append(conflicts, fmt.Sprintf("%s=%s (project requires %s=%s)", k, podValue, k, projectValue))
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
 func (p *podNodeEnvironment) SetProjectCache(c *cache.ProjectCache) {
 	p.cache = c
 }
@@ -1,12 +1,16 @@
 package nodeenv
 
 import (
+	"strings"
 	"testing"
 
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
+	apierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	projectcache "github.com/openshift/origin/pkg/project/cache"
 	"github.com/openshift/origin/pkg/util/labelselector"
@@ -32,6 +36,7 @@ func TestPodAdmission(t *testing.T) {
 	tests := []struct {
 		defaultNodeSelector       string
 		projectNodeSelector       string
+		projectNodeSelectorPolicy string
 		podNodeSelector           map[string]string
 		mergedNodeSelector        map[string]string
 		ignoreProjectNodeSelector bool
@@ -102,12 +107,60 @@ func TestPodAdmission(t *testing.T) {
 			admit:               false,
 			testName:            "Conflicting pod and project node selector, multiple labels",
 		},
+		{
+			defaultNodeSelector:       "env=test",
+			projectNodeSelector:       "infra=true",
+			projectNodeSelectorPolicy: "merge",
+			podNodeSelector:           map[string]string{"color": "blue"},
+			mergedNodeSelector:        map[string]string{"env": "test", "infra": "true", "color": "blue"},
+			admit:                     true,
+			testName:                  "Merged default and project node selector, no conflicts",
+		},
+		{
+			defaultNodeSelector:       "infra=false, env=test",
+			projectNodeSelector:       "infra=true",
+			projectNodeSelectorPolicy: "merge",
+			podNodeSelector:           map[string]string{},
+			mergedNodeSelector:        map[string]string{"env": "test", "infra": "true"},
+			admit:                     true,
+			testName:                  "Merged default and project node selector, project takes precedence",
+		},
+		{
+			defaultNodeSelector:       "env=test",
+			projectNodeSelector:       "",
+			projectNodeSelectorPolicy: "merge",
+			podNodeSelector:           map[string]string{},
+			mergedNodeSelector:        map[string]string{"env": "test"},
+			admit:                     true,
+			testName:                  "Merged default and empty project node selector",
+		},
+		{
+			defaultNodeSelector:       "env=test",
+			projectNodeSelector:       "infra=true",
+			projectNodeSelectorPolicy: "merge",
+			podNodeSelector:           map[string]string{"env": "dev"},
+			mergedNodeSelector:        map[string]string{"env": "dev"},
+			admit:                     false,
+			testName:                  "Pod node selector conflicts with merged default node selector",
+		},
+		{
+			defaultNodeSelector:       "env=test",
+			projectNodeSelector:       "infra=true",
+			projectNodeSelectorPolicy: "override",
+			podNodeSelector:           map[string]string{"env": "dev"},
+			mergedNodeSelector:        map[string]string{"env": "dev", "infra": "true"},
+			admit:                     true,
+			testName:                  "Project node selector overrides default node selector",
+		},
 	}
 	for _, test := range tests {
 		cache := projectcache.NewFake(mockClient.Namespaces(), projectStore, test.defaultNodeSelector)
 		handler.SetProjectCache(cache)
 		if !test.ignoreProjectNodeSelector {
 			project.ObjectMeta.Annotations = map[string]string{"openshift.io/node-selector": test.projectNodeSelector}
+			if len(test.projectNodeSelectorPolicy) > 0 {
+				project.ObjectMeta.Annotations["openshift.io/node-selector-policy"] = test.projectNodeSelectorPolicy
+			}
 		}
 		pod.Spec = kapi.PodSpec{NodeSelector: test.podNodeSelector}
 
@@ -124,6 +177,34 @@ func TestPodAdmission(t *testing.T) {
 	}
 }
 
+func TestPodAdmissionConflictMessage(t *testing.T) {
+	mockClient := &testclient.Fake{}
+	project := &kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "testProject",
+			Annotations: map[string]string{"openshift.io/node-selector": "region=east, infra=false"},
+		},
+	}
+	projectStore := projectcache.NewCacheStore(cache.IndexFuncToKeyFuncAdapter(cache.MetaNamespaceIndexFunc))
+	projectStore.Add(project)
+
+	handler := &podNodeEnvironment{client: mockClient}
+	handler.SetProjectCache(projectcache.NewFake(mockClient.Namespaces(), projectStore, ""))
+	pod := &kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Name: "testPod"},
+		Spec:       kapi.PodSpec{NodeSelector: map[string]string{"region": "west", "infra": "true"}},
+	}
+
+	err := handler.Admit(admission.NewAttributesRecord(pod, kapi.Kind("Pod"), "namespace", project.ObjectMeta.Name, kapi.Resource("pods"), "", admission.Create, nil))
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+	expected := "infra=true (project requires infra=false), region=west (project requires region=east)"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error to describe the conflicting labels %q, got %q", expected, err.Error())
+	}
+}
+
 func TestHandles(t *testing.T) {
 	for op, shouldHandle := range map[admission.Operation]bool{
 		admission.Create:  true,
@@ -142,3 +223,41 @@ func TestHandles(t *testing.T) {
 		}
 	}
 }
+
+func TestInfraPodAdmission(t *testing.T) {
+	mockClient := &testclient.Fake{}
+	projectStore := projectcache.NewCacheStore(cache.MetaNamespaceKeyFunc)
+	projectStore.Add(&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "default"}})
+	projectStore.Add(&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "app"}})
+	projectStore.Add(&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "pinned", Annotations: map[string]string{"openshift.io/node-selector": "region=east"}}})
+
+	projectCache := projectcache.NewFake(mockClient.Namespaces(), projectStore, "role=compute")
+	projectCache.InfraNodeSelector = "role=infra"
+	projectCache.InfraProjects = sets.NewString("default")
+	projectCache.InfraPodCreators = sets.NewString("system:serviceaccount:openshift-infra:build-controller")
+	handler := &podNodeEnvironment{client: mockClient}
+	handler.SetProjectCache(projectCache)
+
+	tests := []struct {
+		namespace string
+		creator   string
+		expected  map[string]string
+	}{
+		{namespace: "app", creator: "alice", expected: map[string]string{"role": "compute"}},
+		{namespace: "app", creator: "system:serviceaccount:openshift-infra:build-controller", expected: map[string]string{"role": "infra"}},
+		{namespace: "app", creator: "system:serviceaccount:app:build-controller", expected: map[string]string{"role": "compute"}},
+		{namespace: "default", creator: "alice", expected: map[string]string{"role": "infra"}},
+		{namespace: "pinned", creator: "system:serviceaccount:openshift-infra:build-controller", expected: map[string]string{"region": "east"}},
+	}
+	for _, test := range tests {
+		pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: "testPod", Namespace: test.namespace}}
+		err := handler.Admit(admission.NewAttributesRecord(pod, kapi.Kind("Pod"), test.namespace, pod.Name, kapi.Resource("pods"), "", admission.Create, &user.DefaultInfo{Name: test.creator}))
+		if err != nil {
+			t.Errorf("%s/%s: unexpected error: %v", test.namespace, test.creator, err)
+			continue
+		}
+		if !labelselector.Equals(test.expected, pod.Spec.NodeSelector) {
+			t.Errorf("%s/%s: expected node selector %v, got %v", test.namespace, test.creator, test.expected, pod.Spec.NodeSelector)
+		}
+	}
+}
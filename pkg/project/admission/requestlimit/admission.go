@@ -5,10 +5,12 @@ import (
 	"io"
 	"io/ioutil"
 	"reflect"
+	"strings"
 
 	"k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
 	"k8s.io/kubernetes/pkg/labels"
 
 	"github.com/openshift/origin/pkg/client"
@@ -87,6 +89,21 @@ func (o *projectRequestLimit) Admit(a admission.Attributes) (err error) {
 // maxProjectsByRequester returns the maximum number of projects allowed for a given user, whether a limit exists, and an error
 // if an error occurred. If a limit doesn't exist, the maximum number should be ignored.
 func (o *projectRequestLimit) maxProjectsByRequester(userName string) (int, bool, error) {
+	// service accounts and system users cannot be trusted to carry meaningful labels, so they
+	// are only subject to their own limits, or to the limits that apply to users without labels
+	if _, _, err := serviceaccount.SplitUsername(userName); err == nil {
+		if o.config.MaxProjectsForServiceAccounts != nil {
+			return *o.config.MaxProjectsForServiceAccounts, true, nil
+		}
+		return o.maxProjectsByLabels(labels.Set{})
+	}
+	if isSystemUser(userName) {
+		if o.config.MaxProjectsForSystemUsers != nil {
+			return *o.config.MaxProjectsForSystemUsers, true, nil
+		}
+		return o.maxProjectsByLabels(labels.Set{})
+	}
+
 	// prevent a user lookup if no limits are configured
 	if len(o.config.Limits) == 0 {
 		return 0, false, nil
@@ -96,8 +113,11 @@ func (o *projectRequestLimit) maxProjectsByRequester(userName string) (int, bool
 	if err != nil {
 		return 0, false, err
 	}
-	userLabels := labels.Set(user.Labels)
+	return o.maxProjectsByLabels(labels.Set(user.Labels))
+}
 
+// maxProjectsByLabels returns the limit of the first selector matching userLabels, and whether a limit exists.
+func (o *projectRequestLimit) maxProjectsByLabels(userLabels labels.Set) (int, bool, error) {
 	for _, limit := range o.config.Limits {
 		selector := labels.Set(limit.Selector).AsSelector()
 		if selector.Matches(userLabels) {
@@ -115,7 +135,28 @@ func (o *projectRequestLimit) projectCountByRequester(userName string) (int, err
 	if err != nil {
 		return 0, err
 	}
-	return len(namespaces), nil
+	if !o.config.ExcludeTerminatingProjects {
+		return len(namespaces), nil
+	}
+
+	count := 0
+	for _, obj := range namespaces {
+		ns, ok := obj.(*kapi.Namespace)
+		if !ok {
+			return 0, fmt.Errorf("object in project cache is not a namespace: %#v", obj)
+		}
+		if ns.Status.Phase == kapi.NamespaceTerminating {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// isSystemUser returns true if the user name could not belong to a user object. Those
+// users are authenticated by other means (client certificates, for example).
+func isSystemUser(userName string) bool {
+	return strings.Contains(userName, ":")
 }
 
 func (o *projectRequestLimit) SetOpenshiftClient(client client.Interface) {
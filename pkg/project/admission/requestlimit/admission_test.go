@@ -98,6 +98,20 @@ kind: ProjectRequestLimitConfig
 `,
 			expected: ProjectRequestLimitConfig{},
 		},
+		{
+			// system users and service accounts
+			config: `apiVersion: v1
+kind: ProjectRequestLimitConfig
+maxProjectsForSystemUsers: 3
+maxProjectsForServiceAccounts: 0
+excludeTerminatingProjects: true
+`,
+			expected: ProjectRequestLimitConfig{
+				MaxProjectsForSystemUsers:     intp(3),
+				MaxProjectsForServiceAccounts: intp(0),
+				ExcludeTerminatingProjects:    true,
+			},
+		},
 	}
 
 	for n, tc := range tests {
@@ -171,6 +185,113 @@ func TestMaxProjectByRequester(t *testing.T) {
 	}
 }
 
+func TestMaxProjectsForSystemUsersAndServiceAccounts(t *testing.T) {
+	tests := []struct {
+		config          *ProjectRequestLimitConfig
+		userName        string
+		expectUnlimited bool
+		expectedLimit   int
+	}{
+		{
+			config:        multiLevelConfig(),
+			userName:      "system:admin",
+			expectedLimit: 1,
+		},
+		{
+			config:        multiLevelConfig(),
+			userName:      "system:serviceaccount:foo:default",
+			expectedLimit: 1,
+		},
+		{
+			config:          emptyConfig(),
+			userName:        "system:admin",
+			expectUnlimited: true,
+		},
+		{
+			config:          emptyConfig(),
+			userName:        "system:serviceaccount:foo:default",
+			expectUnlimited: true,
+		},
+		{
+			config: &ProjectRequestLimitConfig{
+				Limits:                        multiLevelConfig().Limits,
+				MaxProjectsForSystemUsers:     intp(5),
+				MaxProjectsForServiceAccounts: intp(0),
+			},
+			userName:      "system:admin",
+			expectedLimit: 5,
+		},
+		{
+			config: &ProjectRequestLimitConfig{
+				Limits:                        multiLevelConfig().Limits,
+				MaxProjectsForSystemUsers:     intp(5),
+				MaxProjectsForServiceAccounts: intp(0),
+			},
+			userName:      "system:serviceaccount:foo:default",
+			expectedLimit: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		reqLimit, err := NewProjectRequestLimit(tc.config)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		// system users and service accounts must never be looked up
+		client := &testclient.Fake{}
+		reqLimit.(oadmission.WantsOpenshiftClient).SetOpenshiftClient(client)
+
+		maxProjects, hasLimit, err := reqLimit.(*projectRequestLimit).maxProjectsByRequester(tc.userName)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.userName, err)
+			continue
+		}
+		if len(client.Actions()) != 0 {
+			t.Errorf("%s: unexpected client actions: %v", tc.userName, client.Actions())
+		}
+		if tc.expectUnlimited {
+			if hasLimit {
+				t.Errorf("%s: expected no limit, got %d", tc.userName, maxProjects)
+			}
+			continue
+		}
+		if !hasLimit {
+			t.Errorf("%s: expected a limit", tc.userName)
+			continue
+		}
+		if maxProjects != tc.expectedLimit {
+			t.Errorf("%s: expected limit %d, got %d", tc.userName, tc.expectedLimit, maxProjects)
+		}
+	}
+}
+
+func TestProjectCountExcludesTerminating(t *testing.T) {
+	pCache := fakeProjectCache(map[string]int{"user1": 2})
+	terminating := fakeNs("user1")
+	terminating.Status.Phase = kapi.NamespaceTerminating
+	pCache.Store.Add(terminating)
+
+	for _, exclude := range []bool{false, true} {
+		reqLimit, err := NewProjectRequestLimit(&ProjectRequestLimitConfig{ExcludeTerminatingProjects: exclude})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		reqLimit.(oadmission.WantsProjectCache).SetProjectCache(pCache)
+
+		count, err := reqLimit.(*projectRequestLimit).projectCountByRequester("user1")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := 3
+		if exclude {
+			expected = 2
+		}
+		if count != expected {
+			t.Errorf("excludeTerminatingProjects=%t: expected %d projects, got %d", exclude, expected, count)
+		}
+	}
+}
+
 func TestAdmit(t *testing.T) {
 	tests := []struct {
 		config          *ProjectRequestLimitConfig
@@ -266,10 +387,23 @@ func selectorEquals(a, b map[string]string) bool {
 	return true
 }
 
+func intpEquals(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func configEquals(a, b *ProjectRequestLimitConfig) bool {
 	if len(a.Limits) != len(b.Limits) {
 		return false
 	}
+	if !intpEquals(a.MaxProjectsForSystemUsers, b.MaxProjectsForSystemUsers) || !intpEquals(a.MaxProjectsForServiceAccounts, b.MaxProjectsForServiceAccounts) {
+		return false
+	}
+	if a.ExcludeTerminatingProjects != b.ExcludeTerminatingProjects {
+		return false
+	}
 	for n, limit := range a.Limits {
 		limit2 := b.Limits[n]
 		if !selectorEquals(limit.Selector, limit2.Selector) {
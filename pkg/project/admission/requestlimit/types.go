@@ -10,6 +10,20 @@ import (
 type ProjectRequestLimitConfig struct {
 	unversioned.TypeMeta
 	Limits []ProjectLimitBySelector
+
+	// MaxProjectsForSystemUsers controls how many projects a system user (for example a certificate
+	// user such as system:admin) may request. System users do not have a user object, so they have
+	// no labels to match against Limits. If nil, system users are limited like users without labels.
+	MaxProjectsForSystemUsers *int
+
+	// MaxProjectsForServiceAccounts controls how many projects a service account may request. Labels
+	// on service accounts can be changed by project editors, so Limits are never matched against them.
+	// If nil, service accounts are limited like users without labels.
+	MaxProjectsForServiceAccounts *int
+
+	// ExcludeTerminatingProjects indicates that projects which are being deleted should not count
+	// against the requester's limit.
+	ExcludeTerminatingProjects bool
 }
 
 // ProjectLimitBySelector specifies the maximum number of projects allowed for a given user label selector
@@ -10,6 +10,20 @@ import (
 type ProjectRequestLimitConfig struct {
 	unversioned.TypeMeta
 	Limits []ProjectLimitBySelector `json:"limits",description:"project request limits"`
+
+	// MaxProjectsForSystemUsers controls how many projects a system user (for example a certificate
+	// user such as system:admin) may request. System users do not have a user object, so they have
+	// no labels to match against Limits. If nil, system users are limited like users without labels.
+	MaxProjectsForSystemUsers *int `json:"maxProjectsForSystemUsers,omitempty",description:"maximum number of projects for system users, the limit of users without labels if nil"`
+
+	// MaxProjectsForServiceAccounts controls how many projects a service account may request. Labels
+	// on service accounts can be changed by project editors, so Limits are never matched against them.
+	// If nil, service accounts are limited like users without labels.
+	MaxProjectsForServiceAccounts *int `json:"maxProjectsForServiceAccounts,omitempty",description:"maximum number of projects for service accounts, the limit of users without labels if nil"`
+
+	// ExcludeTerminatingProjects indicates that projects which are being deleted should not count
+	// against the requester's limit.
+	ExcludeTerminatingProjects bool `json:"excludeTerminatingProjects,omitempty",description:"do not count projects that are being deleted"`
 }
 
 // ProjectLimitBySelector specifies the maximum number of projects allowed for a given user label selector
@@ -83,6 +83,16 @@ func TestValidateProjectRequestLimitConfig(t *testing.T) {
 			errType:     field.ErrorTypeInvalid,
 			errField:    "limits[2].selector",
 		},
+		// 5: negative service account limit (error)
+		{
+			config: ProjectRequestLimitConfig{
+				MaxProjectsForSystemUsers:     intp(5),
+				MaxProjectsForServiceAccounts: intp(-1),
+			},
+			errExpected: true,
+			errType:     field.ErrorTypeInvalid,
+			errField:    "maxProjectsForServiceAccounts",
+		},
 	}
 
 	for i, tc := range tests {
@@ -26,6 +26,19 @@ type ProjectSpec struct {
 // ProjectStatus is information about the current status of a Project
 type ProjectStatus struct {
 	Phase kapi.NamespacePhase
+	// SecurityAllocation is the UID range, supplemental groups, and SELinux MCS label allocated to the
+	// project.  It is nil until the allocation controller has assigned them.
+	SecurityAllocation *ProjectSecurityAllocation
+}
+
+// ProjectSecurityAllocation describes the security attributes allocated to a project
+type ProjectSecurityAllocation struct {
+	// UIDRange is the block of UIDs allocated to the project, in the form {start}/{length}
+	UIDRange string
+	// SupplementalGroups is the block of supplemental groups allocated to the project, in the form {start}/{length}
+	SupplementalGroups string
+	// MCSLabel is the SELinux MCS label allocated to the project
+	MCSLabel string
 }
 
 // Project is a logical top-level container for a set of origin resources
@@ -50,10 +63,28 @@ const (
 	ProjectDisplayName = "openshift.io/display-name"
 	// ProjectDescription is an annotatoion that holds the description of the project
 	ProjectDescription = "openshift.io/description"
+	// ProjectContact is an annotation that holds how to reach the people responsible for the project
+	ProjectContact = "openshift.io/contact"
 	// ProjectNodeSelector is an annotation that holds the node selector;
 	// the node selector annotation determines which nodes will have pods from this project scheduled to them
 	ProjectNodeSelector = "openshift.io/node-selector"
+	// ProjectNodeSelectorPolicy is an annotation that determines how the project node selector is combined with the
+	// cluster default node selector.  See NodeSelectorPolicyOverride and NodeSelectorPolicyMerge.
+	ProjectNodeSelectorPolicy = "openshift.io/node-selector-policy"
 	// ProjectRequester is the username that requested a given project.  Its not guaranteed to be present,
 	// but it is set by the default project template.
 	ProjectRequester = "openshift.io/requester"
+	// ProjectSkipTemplateDefaults is an annotation that, when set to "true", keeps the resource quotas and limit ranges of
+	// the project request template from being applied to an existing project.
+	ProjectSkipTemplateDefaults = "openshift.io/skip-template-defaults"
+)
+
+// These constants are the values accepted for the ProjectNodeSelectorPolicy annotation
+const (
+	// NodeSelectorPolicyOverride uses the project node selector instead of the cluster default node selector.
+	// This is the policy used when the annotation is not set.
+	NodeSelectorPolicyOverride = "override"
+	// NodeSelectorPolicyMerge adds the cluster default node selector to the project node selector.  When both
+	// select on the same label, the value from the project node selector is used.
+	NodeSelectorPolicyMerge = "merge"
 )
@@ -26,6 +26,15 @@ type ProjectSpec struct {
 // ProjectStatus is information about the current status of a Project
 type ProjectStatus struct {
 	Phase kapi.NamespacePhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the project"`
+	// SecurityAllocation is the UID range, supplemental groups, and SELinux MCS label allocated to the project
+	SecurityAllocation *ProjectSecurityAllocation `json:"securityAllocation,omitempty" description:"the UID range, supplemental groups, and SELinux MCS label allocated to the project; read-only"`
+}
+
+// ProjectSecurityAllocation describes the security attributes allocated to a project
+type ProjectSecurityAllocation struct {
+	UIDRange           string `json:"uidRange,omitempty" description:"the block of UIDs allocated to the project, in the form {start}/{length}"`
+	SupplementalGroups string `json:"supplementalGroups,omitempty" description:"the block of supplemental groups allocated to the project, in the form {start}/{length}"`
+	MCSLabel           string `json:"mcsLabel,omitempty" description:"the SELinux MCS label allocated to the project"`
 }
 
 // Project is a logical top-level container for a set of origin resources
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
@@ -35,9 +36,11 @@ func ValidateProjectName(name string, prefix bool) (bool, string) {
 func ValidateProject(project *api.Project) field.ErrorList {
 	result := validation.ValidateObjectMeta(&project.ObjectMeta, false, ValidateProjectName, field.NewPath("metadata"))
 
-	if !validateNoNewLineOrTab(project.Annotations[projectapi.ProjectDisplayName]) {
-		result = append(result, field.Invalid(field.NewPath("metadata", "annotations").Key(projectapi.ProjectDisplayName),
-			project.Annotations[projectapi.ProjectDisplayName], "may not contain a new line or tab"))
+	for _, annotation := range []string{projectapi.ProjectDisplayName, projectapi.ProjectContact} {
+		if !validateNoNewLineOrTab(project.Annotations[annotation]) {
+			result = append(result, field.Invalid(field.NewPath("metadata", "annotations").Key(annotation),
+				project.Annotations[annotation], "may not contain a new line or tab"))
+		}
 	}
 	result = append(result, validateNodeSelector(project)...)
 	return result
@@ -48,6 +51,10 @@ func validateNoNewLineOrTab(s string) bool {
 	return !(strings.Contains(s, "\n") || strings.Contains(s, "\t"))
 }
 
+// mutableAnnotations are the project annotations that describe the project and may be changed by its
+// admins, the remaining annotations can only be changed through the namespace
+var mutableAnnotations = sets.NewString(projectapi.ProjectDisplayName, projectapi.ProjectDescription, projectapi.ProjectContact)
+
 // ValidateProjectUpdate tests to make sure a project update can be applied.  Modifies newProject with immutable fields.
 func ValidateProjectUpdate(newProject *api.Project, oldProject *api.Project) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&newProject.ObjectMeta, &oldProject.ObjectMeta, field.NewPath("metadata"))
@@ -62,7 +69,7 @@ func ValidateProjectUpdate(newProject *api.Project, oldProject *api.Project) fie
 
 	// TODO this restriction exists because our authorizer/admission cannot properly express and restrict mutation on the field level.
 	for name, value := range newProject.Annotations {
-		if name == projectapi.ProjectDisplayName || name == projectapi.ProjectDescription {
+		if mutableAnnotations.Has(name) {
 			continue
 		}
 
@@ -72,7 +79,7 @@ func ValidateProjectUpdate(newProject *api.Project, oldProject *api.Project) fie
 	}
 	// check for deletions
 	for name, value := range oldProject.Annotations {
-		if name == projectapi.ProjectDisplayName || name == projectapi.ProjectDescription {
+		if mutableAnnotations.Has(name) {
 			continue
 		}
 		if _, inNew := newProject.Annotations[name]; !inNew {
@@ -94,11 +101,17 @@ func ValidateProjectUpdate(newProject *api.Project, oldProject *api.Project) fie
 	return allErrs
 }
 
+// ValidateProjectRequest tests the metadata of a ProjectRequest, including the display name and description
+// that will be set on the requested project.
 func ValidateProjectRequest(request *api.ProjectRequest) field.ErrorList {
 	project := &api.Project{}
 	project.ObjectMeta = request.ObjectMeta
 
-	return ValidateProject(project)
+	allErrs := ValidateProject(project)
+	if !validateNoNewLineOrTab(request.DisplayName) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("displayName"), request.DisplayName, "may not contain a new line or tab"))
+	}
+	return allErrs
 }
 
 func validateNodeSelector(p *api.Project) field.ErrorList {
@@ -111,6 +124,14 @@ func validateNodeSelector(p *api.Project) field.ErrorList {
 					p.Annotations[projectapi.ProjectNodeSelector], "must be a valid label selector"))
 			}
 		}
+		if policy, ok := p.Annotations[projectapi.ProjectNodeSelectorPolicy]; ok {
+			switch policy {
+			case projectapi.NodeSelectorPolicyOverride, projectapi.NodeSelectorPolicyMerge:
+			default:
+				allErrs = append(allErrs, field.NotSupported(field.NewPath("nodeSelectorPolicy"), policy,
+					[]string{projectapi.NodeSelectorPolicyOverride, projectapi.NodeSelectorPolicyMerge}))
+			}
+		}
 	}
 	return allErrs
 }
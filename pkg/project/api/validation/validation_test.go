@@ -144,6 +144,59 @@ func TestValidateProject(t *testing.T) {
 			// Should fail because infra and $test doesn't satisfy the format
 			numErrs: 1,
 		},
+		{
+			name: "valid node selector policy",
+			project: api.Project{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "foo",
+					Namespace: "",
+					Annotations: map[string]string{
+						api.ProjectNodeSelector:       "infra=true",
+						api.ProjectNodeSelectorPolicy: api.NodeSelectorPolicyMerge,
+					},
+				},
+			},
+			numErrs: 0,
+		},
+		{
+			name: "invalid node selector policy",
+			project: api.Project{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "foo",
+					Namespace: "",
+					Annotations: map[string]string{
+						api.ProjectNodeSelector:       "infra=true",
+						api.ProjectNodeSelectorPolicy: "append",
+					},
+				},
+			},
+			numErrs: 1,
+		},
+		{
+			name: "valid contact",
+			project: api.Project{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: "foo",
+					Annotations: map[string]string{
+						api.ProjectContact: "Foo Team <foo-team@example.com>",
+					},
+				},
+			},
+			numErrs: 0,
+		},
+		{
+			name: "invalid contact",
+			project: api.Project{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: "foo",
+					Annotations: map[string]string{
+						api.ProjectContact: "foo-team@example.com\nbar-team@example.com",
+					},
+				},
+			},
+			// Should fail because the contact spans several lines
+			numErrs: 1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -201,6 +254,23 @@ func TestValidateProjectUpdate(t *testing.T) {
 		t.Fatalf("Expected no errors, got %v", errs)
 	}
 
+	addContact := &api.Project{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:            "project-name",
+			ResourceVersion: "1",
+			Annotations: map[string]string{
+				api.ProjectDescription:  "This is a description",
+				api.ProjectDisplayName:  "display name",
+				api.ProjectNodeSelector: "infra=true, env = test",
+				api.ProjectContact:      "foo-team@example.com",
+			},
+			Labels: map[string]string{"label-name": "value"},
+		},
+	}
+	if errs := ValidateProjectUpdate(addContact, project); len(errs) > 0 {
+		t.Fatalf("Expected no errors, got %v", errs)
+	}
+
 	errorCases := map[string]struct {
 		A api.Project
 		T field.ErrorType
@@ -306,3 +376,44 @@ func TestValidateProjectUpdate(t *testing.T) {
 	}
 
 }
+
+func TestValidateProjectRequest(t *testing.T) {
+	testCases := map[string]struct {
+		request api.ProjectRequest
+		field   string
+	}{
+		"valid": {
+			request: api.ProjectRequest{
+				ObjectMeta:  kapi.ObjectMeta{Name: "foo", Annotations: map[string]string{api.ProjectContact: "foo-team@example.com"}},
+				DisplayName: "Foo",
+				Description: "The foo project.\nIt is used by the foo team.",
+			},
+		},
+		"invalid display name": {
+			request: api.ProjectRequest{
+				ObjectMeta:  kapi.ObjectMeta{Name: "foo"},
+				DisplayName: "Foo\tBar",
+			},
+			field: "displayName",
+		},
+		"invalid contact": {
+			request: api.ProjectRequest{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Annotations: map[string]string{api.ProjectContact: "foo\nbar"}},
+			},
+			field: "metadata.annotations[" + api.ProjectContact + "]",
+		},
+	}
+
+	for name, tc := range testCases {
+		errs := ValidateProjectRequest(&tc.request)
+		if len(tc.field) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Field != tc.field {
+			t.Errorf("%s: expected a single error on %s, got %v", name, tc.field, errs)
+		}
+	}
+}
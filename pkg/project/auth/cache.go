@@ -3,6 +3,7 @@ package auth
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -10,6 +11,7 @@ import (
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/cache"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/types"
 	"k8s.io/kubernetes/pkg/util"
@@ -21,8 +23,8 @@ import (
 
 // Lister enforces ability to enumerate a resource based on policy
 type Lister interface {
-	// List returns the list of Namespace items that the user can access
-	List(user user.Info) (*kapi.NamespaceList, error)
+	// List returns the list of Namespace items that the user can access and that match the label selector
+	List(user user.Info, selector labels.Selector) (*kapi.NamespaceList, error)
 }
 
 // subjectRecord is a cache record for the set of namespaces a subject can access
@@ -110,6 +112,8 @@ type AuthorizationCache struct {
 
 	policyClient policyclient.ReadOnlyPolicyClient
 
+	// lock guards the subject records against being read by List while they are synchronized
+	lock                    sync.RWMutex
 	reviewRecordStore       cache.Store
 	userSubjectRecordStore  cache.Store
 	groupSubjectRecordStore cache.Store
@@ -300,6 +304,9 @@ func (ac *AuthorizationCache) synchronize() {
 	namespaceSet := ac.synchronizeNamespaces(userSubjectRecordStore, groupSubjectRecordStore, reviewRecordStore)
 	ac.synchronizePolicies(userSubjectRecordStore, groupSubjectRecordStore, reviewRecordStore)
 	ac.synchronizePolicyBindings(userSubjectRecordStore, groupSubjectRecordStore, reviewRecordStore)
+
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
 	purgeDeletedNamespaces(namespaceSet, userSubjectRecordStore, groupSubjectRecordStore, reviewRecordStore)
 
 	// if we did a full rebuild, now we swap the fully rebuilt cache
@@ -331,6 +338,9 @@ func (ac *AuthorizationCache) syncRequest(request *reviewRequest, userSubjectRec
 		return err
 	}
 
+	ac.lock.Lock()
+	defer ac.lock.Unlock()
+
 	usersToRemove := sets.NewString()
 	groupsToRemove := sets.NewString()
 	if lastKnownValue != nil {
@@ -348,35 +358,44 @@ func (ac *AuthorizationCache) syncRequest(request *reviewRequest, userSubjectRec
 	return nil
 }
 
-// List returns the set of namespace names the user has access to view
-func (ac *AuthorizationCache) List(userInfo user.Info) (*kapi.NamespaceList, error) {
-	keys := sets.String{}
-	user := userInfo.GetName()
-	groups := userInfo.GetGroups()
-
-	obj, exists, _ := ac.userSubjectRecordStore.GetByKey(user)
-	if exists {
-		subjectRecord := obj.(*subjectRecord)
-		keys.Insert(subjectRecord.namespaces.List()...)
+// List returns the namespaces the user has access to view that match the label selector. It is served
+// entirely from the cache, so its cost depends on the number of namespaces the user can see rather than
+// on the number of namespaces in the cluster.
+func (ac *AuthorizationCache) List(userInfo user.Info, selector labels.Selector) (*kapi.NamespaceList, error) {
+	if selector == nil {
+		selector = labels.Everything()
 	}
 
-	for _, group := range groups {
-		obj, exists, _ := ac.groupSubjectRecordStore.GetByKey(group)
-		if exists {
-			subjectRecord := obj.(*subjectRecord)
-			keys.Insert(subjectRecord.namespaces.List()...)
+	ac.lock.RLock()
+	keys := sets.String{}
+	if obj, exists, _ := ac.userSubjectRecordStore.GetByKey(userInfo.GetName()); exists {
+		for namespace := range obj.(*subjectRecord).namespaces {
+			keys.Insert(namespace)
+		}
+	}
+	for _, group := range userInfo.GetGroups() {
+		if obj, exists, _ := ac.groupSubjectRecordStore.GetByKey(group); exists {
+			for namespace := range obj.(*subjectRecord).namespaces {
+				keys.Insert(namespace)
+			}
 		}
 	}
+	ac.lock.RUnlock()
 
-	namespaceList := &kapi.NamespaceList{}
+	namespaceList := &kapi.NamespaceList{Items: make([]kapi.Namespace, 0, len(keys))}
 	for key := range keys {
-		namespace, exists, err := ac.namespaceStore.GetByKey(key)
+		obj, exists, err := ac.namespaceStore.GetByKey(key)
 		if err != nil {
 			return nil, err
 		}
-		if exists {
-			namespaceList.Items = append(namespaceList.Items, *namespace.(*kapi.Namespace))
+		if !exists {
+			continue
+		}
+		namespace := obj.(*kapi.Namespace)
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
 		}
+		namespaceList.Items = append(namespaceList.Items, *namespace)
 	}
 	return namespaceList, nil
 }
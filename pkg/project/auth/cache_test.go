@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
@@ -156,7 +157,11 @@ func (mr *mockReviewer) Review(name string) (Review, error) {
 }
 
 func validateList(t *testing.T, lister Lister, user user.Info, expectedSet sets.String) {
-	namespaceList, err := lister.List(user)
+	validateSelectorList(t, lister, user, labels.Everything(), expectedSet)
+}
+
+func validateSelectorList(t *testing.T, lister Lister, user user.Info, selector labels.Selector, expectedSet sets.String) {
+	namespaceList, err := lister.List(user, selector)
 	if err != nil {
 		t.Errorf("Unexpected error %v", err)
 	}
@@ -247,3 +252,41 @@ func TestSyncNamespace(t *testing.T) {
 	validateList(t, authorizationCache, eve, sets.NewString("bar", "car"))
 	validateList(t, authorizationCache, frank, sets.NewString())
 }
+
+func TestListWithSelector(t *testing.T) {
+	namespaceList := kapi.NamespaceList{
+		Items: []kapi.Namespace{
+			{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", ResourceVersion: "1", Labels: map[string]string{"env": "dev"}},
+			},
+			{
+				ObjectMeta: kapi.ObjectMeta{Name: "bar", ResourceVersion: "2", Labels: map[string]string{"env": "prod"}},
+			},
+		},
+	}
+	mockKubeClient := testclient.NewSimpleFake(&namespaceList)
+
+	reviewer := &mockReviewer{
+		expectedResults: map[string]*mockReview{
+			"foo": {
+				users:  []string{alice.GetName()},
+				groups: []string{},
+			},
+			"bar": {
+				users:  []string{},
+				groups: eve.GetGroups(),
+			},
+		},
+	}
+
+	authorizationCache := NewAuthorizationCache(reviewer, mockKubeClient.Namespaces(), &MockReadOnlyPolicyClient{})
+	for i := range namespaceList.Items {
+		authorizationCache.namespaceStore.Add(&namespaceList.Items[i])
+	}
+	authorizationCache.synchronize()
+
+	user := &user.DefaultInfo{Name: alice.GetName(), Groups: eve.GetGroups()}
+	validateSelectorList(t, authorizationCache, user, nil, sets.NewString("foo", "bar"))
+	validateSelectorList(t, authorizationCache, user, labels.SelectorFromSet(labels.Set{"env": "prod"}), sets.NewString("bar"))
+	validateSelectorList(t, authorizationCache, alice, labels.SelectorFromSet(labels.Set{"env": "prod"}), sets.NewString())
+}
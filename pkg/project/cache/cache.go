@@ -7,6 +7,7 @@ import (
 	"k8s.io/kubernetes/pkg/client/cache"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
 	projectapi "github.com/openshift/origin/pkg/project/api"
@@ -25,6 +26,12 @@ type ProjectCache struct {
 	Client              client.NamespaceInterface
 	Store               cache.Indexer
 	DefaultNodeSelector string
+
+	// InfraNodeSelector takes the place of DefaultNodeSelector for infrastructure pods, which are the pods in
+	// InfraProjects and the pods created by InfraPodCreators. It is unused if empty.
+	InfraNodeSelector string
+	InfraProjects     sets.String
+	InfraPodCreators  sets.String
 }
 
 func (p *ProjectCache) GetNamespace(name string) (*kapi.Namespace, error) {
@@ -55,6 +62,11 @@ func (p *ProjectCache) GetNamespace(name string) (*kapi.Namespace, error) {
 }
 
 func (p *ProjectCache) GetNodeSelector(namespace *kapi.Namespace) string {
+	return nodeSelector(namespace, p.DefaultNodeSelector)
+}
+
+// nodeSelector returns the node selector of the project, or defaultNodeSelector if it has none
+func nodeSelector(namespace *kapi.Namespace, defaultNodeSelector string) string {
 	selector := ""
 	found := false
 	if len(namespace.ObjectMeta.Annotations) > 0 {
@@ -64,18 +76,51 @@ func (p *ProjectCache) GetNodeSelector(namespace *kapi.Namespace) string {
 		}
 	}
 	if !found {
-		selector = p.DefaultNodeSelector
+		selector = defaultNodeSelector
 	}
 	return selector
 }
 
+// GetNodeSelectorMap returns the node selector that applies to pods in the namespace.  When the namespace
+// uses the merge node selector policy, the cluster default node selector is added to the project node
+// selector, with the project node selector taking precedence on conflicting labels.
 func (p *ProjectCache) GetNodeSelectorMap(namespace *kapi.Namespace) (map[string]string, error) {
-	selector := p.GetNodeSelector(namespace)
+	return nodeSelectorMap(namespace, p.DefaultNodeSelector)
+}
+
+// IsInfraPod returns true if a pod created in the namespace by the named user is an infrastructure pod
+func (p *ProjectCache) IsInfraPod(namespace, creator string) bool {
+	return p.InfraProjects.Has(namespace) || p.InfraPodCreators.Has(creator)
+}
+
+// GetInfraNodeSelectorMap returns the node selector that applies to infrastructure pods in the namespace.  It is
+// the same as GetNodeSelectorMap, except that InfraNodeSelector takes the place of the cluster default node selector.
+func (p *ProjectCache) GetInfraNodeSelectorMap(namespace *kapi.Namespace) (map[string]string, error) {
+	if len(p.InfraNodeSelector) == 0 {
+		return p.GetNodeSelectorMap(namespace)
+	}
+	return nodeSelectorMap(namespace, p.InfraNodeSelector)
+}
+
+func nodeSelectorMap(namespace *kapi.Namespace, defaultNodeSelector string) (map[string]string, error) {
+	selector := nodeSelector(namespace, defaultNodeSelector)
 	labelsMap, err := labelselector.Parse(selector)
 	if err != nil {
 		return map[string]string{}, err
 	}
-	return labelsMap, nil
+
+	if namespace.Annotations[projectapi.ProjectNodeSelectorPolicy] != projectapi.NodeSelectorPolicyMerge {
+		return labelsMap, nil
+	}
+	if _, hasProjectSelector := namespace.Annotations[projectapi.ProjectNodeSelector]; !hasProjectSelector {
+		// the default node selector is already in use
+		return labelsMap, nil
+	}
+	defaultLabelsMap, err := labelselector.Parse(defaultNodeSelector)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	return labelselector.Merge(defaultLabelsMap, labelsMap), nil
 }
 
 // Run builds the store that backs this cache and runs the backing reflector
@@ -4,6 +4,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	osclient "github.com/openshift/origin/pkg/client"
 	projectutil "github.com/openshift/origin/pkg/project/util"
@@ -51,54 +52,37 @@ func (c *NamespaceController) Handle(namespace *kapi.Namespace) (err error) {
 	return nil
 }
 
-// deleteAllContent will purge all content in openshift in the specified namespace
-func deleteAllContent(client osclient.Interface, namespace string) (err error) {
-	err = deleteBuildConfigs(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteBuilds(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteDeploymentConfigs(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteImageStreams(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deletePolicies(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deletePolicyBindings(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteRoleBindings(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteRoles(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteRoutes(client, namespace)
-	if err != nil {
-		return err
-	}
-	err = deleteTemplates(client, namespace)
-	if err != nil {
-		return err
+// deleteAllContent will purge all content in openshift in the specified namespace. Every kind of
+// content is attempted even if a previous kind failed, so that a single failure does not leave
+// the remaining content orphaned. All errors are returned as an aggregate.
+func deleteAllContent(client osclient.Interface, namespace string) error {
+	deleters := []func(osclient.Interface, string) error{
+		deleteBuildConfigs,
+		deleteBuilds,
+		deleteDeploymentConfigs,
+		deleteImageStreams,
+		deletePolicies,
+		deletePolicyBindings,
+		deleteRoleBindings,
+		deleteRoles,
+		deleteRoutes,
+		deleteTemplates,
+	}
+	errs := []error{}
+	for _, deleter := range deleters {
+		if err := deleter(client, namespace); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
 func deleteTemplates(client osclient.Interface, ns string) error {
 	items, err := client.Templates(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -113,6 +97,9 @@ func deleteTemplates(client osclient.Interface, ns string) error {
 func deleteRoutes(client osclient.Interface, ns string) error {
 	items, err := client.Routes(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -127,6 +114,9 @@ func deleteRoutes(client osclient.Interface, ns string) error {
 func deleteRoles(client osclient.Interface, ns string) error {
 	items, err := client.Roles(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -141,6 +131,9 @@ func deleteRoles(client osclient.Interface, ns string) error {
 func deleteRoleBindings(client osclient.Interface, ns string) error {
 	items, err := client.RoleBindings(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -155,6 +148,9 @@ func deleteRoleBindings(client osclient.Interface, ns string) error {
 func deletePolicyBindings(client osclient.Interface, ns string) error {
 	items, err := client.PolicyBindings(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -169,6 +165,9 @@ func deletePolicyBindings(client osclient.Interface, ns string) error {
 func deletePolicies(client osclient.Interface, ns string) error {
 	items, err := client.Policies(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -183,6 +182,9 @@ func deletePolicies(client osclient.Interface, ns string) error {
 func deleteImageStreams(client osclient.Interface, ns string) error {
 	items, err := client.ImageStreams(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
@@ -197,6 +199,9 @@ func deleteImageStreams(client osclient.Interface, ns string) error {
 func deleteDeploymentConfigs(client osclient.Interface, ns string) error {
 	items, err := client.DeploymentConfigs(ns).List(kapi.ListOptions{})
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
 	for i := range items.Items {
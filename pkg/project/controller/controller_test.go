@@ -1,11 +1,15 @@
 package controller
 
 import (
+	"fmt"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/client/testclient"
 	"github.com/openshift/origin/pkg/project/api"
@@ -99,3 +103,47 @@ func TestSyncNamespaceThatIsActive(t *testing.T) {
 		t.Errorf("Expected no action from controller, but got: %v", actionSet)
 	}
 }
+
+func TestSyncNamespaceContinuesAfterDeleteFailure(t *testing.T) {
+	mockKubeClient := &ktestclient.Fake{}
+	mockOriginClient := &testclient.Fake{}
+	mockOriginClient.AddReactor("list", "buildconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, kapierrors.NewInternalError(fmt.Errorf("unable to list"))
+	})
+	nm := NamespaceController{
+		KubeClient: mockKubeClient,
+		Client:     mockOriginClient,
+	}
+	now := unversioned.Now()
+	testNamespace := &kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:              "test",
+			ResourceVersion:   "1",
+			DeletionTimestamp: &now,
+		},
+		Spec: kapi.NamespaceSpec{
+			Finalizers: []kapi.FinalizerName{kapi.FinalizerKubernetes, api.FinalizerOrigin},
+		},
+		Status: kapi.NamespaceStatus{
+			Phase: kapi.NamespaceTerminating,
+		},
+	}
+	if err := nm.Handle(testNamespace); err == nil {
+		t.Fatalf("Expected an error when content could not be deleted")
+	}
+
+	listed := sets.NewString()
+	for _, action := range mockOriginClient.Actions() {
+		if action.GetVerb() == "list" {
+			listed.Insert(action.GetResource())
+		}
+	}
+	for _, resource := range []string{"buildconfigs", "builds", "deploymentconfigs", "imagestreams", "policies", "policybindings", "rolebindings", "roles", "routes", "templates"} {
+		if !listed.Has(resource) {
+			t.Errorf("Expected %s to be listed for deletion, got actions: %v", resource, mockOriginClient.Actions())
+		}
+	}
+	if len(mockKubeClient.Actions()) != 0 {
+		t.Errorf("Expected namespace not to be finalized, but got: %v", mockKubeClient.Actions())
+	}
+}
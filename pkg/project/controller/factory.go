@@ -62,3 +62,55 @@ func (factory *NamespaceControllerFactory) Create() controller.RunnableControlle
 		},
 	}
 }
+
+// TemplateDefaultsControllerFactory creates a TemplateDefaultsController.
+type TemplateDefaultsControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+	// TemplateNamespace and TemplateName identify the project request template.
+	TemplateNamespace string
+	TemplateName      string
+	// ResyncPeriod is how often every namespace is checked again, which picks up quotas and limit ranges
+	// removed from a project.
+	ResyncPeriod time.Duration
+}
+
+// Create creates a TemplateDefaultsController.
+func (factory *TemplateDefaultsControllerFactory) Create() controller.RunnableController {
+	namespaceLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.Namespaces().List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.Namespaces().Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(namespaceLW, &kapi.Namespace{}, queue, factory.ResyncPeriod).Run()
+
+	defaultsController := &TemplateDefaultsController{
+		Client:            factory.Client,
+		KubeClient:        factory.KubeClient,
+		TemplateNamespace: factory.TemplateNamespace,
+		TemplateName:      factory.TemplateName,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				return retries.Count < 1
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			namespace := obj.(*kapi.Namespace)
+			return defaultsController.Handle(namespace)
+		},
+	}
+}
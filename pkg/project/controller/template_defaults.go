@@ -0,0 +1,110 @@
+package controller
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	utilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	"github.com/openshift/origin/pkg/project/registry/projectrequest/delegated"
+)
+
+// TemplateDefaultsController creates the resource quotas and limit ranges of the project request template
+// in existing projects that have none, such as projects created before the template was set.
+// Use the TemplateDefaultsControllerFactory to create this controller.
+type TemplateDefaultsController struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+	// TemplateNamespace and TemplateName identify the project request template. The default template is used if
+	// either is empty.
+	TemplateNamespace string
+	TemplateName      string
+}
+
+// Handle creates the resource quotas of the template if the namespace has no resource quota, and the limit
+// ranges of the template if it has no limit range. Only namespaces that were requested as projects are handled,
+// which leaves out system namespaces such as default and openshift-infra. Terminating and opted out namespaces
+// are ignored.
+func (c *TemplateDefaultsController) Handle(namespace *kapi.Namespace) error {
+	if _, requested := namespace.Annotations[projectapi.ProjectRequester]; !requested {
+		return nil
+	}
+	if namespace.Status.Phase == kapi.NamespaceTerminating {
+		return nil
+	}
+	if namespace.Annotations[projectapi.ProjectSkipTemplateDefaults] == "true" {
+		return nil
+	}
+
+	quotas, err := c.KubeClient.ResourceQuotas(namespace.Name).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	limitRanges, err := c.KubeClient.LimitRanges(namespace.Name).List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	needsQuota, needsLimitRange := len(quotas.Items) == 0, len(limitRanges.Items) == 0
+	if !needsQuota && !needsLimitRange {
+		return nil
+	}
+
+	objects, err := c.templateObjects(namespace)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for _, obj := range objects {
+		switch t := obj.(type) {
+		case *kapi.ResourceQuota:
+			if !needsQuota {
+				continue
+			}
+			t.Namespace = namespace.Name
+			_, err = c.KubeClient.ResourceQuotas(namespace.Name).Create(t)
+		case *kapi.LimitRange:
+			if !needsLimitRange {
+				continue
+			}
+			t.Namespace = namespace.Name
+			_, err = c.KubeClient.LimitRanges(namespace.Name).Create(t)
+		default:
+			continue
+		}
+		if err != nil && !kapierrors.IsAlreadyExists(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// templateObjects processes the project request template for the namespace with the values recorded on it
+// when it was requested.
+func (c *TemplateDefaultsController) templateObjects(namespace *kapi.Namespace) ([]runtime.Object, error) {
+	template, err := delegated.ProjectTemplate(c.Client, c.TemplateNamespace, c.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+	delegated.SetTemplateParameters(template, map[string]string{
+		delegated.ProjectNameParam:        namespace.Name,
+		delegated.ProjectDisplayNameParam: namespace.Annotations[projectapi.ProjectDisplayName],
+		delegated.ProjectDescriptionParam: namespace.Annotations[projectapi.ProjectDescription],
+		delegated.ProjectContactParam:     namespace.Annotations[projectapi.ProjectContact],
+		delegated.ProjectRequesterParam:   namespace.Annotations[projectapi.ProjectRequester],
+	})
+
+	list, err := c.Client.TemplateConfigs(kapi.NamespaceDefault).Create(template)
+	if err != nil {
+		return nil, err
+	}
+	if err := utilerrors.NewAggregate(runtime.DecodeList(list.Objects, kapi.Scheme)); err != nil {
+		return nil, err
+	}
+	return list.Objects, nil
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/project/api"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+// fakeTemplateClient serves a project request template holding a quota and a limit range. The fake echoes
+// processed templates back unchanged.
+func fakeTemplateClient() *testclient.Fake {
+	client := &testclient.Fake{}
+	client.AddReactor("get", "templates", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &templateapi.Template{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "openshift", Name: "project-request"},
+			Objects: []runtime.Object{
+				&api.Project{ObjectMeta: kapi.ObjectMeta{Name: "${PROJECT_NAME}"}},
+				&kapi.ResourceQuota{ObjectMeta: kapi.ObjectMeta{Name: "default-quota"}},
+				&kapi.LimitRange{ObjectMeta: kapi.ObjectMeta{Name: "default-limits"}},
+			},
+		}, nil
+	})
+	return client
+}
+
+// fakeNamespaceContentClient returns a client that lists the given quotas and limit ranges.
+func fakeNamespaceContentClient(quotas []kapi.ResourceQuota, limitRanges []kapi.LimitRange) *ktestclient.Fake {
+	client := &ktestclient.Fake{}
+	client.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &kapi.ResourceQuotaList{Items: quotas}, nil
+	})
+	client.AddReactor("list", "limitranges", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &kapi.LimitRangeList{Items: limitRanges}, nil
+	})
+	return client
+}
+
+func createdNames(client *ktestclient.Fake) map[string]string {
+	created := map[string]string{}
+	for _, action := range client.Actions() {
+		if create, ok := action.(ktestclient.CreateAction); ok {
+			accessor, _ := kapi.ObjectMetaFor(create.GetObject())
+			created[action.GetResource()] = accessor.Namespace + "/" + accessor.Name
+		}
+	}
+	return created
+}
+
+func TestTemplateDefaultsCreatesMissingObjects(t *testing.T) {
+	tests := map[string]struct {
+		quotas      []kapi.ResourceQuota
+		limitRanges []kapi.LimitRange
+		expected    map[string]string
+	}{
+		"empty project": {
+			expected: map[string]string{"resourcequotas": "foo/default-quota", "limitranges": "foo/default-limits"},
+		},
+		"project with a quota": {
+			quotas:   []kapi.ResourceQuota{{ObjectMeta: kapi.ObjectMeta{Name: "custom"}}},
+			expected: map[string]string{"limitranges": "foo/default-limits"},
+		},
+		"project with a quota and a limit range": {
+			quotas:      []kapi.ResourceQuota{{ObjectMeta: kapi.ObjectMeta{Name: "custom"}}},
+			limitRanges: []kapi.LimitRange{{ObjectMeta: kapi.ObjectMeta{Name: "custom"}}},
+			expected:    map[string]string{},
+		},
+	}
+
+	for name, test := range tests {
+		kubeClient := fakeNamespaceContentClient(test.quotas, test.limitRanges)
+		controller := TemplateDefaultsController{
+			Client:            fakeTemplateClient(),
+			KubeClient:        kubeClient,
+			TemplateNamespace: "openshift",
+			TemplateName:      "project-request",
+		}
+		namespace := &kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "foo", Annotations: map[string]string{api.ProjectRequester: "alice"}}}
+		if err := controller.Handle(namespace); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		created := createdNames(kubeClient)
+		if len(created) != len(test.expected) {
+			t.Errorf("%s: expected %v to be created, got %v", name, test.expected, created)
+			continue
+		}
+		for resource, expected := range test.expected {
+			if created[resource] != expected {
+				t.Errorf("%s: expected %s %s to be created, got %v", name, resource, expected, created)
+			}
+		}
+	}
+}
+
+func TestTemplateDefaultsIgnoresOptedOutTerminatingAndSystemProjects(t *testing.T) {
+	namespaces := []*kapi.Namespace{
+		{ObjectMeta: kapi.ObjectMeta{Name: "foo", Annotations: map[string]string{api.ProjectRequester: "alice", api.ProjectSkipTemplateDefaults: "true"}}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "bar", Annotations: map[string]string{api.ProjectRequester: "alice"}}, Status: kapi.NamespaceStatus{Phase: kapi.NamespaceTerminating}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "default"}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "openshift-infra"}},
+	}
+	for _, namespace := range namespaces {
+		kubeClient := fakeNamespaceContentClient(nil, nil)
+		originClient := fakeTemplateClient()
+		controller := TemplateDefaultsController{Client: originClient, KubeClient: kubeClient}
+		if err := controller.Handle(namespace); err != nil {
+			t.Errorf("%s: unexpected error: %v", namespace.Name, err)
+		}
+		if actions := append(kubeClient.Actions(), originClient.Actions()...); len(actions) != 0 {
+			t.Errorf("%s: unexpected actions: %#v", namespace.Name, actions)
+		}
+	}
+}
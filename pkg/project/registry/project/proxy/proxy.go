@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/rest"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
 	nsregistry "k8s.io/kubernetes/pkg/registry/namespace"
 	"k8s.io/kubernetes/pkg/runtime"
@@ -18,6 +19,7 @@ import (
 	projectapi "github.com/openshift/origin/pkg/project/api"
 	projectauth "github.com/openshift/origin/pkg/project/auth"
 	projectregistry "github.com/openshift/origin/pkg/project/registry/project"
+	"github.com/openshift/origin/pkg/security"
 )
 
 type REST struct {
@@ -59,11 +61,28 @@ func convertNamespace(namespace *kapi.Namespace) *api.Project {
 			Finalizers: namespace.Spec.Finalizers,
 		},
 		Status: api.ProjectStatus{
-			Phase: namespace.Status.Phase,
+			Phase:              namespace.Status.Phase,
+			SecurityAllocation: securityAllocation(namespace),
 		},
 	}
 }
 
+// securityAllocation returns the security attributes allocated to the namespace, or nil if none have been
+// allocated yet
+func securityAllocation(namespace *kapi.Namespace) *api.ProjectSecurityAllocation {
+	uidRange, hasUIDRange := namespace.Annotations[security.UIDRangeAnnotation]
+	supplementalGroups, hasSupplementalGroups := namespace.Annotations[security.SupplementalGroupsAnnotation]
+	mcsLabel, hasMCSLabel := namespace.Annotations[security.MCSAnnotation]
+	if !hasUIDRange && !hasSupplementalGroups && !hasMCSLabel {
+		return nil
+	}
+	return &api.ProjectSecurityAllocation{
+		UIDRange:           uidRange,
+		SupplementalGroups: supplementalGroups,
+		MCSLabel:           mcsLabel,
+	}
+}
+
 // convertProject transforms a Project into a Namespace
 func convertProject(project *api.Project) *kapi.Namespace {
 	namespace := &kapi.Namespace{
@@ -84,9 +103,9 @@ func convertProject(project *api.Project) *kapi.Namespace {
 
 // convertNamespaceList transforms a NamespaceList into a ProjectList
 func convertNamespaceList(namespaceList *kapi.NamespaceList) *api.ProjectList {
-	projects := &api.ProjectList{}
-	for _, n := range namespaceList.Items {
-		projects.Items = append(projects.Items, *convertNamespace(&n))
+	projects := &api.ProjectList{Items: make([]api.Project, 0, len(namespaceList.Items))}
+	for i := range namespaceList.Items {
+		projects.Items = append(projects.Items, *convertNamespace(&namespaceList.Items[i]))
 	}
 	return projects
 }
@@ -99,16 +118,20 @@ func (s *REST) List(ctx kapi.Context, options *unversioned.ListOptions) (runtime
 	if !ok {
 		return nil, kerrors.NewForbidden("Project", "", fmt.Errorf("unable to list projects without a user on the context"))
 	}
-	namespaceList, err := s.lister.List(user)
+	label, field := oapi.ListOptionsToSelectors(options)
+	// the label selector is applied by the lister, so only namespaces that can be returned are copied
+	namespaceList, err := s.lister.List(user, label)
 	if err != nil {
 		return nil, err
 	}
-	m := nsregistry.MatchNamespace(oapi.ListOptionsToSelectors(options))
-	list, err := filterList(namespaceList, m, nil)
-	if err != nil {
-		return nil, err
+	if !field.Empty() {
+		list, err := filterList(namespaceList, nsregistry.MatchNamespace(labels.Everything(), field), nil)
+		if err != nil {
+			return nil, err
+		}
+		namespaceList = list.(*kapi.NamespaceList)
 	}
-	return convertNamespaceList(list.(*kapi.NamespaceList)), nil
+	return convertNamespaceList(namespaceList), nil
 }
 
 var _ = rest.Getter(&REST{})
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	//  "fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -10,8 +11,10 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
 
 	"github.com/openshift/origin/pkg/project/api"
+	"github.com/openshift/origin/pkg/security"
 )
 
 // mockLister returns the namespaces in the list
@@ -19,7 +22,7 @@ type mockLister struct {
 	namespaceList *kapi.NamespaceList
 }
 
-func (ml *mockLister) List(user user.Info) (*kapi.NamespaceList, error) {
+func (ml *mockLister) List(user user.Info, selector labels.Selector) (*kapi.NamespaceList, error) {
 	return ml.namespaceList, nil
 }
 
@@ -113,6 +116,35 @@ func TestGetProjectOK(t *testing.T) {
 	}
 }
 
+func TestGetProjectSecurityAllocation(t *testing.T) {
+	mockClient := testclient.NewSimpleFake(
+		&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "foo"}},
+		&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "bar", Annotations: map[string]string{
+			security.UIDRangeAnnotation:           "1000010000/10000",
+			security.SupplementalGroupsAnnotation: "1000010000/10000",
+			security.MCSAnnotation:                "s0:c1,c0",
+		}}},
+	)
+	storage := NewREST(mockClient.Namespaces(), &mockLister{})
+
+	project, err := storage.Get(kapi.NewContext(), "foo")
+	if err != nil {
+		t.Fatalf("Unexpected non-nil error: %v", err)
+	}
+	if allocation := project.(*api.Project).Status.SecurityAllocation; allocation != nil {
+		t.Errorf("Unexpected security allocation: %#v", allocation)
+	}
+
+	project, err = storage.Get(kapi.NewContext(), "bar")
+	if err != nil {
+		t.Fatalf("Unexpected non-nil error: %v", err)
+	}
+	expected := &api.ProjectSecurityAllocation{UIDRange: "1000010000/10000", SupplementalGroups: "1000010000/10000", MCSLabel: "s0:c1,c0"}
+	if allocation := project.(*api.Project).Status.SecurityAllocation; !reflect.DeepEqual(expected, allocation) {
+		t.Errorf("Expected security allocation %#v, got %#v", expected, allocation)
+	}
+}
+
 func TestDeleteProject(t *testing.T) {
 	mockClient := &testclient.Fake{}
 	storage := REST{
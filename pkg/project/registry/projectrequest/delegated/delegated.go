@@ -73,25 +73,19 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 		projectRequester = userInfo.GetName()
 	}
 
-	template, err := r.getTemplate()
+	template, err := ProjectTemplate(r.openshiftClient, r.templateNamespace, r.templateName)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := range template.Parameters {
-		switch template.Parameters[i].Name {
-		case ProjectAdminUserParam:
-			template.Parameters[i].Value = projectAdmin
-		case ProjectDescriptionParam:
-			template.Parameters[i].Value = projectRequest.Description
-		case ProjectDisplayNameParam:
-			template.Parameters[i].Value = projectRequest.DisplayName
-		case ProjectNameParam:
-			template.Parameters[i].Value = projectName
-		case ProjectRequesterParam:
-			template.Parameters[i].Value = projectRequester
-		}
-	}
+	SetTemplateParameters(template, map[string]string{
+		ProjectAdminUserParam:   projectAdmin,
+		ProjectDescriptionParam: projectRequest.Description,
+		ProjectContactParam:     projectRequest.Annotations[projectapi.ProjectContact],
+		ProjectDisplayNameParam: projectRequest.DisplayName,
+		ProjectNameParam:        projectName,
+		ProjectRequesterParam:   projectRequester,
+	})
 
 	list, err := r.openshiftClient.TemplateConfigs(kapi.NamespaceDefault).Create(template)
 	if err != nil {
@@ -119,6 +113,9 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	if projectFromTemplate == nil {
 		return nil, kapierror.NewInternalError(fmt.Errorf("the project template (%s/%s) is not correctly configured: must contain a project resource", r.templateNamespace, r.templateName))
 	}
+	if projectFromTemplate.Name != projectName {
+		return nil, kapierror.NewInternalError(fmt.Errorf("the project template (%s/%s) is not correctly configured: the project resource must be named ${%s}", r.templateNamespace, r.templateName, ProjectNameParam))
+	}
 
 	// we split out project creation separately so that in a case of racers for the same project, only one will win and create the rest of their template objects
 	if _, err := r.openshiftClient.Projects().Create(projectFromTemplate); err != nil {
@@ -142,12 +139,33 @@ func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	return r.openshiftClient.Projects().Get(projectName)
 }
 
-func (r *REST) getTemplate() (*templateapi.Template, error) {
-	if len(r.templateNamespace) == 0 || len(r.templateName) == 0 {
+// ProjectTemplate returns the project request template with the given namespace and name, or the default
+// template if none is configured.
+func ProjectTemplate(openshiftClient client.Interface, templateNamespace, templateName string) (*templateapi.Template, error) {
+	if len(templateNamespace) == 0 || len(templateName) == 0 {
 		return DefaultTemplate(), nil
 	}
 
-	return r.openshiftClient.Templates(r.templateNamespace).Get(r.templateName)
+	template, err := openshiftClient.Templates(templateNamespace).Get(templateName)
+	if err != nil {
+		if kapierror.IsNotFound(err) {
+			// a missing template is a server misconfiguration, not something the requester can fix
+			return nil, kapierror.NewInternalError(fmt.Errorf("the project template (%s/%s) could not be found", templateNamespace, templateName))
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+// SetTemplateParameters sets the value of every template parameter that has a
+// value provided by the project request. Parameters the template does not declare
+// are ignored, so custom templates only need to reference the values they use.
+func SetTemplateParameters(template *templateapi.Template, values map[string]string) {
+	for i := range template.Parameters {
+		if value, ok := values[template.Parameters[i].Name]; ok {
+			template.Parameters[i].Value = value
+		}
+	}
 }
 
 var _ = rest.Lister(&REST{})
@@ -1,6 +1,42 @@
 package delegated
 
-import "testing"
+import (
+	"testing"
+
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
 
 func TestDelegated(t *testing.T) {
 }
+
+func TestSetTemplateParameters(t *testing.T) {
+	template := DefaultTemplate()
+	template.Parameters = append(template.Parameters, templateapi.Parameter{Name: "CUSTOM", Value: "unchanged"})
+
+	SetTemplateParameters(template, map[string]string{
+		ProjectNameParam:        "foo",
+		ProjectDisplayNameParam: "Foo",
+		ProjectDescriptionParam: "the foo project",
+		ProjectContactParam:     "foo-team@example.com",
+		ProjectAdminUserParam:   "alice",
+		ProjectRequesterParam:   "bob",
+	})
+
+	expected := map[string]string{
+		ProjectNameParam:        "foo",
+		ProjectDisplayNameParam: "Foo",
+		ProjectDescriptionParam: "the foo project",
+		ProjectContactParam:     "foo-team@example.com",
+		ProjectAdminUserParam:   "alice",
+		ProjectRequesterParam:   "bob",
+		"CUSTOM":                "unchanged",
+	}
+	if len(template.Parameters) != len(expected) {
+		t.Fatalf("unexpected parameters: %#v", template.Parameters)
+	}
+	for _, parameter := range template.Parameters {
+		if e, a := expected[parameter.Name], parameter.Value; e != a {
+			t.Errorf("parameter %s: expected %q, got %q", parameter.Name, e, a)
+		}
+	}
+}
@@ -15,12 +15,13 @@ const (
 	ProjectNameParam        = "PROJECT_NAME"
 	ProjectDisplayNameParam = "PROJECT_DISPLAYNAME"
 	ProjectDescriptionParam = "PROJECT_DESCRIPTION"
+	ProjectContactParam     = "PROJECT_CONTACT"
 	ProjectAdminUserParam   = "PROJECT_ADMIN_USER"
 	ProjectRequesterParam   = "PROJECT_REQUESTING_USER"
 )
 
 var (
-	parameters = []string{ProjectNameParam, ProjectDisplayNameParam, ProjectDescriptionParam, ProjectAdminUserParam, ProjectRequesterParam}
+	parameters = []string{ProjectNameParam, ProjectDisplayNameParam, ProjectDescriptionParam, ProjectContactParam, ProjectAdminUserParam, ProjectRequesterParam}
 )
 
 func DefaultTemplate() *templateapi.Template {
@@ -33,6 +34,7 @@ func DefaultTemplate() *templateapi.Template {
 	project.Name = ns
 	project.Annotations = map[string]string{
 		projectapi.ProjectDescription: "${" + ProjectDescriptionParam + "}",
+		projectapi.ProjectContact:     "${" + ProjectContactParam + "}",
 		projectapi.ProjectDisplayName: "${" + ProjectDisplayNameParam + "}",
 		projectapi.ProjectRequester:   "${" + ProjectRequesterParam + "}",
 	}
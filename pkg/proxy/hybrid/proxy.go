@@ -0,0 +1,124 @@
+package hybrid
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/proxy"
+	pconfig "k8s.io/kubernetes/pkg/proxy/config"
+	"k8s.io/kubernetes/pkg/types"
+
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+)
+
+// Proxier is a proxy that sends the traffic of idled services through the unidling proxy,
+// which can hold connections while the service is scaled back up, and the traffic of all other
+// services through the main proxy.
+type Proxier struct {
+	mainProxy            proxy.ProxyProvider
+	mainEndpointsHandler pconfig.EndpointsConfigHandler
+
+	unidlingProxy            proxy.ProxyProvider
+	unidlingEndpointsHandler pconfig.EndpointsConfigHandler
+
+	lock     sync.Mutex
+	services []kapi.Service
+	// idled holds the services that are sent to the unidling proxy
+	idled map[types.NamespacedName]bool
+}
+
+var _ proxy.ProxyProvider = &Proxier{}
+var _ pconfig.EndpointsConfigHandler = &Proxier{}
+
+// NewProxier returns a proxy that switches services between the main and the unidling proxy.
+// The endpoints of all services are passed to the unidling endpoints handler, so that
+// connections waiting for an idled service find its endpoints once it has been moved back to
+// the main proxy.
+func NewProxier(mainProxy proxy.ProxyProvider, mainEndpointsHandler pconfig.EndpointsConfigHandler, unidlingProxy proxy.ProxyProvider, unidlingEndpointsHandler pconfig.EndpointsConfigHandler) *Proxier {
+	return &Proxier{
+		mainProxy:                mainProxy,
+		mainEndpointsHandler:     mainEndpointsHandler,
+		unidlingProxy:            unidlingProxy,
+		unidlingEndpointsHandler: unidlingEndpointsHandler,
+		idled:                    map[types.NamespacedName]bool{},
+	}
+}
+
+// OnServiceUpdate passes each service to the proxy that handles it
+func (p *Proxier) OnServiceUpdate(services []kapi.Service) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.services = services
+	p.updateServices()
+}
+
+// OnEndpointsUpdate moves services that were idled to the unidling proxy, and services that
+// have endpoints again back to the main proxy
+func (p *Proxier) OnEndpointsUpdate(endpoints []kapi.Endpoints) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	idled := map[types.NamespacedName]bool{}
+	mainEndpoints := []kapi.Endpoints{}
+	for _, e := range endpoints {
+		name := types.NamespacedName{Namespace: e.Namespace, Name: e.Name}
+		_, isIdled := e.Annotations[unidlingapi.IdledAtAnnotation]
+		// a service that is being scaled back up stays with the unidling proxy until it has
+		// endpoints, so that new connections wait for it as well
+		if isIdled || (p.idled[name] && !hasAddresses(&e)) {
+			idled[name] = true
+			continue
+		}
+		mainEndpoints = append(mainEndpoints, e)
+	}
+	changed := len(idled) != len(p.idled)
+	for name := range idled {
+		if !p.idled[name] {
+			glog.V(4).Infof("Sending the traffic of the idled service %s to the unidling proxy", name)
+			changed = true
+		}
+	}
+	p.idled = idled
+
+	p.unidlingEndpointsHandler.OnEndpointsUpdate(endpoints)
+	if changed {
+		p.updateServices()
+	}
+	p.mainEndpointsHandler.OnEndpointsUpdate(mainEndpoints)
+}
+
+// updateServices splits the services between the two proxies. The lock must be held.
+func (p *Proxier) updateServices() {
+	mainServices, unidlingServices := []kapi.Service{}, []kapi.Service{}
+	for _, service := range p.services {
+		if p.idled[types.NamespacedName{Namespace: service.Namespace, Name: service.Name}] {
+			unidlingServices = append(unidlingServices, service)
+		} else {
+			mainServices = append(mainServices, service)
+		}
+	}
+	p.unidlingProxy.OnServiceUpdate(unidlingServices)
+	p.mainProxy.OnServiceUpdate(mainServices)
+}
+
+// Sync synchronizes both proxies
+func (p *Proxier) Sync() {
+	p.mainProxy.Sync()
+	p.unidlingProxy.Sync()
+}
+
+// SyncLoop runs the periodic work of both proxies. It does not return.
+func (p *Proxier) SyncLoop() {
+	go p.unidlingProxy.SyncLoop()
+	p.mainProxy.SyncLoop()
+}
+
+func hasAddresses(endpoints *kapi.Endpoints) bool {
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package hybrid
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+)
+
+// fakeProxy records the names of the services and endpoints it was last given
+type fakeProxy struct {
+	services  []string
+	endpoints []string
+}
+
+func (p *fakeProxy) OnServiceUpdate(services []kapi.Service) {
+	p.services = []string{}
+	for _, service := range services {
+		p.services = append(p.services, service.Name)
+	}
+	sort.Strings(p.services)
+}
+
+func (p *fakeProxy) OnEndpointsUpdate(endpoints []kapi.Endpoints) {
+	p.endpoints = []string{}
+	for _, e := range endpoints {
+		p.endpoints = append(p.endpoints, e.Name)
+	}
+	sort.Strings(p.endpoints)
+}
+
+func (p *fakeProxy) Sync()     {}
+func (p *fakeProxy) SyncLoop() {}
+
+func service(name string) kapi.Service {
+	return kapi.Service{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name}}
+}
+
+func endpoints(name string, idled, addresses bool) kapi.Endpoints {
+	e := kapi.Endpoints{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name}}
+	if idled {
+		e.Annotations = map[string]string{unidlingapi.IdledAtAnnotation: "2016-05-01T12:00:00Z"}
+	}
+	if addresses {
+		e.Subsets = []kapi.EndpointSubset{{
+			Addresses: []kapi.EndpointAddress{{IP: "10.1.0.1"}},
+			Ports:     []kapi.EndpointPort{{Port: 8080}},
+		}}
+	}
+	return e
+}
+
+func TestProxierSwitchesIdledServices(t *testing.T) {
+	main, unidling := &fakeProxy{}, &fakeProxy{}
+	proxier := NewProxier(main, main, unidling, unidling)
+
+	check := func(step string, mainServices, unidlingServices, mainEndpoints []string) {
+		if !reflect.DeepEqual(main.services, mainServices) {
+			t.Errorf("%s: expected the main proxy to have services %v, got %v", step, mainServices, main.services)
+		}
+		if !reflect.DeepEqual(unidling.services, unidlingServices) {
+			t.Errorf("%s: expected the unidling proxy to have services %v, got %v", step, unidlingServices, unidling.services)
+		}
+		if !reflect.DeepEqual(main.endpoints, mainEndpoints) {
+			t.Errorf("%s: expected the main proxy to have endpoints %v, got %v", step, mainEndpoints, main.endpoints)
+		}
+		if len(unidling.endpoints) != 2 {
+			t.Errorf("%s: expected the unidling proxy to have all endpoints, got %v", step, unidling.endpoints)
+		}
+	}
+
+	proxier.OnServiceUpdate([]kapi.Service{service("frontend"), service("database")})
+	proxier.OnEndpointsUpdate([]kapi.Endpoints{endpoints("frontend", false, true), endpoints("database", false, true)})
+	check("running", []string{"database", "frontend"}, []string{}, []string{"database", "frontend"})
+
+	proxier.OnEndpointsUpdate([]kapi.Endpoints{endpoints("frontend", true, false), endpoints("database", false, true)})
+	check("idled", []string{"database"}, []string{"frontend"}, []string{"database"})
+
+	proxier.OnServiceUpdate([]kapi.Service{service("frontend"), service("database")})
+	check("services resynced", []string{"database"}, []string{"frontend"}, []string{"database"})
+
+	// the unidling controller removes the annotations before the pods are ready
+	proxier.OnEndpointsUpdate([]kapi.Endpoints{endpoints("frontend", false, false), endpoints("database", false, true)})
+	check("unidling", []string{"database"}, []string{"frontend"}, []string{"database"})
+
+	proxier.OnEndpointsUpdate([]kapi.Endpoints{endpoints("frontend", false, true), endpoints("database", false, true)})
+	check("unidled", []string{"database", "frontend"}, []string{}, []string{"database", "frontend"})
+}
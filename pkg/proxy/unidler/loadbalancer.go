@@ -0,0 +1,111 @@
+package unidler
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/proxy"
+	"k8s.io/kubernetes/pkg/proxy/userspace"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+)
+
+// NeedPodsSignaler is told when an idled service receives traffic
+type NeedPodsSignaler interface {
+	// NeedPods signals that the service needs pods to handle its traffic
+	NeedPods(service proxy.ServicePortName)
+}
+
+// eventSignaler records a NeedPods event on the service, which the unidling controller
+// handles by scaling the service back up
+type eventSignaler struct {
+	recorder record.EventRecorder
+}
+
+// NewEventSignaler returns a NeedPodsSignaler that records events with the recorder
+func NewEventSignaler(recorder record.EventRecorder) NeedPodsSignaler {
+	return &eventSignaler{recorder: recorder}
+}
+
+func (s *eventSignaler) NeedPods(service proxy.ServicePortName) {
+	ref := &kapi.ObjectReference{
+		Kind:      "Service",
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}
+	s.recorder.Eventf(ref, kapi.EventTypeNormal, unidlingapi.NeedPodsReason, "The service-port %s needs pods", service)
+}
+
+// LoadBalancer is a round robin load balancer for idled services. When a service has no
+// endpoints, it signals that the service needs pods and holds the connection until endpoints
+// appear or the timeout expires.
+type LoadBalancer struct {
+	*userspace.LoadBalancerRR
+
+	signaler NeedPodsSignaler
+	// timeout is how long a connection waits for the service to get endpoints
+	timeout time.Duration
+	// interval is how often the endpoints are checked while waiting
+	interval time.Duration
+
+	lock sync.Mutex
+	// signaled holds the time at which each service-port was last signaled, so that many
+	// connections to the same idled service do not each record an event
+	signaled map[proxy.ServicePortName]time.Time
+}
+
+// NewLoadBalancer returns a load balancer that signals signaler when an idled service receives
+// traffic, and waits up to timeout for the service to get endpoints
+func NewLoadBalancer(signaler NeedPodsSignaler, timeout time.Duration) *LoadBalancer {
+	return &LoadBalancer{
+		LoadBalancerRR: userspace.NewLoadBalancerRR(),
+		signaler:       signaler,
+		timeout:        timeout,
+		interval:       250 * time.Millisecond,
+		signaled:       map[proxy.ServicePortName]time.Time{},
+	}
+}
+
+// NextEndpoint returns the next endpoint of the service, waiting for the service to be
+// scaled back up if it has no endpoints
+func (lb *LoadBalancer) NextEndpoint(service proxy.ServicePortName, srcAddr net.Addr) (string, error) {
+	endpoint, err := lb.LoadBalancerRR.NextEndpoint(service, srcAddr)
+	if err != userspace.ErrMissingEndpoints {
+		return endpoint, err
+	}
+
+	lb.signal(service)
+	glog.V(4).Infof("Waiting for the idled service %s to get endpoints", service)
+	err = wait.Poll(lb.interval, lb.timeout, func() (bool, error) {
+		endpoint, err = lb.LoadBalancerRR.NextEndpoint(service, srcAddr)
+		switch err {
+		case nil:
+			return true, nil
+		case userspace.ErrMissingEndpoints:
+			return false, nil
+		default:
+			return false, err
+		}
+	})
+	if err == wait.ErrWaitTimeout {
+		return "", userspace.ErrMissingEndpoints
+	}
+	return endpoint, err
+}
+
+// signal signals that the service needs pods, unless it was signaled within the timeout
+func (lb *LoadBalancer) signal(service proxy.ServicePortName) {
+	lb.lock.Lock()
+	defer lb.lock.Unlock()
+	now := time.Now()
+	if last, ok := lb.signaled[service]; ok && now.Sub(last) < lb.timeout {
+		return
+	}
+	lb.signaled[service] = now
+	lb.signaler.NeedPods(service)
+}
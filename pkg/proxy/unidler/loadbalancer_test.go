@@ -0,0 +1,78 @@
+package unidler
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/proxy"
+	"k8s.io/kubernetes/pkg/proxy/userspace"
+	"k8s.io/kubernetes/pkg/types"
+)
+
+type fakeSignaler struct {
+	signaled []proxy.ServicePortName
+}
+
+func (s *fakeSignaler) NeedPods(service proxy.ServicePortName) {
+	s.signaled = append(s.signaled, service)
+}
+
+func TestNextEndpointWaitsForEndpoints(t *testing.T) {
+	signaler := &fakeSignaler{}
+	lb := NewLoadBalancer(signaler, 5*time.Second)
+	lb.interval = 10 * time.Millisecond
+
+	service := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: "test", Name: "frontend"}, Port: "web"}
+	if err := lb.NewService(service, kapi.ServiceAffinityNone, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		lb.OnEndpointsUpdate([]kapi.Endpoints{{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"},
+			Subsets: []kapi.EndpointSubset{{
+				Addresses: []kapi.EndpointAddress{{IP: "10.1.0.1"}},
+				Ports:     []kapi.EndpointPort{{Name: "web", Port: 8080}},
+			}},
+		}})
+	}()
+
+	endpoint, err := lb.NextEndpoint(service, &net.TCPAddr{IP: net.ParseIP("10.2.0.1"), Port: 1234})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if endpoint != "10.1.0.1:8080" {
+		t.Errorf("unexpected endpoint %q", endpoint)
+	}
+	if len(signaler.signaled) != 1 || signaler.signaled[0] != service {
+		t.Errorf("expected the service to be signaled once, got %v", signaler.signaled)
+	}
+}
+
+func TestNextEndpointSignalsOncePerTimeout(t *testing.T) {
+	signaler := &fakeSignaler{}
+	lb := NewLoadBalancer(signaler, 50*time.Millisecond)
+	lb.interval = 10 * time.Millisecond
+
+	service := proxy.ServicePortName{NamespacedName: types.NamespacedName{Namespace: "test", Name: "frontend"}, Port: "web"}
+	if err := lb.NewService(service, kapi.ServiceAffinityNone, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	lb.signal(service)
+	lb.signal(service)
+	if len(signaler.signaled) != 1 {
+		t.Errorf("expected one signal within the timeout, got %v", signaler.signaled)
+	}
+
+	if _, err := lb.NextEndpoint(service, &net.TCPAddr{IP: net.ParseIP("10.2.0.1"), Port: 1234}); err != userspace.ErrMissingEndpoints {
+		t.Errorf("expected the wait for endpoints to time out, got %v", err)
+	}
+	lb.signal(service)
+	if len(signaler.signaled) != 2 {
+		t.Errorf("expected another signal after the timeout, got %v", signaler.signaled)
+	}
+}
@@ -0,0 +1,264 @@
+package clusterresourcequota
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+	"k8s.io/kubernetes/plugin/pkg/admission/resourcequota"
+
+	"github.com/openshift/origin/pkg/client"
+	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+func init() {
+	admission.RegisterPlugin("ClusterResourceQuota", func(kubeClient kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewClusterResourceQuota(kubeClient), nil
+	})
+}
+
+// numRetries is how often the usage of a quota is incremented when there are concurrent
+// updates to it before the request is rejected
+const numRetries = 10
+
+// quotaResources are the resources whose creation is charged against a cluster quota
+var quotaResources = map[unversioned.GroupResource]bool{
+	kapi.Resource("pods"):                   true,
+	kapi.Resource("services"):               true,
+	kapi.Resource("replicationcontrollers"): true,
+	kapi.Resource("resourcequotas"):         true,
+	kapi.Resource("secrets"):                true,
+	kapi.Resource("persistentvolumeclaims"): true,
+}
+
+type clusterResourceQuota struct {
+	*admission.Handler
+	kubeClient kclient.Interface
+	client     client.Interface
+	cache      *projectcache.ProjectCache
+	// quotas holds the cluster resource quotas, watched once the Openshift client is set
+	quotas cache.Store
+}
+
+// ensure that the required Openshift admission interfaces are implemented
+var _ = oadmission.WantsProjectCache(&clusterResourceQuota{})
+var _ = oadmission.WantsOpenshiftClient(&clusterResourceQuota{})
+var _ = oadmission.Validator(&clusterResourceQuota{})
+
+// NewClusterResourceQuota returns an admission controller that charges the creation of
+// resources in a namespace against every cluster resource quota selecting that namespace.
+func NewClusterResourceQuota(kubeClient kclient.Interface) admission.Interface {
+	return &clusterResourceQuota{
+		Handler:    admission.NewHandler(admission.Create, admission.Update),
+		kubeClient: kubeClient,
+	}
+}
+
+// Admit increments the total usage of each cluster resource quota that selects the namespace of
+// the request, rejecting the request if it would exceed any of them. The usage recorded per
+// namespace is left to the reconciliation controller.
+func (q *clusterResourceQuota) Admit(a admission.Attributes) error {
+	if a.GetSubresource() != "" {
+		return nil
+	}
+	if !quotaResources[a.GetResource()] {
+		return nil
+	}
+	if len(a.GetNamespace()) == 0 || !q.cache.Running() {
+		return nil
+	}
+
+	namespace, err := q.cache.GetNamespace(a.GetNamespace())
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+
+	// every selecting quota is checked before any usage is written, so that a request rejected by
+	// one quota is not charged against the others. The cached quotas may be stale, which is caught
+	// as a conflict when their usage is updated.
+	charges := []*quotaCharge{}
+	for _, obj := range q.quotas.List() {
+		quota := obj.(*quotaapi.ClusterResourceQuota)
+		if !quota.Spec.Selector.Matches(namespace) {
+			continue
+		}
+		charge, err := chargeQuota(a, quota, q.kubeClient)
+		if err != nil {
+			return err
+		}
+		if charge != nil {
+			charges = append(charges, charge)
+		}
+	}
+
+	for i, charge := range charges {
+		if err := q.incrementUsage(a, charge); err != nil {
+			// the request is rejected, so the usage already written to other quotas is given back
+			for _, written := range charges[:i] {
+				q.decrementUsage(written)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// quotaCharge is the usage a request adds to a cluster resource quota
+type quotaCharge struct {
+	quota *quotaapi.ClusterResourceQuota
+	// status is the total usage of the quota including the request
+	status *kapi.ResourceQuotaStatus
+	// used is the usage added by the request in milli units, kept to give the usage back
+	used map[kapi.ResourceName]int64
+}
+
+// chargeQuota returns the usage the request adds to the quota, nil if the quota does not track
+// any of it, or a forbidden error if the request exceeds the quota.
+func chargeQuota(a admission.Attributes, quota *quotaapi.ClusterResourceQuota, kubeClient kclient.Interface) (*quotaCharge, error) {
+	// we cannot modify the quota we were given, so we copy the status
+	status := &kapi.ResourceQuotaStatus{
+		Hard: kapi.ResourceList{},
+		Used: kapi.ResourceList{},
+	}
+	for k, v := range quota.Status.Total.Hard {
+		status.Hard[k] = *v.Copy()
+	}
+	for k, v := range quota.Status.Total.Used {
+		status.Used[k] = *v.Copy()
+	}
+
+	dirty, err := resourcequota.IncrementUsage(a, status, kubeClient)
+	if err != nil {
+		return nil, admission.NewForbidden(a, fmt.Errorf("cluster quota %s: %v", quota.Name, err))
+	}
+	if !dirty {
+		return nil, nil
+	}
+
+	used := map[kapi.ResourceName]int64{}
+	for k, v := range status.Used {
+		previous := quota.Status.Total.Used[k]
+		if delta := v.MilliValue() - previous.MilliValue(); delta != 0 {
+			used[k] = delta
+		}
+	}
+	return &quotaCharge{quota: quota, status: status, used: used}, nil
+}
+
+// incrementUsage writes the charge to its quota, charging the request again against the current
+// quota on concurrent updates.
+func (q *clusterResourceQuota) incrementUsage(a admission.Attributes, charge *quotaCharge) error {
+	// we fuzz each retry with an interval period to attempt to improve end-user experience during concurrent operations
+	interval := time.Duration(rand.Int63n(90)+int64(10)) * time.Millisecond
+
+	for retry := 1; ; retry++ {
+		updated := *charge.quota
+		updated.Status.Total = *charge.status
+		if _, err := q.client.ClusterResourceQuotas().UpdateStatus(&updated); err == nil {
+			return nil
+		}
+
+		// we have concurrent requests to update quota, so look to retry if needed
+		if retry == numRetries {
+			return admission.NewForbidden(a, fmt.Errorf("unable to %s %s at this time because there are too many concurrent requests to increment quota", a.GetOperation(), a.GetResource()))
+		}
+		time.Sleep(interval)
+		quota, err := q.client.ClusterResourceQuotas().Get(charge.quota.Name)
+		if err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		recharged, err := chargeQuota(a, quota, q.kubeClient)
+		if err != nil {
+			return err
+		}
+		if recharged == nil {
+			charge.used = nil
+			return nil
+		}
+		*charge = *recharged
+	}
+}
+
+// decrementUsage gives back the usage written for a request that was rejected by another quota.
+// Failures are only logged, the usage is corrected when the quota is reconciled.
+func (q *clusterResourceQuota) decrementUsage(charge *quotaCharge) {
+	if len(charge.used) == 0 {
+		return
+	}
+	for retry := 1; retry <= numRetries; retry++ {
+		quota, err := q.client.ClusterResourceQuotas().Get(charge.quota.Name)
+		if err != nil {
+			glog.Errorf("Unable to give back the usage of a rejected request to cluster quota %s: %v", charge.quota.Name, err)
+			return
+		}
+
+		updated := *quota
+		updated.Status.Total.Used = kapi.ResourceList{}
+		for k, v := range quota.Status.Total.Used {
+			updated.Status.Total.Used[k] = *v.Copy()
+		}
+		for k, delta := range charge.used {
+			if used, ok := updated.Status.Total.Used[k]; ok {
+				updated.Status.Total.Used[k] = *resource.NewMilliQuantity(used.MilliValue()-delta, used.Format)
+			}
+		}
+		_, err = q.client.ClusterResourceQuotas().UpdateStatus(&updated)
+		if err == nil {
+			return
+		}
+		if !kapierrors.IsConflict(err) {
+			glog.Errorf("Unable to give back the usage of a rejected request to cluster quota %s: %v", charge.quota.Name, err)
+			return
+		}
+	}
+	glog.Errorf("Unable to give back the usage of a rejected request to cluster quota %s: too many concurrent updates", charge.quota.Name)
+}
+
+func (q *clusterResourceQuota) SetOpenshiftClient(client client.Interface) {
+	q.client = client
+
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return client.ClusterResourceQuotas().List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return client.ClusterResourceQuotas().Watch(options)
+			},
+		},
+		&quotaapi.ClusterResourceQuota{},
+		store,
+		0,
+	)
+	reflector.Run()
+	q.quotas = store
+}
+
+func (q *clusterResourceQuota) SetProjectCache(cache *projectcache.ProjectCache) {
+	q.cache = cache
+}
+
+func (q *clusterResourceQuota) Validate() error {
+	if q.client == nil {
+		return fmt.Errorf("ClusterResourceQuota plugin requires an Openshift client")
+	}
+	if q.cache == nil {
+		return fmt.Errorf("ClusterResourceQuota plugin requires a project cache")
+	}
+	return nil
+}
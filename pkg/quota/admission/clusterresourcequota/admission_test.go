@@ -0,0 +1,210 @@
+package clusterresourcequota
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	projectcache "github.com/openshift/origin/pkg/project/cache"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+func testQuota(used int64) *quotaapi.ClusterResourceQuota {
+	return namedTestQuota("bob", used)
+}
+
+func namedTestQuota(name string, used int64) *quotaapi.ClusterResourceQuota {
+	hard := kapi.ResourceList{kapi.ResourcePods: resource.MustParse("10")}
+	return &quotaapi.ClusterResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Name: name, ResourceVersion: "1"},
+		Spec: quotaapi.ClusterResourceQuotaSpec{
+			Selector: quotaapi.ClusterResourceQuotaSelector{
+				AnnotationSelector: map[string]string{"openshift.io/requester": "bob"},
+			},
+			Quota: kapi.ResourceQuotaSpec{Hard: hard},
+		},
+		Status: quotaapi.ClusterResourceQuotaStatus{
+			Total: kapi.ResourceQuotaStatus{
+				Hard: hard,
+				Used: kapi.ResourceList{kapi.ResourcePods: *resource.NewQuantity(used, resource.DecimalSI)},
+			},
+		},
+	}
+}
+
+func newTestAdmission(t *testing.T, quotas ...*quotaapi.ClusterResourceQuota) (admission.Interface, *testclient.Fake) {
+	kubeClient := &ktestclient.Fake{}
+	store := projectcache.NewCacheStore(cache.MetaNamespaceKeyFunc)
+	for _, ns := range []*kapi.Namespace{
+		{ObjectMeta: kapi.ObjectMeta{Name: "mine", Annotations: map[string]string{"openshift.io/requester": "bob"}}},
+		{ObjectMeta: kapi.ObjectMeta{Name: "other", Annotations: map[string]string{"openshift.io/requester": "alice"}}},
+	} {
+		if err := store.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	originClient := &testclient.Fake{}
+	quotaStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for _, quota := range quotas {
+		if err := quotaStore.Add(quota); err != nil {
+			t.Fatal(err)
+		}
+	}
+	originClient.AddReactor("get", "clusterresourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		obj, _, err := quotaStore.GetByKey(action.(ktestclient.GetAction).GetName())
+		return true, obj.(runtime.Object), err
+	})
+	originClient.AddReactor("update", "clusterresourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		obj := action.(ktestclient.UpdateAction).GetObject()
+		return true, obj, quotaStore.Update(obj)
+	})
+
+	plugin := NewClusterResourceQuota(kubeClient)
+	// the quotas are set directly rather than watched through the client
+	plugin.(*clusterResourceQuota).client = originClient
+	plugin.(*clusterResourceQuota).quotas = quotaStore
+	plugin.(*clusterResourceQuota).SetProjectCache(projectcache.NewFake(kubeClient.Namespaces(), store, ""))
+	if err := plugin.(*clusterResourceQuota).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	return plugin, originClient
+}
+
+func podAttributes(namespace string) admission.Attributes {
+	pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: "pod", Namespace: namespace}}
+	return admission.NewAttributesRecord(pod, kapi.Kind("Pod"), namespace, pod.Name, kapi.Resource("pods"), "", admission.Create, nil)
+}
+
+func statusUpdates(client *testclient.Fake) []*quotaapi.ClusterResourceQuota {
+	updates := []*quotaapi.ClusterResourceQuota{}
+	for _, action := range client.Actions() {
+		if action.Matches("update", "clusterresourcequotas") && action.GetSubresource() == "status" {
+			updates = append(updates, action.(ktestclient.UpdateAction).GetObject().(*quotaapi.ClusterResourceQuota))
+		}
+	}
+	return updates
+}
+
+func TestAdmitIncrementsSelectingQuota(t *testing.T) {
+	plugin, client := newTestAdmission(t, testQuota(3))
+
+	if err := plugin.Admit(podAttributes("mine")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updates := statusUpdates(client)
+	if len(updates) != 1 {
+		t.Fatalf("expected a single status update, got %#v", client.Actions())
+	}
+	if used := updates[0].Status.Total.Used[kapi.ResourcePods]; used.Value() != 4 {
+		t.Errorf("expected usage to be incremented to 4, got %s", used.String())
+	}
+}
+
+func TestAdmitRejectsOverQuota(t *testing.T) {
+	plugin, client := newTestAdmission(t, testQuota(10))
+
+	err := plugin.Admit(podAttributes("mine"))
+	if !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 0 {
+		t.Errorf("unexpected status updates: %#v", updates)
+	}
+}
+
+func TestAdmitIgnoresUnselectedNamespaces(t *testing.T) {
+	plugin, client := newTestAdmission(t, testQuota(10))
+
+	if err := plugin.Admit(podAttributes("other")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 0 {
+		t.Errorf("unexpected status updates: %#v", updates)
+	}
+}
+
+func TestAdmitIgnoresUntrackedResources(t *testing.T) {
+	plugin, client := newTestAdmission(t, testQuota(10))
+
+	obj := &kapi.LimitRange{ObjectMeta: kapi.ObjectMeta{Name: "limits", Namespace: "mine"}}
+	attributes := admission.NewAttributesRecord(obj, kapi.Kind("LimitRange"), "mine", obj.Name, kapi.Resource("limitranges"), "", admission.Create, nil)
+	if err := plugin.Admit(attributes); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := client.Actions(); len(actions) != 0 {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
+
+func TestAdmitRetriesOnConflict(t *testing.T) {
+	plugin, client := newTestAdmission(t, testQuota(3))
+	conflicted := false
+	client.PrependReactor("update", "clusterresourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		if conflicted {
+			return false, nil, nil
+		}
+		conflicted = true
+		return true, nil, kapierrors.NewConflict("clusterresourcequotas", "bob", fmt.Errorf("conflict"))
+	})
+
+	if err := plugin.Admit(podAttributes("mine")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 2 {
+		t.Errorf("expected the status update to be retried, got %#v", client.Actions())
+	}
+}
+
+func TestAdmitChecksEveryQuotaBeforeWriting(t *testing.T) {
+	plugin, client := newTestAdmission(t, namedTestQuota("bob", 3), namedTestQuota("full", 10))
+
+	err := plugin.Admit(podAttributes("mine"))
+	if !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 0 {
+		t.Errorf("expected no quota to be charged, got %#v", updates)
+	}
+}
+
+func TestAdmitGivesBackUsageWhenAWriteFails(t *testing.T) {
+	plugin, client := newTestAdmission(t, namedTestQuota("bob", 3), namedTestQuota("alice", 3))
+	// the quotas are listed in no particular order, so the write to whichever quota comes second fails
+	written := ""
+	client.PrependReactor("update", "clusterresourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		name := action.(ktestclient.UpdateAction).GetObject().(*quotaapi.ClusterResourceQuota).Name
+		if len(written) == 0 {
+			written = name
+		}
+		if name == written {
+			return false, nil, nil
+		}
+		return true, nil, fmt.Errorf("write failed")
+	})
+
+	err := plugin.Admit(podAttributes("mine"))
+	if !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+
+	used := []int64{}
+	for _, update := range statusUpdates(client) {
+		if update.Name == written {
+			pods := update.Status.Total.Used[kapi.ResourcePods]
+			used = append(used, pods.Value())
+		}
+	}
+	if len(used) != 2 || used[0] != 4 || used[1] != 3 {
+		t.Errorf("expected the usage of %s to be incremented to 4 and given back to 3, got %v", written, used)
+	}
+}
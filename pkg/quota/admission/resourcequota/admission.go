@@ -0,0 +1,107 @@
+package resourcequota
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	"github.com/openshift/origin/pkg/quota/evaluator"
+)
+
+// PluginName is the name of the plug-in that enforces quota on OpenShift resources.
+const PluginName = "OriginResourceQuota"
+
+func init() {
+	admission.RegisterPlugin(PluginName, func(kubeClient kclient.Interface, config io.Reader) (admission.Interface, error) {
+		return NewOriginResourceQuota(kubeClient), nil
+	})
+}
+
+// numRetries is how often the usage of a quota is incremented when there are concurrent
+// updates to it before the request is rejected
+const numRetries = 10
+
+type originResourceQuota struct {
+	*admission.Handler
+	kubeClient kclient.Interface
+}
+
+// NewOriginResourceQuota returns an admission controller that charges the creation of image streams,
+// routes, build configs and builds against the resource quotas of their namespace. Builds created by
+// the build generator are admitted by the generator itself, so that builds started by triggers and
+// webhooks are charged as well.
+func NewOriginResourceQuota(kubeClient kclient.Interface) admission.Interface {
+	return &originResourceQuota{
+		Handler:    admission.NewHandler(admission.Create),
+		kubeClient: kubeClient,
+	}
+}
+
+// Admit increments the usage of each resource quota in the namespace of the request that limits the
+// charged resource, rejecting the request if it would exceed any of them. The usage is later
+// recalculated by the resource quota controller.
+func (q *originResourceQuota) Admit(a admission.Attributes) error {
+	resourceName, ok := evaluator.ChargedResource(a.GetResource(), a.GetSubresource())
+	if !ok || len(a.GetNamespace()) == 0 {
+		return nil
+	}
+
+	quotas, err := q.kubeClient.ResourceQuotas(a.GetNamespace()).List(kapi.ListOptions{})
+	if err != nil {
+		return admission.NewForbidden(a, fmt.Errorf("unable to %s %s at this time because there was an error enforcing quota", a.GetOperation(), a.GetResource()))
+	}
+
+	for i := range quotas.Items {
+		if err := q.incrementUsage(a, &quotas.Items[i], resourceName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// incrementUsage charges the request against the quota, retrying on concurrent updates.
+func (q *originResourceQuota) incrementUsage(a admission.Attributes, quota *kapi.ResourceQuota, resourceName kapi.ResourceName) error {
+	// we fuzz each retry with an interval period to attempt to improve end-user experience during concurrent operations
+	interval := time.Duration(rand.Int63n(90)+int64(10)) * time.Millisecond
+
+	for retry := 1; ; retry++ {
+		hard, ok := quota.Status.Hard[resourceName]
+		if !ok {
+			return nil
+		}
+		used, ok := quota.Status.Used[resourceName]
+		if !ok {
+			return admission.NewForbidden(a, fmt.Errorf("quota usage stats are not yet known, unable to admit resource until an accurate count is completed."))
+		}
+		if used.Value() >= hard.Value() {
+			return admission.NewForbidden(a, fmt.Errorf("limited to %s %s", hard.String(), resourceName))
+		}
+
+		// we cannot modify the quota we were given, so we copy the usage
+		updated := *quota
+		updated.Status.Used = kapi.ResourceList{}
+		for k, v := range quota.Status.Used {
+			updated.Status.Used[k] = *v.Copy()
+		}
+		updated.Status.Used[resourceName] = *resource.NewQuantity(used.Value()+1, resource.DecimalSI)
+		if _, err := q.kubeClient.ResourceQuotas(quota.Namespace).UpdateStatus(&updated); err == nil {
+			return nil
+		}
+
+		// we have concurrent requests to update quota, so look to retry if needed
+		if retry == numRetries {
+			return admission.NewForbidden(a, fmt.Errorf("unable to %s %s at this time because there are too many concurrent requests to increment quota", a.GetOperation(), a.GetResource()))
+		}
+		time.Sleep(interval)
+		var err error
+		if quota, err = q.kubeClient.ResourceQuotas(quota.Namespace).Get(quota.Name); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+	}
+}
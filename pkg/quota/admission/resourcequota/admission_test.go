@@ -0,0 +1,142 @@
+package resourcequota
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/admission"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+func testQuota(used int64) *kapi.ResourceQuota {
+	hard := kapi.ResourceList{quotaapi.ResourceImageStreams: resource.MustParse("10")}
+	return &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Name: "quota", Namespace: "foo", ResourceVersion: "1"},
+		Spec:       kapi.ResourceQuotaSpec{Hard: hard},
+		Status: kapi.ResourceQuotaStatus{
+			Hard: hard,
+			Used: kapi.ResourceList{quotaapi.ResourceImageStreams: *resource.NewQuantity(used, resource.DecimalSI)},
+		},
+	}
+}
+
+func newTestAdmission(quota *kapi.ResourceQuota) (admission.Interface, *ktestclient.Fake) {
+	client := &ktestclient.Fake{}
+	client.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &kapi.ResourceQuotaList{Items: []kapi.ResourceQuota{*quota}}, nil
+	})
+	client.AddReactor("get", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, quota, nil
+	})
+	return NewOriginResourceQuota(client), client
+}
+
+func imageStreamAttributes() admission.Attributes {
+	stream := &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: "stream", Namespace: "foo"}}
+	return admission.NewAttributesRecord(stream, imageapi.Kind("ImageStream"), "foo", stream.Name, imageapi.Resource("imagestreams"), "", admission.Create, nil)
+}
+
+func statusUpdates(client *ktestclient.Fake) []*kapi.ResourceQuota {
+	updates := []*kapi.ResourceQuota{}
+	for _, action := range client.Actions() {
+		if action.Matches("update", "resourcequotas") && action.GetSubresource() == "status" {
+			updates = append(updates, action.(ktestclient.UpdateAction).GetObject().(*kapi.ResourceQuota))
+		}
+	}
+	return updates
+}
+
+func TestAdmitIncrementsUsage(t *testing.T) {
+	plugin, client := newTestAdmission(testQuota(3))
+
+	if err := plugin.Admit(imageStreamAttributes()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updates := statusUpdates(client)
+	if len(updates) != 1 {
+		t.Fatalf("expected a single status update, got %#v", client.Actions())
+	}
+	if used := updates[0].Status.Used[quotaapi.ResourceImageStreams]; used.Value() != 4 {
+		t.Errorf("expected usage to be incremented to 4, got %s", used.String())
+	}
+}
+
+func TestAdmitRejectsOverQuota(t *testing.T) {
+	plugin, client := newTestAdmission(testQuota(10))
+
+	err := plugin.Admit(imageStreamAttributes())
+	if !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 0 {
+		t.Errorf("unexpected status updates: %#v", updates)
+	}
+}
+
+func TestAdmitRejectsUnknownUsage(t *testing.T) {
+	quota := testQuota(0)
+	quota.Status.Used = kapi.ResourceList{}
+	plugin, _ := newTestAdmission(quota)
+
+	if err := plugin.Admit(imageStreamAttributes()); !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestAdmitIgnoresUnlimitedResources(t *testing.T) {
+	plugin, client := newTestAdmission(testQuota(10))
+
+	for _, attributes := range []admission.Attributes{
+		admission.NewAttributesRecord(&buildapi.BuildConfig{}, buildapi.Kind("BuildConfig"), "foo", "config", buildapi.Resource("buildconfigs"), "", admission.Create, nil),
+		admission.NewAttributesRecord(&kapi.Pod{}, kapi.Kind("Pod"), "foo", "pod", kapi.Resource("pods"), "", admission.Create, nil),
+	} {
+		if err := plugin.Admit(attributes); err != nil {
+			t.Errorf("%s: unexpected error: %v", attributes.GetResource(), err)
+		}
+	}
+	if updates := statusUpdates(client); len(updates) != 0 {
+		t.Errorf("unexpected status updates: %#v", updates)
+	}
+}
+
+func TestAdmitChargesGeneratedBuilds(t *testing.T) {
+	quota := testQuota(0)
+	quota.Status.Hard = kapi.ResourceList{quotaapi.ResourceRunningBuilds: resource.MustParse("1")}
+	quota.Status.Used = kapi.ResourceList{quotaapi.ResourceRunningBuilds: resource.MustParse("1")}
+	plugin, _ := newTestAdmission(quota)
+
+	// the build generator admits the builds it creates as a create of the build
+	build := &buildapi.Build{ObjectMeta: kapi.ObjectMeta{Name: "config-1", Namespace: "foo"}}
+	attributes := admission.NewAttributesRecord(build, buildapi.Kind("Build"), "foo", build.Name, buildapi.Resource("builds"), "", admission.Create, nil)
+	if err := plugin.Admit(attributes); !kapierrors.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestAdmitRetriesOnConflict(t *testing.T) {
+	plugin, client := newTestAdmission(testQuota(3))
+	conflicted := false
+	client.PrependReactor("update", "resourcequotas", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		if conflicted {
+			return false, nil, nil
+		}
+		conflicted = true
+		return true, nil, kapierrors.NewConflict("resourcequotas", "quota", fmt.Errorf("conflict"))
+	})
+
+	if err := plugin.Admit(imageStreamAttributes()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates := statusUpdates(client); len(updates) != 2 {
+		t.Errorf("expected the status update to be retried, got %#v", client.Actions())
+	}
+}
@@ -0,0 +1,10 @@
+package api
+
+import "k8s.io/kubernetes/pkg/fields"
+
+// ClusterResourceQuotaToSelectableFields returns a label set that represents the object
+func ClusterResourceQuotaToSelectableFields(quota *ClusterResourceQuota) fields.Set {
+	return fields.Set{
+		"metadata.name": quota.Name,
+	}
+}
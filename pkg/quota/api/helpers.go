@@ -0,0 +1,45 @@
+package api
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+)
+
+// Matches returns true if the namespace labels and annotations satisfy the selector. A selector
+// without any criteria matches nothing, so that a quota is never accidentally applied to every
+// project in the cluster.
+func (s ClusterResourceQuotaSelector) Matches(namespace *kapi.Namespace) bool {
+	if len(s.LabelSelector) == 0 && len(s.AnnotationSelector) == 0 {
+		return false
+	}
+	if !labels.SelectorFromSet(labels.Set(s.LabelSelector)).Matches(labels.Set(namespace.Labels)) {
+		return false
+	}
+	for k, v := range s.AnnotationSelector {
+		if namespace.Annotations[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// GetNamespaceStatus returns the usage recorded for the namespace and whether it was found.
+func (s ClusterResourceQuotaStatus) GetNamespaceStatus(namespace string) (kapi.ResourceQuotaStatus, bool) {
+	for _, status := range s.Namespaces {
+		if status.Namespace == namespace {
+			return status.Status, true
+		}
+	}
+	return kapi.ResourceQuotaStatus{}, false
+}
+
+// SetNamespaceStatus records the usage of the namespace, replacing any previous value.
+func (s *ClusterResourceQuotaStatus) SetNamespaceStatus(namespace string, status kapi.ResourceQuotaStatus) {
+	for i := range s.Namespaces {
+		if s.Namespaces[i].Namespace == namespace {
+			s.Namespaces[i].Status = status
+			return
+		}
+	}
+	s.Namespaces = append(s.Namespaces, ResourceQuotaStatusByNamespace{Namespace: namespace, Status: status})
+}
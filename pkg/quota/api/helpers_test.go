@@ -0,0 +1,73 @@
+package api
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	namespace := &kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "foo",
+			Labels:      map[string]string{"team": "a"},
+			Annotations: map[string]string{"openshift.io/requester": "bob"},
+		},
+	}
+
+	testCases := map[string]struct {
+		selector ClusterResourceQuotaSelector
+		matches  bool
+	}{
+		"empty selector": {
+			selector: ClusterResourceQuotaSelector{},
+			matches:  false,
+		},
+		"matching labels": {
+			selector: ClusterResourceQuotaSelector{LabelSelector: map[string]string{"team": "a"}},
+			matches:  true,
+		},
+		"mismatched labels": {
+			selector: ClusterResourceQuotaSelector{LabelSelector: map[string]string{"team": "b"}},
+			matches:  false,
+		},
+		"matching annotations": {
+			selector: ClusterResourceQuotaSelector{AnnotationSelector: map[string]string{"openshift.io/requester": "bob"}},
+			matches:  true,
+		},
+		"matching labels, mismatched annotations": {
+			selector: ClusterResourceQuotaSelector{
+				LabelSelector:      map[string]string{"team": "a"},
+				AnnotationSelector: map[string]string{"openshift.io/requester": "alice"},
+			},
+			matches: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		if actual := tc.selector.Matches(namespace); actual != tc.matches {
+			t.Errorf("%s: expected %v, got %v", name, tc.matches, actual)
+		}
+	}
+}
+
+func TestSetNamespaceStatus(t *testing.T) {
+	status := &ClusterResourceQuotaStatus{}
+	status.SetNamespaceStatus("foo", kapi.ResourceQuotaStatus{Hard: kapi.ResourceList{}})
+	status.SetNamespaceStatus("bar", kapi.ResourceQuotaStatus{})
+	status.SetNamespaceStatus("foo", kapi.ResourceQuotaStatus{})
+
+	if len(status.Namespaces) != 2 {
+		t.Fatalf("expected 2 namespaces, got %#v", status.Namespaces)
+	}
+	foo, ok := status.GetNamespaceStatus("foo")
+	if !ok {
+		t.Fatalf("expected status for foo")
+	}
+	if foo.Hard != nil {
+		t.Errorf("expected status for foo to be replaced, got %#v", foo)
+	}
+	if _, ok := status.GetNamespaceStatus("baz"); ok {
+		t.Errorf("unexpected status for baz")
+	}
+}
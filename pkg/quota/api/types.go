@@ -0,0 +1,79 @@
+package api
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+const (
+	// ResourceImageStreams is the number of image streams in a namespace
+	ResourceImageStreams kapi.ResourceName = "openshift.io/imagestreams"
+	// ResourceRoutes is the number of routes in a namespace
+	ResourceRoutes kapi.ResourceName = "openshift.io/routes"
+	// ResourceBuildConfigs is the number of build configs in a namespace
+	ResourceBuildConfigs kapi.ResourceName = "openshift.io/buildconfigs"
+	// ResourceRunningBuilds is the number of builds in a namespace that have not completed
+	ResourceRunningBuilds kapi.ResourceName = "openshift.io/runningbuilds"
+)
+
+// ClusterResourceQuota mirrors ResourceQuota at a cluster scope.  This object is easily convertible to
+// synthetic ResourceQuota object to allow quota evaluation re-use.
+type ClusterResourceQuota struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec defines the desired quota
+	Spec ClusterResourceQuotaSpec
+
+	// Status defines the actual enforced quota and its current usage
+	Status ClusterResourceQuotaStatus
+}
+
+// ClusterResourceQuotaSpec defines the desired quota restrictions
+type ClusterResourceQuotaSpec struct {
+	// Selector is the selector used to match projects.
+	Selector ClusterResourceQuotaSelector
+
+	// Quota defines the desired quota
+	Quota kapi.ResourceQuotaSpec
+}
+
+// ClusterResourceQuotaSelector is used to select projects.  At least one of LabelSelector or
+// AnnotationSelector must be present.  If only one is present, it is the only selection criteria.
+// If both are specified, the project must match both restrictions.
+type ClusterResourceQuotaSelector struct {
+	// LabelSelector is used to select projects by label.
+	LabelSelector map[string]string
+
+	// AnnotationSelector is used to select projects by annotation.
+	AnnotationSelector map[string]string
+}
+
+// ClusterResourceQuotaStatus defines the actual enforced quota and its current usage
+type ClusterResourceQuotaStatus struct {
+	// Total defines the actual enforced quota and its current usage across all projects
+	Total kapi.ResourceQuotaStatus
+
+	// Namespaces slices the usage by project.  This division allows for quick resolution of
+	// deletion reconciliation inside of a single project without requiring a recalculation
+	// across all projects.  This can be used to pull the deltas for a given project.
+	Namespaces []ResourceQuotaStatusByNamespace
+}
+
+// ResourceQuotaStatusByNamespace gives status for a particular project
+type ResourceQuotaStatusByNamespace struct {
+	// Namespace the project this status applies to
+	Namespace string
+
+	// Status indicates how many resources have been consumed by this project
+	Status kapi.ResourceQuotaStatus
+}
+
+// ClusterResourceQuotaList is a collection of ClusterResourceQuotas
+type ClusterResourceQuotaList struct {
+	unversioned.TypeMeta
+	unversioned.ListMeta
+
+	// Items is a list of ClusterResourceQuotas
+	Items []ClusterResourceQuota
+}
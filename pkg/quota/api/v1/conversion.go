@@ -0,0 +1,16 @@
+package v1
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	"github.com/openshift/origin/pkg/quota/api"
+)
+
+func init() {
+	if err := kapi.Scheme.AddFieldLabelConversionFunc("v1", "ClusterResourceQuota",
+		oapi.GetFieldLabelConversionFunc(api.ClusterResourceQuotaToSelectableFields(&api.ClusterResourceQuota{}), nil),
+	); err != nil {
+		panic(err)
+	}
+}
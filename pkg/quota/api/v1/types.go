@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kapi "k8s.io/kubernetes/pkg/api/v1"
+)
+
+// ClusterResourceQuota mirrors ResourceQuota at a cluster scope.  This object is easily convertible to
+// synthetic ResourceQuota object to allow quota evaluation re-use.
+type ClusterResourceQuota struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec defines the desired quota
+	Spec ClusterResourceQuotaSpec `json:"spec" description:"desired quota and the selector for the projects it applies to"`
+
+	// Status defines the actual enforced quota and its current usage
+	Status ClusterResourceQuotaStatus `json:"status,omitempty" description:"actual enforced quota and its current usage"`
+}
+
+// ClusterResourceQuotaSpec defines the desired quota restrictions
+type ClusterResourceQuotaSpec struct {
+	// Selector is the selector used to match projects.
+	Selector ClusterResourceQuotaSelector `json:"selector" description:"selector for the projects this quota applies to"`
+
+	// Quota defines the desired quota
+	Quota kapi.ResourceQuotaSpec `json:"quota" description:"desired quota across all selected projects"`
+}
+
+// ClusterResourceQuotaSelector is used to select projects.  At least one of LabelSelector or
+// AnnotationSelector must be present.  If only one is present, it is the only selection criteria.
+// If both are specified, the project must match both restrictions.
+type ClusterResourceQuotaSelector struct {
+	// LabelSelector is used to select projects by label.
+	LabelSelector map[string]string `json:"labels,omitempty" description:"labels the selected projects must have"`
+
+	// AnnotationSelector is used to select projects by annotation.
+	AnnotationSelector map[string]string `json:"annotations,omitempty" description:"annotations the selected projects must have"`
+}
+
+// ClusterResourceQuotaStatus defines the actual enforced quota and its current usage
+type ClusterResourceQuotaStatus struct {
+	// Total defines the actual enforced quota and its current usage across all projects
+	Total kapi.ResourceQuotaStatus `json:"total" description:"enforced quota and its current usage across all selected projects"`
+
+	// Namespaces slices the usage by project.  This division allows for quick resolution of
+	// deletion reconciliation inside of a single project without requiring a recalculation
+	// across all projects.  This can be used to pull the deltas for a given project.
+	Namespaces []ResourceQuotaStatusByNamespace `json:"namespaces" description:"usage of each selected project"`
+}
+
+// ResourceQuotaStatusByNamespace gives status for a particular project
+type ResourceQuotaStatusByNamespace struct {
+	// Namespace the project this status applies to
+	Namespace string `json:"namespace" description:"project this status applies to"`
+
+	// Status indicates how many resources have been consumed by this project
+	Status kapi.ResourceQuotaStatus `json:"status" description:"resources consumed by this project"`
+}
+
+// ClusterResourceQuotaList is a collection of ClusterResourceQuotas
+type ClusterResourceQuotaList struct {
+	unversioned.TypeMeta `json:",inline"`
+	unversioned.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of ClusterResourceQuotas
+	Items []ClusterResourceQuota `json:"items" description:"list of cluster resource quotas"`
+}
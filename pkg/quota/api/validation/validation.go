@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/validation"
+	kvalidation "k8s.io/kubernetes/pkg/util/validation"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+// ValidateClusterResourceQuota tests required fields for a ClusterResourceQuota
+func ValidateClusterResourceQuota(quota *quotaapi.ClusterResourceQuota) field.ErrorList {
+	allErrs := validation.ValidateObjectMeta(&quota.ObjectMeta, false, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+
+	selectorPath := field.NewPath("spec", "selector")
+	if len(quota.Spec.Selector.LabelSelector) == 0 && len(quota.Spec.Selector.AnnotationSelector) == 0 {
+		allErrs = append(allErrs, field.Required(selectorPath))
+	}
+	allErrs = append(allErrs, validation.ValidateLabels(quota.Spec.Selector.LabelSelector, selectorPath.Child("labels"))...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(quota.Spec.Selector.AnnotationSelector, selectorPath.Child("annotations"))...)
+
+	allErrs = append(allErrs, validateResourceList(quota.Spec.Quota.Hard, field.NewPath("spec", "quota", "hard"))...)
+	allErrs = append(allErrs, validateResourceQuotaStatus(quota.Status.Total, field.NewPath("status", "total"))...)
+	for i, namespace := range quota.Status.Namespaces {
+		allErrs = append(allErrs, validateResourceQuotaStatus(namespace.Status, field.NewPath("status", "namespaces").Index(i).Child("status"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateClusterResourceQuotaUpdate tests if an update to a ClusterResourceQuota is valid. The status
+// of the quota is carried over from the old object.
+func ValidateClusterResourceQuotaUpdate(quota, oldQuota *quotaapi.ClusterResourceQuota) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&quota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+
+	quota.Status = oldQuota.Status
+	allErrs = append(allErrs, ValidateClusterResourceQuota(quota)...)
+
+	return allErrs
+}
+
+// ValidateClusterResourceQuotaStatusUpdate tests if a status update to a ClusterResourceQuota is valid.
+// The spec of the quota is carried over from the old object.
+func ValidateClusterResourceQuotaStatusUpdate(quota, oldQuota *quotaapi.ClusterResourceQuota) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&quota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+	if len(quota.ResourceVersion) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("metadata", "resourceVersion")))
+	}
+
+	quota.Spec = oldQuota.Spec
+	allErrs = append(allErrs, ValidateClusterResourceQuota(quota)...)
+
+	return allErrs
+}
+
+func validateResourceQuotaStatus(status kapi.ResourceQuotaStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateResourceList(status.Hard, fldPath.Child("hard"))...)
+	allErrs = append(allErrs, validateResourceList(status.Used, fldPath.Child("used"))...)
+	return allErrs
+}
+
+// validateResourceList ensures every resource name is qualified and every quantity is valid for it
+func validateResourceList(resources kapi.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for k, v := range resources {
+		resPath := fldPath.Key(string(k))
+		if !kvalidation.IsQualifiedName(string(k)) {
+			allErrs = append(allErrs, field.Invalid(resPath, string(k), "must be a qualified resource name"))
+			continue
+		}
+		if !strings.Contains(string(k), "/") && !kapi.IsStandardResourceName(string(k)) {
+			allErrs = append(allErrs, field.Invalid(resPath, string(k), "is neither a standard resource type nor is fully qualified"))
+			continue
+		}
+		allErrs = append(allErrs, validation.ValidatePositiveQuantity(v, resPath)...)
+		if kapi.IsIntegerResourceName(string(k)) && v.MilliValue()%int64(1000) != int64(0) {
+			allErrs = append(allErrs, field.Invalid(resPath, v.String(), "must be an integer"))
+		}
+	}
+	return allErrs
+}
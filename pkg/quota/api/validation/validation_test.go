@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+)
+
+func validQuota() *quotaapi.ClusterResourceQuota {
+	return &quotaapi.ClusterResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Name: "good"},
+		Spec: quotaapi.ClusterResourceQuotaSpec{
+			Selector: quotaapi.ClusterResourceQuotaSelector{
+				AnnotationSelector: map[string]string{"openshift.io/requester": "bob"},
+			},
+			Quota: kapi.ResourceQuotaSpec{
+				Hard: kapi.ResourceList{
+					kapi.ResourcePods:   resource.MustParse("10"),
+					kapi.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+}
+
+func TestValidateClusterResourceQuota(t *testing.T) {
+	testCases := map[string]struct {
+		modify  func(*quotaapi.ClusterResourceQuota)
+		numErrs int
+	}{
+		"valid": {
+			modify:  func(*quotaapi.ClusterResourceQuota) {},
+			numErrs: 0,
+		},
+		"missing name": {
+			modify:  func(q *quotaapi.ClusterResourceQuota) { q.Name = "" },
+			numErrs: 1,
+		},
+		"missing selector": {
+			modify:  func(q *quotaapi.ClusterResourceQuota) { q.Spec.Selector = quotaapi.ClusterResourceQuotaSelector{} },
+			numErrs: 1,
+		},
+		"invalid label selector": {
+			modify: func(q *quotaapi.ClusterResourceQuota) {
+				q.Spec.Selector.LabelSelector = map[string]string{"bad key": "value"}
+			},
+			numErrs: 1,
+		},
+		"unknown resource": {
+			modify: func(q *quotaapi.ClusterResourceQuota) {
+				q.Spec.Quota.Hard["unknown"] = resource.MustParse("1")
+			},
+			numErrs: 1,
+		},
+		"negative quantity": {
+			modify: func(q *quotaapi.ClusterResourceQuota) {
+				q.Spec.Quota.Hard[kapi.ResourceCPU] = resource.MustParse("-1")
+			},
+			numErrs: 1,
+		},
+		"fractional count": {
+			modify: func(q *quotaapi.ClusterResourceQuota) {
+				q.Spec.Quota.Hard[kapi.ResourcePods] = resource.MustParse("500m")
+			},
+			numErrs: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		quota := validQuota()
+		tc.modify(quota)
+		if errs := ValidateClusterResourceQuota(quota); len(errs) != tc.numErrs {
+			t.Errorf("%s: expected %d errors, got %d: %v", name, tc.numErrs, len(errs), errs)
+		}
+	}
+}
+
+func TestValidateClusterResourceQuotaUpdate(t *testing.T) {
+	oldQuota := validQuota()
+	oldQuota.ResourceVersion = "1"
+	oldQuota.Status.Total.Used = kapi.ResourceList{kapi.ResourcePods: resource.MustParse("2")}
+
+	quota := validQuota()
+	quota.ResourceVersion = "1"
+	quota.Spec.Quota.Hard[kapi.ResourcePods] = resource.MustParse("20")
+	quota.Status.Total.Used = kapi.ResourceList{kapi.ResourcePods: resource.MustParse("5")}
+
+	if errs := ValidateClusterResourceQuotaUpdate(quota, oldQuota); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if used := quota.Status.Total.Used[kapi.ResourcePods]; used.Value() != 2 {
+		t.Errorf("expected status to be carried over from the old quota, got %s", used.String())
+	}
+}
+
+func TestValidateClusterResourceQuotaStatusUpdate(t *testing.T) {
+	oldQuota := validQuota()
+	oldQuota.ResourceVersion = "1"
+
+	quota := validQuota()
+	quota.ResourceVersion = "1"
+	quota.Spec.Quota.Hard[kapi.ResourcePods] = resource.MustParse("20")
+	quota.Status.Total.Used = kapi.ResourceList{kapi.ResourcePods: resource.MustParse("5")}
+
+	if errs := ValidateClusterResourceQuotaStatusUpdate(quota, oldQuota); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if hard := quota.Spec.Quota.Hard[kapi.ResourcePods]; hard.Value() != 10 {
+		t.Errorf("expected spec to be carried over from the old quota, got %s", hard.String())
+	}
+
+	quota.ResourceVersion = ""
+	if errs := ValidateClusterResourceQuotaStatusUpdate(quota, oldQuota); len(errs) == 0 {
+		t.Errorf("expected an error for a missing resourceVersion")
+	}
+}
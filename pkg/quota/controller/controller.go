@@ -0,0 +1,107 @@
+package controller
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	"github.com/openshift/origin/pkg/quota/evaluator"
+)
+
+// ClusterQuotaReconciliationController observes the usage of every namespace selected by a
+// ClusterResourceQuota and records the per namespace and total usage in its status.
+// Use the ClusterQuotaReconciliationControllerFactory to create this controller.
+type ClusterQuotaReconciliationController struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Handle recalculates the usage of the quota and updates its status if it changed.
+func (c *ClusterQuotaReconciliationController) Handle(quota *quotaapi.ClusterResourceQuota) error {
+	namespaces, err := c.KubeClient.Namespaces().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	hard := quota.Spec.Quota.Hard
+	status := quotaapi.ClusterResourceQuotaStatus{
+		Total: kapi.ResourceQuotaStatus{
+			Hard: copyResourceList(hard),
+			Used: zeroUsage(hard),
+		},
+	}
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		if !quota.Spec.Selector.Matches(namespace) {
+			continue
+		}
+		used, err := NamespaceUsage(c.KubeClient, namespace.Name, hard)
+		if err != nil {
+			return err
+		}
+		status.SetNamespaceStatus(namespace.Name, kapi.ResourceQuotaStatus{
+			Hard: copyResourceList(hard),
+			Used: used,
+		})
+		addUsage(status.Total.Used, used)
+	}
+
+	if kapi.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	updated := *quota
+	updated.Status = status
+	_, err = c.Client.ClusterResourceQuotas().UpdateStatus(&updated)
+	return err
+}
+
+func copyResourceList(list kapi.ResourceList) kapi.ResourceList {
+	out := kapi.ResourceList{}
+	for k, v := range list {
+		out[k] = *v.Copy()
+	}
+	return out
+}
+
+// ResourceQuotaController observes the usage of the OpenShift resources limited by a ResourceQuota
+// and records it in the quota status. The usage of Kubernetes resources is left to the Kubernetes
+// resource quota controller. Use the ResourceQuotaControllerFactory to create this controller.
+type ResourceQuotaController struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Handle recalculates the usage of the OpenShift resources in the quota and updates its status if it changed.
+func (c *ResourceQuotaController) Handle(quota *kapi.ResourceQuota) error {
+	hard := quota.Spec.Hard
+	used, err := evaluator.NamespaceUsage(c.Client, quota.Namespace, hard)
+	if err != nil {
+		return err
+	}
+	if len(used) == 0 {
+		return nil
+	}
+
+	status := kapi.ResourceQuotaStatus{
+		Hard: copyResourceList(hard),
+		Used: copyResourceList(quota.Status.Used),
+	}
+	for k, v := range used {
+		status.Used[k] = v
+	}
+
+	if kapi.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	updated := *quota
+	updated.Status = status
+	_, err = c.KubeClient.ResourceQuotas(quota.Namespace).UpdateStatus(&updated)
+	return err
+}
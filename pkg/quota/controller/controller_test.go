@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func namespace(name, requester string) kapi.Namespace {
+	return kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{"openshift.io/requester": requester},
+		},
+	}
+}
+
+func pod(namespace, name string, phase kapi.PodPhase) kapi.Pod {
+	return kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       kapi.PodSpec{RestartPolicy: kapi.RestartPolicyNever},
+		Status:     kapi.PodStatus{Phase: phase},
+	}
+}
+
+// fakeKubeClient returns a client that serves the namespaces and the pods of the namespace being listed.
+func fakeKubeClient(namespaces []kapi.Namespace, pods []kapi.Pod) *ktestclient.Fake {
+	client := &ktestclient.Fake{}
+	client.AddReactor("list", "namespaces", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &kapi.NamespaceList{Items: namespaces}, nil
+	})
+	client.AddReactor("list", "pods", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		list := &kapi.PodList{}
+		for _, pod := range pods {
+			if pod.Namespace == action.GetNamespace() {
+				list.Items = append(list.Items, pod)
+			}
+		}
+		return true, list, nil
+	})
+	return client
+}
+
+func bobsQuota() *quotaapi.ClusterResourceQuota {
+	return &quotaapi.ClusterResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Name: "bob", ResourceVersion: "1"},
+		Spec: quotaapi.ClusterResourceQuotaSpec{
+			Selector: quotaapi.ClusterResourceQuotaSelector{
+				AnnotationSelector: map[string]string{"openshift.io/requester": "bob"},
+			},
+			Quota: kapi.ResourceQuotaSpec{
+				Hard: kapi.ResourceList{kapi.ResourcePods: resource.MustParse("10")},
+			},
+		},
+	}
+}
+
+func TestHandleRecordsUsageOfSelectedNamespaces(t *testing.T) {
+	kubeClient := fakeKubeClient(
+		[]kapi.Namespace{namespace("foo", "bob"), namespace("bar", "bob"), namespace("baz", "alice")},
+		[]kapi.Pod{
+			pod("foo", "a", kapi.PodRunning),
+			pod("foo", "b", kapi.PodPending),
+			pod("bar", "a", kapi.PodRunning),
+			pod("bar", "b", kapi.PodSucceeded),
+			pod("baz", "a", kapi.PodRunning),
+		},
+	)
+	originClient := &testclient.Fake{}
+	controller := ClusterQuotaReconciliationController{Client: originClient, KubeClient: kubeClient}
+
+	if err := controller.Handle(bobsQuota()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := originClient.Actions()
+	if len(actions) != 1 || !actions[0].Matches("update", "clusterresourcequotas") || actions[0].GetSubresource() != "status" {
+		t.Fatalf("expected a status update, got %#v", actions)
+	}
+	updated := actions[0].(ktestclient.UpdateAction).GetObject().(*quotaapi.ClusterResourceQuota)
+
+	expected := map[string]int64{"foo": 2, "bar": 1}
+	if len(updated.Status.Namespaces) != len(expected) {
+		t.Fatalf("expected %d namespaces, got %#v", len(expected), updated.Status.Namespaces)
+	}
+	for name, count := range expected {
+		status, ok := updated.Status.GetNamespaceStatus(name)
+		if !ok {
+			t.Errorf("missing status for namespace %s", name)
+			continue
+		}
+		if used := status.Used[kapi.ResourcePods]; used.Value() != count {
+			t.Errorf("expected %d pods in namespace %s, got %s", count, name, used.String())
+		}
+	}
+	if used := updated.Status.Total.Used[kapi.ResourcePods]; used.Value() != 3 {
+		t.Errorf("expected 3 pods in total, got %s", used.String())
+	}
+	if hard := updated.Status.Total.Hard[kapi.ResourcePods]; hard.Value() != 10 {
+		t.Errorf("expected a hard limit of 10 pods, got %s", hard.String())
+	}
+}
+
+func TestHandleWithoutSelectedNamespaces(t *testing.T) {
+	kubeClient := fakeKubeClient([]kapi.Namespace{namespace("baz", "alice")}, []kapi.Pod{pod("baz", "a", kapi.PodRunning)})
+	originClient := &testclient.Fake{}
+	controller := ClusterQuotaReconciliationController{Client: originClient, KubeClient: kubeClient}
+
+	if err := controller.Handle(bobsQuota()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := originClient.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("expected a status update, got %#v", actions)
+	}
+	updated := actions[0].(ktestclient.UpdateAction).GetObject().(*quotaapi.ClusterResourceQuota)
+	used, ok := updated.Status.Total.Used[kapi.ResourcePods]
+	if !ok || used.Value() != 0 {
+		t.Errorf("expected a known usage of 0 pods, got %#v", updated.Status.Total.Used)
+	}
+}
+
+func TestHandleSkipsUnchangedStatus(t *testing.T) {
+	kubeClient := fakeKubeClient([]kapi.Namespace{namespace("foo", "bob")}, []kapi.Pod{pod("foo", "a", kapi.PodRunning)})
+	originClient := &testclient.Fake{}
+	controller := ClusterQuotaReconciliationController{Client: originClient, KubeClient: kubeClient}
+
+	quota := bobsQuota()
+	if err := controller.Handle(quota); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := originClient.Actions()[0].(ktestclient.UpdateAction).GetObject().(*quotaapi.ClusterResourceQuota)
+
+	originClient.ClearActions()
+	if err := controller.Handle(updated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := originClient.Actions(); len(actions) != 0 {
+		t.Errorf("expected no update for an unchanged status, got %#v", actions)
+	}
+}
+
+func TestResourceQuotaHandleRecordsOriginUsage(t *testing.T) {
+	kubeClient := &ktestclient.Fake{}
+	originClient := &testclient.Fake{}
+	originClient.AddReactor("list", "routes", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &routeapi.RouteList{Items: make([]routeapi.Route, 2)}, nil
+	})
+	controller := ResourceQuotaController{Client: originClient, KubeClient: kubeClient}
+
+	quota := &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "quota"},
+		Spec: kapi.ResourceQuotaSpec{
+			Hard: kapi.ResourceList{
+				quotaapi.ResourceRoutes: resource.MustParse("10"),
+				kapi.ResourcePods:       resource.MustParse("10"),
+			},
+		},
+		Status: kapi.ResourceQuotaStatus{
+			Used: kapi.ResourceList{kapi.ResourcePods: resource.MustParse("3")},
+		},
+	}
+	if err := controller.Handle(quota); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions := kubeClient.Actions()
+	if len(actions) != 1 || !actions[0].Matches("update", "resourcequotas") || actions[0].GetSubresource() != "status" {
+		t.Fatalf("expected a status update, got %#v", actions)
+	}
+	updated := actions[0].(ktestclient.UpdateAction).GetObject().(*kapi.ResourceQuota)
+	if used := updated.Status.Used[quotaapi.ResourceRoutes]; used.Value() != 2 {
+		t.Errorf("expected 2 routes, got %s", used.String())
+	}
+	if used := updated.Status.Used[kapi.ResourcePods]; used.Value() != 3 {
+		t.Errorf("expected the usage of pods to be kept, got %s", used.String())
+	}
+	if hard := updated.Status.Hard[quotaapi.ResourceRoutes]; hard.Value() != 10 {
+		t.Errorf("expected a hard limit of 10 routes, got %s", hard.String())
+	}
+
+	kubeClient.ClearActions()
+	if err := controller.Handle(updated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := kubeClient.Actions(); len(actions) != 0 {
+		t.Errorf("expected no update for an unchanged status, got %#v", actions)
+	}
+}
+
+func TestResourceQuotaHandleIgnoresKubernetesQuotas(t *testing.T) {
+	kubeClient := &ktestclient.Fake{}
+	originClient := &testclient.Fake{}
+	controller := ResourceQuotaController{Client: originClient, KubeClient: kubeClient}
+
+	quota := &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "quota"},
+		Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{kapi.ResourcePods: resource.MustParse("10")}},
+	}
+	if err := controller.Handle(quota); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := append(kubeClient.Actions(), originClient.Actions()...); len(actions) != 0 {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
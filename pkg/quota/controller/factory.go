@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	osclient "github.com/openshift/origin/pkg/client"
+	controller "github.com/openshift/origin/pkg/controller"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// ClusterQuotaReconciliationControllerFactory creates a ClusterQuotaReconciliationController.
+type ClusterQuotaReconciliationControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+	// ResyncPeriod is how often every quota is recalculated, which picks up changes to the
+	// selected namespaces and to the content they hold.
+	ResyncPeriod time.Duration
+}
+
+// Create creates a ClusterQuotaReconciliationController.
+func (factory *ClusterQuotaReconciliationControllerFactory) Create() controller.RunnableController {
+	quotaLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.ClusterResourceQuotas().List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.ClusterResourceQuotas().Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(quotaLW, &quotaapi.ClusterResourceQuota{}, queue, factory.ResyncPeriod).Run()
+
+	quotaController := &ClusterQuotaReconciliationController{
+		Client:     factory.Client,
+		KubeClient: factory.KubeClient,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				return retries.Count < 1
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			quota := obj.(*quotaapi.ClusterResourceQuota)
+			return quotaController.Handle(quota)
+		},
+	}
+}
+
+// ResourceQuotaControllerFactory creates a ResourceQuotaController.
+type ResourceQuotaControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+	// ResyncPeriod is how often every quota is recalculated. Deleted objects, completed builds
+	// and changed image streams are observed as they happen.
+	ResyncPeriod time.Duration
+}
+
+// Create creates a ResourceQuotaController.
+func (factory *ResourceQuotaControllerFactory) Create() controller.RunnableController {
+	quotaLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.ResourceQuotas(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.ResourceQuotas(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(quotaLW, &kapi.ResourceQuota{}, queue, factory.ResyncPeriod).Run()
+
+	// admission increments the usage, but only the controller releases it, so the objects that are
+	// counted are watched to recalculate the quotas of their namespace as soon as one is deleted, a
+	// build completes or the images of a stream change, rather than on the next resync
+	quotaIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{"namespace": cache.MetaNamespaceIndexFunc})
+	cache.NewReflector(quotaLW, &kapi.ResourceQuota{}, quotaIndexer, 0).Run()
+	enqueueQuotas := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		quotas, err := quotaIndexer.Index("namespace", obj)
+		if err != nil {
+			kutil.HandleError(err)
+			return
+		}
+		for _, quota := range quotas {
+			queue.AddIfNotPresent(quota)
+		}
+	}
+	released := framework.ResourceEventHandlerFuncs{DeleteFunc: enqueueQuotas}
+	runInformer(&cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.ImageStreams(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.ImageStreams(kapi.NamespaceAll).Watch(options)
+		},
+	}, &imageapi.ImageStream{}, framework.ResourceEventHandlerFuncs{
+		// images pushed to a stream change the image storage used by its namespace
+		UpdateFunc: func(old, cur interface{}) {
+			if !kapi.Semantic.DeepEqual(old.(*imageapi.ImageStream).Status.Tags, cur.(*imageapi.ImageStream).Status.Tags) {
+				enqueueQuotas(cur)
+			}
+		},
+		DeleteFunc: enqueueQuotas,
+	})
+	runInformer(&cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.Routes(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.Routes(kapi.NamespaceAll).Watch(options)
+		},
+	}, &routeapi.Route{}, released)
+	runInformer(&cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.BuildConfigs(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.BuildConfigs(kapi.NamespaceAll).Watch(options)
+		},
+	}, &buildapi.BuildConfig{}, released)
+	runInformer(&cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.Builds(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.Builds(kapi.NamespaceAll).Watch(options)
+		},
+	}, &buildapi.Build{}, framework.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) {
+			if !buildutil.IsBuildComplete(old.(*buildapi.Build)) && buildutil.IsBuildComplete(cur.(*buildapi.Build)) {
+				enqueueQuotas(cur)
+			}
+		},
+		DeleteFunc: enqueueQuotas,
+	})
+
+	quotaController := &ResourceQuotaController{
+		Client:     factory.Client,
+		KubeClient: factory.KubeClient,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				return retries.Count < 1
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			quota := obj.(*kapi.ResourceQuota)
+			return quotaController.Handle(quota)
+		},
+	}
+}
+
+// runInformer starts an informer that passes the changes to the objects to the handler.
+func runInformer(lw cache.ListerWatcher, objType runtime.Object, handler framework.ResourceEventHandler) {
+	_, informer := framework.NewInformer(lw, objType, 0, handler)
+	go informer.Run(kutil.NeverStop)
+}
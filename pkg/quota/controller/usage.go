@@ -0,0 +1,118 @@
+package controller
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	resourcequotacontroller "k8s.io/kubernetes/pkg/controller/resourcequota"
+)
+
+// trackedResources are the resources whose usage is observed by this controller. Any other
+// resource in a quota is assumed to be tracked elsewhere and is left untouched.
+var trackedResources = map[kapi.ResourceName]bool{
+	kapi.ResourcePods:                   true,
+	kapi.ResourceServices:               true,
+	kapi.ResourceReplicationControllers: true,
+	kapi.ResourceQuotas:                 true,
+	kapi.ResourceSecrets:                true,
+	kapi.ResourcePersistentVolumeClaims: true,
+	kapi.ResourceMemory:                 true,
+	kapi.ResourceCPU:                    true,
+}
+
+// NamespaceUsage observes the usage in the namespace of every tracked resource in hard.
+func NamespaceUsage(client kclient.Interface, namespace string, hard kapi.ResourceList) (kapi.ResourceList, error) {
+	used := kapi.ResourceList{}
+
+	var filteredPods []*kapi.Pod
+	if hasAny(hard, kapi.ResourcePods, kapi.ResourceMemory, kapi.ResourceCPU) {
+		pods, err := client.Pods(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		filteredPods = resourcequotacontroller.FilterQuotaPods(pods.Items)
+	}
+
+	for k := range hard {
+		var value *resource.Quantity
+
+		switch k {
+		case kapi.ResourcePods:
+			value = resource.NewQuantity(int64(len(filteredPods)), resource.DecimalSI)
+		case kapi.ResourceServices:
+			items, err := client.Services(namespace).List(kapi.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			value = resource.NewQuantity(int64(len(items.Items)), resource.DecimalSI)
+		case kapi.ResourceReplicationControllers:
+			items, err := client.ReplicationControllers(namespace).List(kapi.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			value = resource.NewQuantity(int64(len(items.Items)), resource.DecimalSI)
+		case kapi.ResourceQuotas:
+			items, err := client.ResourceQuotas(namespace).List(kapi.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			value = resource.NewQuantity(int64(len(items.Items)), resource.DecimalSI)
+		case kapi.ResourceSecrets:
+			items, err := client.Secrets(namespace).List(kapi.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			value = resource.NewQuantity(int64(len(items.Items)), resource.DecimalSI)
+		case kapi.ResourcePersistentVolumeClaims:
+			items, err := client.PersistentVolumeClaims(namespace).List(kapi.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			value = resource.NewQuantity(int64(len(items.Items)), resource.DecimalSI)
+		case kapi.ResourceMemory:
+			value = resourcequotacontroller.PodsRequests(filteredPods, kapi.ResourceMemory)
+		case kapi.ResourceCPU:
+			value = resourcequotacontroller.PodsRequests(filteredPods, kapi.ResourceCPU)
+		}
+
+		if value != nil {
+			used[k] = *value
+		}
+	}
+
+	return used, nil
+}
+
+// zeroUsage returns an empty usage for every tracked resource in hard, so that a quota matching no
+// namespaces still reports a known usage.
+func zeroUsage(hard kapi.ResourceList) kapi.ResourceList {
+	used := kapi.ResourceList{}
+	for k := range hard {
+		if trackedResources[k] {
+			used[k] = resource.MustParse("0")
+		}
+	}
+	return used
+}
+
+// addUsage adds every value of delta to total.
+func addUsage(total, delta kapi.ResourceList) {
+	for k, v := range delta {
+		sum, ok := total[k]
+		if !ok {
+			total[k] = *v.Copy()
+			continue
+		}
+		sum.Add(v)
+		total[k] = sum
+	}
+}
+
+func hasAny(list kapi.ResourceList, names ...kapi.ResourceName) bool {
+	for _, name := range names {
+		if _, ok := list[name]; ok {
+			return true
+		}
+	}
+	return false
+}
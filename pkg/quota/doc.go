@@ -0,0 +1,3 @@
+// Package quota contains the OpenShift ClusterResourceQuota API and the components that
+// track and enforce quota across multiple projects.
+package quota
@@ -0,0 +1,144 @@
+package evaluator
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	osclient "github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// countFunc returns the number of objects in the namespace that are charged against a quota
+type countFunc func(client osclient.Interface, namespace string) (int64, error)
+
+// counters observe the usage of the OpenShift resources that can be limited by a ResourceQuota
+var counters = map[kapi.ResourceName]countFunc{
+	quotaapi.ResourceImageStreams: func(client osclient.Interface, namespace string) (int64, error) {
+		items, err := client.ImageStreams(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(items.Items)), nil
+	},
+	quotaapi.ResourceRoutes: func(client osclient.Interface, namespace string) (int64, error) {
+		items, err := client.Routes(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(items.Items)), nil
+	},
+	quotaapi.ResourceBuildConfigs: func(client osclient.Interface, namespace string) (int64, error) {
+		items, err := client.BuildConfigs(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return int64(len(items.Items)), nil
+	},
+	quotaapi.ResourceRunningBuilds: func(client osclient.Interface, namespace string) (int64, error) {
+		items, err := client.Builds(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		running := int64(0)
+		for i := range items.Items {
+			if !buildutil.IsBuildComplete(&items.Items[i]) {
+				running++
+			}
+		}
+		return running, nil
+	},
+	imageapi.ResourceImageStorage: func(client osclient.Interface, namespace string) (int64, error) {
+		streams, err := client.ImageStreams(namespace).List(kapi.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return ImageStorageUsage(streams.Items, func(name string) (int64, error) {
+			image, err := client.Images().Get(name)
+			if kapierrors.IsNotFound(err) {
+				// the image was pruned and its layers will be deleted
+				return 0, nil
+			}
+			if err != nil {
+				return 0, err
+			}
+			return ImageStorage(image), nil
+		})
+	},
+}
+
+// ImageStorage returns the storage used by the image in the integrated registry. Images that were not
+// pushed to the integrated registry use no storage.
+func ImageStorage(image *imageapi.Image) int64 {
+	if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] != "true" {
+		return 0
+	}
+	return image.DockerImageMetadata.Size
+}
+
+// ImageStorageUsage returns the total size of the distinct images referenced by the status of
+// streams, as reported by sizeOf.
+func ImageStorageUsage(streams []imageapi.ImageStream, sizeOf func(name string) (int64, error)) (int64, error) {
+	seen := map[string]bool{}
+	var used int64
+	for _, stream := range streams {
+		for _, history := range stream.Status.Tags {
+			for _, event := range history.Items {
+				if len(event.Image) == 0 || seen[event.Image] {
+					continue
+				}
+				seen[event.Image] = true
+				size, err := sizeOf(event.Image)
+				if err != nil {
+					return 0, err
+				}
+				used += size
+			}
+		}
+	}
+	return used, nil
+}
+
+// creatingResources maps the requests that create an object charged against a quota to the charged resource.
+// Builds instantiated from a build config, by a request, a trigger or a webhook, or cloned from a build are
+// admitted by the build generator as a create of the build itself, so those subresources are not charged.
+var creatingResources = map[unversioned.GroupResource]map[string]kapi.ResourceName{
+	imageapi.Resource("imagestreams"): {"": quotaapi.ResourceImageStreams},
+	routeapi.Resource("routes"):       {"": quotaapi.ResourceRoutes},
+	buildapi.Resource("buildconfigs"): {"": quotaapi.ResourceBuildConfigs},
+	buildapi.Resource("builds"):       {"": quotaapi.ResourceRunningBuilds},
+}
+
+// ChargedResource returns the quota resource charged when a create request is made for the resource and
+// subresource, and false if the request is not charged against any quota.
+func ChargedResource(groupResource unversioned.GroupResource, subresource string) (kapi.ResourceName, bool) {
+	name, ok := creatingResources[groupResource][subresource]
+	return name, ok
+}
+
+// NamespaceUsage observes the usage in the namespace of every OpenShift resource in hard. Other resources are
+// assumed to be tracked elsewhere and are not included.
+func NamespaceUsage(client osclient.Interface, namespace string, hard kapi.ResourceList) (kapi.ResourceList, error) {
+	used := kapi.ResourceList{}
+	for name := range hard {
+		count, ok := counters[name]
+		if !ok {
+			continue
+		}
+		value, err := count(client, namespace)
+		if err != nil {
+			return nil, err
+		}
+		format := resource.DecimalSI
+		if name == imageapi.ResourceImageStorage {
+			format = resource.BinarySI
+		}
+		used[name] = *resource.NewQuantity(value, format)
+	}
+	return used, nil
+}
@@ -0,0 +1,144 @@
+package evaluator
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func build(phase buildapi.BuildPhase) buildapi.Build {
+	return buildapi.Build{Status: buildapi.BuildStatus{Phase: phase}}
+}
+
+func TestNamespaceUsage(t *testing.T) {
+	client := &testclient.Fake{}
+	client.AddReactor("list", "imagestreams", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &imageapi.ImageStreamList{Items: make([]imageapi.ImageStream, 2)}, nil
+	})
+	client.AddReactor("list", "routes", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &routeapi.RouteList{Items: make([]routeapi.Route, 1)}, nil
+	})
+	client.AddReactor("list", "builds", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &buildapi.BuildList{Items: []buildapi.Build{
+			build(buildapi.BuildPhaseNew),
+			build(buildapi.BuildPhasePending),
+			build(buildapi.BuildPhaseRunning),
+			build(buildapi.BuildPhaseComplete),
+			build(buildapi.BuildPhaseFailed),
+		}}, nil
+	})
+
+	hard := kapi.ResourceList{
+		quotaapi.ResourceImageStreams:  resource.MustParse("10"),
+		quotaapi.ResourceRoutes:        resource.MustParse("10"),
+		quotaapi.ResourceRunningBuilds: resource.MustParse("10"),
+		kapi.ResourcePods:              resource.MustParse("10"),
+	}
+	used, err := NamespaceUsage(client, "foo", hard)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[kapi.ResourceName]int64{
+		quotaapi.ResourceImageStreams:  2,
+		quotaapi.ResourceRoutes:        1,
+		quotaapi.ResourceRunningBuilds: 3,
+	}
+	if len(used) != len(expected) {
+		t.Errorf("expected usage of %d resources, got %#v", len(expected), used)
+	}
+	for name, value := range expected {
+		if quantity, ok := used[name]; !ok || quantity.Value() != value {
+			t.Errorf("%s: expected usage %d, got %s", name, value, quantity.String())
+		}
+	}
+	for _, action := range client.Actions() {
+		if action.GetNamespace() != "foo" {
+			t.Errorf("unexpected action outside of the namespace: %#v", action)
+		}
+		if action.GetResource() == "buildconfigs" {
+			t.Errorf("unexpected list of a resource without a quota: %#v", action)
+		}
+	}
+}
+
+func TestImageStorageUsage(t *testing.T) {
+	images := map[string]*imageapi.Image{
+		"sha256:pushed-1": {
+			ObjectMeta:          kapi.ObjectMeta{Name: "sha256:pushed-1", Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"}},
+			DockerImageMetadata: imageapi.DockerImage{Size: 400},
+		},
+		"sha256:pushed-2": {
+			ObjectMeta:          kapi.ObjectMeta{Name: "sha256:pushed-2", Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"}},
+			DockerImageMetadata: imageapi.DockerImage{Size: 300},
+		},
+		"sha256:imported": {
+			ObjectMeta:          kapi.ObjectMeta{Name: "sha256:imported"},
+			DockerImageMetadata: imageapi.DockerImage{Size: 10000},
+		},
+	}
+	stream := func(images ...string) imageapi.ImageStream {
+		events := []imageapi.TagEvent{}
+		for _, image := range images {
+			events = append(events, imageapi.TagEvent{Image: image})
+		}
+		return imageapi.ImageStream{Status: imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{"latest": {Items: events}}}}
+	}
+	client := &testclient.Fake{}
+	client.AddReactor("list", "imagestreams", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		return true, &imageapi.ImageStreamList{Items: []imageapi.ImageStream{
+			stream("sha256:pushed-1", "sha256:imported"),
+			stream("sha256:pushed-1", "sha256:pushed-2", "sha256:pruned"),
+		}}, nil
+	})
+	client.AddReactor("get", "images", func(action ktestclient.Action) (bool, runtime.Object, error) {
+		name := action.(ktestclient.GetAction).GetName()
+		if image, ok := images[name]; ok {
+			return true, image, nil
+		}
+		return true, nil, kapierrors.NewNotFound("Image", name)
+	})
+
+	used, err := NamespaceUsage(client, "foo", kapi.ResourceList{imageapi.ResourceImageStorage: resource.MustParse("1Gi")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quantity := used[imageapi.ResourceImageStorage]; quantity.Value() != 700 {
+		t.Errorf("expected the distinct pushed images to use 700 bytes, got %s", quantity.String())
+	}
+}
+
+func TestChargedResource(t *testing.T) {
+	tests := []struct {
+		resource    string
+		subresource string
+		expected    kapi.ResourceName
+	}{
+		{resource: "imagestreams", expected: quotaapi.ResourceImageStreams},
+		{resource: "routes", expected: quotaapi.ResourceRoutes},
+		{resource: "buildconfigs", expected: quotaapi.ResourceBuildConfigs},
+		{resource: "buildconfigs", subresource: "instantiate"},
+		{resource: "buildconfigs", subresource: "instantiatebinary"},
+		{resource: "builds", expected: quotaapi.ResourceRunningBuilds},
+		{resource: "builds", subresource: "clone"},
+		{resource: "builds", subresource: "log"},
+		{resource: "imagestreamimports"},
+		{resource: "pods"},
+	}
+	for _, test := range tests {
+		name, ok := ChargedResource(kapi.Resource(test.resource), test.subresource)
+		if ok != (len(test.expected) > 0) || name != test.expected {
+			t.Errorf("%s/%s: expected %q, got %q", test.resource, test.subresource, test.expected, name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package etcd
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	etcdgeneric "k8s.io/kubernetes/pkg/registry/generic/etcd"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/storage"
+
+	"github.com/openshift/origin/pkg/quota/api"
+	"github.com/openshift/origin/pkg/quota/registry/clusterresourcequota"
+)
+
+// REST implements a RESTStorage for cluster resource quotas against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+const etcdPrefix = "/clusterresourcequotas"
+
+// NewREST returns a RESTStorage object that will work against cluster resource quotas and their status.
+func NewREST(s storage.Interface) (*REST, *StatusREST) {
+	store := etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.ClusterResourceQuota{} },
+		NewListFunc: func() runtime.Object { return &api.ClusterResourceQuotaList{} },
+		KeyRootFunc: func(ctx kapi.Context) string {
+			return etcdPrefix
+		},
+		KeyFunc: func(ctx kapi.Context, name string) (string, error) {
+			return etcdgeneric.NoNamespaceKeyFunc(ctx, etcdPrefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ClusterResourceQuota).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return clusterresourcequota.Matcher(label, field)
+		},
+		EndpointName: "clusterresourcequotas",
+
+		Storage: s,
+	}
+
+	statusStore := store
+	statusStore.UpdateStrategy = clusterresourcequota.StatusStrategy
+
+	store.CreateStrategy = clusterresourcequota.Strategy
+	store.UpdateStrategy = clusterresourcequota.Strategy
+
+	return &REST{&store}, &StatusREST{store: &statusStore}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a cluster resource quota.
+type StatusREST struct {
+	store *etcdgeneric.Etcd
+}
+
+// New returns a new ClusterResourceQuota
+func (r *StatusREST) New() runtime.Object {
+	return &api.ClusterResourceQuota{}
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
+}
@@ -0,0 +1,101 @@
+package clusterresourcequota
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/registry/generic"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/openshift/origin/pkg/quota/api"
+	"github.com/openshift/origin/pkg/quota/api/validation"
+)
+
+// strategy implements behavior for ClusterResourceQuotas
+type strategy struct {
+	runtime.ObjectTyper
+}
+
+// Strategy is the default logic that applies when creating and updating ClusterResourceQuota
+// objects via the REST API.
+var Strategy = strategy{kapi.Scheme}
+
+// NamespaceScoped is false for cluster resource quotas
+func (strategy) NamespaceScoped() bool {
+	return false
+}
+
+func (strategy) GenerateName(base string) string {
+	return base
+}
+
+// AllowCreateOnUpdate is false for cluster resource quotas
+func (strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// PrepareForCreate clears the status of a quota before creation
+func (strategy) PrepareForCreate(obj runtime.Object) {
+	quota := obj.(*api.ClusterResourceQuota)
+	quota.Status = api.ClusterResourceQuotaStatus{}
+}
+
+// PrepareForUpdate preserves the status of the quota, which may only be changed through the
+// status subresource
+func (strategy) PrepareForUpdate(obj, old runtime.Object) {
+	newQuota := obj.(*api.ClusterResourceQuota)
+	oldQuota := old.(*api.ClusterResourceQuota)
+	newQuota.Status = oldQuota.Status
+}
+
+// Canonicalize normalizes the object after validation.
+func (strategy) Canonicalize(obj runtime.Object) {
+}
+
+// Validate validates a new quota
+func (strategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	return validation.ValidateClusterResourceQuota(obj.(*api.ClusterResourceQuota))
+}
+
+// ValidateUpdate is the default update validation for a quota
+func (strategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateClusterResourceQuotaUpdate(obj.(*api.ClusterResourceQuota), old.(*api.ClusterResourceQuota))
+}
+
+type statusStrategy struct {
+	strategy
+}
+
+// StatusStrategy is the logic that applies when updating the status of a ClusterResourceQuota.
+var StatusStrategy = statusStrategy{Strategy}
+
+// PrepareForUpdate preserves the spec of the quota, which may not be changed through the
+// status subresource
+func (statusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newQuota := obj.(*api.ClusterResourceQuota)
+	oldQuota := old.(*api.ClusterResourceQuota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+// ValidateUpdate is the status update validation for a quota
+func (statusStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateClusterResourceQuotaStatusUpdate(obj.(*api.ClusterResourceQuota), old.(*api.ClusterResourceQuota))
+}
+
+// Matcher returns a generic matcher for a given label and field selector.
+func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		quota, ok := obj.(*api.ClusterResourceQuota)
+		if !ok {
+			return false, fmt.Errorf("not a ClusterResourceQuota")
+		}
+		return label.Matches(labels.Set(quota.Labels)) && field.Matches(api.ClusterResourceQuotaToSelectableFields(quota)), nil
+	})
+}
@@ -42,7 +42,7 @@ type RouteSpec struct {
 type RoutePort struct {
 	// The target port on pods selected by the service this route points to.
 	// If this is a string, it will be looked up as a named port in the target
-	// endpoints port list. Required
+	// endpoints port list, or as the named pod port a port of the service targets. Required
 	TargetPort intstr.IntOrString
 }
 
@@ -86,6 +86,13 @@ type TLSConfig struct {
 	// CACertificate provides the cert authority certificate contents
 	CACertificate string
 
+	// SecretName is the name of a kubernetes.io/tls secret in the namespace of the route holding the
+	// certificate (tls.crt), key (tls.key) and optionally the cert authority certificate (ca.crt) to serve.
+	// Routers reload the certificates when the secret changes. Routers must be granted get, list and
+	// watch on the secrets of the namespace, for example with a role binding to the system:routers
+	// group. Certificate, Key and CACertificate may not be set when a secret is referenced.
+	SecretName string
+
 	// DestinationCACertificate provides the contents of the ca certificate of the final destination.  When using reencrypt
 	// termination this file should be provided in order to have routers use it for health checks on the secure connection
 	DestinationCACertificate string
@@ -121,3 +128,17 @@ const (
 	// insecure HTTP connections will be redirected to use HTTPS.
 	InsecureEdgeTerminationPolicyRedirect InsecureEdgeTerminationPolicyType = "Redirect"
 )
+
+const (
+	// TLSSecretType is the type of the secrets routes may reference for their certificates. Routers
+	// only read secrets of this type.
+	TLSSecretType kapi.SecretType = "kubernetes.io/tls"
+
+	// TLSCertificateKey is the key of the certificate in a secret referenced by a route.
+	TLSCertificateKey = "tls.crt"
+	// TLSPrivateKeyKey is the key of the private key in a secret referenced by a route.
+	TLSPrivateKeyKey = "tls.key"
+	// TLSCACertificateKey is the key of the optional cert authority certificate in a secret
+	// referenced by a route.
+	TLSCACertificateKey = "ca.crt"
+)
@@ -54,7 +54,7 @@ type RouteSpec struct {
 type RoutePort struct {
 	// The target port on pods selected by the service this route points to.
 	// If this is a string, it will be looked up as a named port in the target
-	// endpoints port list. Required
+	// endpoints port list, or as the named pod port a port of the service targets. Required
 	TargetPort intstr.IntOrString `json:"targetPort" description:"the target port on the endpoints for the service; if this is a string must match the named port, if an integer, must match the port number"`
 }
 
@@ -91,6 +91,13 @@ type TLSConfig struct {
 	// CACertificate provides the cert authority certificate contents
 	CACertificate string `json:"caCertificate,omitempty" description:"provides the cert authority certificate contents"`
 
+	// SecretName is the name of a kubernetes.io/tls secret in the namespace of the route holding the
+	// certificate (tls.crt), key (tls.key) and optionally the cert authority certificate (ca.crt) to serve.
+	// Routers reload the certificates when the secret changes. Routers must be granted get, list and
+	// watch on the secrets of the namespace, for example with a role binding to the system:routers
+	// group. Certificate, Key and CACertificate may not be set when a secret is referenced.
+	SecretName string `json:"secretName,omitempty" description:"name of a kubernetes.io/tls secret in the namespace of the route holding the certificate (tls.crt), key (tls.key) and optionally the cert authority certificate (ca.crt) to serve; routers must be granted get, list and watch on the secrets of the namespace; certificate, key and caCertificate may not be set when a secret is referenced"`
+
 	// DestinationCACertificate provides the contents of the ca certificate of the final destination.  When using reencrypt
 	// termination this file should be provided in order to have routers use it for health checks on the secure connection
 	DestinationCACertificate string `json:"destinationCACertificate,omitempty" description:"provides the contents of the ca certificate of the final destination.  When using re-encrypt termination this file should be provided in order to have routers use it for health checks on the secure connection"`
@@ -44,6 +44,11 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		case target.Type == intstr.Int && target.IntVal == 0,
 			target.Type == intstr.String && len(target.StrVal) == 0:
 			result = append(result, field.Required(field.NewPath("targetPort")))
+		case target.Type == intstr.Int && !kvalidation.IsValidPortNum(int(target.IntVal)):
+			result = append(result, field.Invalid(field.NewPath("targetPort"), target.IntVal, "must be between 1 and 65535"))
+		// service port names may be any DNS label, and the router also matches a numeric string against port numbers
+		case target.Type == intstr.String && !kvalidation.IsDNS1123Label(target.StrVal):
+			result = append(result, field.Invalid(field.NewPath("targetPort"), target.StrVal, fmt.Sprintf("must be a port number or a DNS label of at most %d characters", kvalidation.DNS1123LabelMaxLength)))
 		}
 	}
 
@@ -106,6 +111,10 @@ func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
 		if len(tls.DestinationCACertificate) > 0 {
 			result = append(result, field.Invalid(fldPath.Child("destinationCACertificate"), tls.DestinationCACertificate, "passthrough termination does not support certificates"))
 		}
+
+		if len(tls.SecretName) > 0 {
+			result = append(result, field.Invalid(fldPath.Child("secretName"), tls.SecretName, "passthrough termination does not support certificates"))
+		}
 	// edge cert should only specify cert, key, and cacert but those certs
 	// may not be specified if the route is a wildcard route
 	case routeapi.TLSTerminationEdge:
@@ -117,6 +126,10 @@ func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
 		result = append(result, field.NotSupported(fldPath.Child("termination"), tls.Termination, validValues))
 	}
 
+	if len(tls.SecretName) > 0 && tls.Termination != routeapi.TLSTerminationPassthrough {
+		result = append(result, validateSecretName(tls, fldPath)...)
+	}
+
 	if err := validateInsecureEdgeTerminationPolicy(tls); err != nil {
 		result = append(result, err)
 	}
@@ -125,6 +138,25 @@ func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
 	return result
 }
 
+// validateSecretName ensures the referenced secret has a valid name and that the route does not
+// also embed the certificates the secret provides.  Called by validateTLS.
+func validateSecretName(tls *routeapi.TLSConfig, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+	if ok, msg := kval.ValidateSecretName(tls.SecretName, false); !ok {
+		result = append(result, field.Invalid(fldPath.Child("secretName"), tls.SecretName, msg))
+	}
+	if len(tls.Certificate) > 0 {
+		result = append(result, field.Invalid(fldPath.Child("certificate"), tls.Certificate, "may not be set when secretName is set"))
+	}
+	if len(tls.Key) > 0 {
+		result = append(result, field.Invalid(fldPath.Child("key"), tls.Key, "may not be set when secretName is set"))
+	}
+	if len(tls.CACertificate) > 0 {
+		result = append(result, field.Invalid(fldPath.Child("caCertificate"), tls.CACertificate, "may not be set when secretName is set"))
+	}
+	return result
+}
+
 // validateNoDoubleEscapes ensures double escaped newlines are not in the certificates.  Double
 // escaped newlines may be a remnant of old code which used to replace them for the user unnecessarily.
 // TODO this is a temporary validation to reject any of our examples with double slashes.  Remove this quickly.
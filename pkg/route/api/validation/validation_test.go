@@ -129,6 +129,120 @@ func TestValidateRoute(t *testing.T) {
 			},
 			expectedErrors: 1,
 		},
+		{
+			name: "Port out of range",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromInt(65536),
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Invalid port name",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromString("web_port"),
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Valid named port",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromString("web"),
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Valid long named port",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromString("web-frontend-secure"),
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Valid numeric string port",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromString("8080"),
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Invalid too long named port",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+					},
+					Port: &api.RoutePort{
+						TargetPort: intstr.FromString("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
 		{
 			name: "Valid route",
 			route: &api.Route{
@@ -253,6 +367,58 @@ func TestValidateTLS(t *testing.T) {
 			},
 			expectedErrors: 0,
 		},
+		{
+			name: "Edge termination OK with secret",
+			route: &api.Route{
+				Spec: api.RouteSpec{
+					TLS: &api.TLSConfig{
+						Termination: api.TLSTerminationEdge,
+						SecretName:  "route-certs",
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Edge termination with secret and certs",
+			route: &api.Route{
+				Spec: api.RouteSpec{
+					TLS: &api.TLSConfig{
+						Termination:   api.TLSTerminationEdge,
+						SecretName:    "route-certs",
+						Certificate:   "def",
+						Key:           "ghi",
+						CACertificate: "jkl",
+					},
+				},
+			},
+			expectedErrors: 3,
+		},
+		{
+			name: "Reencrypt termination with invalid secret name",
+			route: &api.Route{
+				Spec: api.RouteSpec{
+					TLS: &api.TLSConfig{
+						Termination:              api.TLSTerminationReencrypt,
+						SecretName:               "Route_Certs",
+						DestinationCACertificate: "abc",
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Passthrough termination with secret",
+			route: &api.Route{
+				Spec: api.RouteSpec{
+					TLS: &api.TLSConfig{
+						Termination: api.TLSTerminationPassthrough,
+						SecretName:  "route-certs",
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
 		{
 			name: "Reencrypt termination OK without certs",
 			route: &api.Route{
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/gonum/graph"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	osgraph "github.com/openshift/origin/pkg/api/graph"
 	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
@@ -18,6 +20,9 @@ const (
 	MissingRoutePortWarning = "MissingRoutePort"
 	// MissingServiceWarning is returned when there is no service for the specific route.
 	MissingServiceWarning = "MissingService"
+	// UnknownRoutePortWarning is returned when a route specifies a port that the service it
+	// routes to does not expose.
+	UnknownRoutePortWarning = "UnknownRoutePort"
 	// MissingTLSTerminationTypeErr is returned when a route with a tls config doesn't
 	// specify a tls termination type.
 	MissingTLSTerminationTypeErr = "MissingTLSTermination"
@@ -47,6 +52,7 @@ route:
 					Key:      MissingServiceWarning,
 					Message: fmt.Sprintf("%s is supposed to route traffic to %s but %s doesn't exist.",
 						f.ResourceName(routeNode), f.ResourceName(svcNode), f.ResourceName(svcNode)),
+					Suggestion: osgraph.Suggestion(fmt.Sprintf("oc expose dc/<name> --name=%s (replace <name> with the deployment config that should receive the traffic)", svcNode.Name)),
 				})
 
 				continue route
@@ -65,12 +71,51 @@ route:
 
 				continue route
 			}
+
+			if routeNode.Spec.Port != nil && len(routeNode.Spec.Port.TargetPort.String()) > 0 && !serviceHasTargetPort(svcNode.Service, routeNode.Spec.Port.TargetPort) {
+				markers = append(markers, osgraph.Marker{
+					Node:         routeNode,
+					RelatedNodes: []graph.Node{svcNode},
+
+					Severity: osgraph.WarningSeverity,
+					Key:      UnknownRoutePortWarning,
+					Message: fmt.Sprintf("%s routes traffic to port %s of %s, but the service has no such port.",
+						f.ResourceName(routeNode), routeNode.Spec.Port.TargetPort.String(), f.ResourceName(svcNode)),
+					Suggestion: osgraph.Suggestion(fmt.Sprintf("oc patch %s -p '{\"spec\":{\"port\":{\"targetPort\":\"<port>\"}}}' (replace <port> with the name or target port of a port of %s)", f.ResourceName(routeNode), f.ResourceName(svcNode))),
+				})
+
+				continue route
+			}
 		}
 	}
 
 	return markers
 }
 
+// serviceHasTargetPort returns true if the target port of a route matches the name of a port of
+// the service, or the port of the pods it targets. Ports that may be targeted through a named pod
+// port whose number is unknown are assumed to match.
+func serviceHasTargetPort(svc *kapi.Service, target intstr.IntOrString) bool {
+	for _, port := range svc.Spec.Ports {
+		switch {
+		case target.Type == intstr.String:
+			if port.Name == target.StrVal || (port.TargetPort.Type == intstr.String && port.TargetPort.StrVal == target.StrVal) {
+				return true
+			}
+		case port.TargetPort.Type == intstr.String && len(port.TargetPort.StrVal) > 0:
+			return true
+		case port.TargetPort.IntVal == 0:
+			// the target port defaults to the port of the service
+			if port.Port == int(target.IntVal) {
+				return true
+			}
+		case port.TargetPort.IntVal == target.IntVal:
+			return true
+		}
+	}
+	return false
+}
+
 func FindMissingTLSTerminationType(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
 	markers := []osgraph.Marker{}
 
@@ -6,6 +6,7 @@ import (
 	osgraph "github.com/openshift/origin/pkg/api/graph"
 	osgraphtest "github.com/openshift/origin/pkg/api/graph/test"
 	routeedges "github.com/openshift/origin/pkg/route/graph"
+	routegraph "github.com/openshift/origin/pkg/route/graph/nodes"
 )
 
 func TestMissingPortMapping(t *testing.T) {
@@ -38,6 +39,24 @@ func TestMissingPortMapping(t *testing.T) {
 	if expected, got := MissingServiceWarning, markers[0].Key; expected != got {
 		t.Fatalf("expected %s marker key, got %s", expected, got)
 	}
+
+	// Route port not exposed by the service
+	g, _, err = osgraphtest.BuildGraph("../../../api/graph/test/unknown-route-port.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	routeedges.AddAllRouteEdges(g)
+
+	markers = FindMissingPortMapping(g, osgraph.DefaultNamer)
+	if expected, got := 1, len(markers); expected != got {
+		t.Fatalf("expected %d markers, got %d", expected, got)
+	}
+	if expected, got := UnknownRoutePortWarning, markers[0].Key; expected != got {
+		t.Fatalf("expected %s marker key, got %s", expected, got)
+	}
+	if expected, got := "route-unknown", markers[0].Node.(*routegraph.RouteNode).Name; expected != got {
+		t.Fatalf("expected a marker for %s, got %s", expected, got)
+	}
 }
 
 func TestPathBasedPassthroughRoutes(t *testing.T) {
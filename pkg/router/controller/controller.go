@@ -28,11 +28,29 @@ type RouterController struct {
 	Plugin        router.Plugin
 	NextRoute     func() (watch.EventType, *routeapi.Route, error)
 	NextEndpoints func() (watch.EventType, *kapi.Endpoints, error)
+	// NextSecret returns the events of the secrets that routes reference for their certificates.
+	// Secrets are not watched if it is nil.
+	NextSecret func() (watch.EventType, *kapi.Secret, error)
+	// GetSecret gets a secret when a route first references it.
+	GetSecret func(namespace, name string) (*kapi.Secret, error)
+	// WatchSecrets starts passing the events of the secrets of a namespace to NextSecret. It is
+	// called once for each namespace the router may read the referenced secrets of, since routers
+	// are only granted access to secrets per namespace.
+	WatchSecrets func(namespace string)
 
 	Namespaces            NamespaceLister
 	NamespaceSyncInterval time.Duration
 	NamespaceWaitInterval time.Duration
 	NamespaceRetries      int
+
+	// secrets holds the secrets with a certificate that routes reference, keyed by namespace and name.
+	secrets map[string]*kapi.Secret
+	// secretRoutes holds the routes that reference each secret, keyed by secret and route.
+	secretRoutes map[string]map[string]*routeapi.Route
+	// routeSecrets holds the secret each route references, keyed by route.
+	routeSecrets map[string]string
+	// secretNamespaces holds the namespaces whose secrets are watched.
+	secretNamespaces sets.String
 }
 
 // Run begins watching and syncing.
@@ -44,6 +62,9 @@ func (c *RouterController) Run() {
 	}
 	go util.Forever(c.HandleRoute, 0)
 	go util.Forever(c.HandleEndpoints, 0)
+	if c.NextSecret != nil {
+		go util.Forever(c.HandleSecret, 0)
+	}
 }
 
 func (c *RouterController) HandleNamespaces() {
@@ -80,6 +101,9 @@ func (c *RouterController) HandleRoute() {
 	glog.V(4).Infof("           Alias: %s", route.Spec.Host)
 	glog.V(4).Infof("           Event: %s", eventType)
 
+	if c.NextSecret != nil {
+		route = c.trackRouteSecret(eventType, route)
+	}
 	if err := c.Plugin.HandleRoute(eventType, route); err != nil {
 		util.HandleError(err)
 	}
@@ -31,6 +31,10 @@ type RouterControllerFactory struct {
 	Namespace      string
 	Labels         labels.Selector
 	Fields         fields.Selector
+
+	// SecretsClient watches the secrets that routes reference for their certificates. Secrets
+	// are not watched if it is nil.
+	SecretsClient kclient.SecretsNamespacer
 }
 
 // NewDefaultRouterControllerFactory initializes a default router controller factory.
@@ -64,6 +68,37 @@ func (factory *RouterControllerFactory) Create(plugin router.Plugin) *controller
 		// we do not scope endpoints by labels or fields because the route labels != endpoints labels
 	}, &kapi.Endpoints{}, endpointsEventQueue, factory.ResyncInterval).Run()
 
+	var nextSecret func() (watch.EventType, *kapi.Secret, error)
+	var getSecret func(namespace, name string) (*kapi.Secret, error)
+	var watchSecrets func(namespace string)
+	if factory.SecretsClient != nil {
+		// routers are granted access to secrets per namespace, so each namespace is watched on its own
+		// and the events are passed on to a single channel
+		secretEvents := make(chan secretEvent)
+		watchSecrets = func(namespace string) {
+			secretEventQueue := oscache.NewEventQueue(cache.MetaNamespaceKeyFunc)
+			cache.NewReflector(&secretLW{
+				client:    factory.SecretsClient,
+				namespace: namespace,
+			}, &kapi.Secret{}, secretEventQueue, factory.ResyncInterval).Run()
+			go util.Forever(func() {
+				eventType, obj, err := secretEventQueue.Pop()
+				if err != nil {
+					util.HandleError(err)
+					return
+				}
+				secretEvents <- secretEvent{eventType, obj.(*kapi.Secret)}
+			}, 0)
+		}
+		nextSecret = func() (watch.EventType, *kapi.Secret, error) {
+			event := <-secretEvents
+			return event.eventType, event.secret, nil
+		}
+		getSecret = func(namespace, name string) (*kapi.Secret, error) {
+			return factory.SecretsClient.Secrets(namespace).Get(name)
+		}
+	}
+
 	return &controller.RouterController{
 		Plugin: plugin,
 		NextEndpoints: func() (watch.EventType, *kapi.Endpoints, error) {
@@ -80,7 +115,10 @@ func (factory *RouterControllerFactory) Create(plugin router.Plugin) *controller
 			}
 			return eventType, obj.(*routeapi.Route), nil
 		},
-		Namespaces: factory.Namespaces,
+		NextSecret:   nextSecret,
+		GetSecret:    getSecret,
+		WatchSecrets: watchSecrets,
+		Namespaces:   factory.Namespaces,
 		// check namespaces a bit more often than we resync events, so that we aren't always waiting
 		// the maximum interval for new items to come into the list
 		// TODO: trigger a reflector resync after every namespace sync?
@@ -234,3 +272,36 @@ func (lw *endpointsLW) Watch(options kapi.ListOptions) (watch.Interface, error)
 	}
 	return lw.client.Endpoints(lw.namespace).Watch(opts)
 }
+
+// secretEvent is an event of a secret watched by a secretLW.
+type secretEvent struct {
+	eventType watch.EventType
+	secret    *kapi.Secret
+}
+
+// secretLW is a list watcher for the secrets of routes. Only secrets of the type routes may reference
+// are listed, so that service account tokens and other credentials never reach the router.
+type secretLW struct {
+	client    kclient.SecretsNamespacer
+	namespace string
+}
+
+func (lw *secretLW) List(options kapi.ListOptions) (runtime.Object, error) {
+	opts := kapi.ListOptions{
+		FieldSelector: tlsSecretSelector(),
+	}
+	return lw.client.Secrets(lw.namespace).List(opts)
+}
+
+func (lw *secretLW) Watch(options kapi.ListOptions) (watch.Interface, error) {
+	opts := kapi.ListOptions{
+		FieldSelector:   tlsSecretSelector(),
+		ResourceVersion: options.ResourceVersion,
+	}
+	return lw.client.Secrets(lw.namespace).Watch(opts)
+}
+
+// tlsSecretSelector selects the secrets routes may reference.
+func tlsSecretSelector() fields.Selector {
+	return fields.OneTermEqualSelector("type", string(routeapi.TLSSecretType))
+}
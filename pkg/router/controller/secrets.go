@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// HandleSecret handles a single Secret event and passes the routes that reference the secret
+// to the plugin again, so that the router backend serves the new certificates. Secrets that no
+// route references are not kept.
+func (c *RouterController) HandleSecret() {
+	eventType, secret, err := c.NextSecret()
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to read secrets: %v", err))
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := secretKey(secret.Namespace, secret.Name)
+	routes, ok := c.secretRoutes[key]
+	if !ok {
+		return
+	}
+	if eventType == watch.Deleted {
+		delete(c.secrets, key)
+	} else {
+		c.setSecret(key, secret)
+	}
+
+	for _, route := range routes {
+		glog.V(4).Infof("Reloading the certificates of route %s/%s from secret %s", route.Namespace, route.Name, key)
+		if err := c.Plugin.HandleRoute(watch.Modified, c.routeWithSecret(route)); err != nil {
+			util.HandleError(err)
+		}
+	}
+}
+
+// trackRouteSecret records the secret the route references, if any, so that the route can be
+// reloaded when the secret changes, and returns the route with the certificates of the secret.
+// A secret is fetched when a route first references it. The route is returned without
+// certificates while its secret does not exist, or while the router is not allowed to read the
+// secrets of its namespace. In that case the secret is fetched again the next time the route
+// is handled.
+func (c *RouterController) trackRouteSecret(eventType watch.EventType, route *routeapi.Route) *routeapi.Route {
+	name := routeNameKey(route)
+	if old, ok := c.routeSecrets[name]; ok {
+		delete(c.secretRoutes[old], name)
+		if len(c.secretRoutes[old]) == 0 {
+			delete(c.secretRoutes, old)
+			delete(c.secrets, old)
+		}
+		delete(c.routeSecrets, name)
+	}
+
+	tls := route.Spec.TLS
+	if eventType == watch.Deleted || tls == nil || len(tls.SecretName) == 0 || tls.Termination == routeapi.TLSTerminationPassthrough {
+		return route
+	}
+
+	key := secretKey(route.Namespace, tls.SecretName)
+	if c.routeSecrets == nil {
+		c.routeSecrets = make(map[string]string)
+		c.secretRoutes = make(map[string]map[string]*routeapi.Route)
+	}
+	if _, ok := c.secretRoutes[key]; !ok {
+		if !c.fetchSecret(route.Namespace, tls.SecretName) {
+			return c.routeWithSecret(route)
+		}
+		c.secretRoutes[key] = make(map[string]*routeapi.Route)
+		c.watchSecrets(route.Namespace)
+	}
+	c.secretRoutes[key][name] = route
+	c.routeSecrets[name] = key
+
+	return c.routeWithSecret(route)
+}
+
+// fetchSecret gets a secret that was not referenced by any route before. It returns false if the
+// router is not allowed to read the secret.
+func (c *RouterController) fetchSecret(namespace, name string) bool {
+	if c.GetSecret == nil {
+		return true
+	}
+	secret, err := c.GetSecret(namespace, name)
+	switch {
+	case kapierrors.IsNotFound(err):
+		glog.V(4).Infof("Secret %s/%s does not exist yet", namespace, name)
+	case kapierrors.IsForbidden(err):
+		util.HandleError(fmt.Errorf("the router may not read secret %s/%s, grant it get, list and watch on the secrets of namespace %s: %v", namespace, name, namespace, err))
+		return false
+	case err != nil:
+		util.HandleError(fmt.Errorf("unable to get secret %s/%s: %v", namespace, name, err))
+	default:
+		c.setSecret(secretKey(namespace, name), secret)
+	}
+	return true
+}
+
+// watchSecrets starts watching the secrets of the namespace, unless they are watched already.
+func (c *RouterController) watchSecrets(namespace string) {
+	if c.WatchSecrets == nil {
+		return
+	}
+	if c.secretNamespaces == nil {
+		c.secretNamespaces = sets.NewString()
+	}
+	if c.secretNamespaces.Has(namespace) {
+		return
+	}
+	glog.V(4).Infof("Watching the secrets of namespace %s", namespace)
+	c.secretNamespaces.Insert(namespace)
+	c.WatchSecrets(namespace)
+}
+
+// setSecret keeps the secret if it holds a certificate, and forgets it otherwise.
+func (c *RouterController) setSecret(key string, secret *kapi.Secret) {
+	if secret.Type != routeapi.TLSSecretType || len(secret.Data[routeapi.TLSCertificateKey]) == 0 {
+		glog.V(4).Infof("Secret %s is not a %s secret with a certificate", key, routeapi.TLSSecretType)
+		delete(c.secrets, key)
+		return
+	}
+	if c.secrets == nil {
+		c.secrets = make(map[string]*kapi.Secret)
+	}
+	c.secrets[key] = secret
+}
+
+// routeWithSecret returns a copy of the route with the certificate, key and cert authority
+// certificate of the secret it references.
+func (c *RouterController) routeWithSecret(route *routeapi.Route) *routeapi.Route {
+	copied := *route
+	tls := *route.Spec.TLS
+	copied.Spec.TLS = &tls
+
+	secret, ok := c.secrets[secretKey(route.Namespace, tls.SecretName)]
+	if !ok {
+		glog.V(4).Infof("Secret %s of route %s/%s has no certificate yet", tls.SecretName, route.Namespace, route.Name)
+		return &copied
+	}
+	tls.Certificate = string(secret.Data[routeapi.TLSCertificateKey])
+	tls.Key = string(secret.Data[routeapi.TLSPrivateKeyKey])
+	tls.CACertificate = string(secret.Data[routeapi.TLSCACertificateKey])
+	return &copied
+}
+
+// secretKey returns the key of the secret with the given namespace and name.
+func secretKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
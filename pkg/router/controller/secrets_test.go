@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// fakePlugin records the last route it was passed.
+type fakePlugin struct {
+	eventType watch.EventType
+	route     *routeapi.Route
+}
+
+func (p *fakePlugin) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
+	p.eventType, p.route = eventType, route
+	return nil
+}
+
+func (p *fakePlugin) HandleEndpoints(watch.EventType, *kapi.Endpoints) error {
+	return nil
+}
+
+func (p *fakePlugin) HandleNamespaces(namespaces sets.String) error {
+	return nil
+}
+
+type secretEvent struct {
+	eventType watch.EventType
+	secret    *kapi.Secret
+}
+
+func TestRouterControllerSecrets(t *testing.T) {
+	plugin := &fakePlugin{}
+	var nextRoute *routeapi.Route
+	var nextSecret secretEvent
+	existing := map[string]*kapi.Secret{}
+	forbidden := sets.NewString()
+	watched := []string{}
+	c := &RouterController{
+		Plugin: plugin,
+		NextRoute: func() (watch.EventType, *routeapi.Route, error) {
+			return watch.Added, nextRoute, nil
+		},
+		NextSecret: func() (watch.EventType, *kapi.Secret, error) {
+			return nextSecret.eventType, nextSecret.secret, nil
+		},
+		GetSecret: func(namespace, name string) (*kapi.Secret, error) {
+			if forbidden.Has(namespace) {
+				return nil, kapierrors.NewForbidden("Secret", name, fmt.Errorf("not allowed"))
+			}
+			if secret, ok := existing[secretKey(namespace, name)]; ok {
+				return secret, nil
+			}
+			return nil, kapierrors.NewNotFound("Secret", name)
+		},
+		WatchSecrets: func(namespace string) {
+			watched = append(watched, namespace)
+		},
+	}
+	secret := func(cert string) *kapi.Secret {
+		return &kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "certs"},
+			Type:       routeapi.TLSSecretType,
+			Data: map[string][]byte{
+				routeapi.TLSCertificateKey: []byte(cert),
+				routeapi.TLSPrivateKeyKey:  []byte("key"),
+			},
+		}
+	}
+
+	// the route is passed without certificates until its secret is seen
+	nextRoute = &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "route"},
+		Spec: routeapi.RouteSpec{
+			TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, SecretName: "certs"},
+		},
+	}
+	c.HandleRoute()
+	if plugin.route == nil || len(plugin.route.Spec.TLS.Certificate) != 0 {
+		t.Fatalf("expected the route without certificates, got %#v", plugin.route)
+	}
+
+	nextSecret = secretEvent{watch.Added, secret("cert-1")}
+	c.HandleSecret()
+	if e, a := "cert-1", plugin.route.Spec.TLS.Certificate; e != a {
+		t.Errorf("expected certificate %q, got %q", e, a)
+	}
+	if e, a := "key", plugin.route.Spec.TLS.Key; e != a {
+		t.Errorf("expected key %q, got %q", e, a)
+	}
+	if plugin.eventType != watch.Modified {
+		t.Errorf("expected the route to be modified, got %s", plugin.eventType)
+	}
+	if len(nextRoute.Spec.TLS.Certificate) != 0 {
+		t.Errorf("the route from the watch must not be modified")
+	}
+
+	// a rotated certificate is passed to the plugin
+	nextSecret = secretEvent{watch.Modified, secret("cert-2")}
+	c.HandleSecret()
+	if e, a := "cert-2", plugin.route.Spec.TLS.Certificate; e != a {
+		t.Errorf("expected certificate %q, got %q", e, a)
+	}
+
+	// secrets of other namespaces are ignored
+	plugin.route = nil
+	other := secret("cert-3")
+	other.Namespace = "bar"
+	nextSecret = secretEvent{watch.Modified, other}
+	c.HandleSecret()
+	if plugin.route != nil {
+		t.Errorf("unexpected route %#v", plugin.route)
+	}
+
+	// the certificates are removed with the secret
+	nextSecret = secretEvent{watch.Deleted, secret("cert-2")}
+	c.HandleSecret()
+	if plugin.route == nil || len(plugin.route.Spec.TLS.Certificate) != 0 {
+		t.Errorf("expected the route without certificates, got %#v", plugin.route)
+	}
+
+	// secrets of another type are not served
+	opaque := secret("cert-3")
+	opaque.Type = kapi.SecretTypeOpaque
+	nextSecret = secretEvent{watch.Added, opaque}
+	c.HandleSecret()
+	if plugin.route == nil || len(plugin.route.Spec.TLS.Certificate) != 0 {
+		t.Errorf("expected the route without certificates, got %#v", plugin.route)
+	}
+
+	// routes which no longer reference the secret are not reloaded, and the secret is forgotten
+	nextRoute = &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "route"},
+		Spec: routeapi.RouteSpec{
+			TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge},
+		},
+	}
+	c.HandleRoute()
+	plugin.route = nil
+	nextSecret = secretEvent{watch.Added, secret("cert-4")}
+	c.HandleSecret()
+	if plugin.route != nil {
+		t.Errorf("unexpected route %#v", plugin.route)
+	}
+	if len(c.secrets) != 0 {
+		t.Errorf("expected no secrets to be kept, got %v", c.secrets)
+	}
+
+	// an existing secret is fetched when a route first references it
+	existing["foo/certs"] = secret("cert-5")
+	nextRoute = &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "other"},
+		Spec: routeapi.RouteSpec{
+			TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationReencrypt, SecretName: "certs"},
+		},
+	}
+	c.HandleRoute()
+	if plugin.route == nil || plugin.route.Spec.TLS.Certificate != "cert-5" {
+		t.Errorf("expected the route with the certificate of the existing secret, got %#v", plugin.route)
+	}
+
+	// the secrets of a namespace are watched once
+	if !reflect.DeepEqual(watched, []string{"foo"}) {
+		t.Errorf("expected the secrets of namespace foo to be watched once, got %v", watched)
+	}
+
+	// the secrets of a namespace the router may not read are neither tracked nor watched, and are
+	// fetched again when the route is handled next
+	forbidden.Insert("bar")
+	nextRoute = &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "bar", Name: "route"},
+		Spec: routeapi.RouteSpec{
+			TLS: &routeapi.TLSConfig{Termination: routeapi.TLSTerminationEdge, SecretName: "certs"},
+		},
+	}
+	c.HandleRoute()
+	if plugin.route == nil || len(plugin.route.Spec.TLS.Certificate) != 0 {
+		t.Errorf("expected the route without certificates, got %#v", plugin.route)
+	}
+	if _, ok := c.secretRoutes["bar/certs"]; ok || len(watched) != 1 {
+		t.Errorf("expected the forbidden secret not to be tracked, got %v and watches %v", c.secretRoutes, watched)
+	}
+	forbidden.Delete("bar")
+	barSecret := secret("cert-6")
+	barSecret.Namespace = "bar"
+	existing["bar/certs"] = barSecret
+	c.HandleRoute()
+	if plugin.route == nil || plugin.route.Spec.TLS.Certificate != "cert-6" {
+		t.Errorf("expected the route with the certificate once access is granted, got %#v", plugin.route)
+	}
+	if !reflect.DeepEqual(watched, []string{"foo", "bar"}) {
+		t.Errorf("expected the secrets of namespace bar to be watched once access is granted, got %v", watched)
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorPodNetworkAnnotationNotFound is returned when a NetNamespace has no ChangePodNetworkAnnotation
+var ErrorPodNetworkAnnotationNotFound = errors.New("change pod network annotation not found")
+
+// GetChangePodNetworkAnnotation returns the pod network change requested on netns and, for
+// JoinPodNetwork, the name of the namespace to join.
+func GetChangePodNetworkAnnotation(netns *NetNamespace) (PodNetworkAction, string, error) {
+	value, ok := netns.Annotations[ChangePodNetworkAnnotation]
+	if !ok {
+		return PodNetworkAction(""), "", ErrorPodNetworkAnnotationNotFound
+	}
+
+	args := strings.SplitN(value, ":", 2)
+	switch action := PodNetworkAction(args[0]); action {
+	case JoinPodNetwork:
+		if len(args) != 2 || len(args[1]) == 0 {
+			return PodNetworkAction(""), "", fmt.Errorf("invalid value %q for %s: the namespace to join is required", value, ChangePodNetworkAnnotation)
+		}
+		return action, args[1], nil
+	case GlobalPodNetwork, IsolatePodNetwork:
+		if len(args) != 1 {
+			return PodNetworkAction(""), "", fmt.Errorf("invalid value %q for %s: %s takes no arguments", value, ChangePodNetworkAnnotation, action)
+		}
+		return action, "", nil
+	default:
+		return PodNetworkAction(""), "", fmt.Errorf("invalid value %q for %s: unknown action %q", value, ChangePodNetworkAnnotation, action)
+	}
+}
+
+// SetChangePodNetworkAnnotation requests that the pod network of netns be changed.  namespace is the
+// namespace to join for JoinPodNetwork and is ignored otherwise.
+func SetChangePodNetworkAnnotation(netns *NetNamespace, action PodNetworkAction, namespace string) {
+	if netns.Annotations == nil {
+		netns.Annotations = make(map[string]string)
+	}
+	value := string(action)
+	if action == JoinPodNetwork {
+		value = fmt.Sprintf("%s:%s", action, namespace)
+	}
+	netns.Annotations[ChangePodNetworkAnnotation] = value
+}
+
+// DeleteChangePodNetworkAnnotation removes a pending pod network change from netns
+func DeleteChangePodNetworkAnnotation(netns *NetNamespace) {
+	delete(netns.Annotations, ChangePodNetworkAnnotation)
+}
+
+// GetClusterNetworkEntries returns the CIDRs that node subnets are allocated from. ClusterNetworks
+// written by masters that predate ClusterNetworks only set Network and HostSubnetLength.
+func GetClusterNetworkEntries(cn *ClusterNetwork) []ClusterNetworkEntry {
+	if len(cn.ClusterNetworks) > 0 {
+		return cn.ClusterNetworks
+	}
+	return []ClusterNetworkEntry{{CIDR: cn.Network, HostSubnetLength: cn.HostSubnetLength}}
+}
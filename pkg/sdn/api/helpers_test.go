@@ -0,0 +1,34 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestChangePodNetworkAnnotation(t *testing.T) {
+	tests := []struct {
+		action    PodNetworkAction
+		namespace string
+	}{
+		{action: GlobalPodNetwork},
+		{action: IsolatePodNetwork},
+		{action: JoinPodNetwork, namespace: "other"},
+	}
+
+	for _, tc := range tests {
+		netns := &NetNamespace{}
+		SetChangePodNetworkAnnotation(netns, tc.action, tc.namespace)
+		action, namespace, err := GetChangePodNetworkAnnotation(netns)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.action, err)
+			continue
+		}
+		if action != tc.action || namespace != tc.namespace {
+			t.Errorf("%s: expected %s %q, got %s %q", tc.action, tc.action, tc.namespace, action, namespace)
+		}
+
+		DeleteChangePodNetworkAnnotation(netns)
+		if _, _, err := GetChangePodNetworkAnnotation(netns); err != ErrorPodNetworkAnnotationNotFound {
+			t.Errorf("%s: expected the annotation to be removed, got %v", tc.action, err)
+		}
+	}
+}
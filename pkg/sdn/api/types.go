@@ -5,13 +5,29 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 )
 
+// ClusterNetworkDefault is the name of the cluster network that host subnets are allocated from
+const ClusterNetworkDefault = "default"
+
 type ClusterNetwork struct {
 	unversioned.TypeMeta
 	kapi.ObjectMeta
 
+	// Network and HostSubnetLength are the CIDR and host subnet length of the first entry of
+	// ClusterNetworks. They are kept for clients that only know about a single cluster network.
 	Network          string
 	HostSubnetLength int
 	ServiceNetwork   string
+	// ClusterNetworks is the list of CIDRs that node subnets are allocated from. If empty,
+	// Network and HostSubnetLength describe the only cluster network.
+	ClusterNetworks []ClusterNetworkEntry
+}
+
+// ClusterNetworkEntry is a CIDR that node subnets are allocated from
+type ClusterNetworkEntry struct {
+	// CIDR is the network that node subnets are allocated from
+	CIDR string
+	// HostSubnetLength is the number of bits of each node subnet that are allocated to pods
+	HostSubnetLength int
 }
 
 type ClusterNetworkList struct {
@@ -38,6 +54,9 @@ type HostSubnetList struct {
 	Items []HostSubnet
 }
 
+// MaxNetID is the largest network id of a NetNamespace, the largest VXLAN network identifier
+const MaxNetID = (1 << 24) - 1
+
 // NetNamespace holds the network id against its name
 type NetNamespace struct {
 	unversioned.TypeMeta
@@ -53,3 +72,20 @@ type NetNamespaceList struct {
 	unversioned.ListMeta
 	Items []NetNamespace
 }
+
+// ChangePodNetworkAnnotation is an annotation on a NetNamespace that requests a change of the pod
+// network of the namespace.  The SDN master applies the change and removes the annotation.  The value
+// is a PodNetworkAction, followed by ":<namespace>" for JoinPodNetwork.
+const ChangePodNetworkAnnotation = "pod.network.openshift.io/multitenant.change-network"
+
+// PodNetworkAction is a change of the pod network of a namespace under the multitenant plugin
+type PodNetworkAction string
+
+const (
+	// GlobalPodNetwork makes the namespace reachable by, and able to reach, every other namespace
+	GlobalPodNetwork PodNetworkAction = "global"
+	// JoinPodNetwork makes the namespace share the network of another namespace
+	JoinPodNetwork PodNetworkAction = "join"
+	// IsolatePodNetwork gives the namespace a network of its own
+	IsolatePodNetwork PodNetworkAction = "isolate"
+)
@@ -9,9 +9,16 @@ type ClusterNetwork struct {
 	unversioned.TypeMeta `json:",inline"`
 	kapi.ObjectMeta      `json:"metadata,omitempty"`
 
-	Network          string `json:"network" description:"CIDR string to specify the global overlay network's L3 space"`
-	HostSubnetLength int    `json:"hostsubnetlength" description:"number of bits to allocate to each host's subnet e.g. 8 would mean a /24 network on the host"`
-	ServiceNetwork   string `json:"serviceNetwork" description:"CIDR string to specify the service network"`
+	Network          string                `json:"network" description:"CIDR string to specify the global overlay network's L3 space"`
+	HostSubnetLength int                   `json:"hostsubnetlength" description:"number of bits to allocate to each host's subnet e.g. 8 would mean a /24 network on the host"`
+	ServiceNetwork   string                `json:"serviceNetwork" description:"CIDR string to specify the service network"`
+	ClusterNetworks  []ClusterNetworkEntry `json:"clusterNetworks,omitempty" description:"list of CIDRs to allocate node subnets from; the first entry matches network and hostsubnetlength"`
+}
+
+// ClusterNetworkEntry is a CIDR that node subnets are allocated from
+type ClusterNetworkEntry struct {
+	CIDR             string `json:"cidr" description:"CIDR string to allocate node subnets from"`
+	HostSubnetLength int    `json:"hostSubnetLength" description:"number of bits to allocate to each host's subnet from this CIDR e.g. 8 would mean a /24 network on the host"`
 }
 
 type ClusterNetworkList struct {
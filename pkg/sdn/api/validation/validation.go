@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"fmt"
 	"net"
 
 	"k8s.io/kubernetes/pkg/api/validation"
@@ -13,65 +14,117 @@ import (
 // ValidateClusterNetwork tests if required fields in the ClusterNetwork are set.
 func ValidateClusterNetwork(clusterNet *sdnapi.ClusterNetwork) field.ErrorList {
 	allErrs := validation.ValidateObjectMeta(&clusterNet.ObjectMeta, false, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateClusterNetworks(clusterNet)...)
+	return allErrs
+}
 
-	clusterIP, clusterIPNet, err := net.ParseCIDR(clusterNet.Network)
-	if err != nil {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("network"), clusterNet.Network, err.Error()))
-	} else {
-		ones, bitSize := clusterIPNet.Mask.Size()
-		if (bitSize - ones) <= clusterNet.HostSubnetLength {
-			allErrs = append(allErrs, field.Invalid(field.NewPath("hostSubnetLength"), clusterNet.HostSubnetLength, "subnet length is greater than cluster Mask"))
-		}
-	}
+// validateClusterNetworks ensures that the cluster networks and the service network are valid CIDRs that
+// do not overlap each other.
+func validateClusterNetworks(clusterNet *sdnapi.ClusterNetwork) field.ErrorList {
+	allErrs := field.ErrorList{}
 
 	serviceIP, serviceIPNet, err := net.ParseCIDR(clusterNet.ServiceNetwork)
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("serviceNetwork"), clusterNet.ServiceNetwork, err.Error()))
 	}
 
-	if (clusterIPNet != nil) && (serviceIP != nil) && clusterIPNet.Contains(serviceIP) {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("serviceNetwork"), clusterNet.ServiceNetwork, "service network overlaps with cluster network"))
+	if len(clusterNet.ClusterNetworks) > 0 {
+		first := clusterNet.ClusterNetworks[0]
+		if clusterNet.Network != first.CIDR {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("network"), clusterNet.Network, "must match the CIDR of the first entry of clusterNetworks"))
+		}
+		if clusterNet.HostSubnetLength != first.HostSubnetLength {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("hostSubnetLength"), clusterNet.HostSubnetLength, "must match the hostSubnetLength of the first entry of clusterNetworks"))
+		}
 	}
-	if (serviceIPNet != nil) && (clusterIP != nil) && serviceIPNet.Contains(clusterIP) {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("network"), clusterNet.Network, "cluster network overlaps with service network"))
+
+	clusterIPNets := []*net.IPNet{}
+	for i, entry := range sdnapi.GetClusterNetworkEntries(clusterNet) {
+		cidrPath, lengthPath := clusterNetworkPaths(clusterNet, i)
+
+		clusterIP, clusterIPNet, err := net.ParseCIDR(entry.CIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, entry.CIDR, err.Error()))
+			continue
+		}
+		ones, bitSize := clusterIPNet.Mask.Size()
+		if (bitSize - ones) <= entry.HostSubnetLength {
+			allErrs = append(allErrs, field.Invalid(lengthPath, entry.HostSubnetLength, "subnet length is greater than cluster Mask"))
+		}
+
+		if (serviceIP != nil) && clusterIPNet.Contains(serviceIP) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("serviceNetwork"), clusterNet.ServiceNetwork, "service network overlaps with cluster network"))
+		}
+		if (serviceIPNet != nil) && serviceIPNet.Contains(clusterIP) {
+			allErrs = append(allErrs, field.Invalid(cidrPath, entry.CIDR, "cluster network overlaps with service network"))
+		}
+
+		for _, other := range clusterIPNets {
+			if other.Contains(clusterIP) || clusterIPNet.Contains(other.IP) {
+				allErrs = append(allErrs, field.Invalid(cidrPath, entry.CIDR, fmt.Sprintf("cluster network overlaps with cluster network %s", other.String())))
+			}
+		}
+		clusterIPNets = append(clusterIPNets, clusterIPNet)
 	}
 
 	return allErrs
 }
 
-func validateNewNetwork(obj *sdnapi.ClusterNetwork, old *sdnapi.ClusterNetwork) *field.Error {
-	oldBase, oldNet, err := net.ParseCIDR(old.Network)
+// clusterNetworkPaths returns the paths of the CIDR and host subnet length of the i-th cluster network
+func clusterNetworkPaths(clusterNet *sdnapi.ClusterNetwork, i int) (*field.Path, *field.Path) {
+	if len(clusterNet.ClusterNetworks) == 0 {
+		return field.NewPath("network"), field.NewPath("hostSubnetLength")
+	}
+	entryPath := field.NewPath("clusterNetworks").Index(i)
+	return entryPath.Child("cidr"), entryPath.Child("hostSubnetLength")
+}
+
+// validateExistingNetwork ensures that an existing cluster network is still covered by the new list of
+// cluster networks, so that the node subnets allocated from it stay valid. A network may be replaced by a
+// larger network that contains it, as long as the host subnet length does not change.
+func validateExistingNetwork(old sdnapi.ClusterNetworkEntry, obj *sdnapi.ClusterNetwork) *field.Error {
+	oldBase, oldNet, err := net.ParseCIDR(old.CIDR)
 	if err != nil {
 		// Shouldn't happen, but if the existing value is invalid, then any change should be an improvement...
 		return nil
 	}
 	oldSize, _ := oldNet.Mask.Size()
-	_, newNet, err := net.ParseCIDR(obj.Network)
-	if err != nil {
-		return field.Invalid(field.NewPath("network"), obj.Network, err.Error())
-	}
-	newSize, _ := newNet.Mask.Size()
-	// oldSize/newSize is, eg the "16" in "10.1.0.0/16", so "newSize < oldSize" means
-	// the new network is larger
-	if newSize < oldSize && newNet.Contains(oldBase) {
+
+	for i, entry := range sdnapi.GetClusterNetworkEntries(obj) {
+		_, newNet, err := net.ParseCIDR(entry.CIDR)
+		if err != nil {
+			// reported by ValidateClusterNetwork
+			continue
+		}
+		newSize, _ := newNet.Mask.Size()
+		// oldSize/newSize is, eg the "16" in "10.1.0.0/16", so "newSize <= oldSize" means
+		// the new network is the same size or larger
+		if newSize > oldSize || !newNet.Contains(oldBase) {
+			continue
+		}
+		if entry.HostSubnetLength != old.HostSubnetLength {
+			_, lengthPath := clusterNetworkPaths(obj, i)
+			return field.Invalid(lengthPath, entry.HostSubnetLength, fmt.Sprintf("cannot change the hostSubnetLength of the existing cluster network %s midflight.", old.CIDR))
+		}
 		return nil
-	} else {
-		return field.Invalid(field.NewPath("network"), obj.Network, "cannot change the cluster's network CIDR to a value that does not include the existing network.")
 	}
+
+	cidrPath := field.NewPath("clusterNetworks")
+	if len(obj.ClusterNetworks) == 0 {
+		cidrPath = field.NewPath("network")
+	}
+	return field.Invalid(cidrPath, obj.Network, fmt.Sprintf("cannot change the cluster's network CIDRs to values that do not include the existing network %s.", old.CIDR))
 }
 
 func ValidateClusterNetworkUpdate(obj *sdnapi.ClusterNetwork, old *sdnapi.ClusterNetwork) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&obj.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateClusterNetworks(obj)...)
 
-	if obj.Network != old.Network {
-		err := validateNewNetwork(obj, old)
-		if err != nil {
+	for _, oldEntry := range sdnapi.GetClusterNetworkEntries(old) {
+		if err := validateExistingNetwork(oldEntry, obj); err != nil {
 			allErrs = append(allErrs, err)
 		}
 	}
-	if obj.HostSubnetLength != old.HostSubnetLength {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("hostSubnetLength"), obj.HostSubnetLength, "cannot change the cluster's hostSubnetLength midflight."))
-	}
 	if obj.ServiceNetwork != old.ServiceNetwork && old.ServiceNetwork != "" {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("serviceNetwork"), obj.ServiceNetwork, "cannot change the cluster's serviceNetwork CIDR midflight."))
 	}
@@ -84,9 +137,14 @@ func ValidateClusterNetworkUpdate(obj *sdnapi.ClusterNetwork, old *sdnapi.Cluste
 func ValidateHostSubnet(hs *sdnapi.HostSubnet) field.ErrorList {
 	allErrs := validation.ValidateObjectMeta(&hs.ObjectMeta, false, oapi.MinimalNameRequirements, field.NewPath("metadata"))
 
-	_, _, err := net.ParseCIDR(hs.Subnet)
+	if hs.Host != hs.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("host"), hs.Host, "must be the same as metadata.name"))
+	}
+	ip, ipNet, err := net.ParseCIDR(hs.Subnet)
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("subnet"), hs.Subnet, err.Error()))
+	} else if !ip.Equal(ipNet.IP) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("subnet"), hs.Subnet, fmt.Sprintf("must be the network address of the subnet, %s", ipNet.String())))
 	}
 	if net.ParseIP(hs.HostIP) == nil {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("hostIP"), hs.HostIP, "invalid IP address"))
@@ -94,6 +152,31 @@ func ValidateHostSubnet(hs *sdnapi.HostSubnet) field.ErrorList {
 	return allErrs
 }
 
+// ValidateHostSubnetClusterNetwork ensures that the subnet of the host subnet was allocated from one of
+// the cluster networks, with the host subnet length of that network
+func ValidateHostSubnetClusterNetwork(hs *sdnapi.HostSubnet, clusterNet *sdnapi.ClusterNetwork) field.ErrorList {
+	allErrs := field.ErrorList{}
+	subnetPath := field.NewPath("subnet")
+
+	ip, ipNet, err := net.ParseCIDR(hs.Subnet)
+	if err != nil {
+		// reported by ValidateHostSubnet
+		return allErrs
+	}
+	ones, bitSize := ipNet.Mask.Size()
+	for _, entry := range sdnapi.GetClusterNetworkEntries(clusterNet) {
+		_, clusterIPNet, err := net.ParseCIDR(entry.CIDR)
+		if err != nil || !clusterIPNet.Contains(ip) {
+			continue
+		}
+		if bitSize-ones != entry.HostSubnetLength {
+			allErrs = append(allErrs, field.Invalid(subnetPath, hs.Subnet, fmt.Sprintf("must have a host subnet length of %d to be allocated from cluster network %s", entry.HostSubnetLength, entry.CIDR)))
+		}
+		return allErrs
+	}
+	return append(allErrs, field.Invalid(subnetPath, hs.Subnet, "is not inside any of the cluster networks"))
+}
+
 func ValidateHostSubnetUpdate(obj *sdnapi.HostSubnet, old *sdnapi.HostSubnet) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&obj.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
 
@@ -108,12 +191,34 @@ func ValidateHostSubnetUpdate(obj *sdnapi.HostSubnet, old *sdnapi.HostSubnet) fi
 func ValidateNetNamespace(netnamespace *sdnapi.NetNamespace) field.ErrorList {
 	allErrs := validation.ValidateObjectMeta(&netnamespace.ObjectMeta, false, oapi.MinimalNameRequirements, field.NewPath("metadata"))
 
+	if netnamespace.NetName != netnamespace.Name {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("netName"), netnamespace.NetName, "must be the same as metadata.name"))
+	}
 	if netnamespace.NetID < 0 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("netID"), netnamespace.NetID, "invalid Net ID: cannot be negative"))
+	} else if netnamespace.NetID > sdnapi.MaxNetID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("netID"), netnamespace.NetID, fmt.Sprintf("invalid Net ID: cannot be greater than %d", sdnapi.MaxNetID)))
 	}
+	allErrs = append(allErrs, validateChangePodNetworkAnnotation(netnamespace)...)
 	return allErrs
 }
 
 func ValidateNetNamespaceUpdate(obj *sdnapi.NetNamespace, old *sdnapi.NetNamespace) field.ErrorList {
-	return validation.ValidateObjectMetaUpdate(&obj.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMetaUpdate(&obj.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateChangePodNetworkAnnotation(obj)...)
+	return allErrs
+}
+
+// validateChangePodNetworkAnnotation ensures that a requested pod network change can be understood by the SDN master
+func validateChangePodNetworkAnnotation(netnamespace *sdnapi.NetNamespace) field.ErrorList {
+	allErrs := field.ErrorList{}
+	action, namespace, err := sdnapi.GetChangePodNetworkAnnotation(netnamespace)
+	switch {
+	case err == sdnapi.ErrorPodNetworkAnnotationNotFound:
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(sdnapi.ChangePodNetworkAnnotation), netnamespace.Annotations[sdnapi.ChangePodNetworkAnnotation], err.Error()))
+	case action == sdnapi.JoinPodNetwork && namespace == netnamespace.NetName:
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(sdnapi.ChangePodNetworkAnnotation), netnamespace.Annotations[sdnapi.ChangePodNetworkAnnotation], "a namespace cannot join its own network"))
+	}
+	return allErrs
 }
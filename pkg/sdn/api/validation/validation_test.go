@@ -76,6 +76,62 @@ func TestValidateClusterNetwork(t *testing.T) {
 			},
 			expectedErrors: 1,
 		},
+		{
+			name: "Multiple cluster networks",
+			cn: &api.ClusterNetwork{
+				ObjectMeta:       kapi.ObjectMeta{Name: "any"},
+				Network:          "10.20.0.0/16",
+				HostSubnetLength: 8,
+				ServiceNetwork:   "172.30.0.0/16",
+				ClusterNetworks: []api.ClusterNetworkEntry{
+					{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+					{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Network does not match the first cluster network",
+			cn: &api.ClusterNetwork{
+				ObjectMeta:       kapi.ObjectMeta{Name: "any"},
+				Network:          "10.20.0.0/16",
+				HostSubnetLength: 8,
+				ServiceNetwork:   "172.30.0.0/16",
+				ClusterNetworks: []api.ClusterNetworkEntry{
+					{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+					{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+				},
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "Overlapping cluster networks",
+			cn: &api.ClusterNetwork{
+				ObjectMeta:       kapi.ObjectMeta{Name: "any"},
+				Network:          "10.20.0.0/16",
+				HostSubnetLength: 8,
+				ServiceNetwork:   "172.30.0.0/16",
+				ClusterNetworks: []api.ClusterNetworkEntry{
+					{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+					{CIDR: "10.0.0.0/8", HostSubnetLength: 8},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Invalid subnet length of additional cluster network",
+			cn: &api.ClusterNetwork{
+				ObjectMeta:       kapi.ObjectMeta{Name: "any"},
+				Network:          "10.20.0.0/16",
+				HostSubnetLength: 8,
+				ServiceNetwork:   "172.30.0.0/16",
+				ClusterNetworks: []api.ClusterNetworkEntry{
+					{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+					{CIDR: "10.30.0.0/24", HostSubnetLength: 8},
+				},
+			},
+			expectedErrors: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -87,6 +143,74 @@ func TestValidateClusterNetwork(t *testing.T) {
 	}
 }
 
+func TestValidateClusterNetworkUpdate(t *testing.T) {
+	old := &api.ClusterNetwork{
+		ObjectMeta:       kapi.ObjectMeta{Name: "default", ResourceVersion: "1"},
+		Network:          "10.20.0.0/16",
+		HostSubnetLength: 8,
+		ServiceNetwork:   "172.30.0.0/16",
+	}
+
+	tests := []struct {
+		name           string
+		networks       []api.ClusterNetworkEntry
+		expectedErrors int
+	}{
+		{
+			name:     "Unchanged",
+			networks: []api.ClusterNetworkEntry{{CIDR: "10.20.0.0/16", HostSubnetLength: 8}},
+		},
+		{
+			name:     "Larger network",
+			networks: []api.ClusterNetworkEntry{{CIDR: "10.20.0.0/15", HostSubnetLength: 8}},
+		},
+		{
+			name: "Additional network",
+			networks: []api.ClusterNetworkEntry{
+				{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+				{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+			},
+		},
+		{
+			name: "Existing network moved after a new one",
+			networks: []api.ClusterNetworkEntry{
+				{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+				{CIDR: "10.20.0.0/16", HostSubnetLength: 8},
+			},
+		},
+		{
+			name:           "Smaller network",
+			networks:       []api.ClusterNetworkEntry{{CIDR: "10.20.0.0/17", HostSubnetLength: 8}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Removed network",
+			networks:       []api.ClusterNetworkEntry{{CIDR: "10.128.0.0/14", HostSubnetLength: 8}},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Changed host subnet length",
+			networks:       []api.ClusterNetworkEntry{{CIDR: "10.20.0.0/16", HostSubnetLength: 9}},
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		cn := &api.ClusterNetwork{
+			ObjectMeta:       kapi.ObjectMeta{Name: "default", ResourceVersion: "1"},
+			Network:          tc.networks[0].CIDR,
+			HostSubnetLength: tc.networks[0].HostSubnetLength,
+			ServiceNetwork:   "172.30.0.0/16",
+			ClusterNetworks:  tc.networks,
+		}
+		errs := ValidateClusterNetworkUpdate(cn, old)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
+
 func TestValidateHostSubnet(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -129,6 +253,30 @@ func TestValidateHostSubnet(t *testing.T) {
 			},
 			expectedErrors: 1,
 		},
+		{
+			name: "Subnet is not a network address",
+			hs: &api.HostSubnet{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: "abc.def.com",
+				},
+				Host:   "abc.def.com",
+				HostIP: "10.20.30.40",
+				Subnet: "8.8.8.1/24",
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Host does not match name",
+			hs: &api.HostSubnet{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: "abc.def.com",
+				},
+				Host:   "ghi.def.com",
+				HostIP: "10.20.30.40",
+				Subnet: "8.8.8.0/24",
+			},
+			expectedErrors: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -139,3 +287,129 @@ func TestValidateHostSubnet(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateHostSubnetClusterNetwork(t *testing.T) {
+	clusterNet := &api.ClusterNetwork{
+		ClusterNetworks: []api.ClusterNetworkEntry{
+			{CIDR: "10.128.0.0/14", HostSubnetLength: 9},
+			{CIDR: "10.132.0.0/16", HostSubnetLength: 8},
+		},
+	}
+	tests := []struct {
+		name           string
+		subnet         string
+		expectedErrors int
+	}{
+		{
+			name:           "First cluster network",
+			subnet:         "10.129.2.0/23",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Second cluster network",
+			subnet:         "10.132.4.0/24",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Wrong host subnet length",
+			subnet:         "10.132.4.0/23",
+			expectedErrors: 1,
+		},
+		{
+			name:           "Outside of the cluster networks",
+			subnet:         "10.140.0.0/23",
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		hs := &api.HostSubnet{
+			ObjectMeta: kapi.ObjectMeta{Name: "abc.def.com"},
+			Host:       "abc.def.com",
+			HostIP:     "10.20.30.40",
+			Subnet:     tc.subnet,
+		}
+		errs := ValidateHostSubnetClusterNetwork(hs, clusterNet)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
+
+func TestValidateNetNamespace(t *testing.T) {
+	tests := []struct {
+		name           string
+		netName        string
+		netID          uint
+		annotation     string
+		expectedErrors int
+	}{
+		{
+			name:           "No change requested",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Largest net ID",
+			netID:          api.MaxNetID,
+			expectedErrors: 0,
+		},
+		{
+			name:           "Net ID out of range",
+			netID:          api.MaxNetID + 1,
+			expectedErrors: 1,
+		},
+		{
+			name:           "Net name does not match name",
+			netName:        "def",
+			expectedErrors: 1,
+		},
+		{
+			name:           "Join another namespace",
+			annotation:     "join:other",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Make global",
+			annotation:     "global",
+			expectedErrors: 0,
+		},
+		{
+			name:           "Join without a namespace",
+			annotation:     "join",
+			expectedErrors: 1,
+		},
+		{
+			name:           "Join itself",
+			annotation:     "join:abc",
+			expectedErrors: 1,
+		},
+		{
+			name:           "Unknown action",
+			annotation:     "merge:other",
+			expectedErrors: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		netns := &api.NetNamespace{
+			ObjectMeta: kapi.ObjectMeta{Name: "abc"},
+			NetName:    "abc",
+			NetID:      12,
+		}
+		if len(tc.netName) > 0 {
+			netns.NetName = tc.netName
+		}
+		if tc.netID > 0 {
+			netns.NetID = tc.netID
+		}
+		if len(tc.annotation) > 0 {
+			netns.Annotations = map[string]string{api.ChangePodNetworkAnnotation: tc.annotation}
+		}
+		errs := ValidateNetNamespace(netns)
+
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
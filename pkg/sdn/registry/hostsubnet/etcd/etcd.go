@@ -20,8 +20,9 @@ type REST struct {
 
 const etcdPrefix = "/registry/sdnsubnets"
 
-// NewREST returns a RESTStorage object that will work against subnets
-func NewREST(s storage.Interface) *REST {
+// NewREST returns a RESTStorage object that will work against subnets. New subnets are
+// checked against the cluster network of clusterNetworks.
+func NewREST(s storage.Interface, clusterNetworks hostsubnet.ClusterNetworkGetter) *REST {
 	store := &etcdgeneric.Etcd{
 		NewFunc:     func() runtime.Object { return &api.HostSubnet{} },
 		NewListFunc: func() runtime.Object { return &api.HostSubnetList{} },
@@ -42,8 +43,9 @@ func NewREST(s storage.Interface) *REST {
 		Storage: s,
 	}
 
-	store.CreateStrategy = hostsubnet.Strategy
-	store.UpdateStrategy = hostsubnet.Strategy
+	strategy := hostsubnet.NewStrategy(clusterNetworks)
+	store.CreateStrategy = strategy
+	store.UpdateStrategy = strategy
 
 	return &REST{*store}
 }
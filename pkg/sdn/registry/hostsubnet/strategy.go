@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
@@ -14,14 +15,27 @@ import (
 	"github.com/openshift/origin/pkg/sdn/api/validation"
 )
 
+// ClusterNetworkGetter gets the cluster network that host subnets are allocated from
+type ClusterNetworkGetter interface {
+	GetClusterNetwork(ctx kapi.Context, name string) (*api.ClusterNetwork, error)
+}
+
 // sdnStrategy implements behavior for HostSubnets
 type sdnStrategy struct {
 	runtime.ObjectTyper
+	clusterNetworks ClusterNetworkGetter
 }
 
 // Strategy is the default logic that applies when creating and updating HostSubnet
 // objects via the REST API.
-var Strategy = sdnStrategy{kapi.Scheme}
+var Strategy = sdnStrategy{kapi.Scheme, nil}
+
+// NewStrategy returns the logic that applies when creating and updating HostSubnet
+// objects via the REST API, which also checks that new host subnets were allocated
+// from the cluster network.
+func NewStrategy(clusterNetworks ClusterNetworkGetter) sdnStrategy {
+	return sdnStrategy{kapi.Scheme, clusterNetworks}
+}
 
 func (sdnStrategy) PrepareForUpdate(obj, old runtime.Object) {}
 
@@ -42,8 +56,22 @@ func (sdnStrategy) PrepareForCreate(obj runtime.Object) {
 }
 
 // Validate validates a new sdn
-func (sdnStrategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
-	return validation.ValidateHostSubnet(obj.(*api.HostSubnet))
+func (s sdnStrategy) Validate(ctx kapi.Context, obj runtime.Object) field.ErrorList {
+	hs := obj.(*api.HostSubnet)
+	allErrs := validation.ValidateHostSubnet(hs)
+	if len(allErrs) > 0 || s.clusterNetworks == nil {
+		return allErrs
+	}
+	clusterNetwork, err := s.clusterNetworks.GetClusterNetwork(ctx, api.ClusterNetworkDefault)
+	switch {
+	case kerrors.IsNotFound(err):
+		// the cluster network is only created by the SDN plugins
+	case err != nil:
+		allErrs = append(allErrs, field.InternalError(field.NewPath("subnet"), err))
+	default:
+		allErrs = append(allErrs, validation.ValidateHostSubnetClusterNetwork(hs, clusterNetwork)...)
+	}
+	return allErrs
 }
 
 // AllowCreateOnUpdate is false for sdns
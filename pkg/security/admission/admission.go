@@ -3,20 +3,15 @@ package admission
 import (
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 
 	kadmission "k8s.io/kubernetes/pkg/admission"
 	kapi "k8s.io/kubernetes/pkg/api"
-	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/client/cache"
 	client "k8s.io/kubernetes/pkg/client/unversioned"
-	"k8s.io/kubernetes/pkg/controller/serviceaccount"
-	"k8s.io/kubernetes/pkg/runtime"
 	sc "k8s.io/kubernetes/pkg/securitycontext"
 	scc "k8s.io/kubernetes/pkg/securitycontextconstraints"
 	"k8s.io/kubernetes/pkg/util/sets"
-	"k8s.io/kubernetes/pkg/watch"
 
 	allocator "github.com/openshift/origin/pkg/security"
 	"github.com/openshift/origin/pkg/security/uid"
@@ -38,36 +33,25 @@ type constraint struct {
 	*kadmission.Handler
 	client client.Interface
 
-	reflector *cache.Reflector
-	stopChan  chan struct{}
-	store     cache.Store
+	reflector  *cache.Reflector
+	stopChan   chan struct{}
+	store      cache.Store
+	sccMatcher SCCMatcher
 }
 
 var _ kadmission.Interface = &constraint{}
 
 // NewConstraint creates a new SCC constraint admission plugin.
 func NewConstraint(kclient client.Interface) *constraint {
-	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
-	reflector := cache.NewReflector(
-		&cache.ListWatch{
-			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
-				return kclient.SecurityContextConstraints().List(options)
-			},
-			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
-				return kclient.SecurityContextConstraints().Watch(options)
-			},
-		},
-		&kapi.SecurityContextConstraints{},
-		store,
-		0,
-	)
+	sccCache := NewSCCCache(kclient)
 
 	return &constraint{
 		Handler: kadmission.NewHandler(kadmission.Create),
 		client:  kclient,
 
-		store:     store,
-		reflector: reflector,
+		store:      sccCache.store,
+		reflector:  sccCache.reflector,
+		sccMatcher: sccCache.Matcher(),
 	}
 }
 
@@ -88,8 +72,8 @@ func (a *constraint) Stop() {
 // and the available SCCs.
 //
 // 1.  Find SCCs for the user.
-// 2.  Find SCCs for the SA.  If there is an error retrieving SA SCCs it is not fatal.
-// 3.  Remove duplicates between the user/SA SCCs.
+// 2.  Find SCCs for the SA in the pod's namespace.
+// 3.  Remove duplicates between the user/SA SCCs and sort them by priority.
 // 4.  Create the providers, includes setting pre-allocated values if necessary.
 // 5.  Try to generate and validate an SCC with providers.  If we find one then admit the pod
 //     with the validated SCC.  If we don't find any reject the pod and give all errors from the
@@ -105,27 +89,13 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 		return nil
 	}
 
-	// get all constraints that are usable by the user
+	// get all constraints that are usable by the user or the SA, without duplicates and in priority order
 	glog.V(4).Infof("getting security context constraints for pod %s (generate: %s) in namespace %s with user info %v", pod.Name, pod.GenerateName, a.GetNamespace(), a.GetUserInfo())
-	matchedConstraints, err := getMatchingSecurityContextConstraints(c.store, a.GetUserInfo())
+	matchedConstraints, err := FindPodSCCs(c.sccMatcher, a.GetUserInfo(), a.GetNamespace(), pod.Spec.ServiceAccountName)
 	if err != nil {
 		return kadmission.NewForbidden(a, err)
 	}
 
-	// get all constraints that are usable by the SA
-	if len(pod.Spec.ServiceAccountName) > 0 {
-		userInfo := serviceaccount.UserInfo(a.GetNamespace(), pod.Spec.ServiceAccountName, "")
-		glog.V(4).Infof("getting security context constraints for pod %s (generate: %s) with service account info %v", pod.Name, pod.GenerateName, userInfo)
-		saConstraints, err := getMatchingSecurityContextConstraints(c.store, userInfo)
-		if err != nil {
-			return kadmission.NewForbidden(a, err)
-		}
-		matchedConstraints = append(matchedConstraints, saConstraints...)
-	}
-
-	// remove duplicate constraints and sort
-	matchedConstraints = deduplicateSecurityContextConstraints(matchedConstraints)
-	sort.Sort(ByPriority(matchedConstraints))
 	providers, errs := c.createProvidersFromConstraints(a.GetNamespace(), matchedConstraints)
 	logProviders(pod, providers, errs)
 
@@ -136,7 +106,7 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 	// all containers in a single pod must validate under a single provider or we will reject the request
 	validationErrs := field.ErrorList{}
 	for _, provider := range providers {
-		if errs := assignSecurityContext(provider, pod, field.NewPath(fmt.Sprintf("provider %s: ", provider.GetSCCName()))); len(errs) > 0 {
+		if errs := AssignSecurityContext(provider, pod, field.NewPath(fmt.Sprintf("provider %s: ", provider.GetSCCName()))); len(errs) > 0 {
 			validationErrs = append(validationErrs, errs...)
 			continue
 		}
@@ -155,10 +125,10 @@ func (c *constraint) Admit(a kadmission.Attributes) error {
 	return kadmission.NewForbidden(a, fmt.Errorf("unable to validate against any security context constraint: %v", validationErrs))
 }
 
-// assignSecurityContext creates a security context for each container in the pod
+// AssignSecurityContext creates a security context for each container in the pod
 // and validates that the sc falls within the scc constraints.  All containers must validate against
 // the same scc or is not considered valid.
-func assignSecurityContext(provider scc.SecurityContextConstraintsProvider, pod *kapi.Pod, fldPath *field.Path) field.ErrorList {
+func AssignSecurityContext(provider scc.SecurityContextConstraintsProvider, pod *kapi.Pod, fldPath *field.Path) field.ErrorList {
 	generatedSCs := make([]*kapi.SecurityContext, len(pod.Spec.Containers))
 
 	errs := field.ErrorList{}
@@ -212,6 +182,12 @@ func assignSecurityContext(provider scc.SecurityContextConstraintsProvider, pod
 // createProvidersFromConstraints creates providers from the constraints supplied, including
 // looking up pre-allocated values if necessary using the pod's namespace.
 func (c *constraint) createProvidersFromConstraints(ns string, sccs []*kapi.SecurityContextConstraints) ([]scc.SecurityContextConstraintsProvider, []error) {
+	return CreateProvidersFromConstraints(ns, sccs, c.client)
+}
+
+// CreateProvidersFromConstraints creates providers from the constraints supplied, including
+// looking up pre-allocated values if necessary using the namespace ns retrieved with client.
+func CreateProvidersFromConstraints(ns string, sccs []*kapi.SecurityContextConstraints, client client.Interface) ([]scc.SecurityContextConstraintsProvider, []error) {
 	var (
 		// namespace is declared here for reuse but we will not fetch it unless required by the matched constraints
 		namespace *kapi.Namespace
@@ -232,7 +208,7 @@ func (c *constraint) createProvidersFromConstraints(ns string, sccs []*kapi.Secu
 
 		if requiresNamespaceAllocations {
 			// Ensure we have the namespace
-			namespace, err = c.getNamespace(ns, namespace)
+			namespace, err = getNamespace(client, ns, namespace)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("error fetching namespace %s required to preallocate values for %s: %v", ns, constraint.Name, err))
 				continue
@@ -297,29 +273,11 @@ func (c *constraint) createProvidersFromConstraints(ns string, sccs []*kapi.Secu
 }
 
 // getNamespace retrieves a namespace only if ns is nil.
-func (c *constraint) getNamespace(name string, ns *kapi.Namespace) (*kapi.Namespace, error) {
+func getNamespace(client client.Interface, name string, ns *kapi.Namespace) (*kapi.Namespace, error) {
 	if ns != nil && name == ns.Name {
 		return ns, nil
 	}
-	return c.client.Namespaces().Get(name)
-}
-
-// getMatchingSecurityContextConstraints returns constraints from the store that match the group,
-// uid, or user of the service account.
-func getMatchingSecurityContextConstraints(store cache.Store, userInfo user.Info) ([]*kapi.SecurityContextConstraints, error) {
-	matchedConstraints := make([]*kapi.SecurityContextConstraints, 0)
-
-	for _, c := range store.List() {
-		constraint, ok := c.(*kapi.SecurityContextConstraints)
-		if !ok {
-			return nil, errors.NewInternalError(fmt.Errorf("error converting object from store to a security context constraint: %v", c))
-		}
-		if ConstraintAppliesTo(constraint, userInfo) {
-			matchedConstraints = append(matchedConstraints, constraint)
-		}
-	}
-
-	return matchedConstraints, nil
+	return client.Namespaces().Get(name)
 }
 
 // constraintAppliesTo inspects the constraint's users and groups against the userInfo to determine
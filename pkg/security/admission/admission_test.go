@@ -22,9 +22,10 @@ import (
 
 func NewTestAdmission(store cache.Store, kclient client.Interface) kadmission.Interface {
 	return &constraint{
-		Handler: kadmission.NewHandler(kadmission.Create),
-		client:  kclient,
-		store:   store,
+		Handler:    kadmission.NewHandler(kadmission.Create),
+		client:     kclient,
+		store:      store,
+		sccMatcher: NewDefaultSCCMatcher(store),
 	}
 }
 
@@ -554,7 +555,7 @@ func TestAssignSecurityContext(t *testing.T) {
 	}
 
 	for k, v := range testCases {
-		errs := assignSecurityContext(provider, v.pod, nil)
+		errs := AssignSecurityContext(provider, v.pod, nil)
 		if v.shouldValidate && len(errs) > 0 {
 			t.Errorf("%s expected to validate but received errors %v", k, errs)
 			continue
@@ -0,0 +1,121 @@
+package admission
+
+import (
+	"fmt"
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/client/cache"
+	client "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+// SCCMatcher finds the security context constraints that a user may use.
+type SCCMatcher interface {
+	FindApplicableSCCs(userInfo user.Info) ([]*kapi.SecurityContextConstraints, error)
+}
+
+// storeSCCMatcher matches against the constraints held in a cache.Store.
+type storeSCCMatcher struct {
+	store cache.Store
+}
+
+// NewDefaultSCCMatcher returns an SCCMatcher that matches the constraints in store.
+func NewDefaultSCCMatcher(store cache.Store) SCCMatcher {
+	return &storeSCCMatcher{store: store}
+}
+
+// FindApplicableSCCs returns the constraints from the store that match the name or groups of userInfo.
+func (m *storeSCCMatcher) FindApplicableSCCs(userInfo user.Info) ([]*kapi.SecurityContextConstraints, error) {
+	return getMatchingSecurityContextConstraints(m.store, userInfo)
+}
+
+// SCCCache keeps the constraints listed and watched from the server, like the admission plugin does, so
+// that matching them does not need a request to the server.
+type SCCCache struct {
+	store     cache.Store
+	reflector *cache.Reflector
+}
+
+// NewSCCCache returns a cache of the constraints listed and watched with kclient.  It is empty until it runs.
+func NewSCCCache(kclient client.Interface) *SCCCache {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	reflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return kclient.SecurityContextConstraints().List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return kclient.SecurityContextConstraints().Watch(options)
+			},
+		},
+		&kapi.SecurityContextConstraints{},
+		store,
+		0,
+	)
+	return &SCCCache{store: store, reflector: reflector}
+}
+
+// Run begins watching and synchronizing the cache
+func (c *SCCCache) Run() {
+	c.reflector.Run()
+}
+
+// RunUntil starts a goroutine that synchronizes the cache and returns immediately.  It will exit when
+// stopChannel is closed.
+func (c *SCCCache) RunUntil(stopChannel <-chan struct{}) {
+	c.reflector.RunUntil(stopChannel)
+}
+
+// Matcher returns an SCCMatcher that matches the constraints in the cache.
+func (c *SCCCache) Matcher() SCCMatcher {
+	return NewDefaultSCCMatcher(c.store)
+}
+
+// FindPodSCCs returns the constraints usable by a pod created in namespace by userInfo.  The constraints
+// usable by the pod's service account in that namespace are included.  The result has no duplicates and is
+// sorted by priority, restrictiveness, and name, so it does not depend on the order the constraints were listed in.
+func FindPodSCCs(matcher SCCMatcher, userInfo user.Info, namespace, serviceAccountName string) ([]*kapi.SecurityContextConstraints, error) {
+	matchedConstraints, err := matcher.FindApplicableSCCs(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serviceAccountName) > 0 {
+		saUserInfo := serviceaccount.UserInfo(namespace, serviceAccountName, "")
+		glog.V(4).Infof("getting security context constraints for service account info %v", saUserInfo)
+		saConstraints, err := matcher.FindApplicableSCCs(saUserInfo)
+		if err != nil {
+			return nil, err
+		}
+		matchedConstraints = append(matchedConstraints, saConstraints...)
+	}
+
+	matchedConstraints = deduplicateSecurityContextConstraints(matchedConstraints)
+	sort.Sort(ByPriority(matchedConstraints))
+	return matchedConstraints, nil
+}
+
+// getMatchingSecurityContextConstraints returns constraints from the store that match the group,
+// uid, or user of the service account.
+func getMatchingSecurityContextConstraints(store cache.Store, userInfo user.Info) ([]*kapi.SecurityContextConstraints, error) {
+	matchedConstraints := make([]*kapi.SecurityContextConstraints, 0)
+
+	for _, c := range store.List() {
+		constraint, ok := c.(*kapi.SecurityContextConstraints)
+		if !ok {
+			return nil, errors.NewInternalError(fmt.Errorf("error converting object from store to a security context constraint: %v", c))
+		}
+		if ConstraintAppliesTo(constraint, userInfo) {
+			matchedConstraints = append(matchedConstraints, constraint)
+		}
+	}
+
+	return matchedConstraints, nil
+}
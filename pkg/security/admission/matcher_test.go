@@ -0,0 +1,79 @@
+package admission
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/auth/user"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+func TestFindPodSCCs(t *testing.T) {
+	priority := 10
+	sccs := []*kapi.SecurityContextConstraints{
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "custom"},
+			Priority:   &priority,
+			Groups:     []string{"system:serviceaccounts:myproject"},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "restricted"},
+			Groups:     []string{"system:authenticated"},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "anyuid"},
+			Users:      []string{"system:serviceaccount:myproject:builder"},
+			RunAsUser:  kapi.RunAsUserStrategyOptions{Type: kapi.RunAsUserStrategyRunAsAny},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Name: "other-project"},
+			Users:      []string{"system:serviceaccount:otherproject:builder"},
+		},
+	}
+	userInfo := &user.DefaultInfo{Name: "alice", Groups: []string{"system:authenticated"}}
+
+	testCases := map[string]struct {
+		namespace      string
+		serviceAccount string
+		expected       []string
+	}{
+		"user only": {
+			namespace: "myproject",
+			expected:  []string{"restricted"},
+		},
+		"user and service account": {
+			namespace:      "myproject",
+			serviceAccount: "builder",
+			expected:       []string{"custom", "restricted", "anyuid"},
+		},
+		"service account in another namespace": {
+			namespace:      "thirdproject",
+			serviceAccount: "builder",
+			expected:       []string{"restricted"},
+		},
+	}
+
+	for k, tc := range testCases {
+		// the result must not depend on the order the constraints were added in
+		for _, order := range [][]int{{0, 1, 2, 3}, {3, 2, 1, 0}, {1, 3, 0, 2}} {
+			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			for _, i := range order {
+				store.Add(sccs[i])
+			}
+
+			matched, err := FindPodSCCs(NewDefaultSCCMatcher(store), userInfo, tc.namespace, tc.serviceAccount)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", k, err)
+				continue
+			}
+			names := []string{}
+			for _, scc := range matched {
+				names = append(names, scc.Name)
+			}
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("%s: adding in order %v, expected %v, got %v", k, order, tc.expected, names)
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// PodSecurityPolicySubjectReview checks whether a particular user/group/service account tuple can create
+// the PodTemplateSpec in the current namespace.
+type PodSecurityPolicySubjectReview struct {
+	unversioned.TypeMeta
+
+	// Spec defines the pod template, user, and groups to check
+	Spec PodSecurityPolicySubjectReviewSpec
+
+	// Status contains the result of the check
+	Status PodSecurityPolicySubjectReviewStatus
+}
+
+// PodSecurityPolicySubjectReviewSpec defines the specification for PodSecurityPolicySubjectReview
+type PodSecurityPolicySubjectReviewSpec struct {
+	// Template is the PodTemplateSpec to check.  If Template.Spec.ServiceAccountName is set, the
+	// constraints usable by that service account are checked as well.
+	Template kapi.PodTemplateSpec
+
+	// User is the user to check.  If User and Groups are empty, only the service account is checked.
+	User string
+
+	// Groups are the groups the user belongs to
+	Groups []string
+}
+
+// PodSecurityPolicySubjectReviewStatus contains the result of a check against the security context constraints
+type PodSecurityPolicySubjectReviewStatus struct {
+	// AllowedBy is a reference to the constraint that allows the PodTemplateSpec.  It is nil if no
+	// constraint allows it.
+	AllowedBy *kapi.ObjectReference
+
+	// Reason is set when no constraint allows the PodTemplateSpec and explains why each one rejected it
+	Reason string
+
+	// Template is the PodTemplateSpec with the security context defaults of the allowing constraint applied
+	Template kapi.PodTemplateSpec
+}
+
+// PodSecurityPolicyReview checks which service accounts in the current namespace can create the PodTemplateSpec.
+type PodSecurityPolicyReview struct {
+	unversioned.TypeMeta
+
+	// Spec defines the pod template and service accounts to check
+	Spec PodSecurityPolicyReviewSpec
+
+	// Status contains the result of the check for each service account
+	Status PodSecurityPolicyReviewStatus
+}
+
+// PodSecurityPolicyReviewSpec defines the specification for PodSecurityPolicyReview
+type PodSecurityPolicyReviewSpec struct {
+	// Template is the PodTemplateSpec to check
+	Template kapi.PodTemplateSpec
+
+	// ServiceAccountNames are the service accounts to check.  If empty, the service account named in
+	// Template.Spec.ServiceAccountName is checked, or every service account in the namespace if that is empty too.
+	ServiceAccountNames []string
+}
+
+// PodSecurityPolicyReviewStatus contains the result of a PodSecurityPolicyReview
+type PodSecurityPolicyReviewStatus struct {
+	// AllowedServiceAccounts lists the service accounts that can create the PodTemplateSpec, and the constraint
+	// that allows each of them
+	AllowedServiceAccounts []ServiceAccountPodSecurityPolicyReviewStatus
+}
+
+// ServiceAccountPodSecurityPolicyReviewStatus is the result of the check for a single service account
+type ServiceAccountPodSecurityPolicyReviewStatus struct {
+	PodSecurityPolicySubjectReviewStatus
+
+	// Name is the name of the service account
+	Name string
+}
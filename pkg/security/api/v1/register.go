@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// SchemeGroupVersion is group version used to register these objects
+var SchemeGroupVersion = unversioned.GroupVersion{Group: "", Version: "v1"}
+
+func init() {
+	api.Scheme.AddKnownTypes(SchemeGroupVersion,
+		&PodSecurityPolicySubjectReview{},
+		&PodSecurityPolicyReview{},
+	)
+}
+
+func (*PodSecurityPolicySubjectReview) IsAnAPIObject() {}
+func (*PodSecurityPolicyReview) IsAnAPIObject()        {}
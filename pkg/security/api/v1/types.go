@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kapi "k8s.io/kubernetes/pkg/api/v1"
+)
+
+// PodSecurityPolicySubjectReview checks whether a particular user/group/service account tuple can create
+// the PodTemplateSpec in the current namespace.
+type PodSecurityPolicySubjectReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec defines the pod template, user, and groups to check
+	Spec PodSecurityPolicySubjectReviewSpec `json:"spec" description:"the pod template, user, and groups to check"`
+
+	// Status contains the result of the check
+	Status PodSecurityPolicySubjectReviewStatus `json:"status,omitempty" description:"the result of the check"`
+}
+
+// PodSecurityPolicySubjectReviewSpec defines the specification for PodSecurityPolicySubjectReview
+type PodSecurityPolicySubjectReviewSpec struct {
+	// Template is the PodTemplateSpec to check.  If Template.Spec.ServiceAccountName is set, the
+	// constraints usable by that service account are checked as well.
+	Template kapi.PodTemplateSpec `json:"template" description:"the pod template to check, the constraints usable by its service account are checked as well"`
+
+	// User is the user to check.  If User and Groups are empty, only the service account is checked.
+	User string `json:"user,omitempty" description:"the user to check, if user and groups are empty only the service account is checked"`
+
+	// Groups are the groups the user belongs to
+	Groups []string `json:"groups,omitempty" description:"the groups the user belongs to"`
+}
+
+// PodSecurityPolicySubjectReviewStatus contains the result of a check against the security context constraints
+type PodSecurityPolicySubjectReviewStatus struct {
+	// AllowedBy is a reference to the constraint that allows the PodTemplateSpec.  It is nil if no
+	// constraint allows it.
+	AllowedBy *kapi.ObjectReference `json:"allowedBy,omitempty" description:"the security context constraint that allows the pod template, unset if none does"`
+
+	// Reason is set when no constraint allows the PodTemplateSpec and explains why each one rejected it
+	Reason string `json:"reason,omitempty" description:"why no security context constraint allows the pod template"`
+
+	// Template is the PodTemplateSpec with the security context defaults of the allowing constraint applied
+	Template kapi.PodTemplateSpec `json:"template,omitempty" description:"the pod template with the defaults of the allowing security context constraint applied"`
+}
+
+// PodSecurityPolicyReview checks which service accounts in the current namespace can create the PodTemplateSpec.
+type PodSecurityPolicyReview struct {
+	unversioned.TypeMeta `json:",inline"`
+
+	// Spec defines the pod template and service accounts to check
+	Spec PodSecurityPolicyReviewSpec `json:"spec" description:"the pod template and service accounts to check"`
+
+	// Status contains the result of the check for each service account
+	Status PodSecurityPolicyReviewStatus `json:"status,omitempty" description:"the result of the check for each service account"`
+}
+
+// PodSecurityPolicyReviewSpec defines the specification for PodSecurityPolicyReview
+type PodSecurityPolicyReviewSpec struct {
+	// Template is the PodTemplateSpec to check
+	Template kapi.PodTemplateSpec `json:"template" description:"the pod template to check"`
+
+	// ServiceAccountNames are the service accounts to check.  If empty, the service account named in
+	// Template.Spec.ServiceAccountName is checked, or every service account in the namespace if that is empty too.
+	ServiceAccountNames []string `json:"serviceAccountNames,omitempty" description:"the service accounts to check, defaults to the service account of the pod template or all service accounts in the namespace"`
+}
+
+// PodSecurityPolicyReviewStatus contains the result of a PodSecurityPolicyReview
+type PodSecurityPolicyReviewStatus struct {
+	// AllowedServiceAccounts lists the service accounts that can create the PodTemplateSpec, and the constraint
+	// that allows each of them
+	AllowedServiceAccounts []ServiceAccountPodSecurityPolicyReviewStatus `json:"allowedServiceAccounts" description:"the service accounts that can create the pod template"`
+}
+
+// ServiceAccountPodSecurityPolicyReviewStatus is the result of the check for a single service account
+type ServiceAccountPodSecurityPolicyReviewStatus struct {
+	PodSecurityPolicySubjectReviewStatus `json:",inline"`
+
+	// Name is the name of the service account
+	Name string `json:"name" description:"the name of the service account"`
+}
@@ -0,0 +1,26 @@
+package validation
+
+import (
+	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	securityapi "github.com/openshift/origin/pkg/security/api"
+)
+
+// ValidatePodSecurityPolicySubjectReview validates a PodSecurityPolicySubjectReview
+func ValidatePodSecurityPolicySubjectReview(review *securityapi.PodSecurityPolicySubjectReview) field.ErrorList {
+	specPath := field.NewPath("spec")
+	return validation.ValidatePodSpec(&review.Spec.Template.Spec, specPath.Child("template", "spec"))
+}
+
+// ValidatePodSecurityPolicyReview validates a PodSecurityPolicyReview
+func ValidatePodSecurityPolicyReview(review *securityapi.PodSecurityPolicyReview) field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := validation.ValidatePodSpec(&review.Spec.Template.Spec, specPath.Child("template", "spec"))
+	for i, name := range review.Spec.ServiceAccountNames {
+		if ok, msg := validation.ValidateServiceAccountName(name, false); !ok {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("serviceAccountNames").Index(i), name, msg))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/security"
+	"github.com/openshift/origin/pkg/security/mcs"
+	"github.com/openshift/origin/pkg/security/uid"
+)
+
+// AllocationConflict describes a namespace whose security allocation cannot be trusted, either because it
+// overlaps the allocation of an older namespace or because it cannot be parsed.
+type AllocationConflict struct {
+	// Namespace is the namespace that should be reallocated
+	Namespace string
+	// ConflictsWith is the older namespace that keeps the allocation, empty if the allocation is invalid
+	ConflictsWith string
+	// Reason is a human readable description of the conflict
+	Reason string
+}
+
+// FindAllocationConflicts returns the namespaces whose UID range or MCS label overlaps the allocation of a
+// namespace created before them, or whose allocation annotations are invalid.  The oldest namespace always
+// keeps its allocation, so reallocating the returned namespaces resolves every conflict.
+func FindAllocationConflicts(namespaces []kapi.Namespace) []AllocationConflict {
+	sorted := make([]kapi.Namespace, len(namespaces))
+	copy(sorted, namespaces)
+	sort.Sort(byCreationTimestamp(sorted))
+
+	type allocatedBlock struct {
+		block     uid.Block
+		namespace string
+	}
+	blocks := []allocatedBlock{}
+	labels := map[string]string{}
+
+	conflicts := []AllocationConflict{}
+	for _, ns := range sorted {
+		var block *uid.Block
+		if value, ok := ns.Annotations[security.UIDRangeAnnotation]; ok {
+			parsed, err := uid.ParseBlock(value)
+			if err != nil {
+				conflicts = append(conflicts, AllocationConflict{Namespace: ns.Name, Reason: fmt.Sprintf("invalid UID range %q: %v", value, err)})
+				continue
+			}
+			block = &parsed
+		}
+		var label string
+		if value, ok := ns.Annotations[security.MCSAnnotation]; ok {
+			parsed, err := mcs.ParseLabel(value)
+			if err != nil {
+				conflicts = append(conflicts, AllocationConflict{Namespace: ns.Name, Reason: fmt.Sprintf("invalid MCS label %q: %v", value, err)})
+				continue
+			}
+			label = parsed.String()
+		}
+
+		var conflict *AllocationConflict
+		if block != nil {
+			for _, allocated := range blocks {
+				if block.Start <= allocated.block.End && allocated.block.Start <= block.End {
+					conflict = &AllocationConflict{Namespace: ns.Name, ConflictsWith: allocated.namespace, Reason: fmt.Sprintf("UID range %s overlaps %s", block, allocated.block)}
+					break
+				}
+			}
+		}
+		if conflict == nil && len(label) > 0 {
+			if owner, ok := labels[label]; ok {
+				conflict = &AllocationConflict{Namespace: ns.Name, ConflictsWith: owner, Reason: fmt.Sprintf("MCS label %s is already allocated", label)}
+			}
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+			continue
+		}
+
+		if block != nil {
+			blocks = append(blocks, allocatedBlock{block: *block, namespace: ns.Name})
+		}
+		if len(label) > 0 {
+			labels[label] = ns.Name
+		}
+	}
+	return conflicts
+}
+
+// ClearAllocation removes the security allocation annotations from the namespace so that the allocation
+// controller assigns it a new UID range, supplemental groups, and MCS label.
+func ClearAllocation(ns *kapi.Namespace) {
+	delete(ns.Annotations, security.UIDRangeAnnotation)
+	delete(ns.Annotations, security.SupplementalGroupsAnnotation)
+	delete(ns.Annotations, security.MCSAnnotation)
+}
+
+// byCreationTimestamp sorts namespaces from oldest to newest, using the name to break ties
+type byCreationTimestamp []kapi.Namespace
+
+func (s byCreationTimestamp) Len() int      { return len(s) }
+func (s byCreationTimestamp) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byCreationTimestamp) Less(i, j int) bool {
+	if s[i].CreationTimestamp.Equal(s[j].CreationTimestamp) {
+		return s[i].Name < s[j].Name
+	}
+	return s[i].CreationTimestamp.Before(s[j].CreationTimestamp)
+}
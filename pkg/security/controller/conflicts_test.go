@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/security"
+)
+
+func allocatedNamespace(name string, age time.Duration, uidRange, mcsLabel string) kapi.Namespace {
+	ns := kapi.Namespace{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: unversioned.NewTime(time.Unix(1000000, 0).Add(-age)),
+			Annotations:       map[string]string{},
+		},
+	}
+	if len(uidRange) > 0 {
+		ns.Annotations[security.UIDRangeAnnotation] = uidRange
+		ns.Annotations[security.SupplementalGroupsAnnotation] = uidRange
+	}
+	if len(mcsLabel) > 0 {
+		ns.Annotations[security.MCSAnnotation] = mcsLabel
+	}
+	return ns
+}
+
+func TestFindAllocationConflicts(t *testing.T) {
+	testCases := map[string]struct {
+		namespaces []kapi.Namespace
+		expected   []string
+	}{
+		"no conflicts": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("a", 2*time.Hour, "10/5", "s0:c1,c0"),
+				allocatedNamespace("b", time.Hour, "15/5", "s0:c2,c0"),
+				allocatedNamespace("unallocated", time.Hour, "", ""),
+			},
+			expected: []string{},
+		},
+		"same UID range keeps the oldest": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("newer", time.Hour, "10/5", "s0:c2,c0"),
+				allocatedNamespace("older", 2*time.Hour, "10/5", "s0:c1,c0"),
+			},
+			expected: []string{"newer"},
+		},
+		"overlapping UID ranges": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("a", 2*time.Hour, "10/5", "s0:c1,c0"),
+				allocatedNamespace("b", time.Hour, "12-20", "s0:c2,c0"),
+			},
+			expected: []string{"b"},
+		},
+		"equivalent MCS labels": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("a", 2*time.Hour, "10/5", "s0:c1,c0"),
+				allocatedNamespace("b", time.Hour, "15/5", "s0:c0,c1"),
+			},
+			expected: []string{"b"},
+		},
+		"reallocated namespace frees its range": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("a", 3*time.Hour, "10/5", "s0:c1,c0"),
+				allocatedNamespace("b", 2*time.Hour, "15/5", "s0:c1,c0"),
+				allocatedNamespace("c", time.Hour, "15/5", "s0:c2,c0"),
+			},
+			expected: []string{"b"},
+		},
+		"invalid allocations": {
+			namespaces: []kapi.Namespace{
+				allocatedNamespace("a", 2*time.Hour, "ten", ""),
+				allocatedNamespace("b", time.Hour, "", "s0:x1"),
+			},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for k, tc := range testCases {
+		conflicts := FindAllocationConflicts(tc.namespaces)
+		actual := []string{}
+		for _, conflict := range conflicts {
+			actual = append(actual, conflict.Namespace)
+			if len(conflict.Reason) == 0 {
+				t.Errorf("%s: expected a reason for %s", k, conflict.Namespace)
+			}
+		}
+		if !reflect.DeepEqual(tc.expected, actual) {
+			t.Errorf("%s: expected conflicts %v, got %v", k, tc.expected, actual)
+		}
+	}
+}
+
+func TestClearAllocation(t *testing.T) {
+	ns := allocatedNamespace("a", 0, "10/5", "s0:c1,c0")
+	ns.Annotations["other"] = "value"
+	ClearAllocation(&ns)
+	if !reflect.DeepEqual(map[string]string{"other": "value"}, ns.Annotations) {
+		t.Errorf("unexpected annotations: %v", ns.Annotations)
+	}
+}
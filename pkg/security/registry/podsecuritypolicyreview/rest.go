@@ -0,0 +1,99 @@
+package podsecuritypolicyreview
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	oscc "github.com/openshift/origin/pkg/security/admission"
+	securityapi "github.com/openshift/origin/pkg/security/api"
+	securityvalidation "github.com/openshift/origin/pkg/security/api/validation"
+	"github.com/openshift/origin/pkg/security/registry/podsecuritypolicysubjectreview"
+)
+
+// REST implements the RESTStorage interface for PodSecurityPolicyReview
+type REST struct {
+	sccMatcher oscc.SCCMatcher
+	client     kclient.Interface
+}
+
+// NewREST creates a new REST for PodSecurityPolicyReviews.  client is used to list service accounts and
+// to look up the namespace allocations that constraints may need.
+func NewREST(sccMatcher oscc.SCCMatcher, client kclient.Interface) *REST {
+	return &REST{sccMatcher: sccMatcher, client: client}
+}
+
+func (r *REST) New() runtime.Object {
+	return &securityapi.PodSecurityPolicyReview{}
+}
+
+// Create checks the pod template against the constraints usable by each service account in the review and
+// lists the service accounts that are allowed to create it.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	review, ok := obj.(*securityapi.PodSecurityPolicyReview)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("not a PodSecurityPolicyReview: %#v", obj))
+	}
+	namespace := kapi.NamespaceValue(ctx)
+	if len(namespace) == 0 {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("namespace is required on this type: %v", namespace))
+	}
+	if errs := securityvalidation.ValidatePodSecurityPolicyReview(review); len(errs) > 0 {
+		return nil, kapierrors.NewInvalid("PodSecurityPolicyReview", "", errs)
+	}
+
+	serviceAccountNames, err := r.serviceAccountNames(namespace, review)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Status.AllowedServiceAccounts = []securityapi.ServiceAccountPodSecurityPolicyReviewStatus{}
+	for _, name := range serviceAccountNames {
+		userInfo := serviceaccount.UserInfo(namespace, name, "")
+		constraints, err := oscc.FindPodSCCs(r.sccMatcher, userInfo, namespace, "")
+		if err != nil {
+			return nil, kapierrors.NewInternalError(err)
+		}
+
+		template := review.Spec.Template
+		template.Spec.ServiceAccountName = name
+		status, err := podsecuritypolicysubjectreview.ReviewPodTemplate(namespace, template, constraints, r.client)
+		if err != nil {
+			return nil, kapierrors.NewInternalError(err)
+		}
+		if status.AllowedBy == nil {
+			continue
+		}
+		review.Status.AllowedServiceAccounts = append(review.Status.AllowedServiceAccounts, securityapi.ServiceAccountPodSecurityPolicyReviewStatus{
+			PodSecurityPolicySubjectReviewStatus: status,
+			Name:                                 name,
+		})
+	}
+
+	return review, nil
+}
+
+// serviceAccountNames returns the service accounts named in the review, the service account of the pod
+// template, or every service account in the namespace, in that order of preference.
+func (r *REST) serviceAccountNames(namespace string, review *securityapi.PodSecurityPolicyReview) ([]string, error) {
+	if len(review.Spec.ServiceAccountNames) > 0 {
+		return review.Spec.ServiceAccountNames, nil
+	}
+	if len(review.Spec.Template.Spec.ServiceAccountName) > 0 {
+		return []string{review.Spec.Template.Spec.ServiceAccountName}, nil
+	}
+
+	serviceAccounts, err := r.client.ServiceAccounts(namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, serviceAccount := range serviceAccounts.Items {
+		names = append(names, serviceAccount.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,96 @@
+package podsecuritypolicyreview
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/capabilities"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	oscc "github.com/openshift/origin/pkg/security/admission"
+	securityapi "github.com/openshift/origin/pkg/security/api"
+)
+
+func scc(name string, allowPrivileged bool, users ...string) *kapi.SecurityContextConstraints {
+	return &kapi.SecurityContextConstraints{
+		ObjectMeta:               kapi.ObjectMeta{Name: name},
+		AllowPrivilegedContainer: allowPrivileged,
+		RunAsUser:                kapi.RunAsUserStrategyOptions{Type: kapi.RunAsUserStrategyRunAsAny},
+		SELinuxContext:           kapi.SELinuxContextStrategyOptions{Type: kapi.SELinuxStrategyRunAsAny},
+		FSGroup:                  kapi.FSGroupStrategyOptions{Type: kapi.FSGroupStrategyRunAsAny},
+		SupplementalGroups:       kapi.SupplementalGroupsStrategyOptions{Type: kapi.SupplementalGroupsStrategyRunAsAny},
+		Users:                    users,
+	}
+}
+
+func serviceAccount(name string) *kapi.ServiceAccount {
+	return &kapi.ServiceAccount{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "myproject"}}
+}
+
+func podTemplate(serviceAccountName string) kapi.PodTemplateSpec {
+	privileged := true
+	return kapi.PodTemplateSpec{
+		Spec: kapi.PodSpec{
+			ServiceAccountName: serviceAccountName,
+			RestartPolicy:      kapi.RestartPolicyAlways,
+			DNSPolicy:          kapi.DNSClusterFirst,
+			Containers: []kapi.Container{{
+				Name:            "ctr",
+				Image:           "image",
+				ImagePullPolicy: kapi.PullIfNotPresent,
+				SecurityContext: &kapi.SecurityContext{Privileged: &privileged},
+			}},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	// privileged containers are rejected by pod validation unless they are allowed cluster wide
+	capabilities.SetForTests(capabilities.Capabilities{AllowPrivileged: true})
+
+	testCases := map[string]struct {
+		spec     securityapi.PodSecurityPolicyReviewSpec
+		expected map[string]string
+	}{
+		"all service accounts": {
+			spec:     securityapi.PodSecurityPolicyReviewSpec{Template: podTemplate("")},
+			expected: map[string]string{"router": "privileged"},
+		},
+		"named service accounts": {
+			spec:     securityapi.PodSecurityPolicyReviewSpec{Template: podTemplate(""), ServiceAccountNames: []string{"default", "registry"}},
+			expected: map[string]string{"registry": "privileged"},
+		},
+		"service account of the template": {
+			spec:     securityapi.PodSecurityPolicyReviewSpec{Template: podTemplate("default")},
+			expected: map[string]string{},
+		},
+	}
+
+	for k, tc := range testCases {
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		store.Add(scc("restricted", false, "system:serviceaccount:myproject:default", "system:serviceaccount:myproject:router"))
+		store.Add(scc("privileged", true, "system:serviceaccount:myproject:router", "system:serviceaccount:myproject:registry"))
+		client := testclient.NewSimpleFake(
+			&kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "myproject"}},
+			serviceAccount("default"), serviceAccount("router"),
+		)
+		storage := NewREST(oscc.NewDefaultSCCMatcher(store), client)
+
+		review := &securityapi.PodSecurityPolicyReview{Spec: tc.spec}
+		obj, err := storage.Create(kapi.WithNamespace(kapi.NewContext(), "myproject"), review)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+
+		actual := map[string]string{}
+		for _, allowed := range obj.(*securityapi.PodSecurityPolicyReview).Status.AllowedServiceAccounts {
+			actual[allowed.Name] = allowed.AllowedBy.Name
+		}
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", k, tc.expected, actual)
+		}
+	}
+}
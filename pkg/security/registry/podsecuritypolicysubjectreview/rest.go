@@ -0,0 +1,100 @@
+package podsecuritypolicysubjectreview
+
+import (
+	"fmt"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/auth/user"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
+
+	"github.com/golang/glog"
+
+	oscc "github.com/openshift/origin/pkg/security/admission"
+	securityapi "github.com/openshift/origin/pkg/security/api"
+	securityvalidation "github.com/openshift/origin/pkg/security/api/validation"
+)
+
+// REST implements the RESTStorage interface for PodSecurityPolicySubjectReview
+type REST struct {
+	sccMatcher oscc.SCCMatcher
+	client     kclient.Interface
+}
+
+// NewREST creates a new REST for PodSecurityPolicySubjectReviews.  client is used to look up the namespace allocations
+// that constraints may need.
+func NewREST(sccMatcher oscc.SCCMatcher, client kclient.Interface) *REST {
+	return &REST{sccMatcher: sccMatcher, client: client}
+}
+
+func (r *REST) New() runtime.Object {
+	return &securityapi.PodSecurityPolicySubjectReview{}
+}
+
+// Create finds the first security context constraint that admits the pod template for the user, groups,
+// and service account in the review, and returns the template with that constraint's defaults applied.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	review, ok := obj.(*securityapi.PodSecurityPolicySubjectReview)
+	if !ok {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("not a PodSecurityPolicySubjectReview: %#v", obj))
+	}
+	namespace := kapi.NamespaceValue(ctx)
+	if len(namespace) == 0 {
+		return nil, kapierrors.NewBadRequest(fmt.Sprintf("namespace is required on this type: %v", namespace))
+	}
+	if errs := securityvalidation.ValidatePodSecurityPolicySubjectReview(review); len(errs) > 0 {
+		return nil, kapierrors.NewInvalid("PodSecurityPolicySubjectReview", "", errs)
+	}
+
+	userInfo := &user.DefaultInfo{Name: review.Spec.User, Groups: review.Spec.Groups}
+	constraints, err := oscc.FindPodSCCs(r.sccMatcher, userInfo, namespace, review.Spec.Template.Spec.ServiceAccountName)
+	if err != nil {
+		return nil, kapierrors.NewInternalError(err)
+	}
+
+	status, err := ReviewPodTemplate(namespace, review.Spec.Template, constraints, r.client)
+	if err != nil {
+		return nil, kapierrors.NewInternalError(err)
+	}
+	review.Status = status
+	return review, nil
+}
+
+// ReviewPodTemplate tries the constraints in order and reports the first one that admits template in
+// namespace.  If none does, the reason each constraint rejected the template is returned.
+func ReviewPodTemplate(namespace string, template kapi.PodTemplateSpec, constraints []*kapi.SecurityContextConstraints, client kclient.Interface) (securityapi.PodSecurityPolicySubjectReviewStatus, error) {
+	status := securityapi.PodSecurityPolicySubjectReviewStatus{}
+
+	providers, errs := oscc.CreateProvidersFromConstraints(namespace, constraints, client)
+	for _, err := range errs {
+		glog.V(4).Infof("provider creation error: %v", err)
+	}
+
+	// the security contexts are assigned to a copy so that the same template can be reviewed more than once
+	templateCopy, err := kapi.Scheme.DeepCopy(template)
+	if err != nil {
+		return status, err
+	}
+	pod := &kapi.Pod{ObjectMeta: templateCopy.(kapi.PodTemplateSpec).ObjectMeta, Spec: templateCopy.(kapi.PodTemplateSpec).Spec}
+	pod.Namespace = namespace
+
+	allErrs := field.ErrorList{}
+	for _, provider := range providers {
+		if errs := oscc.AssignSecurityContext(provider, pod, field.NewPath(fmt.Sprintf("provider %s: ", provider.GetSCCName()))); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		status.AllowedBy = &kapi.ObjectReference{Kind: "SecurityContextConstraints", Name: provider.GetSCCName()}
+		status.Template = kapi.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec}
+		return status, nil
+	}
+
+	if len(providers) == 0 {
+		status.Reason = "no security context constraints are available to the subjects"
+	} else {
+		status.Reason = fmt.Sprintf("unable to validate against any security context constraint: %v", allErrs)
+	}
+	return status, nil
+}
@@ -0,0 +1,131 @@
+package podsecuritypolicysubjectreview
+
+import (
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/capabilities"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	oscc "github.com/openshift/origin/pkg/security/admission"
+	securityapi "github.com/openshift/origin/pkg/security/api"
+)
+
+func restrictedSCC() *kapi.SecurityContextConstraints {
+	var uid int64 = 1000
+	return &kapi.SecurityContextConstraints{
+		ObjectMeta:         kapi.ObjectMeta{Name: "restricted"},
+		RunAsUser:          kapi.RunAsUserStrategyOptions{Type: kapi.RunAsUserStrategyMustRunAs, UID: &uid},
+		SELinuxContext:     kapi.SELinuxContextStrategyOptions{Type: kapi.SELinuxStrategyRunAsAny},
+		FSGroup:            kapi.FSGroupStrategyOptions{Type: kapi.FSGroupStrategyRunAsAny},
+		SupplementalGroups: kapi.SupplementalGroupsStrategyOptions{Type: kapi.SupplementalGroupsStrategyRunAsAny},
+		Groups:             []string{"system:authenticated"},
+	}
+}
+
+func privilegedSCC() *kapi.SecurityContextConstraints {
+	return &kapi.SecurityContextConstraints{
+		ObjectMeta:               kapi.ObjectMeta{Name: "privileged"},
+		AllowPrivilegedContainer: true,
+		RunAsUser:                kapi.RunAsUserStrategyOptions{Type: kapi.RunAsUserStrategyRunAsAny},
+		SELinuxContext:           kapi.SELinuxContextStrategyOptions{Type: kapi.SELinuxStrategyRunAsAny},
+		FSGroup:                  kapi.FSGroupStrategyOptions{Type: kapi.FSGroupStrategyRunAsAny},
+		SupplementalGroups:       kapi.SupplementalGroupsStrategyOptions{Type: kapi.SupplementalGroupsStrategyRunAsAny},
+		Users:                    []string{"system:serviceaccount:myproject:router"},
+	}
+}
+
+func podTemplate(serviceAccountName string, privileged bool) kapi.PodTemplateSpec {
+	return kapi.PodTemplateSpec{
+		Spec: kapi.PodSpec{
+			ServiceAccountName: serviceAccountName,
+			RestartPolicy:      kapi.RestartPolicyAlways,
+			DNSPolicy:          kapi.DNSClusterFirst,
+			Containers: []kapi.Container{{
+				Name:            "ctr",
+				Image:           "image",
+				ImagePullPolicy: kapi.PullIfNotPresent,
+				SecurityContext: &kapi.SecurityContext{Privileged: &privileged},
+			}},
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	// privileged containers are rejected by pod validation unless they are allowed cluster wide
+	capabilities.SetForTests(capabilities.Capabilities{AllowPrivileged: true})
+
+	testCases := map[string]struct {
+		spec              securityapi.PodSecurityPolicySubjectReviewSpec
+		expectedAllowedBy string
+		expectedRunAsUser int64
+		expectedReason    string
+	}{
+		"user allowed by restricted": {
+			spec:              securityapi.PodSecurityPolicySubjectReviewSpec{Template: podTemplate("", false), User: "alice", Groups: []string{"system:authenticated"}},
+			expectedAllowedBy: "restricted",
+			expectedRunAsUser: 1000,
+		},
+		"privileged pod denied for user": {
+			spec:           securityapi.PodSecurityPolicySubjectReviewSpec{Template: podTemplate("", true), User: "alice", Groups: []string{"system:authenticated"}},
+			expectedReason: "unable to validate against any security context constraint",
+		},
+		"privileged pod allowed for service account": {
+			spec:              securityapi.PodSecurityPolicySubjectReviewSpec{Template: podTemplate("router", true), User: "alice", Groups: []string{"system:authenticated"}},
+			expectedAllowedBy: "privileged",
+		},
+		"no subjects": {
+			spec:           securityapi.PodSecurityPolicySubjectReviewSpec{Template: podTemplate("", false)},
+			expectedReason: "no security context constraints are available",
+		},
+	}
+
+	for k, tc := range testCases {
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		store.Add(restrictedSCC())
+		store.Add(privilegedSCC())
+		namespace := &kapi.Namespace{ObjectMeta: kapi.ObjectMeta{Name: "myproject"}}
+		storage := NewREST(oscc.NewDefaultSCCMatcher(store), testclient.NewSimpleFake(namespace))
+
+		review := &securityapi.PodSecurityPolicySubjectReview{Spec: tc.spec}
+		obj, err := storage.Create(kapi.WithNamespace(kapi.NewContext(), "myproject"), review)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		status := obj.(*securityapi.PodSecurityPolicySubjectReview).Status
+
+		if len(tc.expectedAllowedBy) == 0 {
+			if status.AllowedBy != nil {
+				t.Errorf("%s: expected no constraint to allow the pod, got %v", k, status.AllowedBy)
+			}
+			if !strings.Contains(status.Reason, tc.expectedReason) {
+				t.Errorf("%s: expected reason to contain %q, got %q", k, tc.expectedReason, status.Reason)
+			}
+			continue
+		}
+		if status.AllowedBy == nil || status.AllowedBy.Name != tc.expectedAllowedBy {
+			t.Errorf("%s: expected the pod to be allowed by %s, got %v: %s", k, tc.expectedAllowedBy, status.AllowedBy, status.Reason)
+			continue
+		}
+		if tc.expectedRunAsUser != 0 {
+			runAsUser := status.Template.Spec.Containers[0].SecurityContext.RunAsUser
+			if runAsUser == nil || *runAsUser != tc.expectedRunAsUser {
+				t.Errorf("%s: expected the defaulted user %d, got %v", k, tc.expectedRunAsUser, runAsUser)
+			}
+		}
+		if review.Spec.Template.Spec.Containers[0].SecurityContext.RunAsUser != nil {
+			t.Errorf("%s: the requested template was modified", k)
+		}
+	}
+}
+
+func TestCreateRequiresNamespace(t *testing.T) {
+	storage := NewREST(oscc.NewDefaultSCCMatcher(cache.NewStore(cache.MetaNamespaceKeyFunc)), testclient.NewSimpleFake())
+	review := &securityapi.PodSecurityPolicySubjectReview{Spec: securityapi.PodSecurityPolicySubjectReviewSpec{Template: podTemplate("", false)}}
+	if _, err := storage.Create(kapi.NewContext(), review); err == nil {
+		t.Errorf("expected an error without a namespace")
+	}
+}
@@ -0,0 +1,117 @@
+package oauthclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/serviceaccount"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/oauthclient"
+	"github.com/openshift/origin/pkg/serviceaccounts"
+)
+
+// OAuthRedirectURISecretAnnotationPrefix is the prefix of service account annotations whose values are
+// allowed redirect URIs when the service account is used as an OAuth client
+const OAuthRedirectURISecretAnnotationPrefix = "serviceaccounts.openshift.io/oauth-redirecturi."
+
+type saOAuthClientAdapter struct {
+	saClient     kclient.ServiceAccountsNamespacer
+	secretClient kclient.SecretsNamespacer
+
+	delegate oauthclient.Getter
+}
+
+var _ oauthclient.Getter = &saOAuthClientAdapter{}
+
+// NewServiceAccountOAuthClientGetter returns a Getter that allows service accounts to be used as OAuth clients.
+// A client named system:serviceaccount:<namespace>:<name> is built from the service account's redirect URI
+// annotations and its API tokens, which serve as client secrets. All other clients are looked up in the delegate.
+func NewServiceAccountOAuthClientGetter(saClient kclient.ServiceAccountsNamespacer, secretClient kclient.SecretsNamespacer, delegate oauthclient.Getter) oauthclient.Getter {
+	return &saOAuthClientAdapter{saClient: saClient, secretClient: secretClient, delegate: delegate}
+}
+
+// IsServiceAccountClient returns true if the client name refers to a service account
+func IsServiceAccountClient(name string) bool {
+	_, _, err := serviceaccount.SplitUsername(name)
+	return err == nil
+}
+
+func (a *saOAuthClientAdapter) GetClient(ctx kapi.Context, name string) (*oauthapi.OAuthClient, error) {
+	saNamespace, saName, err := serviceaccount.SplitUsername(name)
+	if err != nil {
+		return a.delegate.GetClient(ctx, name)
+	}
+
+	sa, err := a.saClient.ServiceAccounts(saNamespace).Get(saName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, kerrors.NewNotFound("OAuthClient", name)
+		}
+		return nil, err
+	}
+
+	redirectURIs := getRedirectURIs(sa)
+	if len(redirectURIs) == 0 {
+		return nil, fmt.Errorf("%s has no redirectURIs; set %s<some-value>=<redirect>", name, OAuthRedirectURISecretAnnotationPrefix)
+	}
+
+	tokens, err := a.getServiceAccountTokens(sa)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%s has no tokens", name)
+	}
+
+	return &oauthapi.OAuthClient{
+		ObjectMeta:        kapi.ObjectMeta{Name: name},
+		Secret:            tokens[0],
+		AdditionalSecrets: tokens[1:],
+		RedirectURIs:      redirectURIs,
+	}, nil
+}
+
+// getRedirectURIs returns the values of the redirect URI annotations of the service account, ordered by annotation name
+func getRedirectURIs(sa *kapi.ServiceAccount) []string {
+	keys := []string{}
+	for key, value := range sa.Annotations {
+		if strings.HasPrefix(key, OAuthRedirectURISecretAnnotationPrefix) && len(value) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	redirectURIs := []string{}
+	for _, key := range keys {
+		redirectURIs = append(redirectURIs, sa.Annotations[key])
+	}
+	return redirectURIs
+}
+
+// getServiceAccountTokens returns the API tokens referenced by the service account. Scoped tokens are left out, since
+// a client secret that authenticated as the service account would otherwise escape the scopes of its token.
+func (a *saOAuthClientAdapter) getServiceAccountTokens(sa *kapi.ServiceAccount) ([]string, error) {
+	tokens := []string{}
+	for _, secretRef := range sa.Secrets {
+		secret, err := a.secretClient.Secrets(sa.Namespace).Get(secretRef.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if _, scoped := secret.Annotations[authapi.ServiceAccountTokenScopesAnnotation]; scoped {
+			continue
+		}
+		if serviceaccounts.IsValidServiceAccountToken(sa, secret) {
+			tokens = append(tokens, string(secret.Data[kapi.ServiceAccountTokenKey]))
+		}
+	}
+	return tokens, nil
+}
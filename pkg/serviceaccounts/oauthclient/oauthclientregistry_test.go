@@ -0,0 +1,132 @@
+package oauthclient
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	"github.com/openshift/origin/pkg/oauth/registry/test"
+)
+
+func TestGetClient(t *testing.T) {
+	testCases := map[string]struct {
+		clientName  string
+		objects     []runtime.Object
+		delegate    *test.ClientRegistry
+		expected    *oauthapi.OAuthClient
+		expectedErr bool
+	}{
+		"delegated client": {
+			clientName: "openshift-web-console",
+			delegate:   &test.ClientRegistry{Client: &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "openshift-web-console"}}},
+			expected:   &oauthapi.OAuthClient{ObjectMeta: kapi.ObjectMeta{Name: "openshift-web-console"}},
+		},
+		"missing service account": {
+			clientName:  "system:serviceaccount:ns-01:missing",
+			expectedErr: true,
+		},
+		"service account without redirect uris": {
+			clientName: "system:serviceaccount:ns-01:default",
+			objects: []runtime.Object{
+				&kapi.ServiceAccount{
+					ObjectMeta: kapi.ObjectMeta{Namespace: "ns-01", Name: "default", UID: "any"},
+					Secrets:    []kapi.ObjectReference{{Name: "default-token"}},
+				},
+				newTokenSecret("default-token", "token-value"),
+			},
+			expectedErr: true,
+		},
+		"service account without tokens": {
+			clientName: "system:serviceaccount:ns-01:default",
+			objects: []runtime.Object{
+				&kapi.ServiceAccount{
+					ObjectMeta: kapi.ObjectMeta{
+						Namespace:   "ns-01",
+						Name:        "default",
+						UID:         "any",
+						Annotations: map[string]string{OAuthRedirectURISecretAnnotationPrefix + "one": "http://anywhere"},
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		"service account client": {
+			clientName: "system:serviceaccount:ns-01:default",
+			objects: []runtime.Object{
+				&kapi.ServiceAccount{
+					ObjectMeta: kapi.ObjectMeta{
+						Namespace: "ns-01",
+						Name:      "default",
+						UID:       "any",
+						Annotations: map[string]string{
+							OAuthRedirectURISecretAnnotationPrefix + "two": "http://elsewhere",
+							OAuthRedirectURISecretAnnotationPrefix + "one": "http://anywhere",
+							"unrelated": "http://nowhere",
+						},
+					},
+					Secrets: []kapi.ObjectReference{{Name: "default-token"}, {Name: "other-token"}, {Name: "scoped-token"}, {Name: "dockercfg"}},
+				},
+				newTokenSecret("default-token", "token-value"),
+				newTokenSecret("other-token", "other-token-value"),
+				newScopedTokenSecret("scoped-token", "scoped-token-value", "user:info"),
+				&kapi.Secret{ObjectMeta: kapi.ObjectMeta{Namespace: "ns-01", Name: "dockercfg"}, Type: kapi.SecretTypeDockercfg},
+			},
+			expected: &oauthapi.OAuthClient{
+				ObjectMeta:        kapi.ObjectMeta{Name: "system:serviceaccount:ns-01:default"},
+				Secret:            "token-value",
+				AdditionalSecrets: []string{"other-token-value"},
+				RedirectURIs:      []string{"http://anywhere", "http://elsewhere"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		kubeClient := ktestclient.NewSimpleFake(tc.objects...)
+		delegate := tc.delegate
+		if delegate == nil {
+			delegate = &test.ClientRegistry{}
+		}
+
+		getter := NewServiceAccountOAuthClientGetter(kubeClient, kubeClient, delegate)
+		client, err := getter.GetClient(kapi.NewContext(), tc.clientName)
+		if tc.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %#v", name, client)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(tc.expected, client) {
+			t.Errorf("%s: expected\n%#v\ngot\n%#v", name, tc.expected, client)
+		}
+	}
+}
+
+func newTokenSecret(name, token string) *kapi.Secret {
+	return &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "ns-01",
+			Name:      name,
+			Annotations: map[string]string{
+				kapi.ServiceAccountNameKey: "default",
+				kapi.ServiceAccountUIDKey:  "any",
+			},
+		},
+		Type: kapi.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{kapi.ServiceAccountTokenKey: []byte(token)},
+	}
+}
+
+func newScopedTokenSecret(name, token, scopes string) *kapi.Secret {
+	secret := newTokenSecret(name, token)
+	secret.Annotations[authapi.ServiceAccountTokenScopesAnnotation] = scopes
+	return secret
+}
@@ -0,0 +1,6 @@
+package api
+
+// IsSharedTemplate returns true if the template is published to all projects
+func IsSharedTemplate(template *Template) bool {
+	return template.Annotations[SharedTemplateAnnotation] == "true"
+}
@@ -6,6 +6,10 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 )
 
+// SharedTemplateAnnotation is set to "true" on templates that are published to all projects
+// through the shared templates API.
+const SharedTemplateAnnotation = "openshift.io/template.shared"
+
 // Template contains the inputs needed to produce a Config.
 type Template struct {
 	unversioned.TypeMeta
@@ -59,4 +63,23 @@ type Parameter struct {
 
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool
+
+	// Optional: ValueFrom selects a source the value of the parameter is read from when the
+	// template is processed by the server. The value is not returned in the processed template
+	// parameters.
+	ValueFrom *ParameterSource
+}
+
+// ParameterSource selects the source of the value of a parameter.
+type ParameterSource struct {
+	// SecretKeyRef selects a key of a secret in the namespace the template is processed in.
+	SecretKeyRef *SecretKeySelector
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	// Name of the secret.
+	Name string
+	// Key of the secret whose value is used.
+	Key string
 }
@@ -61,4 +61,22 @@ type Parameter struct {
 
 	// Optional: Indicates the parameter must have a value.  Defaults to false.
 	Required bool `json:"required,omitempty" description:"indicates the parameter must have a non-empty value or be generated"`
+
+	// ValueFrom selects a source the value of the parameter is read from when the template is
+	// processed by the server. The value is not returned in the processed template parameters. Optional.
+	ValueFrom *ParameterSource `json:"valueFrom,omitempty" description:"optional: source the value of the parameter is read from when the template is processed by the server"`
+}
+
+// ParameterSource selects the source of the value of a parameter.
+type ParameterSource struct {
+	// SecretKeyRef selects a key of a secret in the namespace the template is processed in.
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty" description:"selects a key of a secret in the namespace the template is processed in"`
+}
+
+// SecretKeySelector selects a key of a secret.
+type SecretKeySelector struct {
+	// Name of the secret.
+	Name string `json:"name" description:"name of the secret"`
+	// Key of the secret whose value is used.
+	Key string `json:"key" description:"key of the secret whose value is used"`
 }
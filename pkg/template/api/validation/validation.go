@@ -22,6 +22,28 @@ func ValidateParameter(param *api.Parameter, fldPath *field.Path) (allErrs field
 	if !parameterNameExp.MatchString(param.Name) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), param.Name, fmt.Sprintf("does not match %v", parameterNameExp)))
 	}
+	if param.ValueFrom != nil {
+		allErrs = append(allErrs, validateParameterSource(param, fldPath.Child("valueFrom"))...)
+	}
+	return
+}
+
+// validateParameterSource tests if the source of the value of a parameter is complete
+func validateParameterSource(param *api.Parameter, fldPath *field.Path) (allErrs field.ErrorList) {
+	if len(param.Value) > 0 || len(param.Generate) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "may not be specified together with value or generate"))
+	}
+	ref := param.ValueFrom.SecretKeyRef
+	if ref == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef")))
+		return
+	}
+	if len(ref.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "name")))
+	}
+	if len(ref.Key) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretKeyRef", "key")))
+	}
 	return
 }
 
@@ -43,6 +43,44 @@ func TestValidateParameter(t *testing.T) {
 	}
 }
 
+func TestValidateParameterValueFrom(t *testing.T) {
+	secretRef := &api.ParameterSource{SecretKeyRef: &api.SecretKeySelector{Name: "db", Key: "password"}}
+	tests := map[string]struct {
+		param           api.Parameter
+		isValidExpected bool
+	}{
+		"secret key": {
+			param:           api.Parameter{Name: "PASSWORD", ValueFrom: secretRef},
+			isValidExpected: true,
+		},
+		"value and secret key": {
+			param: api.Parameter{Name: "PASSWORD", Value: "secret", ValueFrom: secretRef},
+		},
+		"generate and secret key": {
+			param: api.Parameter{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}", ValueFrom: secretRef},
+		},
+		"no source": {
+			param: api.Parameter{Name: "PASSWORD", ValueFrom: &api.ParameterSource{}},
+		},
+		"no secret name": {
+			param: api.Parameter{Name: "PASSWORD", ValueFrom: &api.ParameterSource{SecretKeyRef: &api.SecretKeySelector{Key: "password"}}},
+		},
+		"no secret key": {
+			param: api.Parameter{Name: "PASSWORD", ValueFrom: &api.ParameterSource{SecretKeyRef: &api.SecretKeySelector{Name: "db"}}},
+		},
+	}
+
+	for name, test := range tests {
+		errs := ValidateParameter(&test.param, nil)
+		if test.isValidExpected && len(errs) != 0 {
+			t.Errorf("%s: unexpected errors: %v", name, errs)
+		}
+		if !test.isValidExpected && len(errs) == 0 {
+			t.Errorf("%s: expected validation errors", name)
+		}
+	}
+}
+
 func TestValidateProcessTemplate(t *testing.T) {
 	var tests = []struct {
 		template        *api.Template
@@ -1,14 +1,19 @@
 package registry
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
-	"k8s.io/kubernetes/pkg/api/errors"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/validation/field"
 
+	"github.com/openshift/origin/pkg/authorization/authorizer"
 	"github.com/openshift/origin/pkg/template"
 	"github.com/openshift/origin/pkg/template/api"
 	templatevalidation "github.com/openshift/origin/pkg/template/api/validation"
@@ -17,12 +22,16 @@ import (
 
 // REST implements RESTStorage interface for processing Template objects.
 type REST struct {
+	secrets    kclient.SecretsNamespacer
+	authorizer authorizer.Authorizer
 }
 
 // NewREST creates new RESTStorage interface for processing Template objects. If
-// legacyReturn is used, a Config object is returned. Otherwise, a List is returned
-func NewREST() *REST {
-	return &REST{}
+// legacyReturn is used, a Config object is returned. Otherwise, a List is returned.
+// Parameter values referencing secrets are read with the secrets client once the
+// authorizer allows the user to get the secret.
+func NewREST(secrets kclient.SecretsNamespacer, authorizer authorizer.Authorizer) *REST {
+	return &REST{secrets: secrets, authorizer: authorizer}
 }
 
 // New returns a new Template
@@ -37,10 +46,14 @@ func (s *REST) New() runtime.Object {
 func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
 	tpl, ok := obj.(*api.Template)
 	if !ok {
-		return nil, errors.NewBadRequest("not a template")
+		return nil, kapierrors.NewBadRequest("not a template")
 	}
 	if errs := templatevalidation.ValidateProcessedTemplate(tpl); len(errs) > 0 {
-		return nil, errors.NewInvalid("template", tpl.Name, errs)
+		return nil, kapierrors.NewInvalid("template", tpl.Name, errs)
+	}
+	resolved, err := s.resolveParameterSources(ctx, tpl)
+	if err != nil {
+		return nil, err
 	}
 
 	generators := map[string]generator.Generator{
@@ -49,8 +62,60 @@ func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 	processor := template.NewProcessor(generators)
 	if errs := processor.Process(tpl); len(errs) > 0 {
 		glog.V(1).Infof(errs.ToAggregate().Error())
-		return nil, errors.NewInvalid("template", tpl.Name, errs)
+		return nil, kapierrors.NewInvalid("template", tpl.Name, errs)
 	}
 
+	// values read from secrets are never returned to the client
+	for _, i := range resolved {
+		tpl.Parameters[i].Value = ""
+	}
 	return tpl, nil
 }
+
+// resolveParameterSources sets the value of the parameters referencing a key of a secret in
+// the namespace of the request, and returns the indexes of those parameters.
+func (s *REST) resolveParameterSources(ctx kapi.Context, tpl *api.Template) ([]int, error) {
+	resolved := []int{}
+	for i := range tpl.Parameters {
+		param := &tpl.Parameters[i]
+		if param.ValueFrom == nil || param.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		ref := param.ValueFrom.SecretKeyRef
+		if s.secrets == nil || s.authorizer == nil {
+			return nil, kapierrors.NewBadRequest(fmt.Sprintf("the value of parameter %q can only be read from secret %q by the server", param.Name, ref.Name))
+		}
+
+		namespace, ok := kapi.NamespaceFrom(ctx)
+		if !ok || len(namespace) == 0 {
+			return nil, kapierrors.NewBadRequest("a namespace is required to read parameter values from secrets")
+		}
+		attributes := authorizer.DefaultAuthorizationAttributes{
+			Verb:         "get",
+			Resource:     "secrets",
+			ResourceName: ref.Name,
+		}
+		allowed, reason, err := s.authorizer.Authorize(ctx, attributes)
+		if err != nil {
+			return nil, kapierrors.NewForbidden(attributes.GetResource(), ref.Name, err)
+		}
+		if !allowed {
+			return nil, kapierrors.NewForbidden(attributes.GetResource(), ref.Name, errors.New(reason))
+		}
+
+		secret, err := s.secrets.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			keyPath := field.NewPath("parameters").Index(i).Child("valueFrom", "secretKeyRef", "key")
+			return nil, kapierrors.NewInvalid("template", tpl.Name, field.ErrorList{
+				field.Invalid(keyPath, ref.Key, fmt.Sprintf("does not exist in secret %q", ref.Name)),
+			})
+		}
+		param.Value = string(value)
+		resolved = append(resolved, i)
+	}
+	return resolved, nil
+}
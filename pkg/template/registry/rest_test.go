@@ -4,14 +4,33 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
 
 	"github.com/openshift/origin/pkg/api/latest"
+	"github.com/openshift/origin/pkg/authorization/authorizer"
 	template "github.com/openshift/origin/pkg/template/api"
 )
 
+type secretAuthorizer struct {
+	allowed sets.String
+}
+
+func (a *secretAuthorizer) Authorize(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (bool, string, error) {
+	if attributes.GetVerb() == "get" && attributes.GetResource() == "secrets" && a.allowed.Has(attributes.GetResourceName()) {
+		return true, "allowed", nil
+	}
+	return false, "denied", nil
+}
+
+func (a *secretAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.AuthorizationAttributes) (sets.String, sets.String, error) {
+	return sets.NewString(), sets.NewString(), nil
+}
+
 func TestNewRESTInvalidType(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil, nil)
 	_, err := storage.Create(nil, &kapi.Pod{})
 	if err == nil {
 		t.Errorf("Expected type error.")
@@ -23,7 +42,7 @@ func TestNewRESTInvalidType(t *testing.T) {
 }
 
 func TestNewRESTDefaultsName(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil, nil)
 	obj, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -39,7 +58,7 @@ func TestNewRESTDefaultsName(t *testing.T) {
 }
 
 func TestNewRESTInvalidParameter(t *testing.T) {
-	storage := NewREST()
+	storage := NewREST(nil, nil)
 	_, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -62,7 +81,7 @@ func TestNewRESTTemplateLabels(t *testing.T) {
 		"label1": "value1",
 		"label2": "value2",
 	}
-	storage := NewREST()
+	storage := NewREST(nil, nil)
 	obj, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -112,7 +131,7 @@ func TestNewRESTTemplateLabelsList(t *testing.T) {
 		"label1": "value1",
 		"label2": "value2",
 	}
-	storage := NewREST()
+	storage := NewREST(nil, nil)
 	obj, err := storage.Create(nil, &template.Template{
 		ObjectMeta: kapi.ObjectMeta{
 			Name: "test",
@@ -156,3 +175,57 @@ func TestNewRESTTemplateLabelsList(t *testing.T) {
 		}
 	}
 }
+
+func TestNewRESTParameterValueFromSecret(t *testing.T) {
+	secrets := ktestclient.NewSimpleFake(
+		&kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Name: "db", Namespace: "test"},
+			Data:       map[string][]byte{"password": []byte("s3cr3t")},
+		},
+		&kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Name: "other", Namespace: "test"},
+			Data:       map[string][]byte{"password": []byte("other")},
+		},
+	)
+	storage := NewREST(secrets, &secretAuthorizer{allowed: sets.NewString("db")})
+	ctx := kapi.WithNamespace(kapi.NewContext(), "test")
+
+	newTemplate := func(name, key string) *template.Template {
+		return &template.Template{
+			ObjectMeta: kapi.ObjectMeta{Name: "test"},
+			Parameters: []template.Parameter{
+				{
+					Name:      "PASSWORD",
+					ValueFrom: &template.ParameterSource{SecretKeyRef: &template.SecretKeySelector{Name: name, Key: key}},
+				},
+			},
+			Objects: []runtime.Object{
+				&kapi.Service{
+					ObjectMeta: kapi.ObjectMeta{
+						Name:        "test-service",
+						Annotations: map[string]string{"password": "${PASSWORD}"},
+					},
+				},
+			},
+		}
+	}
+
+	obj, err := storage.Create(ctx, newTemplate("db", "password"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	processed := obj.(*template.Template)
+	if value := processed.Objects[0].(*kapi.Service).Annotations["password"]; value != "s3cr3t" {
+		t.Errorf("expected the value of the secret to be substituted, got %q", value)
+	}
+	if value := processed.Parameters[0].Value; len(value) != 0 {
+		t.Errorf("expected the value of the parameter not to be returned, got %q", value)
+	}
+
+	if _, err := storage.Create(ctx, newTemplate("other", "password")); !kapierrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error for a secret the user may not get, got %v", err)
+	}
+	if _, err := storage.Create(ctx, newTemplate("db", "missing")); !kapierrors.IsInvalid(err) {
+		t.Errorf("expected an invalid error for a missing key, got %v", err)
+	}
+}
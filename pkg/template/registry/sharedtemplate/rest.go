@@ -0,0 +1,48 @@
+package sharedtemplate
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+// REST exposes the templates of all projects that are marked as shared, so they can be browsed
+// from any project without access to the project that publishes them.
+type REST struct {
+	templates rest.Lister
+}
+
+var _ rest.Lister = &REST{}
+
+// NewREST returns a RESTStorage object that lists the shared templates stored in templates
+func NewREST(templates rest.Lister) *REST {
+	return &REST{templates: templates}
+}
+
+func (r *REST) New() runtime.Object {
+	return &api.Template{}
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &api.TemplateList{}
+}
+
+// List returns the shared templates of all projects that match the options
+func (r *REST) List(ctx kapi.Context, options *unversioned.ListOptions) (runtime.Object, error) {
+	obj, err := r.templates.List(kapi.WithNamespace(ctx, kapi.NamespaceAll), options)
+	if err != nil {
+		return nil, err
+	}
+	templates := obj.(*api.TemplateList)
+
+	shared := &api.TemplateList{ListMeta: templates.ListMeta}
+	for _, template := range templates.Items {
+		if api.IsSharedTemplate(&template) {
+			shared.Items = append(shared.Items, template)
+		}
+	}
+	return shared, nil
+}
@@ -0,0 +1,55 @@
+package sharedtemplate
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/template/api"
+)
+
+type fakeLister struct {
+	templates *api.TemplateList
+	namespace string
+}
+
+func (l *fakeLister) NewList() runtime.Object {
+	return &api.TemplateList{}
+}
+
+func (l *fakeLister) List(ctx kapi.Context, options *unversioned.ListOptions) (runtime.Object, error) {
+	l.namespace = kapi.NamespaceValue(ctx)
+	return l.templates, nil
+}
+
+func TestList(t *testing.T) {
+	shared := map[string]string{api.SharedTemplateAnnotation: "true"}
+	lister := &fakeLister{templates: &api.TemplateList{
+		ListMeta: unversioned.ListMeta{ResourceVersion: "10"},
+		Items: []api.Template{
+			{ObjectMeta: kapi.ObjectMeta{Namespace: "platform", Name: "golden", Annotations: shared}},
+			{ObjectMeta: kapi.ObjectMeta{Namespace: "platform", Name: "draft"}},
+			{ObjectMeta: kapi.ObjectMeta{Namespace: "platform", Name: "unshared", Annotations: map[string]string{api.SharedTemplateAnnotation: "false"}}},
+			{ObjectMeta: kapi.ObjectMeta{Namespace: "other", Name: "golden", Annotations: shared}},
+		},
+	}}
+
+	ctx := kapi.WithNamespace(kapi.NewContext(), "myproject")
+	obj, err := NewREST(lister).List(ctx, &unversioned.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lister.namespace != kapi.NamespaceAll {
+		t.Errorf("expected the templates of all namespaces to be listed, got %q", lister.namespace)
+	}
+
+	templates := obj.(*api.TemplateList)
+	if templates.ResourceVersion != "10" {
+		t.Errorf("expected the list metadata to be kept: %#v", templates.ListMeta)
+	}
+	if len(templates.Items) != 2 || templates.Items[0].Namespace != "platform" || templates.Items[1].Namespace != "other" {
+		t.Errorf("expected only the shared templates, got %#v", templates.Items)
+	}
+}
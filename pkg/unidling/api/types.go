@@ -0,0 +1,28 @@
+package api
+
+const (
+	// IdledAtAnnotation is set on the endpoints of an idled service, and on the objects that
+	// were scaled down, to the time at which the service was idled
+	IdledAtAnnotation = "idling.alpha.openshift.io/idled-at"
+	// UnidleTargetAnnotation is set on the endpoints of an idled service to the JSON list of
+	// RecordedScaleReferences to scale back up when the service receives traffic
+	UnidleTargetAnnotation = "idling.alpha.openshift.io/unidle-targets"
+	// PreviousScaleAnnotation is set on an idled object to the number of replicas it had
+	// before it was idled
+	PreviousScaleAnnotation = "idling.alpha.openshift.io/previous-scale"
+
+	// NeedPodsReason is the reason of the event that the proxy records on an idled service
+	// that receives traffic
+	NeedPodsReason = "NeedPods"
+)
+
+// RecordedScaleReference is a reference to a deployment config or replication controller that
+// was scaled down when a service was idled, and the number of replicas to restore.
+type RecordedScaleReference struct {
+	// Kind is either DeploymentConfig or ReplicationController
+	Kind string `json:"kind"`
+	// Name is the name of the object, in the namespace of the service
+	Name string `json:"name"`
+	// Replicas is the number of replicas the object had before it was idled
+	Replicas int `json:"replicas"`
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+	unidlingutil "github.com/openshift/origin/pkg/unidling/util"
+)
+
+// UnidlingController scales the deployment configs and replication controllers of an idled
+// service back up when the proxy records that the service received traffic.
+// Use the UnidlingControllerFactory to create this controller.
+type UnidlingController struct {
+	// Scalables gets and updates the objects to scale up
+	Scalables *unidlingutil.ScalableClient
+	// Endpoints gets and updates the endpoints of idled services
+	Endpoints kclient.EndpointsNamespacer
+}
+
+// Handle scales up the targets of the service the event is about if the service is idled, and
+// removes the idling annotations from its endpoints. Events from before the service was idled
+// are ignored.
+func (c *UnidlingController) Handle(event *kapi.Event) error {
+	if event.Reason != unidlingapi.NeedPodsReason || event.InvolvedObject.Kind != "Service" {
+		return nil
+	}
+	namespace, name := event.InvolvedObject.Namespace, event.InvolvedObject.Name
+
+	endpoints, err := c.Endpoints.Endpoints(namespace).Get(name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	idledAt, idled := unidlingutil.IdledAt(&endpoints.ObjectMeta)
+	if !idled {
+		return nil
+	}
+	if !idledAt.IsZero() && event.LastTimestamp.Time.Before(idledAt) {
+		glog.V(4).Infof("Ignoring an event from before service %s/%s was idled", namespace, name)
+		return nil
+	}
+	targets, err := unidlingutil.UnidleTargets(endpoints)
+	if err != nil {
+		return err
+	}
+
+	errs := []error{}
+	for _, target := range targets {
+		replicas := target.Replicas
+		err := c.Scalables.Update(namespace, target.Kind, target.Name, func(meta *kapi.ObjectMeta, current *int) bool {
+			// an object that is no longer idled was scaled up already, by another service or a user
+			if _, idled := unidlingutil.IdledAt(meta); !idled {
+				return false
+			}
+			if *current == 0 {
+				*current = replicas
+			}
+			unidlingutil.ClearIdled(meta)
+			return true
+		})
+		if err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to scale up %s %s/%s: %v", target.Kind, namespace, target.Name, err))
+			continue
+		}
+		glog.V(2).Infof("Unidled %s %s/%s of service %s to %d replicas", target.Kind, namespace, target.Name, name, replicas)
+	}
+	if len(errs) > 0 {
+		return kutilerrors.NewAggregate(errs)
+	}
+
+	unidlingutil.ClearIdled(&endpoints.ObjectMeta)
+	_, err = c.Endpoints.Endpoints(namespace).Update(endpoints)
+	return err
+}
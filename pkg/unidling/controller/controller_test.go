@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+	unidlingutil "github.com/openshift/origin/pkg/unidling/util"
+)
+
+var idledAt = time.Date(2016, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func idledEndpoints(targets ...unidlingapi.RecordedScaleReference) *kapi.Endpoints {
+	endpoints := &kapi.Endpoints{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"}}
+	if err := unidlingutil.SetIdled(endpoints, idledAt, targets); err != nil {
+		panic(err)
+	}
+	return endpoints
+}
+
+func idledAnnotations(previous string) map[string]string {
+	return map[string]string{
+		unidlingapi.IdledAtAnnotation:       idledAt.Format(time.RFC3339),
+		unidlingapi.PreviousScaleAnnotation: previous,
+	}
+}
+
+func needPodsEvent(at time.Time) *kapi.Event {
+	return &kapi.Event{
+		ObjectMeta:     kapi.ObjectMeta{Namespace: "test", Name: "frontend.1"},
+		InvolvedObject: kapi.ObjectReference{Kind: "Service", Namespace: "test", Name: "frontend"},
+		Reason:         unidlingapi.NeedPodsReason,
+		LastTimestamp:  unversioned.NewTime(at),
+	}
+}
+
+func updatedObjects(actions []ktestclient.Action) []runtime.Object {
+	objects := []runtime.Object{}
+	for _, action := range actions {
+		if update, ok := action.(ktestclient.UpdateAction); ok {
+			objects = append(objects, update.GetObject())
+		}
+	}
+	return objects
+}
+
+func TestHandle(t *testing.T) {
+	targets := []unidlingapi.RecordedScaleReference{
+		{Kind: "DeploymentConfig", Name: "frontend", Replicas: 3},
+		{Kind: "ReplicationController", Name: "standalone", Replicas: 2},
+	}
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend", Annotations: idledAnnotations("3")},
+	}
+	rc := &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "standalone", Annotations: idledAnnotations("2")},
+	}
+
+	testCases := []struct {
+		name      string
+		event     *kapi.Event
+		endpoints *kapi.Endpoints
+		config    *deployapi.DeploymentConfig
+		unidled   bool
+		updates   int
+	}{
+		{
+			name:      "scales up the targets of an idled service",
+			event:     needPodsEvent(idledAt.Add(time.Minute)),
+			endpoints: idledEndpoints(targets...),
+			config:    config,
+			unidled:   true,
+			updates:   3,
+		},
+		{
+			name:      "ignores events from before the service was idled",
+			event:     needPodsEvent(idledAt.Add(-time.Minute)),
+			endpoints: idledEndpoints(targets...),
+			config:    config,
+		},
+		{
+			name:      "ignores services that are not idled",
+			event:     needPodsEvent(idledAt.Add(time.Minute)),
+			endpoints: &kapi.Endpoints{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"}},
+			config:    config,
+		},
+		{
+			name:      "leaves targets alone that were scaled up already",
+			event:     needPodsEvent(idledAt.Add(time.Minute)),
+			endpoints: idledEndpoints(targets...),
+			config: &deployapi.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "frontend"},
+				Spec:       deployapi.DeploymentConfigSpec{Replicas: 1},
+			},
+			unidled: true,
+			updates: 2,
+		},
+	}
+
+	for _, test := range testCases {
+		copied, err := kapi.Scheme.DeepCopy(test.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		copiedRC, err := kapi.Scheme.DeepCopy(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		osClient := testclient.NewSimpleFake(copied.(runtime.Object))
+		kubeClient := ktestclient.NewSimpleFake(test.endpoints, copiedRC.(runtime.Object))
+		controller := &UnidlingController{
+			Scalables: &unidlingutil.ScalableClient{Client: osClient, KubeClient: kubeClient},
+			Endpoints: kubeClient,
+		}
+
+		if err := controller.Handle(test.event); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		updates := append(updatedObjects(osClient.Actions()), updatedObjects(kubeClient.Actions())...)
+		if len(updates) != test.updates {
+			t.Errorf("%s: expected %d updates, got %d: %#v", test.name, test.updates, len(updates), updates)
+			continue
+		}
+		for _, obj := range updates {
+			switch obj := obj.(type) {
+			case *deployapi.DeploymentConfig:
+				if obj.Spec.Replicas != 3 || len(obj.Annotations) != 0 {
+					t.Errorf("%s: unexpected deployment config %#v", test.name, obj)
+				}
+			case *kapi.ReplicationController:
+				if obj.Spec.Replicas != 2 || len(obj.Annotations) != 0 {
+					t.Errorf("%s: unexpected replication controller %#v", test.name, obj)
+				}
+			case *kapi.Endpoints:
+				if _, idled := unidlingutil.IdledAt(&obj.ObjectMeta); idled || !test.unidled {
+					t.Errorf("%s: unexpected endpoints %#v", test.name, obj)
+				}
+				if _, ok := obj.Annotations[unidlingapi.UnidleTargetAnnotation]; ok {
+					t.Errorf("%s: expected the unidle targets to be removed, got %#v", test.name, obj.Annotations)
+				}
+			default:
+				t.Errorf("%s: unexpected update of %#v", test.name, obj)
+			}
+		}
+	}
+}
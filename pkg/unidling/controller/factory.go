@@ -0,0 +1,68 @@
+package controller
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	controller "github.com/openshift/origin/pkg/controller"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+	unidlingutil "github.com/openshift/origin/pkg/unidling/util"
+)
+
+// UnidlingControllerFactory creates an UnidlingController.
+type UnidlingControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Create creates an UnidlingController that handles the events the proxy records when an idled
+// service receives traffic.
+func (factory *UnidlingControllerFactory) Create() controller.RunnableController {
+	selector := fields.OneTermEqualSelector("reason", unidlingapi.NeedPodsReason)
+	eventLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return factory.KubeClient.Events(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return factory.KubeClient.Events(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(eventLW, &kapi.Event{}, queue, 0).Run()
+
+	unidlingController := &UnidlingController{
+		Scalables: &unidlingutil.ScalableClient{
+			Client:     factory.Client,
+			KubeClient: factory.KubeClient,
+		},
+		Endpoints: factory.KubeClient,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				// updating the endpoints may conflict with the endpoints controller
+				return retries.Count < 5
+			},
+			kutil.NewTokenBucketRateLimiter(10, 20),
+		),
+		Handle: func(obj interface{}) error {
+			event := obj.(*kapi.Event)
+			return unidlingController.Handle(event)
+		},
+	}
+}
@@ -0,0 +1,4 @@
+// Package unidling contains the idling of services, which scales the objects that back a
+// service to zero, and the controller that scales them back up when the service receives
+// traffic.
+package unidling
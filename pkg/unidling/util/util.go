@@ -0,0 +1,126 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
+)
+
+// IdledAt returns the time at which the object was idled, and false if it is not idled
+func IdledAt(meta *kapi.ObjectMeta) (time.Time, bool) {
+	value, ok := meta.Annotations[unidlingapi.IdledAtAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	idledAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// an unreadable time still marks the object as idled
+		return time.Time{}, true
+	}
+	return idledAt, true
+}
+
+// UnidleTargets returns the objects to scale back up when the service of the endpoints
+// receives traffic
+func UnidleTargets(endpoints *kapi.Endpoints) ([]unidlingapi.RecordedScaleReference, error) {
+	value, ok := endpoints.Annotations[unidlingapi.UnidleTargetAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	targets := []unidlingapi.RecordedScaleReference{}
+	if err := json.Unmarshal([]byte(value), &targets); err != nil {
+		return nil, fmt.Errorf("unable to read the unidle targets of %s/%s: %v", endpoints.Namespace, endpoints.Name, err)
+	}
+	return targets, nil
+}
+
+// SetIdled marks the endpoints as idled at the given time, with the objects to scale back up
+// when the service receives traffic
+func SetIdled(endpoints *kapi.Endpoints, idledAt time.Time, targets []unidlingapi.RecordedScaleReference) error {
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+	if endpoints.Annotations == nil {
+		endpoints.Annotations = map[string]string{}
+	}
+	endpoints.Annotations[unidlingapi.IdledAtAnnotation] = idledAt.UTC().Format(time.RFC3339)
+	endpoints.Annotations[unidlingapi.UnidleTargetAnnotation] = string(data)
+	return nil
+}
+
+// ClearIdled removes the idling annotations from the object
+func ClearIdled(meta *kapi.ObjectMeta) {
+	delete(meta.Annotations, unidlingapi.IdledAtAnnotation)
+	delete(meta.Annotations, unidlingapi.UnidleTargetAnnotation)
+	delete(meta.Annotations, unidlingapi.PreviousScaleAnnotation)
+}
+
+// ScalableClient gets and updates the deployment configs and replication controllers that are
+// scaled by idling
+type ScalableClient struct {
+	Client     osclient.DeploymentConfigsNamespacer
+	KubeClient kclient.ReplicationControllersNamespacer
+}
+
+// Get returns the metadata and the replicas of the referenced object
+func (c *ScalableClient) Get(namespace, kind, name string) (*kapi.ObjectMeta, int, error) {
+	switch kind {
+	case "DeploymentConfig":
+		config, err := c.Client.DeploymentConfigs(namespace).Get(name)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &config.ObjectMeta, config.Spec.Replicas, nil
+	case "ReplicationController":
+		rc, err := c.KubeClient.ReplicationControllers(namespace).Get(name)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &rc.ObjectMeta, rc.Spec.Replicas, nil
+	default:
+		return nil, 0, fmt.Errorf("%s is not a deployment config or replication controller", kind)
+	}
+}
+
+// Update gets the referenced object, lets fn change its replicas and annotations, and stores
+// the result. The object is not stored if fn returns false.
+func (c *ScalableClient) Update(namespace, kind, name string, fn func(meta *kapi.ObjectMeta, replicas *int) bool) error {
+	switch kind {
+	case "DeploymentConfig":
+		config, err := c.Client.DeploymentConfigs(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if !updateScalable(&config.ObjectMeta, &config.Spec.Replicas, fn) {
+			return nil
+		}
+		_, err = c.Client.DeploymentConfigs(namespace).Update(config)
+		return err
+	case "ReplicationController":
+		rc, err := c.KubeClient.ReplicationControllers(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if !updateScalable(&rc.ObjectMeta, &rc.Spec.Replicas, fn) {
+			return nil
+		}
+		_, err = c.KubeClient.ReplicationControllers(namespace).Update(rc)
+		return err
+	default:
+		return fmt.Errorf("%s is not a deployment config or replication controller", kind)
+	}
+}
+
+func updateScalable(meta *kapi.ObjectMeta, replicas *int, fn func(*kapi.ObjectMeta, *int) bool) bool {
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	return fn(meta, replicas)
+}
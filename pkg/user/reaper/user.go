@@ -7,7 +7,9 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/types"
 
 	"github.com/openshift/origin/pkg/client"
 )
@@ -18,6 +20,9 @@ func NewUserReaper(
 	clusterBindingClient client.ClusterRoleBindingsInterface,
 	bindingClient client.RoleBindingsNamespacer,
 	sccClient kclient.SecurityContextConstraintsInterface,
+	identityClient client.IdentitiesInterface,
+	accessTokenClient client.OAuthAccessTokensInterface,
+	authorizeTokenClient client.OAuthAuthorizeTokensInterface,
 ) kubectl.Reaper {
 	return &UserReaper{
 		userClient:           userClient,
@@ -25,6 +30,9 @@ func NewUserReaper(
 		clusterBindingClient: clusterBindingClient,
 		bindingClient:        bindingClient,
 		sccClient:            sccClient,
+		identityClient:       identityClient,
+		accessTokenClient:    accessTokenClient,
+		authorizeTokenClient: authorizeTokenClient,
 	}
 }
 
@@ -34,11 +42,25 @@ type UserReaper struct {
 	clusterBindingClient client.ClusterRoleBindingsInterface
 	bindingClient        client.RoleBindingsNamespacer
 	sccClient            kclient.SecurityContextConstraintsInterface
+	identityClient       client.IdentitiesInterface
+	accessTokenClient    client.OAuthAccessTokensInterface
+	authorizeTokenClient client.OAuthAuthorizeTokensInterface
 }
 
-// Stop on a reaper is actually used for deletion.  In this case, we'll delete referencing identities, clusterBindings, and bindings,
-// then delete the user
+// Stop on a reaper is actually used for deletion.  In this case, we'll delete referencing clusterBindings, bindings,
+// identities, and oauth tokens, then delete the user
 func (r *UserReaper) Stop(namespace, name string, timeout time.Duration, gracePeriod *kapi.DeleteOptions) error {
+	// Look up the user's UID so identities referencing a different user with the same name are left alone
+	var uid types.UID
+	user, err := r.userClient.Users().Get(name)
+	switch {
+	case err == nil:
+		uid = user.UID
+	case kerrors.IsNotFound(err):
+	default:
+		return err
+	}
+
 	removedSubject := kapi.ObjectReference{Kind: "User", Name: name}
 
 	if err := reapClusterBindings(removedSubject, r.clusterBindingClient); err != nil {
@@ -91,9 +113,49 @@ func (r *UserReaper) Stop(namespace, name string, timeout time.Duration, gracePe
 		}
 	}
 
-	// Intentionally leave identities that reference the user
-	// The user does not "own" the identities
-	// If the admin wants to remove the identities, that is a distinct operation
+	// Remove identities mapped to the user, so they cannot be used to log in as a recreated user
+	identities, err := r.identityClient.Identities().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities.Items {
+		if identity.User.Name != name {
+			continue
+		}
+		if len(identity.User.UID) > 0 && len(uid) > 0 && identity.User.UID != uid {
+			continue
+		}
+		if err := r.identityClient.Identities().Delete(identity.Name); err != nil && !kerrors.IsNotFound(err) {
+			glog.Infof("Cannot delete identities/%s: %v", identity.Name, err)
+		}
+	}
+
+	// Remove the user's oauth tokens, so existing credentials stop granting access
+	userNameSelector := fields.OneTermEqualSelector("userName", name)
+	accessTokens, err := r.accessTokenClient.OAuthAccessTokens().List(kapi.ListOptions{FieldSelector: userNameSelector})
+	if err != nil {
+		return err
+	}
+	for _, token := range accessTokens.Items {
+		if token.UserName != name {
+			continue
+		}
+		if err := r.accessTokenClient.OAuthAccessTokens().Delete(token.Name); err != nil && !kerrors.IsNotFound(err) {
+			glog.Infof("Cannot delete oauthaccesstokens/%s: %v", token.Name, err)
+		}
+	}
+	authorizeTokens, err := r.authorizeTokenClient.OAuthAuthorizeTokens().List(kapi.ListOptions{FieldSelector: userNameSelector})
+	if err != nil {
+		return err
+	}
+	for _, token := range authorizeTokens.Items {
+		if token.UserName != name {
+			continue
+		}
+		if err := r.authorizeTokenClient.OAuthAuthorizeTokens().Delete(token.Name); err != nil && !kerrors.IsNotFound(err) {
+			glog.Infof("Cannot delete oauthauthorizetokens/%s: %v", token.Name, err)
+		}
+	}
 
 	// Remove the user
 	if err := r.userClient.Users().Delete(name); err != nil && !kerrors.IsNotFound(err) {
@@ -12,6 +12,7 @@ import (
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	"github.com/openshift/origin/pkg/client/testclient"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	authenticationapi "github.com/openshift/origin/pkg/user/api"
 )
 
@@ -118,6 +119,9 @@ func TestUserReaper(t *testing.T) {
 			name: "identities",
 			user: "bob",
 			objects: []runtime.Object{
+				&authenticationapi.User{
+					ObjectMeta: kapi.ObjectMeta{Name: "bob", UID: "456"},
+				},
 				&authenticationapi.Identity{
 					ObjectMeta: kapi.ObjectMeta{Name: "identity-no-user"},
 					User:       kapi.ObjectReference{},
@@ -130,13 +134,45 @@ func TestUserReaper(t *testing.T) {
 					ObjectMeta: kapi.ObjectMeta{Name: "identity-different-uid"},
 					User:       kapi.ObjectReference{Name: "bob", UID: "123"},
 				},
+				&authenticationapi.Identity{
+					ObjectMeta: kapi.ObjectMeta{Name: "identity-matching-uid"},
+					User:       kapi.ObjectReference{Name: "bob", UID: "456"},
+				},
 				&authenticationapi.Identity{
 					ObjectMeta: kapi.ObjectMeta{Name: "identity-different-user"},
 					User:       kapi.ObjectReference{Name: "bob2"},
 				},
 			},
 			expected: []interface{}{
-				// Make sure identities are not messed with, only the user is removed
+				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "identities"}, Name: "identity-matching-user"},
+				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "identities"}, Name: "identity-matching-uid"},
+				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "users"}, Name: "bob"},
+			},
+		},
+		{
+			name: "oauth tokens",
+			user: "bob",
+			objects: []runtime.Object{
+				&oauthapi.OAuthAccessToken{
+					ObjectMeta: kapi.ObjectMeta{Name: "access-token-matching-user"},
+					UserName:   "bob",
+				},
+				&oauthapi.OAuthAccessToken{
+					ObjectMeta: kapi.ObjectMeta{Name: "access-token-different-user"},
+					UserName:   "bob2",
+				},
+				&oauthapi.OAuthAuthorizeToken{
+					ObjectMeta: kapi.ObjectMeta{Name: "authorize-token-matching-user"},
+					UserName:   "bob",
+				},
+				&oauthapi.OAuthAuthorizeToken{
+					ObjectMeta: kapi.ObjectMeta{Name: "authorize-token-different-user"},
+					UserName:   "bob2",
+				},
+			},
+			expected: []interface{}{
+				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "oauthaccesstokens"}, Name: "access-token-matching-user"},
+				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "oauthauthorizetokens"}, Name: "authorize-token-matching-user"},
 				ktestclient.DeleteActionImpl{ActionImpl: ktestclient.ActionImpl{Verb: "delete", Resource: "users"}, Name: "bob"},
 			},
 		},
@@ -190,7 +226,7 @@ func TestUserReaper(t *testing.T) {
 		ktc.PrependReactor("update", "*", reactor)
 		ktc.PrependReactor("delete", "*", reactor)
 
-		reaper := NewUserReaper(tc, tc, tc, tc, ktc)
+		reaper := NewUserReaper(tc, tc, tc, tc, ktc, tc, tc, tc)
 		err := reaper.Stop("", test.user, 0, nil)
 		if err != nil {
 			t.Errorf("%s: unexpected error: %v", test.name, err)
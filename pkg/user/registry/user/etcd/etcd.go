@@ -2,9 +2,11 @@ package etcd
 
 import (
 	"errors"
+	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrs "k8s.io/kubernetes/pkg/api/errors"
+	kuser "k8s.io/kubernetes/pkg/auth/user"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/registry/generic"
@@ -14,6 +16,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
+	authapi "github.com/openshift/origin/pkg/auth/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	"github.com/openshift/origin/pkg/user/api"
 	"github.com/openshift/origin/pkg/user/api/validation"
@@ -64,28 +67,19 @@ func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
 		if !ok || user.GetName() == "" {
 			return nil, kerrs.NewForbidden("user", "~", errors.New("requests to ~ must be authenticated"))
 		}
-		name = user.GetName()
-
-		// remove the known virtual groups from the list if they are present
-		contextGroups := sets.NewString(user.GetGroups()...)
-		contextGroups.Delete(bootstrappolicy.UnauthenticatedGroup, bootstrappolicy.AuthenticatedGroup)
-
-		if ok, _ := validation.ValidateUserName(name, false); !ok {
-			// The user the authentication layer has identified cannot possibly be a persisted user
-			// Return an API representation of the virtual user
-			return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
-		}
-
-		obj, err := r.Etcd.Get(ctx, name)
-		if err == nil {
-			return obj, nil
-		}
-
-		if !kerrs.IsNotFound(err) {
+		current, err := r.getCurrentUser(ctx, user)
+		if err != nil {
 			return nil, err
 		}
 
-		return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
+		// report the scopes of the request, so that servers authenticating tokens against ~ can restrict them as well
+		if scopes := authapi.GetScopes(user); len(scopes) > 0 {
+			if current.Annotations == nil {
+				current.Annotations = map[string]string{}
+			}
+			current.Annotations[authapi.ScopesAnnotation] = strings.Join(scopes, ",")
+		}
+		return current, nil
 	}
 
 	if ok, details := validation.ValidateUserName(name, false); !ok {
@@ -94,3 +88,29 @@ func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
 
 	return r.Etcd.Get(ctx, name)
 }
+
+// getCurrentUser returns the persisted user of the request, or a virtual user with the groups of the request if there is none
+func (r *REST) getCurrentUser(ctx kapi.Context, user kuser.Info) (*api.User, error) {
+	name := user.GetName()
+
+	// remove the known virtual groups from the list if they are present
+	contextGroups := sets.NewString(user.GetGroups()...)
+	contextGroups.Delete(bootstrappolicy.UnauthenticatedGroup, bootstrappolicy.AuthenticatedGroup)
+
+	if ok, _ := validation.ValidateUserName(name, false); !ok {
+		// The user the authentication layer has identified cannot possibly be a persisted user
+		// Return an API representation of the virtual user
+		return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
+	}
+
+	obj, err := r.Etcd.Get(ctx, name)
+	if err == nil {
+		return obj.(*api.User), nil
+	}
+
+	if !kerrs.IsNotFound(err) {
+		return nil, err
+	}
+
+	return &api.User{ObjectMeta: kapi.ObjectMeta{Name: name}, Groups: contextGroups.List()}, nil
+}
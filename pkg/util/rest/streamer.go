@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"io"
+	"io/ioutil"
+
+	"k8s.io/kubernetes/pkg/api/rest"
+)
+
+// OffsetStreamer is a resource that streams the contents of another streamer after skipping the
+// first Offset bytes, so that a client can resume a stream it was disconnected from.
+type OffsetStreamer struct {
+	rest.ResourceStreamer
+	Offset int64
+}
+
+// an OffsetStreamer must implement a rest.ResourceStreamer
+var _ rest.ResourceStreamer = &OffsetStreamer{}
+
+// IsAnAPIObject marks this object as a runtime.Object
+func (*OffsetStreamer) IsAnAPIObject() {}
+
+// InputStream returns the stream of the wrapped streamer without its first Offset bytes.
+func (s *OffsetStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	stream, flush, contentType, err := s.ResourceStreamer.InputStream(apiVersion, acceptHeader)
+	if err != nil || stream == nil || s.Offset <= 0 {
+		return stream, flush, contentType, err
+	}
+	return &offsetReader{ReadCloser: stream, skip: s.Offset}, flush, contentType, nil
+}
+
+// offsetReader discards the first skip bytes of a stream on the first read, which blocks until
+// they were received.
+type offsetReader struct {
+	io.ReadCloser
+	skip int64
+}
+
+func (r *offsetReader) Read(p []byte) (int, error) {
+	if r.skip > 0 {
+		n, err := io.CopyN(ioutil.Discard, r.ReadCloser, r.skip)
+		r.skip -= n
+		if err != nil {
+			return 0, err
+		}
+	}
+	return r.ReadCloser.Read(p)
+}
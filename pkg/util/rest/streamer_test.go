@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+type fakeStreamer struct {
+	contents string
+}
+
+func (*fakeStreamer) IsAnAPIObject() {}
+func (s *fakeStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	return ioutil.NopCloser(strings.NewReader(s.contents)), true, "text/plain", nil
+}
+
+func TestOffsetStreamer(t *testing.T) {
+	tests := []struct {
+		offset   int64
+		expected string
+	}{
+		{offset: 0, expected: "line 1\nline 2\n"},
+		{offset: 7, expected: "line 2\n"},
+		{offset: 9, expected: "ne 2\n"},
+		{offset: 14, expected: ""},
+		{offset: 100, expected: ""},
+	}
+	for _, test := range tests {
+		streamer := &OffsetStreamer{ResourceStreamer: &fakeStreamer{"line 1\nline 2\n"}, Offset: test.offset}
+		stream, flush, contentType, err := streamer.InputStream("v1", "")
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", test.offset, err)
+		}
+		if !flush || contentType != "text/plain" {
+			t.Errorf("%d: expected the flush and content type of the wrapped streamer", test.offset)
+		}
+		data, err := ioutil.ReadAll(stream)
+		if test.offset < 100 && err != nil {
+			t.Errorf("%d: unexpected error: %v", test.offset, err)
+		}
+		if string(data) != test.expected {
+			t.Errorf("%d: expected %q, got %q", test.offset, test.expected, string(data))
+		}
+	}
+}
@@ -2,36 +2,98 @@ package simple
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
+	"k8s.io/kubernetes/pkg/util"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 
+	"github.com/openshift/origin/pkg/cmd/util/variable"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // Default DNS suffix to use if no configuration is passed to this plugin.
 const defaultDNSSuffix = "router.default.svc.cluster.local"
 
+// DefaultHostnameTemplate is the template of generated host names if no
+// template is passed to this plugin.
+const DefaultHostnameTemplate = "${name}-${namespace}.${subdomain}"
+
 // SimpleAllocationPlugin implements the route.AllocationPlugin interface
 // to provide a simple unsharded (or single sharded) allocation plugin.
 type SimpleAllocationPlugin struct {
 	DNSSuffix string
+	// HostnameTemplate is the template of generated host names. ${name},
+	// ${namespace} and ${subdomain} are replaced with the name and namespace
+	// of the route and the DNS suffix of its router shard.
+	HostnameTemplate string
 }
 
 // NewSimpleAllocationPlugin creates a new SimpleAllocationPlugin.
-func NewSimpleAllocationPlugin(suffix string) (*SimpleAllocationPlugin, error) {
+func NewSimpleAllocationPlugin(suffix, hostnameTemplate string) (*SimpleAllocationPlugin, error) {
 	if len(suffix) == 0 {
 		suffix = defaultDNSSuffix
 	}
+	if len(hostnameTemplate) == 0 {
+		hostnameTemplate = DefaultHostnameTemplate
+	}
 
-	glog.V(4).Infof("Route plugin initialized with suffix=%s", suffix)
+	glog.V(4).Infof("Route plugin initialized with suffix=%s and hostname template=%s", suffix, hostnameTemplate)
 
 	// Check that the DNS suffix is valid.
 	if !kvalidation.IsDNS1123Subdomain(suffix) {
 		return nil, fmt.Errorf("invalid DNS suffix: %s", suffix)
 	}
+	if err := ValidateHostnameTemplate(hostnameTemplate, suffix); err != nil {
+		return nil, err
+	}
+
+	return &SimpleAllocationPlugin{DNSSuffix: suffix, HostnameTemplate: hostnameTemplate}, nil
+}
+
+// ExpandHostnameTemplate returns the host name the template generates for a
+// route with the given name and namespace in a router shard with the given
+// DNS suffix. Keys other than name, namespace and subdomain are an error.
+func ExpandHostnameTemplate(template, name, namespace, suffix string) (string, error) {
+	return variable.ExpandStrict(template, func(key string) (string, bool) {
+		switch key {
+		case "name":
+			return name, true
+		case "namespace":
+			return namespace, true
+		case "subdomain":
+			return suffix, true
+		}
+		return "", false
+	})
+}
+
+// ValidateHostnameTemplate checks that the template only uses known keys and
+// generates valid DNS subdomains for routes in a router shard with the given
+// DNS suffix.
+func ValidateHostnameTemplate(template, suffix string) error {
+	host, err := ExpandHostnameTemplate(template, "name", "namespace", suffix)
+	if err != nil {
+		return err
+	}
+	if !isValidHostname(host) {
+		return fmt.Errorf("hostname template %q generates invalid DNS names such as %q", template, host)
+	}
+	return nil
+}
 
-	return &SimpleAllocationPlugin{DNSSuffix: suffix}, nil
+// isValidHostname returns true if host is a DNS subdomain whose labels are
+// all valid DNS labels of at most 63 characters.
+func isValidHostname(host string) bool {
+	if !kvalidation.IsDNS1123Subdomain(host) {
+		return false
+	}
+	for _, label := range strings.Split(host, ".") {
+		if !kvalidation.IsDNS1123Label(label) {
+			return false
+		}
+	}
+	return true
 }
 
 // Allocate a router shard for the given route. This plugin always returns
@@ -43,12 +105,27 @@ func (p *SimpleAllocationPlugin) Allocate(route *routeapi.Route) (*routeapi.Rout
 	return &routeapi.RouterShard{ShardName: "global", DNSSuffix: p.DNSSuffix}, nil
 }
 
-// GenerateHostname generates a host name for a route - using the service name,
-// namespace (if provided) and the router shard dns suffix.
+// GenerateHostname generates a host name for a route from the hostname
+// template - using the route name, namespace (if provided) and the router
+// shard dns suffix. No host name is generated if the result is not a valid
+// DNS subdomain, for instance because it is too long.
 // TODO: move to router code, and have the routers set this back on the route status.
 func (p *SimpleAllocationPlugin) GenerateHostname(route *routeapi.Route, shard *routeapi.RouterShard) string {
 	if len(route.Name) == 0 || len(route.Namespace) == 0 {
 		return ""
 	}
-	return fmt.Sprintf("%s-%s.%s", route.Name, route.Namespace, shard.DNSSuffix)
+	template := p.HostnameTemplate
+	if len(template) == 0 {
+		template = DefaultHostnameTemplate
+	}
+	host, err := ExpandHostnameTemplate(template, route.Name, route.Namespace, shard.DNSSuffix)
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to generate a host name for route %s/%s: %v", route.Namespace, route.Name, err))
+		return ""
+	}
+	if !isValidHostname(host) {
+		util.HandleError(fmt.Errorf("unable to generate a host name for route %s/%s: %q is not a valid DNS subdomain", route.Namespace, route.Name, host))
+		return ""
+	}
+	return host
 }
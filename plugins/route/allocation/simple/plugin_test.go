@@ -46,7 +46,7 @@ func TestNewSimpleAllocationPlugin(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		sap, err := NewSimpleAllocationPlugin(tc.Name)
+		sap, err := NewSimpleAllocationPlugin(tc.Name, "")
 		if err != nil && !tc.ErrorExpectation {
 			t.Errorf("Test case for %s got an error where none was expected", tc.Name)
 		}
@@ -135,7 +135,7 @@ func TestSimpleAllocationPlugin(t *testing.T) {
 		},
 	}
 
-	plugin, err := NewSimpleAllocationPlugin("www.example.org")
+	plugin, err := NewSimpleAllocationPlugin("www.example.org", "")
 	if err != nil {
 		t.Errorf("Error creating SimpleAllocationPlugin got %s", err)
 		return
@@ -228,7 +228,7 @@ func TestSimpleAllocationPluginViaController(t *testing.T) {
 		},
 	}
 
-	plugin, _ := NewSimpleAllocationPlugin("www.example.org")
+	plugin, _ := NewSimpleAllocationPlugin("www.example.org", "")
 	fac := &rac.RouteAllocationControllerFactory{OSClient: nil, KubeClient: nil}
 	sac := fac.Create(plugin)
 
@@ -249,3 +249,71 @@ func TestSimpleAllocationPluginViaController(t *testing.T) {
 		}
 	}
 }
+
+func TestNewSimpleAllocationPluginHostnameTemplate(t *testing.T) {
+	tests := []struct {
+		template  string
+		expectErr bool
+	}{
+		{template: ""},
+		{template: "${name}.${namespace}.${subdomain}"},
+		{template: "${namespace}-${name}.apps.example.com"},
+		{template: "${name}.${service}.${subdomain}", expectErr: true},
+		{template: "${name}_${namespace}.${subdomain}", expectErr: true},
+		{template: "${name}-${namespace}.", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		_, err := NewSimpleAllocationPlugin("www.example.org", tc.template)
+		if err != nil && !tc.expectErr {
+			t.Errorf("%q: unexpected error: %v", tc.template, err)
+		}
+		if err == nil && tc.expectErr {
+			t.Errorf("%q: expected an error", tc.template)
+		}
+	}
+}
+
+func TestSimpleAllocationPluginGenerateHostnameFromTemplate(t *testing.T) {
+	route := func(name string) *api.Route {
+		return &api.Route{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "foo"}}
+	}
+	tests := []struct {
+		template string
+		route    *api.Route
+		expected string
+	}{
+		{
+			template: "",
+			route:    route("name"),
+			expected: "name-foo.www.example.org",
+		},
+		{
+			template: "${name}.${namespace}.${subdomain}",
+			route:    route("name"),
+			expected: "name.foo.www.example.org",
+		},
+		{
+			template: "${namespace}-${name}.apps.example.com",
+			route:    route("name"),
+			expected: "foo-name.apps.example.com",
+		},
+		{
+			// the first label is longer than 63 characters
+			template: "",
+			route:    route("a-very-long-route-name-that-does-not-fit-into-a-dns-label-with-its-namespace"),
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		plugin, err := NewSimpleAllocationPlugin("www.example.org", tc.template)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tc.template, err)
+		}
+		shard, _ := plugin.Allocate(tc.route)
+		if host := plugin.GenerateHostname(tc.route, shard); host != tc.expected {
+			t.Errorf("%q: expected host %q, got %q", tc.template, tc.expected, host)
+		}
+	}
+}
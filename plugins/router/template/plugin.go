@@ -10,10 +10,12 @@ import (
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
 	ktypes "k8s.io/kubernetes/pkg/types"
+	"k8s.io/kubernetes/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
 )
 
 // TemplatePlugin implements the router.Plugin interface to provide
@@ -21,6 +23,10 @@ import (
 type TemplatePlugin struct {
 	Router     routerInterface
 	IncludeUDP bool
+	// ServiceFetcher finds the services of endpoints, to resolve the pod port names that routes
+	// target and to send the traffic of idled endpoints to the service IP so that the proxy
+	// wakes them up. Idled endpoints get no router endpoints when unset.
+	ServiceFetcher ServiceLookup
 }
 
 func newDefaultTemplatePlugin(router routerInterface, includeUDP bool) *TemplatePlugin {
@@ -74,7 +80,7 @@ type routerInterface interface {
 }
 
 // NewTemplatePlugin creates a new TemplatePlugin.
-func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
+func NewTemplatePlugin(cfg TemplatePluginConfig, lookupSvc ServiceLookup) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
 		"endpointsForAlias": endpointsForAlias,
@@ -110,7 +116,9 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 		peerEndpointsKey:   peerKey,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
-	return newDefaultTemplatePlugin(router, cfg.IncludeUDP), err
+	plugin := newDefaultTemplatePlugin(router, cfg.IncludeUDP)
+	plugin.ServiceFetcher = lookupSvc
+	return plugin, err
 }
 
 // HandleEndpoints processes watch events on the Endpoints resource.
@@ -130,7 +138,7 @@ func (p *TemplatePlugin) HandleEndpoints(eventType watch.EventType, endpoints *k
 	switch eventType {
 	case watch.Added, watch.Modified:
 		glog.V(4).Infof("Modifying endpoints for %s", key)
-		routerEndpoints := createRouterEndpoints(endpoints, !p.IncludeUDP)
+		routerEndpoints := createRouterEndpoints(endpoints, !p.IncludeUDP, p.ServiceFetcher)
 		key := endpointsKey(endpoints)
 		commit := p.Router.AddEndpoints(key, routerEndpoints)
 		if commit {
@@ -196,8 +204,13 @@ func peerEndpointsKey(namespacedName ktypes.NamespacedName) string {
 }
 
 // createRouterEndpoints creates openshift router endpoints based on k8s endpoints
-func createRouterEndpoints(endpoints *kapi.Endpoints, excludeUDP bool) []Endpoint {
+func createRouterEndpoints(endpoints *kapi.Endpoints, excludeUDP bool, lookupSvc ServiceLookup) []Endpoint {
+	if _, idled := endpoints.Annotations[unidlingapi.IdledAtAnnotation]; idled && lookupSvc != nil && !hasAddresses(endpoints) {
+		return createIdledRouterEndpoints(endpoints, excludeUDP, lookupSvc)
+	}
+
 	out := make([]Endpoint, 0, len(endpoints.Subsets)*4)
+	targetPortNames := serviceTargetPortNames(endpoints, lookupSvc)
 
 	// TODO: review me for sanity
 	for _, s := range endpoints.Subsets {
@@ -211,7 +224,8 @@ func createRouterEndpoints(endpoints *kapi.Endpoints, excludeUDP bool) []Endpoin
 					IP:   a.IP,
 					Port: strconv.Itoa(p.Port),
 
-					PortName: p.Name,
+					PortName:       p.Name,
+					TargetPortName: targetPortNames[p.Name],
 				}
 				if a.TargetRef != nil {
 					ep.TargetName = a.TargetRef.Name
@@ -225,3 +239,64 @@ func createRouterEndpoints(endpoints *kapi.Endpoints, excludeUDP bool) []Endpoin
 
 	return out
 }
+
+// serviceTargetPortNames returns the named pod ports targeted by the ports of the service of the
+// endpoints, keyed by the service port name, so routes can refer to the ports of the pods by name.
+func serviceTargetPortNames(endpoints *kapi.Endpoints, lookupSvc ServiceLookup) map[string]string {
+	if lookupSvc == nil {
+		return nil
+	}
+	service, err := lookupSvc.LookupService(endpoints)
+	if err != nil {
+		glog.V(4).Infof("Unable to find the service of the endpoints %s/%s: %v", endpoints.Namespace, endpoints.Name, err)
+		return nil
+	}
+	names := make(map[string]string)
+	for _, p := range service.Spec.Ports {
+		if p.TargetPort.Type == intstr.String {
+			names[p.Name] = p.TargetPort.StrVal
+		}
+	}
+	return names
+}
+
+// createIdledRouterEndpoints returns the service IP and ports as the endpoints of an idled
+// service, so that the proxy receives its traffic and scales the service back up
+func createIdledRouterEndpoints(endpoints *kapi.Endpoints, excludeUDP bool, lookupSvc ServiceLookup) []Endpoint {
+	service, err := lookupSvc.LookupService(endpoints)
+	if err != nil {
+		glog.V(4).Infof("Unable to find the service of the idled endpoints %s/%s: %v", endpoints.Namespace, endpoints.Name, err)
+		return []Endpoint{}
+	}
+	if !kapi.IsServiceIPSet(service) {
+		return []Endpoint{}
+	}
+
+	out := make([]Endpoint, 0, len(service.Spec.Ports))
+	for _, p := range service.Spec.Ports {
+		if excludeUDP && p.Protocol == kapi.ProtocolUDP {
+			continue
+		}
+		ep := Endpoint{
+			ID:         fmt.Sprintf("%s:%d", service.Spec.ClusterIP, p.Port),
+			IP:         service.Spec.ClusterIP,
+			Port:       strconv.Itoa(p.Port),
+			PortName:   p.Name,
+			TargetName: service.Name,
+		}
+		if p.TargetPort.Type == intstr.String {
+			ep.TargetPortName = p.TargetPort.StrVal
+		}
+		out = append(out, ep)
+	}
+	return out
+}
+
+func hasAddresses(endpoints *kapi.Endpoints) bool {
+	for _, s := range endpoints.Subsets {
+		if len(s.Addresses) > 0 {
+			return true
+		}
+	}
+	return false
+}
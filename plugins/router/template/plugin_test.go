@@ -9,11 +9,13 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	"github.com/openshift/origin/pkg/router/controller"
+	unidlingapi "github.com/openshift/origin/pkg/unidling/api"
 )
 
 // TestRouter provides an implementation of the plugin's router interface suitable for unit testing.
@@ -340,6 +342,154 @@ func TestHandleTCPEndpoints(t *testing.T) {
 	}
 }
 
+type fakeServiceLookup struct {
+	services map[string]*kapi.Service
+}
+
+func (l *fakeServiceLookup) LookupService(endpoints *kapi.Endpoints) (*kapi.Service, error) {
+	service, ok := l.services[endpoints.Namespace+"/"+endpoints.Name]
+	if !ok {
+		return nil, fmt.Errorf("service %s/%s not found", endpoints.Namespace, endpoints.Name)
+	}
+	return service, nil
+}
+
+// TestHandleIdledEndpoints tests that idled endpoints point the router at the service IP
+func TestHandleIdledEndpoints(t *testing.T) {
+	idled := map[string]string{unidlingapi.IdledAtAnnotation: "2016-01-01T00:00:00Z"}
+	lookup := &fakeServiceLookup{services: map[string]*kapi.Service{
+		"foo/test": {
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+			Spec: kapi.ServiceSpec{
+				ClusterIP: "172.30.0.1",
+				Ports: []kapi.ServicePort{
+					{Name: "web", Port: 8080, Protocol: kapi.ProtocolTCP},
+					{Name: "dns", Port: 53, Protocol: kapi.ProtocolUDP},
+				},
+			},
+		},
+		"foo/headless": {
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "headless"},
+			Spec: kapi.ServiceSpec{
+				ClusterIP: kapi.ClusterIPNone,
+				Ports:     []kapi.ServicePort{{Port: 8080}},
+			},
+		},
+	}}
+
+	testCases := []struct {
+		name      string
+		endpoints *kapi.Endpoints
+		expected  []Endpoint
+	}{
+		{
+			name: "idled endpoints",
+			endpoints: &kapi.Endpoints{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test", Annotations: idled},
+			},
+			expected: []Endpoint{{ID: "172.30.0.1:8080", IP: "172.30.0.1", Port: "8080", PortName: "web", TargetName: "test"}},
+		},
+		{
+			name: "idled endpoints being scaled up",
+			endpoints: &kapi.Endpoints{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test", Annotations: idled},
+				Subsets: []kapi.EndpointSubset{{
+					Addresses: []kapi.EndpointAddress{{IP: "1.1.1.1"}},
+					Ports:     []kapi.EndpointPort{{Port: 345}},
+				}},
+			},
+			expected: []Endpoint{{ID: "1.1.1.1:345", IP: "1.1.1.1", Port: "345", TargetName: "1.1.1.1"}},
+		},
+		{
+			name: "idled endpoints of a headless service",
+			endpoints: &kapi.Endpoints{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "headless", Annotations: idled},
+			},
+			expected: []Endpoint{},
+		},
+		{
+			name: "idled endpoints without a service",
+			endpoints: &kapi.Endpoints{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "missing", Annotations: idled},
+			},
+			expected: []Endpoint{},
+		},
+	}
+
+	for _, tc := range testCases {
+		router := newTestRouter(make(map[string]ServiceUnit))
+		plugin := newDefaultTemplatePlugin(router, false)
+		plugin.ServiceFetcher = lookup
+
+		if err := plugin.HandleEndpoints(watch.Added, tc.endpoints); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		su, ok := router.FindServiceUnit(tc.endpoints.Namespace + "/" + tc.endpoints.Name)
+		if !ok {
+			t.Errorf("%s: service unit was not created", tc.name)
+			continue
+		}
+		if !reflect.DeepEqual(su.EndpointTable, tc.expected) {
+			t.Errorf("%s: expected endpoints %#v, got %#v", tc.name, tc.expected, su.EndpointTable)
+		}
+	}
+}
+
+// TestHandleEndpointsTargetPortNames tests that routes may select endpoints by the named pod
+// port that the service port targets
+func TestHandleEndpointsTargetPortNames(t *testing.T) {
+	lookup := &fakeServiceLookup{services: map[string]*kapi.Service{
+		"foo/test": {
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+			Spec: kapi.ServiceSpec{
+				ClusterIP: "172.30.0.1",
+				Ports: []kapi.ServicePort{
+					{Name: "web", Port: 80, TargetPort: intstr.FromString("http")},
+					{Name: "metrics", Port: 9090, TargetPort: intstr.FromInt(9090)},
+				},
+			},
+		},
+	}}
+	endpoints := &kapi.Endpoints{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+		Subsets: []kapi.EndpointSubset{{
+			Addresses: []kapi.EndpointAddress{{IP: "1.1.1.1"}},
+			Ports:     []kapi.EndpointPort{{Name: "web", Port: 8080}, {Name: "metrics", Port: 9090}},
+		}},
+	}
+
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := newDefaultTemplatePlugin(router, false)
+	plugin.ServiceFetcher = lookup
+	if err := plugin.HandleEndpoints(watch.Added, endpoints); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	su, ok := router.FindServiceUnit("foo/test")
+	if !ok {
+		t.Fatalf("service unit was not created")
+	}
+
+	testCases := map[string]string{
+		"http":    "1.1.1.1:8080",
+		"web":     "1.1.1.1:8080",
+		"8080":    "1.1.1.1:8080",
+		"metrics": "1.1.1.1:9090",
+		"9090":    "1.1.1.1:9090",
+		"other":   "",
+	}
+	for port, expected := range testCases {
+		endpoints := endpointsForAlias(ServiceAliasConfig{PreferPort: port}, su)
+		switch {
+		case len(expected) == 0 && len(endpoints) != 0:
+			t.Errorf("%s: expected no endpoints, got %#v", port, endpoints)
+		case len(expected) > 0 && (len(endpoints) != 1 || endpoints[0].ID != expected):
+			t.Errorf("%s: expected endpoint %s, got %#v", port, expected, endpoints)
+		}
+	}
+}
+
 // TestHandleRoute test route watch events
 func TestHandleRoute(t *testing.T) {
 	router := newTestRouter(make(map[string]ServiceUnit))
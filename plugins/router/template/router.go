@@ -176,7 +176,7 @@ func endpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
 	endpoints := make([]Endpoint, 0, len(svc.EndpointTable))
 	for i := range svc.EndpointTable {
 		endpoint := svc.EndpointTable[i]
-		if endpoint.PortName == alias.PreferPort || endpoint.Port == alias.PreferPort {
+		if endpoint.PortName == alias.PreferPort || endpoint.Port == alias.PreferPort || endpoint.TargetPortName == alias.PreferPort {
 			endpoints = append(endpoints, endpoint)
 		}
 	}
@@ -0,0 +1,53 @@
+package templaterouter
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+)
+
+// ServiceLookup finds the service of a set of endpoints
+type ServiceLookup interface {
+	LookupService(*kapi.Endpoints) (*kapi.Service, error)
+}
+
+// NewListWatchServiceLookup returns a ServiceLookup backed by a cache of all services, which
+// is refreshed every resync period
+func NewListWatchServiceLookup(svcGetter kclient.ServicesNamespacer, resync time.Duration) ServiceLookup {
+	svcStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	lw := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return svcGetter.Services(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return svcGetter.Services(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	cache.NewReflector(lw, &kapi.Service{}, svcStore, resync).Run()
+
+	return &serviceLWLookup{store: svcStore}
+}
+
+type serviceLWLookup struct {
+	store cache.Store
+}
+
+func (c *serviceLWLookup) LookupService(endpoints *kapi.Endpoints) (*kapi.Service, error) {
+	key, err := cache.MetaNamespaceKeyFunc(endpoints)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok, err := c.store.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("service %s not found", key)
+	}
+	return obj.(*kapi.Service), nil
+}
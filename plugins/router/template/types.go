@@ -62,6 +62,8 @@ type Endpoint struct {
 	Port       string
 	TargetName string
 	PortName   string
+	// TargetPortName is the named port of the pods that the service port of this endpoint targets
+	TargetPortName string
 }
 
 // certificateManager provides the ability to write certificates for a ServiceAliasConfig
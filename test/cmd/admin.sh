@@ -77,6 +77,18 @@ echo "groups: ok"
 os::cmd::expect_success 'oadm policy who-can get pods'
 os::cmd::expect_success 'oadm policy who-can get pods -n default'
 os::cmd::expect_success 'oadm policy who-can get pods --all-namespaces'
+os::cmd::expect_success_and_text 'oadm policy who-can get pods mypod' 'Name:      mypod'
+os::cmd::expect_success_and_text 'oadm policy who-can get /healthz' 'Non-Resource URL: /healthz'
+
+os::cmd::expect_success_and_text 'oc policy can-i get pods' 'yes'
+os::cmd::expect_success_and_text 'oc policy can-i get /healthz' 'yes'
+os::cmd::expect_success_and_text 'oc policy can-i --list' 'selfsubjectrulesreviews'
+os::cmd::expect_failure 'oc policy can-i --list get pods'
+os::cmd::expect_success 'oc policy can-i get pods -q'
+os::cmd::expect_failure 'oc policy can-i get pods --scopes=user:info -q'
+os::cmd::expect_success_and_text 'oc policy can-i get pods --scopes=' 'yes'
+os::cmd::expect_failure_and_text 'oc policy can-i delete namespaces -z deployer' 'no'
+os::cmd::expect_failure 'oc policy can-i get pods -z deployer --user=alice'
 
 os::cmd::expect_success 'oadm policy add-role-to-group cluster-admin system:unauthenticated'
 os::cmd::expect_success 'oadm policy add-role-to-user cluster-admin system:no-user'
@@ -138,6 +150,14 @@ os::cmd::expect_success 'oadm policy reconcile-cluster-roles --confirm'
 os::cmd::expect_success_and_not_text 'oc get clusterroles/basic-user -o yaml' 'groups'
 echo "admin-reconcile-cluster-roles: ok"
 
+# a new cluster starts at the current version of the bootstrap policy
+os::cmd::expect_success_and_text 'oadm policy migrate-bootstrap-policy' 'there is nothing to migrate'
+os::cmd::expect_success 'oc annotate clusterrole/cluster-admin openshift.io/bootstrap-policy-version-'
+os::cmd::expect_success_and_text 'oadm policy migrate-bootstrap-policy' 'Run with --confirm to migrate from version 0'
+os::cmd::expect_success 'oadm policy migrate-bootstrap-policy --confirm'
+os::cmd::expect_success_and_text 'oadm policy migrate-bootstrap-policy' 'there is nothing to migrate'
+echo "admin-migrate-bootstrap-policy: ok"
+
 # Ensure a removed binding gets re-added
 os::cmd::expect_success 'oc delete clusterrolebinding/cluster-status-binding'
 os::cmd::expect_failure 'oc get clusterrolebinding/cluster-status-binding'
@@ -229,6 +249,11 @@ os::cmd::expect_success_and_text 'oadm build-chain ruby-22-centos7 -o dot' 'digr
 os::cmd::expect_success 'oc delete all -l build=sti'
 echo "ex build-chain: ok"
 
+os::cmd::expect_success_and_text 'oadm top images' 'IMAGESTREAMTAGS'
+os::cmd::expect_success_and_text 'oadm top imagestreams' 'EXCLUSIVE'
+os::cmd::expect_failure_and_text 'oadm top images extra' 'no arguments are allowed'
+echo "top: ok"
+
 os::cmd::expect_success 'oadm new-project example --admin="createuser"'
 os::cmd::expect_success 'oc project example'
 os::cmd::try_until_success 'oc get serviceaccount default'
@@ -82,6 +82,22 @@ os::cmd::expect_success_and_text "oc get bc/ruby-sample-build --template='{{ .sp
 os::cmd::expect_success "oc patch bc/ruby-sample-build -p '{\"spec\":{\"output\":{\"to\":{\"name\":\"${REAL_OUTPUT_TO}\"}}}}'"
 echo "patchAnonFields: ok"
 
+os::cmd::expect_success_and_text 'oc set env bc/ruby-sample-build FOO=bar --dry-run' 'updated \(dry run\)'
+os::cmd::expect_success_and_not_text 'oc env bc/ruby-sample-build --list' 'FOO=bar'
+os::cmd::expect_success_and_text 'oc set env bc/ruby-sample-build FOO=bar -o yaml' 'name: FOO'
+os::cmd::expect_success 'oc set env bc/ruby-sample-build FOO=bar'
+os::cmd::expect_success_and_text 'oc env bc/ruby-sample-build --list' 'FOO=bar'
+os::cmd::expect_success 'oc set env bc/ruby-sample-build FOO-'
+os::cmd::expect_success_and_not_text 'oc env bc/ruby-sample-build --list' 'FOO=bar'
+os::cmd::expect_success_and_text 'oc set resources bc/ruby-sample-build --limits=memory=1Gi --dry-run -o yaml' 'memory: 1Gi'
+os::cmd::expect_failure_and_text 'oc set probe bc/ruby-sample-build --readiness --open-tcp=8080' 'does not have a pod template'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-sample-build' 'buildconfigs/ruby-sample-build\s+github\s+secret101'
+os::cmd::expect_success_and_not_text 'oc set triggers bc/ruby-sample-build --from-github --remove -o yaml' 'type: GitHub'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-sample-build --from-github --remove -o yaml' 'type: Generic'
+os::cmd::expect_success_and_text 'oc set triggers bc/ruby-sample-build --from-image=openshift/ruby:2.2 --dry-run -o yaml' 'namespace: openshift'
+os::cmd::expect_failure_and_text 'oc set triggers bc/ruby-sample-build --manual' 'does not support --auto or --manual'
+echo "set: ok"
+
 os::cmd::expect_success_and_text 'oc describe buildConfigs ruby-sample-build' "Webhook GitHub.+${url}/oapi/v1/namespaces/${project}/buildconfigs/ruby-sample-build/webhooks/secret101/github"
 os::cmd::expect_success_and_text 'oc describe buildConfigs ruby-sample-build' "Webhook Generic.+${url}/oapi/v1/namespaces/${project}/buildconfigs/ruby-sample-build/webhooks/secret101/generic"
 os::cmd::expect_success 'oc start-build --list-webhooks='all' ruby-sample-build'
@@ -57,6 +57,28 @@ os::cmd::expect_success_and_not_text 'oc env dc/test-deployment-config --list' '
 os::cmd::expect_success_and_not_text 'oc env dc/test-deployment-config --list' 'C=c'
 os::cmd::expect_success_and_not_text 'oc env dc/test-deployment-config --list' 'G=g'
 echo "env: ok"
+os::cmd::expect_success_and_text 'oc debug dc/test-deployment-config -o yaml' '\- /bin/sh'
+os::cmd::expect_success_and_text 'oc debug dc/test-deployment-config -o yaml' 'debug.openshift.io/source-resource: deploymentconfigs/test-deployment-config'
+os::cmd::expect_success_and_not_text 'oc debug dc/test-deployment-config -o yaml' 'name: test-deployment$'
+os::cmd::expect_success_and_text 'oc debug dc/test-deployment-config -o yaml -- /bin/env' '\- /bin/env'
+os::cmd::expect_success_and_text 'oc debug dc/test-deployment-config DEBUG=true -o yaml' 'name: DEBUG'
+os::cmd::expect_failure_and_text 'oc debug dc/test-deployment-config -c missing -o yaml' 'container "missing" not found'
+echo "debug: ok"
+os::cmd::expect_success_and_text 'oc set probe dc/test-deployment-config --readiness --open-tcp=8080 --dry-run -o yaml' 'tcpSocket:'
+os::cmd::expect_success_and_text 'oc set probe dc/test-deployment-config --liveness --get-url=http://:8080/healthz --initial-delay-seconds=10 -o yaml' 'initialDelaySeconds: 10'
+os::cmd::expect_failure_and_text 'oc set probe dc/test-deployment-config --liveness --initial-delay-seconds=10' 'the probe does not exist'
+os::cmd::expect_success_and_text 'oc set probe dc/test-deployment-config --readiness -- echo ok' 'deploymentconfigs/test-deployment-config'
+os::cmd::expect_success_and_text 'oc get dc/test-deployment-config -o yaml' 'readinessProbe:'
+os::cmd::expect_success 'oc set probe dc/test-deployment-config --readiness --remove'
+os::cmd::expect_success_and_not_text 'oc get dc/test-deployment-config -o yaml' 'readinessProbe:'
+os::cmd::expect_success_and_text 'oc set resources dc/test-deployment-config --limits=cpu=200m --requests=memory=64Mi -o yaml' 'cpu: 200m'
+os::cmd::expect_success_and_text 'oc set volumes dc/test-deployment-config --add --name=scratch --mount-path=/scratch --dry-run' 'dry run'
+os::cmd::expect_success_and_text 'oc set triggers dc/test-deployment-config' 'deploymentconfigs/test-deployment-config\s+config'
+os::cmd::expect_success_and_text 'oc set triggers dc/test-deployment-config --from-image=test-image:latest --manual -o yaml' 'automatic: false'
+os::cmd::expect_success_and_not_text 'oc set triggers dc/test-deployment-config --from-config --remove -o yaml' 'type: ConfigChange'
+os::cmd::expect_failure_and_text 'oc set triggers dc/test-deployment-config --from-github' 'does not support webhook triggers'
+os::cmd::expect_success_and_text 'oc set triggers dc/test-deployment-config --remove-all -o yaml' 'triggers: \[\]'
+echo "set: ok"
 os::cmd::expect_success 'oc deploy test-deployment-config'
 os::cmd::expect_success 'oc deploy dc/test-deployment-config'
 os::cmd::expect_success 'oc delete deploymentConfigs test-deployment-config'
@@ -84,7 +106,14 @@ os::cmd::expect_success 'oc expose dc/database --name=fromdc'
 # should be a service
 os::cmd::expect_success 'oc get svc/fromdc'
 os::cmd::expect_success 'oc delete svc/fromdc'
+os::cmd::expect_success_and_text 'oc autoscale dc/database --max=5' 'deploymentconfig "database" autoscaled'
+os::cmd::expect_success_and_text 'oc get hpa/database -o yaml' 'kind: DeploymentConfig'
+os::cmd::expect_success 'oc delete hpa/database'
 os::cmd::expect_success 'oc stop dc/database'
 os::cmd::expect_failure 'oc get dc/database'
 os::cmd::expect_failure 'oc get rc/database-1'
 echo "stop: ok"
+
+os::cmd::expect_failure_and_text 'oc idle' 'You must specify the services to idle'
+os::cmd::expect_failure_and_text 'oc idle --all -l app=database' 'may not be combined with a selector'
+echo "idle: ok"
@@ -13,14 +13,20 @@ os::log::install_errexit
 
 # available diagnostics (2015-12-21):
 # AnalyzeLogs ClusterRegistry ClusterRoleBindings ClusterRoles ClusterRouter ConfigContexts DiagnosticPod MasterConfigCheck MasterNode NodeConfigCheck NodeDefinitions UnitStatus
+# added since: ClusterNetwork NodeSDNFlows
 # Without things feeding into systemd, AnalyzeLogs and UnitStatus are irrelevant.
+# Without Open vSwitch on the host, NodeSDNFlows is skipped.
 # The rest should be included in some fashion.
 
 os::cmd::expect_success 'openshift ex diagnostics ClusterRoleBindings ClusterRoles ConfigContexts '
 # DiagnosticPod can't run without Docker, would just time out. Exercise flags instead.
 os::cmd::expect_success "openshift ex diagnostics DiagnosticPod --prevent-modification --images=foo"
-os::cmd::expect_success "openshift ex diagnostics MasterConfigCheck NodeConfigCheck --master-config=${MASTER_CONFIG_DIR}/master-config.yaml --node-config=${NODE_CONFIG_DIR}/node-config.yaml"
-os::cmd::expect_success_and_text 'openshift ex diagnostics ClusterRegistry' "DClu1002 from diagnostic ClusterRegistry"
+# the generated master config warns about the missing logging and metrics URLs; warnings exit with 2
+os::cmd::expect_code "openshift ex diagnostics MasterConfigCheck NodeConfigCheck --master-config=${MASTER_CONFIG_DIR}/master-config.yaml --node-config=${NODE_CONFIG_DIR}/node-config.yaml" '2'
+os::cmd::expect_code_and_text 'openshift ex diagnostics ClusterRegistry' '2' "DClu1002 from diagnostic ClusterRegistry"
+os::cmd::expect_code_and_text 'oadm diagnostics ClusterRegistry --output=json' '2' '"id":"DClu1002"'
+os::cmd::expect_success 'oadm diagnostics ClusterNetwork'
+os::cmd::expect_failure_and_text 'oadm diagnostics ClusterNetwork --output=xml' 'Invalid diagnostic output format'
 # ClusterRouter fails differently depending on whether other tests have run first, so don't test for specific error
 # no ordering allowed
 #os::cmd::expect_failure 'openshift ex diagnostics ClusterRouter' # "DClu2001 from diagnostic ClusterRouter"
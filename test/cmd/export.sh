@@ -24,6 +24,9 @@ os::cmd::expect_success 'oc export all --all'
 
 os::cmd::expect_success_and_not_text "oc export svc --template='{{range .items}}{{.metadata.name}}{{\"\n\"}}{{end}}' | wc -l" '^0' # don't expect a leading zero, i.e. expect non-zero count
 os::cmd::expect_success_and_text 'oc export svc --as-template=template' 'kind: Template'
+# webhook secrets are replaced by generated parameters when exporting a template
+os::cmd::expect_success_and_text 'oc export bc --as-template=template' 'RUBY_SAMPLE_BUILD_GITHUB_WEBHOOK_SECRET'
+os::cmd::expect_success_and_not_text 'oc export bc --as-template=template' 'secret101'
 os::cmd::expect_success_and_not_text 'oc export svc' 'clusterIP'
 os::cmd::expect_success_and_not_text 'oc export svc --exact' 'clusterIP: ""'
 os::cmd::expect_success_and_not_text 'oc export svc --raw' 'clusterIP: ""'
@@ -51,6 +51,8 @@ os::cmd::expect_success_and_text 'oadm ca' 'Manage certificates'
 os::cmd::expect_success_and_text 'openshift start kubernetes' 'Kubernetes server components'
 os::cmd::expect_success_and_text 'oc exec --help' '\[options\] \-\- COMMAND'
 os::cmd::expect_success_and_text 'oc rsh --help' '\[options\] \[COMMAND\]'
+os::cmd::expect_success_and_text 'oc debug --help' '\[options\] \[\-\- COMMAND\]'
+os::cmd::expect_success_and_text 'oc set --help' 'Configure application resources'
 
 # check deprecated admin cmds for backward compatibility
 os::cmd::expect_success_and_text 'oadm create-master-certs -h' 'Create keys and certificates'
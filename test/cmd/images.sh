@@ -38,6 +38,11 @@ fi
 os::cmd::expect_success 'oc delete imageStreams test'
 os::cmd::expect_failure 'oc get imageStreams test'
 
+os::cmd::expect_success_and_text 'oc create imagestream empty' 'imagestream "empty" created'
+os::cmd::expect_success 'oc get imageStreams empty'
+os::cmd::expect_failure_and_text 'oc create imagestream' 'NAME is required'
+os::cmd::expect_success 'oc delete imageStreams empty'
+
 os::cmd::expect_success 'oc create -f examples/image-streams/image-streams-centos7.json'
 os::cmd::expect_success_and_text "oc get imageStreams ruby --template='{{.status.dockerImageRepository}}'" 'ruby'
 os::cmd::expect_success_and_text "oc get imageStreams nodejs --template='{{.status.dockerImageRepository}}'" 'nodejs'
@@ -60,6 +60,15 @@ os::cmd::expect_success 'oc secrets add serviceaccounts/deployer secrets/basicau
 # make sure we can add as as pull secret and mount secret at once
 os::cmd::expect_success 'oc secrets add serviceaccounts/deployer secrets/basicauth secrets/sshauth --for=pull,mount'
 
+# link and unlink secrets by name
+os::cmd::expect_success "echo '{\"kind\":\"ServiceAccount\",\"apiVersion\":\"v1\",\"metadata\":{\"name\":\"linker\"}}' | oc create -f -"
+os::cmd::expect_success 'oc secrets link linker dockercfg basicauth --for=pull,mount'
+os::cmd::expect_success_and_text 'oc get serviceaccounts/linker -o yaml' 'name: dockercfg'
+os::cmd::expect_success 'oc secrets unlink linker secrets/dockercfg'
+os::cmd::expect_success_and_not_text 'oc get serviceaccounts/linker -o yaml' 'name: dockercfg'
+os::cmd::expect_failure_and_text 'oc secrets unlink linker dockercfg' 'secrets dockercfg are not linked to service account linker'
+os::cmd::expect_success 'oc delete serviceaccounts/linker'
+
 # command alias
 os::cmd::expect_success 'oc secret --help'
 os::cmd::expect_success 'oc secret new --help'
@@ -67,5 +76,7 @@ os::cmd::expect_success 'oc secret new-dockercfg --help'
 os::cmd::expect_success 'oc secret new-basicauth --help'
 os::cmd::expect_success 'oc secret new-sshauth --help'
 os::cmd::expect_success 'oc secret add --help'
+os::cmd::expect_success 'oc secret link --help'
+os::cmd::expect_success 'oc secret unlink --help'
 
 echo "secrets: ok"
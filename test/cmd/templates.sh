@@ -33,6 +33,10 @@ os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestboo
 # Individually specified parameter values are honored
 os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json -v ADMIN_USERNAME=myuser -v ADMIN_PASSWORD=mypassword' '"myuser"'
 os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json -v ADMIN_USERNAME=myuser -v ADMIN_PASSWORD=mypassword' '"mypassword"'
+# Templates may be processed on the client, without a server
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json --local -v ADMIN_USERNAME=myuser' '"myuser"'
+os::cmd::expect_success_and_text 'oc process -f test/templates/fixtures/guestbook.json --local --server=https://localhost:1 -o name' 'service/frontend-service'
+os::cmd::expect_failure_and_text 'oc process ruby-helloworld-sample --local' 'Only template files may be processed with --local'
 echo "template+parameters: ok"
 
 # Run as cluster-admin to allow choosing any supplemental groups we want
@@ -33,7 +33,10 @@ os::cmd::expect_success_and_not_text 'oc volume dc/test-deployment-config --list
 os::cmd::expect_success_and_text 'oc get pvc --no-headers | wc -l' '0'
 os::cmd::expect_success 'oc volume dc/test-deployment-config --add --mount-path=/other --claim-size=1G'
 os::cmd::expect_success 'oc volume dc/test-deployment-config --add --mount-path=/second --type=pvc --claim-size=1G --claim-mode=rwo'
-os::cmd::expect_success_and_text 'oc get pvc --no-headers | wc -l' '2'
+os::cmd::expect_success 'oc volume dc/test-deployment-config --add --mount-path=/third --claim-name=fast-claim --claim-size=1G --claim-class=fast'
+os::cmd::expect_success_and_text 'oc get pvc/fast-claim -o yaml' 'volume.alpha.kubernetes.io/storage-class: fast'
+os::cmd::expect_failure_and_text 'oc volume dc/test-deployment-config --add --mount-path=/fourth --claim-name=fast-claim --claim-class=fast' 'only valid together with --claim-size'
+os::cmd::expect_success_and_text 'oc get pvc --no-headers | wc -l' '3'
 
 # command alias
 os::cmd::expect_success 'oc volumes --help'
@@ -11,6 +11,7 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	ktransport "k8s.io/kubernetes/pkg/client/transport"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/runtime"
 
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -32,11 +33,12 @@ var (
 func TestAuthProxyOnAuthorize(t *testing.T) {
 	idp := configapi.IdentityProvider{}
 	idp.Name = "front-proxy"
-	idp.Provider = runtime.EmbeddedObject{&configapi.RequestHeaderIdentityProvider{Headers: []string{"X-Remote-User"}}}
 	idp.MappingMethod = "claim"
 
 	masterConfig, err := testserver.DefaultMasterOptions()
 	checkErr(t, err)
+	// the front proxy authenticates itself with a client certificate signed by the master's CA
+	idp.Provider = runtime.EmbeddedObject{&configapi.RequestHeaderIdentityProvider{ClientCA: masterConfig.ServingInfo.ClientCA, Headers: []string{"X-Remote-User"}}}
 	masterConfig.OAuthConfig.IdentityProviders = []configapi.IdentityProvider{idp}
 
 	clusterAdminKubeConfig, err := testserver.StartConfiguredMasterAPI(masterConfig)
@@ -47,7 +49,9 @@ func TestAuthProxyOnAuthorize(t *testing.T) {
 	checkErr(t, err)
 
 	// set up a front proxy guarding the oauth server
-	proxyHTTPHandler := NewBasicAuthChallenger("TestRegistryAndServer", validUsers, NewXRemoteUserProxyingHandler(clusterAdminClientConfig.Host))
+	proxyTransport, err := kclient.TransportFor(clusterAdminClientConfig)
+	checkErr(t, err)
+	proxyHTTPHandler := NewBasicAuthChallenger("TestRegistryAndServer", validUsers, NewXRemoteUserProxyingHandler(clusterAdminClientConfig.Host, proxyTransport))
 	proxyServer := httptest.NewServer(proxyHTTPHandler)
 	defer proxyServer.Close()
 	t.Logf("proxy server is on %v\n", proxyServer.URL)
@@ -143,10 +143,10 @@ func (handler *xRemoteUserProxyingHandler) ServeHTTP(w http.ResponseWriter, r *h
 	handler.proxier.ServeHTTP(w, r)
 }
 
-func NewXRemoteUserProxyingHandler(rawURL string) http.Handler {
+func NewXRemoteUserProxyingHandler(rawURL string, transport http.RoundTripper) http.Handler {
 	parsedURL, _ := url.Parse(rawURL)
 	proxier := httputil.NewSingleHostReverseProxy(parsedURL)
-	proxier.Transport = insecureTransport()
+	proxier.Transport = transport
 
 	// proxier.Transport = NewBasicAuthRoundTripper(http.DefaultTransport)
 	return &xRemoteUserProxyingHandler{proxier}
@@ -145,7 +145,7 @@ func TestDNS(t *testing.T) {
 			Name: "headless2",
 		},
 		Subsets: []kapi.EndpointSubset{{
-			Addresses: []kapi.EndpointAddress{{IP: "172.0.0.2"}},
+			Addresses: []kapi.EndpointAddress{{IP: "172.0.0.2", TargetRef: &kapi.ObjectReference{Kind: "Pod", Name: "headless2-0"}}},
 			Ports: []kapi.EndpointPort{
 				{Port: 2345, Name: "other"},
 				{Port: 2346, Name: "http"},
@@ -157,8 +157,6 @@ func TestDNS(t *testing.T) {
 	headless2IP := net.ParseIP("172.0.0.2")
 	precannedIP := net.ParseIP("10.2.4.50")
 
-	headless2IPHash := getHash(headless2IP.String())
-
 	tests := []struct {
 		dnsQuestionName   string
 		recursionExpected bool
@@ -194,7 +192,7 @@ func TestDNS(t *testing.T) {
 			dnsQuestionName: "headless.default.svc.cluster.local.",
 			srv: []*dns.SRV{
 				{
-					Target: headlessIPHash + "._unknown-port-2345._tcp.headless.default.svc.cluster.local.",
+					Target: headlessIPHash + ".headless.default.svc.cluster.local.",
 					Port:   2345,
 				},
 			},
@@ -207,19 +205,32 @@ func TestDNS(t *testing.T) {
 			dnsQuestionName: "headless2.default.svc.cluster.local.",
 			expect:          []*net.IP{&headless2IP},
 		},
-		{ // SRV records for that service
+		{ // SRV records for that service point to the pod behind the endpoint
 			dnsQuestionName: "headless2.default.svc.cluster.local.",
 			srv: []*dns.SRV{
 				{
-					Target: headless2IPHash + "._http._tcp.headless2.default.svc.cluster.local.",
+					Target: "headless2-0.headless2.default.svc.cluster.local.",
 					Port:   2346,
 				},
 				{
-					Target: headless2IPHash + "._other._tcp.headless2.default.svc.cluster.local.",
+					Target: "headless2-0.headless2.default.svc.cluster.local.",
 					Port:   2345,
 				},
 			},
 		},
+		{ // SRV record for a named port of that service
+			dnsQuestionName: "_http._tcp.headless2.default.svc.cluster.local.",
+			srv: []*dns.SRV{
+				{
+					Target: "headless2-0.headless2.default.svc.cluster.local.",
+					Port:   2346,
+				},
+			},
+		},
+		{ // the pod behind the endpoint resolves by name
+			dnsQuestionName: "headless2-0.headless2.default.svc.cluster.local.",
+			expect:          []*net.IP{&headless2IP},
+		},
 		{ // the SRV record resolves to the IP
 			dnsQuestionName: "other.e1.headless2.default.svc.cluster.local.",
 			expect:          []*net.IP{&headless2IP},
@@ -0,0 +1,82 @@
+// +build integration,etcd
+
+package integration
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	authapi "github.com/openshift/origin/pkg/auth/api"
+	"github.com/openshift/origin/pkg/auth/authenticator/token/remotemaster"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	oauthapi "github.com/openshift/origin/pkg/oauth/api"
+	testutil "github.com/openshift/origin/test/util"
+	testserver "github.com/openshift/origin/test/util/server"
+)
+
+func TestScopedTokensOnNodes(t *testing.T) {
+	testutil.RequireEtcd()
+	_, clusterAdminKubeConfig, err := testserver.StartTestMaster()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clusterAdminClient, err := testutil.GetClusterAdminClient(clusterAdminKubeConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clusterAdminClientConfig, err := testutil.GetClusterAdminClientConfig(clusterAdminKubeConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bobClient, _, bobConfig, err := testutil.GetClientForUser(*clusterAdminClientConfig, "bob")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bob, err := bobClient.Users().Get("~")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, scoped := bob.Annotations[authapi.ScopesAnnotation]; scoped {
+		t.Errorf("expected an unscoped token not to report scopes, got %v", bob.Annotations)
+	}
+
+	scopedToken := &oauthapi.OAuthAccessToken{
+		ObjectMeta: kapi.ObjectMeta{Name: "bob-scoped-token-bob-scoped-token"},
+		ClientName: "openshift-challenging-client",
+		ExpiresIn:  200,
+		Scopes:     []string{"user:info"},
+		UserName:   bob.Name,
+		UserUID:    string(bob.UID),
+	}
+	if err := clusterAdminClient.RESTClient.Post().Resource("oAuthAccessTokens").Body(scopedToken).Do().Error(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scopedConfig := clientcmd.AnonymousClientConfig(*clusterAdminClientConfig)
+	scopedConfig.BearerToken = scopedToken.Name
+	scopedClient, err := client.New(&scopedConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scopedBob, err := scopedClient.Users().Get("~")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scopes := scopedBob.Annotations[authapi.ScopesAnnotation]; scopes != "user:info" {
+		t.Errorf("expected the scopes of the token to be reported, got %q", scopes)
+	}
+
+	// nodes authenticate tokens against the master, and cannot restrict scoped tokens
+	authenticator, err := remotemaster.NewAuthenticator(*clusterAdminClientConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, err := authenticator.AuthenticateToken(bobConfig.BearerToken); !ok || err != nil {
+		t.Errorf("expected the unscoped token to be accepted, got %v %v", ok, err)
+	}
+	if _, ok, err := authenticator.AuthenticateToken(scopedToken.Name); ok || err == nil {
+		t.Errorf("expected the scoped token to be rejected, got %v %v", ok, err)
+	}
+}
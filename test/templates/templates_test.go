@@ -56,7 +56,7 @@ func TestTemplateTransformationFromConfig(t *testing.T) {
 	osClient := osclient.NewOrDie(&kclient.Config{Host: server.URL, GroupVersion: &latest.Version})
 
 	storage := map[string]rest.Storage{
-		"processedTemplates": templateregistry.NewREST(),
+		"processedTemplates": templateregistry.NewREST(nil, nil),
 	}
 	for k, v := range storage {
 		delete(storage, k)